@@ -46,6 +46,24 @@ const (
 	ArtifactReasonDownloadComplete = "DownloadComplete"
 	ArtifactReasonVerifying        = "Verifying"
 	ArtifactReasonVerified         = "Verified"
+	// ArtifactReasonSourceChanged means the download source changed while a download was in
+	// flight, so the obsolete job was cancelled and the download restarts from scratch.
+	ArtifactReasonSourceChanged = "SourceChanged"
+
+	// ArtifactConditionStorageProvisioned is True when the cache PVC is bound.
+	// It is False with the provisioning event message while the PVC cannot be provisioned.
+	// Also mirrored on AIMTemplateCache from its artifacts.
+	ArtifactConditionStorageProvisioned = "StorageProvisioned"
+
+	ArtifactReasonStorageBound   = "Bound"
+	ArtifactReasonStoragePending = "Pending"
+
+	// ArtifactConditionStorageUndersized is True when the existing cache PVC is smaller than
+	// the model it has to hold, e.g. because it was created before the model size was known.
+	// It is removed once the PVC is large enough. Also mirrored on AIMTemplateCache.
+	ArtifactConditionStorageUndersized = "StorageUndersized"
+
+	ArtifactReasonInsufficientCapacity = "InsufficientCapacity"
 )
 
 // AIMArtifactMode indicates the ownership mode of a artifact, derived from owner references.
@@ -63,6 +81,7 @@ const (
 )
 
 // AIMArtifactSpec defines the desired state of AIMArtifact
+// +kubebuilder:validation:XValidation:rule="has(self.encryption) == has(oldSelf.encryption)",message="encryption cannot be added or removed after creation"
 type AIMArtifactSpec struct {
 	// SourceURI specifies the source location of the model to download.
 	// Supported protocols: hf:// (HuggingFace) and s3:// (S3-compatible storage).
@@ -87,6 +106,12 @@ type AIMArtifactSpec struct {
 	// +optional
 	StorageClassName string `json:"storageClassName,omitempty"`
 
+	// Encryption encrypts the downloaded files at rest using the referenced key.
+	// The key reference is immutable, since files already on the volume were encrypted with it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.kmsSecretRef == oldSelf.kmsSecretRef",message="encryption key reference is immutable"
+	Encryption *AIMStorageEncryptionConfig `json:"encryption,omitempty"`
+
 	// Size specifies the size of the cache volume
 	// +optional
 	Size resource.Quantity `json:"size"`
@@ -167,6 +192,18 @@ type AIMArtifactStatus struct {
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// TransitionHistory records the most recent transitions of the Ready condition, oldest first.
+	// It is bounded to the last 10 entries and outlives the events that reported the transitions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	TransitionHistory []AIMConditionTransition `json:"transitionHistory,omitempty"`
+
+	// ApplyFailures lists the child objects that could not be applied in the last reconcile.
+	// Other children are still applied when one fails. Cleared once all objects apply.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyFailures []AIMApplyFailure `json:"applyFailures,omitempty"`
+
 	// Status represents the current status of the artifact
 	// +kubebuilder:default=Pending
 	// +kubebuilder:validation:Enum=Pending;Progressing;Ready;Degraded;Failed;NotAvailable
@@ -208,6 +245,71 @@ type AIMArtifactStatus struct {
 	// HeadroomPercent is the headroom percentage that was applied to the PVC size.
 	// +optional
 	HeadroomPercent *int32 `json:"headroomPercent,omitempty"`
+
+	// Provenance records which exact files were downloaded and from which upstream revision.
+	// Patched by the downloader pod once the download has been verified.
+	// +optional
+	Provenance *AIMArtifactProvenance `json:"provenance,omitempty"`
+
+	// LastInterruption records the most recent download that was cancelled because the
+	// download image, source, model ID or env changed while it was in flight.
+	// +optional
+	LastInterruption *AIMDownloadInterruption `json:"lastInterruption,omitempty"`
+}
+
+// AIMDownloadInterruption describes a download job that was cancelled because its source changed.
+type AIMDownloadInterruption struct {
+	// Time is when the obsolete download job was cancelled.
+	Time metav1.Time `json:"time"`
+
+	// JobName is the name of the cancelled download job.
+	JobName string `json:"jobName"`
+
+	// PreviousSourceHash identifies the source the cancelled job was downloading.
+	PreviousSourceHash string `json:"previousSourceHash"`
+
+	// SourceHash identifies the source the restarted download uses.
+	SourceHash string `json:"sourceHash"`
+
+	// Message describes what changed.
+	Message string `json:"message"`
+}
+
+// AIMArtifactProvenance identifies the exact weights held by an artifact.
+type AIMArtifactProvenance struct {
+	// SourceURI is the URI the files were downloaded from.
+	SourceURI string `json:"sourceUri"`
+
+	// Revision is the upstream revision of the files, such as the Hugging Face commit hash.
+	// Empty for sources without revisions, such as S3.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// ManifestDigest is the SHA-256 digest of the file manifest: one "<sha256>  <path>" line per
+	// file, sorted by path. Two artifacts with the same digest hold identical weights.
+	ManifestDigest string `json:"manifestDigest"`
+
+	// RecordedAt is when the checksums were computed.
+	// +optional
+	RecordedAt *metav1.Time `json:"recordedAt,omitempty"`
+
+	// Files lists the checksum of each downloaded file, sorted by path.
+	// Checksums are computed before the files are encrypted at rest.
+	// +optional
+	// +kubebuilder:validation:MaxItems=1000
+	Files []AIMArtifactFile `json:"files,omitempty"`
+}
+
+// AIMArtifactFile is the checksum of a single downloaded file.
+type AIMArtifactFile struct {
+	// Path is the path of the file relative to the artifact root.
+	Path string `json:"path"`
+
+	// SizeBytes is the size of the file in bytes.
+	SizeBytes int64 `json:"sizeBytes"`
+
+	// SHA256 is the hex-encoded SHA-256 checksum of the file.
+	SHA256 string `json:"sha256"`
 }
 
 func (m *AIMArtifact) GetStatus() *AIMArtifactStatus {
@@ -226,6 +328,22 @@ func (s *AIMArtifactStatus) SetConditions(conditions []metav1.Condition) {
 	s.Conditions = conditions
 }
 
+func (s *AIMArtifactStatus) GetTransitionHistory() []AIMConditionTransition {
+	return s.TransitionHistory
+}
+
+func (s *AIMArtifactStatus) SetTransitionHistory(history []AIMConditionTransition) {
+	s.TransitionHistory = history
+}
+
+func (s *AIMArtifactStatus) GetApplyFailures() []AIMApplyFailure {
+	return s.ApplyFailures
+}
+
+func (s *AIMArtifactStatus) SetApplyFailures(failures []AIMApplyFailure) {
+	s.ApplyFailures = failures
+}
+
 func (s *AIMArtifactStatus) SetStatus(status string) {
 	s.Status = constants.AIMStatus(status)
 }
@@ -0,0 +1,304 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package v1alpha1
+
+import (
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+const (
+	// AIMBatchJobReasonTemplateNotFound is used when neither an AIMServiceTemplate nor an
+	// AIMClusterServiceTemplate exists with the referenced name.
+	AIMBatchJobReasonTemplateNotFound = "TemplateNotFound"
+
+	// AIMBatchJobReasonTemplateNotReady is used while the referenced template is not Ready.
+	AIMBatchJobReasonTemplateNotReady = "TemplateNotReady"
+
+	// AIMBatchJobReasonTemplateNamespaceDenied is used when the namespaceSelector of the referenced
+	// cluster template does not match the namespace of the batch job.
+	AIMBatchJobReasonTemplateNamespaceDenied = "TemplateNamespaceDenied"
+)
+
+// AIMBatchJobDataset references a dataset stored on a PersistentVolumeClaim or in S3.
+// Exactly one of persistentVolumeClaim and s3Uri must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.persistentVolumeClaim) != has(self.s3Uri)",message="exactly one of persistentVolumeClaim or s3Uri must be set"
+type AIMBatchJobDataset struct {
+	// PersistentVolumeClaim references a claim in the namespace of the batch job.
+	// +optional
+	PersistentVolumeClaim *AIMBatchJobVolumeDataset `json:"persistentVolumeClaim,omitempty"`
+
+	// S3URI is the s3:// location of the dataset. Credentials are passed through env.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^s3://[^ \t\r\n]+$`
+	S3URI string `json:"s3Uri,omitempty"`
+}
+
+// AIMBatchJobVolumeDataset locates a dataset on a PersistentVolumeClaim.
+type AIMBatchJobVolumeDataset struct {
+	// ClaimName is the name of the PersistentVolumeClaim.
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+
+	// Path is the path of the dataset inside the volume. Defaults to the volume root.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!self.contains('..')",message="path must not contain '..'"
+	Path string `json:"path,omitempty"`
+}
+
+// AIMBatchJobSpec defines the desired state of AIMBatchJob.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type AIMBatchJobSpec struct {
+	// Template is the name of the AIMServiceTemplate that selects the model, image and profile.
+	// The controller looks for a namespace-scoped AIMServiceTemplate first and falls back to
+	// the AIMClusterServiceTemplate with the same name.
+	// +kubebuilder:validation:MinLength=1
+	Template string `json:"template"`
+
+	// Input is the dataset to run inference on.
+	Input AIMBatchJobDataset `json:"input"`
+
+	// Output is where the results are written.
+	Output AIMBatchJobDataset `json:"output"`
+
+	// Shards splits the input across this many pods of an Indexed Job. Each pod receives
+	// its shard index and the shard count through AIM_BATCH_SHARD_INDEX and AIM_BATCH_SHARD_COUNT.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=256
+	Shards *int32 `json:"shards,omitempty"`
+
+	// BackoffLimitPerShard is the number of retries of a shard before it is marked failed.
+	// +optional
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=0
+	BackoffLimitPerShard *int32 `json:"backoffLimitPerShard,omitempty"`
+
+	// Args overrides the arguments of the inference container. Defaults to ["batch"].
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env lists additional environment variables for the inference container, such as
+	// S3 credentials. They take precedence over the variables derived from the template.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources overrides the resources derived from the template.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// ImagePullSecrets are added to the secrets of the model.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// ServiceAccountName overrides the service account of the model.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// RuntimeConfigRef contains the runtime config reference for this batch job.
+	RuntimeConfigRef `json:",inline"`
+}
+
+// AIMBatchJobProgress counts the shards of a batch job by state.
+type AIMBatchJobProgress struct {
+	// Total is the number of shards.
+	Total int32 `json:"total"`
+
+	// Succeeded is the number of shards that completed.
+	Succeeded int32 `json:"succeeded"`
+
+	// Failed is the number of shards that exhausted their retries.
+	Failed int32 `json:"failed"`
+
+	// Active is the number of shards that are running.
+	Active int32 `json:"active"`
+}
+
+// AIMBatchJobStatus defines the observed state of AIMBatchJob.
+type AIMBatchJobStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest observations of the batch job's state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// TransitionHistory records the most recent transitions of the Ready condition, oldest first.
+	// It is bounded to the last 10 entries and outlives the events that reported the transitions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	TransitionHistory []AIMConditionTransition `json:"transitionHistory,omitempty"`
+
+	// ApplyFailures lists the child objects that could not be applied in the last reconcile.
+	// Other children are still applied when one fails. Cleared once all objects apply.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyFailures []AIMApplyFailure `json:"applyFailures,omitempty"`
+
+	// ValidationErrors lists the spec fields that failed validation in the last reconcile,
+	// sorted by field path. ConfigValid summarizes them. Cleared once the spec is valid.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	ValidationErrors []AIMValidationError `json:"validationErrors,omitempty"`
+
+	// Status represents the current status of the batch job. Ready means all shards completed.
+	// +kubebuilder:default=Pending
+	// +kubebuilder:validation:Enum=Pending;Progressing;Ready;Degraded;Failed;NotAvailable
+	Status constants.AIMStatus `json:"status,omitempty"`
+
+	// ResolvedTemplate is the template the batch job runs.
+	// +optional
+	ResolvedTemplate *AIMResolvedReference `json:"resolvedTemplate,omitempty"`
+
+	// TemplateCache is the name of the Ready template cache whose artifacts are mounted.
+	// Empty when no cache was available and the model is downloaded by the job.
+	// +optional
+	TemplateCache string `json:"templateCache,omitempty"`
+
+	// Job is the name of the Job running the inference.
+	// +optional
+	Job string `json:"job,omitempty"`
+
+	// Progress counts the shards by state.
+	// +optional
+	Progress *AIMBatchJobProgress `json:"progress,omitempty"`
+
+	// OutputLocation is where the results are written, as pvc://<claim>/<path> or an s3:// URI.
+	// +optional
+	OutputLocation string `json:"outputLocation,omitempty"`
+
+	// StartTime is when the Job started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when all shards completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=aimbj,categories=aim;all
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Template",type=string,JSONPath=`.spec.template`
+// +kubebuilder:printcolumn:name="Succeeded",type=integer,JSONPath=`.status.progress.succeeded`
+// +kubebuilder:printcolumn:name="Shards",type=integer,JSONPath=`.status.progress.total`
+// +kubebuilder:printcolumn:name="Output",type=string,JSONPath=`.status.outputLocation`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AIMBatchJob runs offline batch inference over a dataset with the image and profile of a
+// template, mounting the template cache created for serving when one is Ready.
+type AIMBatchJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AIMBatchJobSpec   `json:"spec,omitempty"`
+	Status AIMBatchJobStatus `json:"status,omitempty"`
+}
+
+// GetStatus returns a pointer to the status for use with the controller pipeline.
+func (j *AIMBatchJob) GetStatus() *AIMBatchJobStatus {
+	return &j.Status
+}
+
+func (j *AIMBatchJob) GetRuntimeConfigRef() RuntimeConfigRef {
+	return j.Spec.RuntimeConfigRef
+}
+
+// GetShards returns the number of shards, defaulting to 1.
+func (j *AIMBatchJob) GetShards() int32 {
+	if j.Spec.Shards == nil || *j.Spec.Shards < 1 {
+		return 1
+	}
+	return *j.Spec.Shards
+}
+
+// Location returns the dataset location as pvc://<claim>/<path> or the s3:// URI.
+func (d AIMBatchJobDataset) Location() string {
+	if d.PersistentVolumeClaim != nil {
+		return "pvc://" + path.Join(d.PersistentVolumeClaim.ClaimName, d.PersistentVolumeClaim.Path)
+	}
+	return d.S3URI
+}
+
+func (s *AIMBatchJobStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
+
+func (s *AIMBatchJobStatus) SetConditions(conditions []metav1.Condition) {
+	s.Conditions = conditions
+}
+
+func (s *AIMBatchJobStatus) GetTransitionHistory() []AIMConditionTransition {
+	return s.TransitionHistory
+}
+
+func (s *AIMBatchJobStatus) SetTransitionHistory(history []AIMConditionTransition) {
+	s.TransitionHistory = history
+}
+
+func (s *AIMBatchJobStatus) GetApplyFailures() []AIMApplyFailure {
+	return s.ApplyFailures
+}
+
+func (s *AIMBatchJobStatus) SetApplyFailures(failures []AIMApplyFailure) {
+	s.ApplyFailures = failures
+}
+
+func (s *AIMBatchJobStatus) GetValidationErrors() []AIMValidationError {
+	return s.ValidationErrors
+}
+
+func (s *AIMBatchJobStatus) SetValidationErrors(errs []AIMValidationError) {
+	s.ValidationErrors = errs
+}
+
+func (s *AIMBatchJobStatus) SetStatus(status string) {
+	s.Status = constants.AIMStatus(status)
+}
+
+func (s *AIMBatchJobStatus) GetAIMStatus() constants.AIMStatus {
+	return s.Status
+}
+
+// +kubebuilder:object:root=true
+
+// AIMBatchJobList contains a list of AIMBatchJob.
+type AIMBatchJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AIMBatchJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AIMBatchJob{}, &AIMBatchJobList{})
+}
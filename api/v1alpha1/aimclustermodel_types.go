@@ -49,6 +49,8 @@ const (
 // +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.status.sourceType`
 // +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.spec.image`
 // +kubebuilder:printcolumn:name="Model",type=string,JSONPath=`.status.imageMetadata.model.canonicalName`
+// +kubebuilder:printcolumn:name="Family",type=string,JSONPath=`.spec.family`,priority=1
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.spec.version`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type AIMClusterModel struct {
 	metav1.TypeMeta   `json:",inline"`
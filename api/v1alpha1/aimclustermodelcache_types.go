@@ -0,0 +1,222 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+const (
+	// ClusterModelCacheReasonVolumeNotBound is used while the artifact PVC has no bound volume to share.
+	ClusterModelCacheReasonVolumeNotBound = "VolumeNotBound"
+)
+
+// AIMClusterModelCacheSpec defines the desired state of AIMClusterModelCache.
+type AIMClusterModelCacheSpec struct {
+	// SourceURI specifies the source location of the model to download.
+	// Supported protocols: hf:// (HuggingFace) and s3:// (S3-compatible storage).
+	// Services whose template lists a model source with the same URI use this cache.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sourceUri is immutable"
+	// +kubebuilder:validation:Pattern=`^(hf|s3)://[^ \t\r\n]+$`
+	SourceURI string `json:"sourceUri"`
+
+	// ModelID is the canonical identifier in {org}/{name} format, which determines the
+	// download path. When not specified, derived from SourceURI for HuggingFace sources.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+/[a-zA-Z0-9._-]+$`
+	ModelID string `json:"modelId,omitempty"`
+
+	// StorageClassName specifies the storage class for the cache volume. The class must provide
+	// ReadWriteMany volumes that can be statically bound more than once (e.g. NFS or CephFS),
+	// since every namespace mounts the same volume through its own PersistentVolume.
+	// When not specified, uses the cluster default storage class.
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// Size specifies the size of the cache volume.
+	// +optional
+	Size resource.Quantity `json:"size,omitempty"`
+
+	// Env lists the environment variables to use for authentication when downloading the model.
+	// Secrets referenced here must exist in the operator namespace.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// ModelDownloadImage specifies the container image used to download the model.
+	// +optional
+	ModelDownloadImage string `json:"modelDownloadImage,omitempty"`
+
+	// ImagePullSecrets references secrets in the operator namespace for pulling the download image.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// NamespaceSelector selects the namespaces whose services may mount the cache.
+	// An empty selector allows all namespaces. When unset, no namespace may use the cache.
+	// Services in namespaces that stop matching lose access: their volume is removed and
+	// they fall back to their own template cache.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// AIMClusterModelCacheStatus defines the observed state of AIMClusterModelCache.
+type AIMClusterModelCacheStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest observations of the cache's state.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// TransitionHistory records the most recent transitions of the Ready condition, oldest first.
+	// It is bounded to the last 10 entries and outlives the events that reported the transitions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	TransitionHistory []AIMConditionTransition `json:"transitionHistory,omitempty"`
+
+	// ApplyFailures lists the child objects that could not be applied in the last reconcile.
+	// Other children are still applied when one fails. Cleared once all objects apply.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyFailures []AIMApplyFailure `json:"applyFailures,omitempty"`
+
+	// Status represents the current status of the cache.
+	// +kubebuilder:default=Pending
+	// +kubebuilder:validation:Enum=Pending;Progressing;Ready;Degraded;Failed;NotAvailable
+	Status constants.AIMStatus `json:"status,omitempty"`
+
+	// Artifact is the name of the AIMArtifact in the operator namespace that downloads the model.
+	// +optional
+	Artifact string `json:"artifact,omitempty"`
+
+	// PersistentVolume is the name of the volume holding the downloaded model. Namespaces
+	// mount it through their own PersistentVolume and PersistentVolumeClaim pair.
+	// +optional
+	PersistentVolume string `json:"persistentVolume,omitempty"`
+
+	// DisplaySize is the human-readable size of the cache volume.
+	// +optional
+	DisplaySize string `json:"displaySize,omitempty"`
+
+	// SharedNamespaces lists the namespaces that currently mount the cache.
+	// +optional
+	SharedNamespaces []string `json:"sharedNamespaces,omitempty"`
+
+	// Revision is the upstream revision recorded in the artifact's provenance, if any.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// ManifestDigest is the manifest digest recorded in the artifact's provenance, if any.
+	// +optional
+	ManifestDigest string `json:"manifestDigest,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=aimclmc,categories=aim;all
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceUri`
+// +kubebuilder:printcolumn:name="Size",type=string,JSONPath=`.status.displaySize`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AIMClusterModelCache downloads a model once onto ReadWriteMany storage and shares it
+// read-only with services in the namespaces selected by its namespaceSelector.
+type AIMClusterModelCache struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AIMClusterModelCacheSpec   `json:"spec,omitempty"`
+	Status AIMClusterModelCacheStatus `json:"status,omitempty"`
+}
+
+// GetStatus returns a pointer to the status for use with the controller pipeline.
+func (c *AIMClusterModelCache) GetStatus() *AIMClusterModelCacheStatus {
+	return &c.Status
+}
+
+// AllowsNamespace returns true if services in a namespace with the given labels may mount the cache.
+// Invalid selectors allow no namespace.
+func (c *AIMClusterModelCache) AllowsNamespace(namespaceLabels map[string]string) bool {
+	if c.Spec.NamespaceSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(c.Spec.NamespaceSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(namespaceLabels))
+}
+
+func (s *AIMClusterModelCacheStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
+
+func (s *AIMClusterModelCacheStatus) SetConditions(conditions []metav1.Condition) {
+	s.Conditions = conditions
+}
+
+func (s *AIMClusterModelCacheStatus) GetTransitionHistory() []AIMConditionTransition {
+	return s.TransitionHistory
+}
+
+func (s *AIMClusterModelCacheStatus) SetTransitionHistory(history []AIMConditionTransition) {
+	s.TransitionHistory = history
+}
+
+func (s *AIMClusterModelCacheStatus) GetApplyFailures() []AIMApplyFailure {
+	return s.ApplyFailures
+}
+
+func (s *AIMClusterModelCacheStatus) SetApplyFailures(failures []AIMApplyFailure) {
+	s.ApplyFailures = failures
+}
+
+func (s *AIMClusterModelCacheStatus) SetStatus(status string) {
+	s.Status = constants.AIMStatus(status)
+}
+
+func (s *AIMClusterModelCacheStatus) GetAIMStatus() constants.AIMStatus {
+	return s.Status
+}
+
+// +kubebuilder:object:root=true
+
+// AIMClusterModelCacheList contains a list of AIMClusterModelCache.
+type AIMClusterModelCacheList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AIMClusterModelCache `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AIMClusterModelCache{}, &AIMClusterModelCacheList{})
+}
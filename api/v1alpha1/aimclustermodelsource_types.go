@@ -183,6 +183,18 @@ type AIMClusterModelSourceStatus struct {
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// TransitionHistory records the most recent transitions of the Ready condition, oldest first.
+	// It is bounded to the last 10 entries and outlives the events that reported the transitions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	TransitionHistory []AIMConditionTransition `json:"transitionHistory,omitempty"`
+
+	// ApplyFailures lists the child objects that could not be applied in the last reconcile.
+	// Other children are still applied when one fails. Cleared once all objects apply.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyFailures []AIMApplyFailure `json:"applyFailures,omitempty"`
+
 	// ObservedGeneration reflects the generation of the most recently observed spec.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -211,6 +223,22 @@ func (s *AIMClusterModelSourceStatus) SetConditions(conditions []metav1.Conditio
 	s.Conditions = conditions
 }
 
+func (s *AIMClusterModelSourceStatus) GetTransitionHistory() []AIMConditionTransition {
+	return s.TransitionHistory
+}
+
+func (s *AIMClusterModelSourceStatus) SetTransitionHistory(history []AIMConditionTransition) {
+	s.TransitionHistory = history
+}
+
+func (s *AIMClusterModelSourceStatus) GetApplyFailures() []AIMApplyFailure {
+	return s.ApplyFailures
+}
+
+func (s *AIMClusterModelSourceStatus) SetApplyFailures(failures []AIMApplyFailure) {
+	s.ApplyFailures = failures
+}
+
 // SetStatus sets the overall status string.
 func (s *AIMClusterModelSourceStatus) SetStatus(status string) {
 	s.Status = status
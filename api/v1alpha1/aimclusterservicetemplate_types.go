@@ -24,6 +24,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // AIMClusterServiceTemplate is a cluster-scoped template that defines runtime profiles for AIM services.
@@ -75,6 +76,19 @@ func (t *AIMClusterServiceTemplate) GetRuntimeConfigRef() RuntimeConfigRef {
 	return t.Spec.RuntimeConfigRef
 }
 
+// AllowsNamespace returns true if the namespaceSelector matches the namespace labels.
+// An unset selector allows all namespaces; an invalid selector allows none.
+func (t *AIMClusterServiceTemplate) AllowsNamespace(namespaceLabels map[string]string) bool {
+	if t.Spec.NamespaceSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(t.Spec.NamespaceSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(namespaceLabels))
+}
+
 func init() {
 	SchemeBuilder.Register(&AIMClusterServiceTemplate{}, &AIMClusterServiceTemplateList{})
 }
@@ -0,0 +1,124 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// AIMEndpointProtocol is the API protocol an endpoint serves.
+// +kubebuilder:validation:Enum=OpenAI
+type AIMEndpointProtocol string
+
+const (
+	// AIMEndpointProtocolOpenAI is the OpenAI-compatible HTTP API served by AIM images.
+	AIMEndpointProtocolOpenAI AIMEndpointProtocol = "OpenAI"
+)
+
+// AIMEndpointAuthMode describes how requests to an endpoint are authenticated.
+// +kubebuilder:validation:Enum=None;Gateway
+type AIMEndpointAuthMode string
+
+const (
+	// AIMEndpointAuthModeNone means the endpoint is reached directly and requests are not authenticated.
+	AIMEndpointAuthModeNone AIMEndpointAuthMode = "None"
+	// AIMEndpointAuthModeGateway means requests are routed through a Gateway API gateway, whose
+	// policies authenticate them.
+	AIMEndpointAuthModeGateway AIMEndpointAuthMode = "Gateway"
+)
+
+// AIMEndpointSpec identifies the service an endpoint exposes.
+type AIMEndpointSpec struct {
+	// ServiceName is the name of the AIMService in the same namespace.
+	// +kubebuilder:validation:MinLength=1
+	ServiceName string `json:"serviceName"`
+}
+
+// AIMEndpointStatus is the consumer contract of an AIMService. All fields are written on every
+// update, so empty values are meaningful.
+type AIMEndpointStatus struct {
+	// Status is Ready while the service accepts requests and NotAvailable otherwise.
+	// +kubebuilder:validation:Enum=Ready;NotAvailable
+	// +optional
+	Status constants.AIMStatus `json:"status"`
+
+	// URL is the base URL clients send requests to.
+	// +optional
+	URL string `json:"url"`
+
+	// InternalURL is the cluster-local base URL, for clients running in the cluster.
+	// +optional
+	InternalURL string `json:"internalUrl"`
+
+	// RoutePath is the path prefix of the service on the gateway when routing is enabled.
+	// +optional
+	RoutePath string `json:"routePath"`
+
+	// Protocol is the API protocol the endpoint serves.
+	// +optional
+	Protocol AIMEndpointProtocol `json:"protocol"`
+
+	// ModelID is the model identifier clients pass in the model field of requests.
+	// +optional
+	ModelID string `json:"modelId"`
+
+	// AuthMode describes how requests are authenticated.
+	// +optional
+	AuthMode AIMEndpointAuthMode `json:"authMode"`
+}
+
+// AIMEndpoint is the stable consumer contract of an AIMService: its URL, protocol, model
+// identifier, authentication mode and readiness, independent of the underlying InferenceService.
+// The service controller creates one per AIMService, with the name of the service, once the
+// service is first ready, keeps it up to date and deletes it with the service.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=aimep,categories=aim;all
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`
+// +kubebuilder:printcolumn:name="Model",type=string,JSONPath=`.status.modelId`
+// +kubebuilder:printcolumn:name="Protocol",type=string,JSONPath=`.status.protocol`,priority=1
+// +kubebuilder:printcolumn:name="Auth",type=string,JSONPath=`.status.authMode`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type AIMEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AIMEndpointSpec   `json:"spec,omitempty"`
+	Status AIMEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AIMEndpointList contains a list of AIMEndpoint.
+type AIMEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AIMEndpoint `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AIMEndpoint{}, &AIMEndpointList{})
+}
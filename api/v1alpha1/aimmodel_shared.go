@@ -39,6 +39,14 @@ const (
 	// AIMModelReasonMetadataExtractionFailed indicates metadata extraction failed (non-blocking, prevents retries).
 	AIMModelReasonMetadataExtractionFailed = "MetadataExtractionFailed"
 
+	// Image preflight reasons. The model image is checked against the registry before any
+	// discovery job is scheduled; these reasons mark an image that cannot work as specified.
+	AIMModelReasonInvalidImageReference    = "InvalidImageReference"
+	AIMModelReasonImageNotFound            = "ImageNotFound"
+	AIMModelReasonImagePlatformUnsupported = "ImagePlatformUnsupported"
+	AIMModelReasonInvalidImageMetadata     = "InvalidImageMetadata"
+	AIMModelReasonImageRegistryAuthFailed  = "ImageRegistryAuthFailed"
+
 	// Runtime config resolution reasons
 	AIMModelReasonConfigNotFound     = "ConfigNotFound"
 	AIMModelReasonRuntimeConfigError = "RuntimeConfigError"
@@ -56,8 +64,70 @@ const (
 	AIMModelReasonAwaitingMetadata                      = "AwaitingMetadata"
 	AIMModelReasonCreatingTemplates                     = "CreatingTemplates"
 	AIMModelReasonMetadataMissingRecommendedDeployments = "MetadataMissingRecommendedDeployments"
+
+	// AIMModelConditionSecurityPolicyViolated is True when the image's CVE scan summary reports
+	// vulnerabilities above the runtime config's model.security.maxCVESeverity. Templates do not
+	// run discovery for such images and services refuse to serve them.
+	// Only set when a security policy is configured.
+	AIMModelConditionSecurityPolicyViolated = "SecurityPolicyViolated"
+
+	AIMModelReasonCVESeverityExceeded = "CVESeverityExceeded"
+	AIMModelReasonWithinPolicy        = "WithinPolicy"
+	AIMModelReasonNoScanSummary       = "NoScanSummary"
+)
+
+// AIMCVESeverity is the severity of a vulnerability, as reported by an image scanner.
+// +kubebuilder:validation:Enum=Low;Medium;High;Critical
+type AIMCVESeverity string
+
+const (
+	AIMCVESeverityLow      AIMCVESeverity = "Low"
+	AIMCVESeverityMedium   AIMCVESeverity = "Medium"
+	AIMCVESeverityHigh     AIMCVESeverity = "High"
+	AIMCVESeverityCritical AIMCVESeverity = "Critical"
 )
 
+// AIMImageSecurity is the supply-chain information read from the model image's labels and
+// manifest annotations during the image preflight.
+type AIMImageSecurity struct {
+	// SBOMRefs lists references to the image's software bill of materials.
+	// Read from com.amd.aim.security.sbom (comma-separated).
+	// +optional
+	SBOMRefs []string `json:"sbomRefs,omitempty"`
+
+	// Scanner is the tool that produced the CVE summary.
+	// Read from com.amd.aim.security.scanner.
+	// +optional
+	Scanner string `json:"scanner,omitempty"`
+
+	// ScannedAt is when the image was scanned, as reported by the image.
+	// Read from com.amd.aim.security.scanned.
+	// +optional
+	ScannedAt string `json:"scannedAt,omitempty"`
+
+	// CVEs is the number of known vulnerabilities per severity.
+	// Read from com.amd.aim.security.cve.<severity>. Nil if the image carries no scan summary.
+	// +optional
+	CVEs *AIMCVESummary `json:"cves,omitempty"`
+
+	// HighestSeverity is the most severe level with at least one vulnerability.
+	// Empty if the scan summary reports none.
+	// +optional
+	HighestSeverity AIMCVESeverity `json:"highestSeverity,omitempty"`
+}
+
+// AIMCVESummary counts the known vulnerabilities of an image per severity.
+type AIMCVESummary struct {
+	// +optional
+	Critical int32 `json:"critical,omitempty"`
+	// +optional
+	High int32 `json:"high,omitempty"`
+	// +optional
+	Medium int32 `json:"medium,omitempty"`
+	// +optional
+	Low int32 `json:"low,omitempty"`
+}
+
 // AIMModelSourceType indicates how a model's artifacts are sourced.
 // +kubebuilder:validation:Enum=Image;Custom
 type AIMModelSourceType string
@@ -152,6 +222,27 @@ type AIMModelSpec struct {
 	// +optional
 	Discovery *AIMModelDiscoveryConfig `json:"discovery,omitempty"`
 
+	// Family groups related models under a common name, typically different versions of the same model.
+	// Services can reference a family through spec.model.alias and the controller resolves it to
+	// a concrete model according to the service's version policy.
+	// Example: `llama-3.1-8b`
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Family string `json:"family,omitempty"`
+
+	// Version identifies this model within its family. Semantic versions (e.g. `1.2.0`, `v0.8.4`)
+	// are compared numerically; other values are compared lexically and sort below semantic versions.
+	// +optional
+	// +kubebuilder:validation:MaxLength=128
+	Version string `json:"version,omitempty"`
+
+	// Aliases lists alternative names that services can use to reference this model via spec.model.alias.
+	// When several models share an alias, the service version policy decides which one is used.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	Aliases []string `json:"aliases,omitempty"`
+
 	// DefaultServiceTemplate specifies the default AIMServiceTemplate to use when creating services for this model.
 	// When set, services that reference this model will use this template if no template is explicitly specified.
 	// If this is not set, a template will be automatically selected.
@@ -212,6 +303,11 @@ type AIMModelSpec struct {
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 
+	// ModelClass is the kind of model, which selects the planning defaults for its services.
+	// Templates can override it. Defaults to `llm`.
+	// +optional
+	ModelClass AIMModelClass `json:"modelClass,omitempty"`
+
 	// ImageMetadata is the metadata that is used to determine which recommended service templates to create,
 	// and to drive clients with richer metadata regarding this particular model. For most cases the user does
 	// not need to set this field manually, for images that have the supported labels embedded in them
@@ -251,6 +347,18 @@ type AIMModelStatus struct {
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// TransitionHistory records the most recent transitions of the Ready condition, oldest first.
+	// It is bounded to the last 10 entries and outlives the events that reported the transitions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	TransitionHistory []AIMConditionTransition `json:"transitionHistory,omitempty"`
+
+	// ApplyFailures lists the child objects that could not be applied in the last reconcile.
+	// Other children are still applied when one fails. Cleared once all objects apply.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyFailures []AIMApplyFailure `json:"applyFailures,omitempty"`
+
 	// ResolvedRuntimeConfig captures metadata about the runtime config that was resolved.
 	// +optional
 	ResolvedRuntimeConfig *AIMResolvedReference `json:"resolvedRuntimeConfig,omitempty"`
@@ -259,12 +367,22 @@ type AIMModelStatus struct {
 	// +optional
 	ImageMetadata *ImageMetadata `json:"imageMetadata,omitempty"`
 
+	// Security is the SBOM and CVE scan information read from the image.
+	// Only populated for image-based models whose image carries security labels or annotations.
+	// +optional
+	Security *AIMImageSecurity `json:"security,omitempty"`
+
 	// SourceType indicates how this model's artifacts are sourced.
 	// - "Image": Model discovered from container image labels
 	// - "Custom": Model uses explicit spec.modelSources
 	// Set by the controller based on whether spec.modelSources is populated.
 	// +optional
 	SourceType AIMModelSourceType `json:"sourceType,omitempty"`
+
+	// Consumers summarizes the AIMServices currently resolved to this model.
+	// Only populated for cluster-scoped models.
+	// +optional
+	Consumers *AIMConsumersStatus `json:"consumers,omitempty"`
 }
 
 func (s *AIMModelStatus) GetConditions() []metav1.Condition {
@@ -275,6 +393,22 @@ func (s *AIMModelStatus) SetConditions(conditions []metav1.Condition) {
 	s.Conditions = conditions
 }
 
+func (s *AIMModelStatus) GetTransitionHistory() []AIMConditionTransition {
+	return s.TransitionHistory
+}
+
+func (s *AIMModelStatus) SetTransitionHistory(history []AIMConditionTransition) {
+	s.TransitionHistory = history
+}
+
+func (s *AIMModelStatus) GetApplyFailures() []AIMApplyFailure {
+	return s.ApplyFailures
+}
+
+func (s *AIMModelStatus) SetApplyFailures(failures []AIMApplyFailure) {
+	s.ApplyFailures = failures
+}
+
 func (s *AIMModelStatus) SetStatus(status string) {
 	s.Status = constants.AIMStatus(status)
 }
@@ -295,6 +429,22 @@ func (s *AIMModelSpec) GetEffectiveImageMetadata(status *AIMModelStatus) *ImageM
 	return nil
 }
 
+// MatchesAlias returns true if the given alias equals the model family or one of its aliases.
+func (s *AIMModelSpec) MatchesAlias(alias string) bool {
+	if alias == "" {
+		return false
+	}
+	if s.Family == alias {
+		return true
+	}
+	for _, a := range s.Aliases {
+		if a == alias {
+			return true
+		}
+	}
+	return false
+}
+
 // ShouldCreateTemplates returns whether template creation is enabled for this model.
 // Returns true if discovery.createServiceTemplates is unset or true.
 func (s *AIMModelSpec) ShouldCreateTemplates() bool {
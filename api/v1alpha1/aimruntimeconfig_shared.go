@@ -24,6 +24,8 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -38,6 +40,14 @@ type AIMStorageConfig struct {
 	// +optional
 	DefaultStorageClassName *string `json:"defaultStorageClassName,omitempty"`
 
+	// TopologyStorageClasses maps node pools or zones to the storage class used for cache PVCs
+	// of services placed there. The first entry whose node labels are all pinned by the service's
+	// nodeSelector or required node affinity is used, so the volume is provisioned where the
+	// inference pods run. Services without a matching entry use DefaultStorageClassName.
+	// +optional
+	// +listType=atomic
+	TopologyStorageClasses []AIMTopologyStorageClass `json:"topologyStorageClasses,omitempty"`
+
 	// PVCHeadroomPercent specifies the percentage of extra space to add to PVCs
 	// for model storage. This accounts for filesystem overhead and temporary files
 	// during model loading. The value represents a percentage (e.g., 10 means 10% extra space).
@@ -46,6 +56,65 @@ type AIMStorageConfig struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	PVCHeadroomPercent *int32 `json:"pvcHeadroomPercent,omitempty"`
+
+	// Encryption encrypts model weights at rest in cache volumes.
+	// Artifacts downloaded with encryption are only reused by consumers configured with the same key.
+	// +optional
+	Encryption *AIMStorageEncryptionConfig `json:"encryption,omitempty"`
+}
+
+// AIMTopologyStorageClass selects a storage class for services placed on a node pool or zone.
+type AIMTopologyStorageClass struct {
+	// NodeLabels identifies the node pool or zone, e.g. topology.kubernetes.io/zone: us-east-1a.
+	// +kubebuilder:validation:MinProperties=1
+	NodeLabels map[string]string `json:"nodeLabels"`
+
+	// StorageClassName is the storage class used for cache PVCs of services placed on matching nodes.
+	// +kubebuilder:validation:MinLength=1
+	StorageClassName string `json:"storageClassName"`
+}
+
+// AIMCacheDecryptionMode selects how serving pods read encrypted cache volumes.
+// +kubebuilder:validation:Enum=InitContainer;Transparent
+type AIMCacheDecryptionMode string
+
+const (
+	// CacheDecryptionModeInitContainer decrypts the cache into an emptyDir volume in an init container
+	// before the inference container starts.
+	CacheDecryptionModeInitContainer AIMCacheDecryptionMode = "InitContainer"
+	// CacheDecryptionModeTransparent mounts the encrypted cache volume directly and hands the key to
+	// the inference container, for runtimes or volume drivers that decrypt on read.
+	CacheDecryptionModeTransparent AIMCacheDecryptionMode = "Transparent"
+)
+
+// AIMStorageEncryptionConfig configures encryption at rest for cached model weights.
+type AIMStorageEncryptionConfig struct {
+	// KMSSecretRef references the key in a Secret that holds the encryption passphrase.
+	// The Secret must exist in the namespace of the cache and of the consuming services.
+	// Files are encrypted with AES-256 (openssl enc, PBKDF2 key derivation) after download.
+	KMSSecretRef corev1.SecretKeySelector `json:"kmsSecretRef"`
+
+	// Decryption selects how serving pods read the encrypted weights.
+	// +kubebuilder:default=InitContainer
+	// +optional
+	Decryption AIMCacheDecryptionMode `json:"decryption,omitempty"`
+}
+
+// GetDecryption returns the effective decryption mode, defaulting to InitContainer.
+func (e *AIMStorageEncryptionConfig) GetDecryption() AIMCacheDecryptionMode {
+	if e == nil || e.Decryption == "" {
+		return CacheDecryptionModeInitContainer
+	}
+	return e.Decryption
+}
+
+// UsesSameKey returns true if both configs reference the same encryption key.
+// Two nil configs (no encryption) are considered equal.
+func (e *AIMStorageEncryptionConfig) UsesSameKey(other *AIMStorageEncryptionConfig) bool {
+	if e == nil || other == nil {
+		return e == nil && other == nil
+	}
+	return e.KMSSecretRef.Name == other.KMSSecretRef.Name && e.KMSSecretRef.Key == other.KMSSecretRef.Key
 }
 
 // AIMServiceRuntimeConfig contains runtime configuration fields that apply to services.
@@ -71,6 +140,153 @@ type AIMServiceRuntimeConfig struct {
 	// +listType=map
 	// +listMapKey=name
 	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Scheduling constrains which nodes the operator's pods are placed on, e.g. to target
+	// a tainted GPU node pool. On RuntimeConfigs it applies to inference, discovery, and
+	// cache download pods. On AIMService it applies to the inference pods and is merged
+	// over the runtime config values.
+	// +optional
+	Scheduling *AIMSchedulingConfig `json:"scheduling,omitempty"`
+
+	// Logging configures the log output of inference containers through the AIM image's
+	// logging environment variables. On AIMService, fields that are set take precedence
+	// over the runtime config values. Variables set explicitly in env take precedence over both.
+	// Discovery jobs are not affected, since they rely on silenced logs for their output.
+	// +optional
+	Logging *AIMLoggingConfig `json:"logging,omitempty"`
+
+	// Termination controls how inference pods shut down, so rolling updates and scale-downs
+	// let in-flight generations finish. On AIMService, fields that are set take precedence
+	// over the runtime config values. When unset everywhere, the Kubernetes defaults apply.
+	// +optional
+	Termination *AIMTerminationConfig `json:"termination,omitempty"`
+
+	// NetworkPolicy isolates the inference pods with a NetworkPolicy that only admits
+	// traffic from selected peers and only lets the pods reach DNS and the model registries.
+	// On AIMService, fields that are set take precedence over the runtime config values.
+	// +optional
+	NetworkPolicy *AIMNetworkPolicyConfig `json:"networkPolicy,omitempty"`
+}
+
+// AIMNetworkPolicyConfig configures the NetworkPolicy planned for the inference pods of a service.
+type AIMNetworkPolicyConfig struct {
+	// Enabled controls whether a NetworkPolicy is planned. When it is disabled, a policy
+	// created earlier is removed.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// IngressFrom lists additional peers allowed to reach the inference pods, for example
+	// a monitoring namespace. Pods in the service's namespace and in the namespace of the
+	// routing gateway are always allowed.
+	// +optional
+	IngressFrom []networkingv1.NetworkPolicyPeer `json:"ingressFrom,omitempty"`
+
+	// SystemNamespaces are the namespaces of platform components that must reach the inference
+	// pods, such as the Knative activator in Serverless mode and the metrics scraper KEDA
+	// scales on. The operator namespace is always allowed.
+	// Defaults to `knative-serving` and `monitoring`.
+	// +optional
+	SystemNamespaces []string `json:"systemNamespaces,omitempty"`
+
+	// RegistryCIDRs are the address ranges of the model and image registries the inference
+	// pods may connect to, in CIDR notation. DNS lookups are always allowed. When empty,
+	// the pods cannot open any other outbound connection.
+	// Example: `10.20.0.0/16`
+	// +optional
+	RegistryCIDRs []string `json:"registryCIDRs,omitempty"`
+}
+
+// IsEnabled reports whether the network policy is enabled, false when unset.
+func (c *AIMNetworkPolicyConfig) IsEnabled() bool {
+	return c != nil && c.Enabled != nil && *c.Enabled
+}
+
+// AIMTerminationConfig configures the termination grace period and preStop hook of inference pods.
+type AIMTerminationConfig struct {
+	// GracePeriodSeconds is the terminationGracePeriodSeconds of inference pods: how long a
+	// pod may take to finish in-flight requests after it is asked to stop. Defaults to 120.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// DrainPath is an HTTP path on the serving port that the preStop hook calls with POST
+	// to make the server stop accepting new requests. The call needs curl in the image.
+	// When empty, the preStop hook only waits.
+	// Example: `/drain`
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*`
+	DrainPath string `json:"drainPath,omitempty"`
+
+	// DrainSeconds is how long the preStop hook waits before the container receives SIGTERM,
+	// so load balancers stop routing to the pod and requests in flight can complete.
+	// Capped at the grace period. Defaults to 15.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DrainSeconds *int32 `json:"drainSeconds,omitempty"`
+}
+
+// AIMLogLevel is a log level understood by the AIM runtime.
+// +kubebuilder:validation:Enum=DEBUG;INFO;WARNING;ERROR;CRITICAL
+type AIMLogLevel string
+
+const (
+	LogLevelDebug    AIMLogLevel = "DEBUG"
+	LogLevelInfo     AIMLogLevel = "INFO"
+	LogLevelWarning  AIMLogLevel = "WARNING"
+	LogLevelError    AIMLogLevel = "ERROR"
+	LogLevelCritical AIMLogLevel = "CRITICAL"
+)
+
+// AIMLogFormat selects how log lines are written.
+// +kubebuilder:validation:Enum=Text;JSON
+type AIMLogFormat string
+
+const (
+	LogFormatText AIMLogFormat = "Text"
+	LogFormatJSON AIMLogFormat = "JSON"
+)
+
+// AIMLoggingConfig configures logging of the AIM runtime in inference containers.
+type AIMLoggingConfig struct {
+	// Level sets the log level of the AIM runtime (AIM_LOG_LEVEL).
+	// Defaults to the image default.
+	// +optional
+	Level AIMLogLevel `json:"level,omitempty"`
+
+	// RootLevel sets the log level of the libraries used by the runtime, such as the
+	// inference engine (AIM_LOG_LEVEL_ROOT). Defaults to the image default.
+	// +optional
+	RootLevel AIMLogLevel `json:"rootLevel,omitempty"`
+
+	// Format selects plain text or JSON log lines (AIM_LOG_FORMAT).
+	// Defaults to the image default.
+	// +optional
+	Format AIMLogFormat `json:"format,omitempty"`
+
+	// Debug enables debug logging for the runtime and its libraries,
+	// overriding Level and RootLevel.
+	// +optional
+	Debug *bool `json:"debug,omitempty"`
+}
+
+// AIMSchedulingConfig holds node placement constraints that are merged into planned pod specs.
+type AIMSchedulingConfig struct {
+	// NodeSelector restricts pods to nodes with matching labels.
+	// Keys set on the service take precedence over runtime config keys.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow pods to be scheduled on nodes with matching taints.
+	// Service tolerations are added to runtime config tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity sets node, pod affinity and pod anti-affinity rules.
+	// Each affinity kind set on the service replaces the runtime config value of that kind.
+	// Node affinity is combined with the GPU node affinity resolved from the template,
+	// so both must be satisfied.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
 }
 
 type AIMModelConfig struct {
@@ -79,6 +295,321 @@ type AIMModelConfig struct {
 	// When false, discovery is skipped. Discovery failures are non-fatal and reported via conditions.
 	// +optional
 	AutoDiscovery *bool `json:"autoDiscovery,omitempty"`
+
+	// Security restricts which model images may be served based on their CVE scan summary.
+	// +optional
+	Security *AIMModelSecurityPolicy `json:"security,omitempty"`
+}
+
+// AIMModelSecurityPolicy refuses model images whose scan summary reports vulnerabilities
+// above a severity threshold. Images without a scan summary are allowed.
+type AIMModelSecurityPolicy struct {
+	// MaxCVESeverity is the most severe vulnerability level an image may have.
+	// Images with any vulnerability above this level violate the policy.
+	// For example, High refuses images with Critical vulnerabilities.
+	MaxCVESeverity AIMCVESeverity `json:"maxCVESeverity"`
+}
+
+// AIMDiscoveryConfig configures the resources of discovery jobs, garbage collection of
+// completed discovery jobs, and how discovery reacts to jobs that cannot be scheduled.
+// Before a completed job is deleted, its log tail is captured into the template's
+// status.discoveryOutput (and, on success, the parsed profile into status.profile),
+// so debugging does not require the job to still exist.
+type AIMDiscoveryConfig struct {
+	// SuccessfulJobRetention is how long a succeeded discovery job and its pods are kept
+	// after its output has been captured. Defaults to 60s.
+	// +optional
+	SuccessfulJobRetention *metav1.Duration `json:"successfulJobRetention,omitempty"`
+
+	// FailedJobRetention is how long a failed discovery job and its pods are kept
+	// after its output has been captured. No new discovery attempt is started while
+	// a failed job is retained. Defaults to 60s.
+	// +optional
+	FailedJobRetention *metav1.Duration `json:"failedJobRetention,omitempty"`
+
+	// LogTailLines is the number of trailing log lines captured from the discovery container.
+	// Set to 0 to disable log capture. Defaults to 50.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=500
+	// +optional
+	LogTailLines *int32 `json:"logTailLines,omitempty"`
+
+	// SchedulingTimeout is how long a discovery pod may remain unschedulable before
+	// the CPU-only fallback is attempted. Defaults to 5m.
+	// +optional
+	SchedulingTimeout *metav1.Duration `json:"schedulingTimeout,omitempty"`
+
+	// CPUFallback replaces a discovery job that has been unschedulable for longer than
+	// SchedulingTimeout with one that runs discovery in CPU-only mode. The fallback is only
+	// used when the model image declares support through the `com.amd.aim.discovery.cpu=true`
+	// label. Defaults to false.
+	// +optional
+	CPUFallback *bool `json:"cpuFallback,omitempty"`
+
+	// PreferCPU runs discovery in CPU-only mode from the start, without first trying to
+	// schedule a GPU job. Like CPUFallback, it only applies to model images that declare
+	// support through the `com.amd.aim.discovery.cpu=true` label. Defaults to false.
+	// +optional
+	PreferCPU *bool `json:"preferCPU,omitempty"`
+
+	// RequestGPUs makes GPU discovery jobs request the GPUs declared in the template's
+	// spec.hardware.gpu, so that discovery runs on a node that can actually serve the profile.
+	// CPU-only discovery jobs never request GPUs. Defaults to true.
+	// +optional
+	RequestGPUs *bool `json:"requestGPUs,omitempty"`
+
+	// Resources overrides the CPU and memory requests and limits of the discovery container.
+	// Entries are merged over the defaults (1 CPU and 2Gi memory requested, 8Gi memory limit).
+	// GPU resources are controlled by RequestGPUs and should not be set here.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// StrictParsing rejects discovery output that contains fields this controller does not
+	// recognize, instead of silently ignoring them. Unknown fields usually mean the model image
+	// is newer than the controller. The template then fails with reason UnknownDiscoveryFields.
+	// Unknown fields are listed in the template's status.discoveryWarnings in either mode.
+	// Defaults to false.
+	// +optional
+	StrictParsing *bool `json:"strictParsing,omitempty"`
+
+	// KnownEngineArgs lists the engine_args keys expected in discovery output. When set,
+	// other engine_args keys are reported as unknown fields. When unset, engine_args are
+	// not checked, since they are specific to the inference engine.
+	// +optional
+	KnownEngineArgs []string `json:"knownEngineArgs,omitempty"`
+
+	// ProfileVerification verifies the signature the model image attaches to its discovery
+	// profile before the profile is accepted. Unsigned or tampered profiles are rejected.
+	// +optional
+	ProfileVerification *AIMProfileVerificationConfig `json:"profileVerification,omitempty"`
+}
+
+// AIMProfileVerificationConfig configures signature verification of discovery profiles.
+// The signature is a base64-encoded cosign-style signature over the SHA-256 digest of the
+// profile JSON exactly as emitted by the discovery job.
+type AIMProfileVerificationConfig struct {
+	// PublicKeys lists PEM-encoded public keys (ECDSA, Ed25519 or RSA) trusted to sign
+	// discovery profiles. A profile is accepted when any key verifies its signature.
+	// +kubebuilder:validation:MinItems=1
+	PublicKeys []string `json:"publicKeys"`
+}
+
+// AIMServiceArchiveConfig enables archiving of deleted AIMServices.
+type AIMServiceArchiveConfig struct {
+	// RetentionDays is how many days an archive record is kept after the service was deleted.
+	// Expired records are removed the next time a service in the namespace is archived.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=30
+	RetentionDays int32 `json:"retentionDays"`
+}
+
+// AIMGPUJobsConfig limits the number of concurrently running GPU-consuming jobs launched by the operator.
+// Currently these are the GPU discovery jobs; CPU-only discovery jobs are not limited.
+type AIMGPUJobsConfig struct {
+	// MaxConcurrent is the maximum number of running GPU jobs. On the AIMClusterRuntimeConfig
+	// it limits jobs across the whole cluster and defaults to 10. On an AIMRuntimeConfig it
+	// limits jobs in that namespace and takes precedence over MaxConcurrentPerNamespace.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrent *int32 `json:"maxConcurrent,omitempty"`
+
+	// MaxConcurrentPerNamespace is the default limit of running GPU jobs in each namespace.
+	// Only honored on the AIMClusterRuntimeConfig. Unset means no per-namespace limit.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentPerNamespace *int32 `json:"maxConcurrentPerNamespace,omitempty"`
+}
+
+// AIMGPUHealthConfig configures the detection of unhealthy GPUs on nodes running inference pods.
+// GPUs are unhealthy when the node has one of the listed conditions set to True, or when the
+// AMD device metrics exporter labels one of its GPUs as unhealthy.
+type AIMGPUHealthConfig struct {
+	// NodeConditions lists the node condition types that report unhealthy GPUs while True, such as
+	// conditions set by Node Problem Detector for ECC errors or thermal throttling. Replaces the
+	// defaults AMDGPUUnhealthy, AMDGPUECCError and AMDGPUThermalThrottling.
+	// +optional
+	// +listType=atomic
+	NodeConditions []string `json:"nodeConditions,omitempty"`
+
+	// ReschedulePods deletes inference pods running on nodes with unhealthy GPUs so they are
+	// scheduled again. One pod is deleted at a time, and only while the service's pods on
+	// healthy nodes are ready.
+	// +optional
+	ReschedulePods bool `json:"reschedulePods,omitempty"`
+}
+
+// AIMGPUSharingConfig configures time-sliced GPU sharing between services. Services sharing a GPU
+// compete for its compute and memory, so their latency and throughput depend on each other's load.
+type AIMGPUSharingConfig struct {
+	// Enabled schedules the predictor pods of single-GPU services on shared GPUs.
+	// Services that need more than one GPU keep exclusive GPUs.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// ServicesPerGPU is how many services may share one GPU. Each predictor pod is annotated
+	// with a 1/servicesPerGPU fraction of the GPU for the device plugin. Defaults to 2.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=16
+	ServicesPerGPU *int32 `json:"servicesPerGPU,omitempty"`
+
+	// ResourceName is the extended resource the device plugin advertises for shared GPUs.
+	// Defaults to amd.com/gpu, for device plugins that advertise time-sliced replicas of each GPU.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	ResourceName string `json:"resourceName,omitempty"`
+}
+
+// AIMTemplateSelectionConfig tunes template auto-selection for services.
+type AIMTemplateSelectionConfig struct {
+	// MaxFreeGPUPercent rejects templates that need more than this percentage of the
+	// currently free GPUs of their GPU model, so that a single service cannot take the whole
+	// GPU pool. Free GPUs are the allocatable GPUs of schedulable nodes minus the GPUs requested
+	// by running pods, capped by the remaining requests.amd.com/gpu of the service namespace's
+	// ResourceQuotas. GPUs used by the service itself are counted as free.
+	// Only applies when a template is selected; a resolved template is kept.
+	// Unset disables the check.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxFreeGPUPercent *int32 `json:"maxFreeGPUPercent,omitempty"`
+
+	// SelectionPolicyPlugin delegates the final ranking of matching templates to an external
+	// policy. Experimental. Only honored on the AIMClusterRuntimeConfig.
+	// +optional
+	SelectionPolicyPlugin *AIMSelectionPolicyPlugin `json:"selectionPolicyPlugin,omitempty"`
+}
+
+// AIMSelectionPolicyPlugin configures an external template selection policy.
+// The plugin receives the service and the templates that passed all filters as JSON, and
+// answers with the template names in order of preference. The first ranked template is
+// selected. If the plugin fails, times out or ranks no candidate, the built-in scoring is used.
+// +kubebuilder:validation:XValidation:rule="has(self.exec) != has(self.url)",message="exactly one of exec and url must be set"
+type AIMSelectionPolicyPlugin struct {
+	// Exec is the absolute path of an executable in the operator container. The request is
+	// written to its stdin and the response is read from its stdout.
+	// +kubebuilder:validation:Pattern=`^/`
+	// +optional
+	Exec string `json:"exec,omitempty"`
+
+	// URL is an HTTP endpoint the request is posted to. The response body holds the ranking.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('https://') || self.startsWith('http://')",message="url must use http or https"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// TimeoutSeconds bounds a single plugin call. Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// AIMInferenceResourcesConfig tunes the default CPU and memory given to inference containers.
+// The defaults scale with the number of GPUs; template and service resources still override them.
+type AIMInferenceResourcesConfig struct {
+	// Default sets the per-GPU ratios for GPU models without an entry in GPUModels.
+	// Unset ratios fall back to 4 CPUs, a 32Gi memory request and a 48Gi memory limit per GPU.
+	// +optional
+	Default *AIMPerGPUResources `json:"default,omitempty"`
+
+	// GPUModels overrides the per-GPU ratios for specific GPU models, e.g. MI325X,
+	// which carries more HBM than MI300X. Unset ratios fall back to Default.
+	// +optional
+	// +listType=map
+	// +listMapKey=gpuModel
+	GPUModels []AIMGPUModelResources `json:"gpuModels,omitempty"`
+
+	// ModelMemoryOverhead is added to the discovered model size to get the minimum memory
+	// request. When the per-GPU ratio would request less, memory is raised to this minimum.
+	// Defaults to 8Gi.
+	// +optional
+	ModelMemoryOverhead *resource.Quantity `json:"modelMemoryOverhead,omitempty"`
+}
+
+// AIMTenancyPolicy restricts the cluster-scoped resources that services may use.
+type AIMTenancyPolicy struct {
+	// AllowedClusterTemplates lists the AIMClusterServiceTemplates that services may use, by name.
+	// Patterns support * and ? wildcards (e.g. "llama-*"). When empty, all cluster templates are allowed.
+	// +optional
+	AllowedClusterTemplates []string `json:"allowedClusterTemplates,omitempty"`
+
+	// AllowedClusterModels lists the AIMClusterModels that services may use, by name.
+	// Patterns support * and ? wildcards. When empty, all cluster models are allowed.
+	// +optional
+	AllowedClusterModels []string `json:"allowedClusterModels,omitempty"`
+}
+
+// AIMSpreadingConfig configures how the replicas of a service are spread across failure domains.
+type AIMSpreadingConfig struct {
+	// TopologyKeys lists the node labels that identify failure domains, e.g.
+	// topology.kubernetes.io/zone or a rack label. Each key becomes one topology spread constraint.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	TopologyKeys []string `json:"topologyKeys"`
+
+	// MaxSkew is the largest allowed difference in replica count between two domains.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	MaxSkew *int32 `json:"maxSkew,omitempty"`
+
+	// WhenUnsatisfiable decides what happens to a replica that cannot be placed within the skew.
+	// `ScheduleAnyway` prefers balanced placement but still schedules the replica, `DoNotSchedule`
+	// leaves it pending. Defaults to `ScheduleAnyway`.
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	// +kubebuilder:default=ScheduleAnyway
+	// +optional
+	WhenUnsatisfiable corev1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// AIMPerGPUResources holds CPU and memory amounts per requested GPU.
+type AIMPerGPUResources struct {
+	// CPU is the CPU request per GPU.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+
+	// MemoryRequest is the memory request per GPU.
+	// +optional
+	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
+
+	// MemoryLimit is the memory limit per GPU.
+	// +optional
+	MemoryLimit *resource.Quantity `json:"memoryLimit,omitempty"`
+}
+
+// AIMGPUModelResources sets the per-GPU ratios for one GPU model.
+type AIMGPUModelResources struct {
+	// GPUModel is the GPU model name as resolved on templates, e.g. MI300X.
+	// +kubebuilder:validation:MinLength=1
+	GPUModel string `json:"gpuModel"`
+
+	AIMPerGPUResources `json:",inline"`
+}
+
+// AIMRuntimeConfigOverride is the subset of runtime config fields a service can set inline.
+// It is merged on top of the namespace and cluster runtime configs, so it behaves as if the
+// referenced AIMRuntimeConfig carried these values. Admin-only fields (model, discovery,
+// gpuJobs, templateSelection, inferenceResources, notifications, serviceArchive) cannot be
+// overridden per service.
+type AIMRuntimeConfigOverride struct {
+	AIMServiceRuntimeConfig `json:",inline"`
+
+	// LabelPropagation controls how labels from the service are propagated to its child resources.
+	// +optional
+	LabelPropagation *AIMRuntimeConfigLabelPropagationSpec `json:"labelPropagation,omitempty"`
+}
+
+// ToRuntimeConfigCommon converts the override into an AIMRuntimeConfigCommon so it can be
+// merged with the namespace and cluster runtime configs.
+func (o *AIMRuntimeConfigOverride) ToRuntimeConfigCommon() *AIMRuntimeConfigCommon {
+	if o == nil {
+		return nil
+	}
+	return &AIMRuntimeConfigCommon{
+		AIMServiceRuntimeConfig: *o.AIMServiceRuntimeConfig.DeepCopy(),
+		LabelPropagation:        o.LabelPropagation.DeepCopy(),
+	}
 }
 
 // AIMRuntimeConfigCommon captures configuration fields shared across cluster and namespace scopes.
@@ -92,6 +623,71 @@ type AIMRuntimeConfigCommon struct {
 	// +optional
 	Model *AIMModelConfig `json:"model,omitempty"`
 
+	// Discovery controls retention of completed discovery jobs, what is captured
+	// from them before they are cleaned up, and the fallback for unschedulable jobs.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	Discovery *AIMDiscoveryConfig `json:"discovery,omitempty"`
+
+	// GPUJobs limits how many GPU-consuming jobs the operator runs at once.
+	// Jobs over the limit are created suspended and started in creation order as slots free up.
+	// Only the runtime configs named "default" are consulted, since the limits apply to all
+	// jobs in a namespace regardless of which runtime config their owner references.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	GPUJobs *AIMGPUJobsConfig `json:"gpuJobs,omitempty"`
+
+	// GPUHealth configures how unhealthy GPUs on the nodes running inference pods are detected
+	// and whether those pods are moved to other nodes.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	GPUHealth *AIMGPUHealthConfig `json:"gpuHealth,omitempty"`
+
+	// GPUSharing lets single-GPU services of the namespace share GPUs through time-slicing.
+	// Intended for development namespaces. Only honored on the namespace-scoped AIMRuntimeConfig,
+	// so that every namespace opts in on its own; it is ignored on AIMClusterRuntimeConfig.
+	// This field only applies to RuntimeConfig and is not available for services.
+	// +optional
+	GPUSharing *AIMGPUSharingConfig `json:"gpuSharing,omitempty"`
+
+	// TemplateSelection tunes how templates are auto-selected for services.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	TemplateSelection *AIMTemplateSelectionConfig `json:"templateSelection,omitempty"`
+
+	// InferenceResources tunes the default CPU and memory of inference containers per GPU model.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	InferenceResources *AIMInferenceResourcesConfig `json:"inferenceResources,omitempty"`
+
+	// Tenancy restricts the cluster-scoped templates and models that services may use.
+	// Without it, services in any namespace may use any cluster template and cluster model.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	Tenancy *AIMTenancyPolicy `json:"tenancy,omitempty"`
+
+	// Spreading spreads the predictor pods of services with more than one replica across
+	// failure domains such as zones or racks, so that losing one domain does not take down
+	// every replica.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	Spreading *AIMSpreadingConfig `json:"spreading,omitempty"`
+
+	// Proxy sets the egress proxy for discovery jobs, cache download jobs and inference containers,
+	// which reach Hugging Face and image registries. Env vars set explicitly in env take precedence.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	Proxy *AIMProxyConfig `json:"proxy,omitempty"`
+
+	// AdoptionPolicy decides what happens when an object the operator would create already exists
+	// without owner references and was not created by the operator, e.g. a manually created
+	// InferenceService. `Adopt` takes it over, `Conflict` leaves it untouched and sets the
+	// ResourceConflict condition on the owner, and `Ignore` leaves it untouched silently.
+	// The aim.eai.amd.com/adoption-policy annotation on the owner takes precedence. Defaults to `Adopt`.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	AdoptionPolicy AIMAdoptionPolicy `json:"adoptionPolicy,omitempty"`
+
 	// LabelPropagation controls how labels from parent AIM resources are propagated to child resources.
 	// When enabled, labels matching the specified patterns are automatically copied from parent resources
 	// (e.g., AIMService, AIMTemplateCache) to their child resources (e.g., Deployments, Services, PVCs).
@@ -100,6 +696,25 @@ type AIMRuntimeConfigCommon struct {
 	// +optional
 	LabelPropagation *AIMRuntimeConfigLabelPropagationSpec `json:"labelPropagation,omitempty"`
 
+	// Notifications posts JSON payloads to external systems, such as Slack incoming webhooks,
+	// when resources hit critical transitions. A namespace runtime config that sets notifications
+	// replaces the cluster webhooks for resources in that namespace.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	Notifications *AIMNotificationsConfig `json:"notifications,omitempty"`
+
+	// ServiceArchive keeps a compact record of deleted AIMServices (final status, resolved
+	// template and model, timestamps) in a ConfigMap, so usage audits survive deletion.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	ServiceArchive *AIMServiceArchiveConfig `json:"serviceArchive,omitempty"`
+
+	// RequestLogger configures the sidecar that samples, redacts and exports request logs
+	// for services with spec.observability.requestLogging.
+	// This field only applies to RuntimeConfig/ClusterRuntimeConfig and is not available for services.
+	// +optional
+	RequestLogger *AIMRequestLoggerConfig `json:"requestLogger,omitempty"`
+
 	// DEPRECATED: Use Storage.DefaultStorageClassName instead. This field will be removed in a future version.
 	// For backward compatibility, if this field is set and Storage.DefaultStorageClassName is not set,
 	// the value will be automatically migrated.
@@ -117,6 +732,37 @@ type AIMRuntimeConfigCommon struct {
 	PVCHeadroomPercent *int32 `json:"pvcHeadroomPercent,omitempty"`
 }
 
+// AIMAdoptionPolicy decides how pre-existing objects the operator did not create are handled.
+// +kubebuilder:validation:Enum=Adopt;Conflict;Ignore
+type AIMAdoptionPolicy string
+
+const (
+	// AIMAdoptionPolicyAdopt adds the owner reference and manages the object (default).
+	AIMAdoptionPolicyAdopt AIMAdoptionPolicy = "Adopt"
+	// AIMAdoptionPolicyConflict leaves the object untouched and reports a ResourceConflict condition.
+	AIMAdoptionPolicyConflict AIMAdoptionPolicy = "Conflict"
+	// AIMAdoptionPolicyIgnore leaves the object untouched without reporting it.
+	AIMAdoptionPolicyIgnore AIMAdoptionPolicy = "Ignore"
+)
+
+// AIMProxyConfig configures an egress proxy through the standard proxy environment variables.
+// Both the upper- and lowercase variants are set, since tools differ in which they read.
+type AIMProxyConfig struct {
+	// HTTPProxy is the proxy URL for HTTP requests (HTTP_PROXY).
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the proxy URL for HTTPS requests (HTTPS_PROXY).
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy lists hosts, domains and CIDRs reached without the proxy (NO_PROXY),
+	// e.g. ".svc", ".cluster.local" or "10.0.0.0/8".
+	// +optional
+	// +listType=atomic
+	NoProxy []string `json:"noProxy,omitempty"`
+}
+
 type AIMRuntimeConfigLabelPropagationSpec struct {
 	// Enabled, if true, allows propagating parent labels to all child resources it creates directly
 	// Only label keys that match the ones in Match are propagated.
@@ -128,11 +774,96 @@ type AIMRuntimeConfigLabelPropagationSpec struct {
 	// Wildcards are supported, so for example `org.my/my-key-*` would match any label with that prefix.
 	// +optional
 	Match []string `json:"match,omitempty"`
+
+	// Exclude is a list of label keys that are never propagated, even if they match an entry in Match.
+	// Wildcards are supported in the same way as for Match.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+
+	// Annotations selects parent annotations to propagate to child resources.
+	// Annotations in the aim.eai.amd.com and kubectl.kubernetes.io domains are never propagated.
+	// +optional
+	Annotations *AIMPropagationKeyFilter `json:"annotations,omitempty"`
+
+	// Templates adds labels to child resources whose values are rendered from the parent with Go
+	// text/template syntax. The available fields are .Name, .Namespace, .Kind, .ModelName,
+	// .Labels and .Annotations, for example `{{ .ModelName }}` or `{{ index .Labels "team" }}`.
+	// Labels that fail to render or do not render to a valid label value are skipped.
+	// Controller-owned keys cannot be set this way.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(k, !k.startsWith('aim.eai.amd.com/') && k != 'app.kubernetes.io/managed-by')",message="templates cannot set controller-owned label keys"
+	Templates map[string]string `json:"templates,omitempty"`
+}
+
+// AIMPropagationKeyFilter selects metadata keys by allowlist and denylist patterns.
+type AIMPropagationKeyFilter struct {
+	// Match is a list of keys to propagate. Wildcards are supported.
+	// +optional
+	Match []string `json:"match,omitempty"`
+
+	// Exclude is a list of keys that are never propagated, even if they match. Wildcards are supported.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// AIMNotificationEvent identifies a critical transition that triggers a notification.
+// +kubebuilder:validation:Enum=AuthError;InvalidSpec;CacheFailed
+type AIMNotificationEvent string
+
+const (
+	// NotificationEventAuthError is sent when Ready becomes False because of an
+	// authentication or authorization failure.
+	NotificationEventAuthError AIMNotificationEvent = "AuthError"
+	// NotificationEventInvalidSpec is sent when Ready becomes False because of an invalid spec.
+	NotificationEventInvalidSpec AIMNotificationEvent = "InvalidSpec"
+	// NotificationEventCacheFailed is sent when an AIMTemplateCache or AIMArtifact fails.
+	NotificationEventCacheFailed AIMNotificationEvent = "CacheFailed"
+)
+
+// AIMNotificationsConfig configures notifications about critical transitions.
+type AIMNotificationsConfig struct {
+	// Webhooks receive a JSON POST for each matching transition.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Webhooks []AIMNotificationWebhook `json:"webhooks,omitempty"`
+}
+
+// AIMNotificationWebhook is an HTTP endpoint that receives notifications.
+type AIMNotificationWebhook struct {
+	// Name identifies the webhook in logs.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// URL receives the JSON payload. The payload includes a `text` field, so Slack incoming
+	// webhooks can be used directly. Anyone who can read the runtime config can read the URL.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('https://') || self.startsWith('http://')",message="url must use http or https"
+	URL string `json:"url"`
+
+	// Events limits the notifications to these events. When empty, all events are sent.
+	// +optional
+	Events []AIMNotificationEvent `json:"events,omitempty"`
+
+	// Kinds limits the notifications to resources of these kinds, e.g. AIMService.
+	// When empty, notifications are sent for all kinds.
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
 }
 
 // AIMClusterRuntimeConfigSpec defines cluster-wide defaults for AIM resources.
 type AIMClusterRuntimeConfigSpec struct {
 	AIMRuntimeConfigCommon `json:",inline"`
+
+	// SyncedImagePullSecrets lists image pull secrets in the operator namespace that are
+	// copied into the namespace of each AIMService using this config. The copies are owned
+	// by the service, follow changes to the source secret, and are added to the inference
+	// pods' imagePullSecrets, so cluster catalogs on private registries work in every
+	// namespace without copying secrets by hand.
+	// Only available on the cluster config, as namespace configs must not read secrets
+	// from the operator namespace.
+	// +optional
+	SyncedImagePullSecrets []corev1.LocalObjectReference `json:"syncedImagePullSecrets,omitempty"`
 }
 
 // AIMRuntimeConfigSpec defines namespace-scoped overrides for AIM resources.
@@ -142,6 +873,7 @@ type AIMRuntimeConfigSpec struct {
 
 // AIMRuntimeRoutingConfig configures HTTP routing defaults for inference services.
 // These settings control how Gateway API HTTPRoutes are created and configured.
+// +kubebuilder:validation:XValidation:rule="!(has(self.timeoutSeconds) && has(self.requestTimeout))",message="at most one of timeoutSeconds and requestTimeout may be set"
 type AIMRuntimeRoutingConfig struct {
 	// Enabled controls whether HTTP routing is managed for inference services using this config.
 	// When true, the operator creates HTTPRoute resources for services that reference this config.
@@ -185,6 +917,32 @@ type AIMRuntimeRoutingConfig struct {
 	// +optional
 	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
 
+	// TimeoutSeconds is the maximum time in seconds for a request to complete, as an
+	// alternative to requestTimeout. Besides the route timeout, it sets the KServe predictor
+	// timeout, which Knative enforces in Serverless mode.
+	// Individual services can override this value via spec.routing.timeoutSeconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// IdleTimeout closes client connections that carry no data for this long.
+	// Gateway API has no field for it, so it is written to the HTTPRoute annotation
+	// named by annotationKeys.idleTimeout, in seconds (e.g. `300s`).
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// MaxRequestBodySize rejects requests with a larger body. Gateway API has no field
+	// for it, so it is written to the HTTPRoute annotation named by
+	// annotationKeys.maxRequestBodySize (e.g. `10Mi`).
+	// +optional
+	MaxRequestBodySize *resource.Quantity `json:"maxRequestBodySize,omitempty"`
+
+	// AnnotationKeys names the HTTPRoute annotations the gateway implementation reads for
+	// settings that Gateway API has no field for. Setting idleTimeout or maxRequestBodySize
+	// without the matching key fails validation, since the gateway would ignore it.
+	// +optional
+	AnnotationKeys *AIMRouteAnnotationKeys `json:"annotationKeys,omitempty"`
+
 	// Annotations defines default annotations to add to all HTTPRoute resources.
 	// Services can add additional annotations or override these via spec.routingAnnotations.
 	// When both are specified, service annotations take precedence for conflicting keys.
@@ -194,6 +952,17 @@ type AIMRuntimeRoutingConfig struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// AIMRouteAnnotationKeys names gateway implementation specific HTTPRoute annotations.
+type AIMRouteAnnotationKeys struct {
+	// IdleTimeout is the annotation the gateway reads the connection idle timeout from.
+	// +optional
+	IdleTimeout string `json:"idleTimeout,omitempty"`
+
+	// MaxRequestBodySize is the annotation the gateway reads the request body size limit from.
+	// +optional
+	MaxRequestBodySize string `json:"maxRequestBodySize,omitempty"`
+}
+
 // AIMRuntimeConfigStatus records the resolved config reference surfaced to consumers.
 type AIMRuntimeConfigStatus struct {
 	// ObservedGeneration is the last reconciled generation.
@@ -23,8 +23,12 @@
 package v1alpha1
 
 import (
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
@@ -38,6 +42,10 @@ const (
 	// AIMServiceResolvedTemplateIndexKey is the field index key for resolved template name
 	// Indexes by .status.resolvedTemplate.name for finding services using a specific template
 	AIMServiceResolvedTemplateIndexKey = ".status.resolvedTemplate.name"
+
+	// AIMServiceResolvedModelIndexKey is the field index key for resolved model name
+	// Indexes by .status.resolvedModel.name for finding services using a specific model
+	AIMServiceResolvedModelIndexKey = ".status.resolvedModel.name"
 )
 
 // AIMCachingMode controls caching behavior for a service.
@@ -66,6 +74,19 @@ const (
 	CachingModeNever AIMCachingMode = "Never"
 )
 
+// AIMCacheReadinessGate selects the template cache state the InferenceService waits for.
+// +kubebuilder:validation:Enum=Warm;Provisioned
+type AIMCacheReadinessGate string
+
+const (
+	// CacheReadinessGateWarm waits until all model artifacts are downloaded.
+	CacheReadinessGateWarm AIMCacheReadinessGate = "Warm"
+
+	// CacheReadinessGateProvisioned waits only until the cache volumes exist.
+	// The inference pod downloads the files that are still missing itself.
+	CacheReadinessGateProvisioned AIMCacheReadinessGate = "Provisioned"
+)
+
 // AIMServiceCachingConfig controls caching behavior for a service.
 // +kubebuilder:validation:XValidation:rule="self == oldSelf",message="caching mode is immutable after creation"
 type AIMServiceCachingConfig struct {
@@ -81,6 +102,17 @@ type AIMServiceCachingConfig struct {
 	// +kubebuilder:default=Shared
 	// +optional
 	Mode AIMCachingMode `json:"mode,omitempty"`
+
+	// ReadinessGate selects the template cache state the InferenceService waits for
+	// before it is created:
+	// - Warm (default): all model artifacts are downloaded
+	// - Provisioned: the cache volumes exist; the inference pod downloads missing files itself
+	//
+	// Provisioned only applies to Dedicated mode without cache encryption; otherwise the
+	// service waits for a warm cache.
+	// +kubebuilder:default=Warm
+	// +optional
+	ReadinessGate AIMCacheReadinessGate `json:"readinessGate,omitempty"`
 }
 
 // AIMServiceTemplateConfig contains template selection configuration for AIMService.
@@ -96,10 +128,121 @@ type AIMServiceTemplateConfig struct {
 	// that resolve to an unoptimized profile.
 	// +optional
 	AllowUnoptimized bool `json:"allowUnoptimized,omitempty"`
+
+	// AllowUnoptimizedFallback, if true, falls back to unoptimized and preview profiles when
+	// no optimized template can be selected, for example because no matching GPUs are present.
+	// Unlike AllowUnoptimized, optimized templates are always preferred when one fits. The
+	// RunningUnoptimized condition warns while the service runs an unoptimized profile.
+	// +optional
+	AllowUnoptimizedFallback bool `json:"allowUnoptimizedFallback,omitempty"`
+
+	// OverridesBehavior controls how spec.overrides combine with an explicit template name.
+	// Derive creates a namespace-scoped derived template with the overrides applied,
+	// Reject refuses the combination at admission, and ApplyInPlace deploys the named
+	// template with the overrides applied only to the inference workload.
+	// Defaults to Derive.
+	// +optional
+	OverridesBehavior AIMOverridesBehavior `json:"overridesBehavior,omitempty"`
+
+	// BaseChangePolicy controls what happens when the base template of a derived template is
+	// edited after derivation. Alert sets the BaseTemplateChanged condition on the derived
+	// template and the service. Regenerate additionally rebuilds the derived template from the
+	// current base spec. Defaults to Alert.
+	// +optional
+	BaseChangePolicy AIMBaseTemplateChangePolicy `json:"baseChangePolicy,omitempty"`
+
+	// GPUPreference lists the acceptable GPU models in priority order, e.g. [MI325X, MI300X].
+	// Auto-selection only considers templates for these models and picks the most preferred
+	// model that has enough free GPUs, falling back to the most preferred model with a template.
+	// Ignored when Name is set.
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=atomic
+	// +optional
+	GPUPreference []string `json:"gpuPreference,omitempty"`
+
+	// PlacementPolicy controls what happens when a more preferred GPU model gains free
+	// capacity after the service was placed. Report only sets the PlacementOptimal condition
+	// to False. Rebalance also switches the service to a template for the preferred model,
+	// which rolls out the deployment on the new GPU pool. Defaults to Report.
+	// +optional
+	PlacementPolicy AIMPlacementPolicy `json:"placementPolicy,omitempty"`
 }
 
-// AIMServiceModel specifies which model to deploy. Exactly one field must be set.
-// +kubebuilder:validation:XValidation:rule="(has(self.name) ? 1 : 0) + (has(self.image) ? 1 : 0) + (has(self.custom) ? 1 : 0) == 1",message="exactly one of name, image, or custom must be specified"
+// AIMPlacementPolicy controls how a service reacts to capacity on a more preferred GPU model.
+// +kubebuilder:validation:Enum=Report;Rebalance
+type AIMPlacementPolicy string
+
+const (
+	// PlacementPolicyReport only reports a better placement through the PlacementOptimal condition.
+	PlacementPolicyReport AIMPlacementPolicy = "Report"
+
+	// PlacementPolicyRebalance re-plans the service on the more preferred GPU model.
+	PlacementPolicyRebalance AIMPlacementPolicy = "Rebalance"
+)
+
+// AIMBaseTemplateChangePolicy controls how a derived template reacts to changes of its base template.
+// +kubebuilder:validation:Enum=Alert;Regenerate
+type AIMBaseTemplateChangePolicy string
+
+const (
+	// BaseTemplateChangePolicyAlert only reports the divergence through the BaseTemplateChanged condition.
+	BaseTemplateChangePolicyAlert AIMBaseTemplateChangePolicy = "Alert"
+
+	// BaseTemplateChangePolicyRegenerate rebuilds the derived template from the current base spec.
+	BaseTemplateChangePolicyRegenerate AIMBaseTemplateChangePolicy = "Regenerate"
+)
+
+// AIMCacheUpdatePolicy controls how running services react to a cache refreshed in place.
+// +kubebuilder:validation:Enum=Ignore;Restart;Reload
+type AIMCacheUpdatePolicy string
+
+const (
+	// CacheUpdatePolicyIgnore keeps the weights loaded by running pods; pods started later
+	// load the new revision.
+	CacheUpdatePolicyIgnore AIMCacheUpdatePolicy = "Ignore"
+
+	// CacheUpdatePolicyRestart rolls the predictor pods so they load the new revision.
+	CacheUpdatePolicyRestart AIMCacheUpdatePolicy = "Restart"
+
+	// CacheUpdatePolicyReload calls the engine's reload endpoint on each running predictor pod,
+	// and rolls the pods if a reload fails.
+	CacheUpdatePolicyReload AIMCacheUpdatePolicy = "Reload"
+)
+
+// AIMOverridesBehavior controls how service overrides interact with an explicit template name.
+// +kubebuilder:validation:Enum=Derive;Reject;ApplyInPlace
+type AIMOverridesBehavior string
+
+const (
+	// OverridesBehaviorDerive creates a derived AIMServiceTemplate from the named template
+	// with the overrides applied, and deploys the derived template once it is Ready.
+	OverridesBehaviorDerive AIMOverridesBehavior = "Derive"
+
+	// OverridesBehaviorReject rejects services that set both template.name and overrides.
+	OverridesBehaviorReject AIMOverridesBehavior = "Reject"
+
+	// OverridesBehaviorApplyInPlace deploys the named template directly and applies the
+	// overrides to the InferenceService only, without creating a derived template.
+	OverridesBehaviorApplyInPlace AIMOverridesBehavior = "ApplyInPlace"
+)
+
+// AIMModelVersionPolicy controls how an alias reference is resolved to a concrete model version.
+// +kubebuilder:validation:Enum=Pinned;Latest
+type AIMModelVersionPolicy string
+
+const (
+	// ModelVersionPolicyPinned resolves the alias once and keeps using the resolved model
+	// for as long as it remains Ready, even if newer versions become available.
+	ModelVersionPolicyPinned AIMModelVersionPolicy = "Pinned"
+
+	// ModelVersionPolicyLatest re-resolves the alias on every reconcile and moves the service
+	// to the highest Ready version in the family.
+	ModelVersionPolicyLatest AIMModelVersionPolicy = "Latest"
+)
+
+// AIMServiceModel specifies which model to deploy. Exactly one of name, image, custom, or alias must be set.
+// +kubebuilder:validation:XValidation:rule="(has(self.name) ? 1 : 0) + (has(self.image) ? 1 : 0) + (has(self.custom) ? 1 : 0) + (has(self.alias) ? 1 : 0) == 1",message="exactly one of name, image, custom, or alias must be specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.versionPolicy) || has(self.alias)",message="versionPolicy can only be set when alias is specified"
 // +kubebuilder:validation:XValidation:rule="self == oldSelf",message="model selection is immutable after creation"
 type AIMServiceModel struct {
 	// Name references an existing AIMModel or AIMClusterModel by metadata.name.
@@ -120,6 +263,18 @@ type AIMServiceModel struct {
 	// an existing matching AIMModel or auto-create one if not found.
 	// +optional
 	Custom *AIMServiceModelCustom `json:"custom,omitempty"`
+
+	// Alias references a model family or alias declared on AIMModel/AIMClusterModel resources
+	// (spec.family or spec.aliases). The controller picks the highest Ready version among the
+	// matching models, preferring namespace-scoped models when versions are equal.
+	// Example: `llama-3.1-8b`
+	// +optional
+	Alias *string `json:"alias,omitempty"`
+
+	// VersionPolicy controls whether an alias stays pinned to the first resolved model
+	// or follows the latest version. Only valid together with alias. Defaults to Pinned.
+	// +optional
+	VersionPolicy AIMModelVersionPolicy `json:"versionPolicy,omitempty"`
 }
 
 // AIMServiceModelCustom specifies a custom model configuration with explicit base image,
@@ -160,6 +315,7 @@ type AIMServiceOverrides struct {
 // caching behavior, and optional overrides. The template governs the base
 // runtime selection knobs, while the overrides field allows service-specific
 // customization.
+// +kubebuilder:validation:XValidation:rule="!has(self.overrides) || !has(self.template) || !has(self.template.name) || !has(self.template.overridesBehavior) || self.template.overridesBehavior != 'Reject'",message="overrides cannot be combined with template.name when template.overridesBehavior is Reject"
 type AIMServiceSpec struct {
 	// Model specifies which model to deploy using one of the available reference methods.
 	// Use `name` to reference an existing AIMModel/AIMClusterModel by name, or use `image`
@@ -171,6 +327,13 @@ type AIMServiceSpec struct {
 	// +optional
 	Template AIMServiceTemplateConfig `json:"template,omitempty"`
 
+	// SelectionGoal ranks auto-selected templates by their measured performance (status.performance).
+	// `latency` prefers the lowest latency and `throughputPerGPU` the highest token throughput per GPU.
+	// Templates without a measurement are only selected when no remaining candidate has one.
+	// Ignored when template.name is set.
+	// +optional
+	SelectionGoal AIMSelectionGoal `json:"selectionGoal,omitempty"`
+
 	// Caching controls caching behavior for this service.
 	// When nil, defaults to Shared mode.
 	// +optional
@@ -217,6 +380,13 @@ type AIMServiceSpec struct {
 	// Inline AIMServiceRuntimeConfig fields for cleaner access
 	AIMServiceRuntimeConfig `json:",inline"`
 
+	// RuntimeConfigOverride is merged last on top of the referenced namespace and cluster
+	// runtime configs. Use it for one-off tweaks that do not warrant a separate AIMRuntimeConfig.
+	// Unlike the inline service fields above, it applies at runtime config precedence,
+	// so template values still take priority over it.
+	// +optional
+	RuntimeConfigOverride *AIMRuntimeConfigOverride `json:"runtimeConfigOverride,omitempty"`
+
 	// Resources overrides the container resource requirements for this service.
 	// When specified, these values take precedence over the template and image defaults.
 	// +optional
@@ -227,6 +397,13 @@ type AIMServiceSpec struct {
 	// +optional
 	Overrides *AIMServiceOverrides `json:"overrides,omitempty"`
 
+	// Compute selects whether the service runs on GPUs or CPUs only.
+	// When set, template selection only considers templates with the same compute mode;
+	// in `cpu` mode the GPU availability filter is skipped and no GPU resources are requested.
+	// When unset, the compute mode of the resolved template is used.
+	// +optional
+	Compute AIMComputeMode `json:"compute,omitempty"`
+
 	// ImagePullSecrets references secrets for pulling AIM container images.
 	// +optional
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
@@ -236,6 +413,200 @@ type AIMServiceSpec struct {
 	// If empty, the default service account for the namespace is used.
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// ModelReadiness configures how the serving container reports that model weights are loaded.
+	// When set, a startupProbe is planned on the serving container so that pods only count as
+	// started once the model is usable, and the ModelLoaded condition tracks that signal
+	// separately from PodReady.
+	// +optional
+	ModelReadiness *AIMModelReadinessCheck `json:"modelReadiness,omitempty"`
+
+	// ServingReadinessGate adds a readiness gate to the predictor pods. The operator only sets the
+	// gate's pod condition once the serving container mounts the service's model caches and the
+	// operator reached its serving endpoint, so Service endpoints never include half-initialized pods.
+	// New pods cannot become ready while the operator is not running.
+	// +optional
+	ServingReadinessGate bool `json:"servingReadinessGate,omitempty"`
+
+	// UpdateStrategy controls how predictor pods are replaced when the planned InferenceService changes.
+	// When unset, the KServe default rollout behavior is used.
+	// +optional
+	UpdateStrategy *AIMServiceUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// CacheUpdatePolicy controls what happens to running predictor pods when a cache they mount
+	// is refreshed in place with a new revision of the same model. Ignore keeps the loaded weights
+	// until the pods are replaced, Restart rolls the pods, and Reload asks each pod's engine to
+	// reload the weights, restarting the pods if it cannot.
+	// +optional
+	// +kubebuilder:default=Ignore
+	CacheUpdatePolicy AIMCacheUpdatePolicy `json:"cacheUpdatePolicy,omitempty"`
+
+	// MaintenanceWindow restricts disruptive InferenceService changes, those that restart the
+	// predictor pods, to recurring windows. Outside a window such changes are deferred and the
+	// ChangesPending condition is set; they are applied when the next window opens.
+	// When unset, changes are applied immediately.
+	// +optional
+	MaintenanceWindow *AIMMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// Observability configures request logging for the inference service.
+	// +optional
+	Observability *AIMServiceObservability `json:"observability,omitempty"`
+
+	// Ensemble deploys additional template variants of the model behind the service's route,
+	// selected per request by a header. Experimental: requires routing to be enabled.
+	// +optional
+	Ensemble *AIMServiceEnsemble `json:"ensemble,omitempty"`
+}
+
+// AIMMaintenanceWindow is a recurring window in which disruptive changes may be applied.
+// +kubebuilder:validation:XValidation:rule="duration(self.duration) >= duration('1m') && duration(self.duration) <= duration('168h')",message="duration must be between 1m and 168h"
+type AIMMaintenanceWindow struct {
+	// Schedule is a five-field cron expression (minute hour day-of-month month day-of-week)
+	// for when each window opens, e.g. "0 2 * * SAT,SUN".
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long each window stays open.
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA time zone the schedule is evaluated in, e.g. "Europe/Helsinki".
+	// +optional
+	// +kubebuilder:default="UTC"
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// DefaultEnsembleHeader is the request header that selects an ensemble variant.
+const DefaultEnsembleHeader = "X-AIM-Variant"
+
+// AIMServiceEnsemble deploys template variants next to the service's resolved template.
+// Requests without the header, or with an unknown value, go to the resolved template.
+type AIMServiceEnsemble struct {
+	// Header is the request header whose value selects a variant by name.
+	// +optional
+	// +kubebuilder:default="X-AIM-Variant"
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+	// +kubebuilder:validation:MaxLength=256
+	Header string `json:"header,omitempty"`
+
+	// Variants are the additional templates to deploy. Each gets its own InferenceService.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=map
+	// +listMapKey=name
+	Variants []AIMServiceVariant `json:"variants"`
+}
+
+// AIMServiceVariant is a template deployed as an ensemble variant.
+type AIMServiceVariant struct {
+	// Name identifies the variant. It is the header value that selects the variant.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=20
+	Name string `json:"name"`
+
+	// Template is the name of the AIMServiceTemplate or AIMClusterServiceTemplate to deploy.
+	// It must be a template for the same model as the service. A namespace-scoped template
+	// takes precedence over a cluster template with the same name.
+	// +kubebuilder:validation:MinLength=1
+	Template string `json:"template"`
+}
+
+// GetHeader returns the effective variant selection header.
+func (e *AIMServiceEnsemble) GetHeader() string {
+	if e.Header == "" {
+		return DefaultEnsembleHeader
+	}
+	return e.Header
+}
+
+// AIMServiceVariantStatus reports the readiness of an ensemble variant.
+type AIMServiceVariantStatus struct {
+	// Name is the variant name.
+	Name string `json:"name"`
+
+	// Template is the template the variant deploys.
+	Template string `json:"template"`
+
+	// InferenceService is the name of the variant's InferenceService.
+	// +optional
+	InferenceService string `json:"inferenceService,omitempty"`
+
+	// Status is the readiness of the variant.
+	// +kubebuilder:validation:Enum=Pending;Starting;Running;Failed
+	Status constants.AIMStatus `json:"status"`
+
+	// Message explains the status.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// AIMServiceUpdateStrategyType is the type of update strategy for the inference predictor.
+// +kubebuilder:validation:Enum=RollingUpdate;Recreate
+type AIMServiceUpdateStrategyType string
+
+const (
+	// AIMServiceUpdateStrategyRollingUpdate replaces pods gradually, bounded by maxSurge and maxUnavailable.
+	AIMServiceUpdateStrategyRollingUpdate AIMServiceUpdateStrategyType = "RollingUpdate"
+
+	// AIMServiceUpdateStrategyRecreate terminates all existing pods before new ones are created.
+	// Use this when the GPUs required by the new pods are only available once the old pods release them.
+	AIMServiceUpdateStrategyRecreate AIMServiceUpdateStrategyType = "Recreate"
+)
+
+// AIMServiceUpdateStrategy describes how predictor pods are replaced on updates.
+// +kubebuilder:validation:XValidation:rule="!has(self.rollingUpdate) || self.type == 'RollingUpdate'",message="rollingUpdate may only be set when type is RollingUpdate"
+type AIMServiceUpdateStrategy struct {
+	// Type is the update strategy type.
+	// +optional
+	// +kubebuilder:default=RollingUpdate
+	Type AIMServiceUpdateStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate tunes the rolling update. Only valid when type is RollingUpdate.
+	// +optional
+	RollingUpdate *AIMServiceRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// AIMServiceRollingUpdate bounds the number of pods created or unavailable during a rolling update.
+type AIMServiceRollingUpdate struct {
+	// MaxSurge is the maximum number of pods that can be created above the desired replica count.
+	// Can be an absolute number (e.g. 1) or a percentage (e.g. "25%").
+	// Setting this to 0 avoids requesting extra GPUs during a rollout.
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the maximum number of pods that can be unavailable during the update.
+	// Can be an absolute number (e.g. 1) or a percentage (e.g. "25%").
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// AIMModelReadinessCheck describes the "weights loaded" signal exposed by the serving container.
+// Exactly one of httpPath or file must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.httpPath) != has(self.file)",message="exactly one of httpPath or file must be set"
+type AIMModelReadinessCheck struct {
+	// HTTPPath is polled on the serving port. A 2xx response means the weights are loaded.
+	// Example: `/v1/models`
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*`
+	HTTPPath string `json:"httpPath,omitempty"`
+
+	// File is a path inside the serving container that is created once the weights are loaded.
+	// Example: `/tmp/model-loaded`
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*`
+	File string `json:"file,omitempty"`
+
+	// PeriodSeconds is how often the signal is checked. Defaults to 10.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+
+	// FailureThreshold is the number of failed checks tolerated before the container is restarted.
+	// The maximum load time is roughly periodSeconds * failureThreshold. Defaults to 180.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
 }
 
 // AIMServiceStatus defines the observed state of AIMService.
@@ -248,14 +619,42 @@ type AIMServiceStatus struct {
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// TransitionHistory records the most recent transitions of the Ready condition, oldest first.
+	// It is bounded to the last 10 entries and outlives the events that reported the transitions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	TransitionHistory []AIMConditionTransition `json:"transitionHistory,omitempty"`
+
+	// ApplyFailures lists the child objects that could not be applied in the last reconcile.
+	// Other children are still applied when one fails. Cleared once all objects apply.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyFailures []AIMApplyFailure `json:"applyFailures,omitempty"`
+
+	// ValidationErrors lists the spec fields that failed validation in the last reconcile,
+	// sorted by field path. ConfigValid summarizes them. Cleared once the spec is valid.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	ValidationErrors []AIMValidationError `json:"validationErrors,omitempty"`
+
 	// ResolvedRuntimeConfig captures metadata about the runtime config that was resolved.
 	// +optional
 	ResolvedRuntimeConfig *AIMResolvedReference `json:"resolvedRuntimeConfig,omitempty"`
 
+	// RuntimeConfigSources lists the runtime configs merged into the effective configuration,
+	// lowest precedence first. An inline spec.runtimeConfigOverride appears with scope Inline.
+	// +optional
+	RuntimeConfigSources []AIMResolvedReference `json:"runtimeConfigSources,omitempty"`
+
 	// ResolvedModel captures metadata about the image that was resolved.
 	// +optional
 	ResolvedModel *AIMResolvedReference `json:"resolvedModel,omitempty"`
 
+	// ResolvedModelVersion is the spec.version of the resolved model, when set.
+	// Populated for all reference modes, and most useful when the service references an alias.
+	// +optional
+	ResolvedModelVersion string `json:"resolvedModelVersion,omitempty"`
+
 	// Status represents the current high‑level status of the service lifecycle.
 	// Values: `Pending`, `Starting`, `Running`, `Degraded`, `Failed`.
 	// +kubebuilder:default=Pending
@@ -269,6 +668,17 @@ type AIMServiceStatus struct {
 	// ResolvedTemplate captures metadata about the template that satisfied the reference.
 	ResolvedTemplate *AIMResolvedReference `json:"resolvedTemplate,omitempty"`
 
+	// SelectionMode reports how the template was chosen: `Auto` for auto-selection,
+	// `Explicit` for spec.template.name, and `Forced` for the force-template annotation.
+	// +optional
+	SelectionMode AIMTemplateSelectionMode `json:"selectionMode,omitempty"`
+
+	// DeploymentMode reports whether KServe runs the InferenceService with Knative (`Serverless`)
+	// or as a plain Deployment (`RawDeployment`). Autoscaling and URLs differ between the modes.
+	// Empty until the mode is known.
+	// +optional
+	DeploymentMode AIMKServeDeploymentMode `json:"deploymentMode,omitempty"`
+
 	// Cache captures cache-related status for this service.
 	// +optional
 	Cache *AIMServiceCacheStatus `json:"cache,omitempty"`
@@ -276,6 +686,192 @@ type AIMServiceStatus struct {
 	// Runtime captures runtime status including replica counts.
 	// +optional
 	Runtime *AIMServiceRuntimeStatus `json:"runtime,omitempty"`
+
+	// Overrides reports how spec.overrides were applied to an explicitly named template.
+	// +optional
+	Overrides *AIMServiceOverridesStatus `json:"overrides,omitempty"`
+
+	// Variants reports the readiness of each ensemble variant.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Variants []AIMServiceVariantStatus `json:"variants,omitempty"`
+
+	// Spread reports how the predictor pods are distributed over the failure domains of the
+	// runtime config spreading settings. Only populated for services with more than one replica.
+	// +optional
+	// +listType=map
+	// +listMapKey=topologyKey
+	Spread []AIMServiceSpreadStatus `json:"spread,omitempty"`
+
+	// Recommendations suggests resource request changes based on observed usage.
+	// Only populated once the service has been running for a while and the metrics API is available.
+	// The controller never acts on these suggestions.
+	// +optional
+	Recommendations *AIMServiceRecommendations `json:"recommendations,omitempty"`
+
+	// EffectiveRuntimeSpec records the fully merged inference container spec the InferenceService
+	// was last planned with, so the deployment can be reproduced exactly.
+	// +optional
+	EffectiveRuntimeSpec *AIMServiceEffectiveRuntimeSpec `json:"effectiveRuntimeSpec,omitempty"`
+}
+
+// AIMServiceSpreadStatus reports the pod distribution for one topology key.
+type AIMServiceSpreadStatus struct {
+	// TopologyKey is the node label the pods are spread over.
+	TopologyKey string `json:"topologyKey"`
+
+	// Domains lists the domains running predictor pods, sorted by name. Pods on nodes
+	// without the label are not counted.
+	// +optional
+	Domains []AIMSpreadDomain `json:"domains,omitempty"`
+}
+
+// AIMSpreadDomain is the number of predictor pods running in one failure domain.
+type AIMSpreadDomain struct {
+	// Name is the value of the topology label on the nodes of the domain.
+	Name string `json:"name"`
+
+	// Pods is the number of predictor pods scheduled in the domain.
+	Pods int32 `json:"pods"`
+}
+
+// AIMServiceEffectiveRuntimeSpec is the normalized inference container spec of a service after
+// the runtime config, template, profile and service settings have been merged.
+type AIMServiceEffectiveRuntimeSpec struct {
+	// Template is the name of the template the spec was planned from.
+	Template string `json:"template"`
+
+	// ProfileID is the AIM profile the template pins, if any.
+	// +optional
+	ProfileID string `json:"profileId,omitempty"`
+
+	// Image is the container image reference.
+	Image string `json:"image"`
+
+	// ImageDigest is the digest the predictor pods resolved the image to.
+	// Empty until a predictor container has started.
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// Env lists the merged environment variables, sorted by name. Secret-backed variables
+	// keep their references, so no secret values are recorded.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Args lists the container arguments. Engine arguments are passed through the
+	// AIM_ENGINE_ARGS environment variable.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Resources are the container resource requests and limits.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Hash identifies the spec, excluding the image digest. It changes whenever the planned spec changes.
+	Hash string `json:"hash"`
+
+	// LastChangedTime is when the hash last changed.
+	// +optional
+	LastChangedTime *metav1.Time `json:"lastChangedTime,omitempty"`
+}
+
+// AIMTemplateSelectionMode describes how the template of a service was chosen.
+// +kubebuilder:validation:Enum=Auto;Explicit;Forced
+type AIMTemplateSelectionMode string
+
+const (
+	// TemplateSelectionModeAuto means the template was auto-selected for the model.
+	TemplateSelectionModeAuto AIMTemplateSelectionMode = "Auto"
+
+	// TemplateSelectionModeExplicit means the template was named in spec.template.name.
+	TemplateSelectionModeExplicit AIMTemplateSelectionMode = "Explicit"
+
+	// TemplateSelectionModeForced means the template was pinned with the force-template annotation,
+	// bypassing selection and overrides.
+	TemplateSelectionModeForced AIMTemplateSelectionMode = "Forced"
+)
+
+// AIMKServeDeploymentMode is the KServe deployment mode of an InferenceService.
+// +kubebuilder:validation:Enum=Serverless;RawDeployment
+type AIMKServeDeploymentMode string
+
+const (
+	// KServeDeploymentModeServerless means KServe runs the predictor as a Knative Service.
+	KServeDeploymentModeServerless AIMKServeDeploymentMode = "Serverless"
+
+	// KServeDeploymentModeRawDeployment means KServe runs the predictor as a Deployment
+	// with a Service and an optional HPA or KEDA ScaledObject, without Knative.
+	KServeDeploymentModeRawDeployment AIMKServeDeploymentMode = "RawDeployment"
+)
+
+// AIMRecommendationAction is the direction of a resource recommendation.
+// +kubebuilder:validation:Enum=Increase;Decrease
+type AIMRecommendationAction string
+
+const (
+	// RecommendationActionIncrease suggests raising the request because usage is close to it.
+	RecommendationActionIncrease AIMRecommendationAction = "Increase"
+
+	// RecommendationActionDecrease suggests lowering the request because most of it is unused.
+	RecommendationActionDecrease AIMRecommendationAction = "Decrease"
+)
+
+// AIMServiceRecommendations captures the result of the most recent usage analysis.
+type AIMServiceRecommendations struct {
+	// LastAnalyzedTime is when usage was last sampled.
+	LastAnalyzedTime metav1.Time `json:"lastAnalyzedTime"`
+
+	// SampledPods is the number of predictor pods whose usage was analyzed.
+	SampledPods int32 `json:"sampledPods"`
+
+	// Items lists the suggested changes. Empty when the current requests fit the observed usage.
+	// +optional
+	Items []AIMResourceRecommendation `json:"items,omitempty"`
+}
+
+// AIMResourceRecommendation suggests a new request for one resource of one container.
+type AIMResourceRecommendation struct {
+	// Container is the name of the container the recommendation applies to.
+	Container string `json:"container"`
+
+	// Resource is the resource name, e.g. cpu or memory.
+	Resource corev1.ResourceName `json:"resource"`
+
+	// Action is the direction of the suggested change.
+	Action AIMRecommendationAction `json:"action"`
+
+	// Current is the current request. Unset when the container has no request for this resource.
+	// +optional
+	Current *resource.Quantity `json:"current,omitempty"`
+
+	// Observed is the highest usage seen across the sampled pods.
+	Observed resource.Quantity `json:"observed"`
+
+	// Recommended is the suggested request.
+	Recommended resource.Quantity `json:"recommended"`
+
+	// Message explains the recommendation.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// AIMServiceOverridesStatus records how service overrides were combined with the named template.
+type AIMServiceOverridesStatus struct {
+	// Behavior is the effective overrides behavior.
+	Behavior AIMOverridesBehavior `json:"behavior"`
+
+	// BaseTemplate is the template named in spec.template.name.
+	BaseTemplate string `json:"baseTemplate"`
+
+	// DerivedTemplate is the name of the derived template produced for the overrides.
+	// Empty when no derived template is used.
+	// +optional
+	DerivedTemplate string `json:"derivedTemplate,omitempty"`
+
+	// Message explains why the overrides were applied this way.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // AIMServiceCacheStatus captures cache-related status for an AIMService.
@@ -284,12 +880,114 @@ type AIMServiceCacheStatus struct {
 	// +optional
 	TemplateCacheRef *AIMResolvedReference `json:"templateCacheRef,omitempty"`
 
+	// ClusterModelCacheRefs references the AIMClusterModelCaches the service mounts
+	// instead of a template cache, if any.
+	// +optional
+	ClusterModelCacheRefs []AIMResolvedReference `json:"clusterModelCacheRefs,omitempty"`
+
 	// RetryAttempts tracks how many times this service has attempted to retry a failed cache.
 	// Each service gets exactly one retry attempt. When a TemplateCache enters Failed state,
 	// this counter is incremented from 0 to 1 after deleting failed Artifacts.
 	// If the retry fails (cache enters Failed again with attempts == 1), the service degrades.
 	// +optional
 	RetryAttempts int `json:"retryAttempts,omitempty"`
+
+	// Migration tracks a move to a different template cache after the caching settings
+	// (spec.caching.mode or storage encryption) changed on a running service.
+	// Cleared once the previous cache has been released.
+	// +optional
+	Migration *AIMServiceCacheMigrationStatus `json:"migration,omitempty"`
+
+	// Lookup records whether the template cache was already warm when the service first
+	// found it. Template caches count these outcomes in their status.usage.
+	// +optional
+	Lookup *AIMServiceCacheLookup `json:"lookup,omitempty"`
+
+	// MountedArtifacts lists the cached models mounted into the inference service, with the
+	// revision of the weights they hold. Use it to trace which exact weights served traffic.
+	// +optional
+	MountedArtifacts []AIMMountedArtifact `json:"mountedArtifacts,omitempty"`
+
+	// LoadedWeightsDigest identifies the revisions of the mounted artifacts the running predictor
+	// pods have loaded. It differs from the mounted revisions while a cache refreshed in place is
+	// being reloaded or the pods are being restarted, see spec.cacheUpdatePolicy.
+	// +optional
+	LoadedWeightsDigest string `json:"loadedWeightsDigest,omitempty"`
+}
+
+// AIMMountedArtifact identifies the cached weights of a model mounted into a service.
+type AIMMountedArtifact struct {
+	// Model is the name of the model.
+	Model string `json:"model"`
+
+	// Artifact is the name of the AIMArtifact holding the weights. For cluster model caches it
+	// lives in the operator namespace.
+	Artifact string `json:"artifact"`
+
+	// Revision is the upstream revision of the weights, such as the Hugging Face commit hash.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// ManifestDigest is the digest of the artifact's file manifest.
+	// Empty until the downloader has recorded the artifact's provenance.
+	// +optional
+	ManifestDigest string `json:"manifestDigest,omitempty"`
+}
+
+// AIMCacheLookupResult is the outcome of a service's template cache lookup.
+// +kubebuilder:validation:Enum=Hit;Miss
+type AIMCacheLookupResult string
+
+const (
+	// CacheLookupHit means the template cache was Ready when the service first found it.
+	CacheLookupHit AIMCacheLookupResult = "Hit"
+	// CacheLookupMiss means the service had to wait for the template cache to be downloaded.
+	CacheLookupMiss AIMCacheLookupResult = "Miss"
+)
+
+// AIMServiceCacheLookup records the outcome of a service's first lookup of a template cache.
+type AIMServiceCacheLookup struct {
+	// TemplateCache is the name of the template cache, in the service namespace.
+	TemplateCache string `json:"templateCache"`
+
+	// Result is Hit if the cache was warm, or Miss if its models still had to be downloaded.
+	Result AIMCacheLookupResult `json:"result"`
+}
+
+// AIMCacheMigrationPhase is the current step of a cache migration.
+// +kubebuilder:validation:Enum=Provisioning;Switching;Releasing
+type AIMCacheMigrationPhase string
+
+const (
+	// CacheMigrationPhaseProvisioning waits for the new template cache to become ready.
+	// The InferenceService keeps serving from the previous cache.
+	CacheMigrationPhaseProvisioning AIMCacheMigrationPhase = "Provisioning"
+
+	// CacheMigrationPhaseSwitching moves the InferenceService volumes to the new cache
+	// and waits for it to become ready again.
+	CacheMigrationPhaseSwitching AIMCacheMigrationPhase = "Switching"
+
+	// CacheMigrationPhaseReleasing releases the previous cache. Dedicated caches are deleted,
+	// shared caches are left for other services.
+	CacheMigrationPhaseReleasing AIMCacheMigrationPhase = "Releasing"
+)
+
+// AIMServiceCacheMigrationStatus describes an in-progress cache migration.
+type AIMServiceCacheMigrationStatus struct {
+	// Source is the template cache the service is migrating away from.
+	Source AIMResolvedReference `json:"source"`
+
+	// FromMode is the mode of the source cache.
+	FromMode AIMTemplateCacheMode `json:"fromMode"`
+
+	// ToMode is the caching mode of the service being migrated to.
+	ToMode AIMCachingMode `json:"toMode"`
+
+	// Phase is the current step of the migration.
+	Phase AIMCacheMigrationPhase `json:"phase"`
+
+	// StartTime is when the migration was first observed.
+	StartTime metav1.Time `json:"startTime"`
 }
 
 // AIMServiceRuntimeStatus captures runtime status including replica counts from HPA.
@@ -320,6 +1018,27 @@ func (s *AIMService) GetRuntimeConfigRef() RuntimeConfigRef {
 	return s.Spec.RuntimeConfigRef
 }
 
+func (s *AIMService) GetRuntimeConfigOverride() *AIMRuntimeConfigCommon {
+	return s.Spec.RuntimeConfigOverride.ToRuntimeConfigCommon()
+}
+
+// GetForcedTemplate returns the template name pinned with the force-template annotation, if any.
+func (s *AIMService) GetForcedTemplate() string {
+	return strings.TrimSpace(s.Annotations[constants.AnnotationForceTemplate])
+}
+
+// GetTemplateSelectionMode returns how the template of the service is chosen.
+func (s *AIMService) GetTemplateSelectionMode() AIMTemplateSelectionMode {
+	switch {
+	case s.GetForcedTemplate() != "":
+		return TemplateSelectionModeForced
+	case strings.TrimSpace(s.Spec.Template.Name) != "":
+		return TemplateSelectionModeExplicit
+	default:
+		return TemplateSelectionModeAuto
+	}
+}
+
 func (s *AIMServiceStatus) GetConditions() []metav1.Condition {
 	return s.Conditions
 }
@@ -328,6 +1047,30 @@ func (s *AIMServiceStatus) SetConditions(conditions []metav1.Condition) {
 	s.Conditions = conditions
 }
 
+func (s *AIMServiceStatus) GetTransitionHistory() []AIMConditionTransition {
+	return s.TransitionHistory
+}
+
+func (s *AIMServiceStatus) SetTransitionHistory(history []AIMConditionTransition) {
+	s.TransitionHistory = history
+}
+
+func (s *AIMServiceStatus) GetApplyFailures() []AIMApplyFailure {
+	return s.ApplyFailures
+}
+
+func (s *AIMServiceStatus) SetApplyFailures(failures []AIMApplyFailure) {
+	s.ApplyFailures = failures
+}
+
+func (s *AIMServiceStatus) GetValidationErrors() []AIMValidationError {
+	return s.ValidationErrors
+}
+
+func (s *AIMServiceStatus) SetValidationErrors(errs []AIMValidationError) {
+	s.ValidationErrors = errs
+}
+
 func (s *AIMServiceStatus) SetStatus(status string) {
 	// Map framework statuses to AIMService-specific statuses.
 	// AIMService uses: Pending, Starting, Running, Failed, Degraded
@@ -350,6 +1093,47 @@ func (s *AIMServiceStatus) GetAIMStatus() constants.AIMStatus {
 // +kubebuilder:validation:Enum=Pending;Starting;Running;Failed;Degraded
 type AIMServiceStatusEnum string
 
+// Condition types for AIMService
+const (
+	// AIMServiceConditionPodReady is True when at least one predictor pod is running.
+	AIMServiceConditionPodReady = "PodReady"
+	// AIMServiceConditionModelLoaded is True when at least one predictor pod reports its model weights as loaded.
+	// While it is set, the service is only Ready when it is True.
+	AIMServiceConditionModelLoaded = "ModelLoaded"
+	// AIMServiceConditionBaseTemplateChanged mirrors the BaseTemplateChanged condition of the derived template the service uses.
+	AIMServiceConditionBaseTemplateChanged = "BaseTemplateChanged"
+	// AIMServiceConditionCacheMigration is True while the service is moving to a different template cache.
+	AIMServiceConditionCacheMigration = "CacheMigration"
+	// AIMServiceConditionPlacementOptimal is True when the service runs on the most preferred GPU
+	// model with free capacity. Only set for auto-selected services with a GPU preference.
+	AIMServiceConditionPlacementOptimal = "PlacementOptimal"
+	// AIMServiceConditionRunningUnoptimized is True when the service fell back to an unoptimized
+	// or preview profile. Only set when allowUnoptimizedFallback is enabled.
+	AIMServiceConditionRunningUnoptimized = "RunningUnoptimized"
+	// AIMServiceConditionNameCollision is True when an object with the name generated for one of the
+	// service's resources exists but is controlled by something else. The resource is not applied.
+	AIMServiceConditionNameCollision = "NameCollision"
+	// AIMServiceConditionRequestLogSinkReachable is True when the operator can reach the request log sink.
+	// Only set when spec.observability.requestLogging is configured.
+	AIMServiceConditionRequestLogSinkReachable = "RequestLogSinkReachable"
+	// AIMServiceConditionChangesPending is True when disruptive InferenceService changes are
+	// deferred until the next maintenance window. Only set when spec.maintenanceWindow is configured.
+	AIMServiceConditionChangesPending = "ChangesPending"
+	// AIMServiceConditionHardwareHealthy is False when a node running an inference pod reports
+	// unhealthy GPUs. Only set while the service has inference pods scheduled to nodes.
+	AIMServiceConditionHardwareHealthy = "HardwareHealthy"
+	// AIMServiceConditionGPUShared is True when the service runs on a GPU shared with other services.
+	// Only set when GPU sharing is enabled in the namespace runtime config.
+	AIMServiceConditionGPUShared = "GPUShared"
+	// AIMServiceConditionWeightsRefreshed is set once a cache the service mounts was refreshed in
+	// place. It is True when the running pods loaded the new revision according to
+	// spec.cacheUpdatePolicy, and False while a reload or restart is pending or the refresh is ignored.
+	AIMServiceConditionWeightsRefreshed = "WeightsRefreshed"
+	// AIMServiceConditionUpdateStrategyApplied is False when spec.updateStrategy cannot be applied
+	// because Knative rolls out the predictor in Serverless mode. Only set when spec.updateStrategy is set.
+	AIMServiceConditionUpdateStrategyApplied = "UpdateStrategyApplied"
+)
+
 // Condition reasons for AIMService
 const (
 	// Model Resolution
@@ -358,12 +1142,35 @@ const (
 	AIMServiceReasonCreatingModel         = "CreatingModel"
 	AIMServiceReasonModelNotReady         = "ModelNotReady"
 	AIMServiceReasonModelResolved         = "ModelResolved"
+	AIMServiceReasonModelAliasNotFound    = "ModelAliasNotFound"
+	// AIMServiceReasonModelSecurityPolicyViolated means the model's image violates the runtime
+	// config's security policy, so no InferenceService is created for it.
+	AIMServiceReasonModelSecurityPolicyViolated = "ModelSecurityPolicyViolated"
+	// AIMServiceReasonClusterModelForbidden means the namespace's tenancy policy does not allow
+	// services to use the resolved cluster model.
+	AIMServiceReasonClusterModelForbidden = "ClusterModelForbidden"
 
 	// Template Resolution
 	AIMServiceReasonTemplateNotFound           = "TemplateNotFound"
 	AIMServiceReasonTemplateNotReady           = "TemplateNotReady"
 	AIMServiceReasonResolved                   = "Resolved"
 	AIMServiceReasonTemplateSelectionAmbiguous = "TemplateSelectionAmbiguous"
+	AIMServiceReasonOverridesRejected          = "OverridesRejected"
+	AIMServiceReasonDerivedTemplatePending     = "DerivedTemplatePending"
+	AIMServiceReasonTemplateNamespaceDenied    = "TemplateNamespaceDenied"
+	AIMServiceReasonForcedTemplateMismatch     = "ForcedTemplateModelMismatch"
+	AIMServiceReasonInsufficientGPUHeadroom    = "InsufficientGPUHeadroom"
+	AIMServiceReasonTemplateAtCapacity         = "TemplateAtCapacity"
+	AIMServiceReasonUnoptimizedFallback        = "UnoptimizedFallback"
+	AIMServiceReasonOptimizedProfile           = "OptimizedProfile"
+	// AIMServiceReasonClusterTemplateForbidden means the namespace's tenancy policy does not allow
+	// services to use the resolved cluster template.
+	AIMServiceReasonClusterTemplateForbidden = "ClusterTemplateForbidden"
+
+	// Referenced secrets
+	AIMServiceReasonSecretNotFound = "SecretNotFound"
+	AIMServiceReasonInvalidSecret  = "InvalidSecret"
+	AIMServiceReasonSecretsValid   = "SecretsValid"
 
 	// Storage
 	AIMServiceReasonPVCNotBound      = "PVCNotBound"
@@ -377,12 +1184,78 @@ const (
 	AIMServiceReasonCacheFailed   = "CacheFailed"
 	AIMServiceReasonCacheLost     = "CacheLost"
 
+	// Cache migration
+	AIMServiceReasonProvisioningCache  = "ProvisioningCache"
+	AIMServiceReasonSwitchingVolumes   = "SwitchingVolumes"
+	AIMServiceReasonReleasingCache     = "ReleasingCache"
+	AIMServiceReasonMigrationCompleted = "MigrationCompleted"
+
+	// Cache updates
+	AIMServiceReasonModelReloaded     = "ModelReloaded"
+	AIMServiceReasonReloadPending     = "ReloadPending"
+	AIMServiceReasonReloadFailed      = "ReloadFailed"
+	AIMServiceReasonRestartingPods    = "RestartingPods"
+	AIMServiceReasonPodsRestarted     = "PodsRestarted"
+	AIMServiceReasonRefreshNotApplied = "RefreshNotApplied"
+
+	// Placement
+	AIMServiceReasonPreferredPlacement       = "PreferredPlacement"
+	AIMServiceReasonBetterPlacementAvailable = "BetterPlacementAvailable"
+	AIMServiceReasonRebalancing              = "Rebalancing"
+
+	// Naming
+	AIMServiceReasonNameCollision = "NameCollision"
+
 	// Runtime
 	AIMServiceReasonCreatingRuntime = "CreatingRuntime"
 	AIMServiceReasonRuntimeReady    = "RuntimeReady"
 
+	// KServe predictor and routes
+	AIMServiceReasonPredictorReady    = "PredictorReady"
+	AIMServiceReasonPredictorNotReady = "PredictorNotReady"
+	AIMServiceReasonRevisionFailed    = "RevisionFailed"
+	AIMServiceReasonRoutesReady       = "RoutesReady"
+	AIMServiceReasonRoutesNotReady    = "RoutesNotReady"
+
+	// Pod and model readiness
+	AIMServiceReasonPodsRunning    = "PodsRunning"
+	AIMServiceReasonPodsNotRunning = "PodsNotRunning"
+	AIMServiceReasonModelLoading   = "ModelLoading"
+	AIMServiceReasonModelLoaded    = "ModelLoaded"
+
 	// Routing
-	AIMServiceReasonPathTemplateInvalid = "PathTemplateInvalid"
+	AIMServiceReasonPathTemplateInvalid     = "PathTemplateInvalid"
+	AIMServiceReasonRouteSettingUnsupported = "RouteSettingUnsupported"
+
+	// Request logging
+	AIMServiceReasonInvalidRequestLogging = "InvalidRequestLogging"
+	AIMServiceReasonSinkReachable         = "SinkReachable"
+	AIMServiceReasonSinkUnreachable       = "SinkUnreachable"
+
+	// Network policy
+	AIMServiceReasonInvalidNetworkPolicy = "InvalidNetworkPolicy"
+
+	// Env templates
+	AIMServiceReasonInvalidEnvTemplate   = "InvalidEnvTemplate"
+	AIMServiceReasonEnvTemplatesResolved = "EnvTemplatesResolved"
+
+	// Maintenance windows
+	AIMServiceReasonInvalidMaintenanceWindow = "InvalidMaintenanceWindow"
+	AIMServiceReasonAwaitingMaintenance      = "AwaitingMaintenanceWindow"
+	AIMServiceReasonNoChangesPending         = "NoChangesPending"
+
+	// GPU health
+	AIMServiceReasonGPUsHealthy      = "GPUsHealthy"
+	AIMServiceReasonGPUsUnhealthy    = "GPUsUnhealthy"
+	AIMServiceReasonReschedulingPods = "ReschedulingPods"
+
+	// GPU sharing
+	AIMServiceReasonTimeSliced    = "TimeSliced"
+	AIMServiceReasonExclusiveGPUs = "ExclusiveGPUs"
+
+	// Update strategy
+	AIMServiceReasonStrategyApplied              = "StrategyApplied"
+	AIMServiceReasonStrategyNotAppliedServerless = "NotAppliedInServerless"
 )
 
 // AIMService manages a KServe-based AIM inference service for the selected model and template.
@@ -425,6 +1298,50 @@ func (svc *AIMService) GetStatus() *AIMServiceStatus {
 	return &svc.Status
 }
 
+// GetModelName returns the name of the model the service deploys. The resolved model is preferred,
+// falling back to the model name reference in the spec. Empty if neither is known yet.
+func (svc *AIMService) GetModelName() string {
+	if svc.Status.ResolvedModel != nil && svc.Status.ResolvedModel.Name != "" {
+		return svc.Status.ResolvedModel.Name
+	}
+	if svc.Spec.Model.Name != nil {
+		return *svc.Spec.Model.Name
+	}
+	return ""
+}
+
+// GetModelVersionPolicy returns the effective version policy for alias references.
+func (spec *AIMServiceSpec) GetModelVersionPolicy() AIMModelVersionPolicy {
+	if spec.Model.VersionPolicy == "" {
+		return ModelVersionPolicyPinned
+	}
+	return spec.Model.VersionPolicy
+}
+
+// GetOverridesBehavior returns the effective overrides behavior for an explicit template name.
+func (spec *AIMServiceSpec) GetOverridesBehavior() AIMOverridesBehavior {
+	if spec.Template.OverridesBehavior == "" {
+		return OverridesBehaviorDerive
+	}
+	return spec.Template.OverridesBehavior
+}
+
+// GetBaseChangePolicy returns the effective base template change policy for derived templates.
+func (spec *AIMServiceSpec) GetBaseChangePolicy() AIMBaseTemplateChangePolicy {
+	if spec.Template.BaseChangePolicy == "" {
+		return BaseTemplateChangePolicyAlert
+	}
+	return spec.Template.BaseChangePolicy
+}
+
+// GetPlacementPolicy returns the effective placement policy for GPU preferences.
+func (spec *AIMServiceSpec) GetPlacementPolicy() AIMPlacementPolicy {
+	if spec.Template.PlacementPolicy == "" {
+		return PlacementPolicyReport
+	}
+	return spec.Template.PlacementPolicy
+}
+
 // GetCachingMode returns the effective canonical caching mode for this service.
 // Legacy values are normalized for backward compatibility.
 func (spec *AIMServiceSpec) GetCachingMode() AIMCachingMode {
@@ -443,6 +1360,22 @@ func (spec *AIMServiceSpec) GetCachingMode() AIMCachingMode {
 	}
 }
 
+// GetCacheUpdatePolicy returns the effective policy for caches refreshed in place.
+func (spec *AIMServiceSpec) GetCacheUpdatePolicy() AIMCacheUpdatePolicy {
+	if spec.CacheUpdatePolicy == "" {
+		return CacheUpdatePolicyIgnore
+	}
+	return spec.CacheUpdatePolicy
+}
+
+// GetCacheReadinessGate returns the template cache state the InferenceService waits for.
+func (spec *AIMServiceSpec) GetCacheReadinessGate() AIMCacheReadinessGate {
+	if spec.Caching == nil || spec.Caching.ReadinessGate == "" {
+		return CacheReadinessGateWarm
+	}
+	return spec.Caching.ReadinessGate
+}
+
 func init() {
 	SchemeBuilder.Register(&AIMService{}, &AIMServiceList{})
 }
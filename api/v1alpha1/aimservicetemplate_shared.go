@@ -86,6 +86,22 @@ type AIMServiceTemplateSpecCommon struct {
 	// +kubebuilder:validation:Enum=optimized;preview;unoptimized
 	Type *AIMProfileType `json:"type,omitempty"`
 
+	// Compute selects whether services created from this template run on GPUs or CPUs only.
+	// In `cpu` mode no GPU resources or GPU node affinity are planned, discovery runs in
+	// CPU-only mode, and the runtime is configured for CPU inference. Useful for embedding
+	// models and smoke tests. Defaults to `gpu`.
+	// +optional
+	// +kubebuilder:validation:Enum=gpu;cpu
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="compute is immutable"
+	Compute AIMComputeMode `json:"compute,omitempty"`
+
+	// ModelClass is the kind of model this template serves. Services of `embedding` and `reranker`
+	// models are planned without the shared memory volume and LLM-specific environment variables,
+	// with smaller per-GPU CPU and memory defaults, and with a readiness probe on the class's
+	// endpoint. When unset, the class of the model is used.
+	// +optional
+	ModelClass AIMModelClass `json:"modelClass,omitempty"`
+
 	// Env specifies environment variables for inference containers.
 	// These variables are passed to the inference runtime and can be used
 	// to configure runtime behavior, authentication, or other settings.
@@ -93,6 +109,23 @@ type AIMServiceTemplateSpecCommon struct {
 	// +listType=map
 	// +listMapKey=name
 	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// MaxConcurrentServices limits how many AIMServices may use this template at the same time,
+	// for example for licensing-restricted or benchmark-only profiles. Further services stay
+	// Pending with reason TemplateAtCapacity until a service using the template is deleted or
+	// moves to another template. Set to 0 to stop new services from using the template.
+	// When unset, the number of services is not limited.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentServices *int32 `json:"maxConcurrentServices,omitempty"`
+}
+
+// GetCompute returns the effective compute mode of the template, defaulting to GPU.
+func (s *AIMServiceTemplateSpecCommon) GetCompute() AIMComputeMode {
+	if s.Compute == "" {
+		return AIMComputeModeGPU
+	}
+	return s.Compute
 }
 
 // AIMTemplateCachingConfig configures model caching behavior for namespace-scoped templates.
@@ -133,6 +166,12 @@ type AIMServiceTemplateSpec struct {
 // A cluster-scoped template that selects a runtime profile for a given AIM model.
 type AIMClusterServiceTemplateSpec struct {
 	AIMServiceTemplateSpecCommon `json:",inline"`
+
+	// NamespaceSelector restricts which namespaces may use this template. Services in
+	// namespaces whose labels do not match neither auto-select nor reference it by name.
+	// When unset, the template is available in all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 // AIMServiceTemplateStatus defines the observed state of AIMServiceTemplate.
@@ -145,6 +184,18 @@ type AIMServiceTemplateStatus struct {
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// TransitionHistory records the most recent transitions of the Ready condition, oldest first.
+	// It is bounded to the last 10 entries and outlives the events that reported the transitions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	TransitionHistory []AIMConditionTransition `json:"transitionHistory,omitempty"`
+
+	// ApplyFailures lists the child objects that could not be applied in the last reconcile.
+	// Other children are still applied when one fails. Cleared once all objects apply.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyFailures []AIMApplyFailure `json:"applyFailures,omitempty"`
+
 	// ResolvedRuntimeConfig captures metadata about the runtime config that was resolved.
 	// +optional
 	ResolvedRuntimeConfig *AIMResolvedReference `json:"resolvedRuntimeConfig,omitempty"`
@@ -197,6 +248,115 @@ type AIMServiceTemplateStatus struct {
 	// retry attempts and backoff timing for the circuit breaker pattern.
 	// +optional
 	Discovery *DiscoveryState `json:"discovery,omitempty"`
+
+	// DiscoveryWarnings lists fields of the discovery output that this controller does not
+	// recognize, such as `profile.metadata.foo` or `profile.engine_args.bar`.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	DiscoveryWarnings []string `json:"discoveryWarnings,omitempty"`
+
+	// DiscoveryOutput holds output captured from the most recent completed discovery job.
+	// It is retained after the job and its pods have been garbage collected.
+	// +optional
+	DiscoveryOutput *AIMDiscoveryOutput `json:"discoveryOutput,omitempty"`
+
+	// DiscoveredBy records the controller and discovery job builder that produced the current
+	// discovery results. When a newer controller builds discovery jobs differently, discovery
+	// runs again and the DiscoverySuperseded condition is set until the new results are in.
+	// +optional
+	DiscoveredBy *AIMDiscoveryBuilder `json:"discoveredBy,omitempty"`
+
+	// Consumers summarizes the AIMServices currently resolved to this template.
+	// Only populated for cluster-scoped templates.
+	// +optional
+	Consumers *AIMConsumersStatus `json:"consumers,omitempty"`
+
+	// Performance is the measured performance of the profile, recorded from the
+	// aim.eai.amd.com/performance annotation written by benchmark or live-traffic analysis.
+	// +optional
+	Performance *AIMProfilePerformance `json:"performance,omitempty"`
+
+	// RecommendedFor lists the selection goals this template is the best measured choice for
+	// among the ready templates of the same model and scope.
+	// +optional
+	// +listType=set
+	RecommendedFor []AIMSelectionGoal `json:"recommendedFor,omitempty"`
+}
+
+// AIMSelectionGoal is what template auto-selection optimizes for when templates have
+// measured performance.
+// +kubebuilder:validation:Enum=latency;throughputPerGPU
+type AIMSelectionGoal string
+
+const (
+	// AIMSelectionGoalLatency prefers the template with the lowest latency.
+	AIMSelectionGoalLatency AIMSelectionGoal = "latency"
+	// AIMSelectionGoalThroughputPerGPU prefers the template with the highest token throughput per GPU.
+	AIMSelectionGoalThroughputPerGPU AIMSelectionGoal = "throughputPerGPU"
+)
+
+// AIMPerformanceSource identifies how a performance measurement was taken.
+// +kubebuilder:validation:Enum=Benchmark;LiveTraffic
+type AIMPerformanceSource string
+
+const (
+	// AIMPerformanceSourceBenchmark is a measurement from a benchmark run.
+	AIMPerformanceSourceBenchmark AIMPerformanceSource = "Benchmark"
+	// AIMPerformanceSourceLiveTraffic is a measurement from the traffic of running services.
+	AIMPerformanceSourceLiveTraffic AIMPerformanceSource = "LiveTraffic"
+)
+
+// AIMProfilePerformance is the measured performance of a template's profile.
+type AIMProfilePerformance struct {
+	// Source is how the measurement was taken.
+	// +optional
+	Source AIMPerformanceSource `json:"source,omitempty"`
+
+	// Latency is the median time per output token.
+	// +optional
+	Latency *metav1.Duration `json:"latency,omitempty"`
+
+	// TokensPerSecond is the output token throughput of one replica.
+	// +optional
+	TokensPerSecond *int64 `json:"tokensPerSecond,omitempty"`
+
+	// TokensPerSecondPerGPU is TokensPerSecond divided by the GPUs of one replica.
+	// Not set for templates without GPUs.
+	// +optional
+	TokensPerSecondPerGPU *int64 `json:"tokensPerSecondPerGPU,omitempty"`
+
+	// MeasuredAt is when the measurement was taken.
+	// +optional
+	MeasuredAt *metav1.Time `json:"measuredAt,omitempty"`
+}
+
+// AIMDiscoveryOutput is the output captured from a completed discovery job.
+type AIMDiscoveryOutput struct {
+	// JobName is the name of the discovery job the output was captured from.
+	JobName string `json:"jobName"`
+
+	// Succeeded indicates whether the discovery job completed successfully.
+	Succeeded bool `json:"succeeded"`
+
+	// CompletionTime is when the discovery job finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// LogTail contains the trailing lines of the discovery container log.
+	// Truncated to the last 8KiB.
+	// +optional
+	LogTail string `json:"logTail,omitempty"`
+}
+
+// AIMDiscoveryBuilder identifies the controller build that produced discovery results.
+type AIMDiscoveryBuilder struct {
+	// ControllerVersion is the version of the controller that launched the discovery job.
+	// +optional
+	ControllerVersion string `json:"controllerVersion,omitempty"`
+
+	// BuilderHash is a fingerprint of how that controller builds discovery jobs.
+	// +optional
+	BuilderHash string `json:"builderHash,omitempty"`
 }
 
 // DiscoveryState tracks the discovery process state for circuit breaker logic.
@@ -222,6 +382,28 @@ type DiscoveryState struct {
 	// When the spec changes, the circuit breaker resets to allow fresh attempts.
 	// +optional
 	SpecHash string `json:"specHash,omitempty"`
+
+	// QueuedBehind lists the GPU jobs (as namespace/name) that will be started before this
+	// template's suspended discovery job. Empty unless the job is waiting for a GPU job slot.
+	// +optional
+	QueuedBehind []string `json:"queuedBehind,omitempty"`
+
+	// Image is the container image of the last successful discovery job.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// StartTime is when the last successful discovery job started running.
+	// Time spent waiting for a GPU job slot is not included.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the last successful discovery job completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Duration is how long the last successful discovery job ran, from StartTime to CompletionTime.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
 func (s *AIMServiceTemplateStatus) GetConditions() []metav1.Condition {
@@ -232,6 +414,22 @@ func (s *AIMServiceTemplateStatus) SetConditions(conditions []metav1.Condition)
 	s.Conditions = conditions
 }
 
+func (s *AIMServiceTemplateStatus) GetTransitionHistory() []AIMConditionTransition {
+	return s.TransitionHistory
+}
+
+func (s *AIMServiceTemplateStatus) SetTransitionHistory(history []AIMConditionTransition) {
+	s.TransitionHistory = history
+}
+
+func (s *AIMServiceTemplateStatus) GetApplyFailures() []AIMApplyFailure {
+	return s.ApplyFailures
+}
+
+func (s *AIMServiceTemplateStatus) SetApplyFailures(failures []AIMApplyFailure) {
+	s.ApplyFailures = failures
+}
+
 func (s *AIMServiceTemplateStatus) SetStatus(status string) {
 	s.Status = constants.AIMStatus(status)
 }
@@ -325,12 +523,24 @@ type AIMTemplateCandidateResult struct {
 	Status string `json:"status"`
 	// Reason provides a CamelCase reason for the evaluation result.
 	Reason string `json:"reason,omitempty"`
+	// Message explains the evaluation, such as the GPU headroom computation.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // Discovery conditions
 const (
 	// AIMTemplateDiscoveryConditionType is True when runtime profiles have been discovered and sources resolved for the referenced model.
 	AIMTemplateDiscoveryConditionType = "Discovered"
+
+	// AIMTemplateDiscoverySchedulingBlockedConditionType is True while the discovery pod cannot be scheduled.
+	// The message carries the scheduler's explanation (e.g. insufficient GPUs).
+	AIMTemplateDiscoverySchedulingBlockedConditionType = "DiscoverySchedulingBlocked"
+
+	// AIMTemplateDiscoverySupersededConditionType is True while the discovery results in status were
+	// produced by a discovery job builder that differs from the running controller's. The results
+	// stay in use until discovery has been re-run.
+	AIMTemplateDiscoverySupersededConditionType = "DiscoverySuperseded"
 )
 
 // Caching conditions
@@ -350,6 +560,20 @@ const (
 	AIMTemplateReasonAwaitingDiscovery  = "AwaitingDiscovery"
 	AIMTemplateReasonProfilesDiscovered = "ProfilesDiscovered"
 	AIMTemplateReasonDiscoveryFailed    = "DiscoveryFailed"
+	AIMTemplateReasonDiscoveryQueued    = "DiscoveryQueued"
+
+	// Discovery superseded reasons
+	AIMTemplateReasonDiscoveryBuilderChanged = "BuilderChanged"
+	AIMTemplateReasonRediscoveryFailed       = "RediscoveryFailed"
+
+	// AIMTemplateReasonUnknownDiscoveryFields is used when strict parsing rejects discovery output
+	// that contains fields the controller does not recognize.
+	AIMTemplateReasonUnknownDiscoveryFields = "UnknownDiscoveryFields"
+
+	// Discovery scheduling reasons
+	AIMTemplateReasonDiscoveryUnschedulable = "Unschedulable"
+	AIMTemplateReasonDiscoveryScheduled     = "Scheduled"
+	AIMTemplateReasonDiscoveryCPUFallback   = "CPUFallback"
 
 	AIMTemplateReasonGpuNotAvailable = "GpuNotAvailable"
 
@@ -364,3 +588,41 @@ const (
 	// Model resolution condition type
 	AIMServiceTemplateConditionModelFound = "ModelFound"
 )
+
+// Profile verification conditions
+const (
+	// AIMTemplateConditionProfilesVerified is True when the discovery profile signature was
+	// verified against a configured public key. Only set when profile verification is enabled.
+	AIMTemplateConditionProfilesVerified = "ProfilesVerified"
+
+	AIMTemplateReasonProfileSignatureVerified = "ProfileSignatureVerified"
+	AIMTemplateReasonProfileSignatureInvalid  = "ProfileSignatureInvalid"
+	AIMTemplateReasonProfileUnsigned          = "ProfileUnsigned"
+)
+
+// Derived template conditions. Also mirrored onto services that use the derived template.
+const (
+	// AIMTemplateConditionBaseTemplateChanged is True when the base template of a derived template
+	// was edited after the derived template was created from it.
+	AIMTemplateConditionBaseTemplateChanged = "BaseTemplateChanged"
+
+	AIMTemplateReasonBaseTemplateModified  = "BaseTemplateModified"
+	AIMTemplateReasonBaseTemplateUnchanged = "BaseTemplateUnchanged"
+	AIMTemplateReasonBaseTemplateNotFound  = "BaseTemplateNotFound"
+
+	// AIMTemplateConditionBaseDeleted is True when the base template of a derived template was
+	// deleted or is being deleted. It is removed if the base template exists again.
+	AIMTemplateConditionBaseDeleted = "BaseDeleted"
+
+	AIMTemplateReasonBaseTemplateDeleted = "BaseTemplateDeleted"
+)
+
+// Performance conditions
+const (
+	// AIMTemplateConditionPerformanceRecorded is True when the performance annotation was parsed
+	// into status. It is removed from templates without the annotation.
+	AIMTemplateConditionPerformanceRecorded = "PerformanceRecorded"
+
+	AIMTemplateReasonPerformanceRecorded = "PerformanceRecorded"
+	AIMTemplateReasonInvalidPerformance  = "InvalidPerformance"
+)
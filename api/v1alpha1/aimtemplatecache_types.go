@@ -82,6 +82,11 @@ type AIMTemplateCacheSpec struct {
 	// +optional
 	StorageClassName string `json:"storageClassName,omitempty"`
 
+	// Encryption encrypts the cached model weights at rest.
+	// Only artifacts encrypted with the same key are reused by this template cache.
+	// +optional
+	Encryption *AIMStorageEncryptionConfig `json:"encryption,omitempty"`
+
 	// DownloadImage specifies the container image used to download and initialize artifacts.
 	// When not specified, the controller uses the default model download image.
 	// +optional
@@ -115,6 +120,18 @@ type AIMTemplateCacheStatus struct {
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// TransitionHistory records the most recent transitions of the Ready condition, oldest first.
+	// It is bounded to the last 10 entries and outlives the events that reported the transitions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	TransitionHistory []AIMConditionTransition `json:"transitionHistory,omitempty"`
+
+	// ApplyFailures lists the child objects that could not be applied in the last reconcile.
+	// Other children are still applied when one fails. Cleared once all objects apply.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyFailures []AIMApplyFailure `json:"applyFailures,omitempty"`
+
 	// ResolvedRuntimeConfig captures metadata about the runtime config that was resolved.
 	// +optional
 	ResolvedRuntimeConfig *AIMResolvedReference `json:"resolvedRuntimeConfig,omitempty"`
@@ -132,6 +149,24 @@ type AIMTemplateCacheStatus struct {
 	// Artifacts maps model names to their resolved AIMArtifact resources.
 	// +optional
 	Artifacts map[string]AIMResolvedArtifact `json:"artifacts,omitempty"`
+
+	// Usage counts how often services found this cache warm or had to wait for a download.
+	// +optional
+	Usage *AIMTemplateCacheUsage `json:"usage,omitempty"`
+}
+
+// AIMTemplateCacheUsage counts the template cache lookups of services.
+type AIMTemplateCacheUsage struct {
+	// Hits is the number of services that found the cache Ready when they first used it.
+	Hits int64 `json:"hits"`
+
+	// Misses is the number of services that had to wait for the cache to be downloaded.
+	Misses int64 `json:"misses"`
+
+	// CountedServices lists the UIDs of the services currently using the cache whose lookups
+	// have been counted, so that each lookup is counted once.
+	// +optional
+	CountedServices []string `json:"countedServices,omitempty"`
 }
 
 func (s *AIMTemplateCacheStatus) GetConditions() []metav1.Condition {
@@ -142,6 +177,22 @@ func (s *AIMTemplateCacheStatus) SetConditions(conditions []metav1.Condition) {
 	s.Conditions = conditions
 }
 
+func (s *AIMTemplateCacheStatus) GetTransitionHistory() []AIMConditionTransition {
+	return s.TransitionHistory
+}
+
+func (s *AIMTemplateCacheStatus) SetTransitionHistory(history []AIMConditionTransition) {
+	s.TransitionHistory = history
+}
+
+func (s *AIMTemplateCacheStatus) GetApplyFailures() []AIMApplyFailure {
+	return s.ApplyFailures
+}
+
+func (s *AIMTemplateCacheStatus) SetApplyFailures(failures []AIMApplyFailure) {
+	s.ApplyFailures = failures
+}
+
 func (s *AIMTemplateCacheStatus) SetStatus(status string) {
 	s.Status = constants.AIMStatus(status)
 }
@@ -177,6 +228,10 @@ type AIMResolvedArtifact struct {
 	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
 	// MountPoint is the mount point for the artifact
 	MountPoint string `json:"mountPoint,omitempty"`
+	// Revision is the upstream revision recorded in the artifact's provenance, if any
+	Revision string `json:"revision,omitempty"`
+	// ManifestDigest is the manifest digest recorded in the artifact's provenance, if any
+	ManifestDigest string `json:"manifestDigest,omitempty"`
 }
 
 // Condition reasons for AIMTemplateCache
@@ -192,6 +247,15 @@ const (
 
 	// Template resolution
 	AIMTemplateCacheConditionTemplateFound = "TemplateFound"
+
+	// Cache readiness, reported on the services and templates that use a template cache.
+	// CacheProvisioned is True once the cache volumes exist, CacheWarm once all model
+	// artifacts are downloaded.
+	AIMTemplateCacheConditionProvisioned = "CacheProvisioned"
+	AIMTemplateCacheConditionWarm        = "CacheWarm"
+
+	AIMTemplateCacheReasonProvisioned  = "Provisioned"
+	AIMTemplateCacheReasonProvisioning = "Provisioning"
 )
 
 // +kubebuilder:object:root=true
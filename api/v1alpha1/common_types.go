@@ -23,12 +23,13 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // AIMResolutionScope describes the scope of a resolved reference.
-// +kubebuilder:validation:Enum=Namespace;Cluster;Merged;Unknown
+// +kubebuilder:validation:Enum=Namespace;Cluster;Merged;Inline;Unknown
 type AIMResolutionScope string
 
 const (
@@ -38,6 +39,8 @@ const (
 	AIMResolutionScopeCluster AIMResolutionScope = "Cluster"
 	// AIMResolutionScopeMerged denotes that both cluster and namespace configs were merged.
 	AIMResolutionScopeMerged AIMResolutionScope = "Merged"
+	// AIMResolutionScopeInline denotes configuration declared inline on the resource itself.
+	AIMResolutionScopeInline AIMResolutionScope = "Inline"
 	// AIMResolutionScopeUnknown denotes that the scope could not be determined.
 	AIMResolutionScopeUnknown AIMResolutionScope = "Unknown"
 )
@@ -63,6 +66,100 @@ type AIMResolvedReference struct {
 	UID types.UID `json:"uid,omitempty"`
 }
 
+// MaxTransitionHistory caps the number of entries kept in a status transitionHistory.
+const MaxTransitionHistory = 10
+
+// AIMConditionTransition records a single transition of a condition.
+type AIMConditionTransition struct {
+	// Status is the condition status after the transition.
+	Status metav1.ConditionStatus `json:"status"`
+
+	// Reason is the condition reason after the transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the condition message at the time of the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Time is when the transition happened.
+	Time metav1.Time `json:"time"`
+}
+
+// MaxApplyFailures caps the number of entries kept in a status applyFailures list.
+const MaxApplyFailures = 10
+
+// AIMApplyFailure records a child object that could not be applied.
+type AIMApplyFailure struct {
+	// APIVersion is the API version of the object.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the object, empty for cluster-scoped objects.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the object.
+	Name string `json:"name"`
+
+	// Message is the error returned by the API server.
+	Message string `json:"message"`
+}
+
+// MaxValidationErrors caps the number of entries kept in a status validationErrors list.
+const MaxValidationErrors = 20
+
+// AIMValidationError records a spec field that failed validation.
+type AIMValidationError struct {
+	// FieldPath is the path of the offending field, e.g. spec.routing.gatewayRef.
+	FieldPath string `json:"fieldPath"`
+
+	// Reason is a machine-readable reason code for the failure.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message describes what is wrong with the field.
+	Message string `json:"message"`
+}
+
+const (
+	// MaxConsumerSampleSize caps the number of consumers listed in AIMConsumersStatus.Sample.
+	MaxConsumerSampleSize = 10
+
+	// MaxConsumerNamespaces caps the number of namespaces listed in AIMConsumersStatus.Namespaces.
+	MaxConsumerNamespaces = 50
+)
+
+// AIMConsumersStatus summarizes the resources that currently use a cluster-scoped resource,
+// so administrators can see the blast radius before editing or deleting it.
+type AIMConsumersStatus struct {
+	// Count is the total number of consumers across all namespaces.
+	Count int32 `json:"count"`
+
+	// Namespaces lists the distinct namespaces of the consumers in sorted order.
+	// Capped at 50 entries.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Sample lists up to 10 consumers, sorted by namespace and name.
+	// +optional
+	Sample []AIMConsumerReference `json:"sample,omitempty"`
+}
+
+// AIMConsumerReference identifies a single consumer of a cluster-scoped resource.
+type AIMConsumerReference struct {
+	// Kind is the kind of the consumer, e.g. AIMService.
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the consumer.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the consumer.
+	Name string `json:"name"`
+}
+
 func CreateResolvedReference(obj client.Object) AIMResolvedReference {
 	scope := AIMResolutionScopeCluster
 	if obj.GetNamespace() != "" {
@@ -0,0 +1,99 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package v1alpha1
+
+// AIMServiceObservability configures observability features of an inference service.
+type AIMServiceObservability struct {
+	// RequestLogging records inference requests and responses of the service.
+	// +optional
+	RequestLogging *AIMRequestLogging `json:"requestLogging,omitempty"`
+}
+
+// AIMRequestLogSink selects where request logs are written.
+// +kubebuilder:validation:Enum=stdout;otlp
+type AIMRequestLogSink string
+
+const (
+	// RequestLogSinkStdout writes request logs to the container output.
+	RequestLogSinkStdout AIMRequestLogSink = "stdout"
+
+	// RequestLogSinkOTLP exports request logs to an OpenTelemetry collector over OTLP/gRPC.
+	RequestLogSinkOTLP AIMRequestLogSink = "otlp"
+)
+
+// AIMRequestLogging configures request logging for the inference service.
+// Plain logging of every request to stdout is done by the inference engine itself.
+// Sampling, redaction and the otlp sink are handled by a logging sidecar that receives
+// the requests from the KServe inference logger; its image is set in the runtime config
+// (requestLogger.image).
+// +kubebuilder:validation:XValidation:rule="self.sink != 'otlp' || has(self.otlp)",message="otlp is required when sink is otlp"
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp) || self.sink == 'otlp'",message="otlp may only be set when sink is otlp"
+type AIMRequestLogging struct {
+	// SampleRate is the fraction of requests that are logged, between 0 and 1.
+	// Defaults to 1 (every request).
+	// Example: `0.1`
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	SampleRate string `json:"sampleRate,omitempty"`
+
+	// RedactFields lists JSON fields of request and response bodies whose values are
+	// replaced before logging, at any depth.
+	// Example: `["prompt", "messages"]`
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	RedactFields []string `json:"redactFields,omitempty"`
+
+	// Sink selects where request logs are written.
+	// +optional
+	// +kubebuilder:default=stdout
+	Sink AIMRequestLogSink `json:"sink,omitempty"`
+
+	// OTLP configures the collector receiving request logs. Required when sink is otlp.
+	// +optional
+	OTLP *AIMRequestLogOTLPSink `json:"otlp,omitempty"`
+}
+
+// AIMRequestLogOTLPSink is an OpenTelemetry collector endpoint.
+type AIMRequestLogOTLPSink struct {
+	// Endpoint is the host:port of the collector's OTLP/gRPC receiver.
+	// Example: `otel-collector.observability:4317`
+	// +kubebuilder:validation:Pattern=`^[^\s/:]+:[0-9]+$`
+	Endpoint string `json:"endpoint"`
+}
+
+// AIMRequestLoggerConfig configures the request logging sidecar.
+type AIMRequestLoggerConfig struct {
+	// Image is the container image of the request logging sidecar. It is required for
+	// services that sample, redact or export request logs over OTLP.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// GetSink returns the effective request log sink.
+func (l *AIMRequestLogging) GetSink() AIMRequestLogSink {
+	if l.Sink == "" {
+		return RequestLogSinkStdout
+	}
+	return l.Sink
+}
@@ -79,6 +79,43 @@ const (
 	AIMPrecisionInt8 AIMPrecision = "int8"
 )
 
+// AIMComputeMode selects the device class an inference workload runs on.
+// +kubebuilder:validation:Enum=gpu;cpu
+type AIMComputeMode string
+
+const (
+	// AIMComputeModeGPU runs the workload on GPUs (default).
+	AIMComputeModeGPU AIMComputeMode = "gpu"
+	// AIMComputeModeCPU runs the workload on CPUs only, without requesting GPU resources.
+	AIMComputeModeCPU AIMComputeMode = "cpu"
+)
+
+// AIMModelClass is the kind of model served, which selects the planning defaults for its services.
+// +kubebuilder:validation:Enum=llm;embedding;reranker
+type AIMModelClass string
+
+const (
+	// AIMModelClassLLM is a generative language model (default).
+	AIMModelClassLLM AIMModelClass = "llm"
+	// AIMModelClassEmbedding is a model that serves /v1/embeddings.
+	AIMModelClassEmbedding AIMModelClass = "embedding"
+	// AIMModelClassReranker is a model that scores documents against a query.
+	AIMModelClassReranker AIMModelClass = "reranker"
+)
+
+// HealthPath returns the endpoint the readiness probe polls for the class.
+// Empty for LLMs, whose services are planned without a readiness probe.
+func (c AIMModelClass) HealthPath() string {
+	switch c {
+	case AIMModelClassEmbedding:
+		return "/v1/embeddings"
+	case AIMModelClassReranker:
+		return "/v1/rerank"
+	default:
+		return ""
+	}
+}
+
 // AIMHardwareRequirements specifies compute resource requirements for custom models.
 // Used in AIMModelSpec and AIMCustomTemplate to define GPU and CPU needs.
 // +kubebuilder:validation:XValidation:rule="has(self.gpu) || has(self.cpu)",message="at least one of gpu or cpu must be specified"
@@ -22,12 +22,29 @@ package v1alpha1
 
 import (
 	"k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMApplyFailure) DeepCopyInto(out *AIMApplyFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMApplyFailure.
+func (in *AIMApplyFailure) DeepCopy() *AIMApplyFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMApplyFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMArtifact) DeepCopyInto(out *AIMArtifact) {
 	*out = *in
@@ -55,6 +72,21 @@ func (in *AIMArtifact) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMArtifactFile) DeepCopyInto(out *AIMArtifactFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMArtifactFile.
+func (in *AIMArtifactFile) DeepCopy() *AIMArtifactFile {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMArtifactFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMArtifactList) DeepCopyInto(out *AIMArtifactList) {
 	*out = *in
@@ -87,9 +119,38 @@ func (in *AIMArtifactList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMArtifactProvenance) DeepCopyInto(out *AIMArtifactProvenance) {
+	*out = *in
+	if in.RecordedAt != nil {
+		in, out := &in.RecordedAt, &out.RecordedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]AIMArtifactFile, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMArtifactProvenance.
+func (in *AIMArtifactProvenance) DeepCopy() *AIMArtifactProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMArtifactProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMArtifactSpec) DeepCopyInto(out *AIMArtifactSpec) {
 	*out = *in
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(AIMStorageEncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Size = in.Size.DeepCopy()
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
@@ -126,6 +187,18 @@ func (in *AIMArtifactStatus) DeepCopyInto(out *AIMArtifactStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TransitionHistory != nil {
+		in, out := &in.TransitionHistory, &out.TransitionHistory
+		*out = make([]AIMConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyFailures != nil {
+		in, out := &in.ApplyFailures, &out.ApplyFailures
+		*out = make([]AIMApplyFailure, len(*in))
+		copy(*out, *in)
+	}
 	if in.Progress != nil {
 		in, out := &in.Progress, &out.Progress
 		*out = new(DownloadProgress)
@@ -151,6 +224,16 @@ func (in *AIMArtifactStatus) DeepCopyInto(out *AIMArtifactStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(AIMArtifactProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastInterruption != nil {
+		in, out := &in.LastInterruption, &out.LastInterruption
+		*out = new(AIMDownloadInterruption)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMArtifactStatus.
@@ -164,7 +247,7 @@ func (in *AIMArtifactStatus) DeepCopy() *AIMArtifactStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterModel) DeepCopyInto(out *AIMClusterModel) {
+func (in *AIMBatchJob) DeepCopyInto(out *AIMBatchJob) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -172,18 +255,18 @@ func (in *AIMClusterModel) DeepCopyInto(out *AIMClusterModel) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModel.
-func (in *AIMClusterModel) DeepCopy() *AIMClusterModel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMBatchJob.
+func (in *AIMBatchJob) DeepCopy() *AIMBatchJob {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterModel)
+	out := new(AIMBatchJob)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMClusterModel) DeepCopyObject() runtime.Object {
+func (in *AIMBatchJob) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -191,31 +274,51 @@ func (in *AIMClusterModel) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterModelList) DeepCopyInto(out *AIMClusterModelList) {
+func (in *AIMBatchJobDataset) DeepCopyInto(out *AIMBatchJobDataset) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(AIMBatchJobVolumeDataset)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMBatchJobDataset.
+func (in *AIMBatchJobDataset) DeepCopy() *AIMBatchJobDataset {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMBatchJobDataset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMBatchJobList) DeepCopyInto(out *AIMBatchJobList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AIMClusterModel, len(*in))
+		*out = make([]AIMBatchJob, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelList.
-func (in *AIMClusterModelList) DeepCopy() *AIMClusterModelList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMBatchJobList.
+func (in *AIMBatchJobList) DeepCopy() *AIMBatchJobList {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterModelList)
+	out := new(AIMBatchJobList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMClusterModelList) DeepCopyObject() runtime.Object {
+func (in *AIMBatchJobList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -223,181 +326,178 @@ func (in *AIMClusterModelList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterModelSource) DeepCopyInto(out *AIMClusterModelSource) {
+func (in *AIMBatchJobProgress) DeepCopyInto(out *AIMBatchJobProgress) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelSource.
-func (in *AIMClusterModelSource) DeepCopy() *AIMClusterModelSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMBatchJobProgress.
+func (in *AIMBatchJobProgress) DeepCopy() *AIMBatchJobProgress {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterModelSource)
+	out := new(AIMBatchJobProgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMClusterModelSource) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterModelSourceList) DeepCopyInto(out *AIMClusterModelSourceList) {
+func (in *AIMBatchJobSpec) DeepCopyInto(out *AIMBatchJobSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]AIMClusterModelSource, len(*in))
+	in.Input.DeepCopyInto(&out.Input)
+	in.Output.DeepCopyInto(&out.Output)
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffLimitPerShard != nil {
+		in, out := &in.BackoffLimitPerShard, &out.BackoffLimitPerShard
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	out.RuntimeConfigRef = in.RuntimeConfigRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelSourceList.
-func (in *AIMClusterModelSourceList) DeepCopy() *AIMClusterModelSourceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMBatchJobSpec.
+func (in *AIMBatchJobSpec) DeepCopy() *AIMBatchJobSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterModelSourceList)
+	out := new(AIMBatchJobSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMClusterModelSourceList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterModelSourceSpec) DeepCopyInto(out *AIMClusterModelSourceSpec) {
+func (in *AIMBatchJobStatus) DeepCopyInto(out *AIMBatchJobStatus) {
 	*out = *in
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Filters != nil {
-		in, out := &in.Filters, &out.Filters
-		*out = make([]ModelSourceFilter, len(*in))
+	if in.TransitionHistory != nil {
+		in, out := &in.TransitionHistory, &out.TransitionHistory
+		*out = make([]AIMConditionTransition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.SyncInterval = in.SyncInterval
-	if in.Versions != nil {
-		in, out := &in.Versions, &out.Versions
-		*out = make([]string, len(*in))
+	if in.ApplyFailures != nil {
+		in, out := &in.ApplyFailures, &out.ApplyFailures
+		*out = make([]AIMApplyFailure, len(*in))
 		copy(*out, *in)
 	}
-	if in.MaxModels != nil {
-		in, out := &in.MaxModels, &out.MaxModels
-		*out = new(int)
+	if in.ValidationErrors != nil {
+		in, out := &in.ValidationErrors, &out.ValidationErrors
+		*out = make([]AIMValidationError, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedTemplate != nil {
+		in, out := &in.ResolvedTemplate, &out.ResolvedTemplate
+		*out = new(AIMResolvedReference)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(AIMBatchJobProgress)
 		**out = **in
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelSourceSpec.
-func (in *AIMClusterModelSourceSpec) DeepCopy() *AIMClusterModelSourceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMBatchJobStatus.
+func (in *AIMBatchJobStatus) DeepCopy() *AIMBatchJobStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterModelSourceSpec)
+	out := new(AIMBatchJobStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterModelSourceStatus) DeepCopyInto(out *AIMClusterModelSourceStatus) {
+func (in *AIMBatchJobVolumeDataset) DeepCopyInto(out *AIMBatchJobVolumeDataset) {
 	*out = *in
-	if in.LastSyncTime != nil {
-		in, out := &in.LastSyncTime, &out.LastSyncTime
-		*out = (*in).DeepCopy()
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelSourceStatus.
-func (in *AIMClusterModelSourceStatus) DeepCopy() *AIMClusterModelSourceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMBatchJobVolumeDataset.
+func (in *AIMBatchJobVolumeDataset) DeepCopy() *AIMBatchJobVolumeDataset {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterModelSourceStatus)
+	out := new(AIMBatchJobVolumeDataset)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterRuntimeConfig) DeepCopyInto(out *AIMClusterRuntimeConfig) {
+func (in *AIMCVESummary) DeepCopyInto(out *AIMCVESummary) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterRuntimeConfig.
-func (in *AIMClusterRuntimeConfig) DeepCopy() *AIMClusterRuntimeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMCVESummary.
+func (in *AIMCVESummary) DeepCopy() *AIMCVESummary {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterRuntimeConfig)
+	out := new(AIMCVESummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMClusterRuntimeConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterRuntimeConfigList) DeepCopyInto(out *AIMClusterRuntimeConfigList) {
+func (in *AIMClusterModel) DeepCopyInto(out *AIMClusterModel) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]AIMClusterRuntimeConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterRuntimeConfigList.
-func (in *AIMClusterRuntimeConfigList) DeepCopy() *AIMClusterRuntimeConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModel.
+func (in *AIMClusterModel) DeepCopy() *AIMClusterModel {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterRuntimeConfigList)
+	out := new(AIMClusterModel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMClusterRuntimeConfigList) DeepCopyObject() runtime.Object {
+func (in *AIMClusterModel) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -405,23 +505,7 @@ func (in *AIMClusterRuntimeConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterRuntimeConfigSpec) DeepCopyInto(out *AIMClusterRuntimeConfigSpec) {
-	*out = *in
-	in.AIMRuntimeConfigCommon.DeepCopyInto(&out.AIMRuntimeConfigCommon)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterRuntimeConfigSpec.
-func (in *AIMClusterRuntimeConfigSpec) DeepCopy() *AIMClusterRuntimeConfigSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(AIMClusterRuntimeConfigSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterServiceTemplate) DeepCopyInto(out *AIMClusterServiceTemplate) {
+func (in *AIMClusterModelCache) DeepCopyInto(out *AIMClusterModelCache) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -429,18 +513,18 @@ func (in *AIMClusterServiceTemplate) DeepCopyInto(out *AIMClusterServiceTemplate
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterServiceTemplate.
-func (in *AIMClusterServiceTemplate) DeepCopy() *AIMClusterServiceTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelCache.
+func (in *AIMClusterModelCache) DeepCopy() *AIMClusterModelCache {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterServiceTemplate)
+	out := new(AIMClusterModelCache)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMClusterServiceTemplate) DeepCopyObject() runtime.Object {
+func (in *AIMClusterModelCache) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -448,31 +532,31 @@ func (in *AIMClusterServiceTemplate) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterServiceTemplateList) DeepCopyInto(out *AIMClusterServiceTemplateList) {
+func (in *AIMClusterModelCacheList) DeepCopyInto(out *AIMClusterModelCacheList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AIMClusterServiceTemplate, len(*in))
+		*out = make([]AIMClusterModelCache, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterServiceTemplateList.
-func (in *AIMClusterServiceTemplateList) DeepCopy() *AIMClusterServiceTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelCacheList.
+func (in *AIMClusterModelCacheList) DeepCopy() *AIMClusterModelCacheList {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMClusterServiceTemplateList)
+	out := new(AIMClusterModelCacheList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMClusterServiceTemplateList) DeepCopyObject() runtime.Object {
+func (in *AIMClusterModelCacheList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -480,70 +564,1213 @@ func (in *AIMClusterServiceTemplateList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMClusterServiceTemplateSpec) DeepCopyInto(out *AIMClusterServiceTemplateSpec) {
+func (in *AIMClusterModelCacheSpec) DeepCopyInto(out *AIMClusterModelCacheSpec) {
 	*out = *in
-	in.AIMServiceTemplateSpecCommon.DeepCopyInto(&out.AIMServiceTemplateSpecCommon)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterServiceTemplateSpec.
-func (in *AIMClusterServiceTemplateSpec) DeepCopy() *AIMClusterServiceTemplateSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(AIMClusterServiceTemplateSpec)
-	in.DeepCopyInto(out)
+	out.Size = in.Size.DeepCopy()
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelCacheSpec.
+func (in *AIMClusterModelCacheSpec) DeepCopy() *AIMClusterModelCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterModelCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterModelCacheStatus) DeepCopyInto(out *AIMClusterModelCacheStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransitionHistory != nil {
+		in, out := &in.TransitionHistory, &out.TransitionHistory
+		*out = make([]AIMConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyFailures != nil {
+		in, out := &in.ApplyFailures, &out.ApplyFailures
+		*out = make([]AIMApplyFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.SharedNamespaces != nil {
+		in, out := &in.SharedNamespaces, &out.SharedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelCacheStatus.
+func (in *AIMClusterModelCacheStatus) DeepCopy() *AIMClusterModelCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterModelCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterModelList) DeepCopyInto(out *AIMClusterModelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AIMClusterModel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelList.
+func (in *AIMClusterModelList) DeepCopy() *AIMClusterModelList {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterModelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMClusterModelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterModelSource) DeepCopyInto(out *AIMClusterModelSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelSource.
+func (in *AIMClusterModelSource) DeepCopy() *AIMClusterModelSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterModelSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMClusterModelSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterModelSourceList) DeepCopyInto(out *AIMClusterModelSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AIMClusterModelSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelSourceList.
+func (in *AIMClusterModelSourceList) DeepCopy() *AIMClusterModelSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterModelSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMClusterModelSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterModelSourceSpec) DeepCopyInto(out *AIMClusterModelSourceSpec) {
+	*out = *in
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]ModelSourceFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.SyncInterval = in.SyncInterval
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxModels != nil {
+		in, out := &in.MaxModels, &out.MaxModels
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelSourceSpec.
+func (in *AIMClusterModelSourceSpec) DeepCopy() *AIMClusterModelSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterModelSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterModelSourceStatus) DeepCopyInto(out *AIMClusterModelSourceStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransitionHistory != nil {
+		in, out := &in.TransitionHistory, &out.TransitionHistory
+		*out = make([]AIMConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyFailures != nil {
+		in, out := &in.ApplyFailures, &out.ApplyFailures
+		*out = make([]AIMApplyFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterModelSourceStatus.
+func (in *AIMClusterModelSourceStatus) DeepCopy() *AIMClusterModelSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterModelSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterRuntimeConfig) DeepCopyInto(out *AIMClusterRuntimeConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterRuntimeConfig.
+func (in *AIMClusterRuntimeConfig) DeepCopy() *AIMClusterRuntimeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterRuntimeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMClusterRuntimeConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterRuntimeConfigList) DeepCopyInto(out *AIMClusterRuntimeConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AIMClusterRuntimeConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterRuntimeConfigList.
+func (in *AIMClusterRuntimeConfigList) DeepCopy() *AIMClusterRuntimeConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterRuntimeConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMClusterRuntimeConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterRuntimeConfigSpec) DeepCopyInto(out *AIMClusterRuntimeConfigSpec) {
+	*out = *in
+	in.AIMRuntimeConfigCommon.DeepCopyInto(&out.AIMRuntimeConfigCommon)
+	if in.SyncedImagePullSecrets != nil {
+		in, out := &in.SyncedImagePullSecrets, &out.SyncedImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterRuntimeConfigSpec.
+func (in *AIMClusterRuntimeConfigSpec) DeepCopy() *AIMClusterRuntimeConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterRuntimeConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterServiceTemplate) DeepCopyInto(out *AIMClusterServiceTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterServiceTemplate.
+func (in *AIMClusterServiceTemplate) DeepCopy() *AIMClusterServiceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterServiceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMClusterServiceTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterServiceTemplateList) DeepCopyInto(out *AIMClusterServiceTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AIMClusterServiceTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterServiceTemplateList.
+func (in *AIMClusterServiceTemplateList) DeepCopy() *AIMClusterServiceTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterServiceTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMClusterServiceTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMClusterServiceTemplateSpec) DeepCopyInto(out *AIMClusterServiceTemplateSpec) {
+	*out = *in
+	in.AIMServiceTemplateSpecCommon.DeepCopyInto(&out.AIMServiceTemplateSpecCommon)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMClusterServiceTemplateSpec.
+func (in *AIMClusterServiceTemplateSpec) DeepCopy() *AIMClusterServiceTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMClusterServiceTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMConditionTransition) DeepCopyInto(out *AIMConditionTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMConditionTransition.
+func (in *AIMConditionTransition) DeepCopy() *AIMConditionTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMConditionTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMConsumerReference) DeepCopyInto(out *AIMConsumerReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMConsumerReference.
+func (in *AIMConsumerReference) DeepCopy() *AIMConsumerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMConsumerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMConsumersStatus) DeepCopyInto(out *AIMConsumersStatus) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sample != nil {
+		in, out := &in.Sample, &out.Sample
+		*out = make([]AIMConsumerReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMConsumersStatus.
+func (in *AIMConsumersStatus) DeepCopy() *AIMConsumersStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMConsumersStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMCpuRequirements) DeepCopyInto(out *AIMCpuRequirements) {
+	*out = *in
+	out.Requests = in.Requests.DeepCopy()
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMCpuRequirements.
+func (in *AIMCpuRequirements) DeepCopy() *AIMCpuRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMCpuRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMCustomModelSpec) DeepCopyInto(out *AIMCustomModelSpec) {
+	*out = *in
+	if in.Hardware != nil {
+		in, out := &in.Hardware, &out.Hardware
+		*out = new(AIMHardwareRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(AIMProfileType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMCustomModelSpec.
+func (in *AIMCustomModelSpec) DeepCopy() *AIMCustomModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMCustomModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMCustomTemplate) DeepCopyInto(out *AIMCustomTemplate) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hardware != nil {
+		in, out := &in.Hardware, &out.Hardware
+		*out = new(AIMHardwareRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(AIMTemplateProfile)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMCustomTemplate.
+func (in *AIMCustomTemplate) DeepCopy() *AIMCustomTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMCustomTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMDiscoveryBuilder) DeepCopyInto(out *AIMDiscoveryBuilder) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMDiscoveryBuilder.
+func (in *AIMDiscoveryBuilder) DeepCopy() *AIMDiscoveryBuilder {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMDiscoveryBuilder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMDiscoveryConfig) DeepCopyInto(out *AIMDiscoveryConfig) {
+	*out = *in
+	if in.SuccessfulJobRetention != nil {
+		in, out := &in.SuccessfulJobRetention, &out.SuccessfulJobRetention
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailedJobRetention != nil {
+		in, out := &in.FailedJobRetention, &out.FailedJobRetention
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LogTailLines != nil {
+		in, out := &in.LogTailLines, &out.LogTailLines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SchedulingTimeout != nil {
+		in, out := &in.SchedulingTimeout, &out.SchedulingTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CPUFallback != nil {
+		in, out := &in.CPUFallback, &out.CPUFallback
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreferCPU != nil {
+		in, out := &in.PreferCPU, &out.PreferCPU
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequestGPUs != nil {
+		in, out := &in.RequestGPUs, &out.RequestGPUs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StrictParsing != nil {
+		in, out := &in.StrictParsing, &out.StrictParsing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KnownEngineArgs != nil {
+		in, out := &in.KnownEngineArgs, &out.KnownEngineArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProfileVerification != nil {
+		in, out := &in.ProfileVerification, &out.ProfileVerification
+		*out = new(AIMProfileVerificationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMDiscoveryConfig.
+func (in *AIMDiscoveryConfig) DeepCopy() *AIMDiscoveryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMDiscoveryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMDiscoveryOutput) DeepCopyInto(out *AIMDiscoveryOutput) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMDiscoveryOutput.
+func (in *AIMDiscoveryOutput) DeepCopy() *AIMDiscoveryOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMDiscoveryOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMDiscoveryProfile) DeepCopyInto(out *AIMDiscoveryProfile) {
+	*out = *in
+	if in.EngineArgs != nil {
+		in, out := &in.EngineArgs, &out.EngineArgs
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Metadata = in.Metadata
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMDiscoveryProfile.
+func (in *AIMDiscoveryProfile) DeepCopy() *AIMDiscoveryProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMDiscoveryProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMDiscoveryProfileMetadata) DeepCopyInto(out *AIMDiscoveryProfileMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMDiscoveryProfileMetadata.
+func (in *AIMDiscoveryProfileMetadata) DeepCopy() *AIMDiscoveryProfileMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMDiscoveryProfileMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMDownloadInterruption) DeepCopyInto(out *AIMDownloadInterruption) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMDownloadInterruption.
+func (in *AIMDownloadInterruption) DeepCopy() *AIMDownloadInterruption {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMDownloadInterruption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMEndpoint) DeepCopyInto(out *AIMEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMEndpoint.
+func (in *AIMEndpoint) DeepCopy() *AIMEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMEndpointList) DeepCopyInto(out *AIMEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AIMEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMEndpointList.
+func (in *AIMEndpointList) DeepCopy() *AIMEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMEndpointSpec) DeepCopyInto(out *AIMEndpointSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMEndpointSpec.
+func (in *AIMEndpointSpec) DeepCopy() *AIMEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMEndpointStatus) DeepCopyInto(out *AIMEndpointStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMEndpointStatus.
+func (in *AIMEndpointStatus) DeepCopy() *AIMEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMGPUHealthConfig) DeepCopyInto(out *AIMGPUHealthConfig) {
+	*out = *in
+	if in.NodeConditions != nil {
+		in, out := &in.NodeConditions, &out.NodeConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMGPUHealthConfig.
+func (in *AIMGPUHealthConfig) DeepCopy() *AIMGPUHealthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMGPUHealthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMGPUJobsConfig) DeepCopyInto(out *AIMGPUJobsConfig) {
+	*out = *in
+	if in.MaxConcurrent != nil {
+		in, out := &in.MaxConcurrent, &out.MaxConcurrent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentPerNamespace != nil {
+		in, out := &in.MaxConcurrentPerNamespace, &out.MaxConcurrentPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMGPUJobsConfig.
+func (in *AIMGPUJobsConfig) DeepCopy() *AIMGPUJobsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMGPUJobsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMGPUSharingConfig) DeepCopyInto(out *AIMGPUSharingConfig) {
+	*out = *in
+	if in.ServicesPerGPU != nil {
+		in, out := &in.ServicesPerGPU, &out.ServicesPerGPU
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMGPUSharingConfig.
+func (in *AIMGPUSharingConfig) DeepCopy() *AIMGPUSharingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMGPUSharingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMGPUModelResources) DeepCopyInto(out *AIMGPUModelResources) {
+	*out = *in
+	in.AIMPerGPUResources.DeepCopyInto(&out.AIMPerGPUResources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMGPUModelResources.
+func (in *AIMGPUModelResources) DeepCopy() *AIMGPUModelResources {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMGPUModelResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMGpuRequirements) DeepCopyInto(out *AIMGpuRequirements) {
+	*out = *in
+	if in.MinVRAM != nil {
+		in, out := &in.MinVRAM, &out.MinVRAM
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMGpuRequirements.
+func (in *AIMGpuRequirements) DeepCopy() *AIMGpuRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMGpuRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMHardwareRequirements) DeepCopyInto(out *AIMHardwareRequirements) {
+	*out = *in
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(AIMGpuRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(AIMCpuRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMHardwareRequirements.
+func (in *AIMHardwareRequirements) DeepCopy() *AIMHardwareRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMHardwareRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMImageSecurity) DeepCopyInto(out *AIMImageSecurity) {
+	*out = *in
+	if in.SBOMRefs != nil {
+		in, out := &in.SBOMRefs, &out.SBOMRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CVEs != nil {
+		in, out := &in.CVEs, &out.CVEs
+		*out = new(AIMCVESummary)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMImageSecurity.
+func (in *AIMImageSecurity) DeepCopy() *AIMImageSecurity {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMImageSecurity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMInferenceResourcesConfig) DeepCopyInto(out *AIMInferenceResourcesConfig) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(AIMPerGPUResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUModels != nil {
+		in, out := &in.GPUModels, &out.GPUModels
+		*out = make([]AIMGPUModelResources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ModelMemoryOverhead != nil {
+		in, out := &in.ModelMemoryOverhead, &out.ModelMemoryOverhead
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMInferenceResourcesConfig.
+func (in *AIMInferenceResourcesConfig) DeepCopy() *AIMInferenceResourcesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMInferenceResourcesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMLoggingConfig) DeepCopyInto(out *AIMLoggingConfig) {
+	*out = *in
+	if in.Debug != nil {
+		in, out := &in.Debug, &out.Debug
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMLoggingConfig.
+func (in *AIMLoggingConfig) DeepCopy() *AIMLoggingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMLoggingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMMaintenanceWindow) DeepCopyInto(out *AIMMaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMMaintenanceWindow.
+func (in *AIMMaintenanceWindow) DeepCopy() *AIMMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMModel) DeepCopyInto(out *AIMModel) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModel.
+func (in *AIMModel) DeepCopy() *AIMModel {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMModel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMModelConfig) DeepCopyInto(out *AIMModelConfig) {
+	*out = *in
+	if in.AutoDiscovery != nil {
+		in, out := &in.AutoDiscovery, &out.AutoDiscovery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(AIMModelSecurityPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelConfig.
+func (in *AIMModelConfig) DeepCopy() *AIMModelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMModelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMModelDiscoveryConfig) DeepCopyInto(out *AIMModelDiscoveryConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelDiscoveryConfig.
+func (in *AIMModelDiscoveryConfig) DeepCopy() *AIMModelDiscoveryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMModelDiscoveryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMModelList) DeepCopyInto(out *AIMModelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AIMModel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelList.
+func (in *AIMModelList) DeepCopy() *AIMModelList {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMModelList)
+	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AIMModelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMCpuRequirements) DeepCopyInto(out *AIMCpuRequirements) {
+func (in *AIMModelReadinessCheck) DeepCopyInto(out *AIMModelReadinessCheck) {
 	*out = *in
-	out.Requests = in.Requests.DeepCopy()
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMCpuRequirements.
-func (in *AIMCpuRequirements) DeepCopy() *AIMCpuRequirements {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelReadinessCheck.
+func (in *AIMModelReadinessCheck) DeepCopy() *AIMModelReadinessCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMCpuRequirements)
+	out := new(AIMModelReadinessCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMCustomModelSpec) DeepCopyInto(out *AIMCustomModelSpec) {
+func (in *AIMModelSecurityPolicy) DeepCopyInto(out *AIMModelSecurityPolicy) {
 	*out = *in
-	if in.Hardware != nil {
-		in, out := &in.Hardware, &out.Hardware
-		*out = new(AIMHardwareRequirements)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelSecurityPolicy.
+func (in *AIMModelSecurityPolicy) DeepCopy() *AIMModelSecurityPolicy {
+	if in == nil {
+		return nil
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(AIMProfileType)
-		**out = **in
+	out := new(AIMModelSecurityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMModelSource) DeepCopyInto(out *AIMModelSource) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMCustomModelSpec.
-func (in *AIMCustomModelSpec) DeepCopy() *AIMCustomModelSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelSource.
+func (in *AIMModelSource) DeepCopy() *AIMModelSource {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMCustomModelSpec)
+	out := new(AIMModelSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMCustomTemplate) DeepCopyInto(out *AIMCustomTemplate) {
+func (in *AIMModelSpec) DeepCopyInto(out *AIMModelSpec) {
 	*out = *in
+	if in.Discovery != nil {
+		in, out := &in.Discovery, &out.Discovery
+		*out = new(AIMModelDiscoveryConfig)
+		**out = **in
+	}
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(AIMCustomModelSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomTemplates != nil {
+		in, out := &in.CustomTemplates, &out.CustomTemplates
+		*out = make([]AIMCustomTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ModelSources != nil {
+		in, out := &in.ModelSources, &out.ModelSources
+		*out = make([]AIMModelSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.RuntimeConfigRef = in.RuntimeConfigRef
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]v1.EnvVar, len(*in))
@@ -551,401 +1778,472 @@ func (in *AIMCustomTemplate) DeepCopyInto(out *AIMCustomTemplate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Hardware != nil {
-		in, out := &in.Hardware, &out.Hardware
-		*out = new(AIMHardwareRequirements)
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.ImageMetadata != nil {
+		in, out := &in.ImageMetadata, &out.ImageMetadata
+		*out = new(ImageMetadata)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Profile != nil {
-		in, out := &in.Profile, &out.Profile
-		*out = new(AIMTemplateProfile)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelSpec.
+func (in *AIMModelSpec) DeepCopy() *AIMModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMModelStatus) DeepCopyInto(out *AIMModelStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransitionHistory != nil {
+		in, out := &in.TransitionHistory, &out.TransitionHistory
+		*out = make([]AIMConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyFailures != nil {
+		in, out := &in.ApplyFailures, &out.ApplyFailures
+		*out = make([]AIMApplyFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedRuntimeConfig != nil {
+		in, out := &in.ResolvedRuntimeConfig, &out.ResolvedRuntimeConfig
+		*out = new(AIMResolvedReference)
 		**out = **in
 	}
+	if in.ImageMetadata != nil {
+		in, out := &in.ImageMetadata, &out.ImageMetadata
+		*out = new(ImageMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(AIMImageSecurity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = new(AIMConsumersStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMCustomTemplate.
-func (in *AIMCustomTemplate) DeepCopy() *AIMCustomTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelStatus.
+func (in *AIMModelStatus) DeepCopy() *AIMModelStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMCustomTemplate)
+	out := new(AIMModelStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMDiscoveryProfile) DeepCopyInto(out *AIMDiscoveryProfile) {
+func (in *AIMMountedArtifact) DeepCopyInto(out *AIMMountedArtifact) {
 	*out = *in
-	if in.EngineArgs != nil {
-		in, out := &in.EngineArgs, &out.EngineArgs
-		*out = new(apiextensionsv1.JSON)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMMountedArtifact.
+func (in *AIMMountedArtifact) DeepCopy() *AIMMountedArtifact {
+	if in == nil {
+		return nil
 	}
-	if in.EnvVars != nil {
-		in, out := &in.EnvVars, &out.EnvVars
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out := new(AIMMountedArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMNetworkPolicyConfig) DeepCopyInto(out *AIMNetworkPolicyConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IngressFrom != nil {
+		in, out := &in.IngressFrom, &out.IngressFrom
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Metadata = in.Metadata
+	if in.SystemNamespaces != nil {
+		in, out := &in.SystemNamespaces, &out.SystemNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RegistryCIDRs != nil {
+		in, out := &in.RegistryCIDRs, &out.RegistryCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMDiscoveryProfile.
-func (in *AIMDiscoveryProfile) DeepCopy() *AIMDiscoveryProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMNetworkPolicyConfig.
+func (in *AIMNetworkPolicyConfig) DeepCopy() *AIMNetworkPolicyConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMDiscoveryProfile)
+	out := new(AIMNetworkPolicyConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMDiscoveryProfileMetadata) DeepCopyInto(out *AIMDiscoveryProfileMetadata) {
+func (in *AIMNotificationWebhook) DeepCopyInto(out *AIMNotificationWebhook) {
 	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]AIMNotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMDiscoveryProfileMetadata.
-func (in *AIMDiscoveryProfileMetadata) DeepCopy() *AIMDiscoveryProfileMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMNotificationWebhook.
+func (in *AIMNotificationWebhook) DeepCopy() *AIMNotificationWebhook {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMDiscoveryProfileMetadata)
+	out := new(AIMNotificationWebhook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMGpuRequirements) DeepCopyInto(out *AIMGpuRequirements) {
+func (in *AIMNotificationsConfig) DeepCopyInto(out *AIMNotificationsConfig) {
 	*out = *in
-	if in.MinVRAM != nil {
-		in, out := &in.MinVRAM, &out.MinVRAM
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]AIMNotificationWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMNotificationsConfig.
+func (in *AIMNotificationsConfig) DeepCopy() *AIMNotificationsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMNotificationsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMPerGPUResources) DeepCopyInto(out *AIMPerGPUResources) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryRequest != nil {
+		in, out := &in.MemoryRequest, &out.MemoryRequest
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMPerGPUResources.
+func (in *AIMPerGPUResources) DeepCopy() *AIMPerGPUResources {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMPerGPUResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMProfile) DeepCopyInto(out *AIMProfile) {
+	*out = *in
+	if in.EngineArgs != nil {
+		in, out := &in.EngineArgs, &out.EngineArgs
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Metadata = in.Metadata
+	if in.OriginalDiscoveryOutput != nil {
+		in, out := &in.OriginalDiscoveryOutput, &out.OriginalDiscoveryOutput
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMGpuRequirements.
-func (in *AIMGpuRequirements) DeepCopy() *AIMGpuRequirements {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMProfile.
+func (in *AIMProfile) DeepCopy() *AIMProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMGpuRequirements)
+	out := new(AIMProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMHardwareRequirements) DeepCopyInto(out *AIMHardwareRequirements) {
+func (in *AIMProfilePerformance) DeepCopyInto(out *AIMProfilePerformance) {
 	*out = *in
-	if in.GPU != nil {
-		in, out := &in.GPU, &out.GPU
-		*out = new(AIMGpuRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.CPU != nil {
-		in, out := &in.CPU, &out.CPU
-		*out = new(AIMCpuRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.TokensPerSecond != nil {
+		in, out := &in.TokensPerSecond, &out.TokensPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TokensPerSecondPerGPU != nil {
+		in, out := &in.TokensPerSecondPerGPU, &out.TokensPerSecondPerGPU
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MeasuredAt != nil {
+		in, out := &in.MeasuredAt, &out.MeasuredAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMHardwareRequirements.
-func (in *AIMHardwareRequirements) DeepCopy() *AIMHardwareRequirements {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMProfilePerformance.
+func (in *AIMProfilePerformance) DeepCopy() *AIMProfilePerformance {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMHardwareRequirements)
+	out := new(AIMProfilePerformance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMModel) DeepCopyInto(out *AIMModel) {
+func (in *AIMProfileMetadata) DeepCopyInto(out *AIMProfileMetadata) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModel.
-func (in *AIMModel) DeepCopy() *AIMModel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMProfileMetadata.
+func (in *AIMProfileMetadata) DeepCopy() *AIMProfileMetadata {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMModel)
+	out := new(AIMProfileMetadata)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMModel) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMModelConfig) DeepCopyInto(out *AIMModelConfig) {
+func (in *AIMProfileVerificationConfig) DeepCopyInto(out *AIMProfileVerificationConfig) {
 	*out = *in
-	if in.AutoDiscovery != nil {
-		in, out := &in.AutoDiscovery, &out.AutoDiscovery
-		*out = new(bool)
-		**out = **in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelConfig.
-func (in *AIMModelConfig) DeepCopy() *AIMModelConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMProfileVerificationConfig.
+func (in *AIMProfileVerificationConfig) DeepCopy() *AIMProfileVerificationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMModelConfig)
+	out := new(AIMProfileVerificationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMModelDiscoveryConfig) DeepCopyInto(out *AIMModelDiscoveryConfig) {
+func (in *AIMPropagationKeyFilter) DeepCopyInto(out *AIMPropagationKeyFilter) {
 	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelDiscoveryConfig.
-func (in *AIMModelDiscoveryConfig) DeepCopy() *AIMModelDiscoveryConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMPropagationKeyFilter.
+func (in *AIMPropagationKeyFilter) DeepCopy() *AIMPropagationKeyFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMModelDiscoveryConfig)
+	out := new(AIMPropagationKeyFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMModelList) DeepCopyInto(out *AIMModelList) {
+func (in *AIMProxyConfig) DeepCopyInto(out *AIMProxyConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]AIMModel, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelList.
-func (in *AIMModelList) DeepCopy() *AIMModelList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMProxyConfig.
+func (in *AIMProxyConfig) DeepCopy() *AIMProxyConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMModelList)
+	out := new(AIMProxyConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AIMModelList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMModelSource) DeepCopyInto(out *AIMModelSource) {
+func (in *AIMRequestLogOTLPSink) DeepCopyInto(out *AIMRequestLogOTLPSink) {
 	*out = *in
-	if in.Size != nil {
-		in, out := &in.Size, &out.Size
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelSource.
-func (in *AIMModelSource) DeepCopy() *AIMModelSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMRequestLogOTLPSink.
+func (in *AIMRequestLogOTLPSink) DeepCopy() *AIMRequestLogOTLPSink {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMModelSource)
+	out := new(AIMRequestLogOTLPSink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMModelSpec) DeepCopyInto(out *AIMModelSpec) {
+func (in *AIMRequestLoggerConfig) DeepCopyInto(out *AIMRequestLoggerConfig) {
 	*out = *in
-	if in.Discovery != nil {
-		in, out := &in.Discovery, &out.Discovery
-		*out = new(AIMModelDiscoveryConfig)
-		**out = **in
-	}
-	if in.Custom != nil {
-		in, out := &in.Custom, &out.Custom
-		*out = new(AIMCustomModelSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CustomTemplates != nil {
-		in, out := &in.CustomTemplates, &out.CustomTemplates
-		*out = make([]AIMCustomTemplate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ModelSources != nil {
-		in, out := &in.ModelSources, &out.ModelSources
-		*out = make([]AIMModelSource, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	out.RuntimeConfigRef = in.RuntimeConfigRef
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
-		copy(*out, *in)
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	in.Resources.DeepCopyInto(&out.Resources)
-	if in.ImageMetadata != nil {
-		in, out := &in.ImageMetadata, &out.ImageMetadata
-		*out = new(ImageMetadata)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelSpec.
-func (in *AIMModelSpec) DeepCopy() *AIMModelSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMRequestLoggerConfig.
+func (in *AIMRequestLoggerConfig) DeepCopy() *AIMRequestLoggerConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMModelSpec)
+	out := new(AIMRequestLoggerConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMModelStatus) DeepCopyInto(out *AIMModelStatus) {
+func (in *AIMRequestLogging) DeepCopyInto(out *AIMRequestLogging) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RedactFields != nil {
+		in, out := &in.RedactFields, &out.RedactFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.ResolvedRuntimeConfig != nil {
-		in, out := &in.ResolvedRuntimeConfig, &out.ResolvedRuntimeConfig
-		*out = new(AIMResolvedReference)
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(AIMRequestLogOTLPSink)
 		**out = **in
 	}
-	if in.ImageMetadata != nil {
-		in, out := &in.ImageMetadata, &out.ImageMetadata
-		*out = new(ImageMetadata)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMModelStatus.
-func (in *AIMModelStatus) DeepCopy() *AIMModelStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMRequestLogging.
+func (in *AIMRequestLogging) DeepCopy() *AIMRequestLogging {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMModelStatus)
+	out := new(AIMRequestLogging)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMProfile) DeepCopyInto(out *AIMProfile) {
+func (in *AIMResolvedArtifact) DeepCopyInto(out *AIMResolvedArtifact) {
 	*out = *in
-	if in.EngineArgs != nil {
-		in, out := &in.EngineArgs, &out.EngineArgs
-		*out = new(apiextensionsv1.JSON)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EnvVars != nil {
-		in, out := &in.EnvVars, &out.EnvVars
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	out.Metadata = in.Metadata
-	if in.OriginalDiscoveryOutput != nil {
-		in, out := &in.OriginalDiscoveryOutput, &out.OriginalDiscoveryOutput
-		*out = new(apiextensionsv1.JSON)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMProfile.
-func (in *AIMProfile) DeepCopy() *AIMProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMResolvedArtifact.
+func (in *AIMResolvedArtifact) DeepCopy() *AIMResolvedArtifact {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMProfile)
+	out := new(AIMResolvedArtifact)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMProfileMetadata) DeepCopyInto(out *AIMProfileMetadata) {
+func (in *AIMResolvedReference) DeepCopyInto(out *AIMResolvedReference) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMProfileMetadata.
-func (in *AIMProfileMetadata) DeepCopy() *AIMProfileMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMResolvedReference.
+func (in *AIMResolvedReference) DeepCopy() *AIMResolvedReference {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMProfileMetadata)
+	out := new(AIMResolvedReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMResolvedArtifact) DeepCopyInto(out *AIMResolvedArtifact) {
+func (in *AIMResourceRecommendation) DeepCopyInto(out *AIMResourceRecommendation) {
 	*out = *in
+	if in.Current != nil {
+		in, out := &in.Current, &out.Current
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	out.Observed = in.Observed.DeepCopy()
+	out.Recommended = in.Recommended.DeepCopy()
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMResolvedArtifact.
-func (in *AIMResolvedArtifact) DeepCopy() *AIMResolvedArtifact {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMResourceRecommendation.
+func (in *AIMResourceRecommendation) DeepCopy() *AIMResourceRecommendation {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMResolvedArtifact)
+	out := new(AIMResourceRecommendation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMResolvedReference) DeepCopyInto(out *AIMResolvedReference) {
+func (in *AIMRouteAnnotationKeys) DeepCopyInto(out *AIMRouteAnnotationKeys) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMResolvedReference.
-func (in *AIMResolvedReference) DeepCopy() *AIMResolvedReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMRouteAnnotationKeys.
+func (in *AIMRouteAnnotationKeys) DeepCopy() *AIMRouteAnnotationKeys {
 	if in == nil {
 		return nil
 	}
-	out := new(AIMResolvedReference)
+	out := new(AIMRouteAnnotationKeys)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -974,16 +2272,61 @@ func (in *AIMRuntimeConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AIMRuntimeConfigCommon) DeepCopyInto(out *AIMRuntimeConfigCommon) {
-	*out = *in
-	in.AIMServiceRuntimeConfig.DeepCopyInto(&out.AIMServiceRuntimeConfig)
-	if in.Model != nil {
-		in, out := &in.Model, &out.Model
-		*out = new(AIMModelConfig)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMRuntimeConfigCommon) DeepCopyInto(out *AIMRuntimeConfigCommon) {
+	*out = *in
+	in.AIMServiceRuntimeConfig.DeepCopyInto(&out.AIMServiceRuntimeConfig)
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(AIMModelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Discovery != nil {
+		in, out := &in.Discovery, &out.Discovery
+		*out = new(AIMDiscoveryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUJobs != nil {
+		in, out := &in.GPUJobs, &out.GPUJobs
+		*out = new(AIMGPUJobsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUHealth != nil {
+		in, out := &in.GPUHealth, &out.GPUHealth
+		*out = new(AIMGPUHealthConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUSharing != nil {
+		in, out := &in.GPUSharing, &out.GPUSharing
+		*out = new(AIMGPUSharingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateSelection != nil {
+		in, out := &in.TemplateSelection, &out.TemplateSelection
+		*out = new(AIMTemplateSelectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InferenceResources != nil {
+		in, out := &in.InferenceResources, &out.InferenceResources
+		*out = new(AIMInferenceResourcesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tenancy != nil {
+		in, out := &in.Tenancy, &out.Tenancy
+		*out = new(AIMTenancyPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spreading != nil {
+		in, out := &in.Spreading, &out.Spreading
+		*out = new(AIMSpreadingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(AIMProxyConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LabelPropagation != nil {
@@ -991,6 +2334,21 @@ func (in *AIMRuntimeConfigCommon) DeepCopyInto(out *AIMRuntimeConfigCommon) {
 		*out = new(AIMRuntimeConfigLabelPropagationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(AIMNotificationsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceArchive != nil {
+		in, out := &in.ServiceArchive, &out.ServiceArchive
+		*out = new(AIMServiceArchiveConfig)
+		**out = **in
+	}
+	if in.RequestLogger != nil {
+		in, out := &in.RequestLogger, &out.RequestLogger
+		*out = new(AIMRequestLoggerConfig)
+		**out = **in
+	}
 	if in.PVCHeadroomPercent != nil {
 		in, out := &in.PVCHeadroomPercent, &out.PVCHeadroomPercent
 		*out = new(int32)
@@ -1016,6 +2374,23 @@ func (in *AIMRuntimeConfigLabelPropagationSpec) DeepCopyInto(out *AIMRuntimeConf
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = new(AIMPropagationKeyFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMRuntimeConfigLabelPropagationSpec.
@@ -1060,6 +2435,27 @@ func (in *AIMRuntimeConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMRuntimeConfigOverride) DeepCopyInto(out *AIMRuntimeConfigOverride) {
+	*out = *in
+	in.AIMServiceRuntimeConfig.DeepCopyInto(&out.AIMServiceRuntimeConfig)
+	if in.LabelPropagation != nil {
+		in, out := &in.LabelPropagation, &out.LabelPropagation
+		*out = new(AIMRuntimeConfigLabelPropagationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMRuntimeConfigOverride.
+func (in *AIMRuntimeConfigOverride) DeepCopy() *AIMRuntimeConfigOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMRuntimeConfigOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMRuntimeConfigSpec) DeepCopyInto(out *AIMRuntimeConfigSpec) {
 	*out = *in
@@ -1151,6 +2547,26 @@ func (in *AIMRuntimeRoutingConfig) DeepCopyInto(out *AIMRuntimeRoutingConfig) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRequestBodySize != nil {
+		in, out := &in.MaxRequestBodySize, &out.MaxRequestBodySize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AnnotationKeys != nil {
+		in, out := &in.AnnotationKeys, &out.AnnotationKeys
+		*out = new(AIMRouteAnnotationKeys)
+		**out = **in
+	}
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
@@ -1170,6 +2586,60 @@ func (in *AIMRuntimeRoutingConfig) DeepCopy() *AIMRuntimeRoutingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMSchedulingConfig) DeepCopyInto(out *AIMSchedulingConfig) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMSchedulingConfig.
+func (in *AIMSchedulingConfig) DeepCopy() *AIMSchedulingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMSchedulingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMSelectionPolicyPlugin) DeepCopyInto(out *AIMSelectionPolicyPlugin) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMSelectionPolicyPlugin.
+func (in *AIMSelectionPolicyPlugin) DeepCopy() *AIMSelectionPolicyPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMSelectionPolicyPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMService) DeepCopyInto(out *AIMService) {
 	*out = *in
@@ -1197,6 +2667,21 @@ func (in *AIMService) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceArchiveConfig) DeepCopyInto(out *AIMServiceArchiveConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceArchiveConfig.
+func (in *AIMServiceArchiveConfig) DeepCopy() *AIMServiceArchiveConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceArchiveConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMServiceAutoScaling) DeepCopyInto(out *AIMServiceAutoScaling) {
 	*out = *in
@@ -1219,6 +2704,38 @@ func (in *AIMServiceAutoScaling) DeepCopy() *AIMServiceAutoScaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceCacheLookup) DeepCopyInto(out *AIMServiceCacheLookup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceCacheLookup.
+func (in *AIMServiceCacheLookup) DeepCopy() *AIMServiceCacheLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceCacheLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceCacheMigrationStatus) DeepCopyInto(out *AIMServiceCacheMigrationStatus) {
+	*out = *in
+	out.Source = in.Source
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceCacheMigrationStatus.
+func (in *AIMServiceCacheMigrationStatus) DeepCopy() *AIMServiceCacheMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceCacheMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMServiceCacheStatus) DeepCopyInto(out *AIMServiceCacheStatus) {
 	*out = *in
@@ -1227,6 +2744,26 @@ func (in *AIMServiceCacheStatus) DeepCopyInto(out *AIMServiceCacheStatus) {
 		*out = new(AIMResolvedReference)
 		**out = **in
 	}
+	if in.ClusterModelCacheRefs != nil {
+		in, out := &in.ClusterModelCacheRefs, &out.ClusterModelCacheRefs
+		*out = make([]AIMResolvedReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(AIMServiceCacheMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lookup != nil {
+		in, out := &in.Lookup, &out.Lookup
+		*out = new(AIMServiceCacheLookup)
+		**out = **in
+	}
+	if in.MountedArtifacts != nil {
+		in, out := &in.MountedArtifacts, &out.MountedArtifacts
+		*out = make([]AIMMountedArtifact, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceCacheStatus.
@@ -1254,6 +2791,58 @@ func (in *AIMServiceCachingConfig) DeepCopy() *AIMServiceCachingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceEffectiveRuntimeSpec) DeepCopyInto(out *AIMServiceEffectiveRuntimeSpec) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.LastChangedTime != nil {
+		in, out := &in.LastChangedTime, &out.LastChangedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceEffectiveRuntimeSpec.
+func (in *AIMServiceEffectiveRuntimeSpec) DeepCopy() *AIMServiceEffectiveRuntimeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceEffectiveRuntimeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceEnsemble) DeepCopyInto(out *AIMServiceEnsemble) {
+	*out = *in
+	if in.Variants != nil {
+		in, out := &in.Variants, &out.Variants
+		*out = make([]AIMServiceVariant, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceEnsemble.
+func (in *AIMServiceEnsemble) DeepCopy() *AIMServiceEnsemble {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceEnsemble)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMServiceList) DeepCopyInto(out *AIMServiceList) {
 	*out = *in
@@ -1344,6 +2933,11 @@ func (in *AIMServiceModel) DeepCopyInto(out *AIMServiceModel) {
 		*out = new(AIMServiceModelCustom)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceModel.
@@ -1379,6 +2973,26 @@ func (in *AIMServiceModelCustom) DeepCopy() *AIMServiceModelCustom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceObservability) DeepCopyInto(out *AIMServiceObservability) {
+	*out = *in
+	if in.RequestLogging != nil {
+		in, out := &in.RequestLogging, &out.RequestLogging
+		*out = new(AIMRequestLogging)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceObservability.
+func (in *AIMServiceObservability) DeepCopy() *AIMServiceObservability {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceObservability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMServiceOverrides) DeepCopyInto(out *AIMServiceOverrides) {
 	*out = *in
@@ -1395,6 +3009,21 @@ func (in *AIMServiceOverrides) DeepCopy() *AIMServiceOverrides {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceOverridesStatus) DeepCopyInto(out *AIMServiceOverridesStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceOverridesStatus.
+func (in *AIMServiceOverridesStatus) DeepCopy() *AIMServiceOverridesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceOverridesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMServicePodMetric) DeepCopyInto(out *AIMServicePodMetric) {
 	*out = *in
@@ -1440,6 +3069,54 @@ func (in *AIMServicePodMetricSource) DeepCopy() *AIMServicePodMetricSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceRecommendations) DeepCopyInto(out *AIMServiceRecommendations) {
+	*out = *in
+	in.LastAnalyzedTime.DeepCopyInto(&out.LastAnalyzedTime)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AIMResourceRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceRecommendations.
+func (in *AIMServiceRecommendations) DeepCopy() *AIMServiceRecommendations {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceRecommendations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceRollingUpdate) DeepCopyInto(out *AIMServiceRollingUpdate) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceRollingUpdate.
+func (in *AIMServiceRollingUpdate) DeepCopy() *AIMServiceRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMServiceRoutingStatus) DeepCopyInto(out *AIMServiceRoutingStatus) {
 	*out = *in
@@ -1475,6 +3152,26 @@ func (in *AIMServiceRuntimeConfig) DeepCopyInto(out *AIMServiceRuntimeConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(AIMSchedulingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(AIMLoggingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Termination != nil {
+		in, out := &in.Termination, &out.Termination
+		*out = new(AIMTerminationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(AIMNetworkPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceRuntimeConfig.
@@ -1506,7 +3203,7 @@ func (in *AIMServiceRuntimeStatus) DeepCopy() *AIMServiceRuntimeStatus {
 func (in *AIMServiceSpec) DeepCopyInto(out *AIMServiceSpec) {
 	*out = *in
 	in.Model.DeepCopyInto(&out.Model)
-	out.Template = in.Template
+	in.Template.DeepCopyInto(&out.Template)
 	if in.Caching != nil {
 		in, out := &in.Caching, &out.Caching
 		*out = new(AIMServiceCachingConfig)
@@ -1539,6 +3236,11 @@ func (in *AIMServiceSpec) DeepCopyInto(out *AIMServiceSpec) {
 	}
 	out.RuntimeConfigRef = in.RuntimeConfigRef
 	in.AIMServiceRuntimeConfig.DeepCopyInto(&out.AIMServiceRuntimeConfig)
+	if in.RuntimeConfigOverride != nil {
+		in, out := &in.RuntimeConfigOverride, &out.RuntimeConfigOverride
+		*out = new(AIMRuntimeConfigOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(v1.ResourceRequirements)
@@ -1554,6 +3256,31 @@ func (in *AIMServiceSpec) DeepCopyInto(out *AIMServiceSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.ModelReadiness != nil {
+		in, out := &in.ModelReadiness, &out.ModelReadiness
+		*out = new(AIMModelReadinessCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(AIMServiceUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(AIMMaintenanceWindow)
+		**out = **in
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(AIMServiceObservability)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ensemble != nil {
+		in, out := &in.Ensemble, &out.Ensemble
+		*out = new(AIMServiceEnsemble)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceSpec.
@@ -1566,6 +3293,26 @@ func (in *AIMServiceSpec) DeepCopy() *AIMServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceSpreadStatus) DeepCopyInto(out *AIMServiceSpreadStatus) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]AIMSpreadDomain, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceSpreadStatus.
+func (in *AIMServiceSpreadStatus) DeepCopy() *AIMServiceSpreadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceSpreadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMServiceStatus) DeepCopyInto(out *AIMServiceStatus) {
 	*out = *in
@@ -1576,11 +3323,33 @@ func (in *AIMServiceStatus) DeepCopyInto(out *AIMServiceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TransitionHistory != nil {
+		in, out := &in.TransitionHistory, &out.TransitionHistory
+		*out = make([]AIMConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyFailures != nil {
+		in, out := &in.ApplyFailures, &out.ApplyFailures
+		*out = make([]AIMApplyFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValidationErrors != nil {
+		in, out := &in.ValidationErrors, &out.ValidationErrors
+		*out = make([]AIMValidationError, len(*in))
+		copy(*out, *in)
+	}
 	if in.ResolvedRuntimeConfig != nil {
 		in, out := &in.ResolvedRuntimeConfig, &out.ResolvedRuntimeConfig
 		*out = new(AIMResolvedReference)
 		**out = **in
 	}
+	if in.RuntimeConfigSources != nil {
+		in, out := &in.RuntimeConfigSources, &out.RuntimeConfigSources
+		*out = make([]AIMResolvedReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.ResolvedModel != nil {
 		in, out := &in.ResolvedModel, &out.ResolvedModel
 		*out = new(AIMResolvedReference)
@@ -1606,6 +3375,33 @@ func (in *AIMServiceStatus) DeepCopyInto(out *AIMServiceStatus) {
 		*out = new(AIMServiceRuntimeStatus)
 		**out = **in
 	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(AIMServiceOverridesStatus)
+		**out = **in
+	}
+	if in.Variants != nil {
+		in, out := &in.Variants, &out.Variants
+		*out = make([]AIMServiceVariantStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Spread != nil {
+		in, out := &in.Spread, &out.Spread
+		*out = make([]AIMServiceSpreadStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = new(AIMServiceRecommendations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EffectiveRuntimeSpec != nil {
+		in, out := &in.EffectiveRuntimeSpec, &out.EffectiveRuntimeSpec
+		*out = new(AIMServiceEffectiveRuntimeSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceStatus.
@@ -1648,6 +3444,11 @@ func (in *AIMServiceTemplate) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMServiceTemplateConfig) DeepCopyInto(out *AIMServiceTemplateConfig) {
 	*out = *in
+	if in.GPUPreference != nil {
+		in, out := &in.GPUPreference, &out.GPUPreference
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceTemplateConfig.
@@ -1747,6 +3548,11 @@ func (in *AIMServiceTemplateSpecCommon) DeepCopyInto(out *AIMServiceTemplateSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaxConcurrentServices != nil {
+		in, out := &in.MaxConcurrentServices, &out.MaxConcurrentServices
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceTemplateSpecCommon.
@@ -1769,6 +3575,18 @@ func (in *AIMServiceTemplateStatus) DeepCopyInto(out *AIMServiceTemplateStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TransitionHistory != nil {
+		in, out := &in.TransitionHistory, &out.TransitionHistory
+		*out = make([]AIMConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyFailures != nil {
+		in, out := &in.ApplyFailures, &out.ApplyFailures
+		*out = make([]AIMApplyFailure, len(*in))
+		copy(*out, *in)
+	}
 	if in.ResolvedRuntimeConfig != nil {
 		in, out := &in.ResolvedRuntimeConfig, &out.ResolvedRuntimeConfig
 		*out = new(AIMResolvedReference)
@@ -1816,6 +3634,36 @@ func (in *AIMServiceTemplateStatus) DeepCopyInto(out *AIMServiceTemplateStatus)
 		*out = new(DiscoveryState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DiscoveryWarnings != nil {
+		in, out := &in.DiscoveryWarnings, &out.DiscoveryWarnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiscoveryOutput != nil {
+		in, out := &in.DiscoveryOutput, &out.DiscoveryOutput
+		*out = new(AIMDiscoveryOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiscoveredBy != nil {
+		in, out := &in.DiscoveredBy, &out.DiscoveredBy
+		*out = new(AIMDiscoveryBuilder)
+		**out = **in
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = new(AIMConsumersStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Performance != nil {
+		in, out := &in.Performance, &out.Performance
+		*out = new(AIMProfilePerformance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecommendedFor != nil {
+		in, out := &in.RecommendedFor, &out.RecommendedFor
+		*out = make([]AIMSelectionGoal, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceTemplateStatus.
@@ -1828,6 +3676,96 @@ func (in *AIMServiceTemplateStatus) DeepCopy() *AIMServiceTemplateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceUpdateStrategy) DeepCopyInto(out *AIMServiceUpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(AIMServiceRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceUpdateStrategy.
+func (in *AIMServiceUpdateStrategy) DeepCopy() *AIMServiceUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceVariant) DeepCopyInto(out *AIMServiceVariant) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceVariant.
+func (in *AIMServiceVariant) DeepCopy() *AIMServiceVariant {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceVariant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMServiceVariantStatus) DeepCopyInto(out *AIMServiceVariantStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMServiceVariantStatus.
+func (in *AIMServiceVariantStatus) DeepCopy() *AIMServiceVariantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMServiceVariantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMSpreadDomain) DeepCopyInto(out *AIMSpreadDomain) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMSpreadDomain.
+func (in *AIMSpreadDomain) DeepCopy() *AIMSpreadDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMSpreadDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMSpreadingConfig) DeepCopyInto(out *AIMSpreadingConfig) {
+	*out = *in
+	if in.TopologyKeys != nil {
+		in, out := &in.TopologyKeys, &out.TopologyKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMSpreadingConfig.
+func (in *AIMSpreadingConfig) DeepCopy() *AIMSpreadingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMSpreadingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMStorageConfig) DeepCopyInto(out *AIMStorageConfig) {
 	*out = *in
@@ -1836,11 +3774,23 @@ func (in *AIMStorageConfig) DeepCopyInto(out *AIMStorageConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TopologyStorageClasses != nil {
+		in, out := &in.TopologyStorageClasses, &out.TopologyStorageClasses
+		*out = make([]AIMTopologyStorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PVCHeadroomPercent != nil {
 		in, out := &in.PVCHeadroomPercent, &out.PVCHeadroomPercent
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(AIMStorageEncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMStorageConfig.
@@ -1853,6 +3803,22 @@ func (in *AIMStorageConfig) DeepCopy() *AIMStorageConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMStorageEncryptionConfig) DeepCopyInto(out *AIMStorageEncryptionConfig) {
+	*out = *in
+	in.KMSSecretRef.DeepCopyInto(&out.KMSSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMStorageEncryptionConfig.
+func (in *AIMStorageEncryptionConfig) DeepCopy() *AIMStorageEncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMStorageEncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMTemplateCache) DeepCopyInto(out *AIMTemplateCache) {
 	*out = *in
@@ -1927,6 +3893,11 @@ func (in *AIMTemplateCacheSpec) DeepCopyInto(out *AIMTemplateCacheSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(AIMStorageEncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ModelSources != nil {
 		in, out := &in.ModelSources, &out.ModelSources
 		*out = make([]AIMModelSource, len(*in))
@@ -1957,6 +3928,18 @@ func (in *AIMTemplateCacheStatus) DeepCopyInto(out *AIMTemplateCacheStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TransitionHistory != nil {
+		in, out := &in.TransitionHistory, &out.TransitionHistory
+		*out = make([]AIMConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplyFailures != nil {
+		in, out := &in.ApplyFailures, &out.ApplyFailures
+		*out = make([]AIMApplyFailure, len(*in))
+		copy(*out, *in)
+	}
 	if in.ResolvedRuntimeConfig != nil {
 		in, out := &in.ResolvedRuntimeConfig, &out.ResolvedRuntimeConfig
 		*out = new(AIMResolvedReference)
@@ -1969,6 +3952,11 @@ func (in *AIMTemplateCacheStatus) DeepCopyInto(out *AIMTemplateCacheStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(AIMTemplateCacheUsage)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMTemplateCacheStatus.
@@ -1981,6 +3969,26 @@ func (in *AIMTemplateCacheStatus) DeepCopy() *AIMTemplateCacheStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMTemplateCacheUsage) DeepCopyInto(out *AIMTemplateCacheUsage) {
+	*out = *in
+	if in.CountedServices != nil {
+		in, out := &in.CountedServices, &out.CountedServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMTemplateCacheUsage.
+func (in *AIMTemplateCacheUsage) DeepCopy() *AIMTemplateCacheUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMTemplateCacheUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIMTemplateCachingConfig) DeepCopyInto(out *AIMTemplateCachingConfig) {
 	*out = *in
@@ -2033,6 +4041,118 @@ func (in *AIMTemplateProfile) DeepCopy() *AIMTemplateProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMTemplateSelectionConfig) DeepCopyInto(out *AIMTemplateSelectionConfig) {
+	*out = *in
+	if in.MaxFreeGPUPercent != nil {
+		in, out := &in.MaxFreeGPUPercent, &out.MaxFreeGPUPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SelectionPolicyPlugin != nil {
+		in, out := &in.SelectionPolicyPlugin, &out.SelectionPolicyPlugin
+		*out = new(AIMSelectionPolicyPlugin)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMTemplateSelectionConfig.
+func (in *AIMTemplateSelectionConfig) DeepCopy() *AIMTemplateSelectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMTemplateSelectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMTenancyPolicy) DeepCopyInto(out *AIMTenancyPolicy) {
+	*out = *in
+	if in.AllowedClusterTemplates != nil {
+		in, out := &in.AllowedClusterTemplates, &out.AllowedClusterTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedClusterModels != nil {
+		in, out := &in.AllowedClusterModels, &out.AllowedClusterModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMTenancyPolicy.
+func (in *AIMTenancyPolicy) DeepCopy() *AIMTenancyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMTenancyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMTerminationConfig) DeepCopyInto(out *AIMTerminationConfig) {
+	*out = *in
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DrainSeconds != nil {
+		in, out := &in.DrainSeconds, &out.DrainSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMTerminationConfig.
+func (in *AIMTerminationConfig) DeepCopy() *AIMTerminationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMTerminationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMTopologyStorageClass) DeepCopyInto(out *AIMTopologyStorageClass) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMTopologyStorageClass.
+func (in *AIMTopologyStorageClass) DeepCopy() *AIMTopologyStorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMTopologyStorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMValidationError) DeepCopyInto(out *AIMValidationError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMValidationError.
+func (in *AIMValidationError) DeepCopy() *AIMValidationError {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMValidationError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiscoveryState) DeepCopyInto(out *DiscoveryState) {
 	*out = *in
@@ -2040,6 +4160,24 @@ func (in *DiscoveryState) DeepCopyInto(out *DiscoveryState) {
 		in, out := &in.LastAttemptTime, &out.LastAttemptTime
 		*out = (*in).DeepCopy()
 	}
+	if in.QueuedBehind != nil {
+		in, out := &in.QueuedBehind, &out.QueuedBehind
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveryState.
@@ -0,0 +1,126 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Command aim-conformance runs the AIM conformance suite against a live cluster. It creates a
+// model, waits for discovery, creates a service, verifies its endpoint and deletes everything
+// again, then prints the result of each step and optionally writes a JUnit report.
+//
+// Usage:
+//
+//	aim-conformance run [flags]
+//
+// The cluster is selected with the usual kubeconfig resolution (--kubeconfig, KUBECONFIG,
+// in-cluster). The exit code is non-zero if any step failed.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/conformance"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(aimv1alpha1.AddToScheme(scheme))
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "run" {
+		fmt.Fprintln(os.Stderr, "usage: aim-conformance run [flags]")
+		os.Exit(2)
+	}
+
+	failed, err := run(context.Background(), os.Args[2:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(2)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) (bool, error) {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	var cfg conformance.Config
+	var junitPath string
+	fs.StringVar(&cfg.Namespace, "namespace", "aim-conformance", "Namespace to run in; created and deleted if it does not exist.")
+	fs.StringVar(&cfg.ModelImage, "model-image", conformance.DefaultModelImage, "AIM model image to deploy. Use a small model.")
+	fs.StringVar(&cfg.Name, "name", "aim-conformance", "Name of the created service; the model gets a -model suffix.")
+	fs.DurationVar(&cfg.DiscoveryTimeout, "discovery-timeout", 10*time.Minute, "How long to wait for model discovery.")
+	fs.DurationVar(&cfg.ServiceTimeout, "service-timeout", 20*time.Minute, "How long to wait for the service to run.")
+	fs.DurationVar(&cfg.DeleteTimeout, "delete-timeout", 5*time.Minute, "How long to wait for deleted resources to disappear.")
+	fs.BoolVar(&cfg.KeepResources, "keep", false, "Keep the created resources for inspection.")
+	fs.StringVar(&junitPath, "junit", "", "Write a JUnit report to this file.")
+	_ = fs.Parse(args)
+
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return false, err
+	}
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return false, err
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return false, err
+	}
+
+	startedAt := time.Now()
+	results := conformance.NewSuite(c, clientset, cfg).Run(ctx, func(r conformance.Result) {
+		switch {
+		case r.Skipped:
+			fmt.Printf("SKIP  %s\n", r.Name)
+		case r.Err != nil:
+			fmt.Printf("FAIL  %s (%s): %v\n", r.Name, r.Duration.Round(time.Second), r.Err)
+		default:
+			fmt.Printf("PASS  %s (%s)\n", r.Name, r.Duration.Round(time.Second))
+		}
+	})
+
+	if junitPath != "" {
+		f, err := os.Create(junitPath)
+		if err != nil {
+			return false, err
+		}
+		defer func() { _ = f.Close() }()
+		if err := conformance.WriteJUnit(f, "aim-conformance", startedAt, results); err != nil {
+			return false, err
+		}
+	}
+	return conformance.Failed(results), nil
+}
@@ -0,0 +1,230 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Command aim-snapshot exports AIM resources from a cluster into a portable bundle and
+// imports a bundle into another cluster, for disaster recovery and cluster upgrades.
+//
+// Usage:
+//
+//	aim-snapshot export [flags] > bundle.yaml
+//	aim-snapshot import [flags] bundle.yaml
+//
+// The bundle is a v1 List, so its specs can also be applied with kubectl. The cluster is
+// selected with the usual kubeconfig resolution (--kubeconfig, KUBECONFIG, in-cluster).
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/snapshot"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(aimv1alpha1.AddToScheme(scheme))
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (s *stringList) String() string     { return strings.Join(*s, ",") }
+func (s *stringList) Set(v string) error { *s = append(*s, v); return nil }
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(context.Background(), os.Args[2:])
+	case "import":
+		err = runImport(context.Background(), os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aim-snapshot export [flags] > bundle.yaml")
+	fmt.Fprintln(os.Stderr, "       aim-snapshot import [flags] bundle.yaml")
+	os.Exit(2)
+}
+
+func newClient() (client.Client, error) {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{Scheme: scheme})
+}
+
+func runExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var namespaces stringList
+	var output string
+	var opts snapshot.ExportOptions
+	fs.Var(&namespaces, "namespace", "Only export namespaced resources from this namespace. Repeatable; default is all namespaces.")
+	fs.BoolVar(&opts.IncludeStatus, "include-status", false, "Include resource status, e.g. discovery results of templates.")
+	fs.BoolVar(&opts.IncludeOwned, "include-owned", false, "Include resources created by the operator for other AIM resources.")
+	fs.StringVar(&output, "output", "", "Write the bundle to this file instead of stdout.")
+	_ = fs.Parse(args)
+	opts.Namespaces = namespaces
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	bundle, err := snapshot.Export(ctx, c, opts, time.Now())
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(bundle.UnstructuredContent())
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(output, data, 0o600); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "exported %d resources to %s\n", len(bundle.Items), output)
+	return nil
+}
+
+func runImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	var mappings stringList
+	var opts snapshot.ImportOptions
+	fs.Var(&mappings, "namespace-map", "Rename a namespace on import, as source=target. Repeatable.")
+	fs.BoolVar(&opts.RestoreStatus, "restore-status", false, "Restore exported status through the status subresource.")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "Validate the import server-side without persisting anything.")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		usage()
+	}
+	namespaceMap, err := parseNamespaceMap(mappings)
+	if err != nil {
+		return err
+	}
+	opts.NamespaceMap = namespaceMap
+
+	bundle, err := readBundle(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range snapshot.Import(ctx, c, bundle, opts) {
+		if result.Error != nil {
+			failed++
+			fmt.Printf("%s: %s: %v\n", result.Object, result.Action, result.Error)
+			continue
+		}
+		fmt.Printf("%s: %s\n", result.Object, result.Action)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d resources failed to import", failed, len(bundle.Items))
+	}
+	return nil
+}
+
+func parseNamespaceMap(mappings []string) (map[string]string, error) {
+	result := make(map[string]string, len(mappings))
+	for _, m := range mappings {
+		source, target, ok := strings.Cut(m, "=")
+		if !ok || source == "" || target == "" {
+			return nil, fmt.Errorf("invalid namespace mapping %q, expected source=target", m)
+		}
+		result[source] = target
+	}
+	return result, nil
+}
+
+// readBundle reads a bundle file. Lists are flattened, so plain multi-document YAML
+// (e.g. from kubectl get -o yaml) is accepted as well.
+func readBundle(path string) (*unstructured.UnstructuredList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	bundle := &unstructured.UnstructuredList{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(f), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.IsList() {
+			if err := obj.EachListItem(func(item runtime.Object) error {
+				bundle.Items = append(bundle.Items, *item.(*unstructured.Unstructured))
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		bundle.Items = append(bundle.Items, *obj)
+	}
+	return bundle, nil
+}
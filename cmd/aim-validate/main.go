@@ -0,0 +1,410 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Command aim-validate validates AIM manifests offline and simulates how the operator would
+// resolve each AIMService, printing the model and template selection explanation.
+//
+// Manifests are validated against the CRD schemas (defaults, OpenAPI and CEL rules), then
+// loaded together with an optional cluster snapshot (nodes, models, templates, runtime configs)
+// into an in-memory client, and the AIMService reconcile phases are run against it without
+// applying anything. The exit code is non-zero if any manifest is invalid or a service cannot
+// be resolved, so it can gate deployment manifests in CI.
+//
+// Usage:
+//
+//	aim-validate [flags] manifest.yaml [manifest.yaml ...]
+//	kubectl get nodes,aimclustermodels,aimclusterservicetemplates -o yaml > snapshot.yaml
+//	aim-validate --snapshot snapshot.yaml services/*.yaml
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	kservev1alpha1 "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservice"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(aimv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(gatewayapiv1.Install(scheme))
+	utilruntime.Must(kservev1alpha1.AddToScheme(scheme))
+	utilruntime.Must(kservev1beta1.AddToScheme(scheme))
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (s *stringList) String() string     { return strings.Join(*s, ",") }
+func (s *stringList) Set(v string) error { *s = append(*s, v); return nil }
+
+// Report is the machine-readable output of a validation run.
+type Report struct {
+	Objects  []ObjectReport  `json:"objects"`
+	Services []ServiceReport `json:"services"`
+	Valid    bool            `json:"valid"`
+}
+
+// ObjectReport holds the schema validation result for one manifest object.
+type ObjectReport struct {
+	Object string   `json:"object"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ServiceReport holds the simulated resolution of one AIMService.
+type ServiceReport struct {
+	Service          string                                   `json:"service"`
+	Model            string                                   `json:"model,omitempty"`
+	Template         string                                   `json:"template,omitempty"`
+	SelectionReason  string                                   `json:"selectionReason,omitempty"`
+	SelectionMessage string                                   `json:"selectionMessage,omitempty"`
+	Candidates       []aimv1alpha1.AIMTemplateCandidateResult `json:"candidates,omitempty"`
+	Health           []HealthReport                           `json:"health,omitempty"`
+	Planned          []string                                 `json:"planned,omitempty"`
+	Problems         []string                                 `json:"problems,omitempty"`
+}
+
+// HealthReport is a printable ComponentHealth.
+type HealthReport struct {
+	Component string `json:"component"`
+	State     string `json:"state"`
+	Reason    string `json:"reason,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+func main() {
+	var snapshots stringList
+	var crdDir, namespace, output string
+
+	flag.Var(&snapshots, "snapshot", "Cluster snapshot file (nodes, models, templates, runtime configs). Repeatable.")
+	flag.StringVar(&crdDir, "crd-dir", "config/crd/bases", "Directory with AIM CRD manifests used for schema validation.")
+	flag.StringVar(&namespace, "namespace", "default", "Namespace for namespaced manifests that do not set one.")
+	flag.StringVar(&output, "output", "text", "Output format: text or json.")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: aim-validate [flags] manifest.yaml [manifest.yaml ...]")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	report, err := run(context.Background(), flag.Args(), snapshots, crdDir, namespace)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(2)
+	}
+
+	switch output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(2)
+		}
+	case "text":
+		printReport(os.Stdout, report)
+	default:
+		fmt.Fprintf(os.Stderr, "error: unknown output format %q\n", output)
+		os.Exit(2)
+	}
+
+	if !report.Valid {
+		os.Exit(1)
+	}
+}
+
+// run validates the manifests and simulates every AIMService among them.
+func run(ctx context.Context, manifests, snapshots []string, crdDir, namespace string) (*Report, error) {
+	validators, err := loadCRDValidators(crdDir)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Valid: true}
+	var objects []client.Object
+	var services []*aimv1alpha1.AIMService
+
+	// Validate and decode manifests
+	for _, path := range manifests {
+		docs, err := readDocuments(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			setDefaultNamespace(doc, namespace)
+			objReport := ObjectReport{Object: objectRef(doc.GetKind(), doc.GetNamespace(), doc.GetName())}
+
+			if validator, ok := validators[doc.GroupVersionKind()]; ok {
+				for _, fieldErr := range validator.validate(ctx, doc) {
+					objReport.Errors = append(objReport.Errors, fieldErr.Error())
+				}
+			}
+
+			obj, err := toTypedObject(doc)
+			if err != nil {
+				objReport.Errors = append(objReport.Errors, err.Error())
+			}
+			if len(objReport.Errors) > 0 {
+				report.Valid = false
+			}
+			report.Objects = append(report.Objects, objReport)
+
+			if obj == nil {
+				continue
+			}
+			objects = append(objects, obj)
+			if svc, ok := obj.(*aimv1alpha1.AIMService); ok && len(objReport.Errors) == 0 {
+				services = append(services, svc)
+			}
+		}
+	}
+
+	// Snapshot objects represent live cluster state and are loaded as-is
+	for _, path := range snapshots {
+		docs, err := readDocuments(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			obj, err := toTypedObject(doc)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			if obj != nil {
+				objects = append(objects, obj)
+			}
+		}
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithIndex(&corev1.Event{}, "involvedObject.name", func(obj client.Object) []string {
+			return []string{obj.(*corev1.Event).InvolvedObject.Name}
+		}).
+		// Services count toward their template's maxConcurrentServices
+		WithIndex(&aimv1alpha1.AIMService{}, aimv1alpha1.AIMServiceResolvedTemplateIndexKey, func(obj client.Object) []string {
+			if ref := obj.(*aimv1alpha1.AIMService).Status.ResolvedTemplate; ref != nil && ref.Name != "" {
+				return []string{ref.Name}
+			}
+			return nil
+		}).
+		Build()
+	clientset := kubefake.NewClientset()
+
+	for _, svc := range services {
+		serviceReport := simulateService(ctx, c, clientset, svc)
+		if len(serviceReport.Problems) > 0 {
+			report.Valid = false
+		}
+		report.Services = append(report.Services, serviceReport)
+	}
+
+	return report, nil
+}
+
+// simulateService runs the AIMService reconcile phases and summarizes the outcome.
+func simulateService(ctx context.Context, c client.Client, clientset *kubefake.Clientset, svc *aimv1alpha1.AIMService) ServiceReport {
+	result := aimservice.Simulate(ctx, c, clientset, svc)
+	report := ServiceReport{Service: objectRef("AIMService", svc.Namespace, svc.Name)}
+
+	switch {
+	case result.Model != nil:
+		report.Model = objectRef("AIMModel", result.Model.Namespace, result.Model.Name)
+	case result.ClusterModel != nil:
+		report.Model = objectRef("AIMClusterModel", "", result.ClusterModel.Name)
+	case result.PendingModelName != "":
+		report.Model = objectRef("AIMModel", svc.Namespace, result.PendingModelName) + " (would be created)"
+	}
+
+	switch {
+	case result.Template != nil:
+		report.Template = objectRef("AIMServiceTemplate", result.Template.Namespace, result.Template.Name)
+	case result.ClusterTemplate != nil:
+		report.Template = objectRef("AIMClusterServiceTemplate", "", result.ClusterTemplate.Name)
+	}
+
+	if sel := result.Selection; sel != nil {
+		report.SelectionReason = sel.SelectionReason
+		report.SelectionMessage = sel.SelectionMessage
+		report.Candidates = sel.MatchingResults
+	}
+
+	for _, health := range result.Health {
+		if health.Component == "" {
+			continue
+		}
+		h := HealthReport{
+			Component: health.Component,
+			State:     string(health.State),
+			Reason:    health.Reason,
+			Message:   health.Message,
+		}
+		if len(health.Errors) > 0 {
+			categorized := controllerutils.CategorizeError(health.Errors[0])
+			if h.State == "" {
+				h.State = string(controllerutils.DeriveStateFromErrors(health.Errors))
+			}
+			if h.Reason == "" {
+				h.Reason = categorized.Reason()
+			}
+			if h.Message == "" {
+				h.Message = categorized.UserMessage()
+			}
+		}
+		if h.State == string(constants.AIMStatusFailed) {
+			report.Problems = append(report.Problems, fmt.Sprintf("%s: %s %s", h.Component, h.Reason, h.Message))
+		}
+		report.Health = append(report.Health, h)
+	}
+
+	// A pending model is resolved by discovery at runtime, which cannot be simulated offline
+	if report.Template == "" && result.PendingModelName == "" {
+		problem := "no template resolved"
+		if report.SelectionMessage != "" {
+			problem += ": " + report.SelectionMessage
+		}
+		report.Problems = append(report.Problems, problem)
+	}
+
+	for _, obj := range result.Planned {
+		gvk, err := c.GroupVersionKindFor(obj)
+		kind := gvk.Kind
+		if err != nil {
+			kind = fmt.Sprintf("%T", obj)
+		}
+		report.Planned = append(report.Planned, objectRef(kind, obj.GetNamespace(), obj.GetName()))
+	}
+
+	return report
+}
+
+// setDefaultNamespace sets the namespace of namespaced AIM and core objects that omit it.
+func setDefaultNamespace(obj *unstructured.Unstructured, namespace string) {
+	if obj.GetNamespace() != "" {
+		return
+	}
+	switch obj.GetKind() {
+	case "AIMService", "AIMModel", "AIMServiceTemplate", "AIMRuntimeConfig", "AIMTemplateCache", "AIMArtifact",
+		"Secret", "ConfigMap":
+		obj.SetNamespace(namespace)
+	}
+}
+
+// toTypedObject converts an unstructured object into its registered Go type.
+func toTypedObject(obj *unstructured.Unstructured) (client.Object, error) {
+	typed, err := scheme.New(obj.GroupVersionKind())
+	if err != nil {
+		return nil, fmt.Errorf("unsupported kind %s: %w", obj.GroupVersionKind(), err)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typed); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", obj.GetKind(), err)
+	}
+	clientObj, ok := typed.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("kind %s is not a Kubernetes object", obj.GetKind())
+	}
+	return clientObj, nil
+}
+
+// printReport writes a human-readable report.
+func printReport(w io.Writer, report *Report) {
+	for _, obj := range report.Objects {
+		if len(obj.Errors) == 0 {
+			_, _ = fmt.Fprintf(w, "✓ %s\n", obj.Object)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "✗ %s\n", obj.Object)
+		for _, e := range obj.Errors {
+			_, _ = fmt.Fprintf(w, "    %s\n", e)
+		}
+	}
+
+	for _, svc := range report.Services {
+		_, _ = fmt.Fprintf(w, "\n%s\n", svc.Service)
+		_, _ = fmt.Fprintf(w, "  Model:    %s\n", valueOr(svc.Model, "<unresolved>"))
+		_, _ = fmt.Fprintf(w, "  Template: %s\n", valueOr(svc.Template, "<unresolved>"))
+		if svc.SelectionReason != "" || svc.SelectionMessage != "" {
+			_, _ = fmt.Fprintf(w, "  Selection: %s %s\n", svc.SelectionReason, svc.SelectionMessage)
+		}
+		if len(svc.Candidates) > 0 {
+			_, _ = fmt.Fprintln(w, "  Candidates:")
+			for _, cand := range svc.Candidates {
+				_, _ = fmt.Fprintf(w, "    - %s: %s (%s)\n", cand.Name, cand.Status, cand.Reason)
+				if cand.Message != "" {
+					_, _ = fmt.Fprintf(w, "      %s\n", cand.Message)
+				}
+			}
+		}
+		if len(svc.Health) > 0 {
+			_, _ = fmt.Fprintln(w, "  Health:")
+			for _, h := range svc.Health {
+				_, _ = fmt.Fprintf(w, "    - %s: %s %s %s\n", h.Component, valueOr(h.State, "Unknown"), h.Reason, h.Message)
+			}
+		}
+		if len(svc.Planned) > 0 {
+			_, _ = fmt.Fprintln(w, "  Would apply:")
+			for _, p := range svc.Planned {
+				_, _ = fmt.Fprintf(w, "    - %s\n", p)
+			}
+		}
+		for _, p := range svc.Problems {
+			_, _ = fmt.Fprintf(w, "  ✗ %s\n", p)
+		}
+	}
+
+	if report.Valid {
+		_, _ = fmt.Fprintln(w, "\nvalidation passed")
+	} else {
+		_, _ = fmt.Fprintln(w, "\nvalidation failed")
+	}
+}
+
+func valueOr(v, fallback string) string {
+	if v == "" {
+		return fallback
+	}
+	return v
+}
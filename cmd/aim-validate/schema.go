@@ -0,0 +1,177 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apiextensionsinternal "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+)
+
+// crdValidator validates custom resources of a single version against the CRD schema,
+// mirroring what the API server does on create: defaulting, OpenAPI schema and CEL rules.
+type crdValidator struct {
+	schemaValidator apiservervalidation.SchemaValidator
+	structural      *structuralschema.Structural
+	celValidator    *cel.Validator
+}
+
+// loadCRDValidators reads all CRD manifests in dir and builds a validator per served version.
+func loadCRDValidators(dir string) (map[schema.GroupVersionKind]*crdValidator, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no CRD manifests found in %s", dir)
+	}
+
+	validators := make(map[schema.GroupVersionKind]*crdValidator)
+	for _, file := range files {
+		docs, err := readDocuments(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			if doc.GetKind() != "CustomResourceDefinition" {
+				continue
+			}
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(doc.Object, crd); err != nil {
+				return nil, fmt.Errorf("%s: failed to decode CRD: %w", file, err)
+			}
+			for _, version := range crd.Spec.Versions {
+				if !version.Served || version.Schema == nil {
+					continue
+				}
+				validator, err := newCRDValidator(version.Schema)
+				if err != nil {
+					return nil, fmt.Errorf("%s: version %s: %w", crd.Name, version.Name, err)
+				}
+				gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.Kind}
+				validators[gvk] = validator
+			}
+		}
+	}
+	return validators, nil
+}
+
+func newCRDValidator(validation *apiextensionsv1.CustomResourceValidation) (*crdValidator, error) {
+	internal := &apiextensionsinternal.CustomResourceValidation{}
+	if err := apiextensionsv1.Convert_v1_CustomResourceValidation_To_apiextensions_CustomResourceValidation(validation, internal, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert schema: %w", err)
+	}
+
+	schemaValidator, _, err := apiservervalidation.NewSchemaValidator(internal.OpenAPIV3Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build schema validator: %w", err)
+	}
+	structural, err := structuralschema.NewStructural(internal.OpenAPIV3Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build structural schema: %w", err)
+	}
+
+	return &crdValidator{
+		schemaValidator: schemaValidator,
+		structural:      structural,
+		celValidator:    cel.NewValidator(structural, true, celconfig.PerCallLimit),
+	}, nil
+}
+
+// validate applies schema defaults to obj in place and returns all schema and CEL violations.
+func (v *crdValidator) validate(ctx context.Context, obj *unstructured.Unstructured) field.ErrorList {
+	structuraldefaulting.Default(obj.Object, v.structural)
+
+	errs := apiservervalidation.ValidateCustomResource(nil, obj.Object, v.schemaValidator)
+	celErrs, _ := v.celValidator.Validate(ctx, nil, v.structural, obj.Object, nil, celconfig.RuntimeCELCostBudget)
+	return append(errs, celErrs...)
+}
+
+// readDocuments decodes all YAML or JSON documents in a file, expanding `kind: List` documents.
+// A path of "-" reads from stdin.
+func readDocuments(path string) ([]*unstructured.Unstructured, error) {
+	var reader io.Reader
+	if path == "-" {
+		reader = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		reader = f
+	}
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(reader), 4096)
+	var docs []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			for i := range list.Items {
+				docs = append(docs, &list.Items[i])
+			}
+			continue
+		}
+		docs = append(docs, obj)
+	}
+	return docs, nil
+}
+
+// objectRef formats an object as Kind namespace/name for output.
+func objectRef(kind, namespace, name string) string {
+	if namespace == "" {
+		return kind + " " + name
+	}
+	return kind + " " + strings.Join([]string{namespace, name}, "/")
+}
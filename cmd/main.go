@@ -23,14 +23,23 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
+	"strings"
+	"time"
 
 	"go.uber.org/zap/zapcore"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservice"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservicetemplate"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimtemplatecache"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 	"github.com/amd-enterprise-ai/aim-engine/internal/controller"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	webhookv1alpha1 "github.com/amd-enterprise-ai/aim-engine/internal/webhook/v1alpha1"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -38,12 +47,21 @@ import (
 
 	kservev1alpha1 "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -63,6 +81,9 @@ func init() {
 
 	utilruntime.Must(aimv1alpha1.AddToScheme(scheme))
 
+	// Installed CRDs are read at startup to check them against the compiled API types
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+
 	// Register Gateway API schemes
 	utilruntime.Must(gatewayapiv1.Install(scheme))
 
@@ -78,10 +99,29 @@ func main() {
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
+	var enableWebhooks bool
 	var enableLeaderElection bool
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var cacheSyncPeriod, cacheSyncTimeout time.Duration
+	var cacheFilterWorkloads bool
+	var watchNamespaces string
+	var crdSchemaCheck string
+	var rbacAudit bool
+	var workqueueSLO controllerutils.WorkqueueSLO
+	var statusUpdateDebounce time.Duration
+	var statusWriteBatchWindow time.Duration
+	var kubeAPIQPS float64
+	var kubeAPIBurst int
+	var adaptiveThrottling bool
+	workqueueRateLimits := controllerutils.DefaultWorkqueueRateLimits()
+	var workqueueRateLimitOverrides string
+	var startupGateTimeout time.Duration
+	var circuitBreakerFailures int
+	var circuitBreakerWindow, circuitBreakerBackoff time.Duration
+	var startupGateKServe, startupGateGPUOperator string
+	var kserveNamespace string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -94,12 +134,80 @@ func main() {
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook certificate file.")
 	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, runtime configs are validated by admission webhooks. Requires the webhook certificate "+
+			"and the ValidatingWebhookConfiguration to be installed.")
 	flag.StringVar(&metricsCertPath, "metrics-cert-path", "",
 		"The directory that contains the metrics server certificate.")
 	flag.StringVar(&metricsCertName, "metrics-cert-name", "tls.crt", "The name of the metrics server certificate file.")
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.DurationVar(&cacheSyncPeriod, "cache-sync-period", 10*time.Hour,
+		"How often the informer caches resync, which reconciles every watched object again.")
+	flag.DurationVar(&cacheSyncTimeout, "cache-sync-timeout", 2*time.Minute,
+		"How long controllers wait for their caches to fill at startup before the manager fails.")
+	flag.BoolVar(&cacheFilterWorkloads, "cache-filter-workloads", false,
+		"If set, only Pods and Jobs labeled "+constants.LabelKeyManagedBy+"="+constants.LabelValueManagedBy+
+			" are cached, which bounds memory on clusters with many unrelated pods.")
+	flag.StringVar(&watchNamespaces, "watch-namespaces", "",
+		"Comma-separated namespaces to watch. If set, the operator runs in namespaced-only mode: "+
+			"cluster-scoped AIM resources are neither reconciled nor read, so no cluster RBAC is needed "+
+			"besides read access to nodes.")
+	flag.StringVar(&crdSchemaCheck, "crd-schema-check", string(controllerutils.CRDSchemaCheckEnforce),
+		"What to do when the installed CRDs lack fields of the operator's API types, for example after a "+
+			"partial upgrade: 'enforce' refuses to start, 'warn' logs and starts anyway, 'off' skips the check.")
+	flag.BoolVar(&rbacAudit, "rbac-audit", true,
+		"If set, the operator checks at startup that it holds the permissions it needs, logs and exports "+
+			"the missing ones, and reports not ready while required permissions are missing.")
+	flag.DurationVar(&workqueueSLO.MaxOldestItemAge, "workqueue-slo-max-age", 5*time.Minute,
+		"The longest a request may wait in a controller workqueue before it counts against the SLO. "+
+			"Set to 0 to disable SLO alerting; queue depth and age are recorded either way.")
+	flag.DurationVar(&workqueueSLO.BreachDuration, "workqueue-slo-breach-duration", 5*time.Minute,
+		"How long --workqueue-slo-max-age must be exceeded before the breach is logged and set as a "+
+			"condition on the operator pod.")
+	flag.DurationVar(&statusUpdateDebounce, "status-update-debounce", 2*time.Second,
+		"Collapse status-only updates of InferenceServices, predictor pods and their events into one "+
+			"AIMService reconcile within this window. Set to 0 to reconcile on every update.")
+	flag.DurationVar(&statusWriteBatchWindow, "status-write-batch-window", 500*time.Millisecond,
+		"Write status asynchronously, coalescing the status updates of an object within this window into "+
+			"one write. Set to 0 to write status synchronously at the end of every reconcile.")
+	flag.Float64Var(&kubeAPIQPS, "kube-api-qps", -1,
+		"The sustained rate of requests to the API server. A negative value disables client-side rate "+
+			"limiting and relies on API priority and fairness.")
+	flag.IntVar(&kubeAPIBurst, "kube-api-burst", 30,
+		"The number of requests to the API server allowed at once above --kube-api-qps.")
+	flag.BoolVar(&adaptiveThrottling, "kube-api-adaptive-throttling", false,
+		"If set, halve the request rate whenever the API server answers 429 Too Many Requests, down to a "+
+			"tenth of --kube-api-qps, and restore it gradually once throttling stops. Requires --kube-api-qps.")
+	flag.DurationVar(&workqueueRateLimits.BaseDelay, "workqueue-base-delay", workqueueRateLimits.BaseDelay,
+		"The requeue delay after the first failed reconcile of an object. It doubles with each further failure.")
+	flag.DurationVar(&workqueueRateLimits.MaxDelay, "workqueue-max-delay", workqueueRateLimits.MaxDelay,
+		"The longest requeue delay after repeated failed reconciles of an object.")
+	flag.Float64Var(&workqueueRateLimits.Bucket.QPS, "workqueue-qps", workqueueRateLimits.Bucket.QPS,
+		"The sustained rate of requeues of each controller, across all its objects.")
+	flag.IntVar(&workqueueRateLimits.Bucket.Burst, "workqueue-burst", workqueueRateLimits.Bucket.Burst,
+		"The number of requeues of each controller allowed at once above --workqueue-qps.")
+	flag.StringVar(&workqueueRateLimitOverrides, "workqueue-rate-limits", "",
+		"Comma-separated per-controller overrides of --workqueue-qps and --workqueue-burst, as "+
+			"controller=qps:burst pairs, for example 'service=20:200,artifact=5:50'.")
+	flag.IntVar(&circuitBreakerFailures, "circuit-breaker-failures", 0,
+		"Number of failed reconciles of a resource within --circuit-breaker-window after which it is only "+
+			"retried every --circuit-breaker-backoff. 0 disables the circuit breaker.")
+	flag.DurationVar(&circuitBreakerWindow, "circuit-breaker-window", 5*time.Minute,
+		"How far back failed reconciles of a resource are counted by the circuit breaker.")
+	flag.DurationVar(&circuitBreakerBackoff, "circuit-breaker-backoff", 10*time.Minute,
+		"How long reconciles of a resource are held back once its circuit breaker opened.")
+	flag.DurationVar(&startupGateTimeout, "startup-gate-timeout", 0,
+		"How long the controllers wait for KServe and the GPU operator to be available before they start "+
+			"processing. The controllers start anyway once it expires. 0 disables the wait.")
+	flag.StringVar(&startupGateKServe, "startup-gate-kserve", "kserve/kserve-controller-manager",
+		"The namespace/name of the KServe controller deployment the startup gate waits for. Empty to skip.")
+	flag.StringVar(&startupGateGPUOperator, "startup-gate-gpu-operator",
+		"kube-amd-gpu/amd-gpu-operator-gpu-operator-charts-controller-manager",
+		"The namespace/name of the AMD GPU operator deployment the startup gate waits for. Empty to skip.")
+	flag.StringVar(&kserveNamespace, "kserve-namespace", aimservice.DefaultKServeNamespace,
+		"The namespace of the KServe inferenceservice-config ConfigMap the default deployment mode is read from.")
 	opts := zap.Options{
 		Development: false,
 		// Disable stack traces for errors - they're noisy for expected infrastructure errors.
@@ -111,6 +219,12 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	namespaces := parseNamespaces(watchNamespaces)
+	namespacedOnly := len(namespaces) > 0
+	if namespacedOnly {
+		setupLog.Info("running in namespaced-only mode", "namespaces", namespaces)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -178,11 +292,33 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	overrides, err := controllerutils.ParseWorkqueueBucketOverrides(workqueueRateLimitOverrides)
+	if err != nil {
+		setupLog.Error(err, "invalid --workqueue-rate-limits")
+		os.Exit(1)
+	}
+	workqueueRateLimits.Overrides = overrides
+
+	restConfig := ctrl.GetConfigOrDie()
+	restConfig.QPS = float32(kubeAPIQPS)
+	restConfig.Burst = kubeAPIBurst
+	if adaptiveThrottling {
+		if kubeAPIQPS <= 0 {
+			setupLog.Error(nil, "--kube-api-adaptive-throttling requires a positive --kube-api-qps")
+			os.Exit(1)
+		}
+		// Shared by every client built from the config, so throttling slows down all of them
+		ctrlmetrics.Registry.MustRegister(controllerutils.ClientQPSLimit, controllerutils.ClientThrottledRequests)
+		controllerutils.NewAdaptiveRateLimiter(restConfig.QPS, restConfig.Burst).Configure(restConfig)
+	}
+
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
+		Cache:                  cacheOptions(cacheSyncPeriod, cacheFilterWorkloads, namespaces),
+		Controller:             config.Controller{CacheSyncTimeout: cacheSyncTimeout},
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "3be10d2f.eai.amd.com",
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
@@ -202,6 +338,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Refuse to run against CRDs that would prune fields this version writes
+	ctrlmetrics.Registry.MustRegister(controllerutils.CRDSchemaMissingFields)
+	if !checkCRDSchemas(mgr, controllerutils.CRDSchemaCheckMode(crdSchemaCheck), namespacedOnly) {
+		os.Exit(1)
+	}
+
 	// Create Kubernetes clientset for controllers that need direct API access (e.g., registry operations)
 	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
 	if err != nil {
@@ -209,79 +351,224 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := (&controller.AIMClusterModelReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Clientset: clientset,
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AIMClusterModel")
+	// Record workqueue depth and age, and report sustained SLO breaches on the operator pod
+	ctrlmetrics.Registry.MustRegister(
+		controllerutils.WorkqueueDepth,
+		controllerutils.WorkqueueOldestItemAge,
+		controllerutils.WorkqueueSLOBreached,
+	)
+	workqueueMonitor := controllerutils.NewWorkqueueMonitor(workqueueSLO, &workqueueRateLimits,
+		controllerutils.NewPodConditionReporter(mgr.GetAPIReader(), mgr.GetClient()))
+	if err := mgr.Add(workqueueMonitor); err != nil {
+		setupLog.Error(err, "unable to set up workqueue monitor")
 		os.Exit(1)
 	}
-	if err := (&controller.AIMModelReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Clientset: clientset,
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AIMModel")
+
+	// Report missing permissions up front instead of as Forbidden errors in the middle of a reconcile
+	var rbacAuditor *controllerutils.RBACAudit
+	if rbacAudit {
+		ctrlmetrics.Registry.MustRegister(controllerutils.RBACMissingPermissions)
+		rbacAuditor = controllerutils.NewRBACAudit(clientset, namespaces, constants.GetOperatorNamespace())
+		if err := mgr.Add(rbacAuditor); err != nil {
+			setupLog.Error(err, "unable to set up RBAC audit")
+			os.Exit(1)
+		}
+	}
+
+	// Hold back the controllers on cold cluster boots until KServe and the GPU operator are up
+	if startupGateTimeout > 0 {
+		startupGate, err := newStartupGate(clientset, startupGateTimeout, startupGateKServe, startupGateGPUOperator)
+		if err != nil {
+			setupLog.Error(err, "invalid startup gate")
+			os.Exit(1)
+		}
+		workqueueMonitor.SetStartupGate(startupGate)
+		if err := mgr.Add(startupGate); err != nil {
+			setupLog.Error(err, "unable to set up startup gate")
+			os.Exit(1)
+		}
+	}
+
+	// Let incident responders pause apply and delete of a controller without stopping the operator
+	pauseSwitch := controllerutils.NewPauseSwitch(clientset, constants.GetOperatorNamespace())
+	if err := mgr.Add(pauseSwitch); err != nil {
+		setupLog.Error(err, "unable to set up pause switch")
 		os.Exit(1)
 	}
 
-	// Setup AIMClusterModelSource controller
-	if err = (&controller.AIMClusterModelSourceReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Clientset: clientset,
+	// Coalesce status writes of objects that are reconciled repeatedly, e.g. in error loops
+	var statusBatcher *controllerutils.StatusBatcher
+	if statusWriteBatchWindow > 0 {
+		ctrlmetrics.Registry.MustRegister(controllerutils.StatusWrites, controllerutils.StatusWritesCoalesced)
+		statusBatcher = controllerutils.NewStatusBatcher(mgr.GetClient().Status(), statusWriteBatchWindow)
+		if err := mgr.Add(statusBatcher); err != nil {
+			setupLog.Error(err, "unable to set up status batcher")
+			os.Exit(1)
+		}
+	}
+
+	// Back off sharply from resources that keep failing, to protect the API server quota
+	circuitBreaker := controllerutils.NewCircuitBreaker(circuitBreakerFailures, circuitBreakerWindow, circuitBreakerBackoff)
+
+	// In namespaced-only mode, cluster-scoped resources are hidden from the namespaced controllers
+	k8sClient := mgr.GetClient()
+	if namespacedOnly {
+		k8sClient = controllerutils.NewNamespacedOnlyClient(k8sClient)
+	}
+
+	if !namespacedOnly {
+		if err := (&controller.AIMClusterModelReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			Clientset:        clientset,
+			WorkqueueMonitor: workqueueMonitor,
+			PauseSwitch:      pauseSwitch,
+			StatusBatcher:    statusBatcher,
+			CircuitBreaker:   circuitBreaker,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AIMClusterModel")
+			os.Exit(1)
+		}
+
+		// Setup AIMClusterModelSource controller
+		if err = (&controller.AIMClusterModelSourceReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			Clientset:        clientset,
+			WorkqueueMonitor: workqueueMonitor,
+			PauseSwitch:      pauseSwitch,
+			StatusBatcher:    statusBatcher,
+			CircuitBreaker:   circuitBreaker,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AIMClusterModelSource")
+			os.Exit(1)
+		}
+
+		// Setup AIMClusterModelCache controller
+		if err = (&controller.AIMClusterModelCacheReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			WorkqueueMonitor: workqueueMonitor,
+			PauseSwitch:      pauseSwitch,
+			StatusBatcher:    statusBatcher,
+			CircuitBreaker:   circuitBreaker,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AIMClusterModelCache")
+			os.Exit(1)
+		}
+
+		if err := (&controller.AIMClusterServiceTemplateReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			Clientset:        clientset,
+			WorkqueueMonitor: workqueueMonitor,
+			PauseSwitch:      pauseSwitch,
+			StatusBatcher:    statusBatcher,
+			CircuitBreaker:   circuitBreaker,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AIMClusterServiceTemplate")
+			os.Exit(1)
+		}
+	}
+
+	if err := (&controller.AIMModelReconciler{
+		Client:           k8sClient,
+		Scheme:           mgr.GetScheme(),
+		Clientset:        clientset,
+		NamespacedOnly:   namespacedOnly,
+		WorkqueueMonitor: workqueueMonitor,
+		PauseSwitch:      pauseSwitch,
+		StatusBatcher:    statusBatcher,
+		CircuitBreaker:   circuitBreaker,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AIMClusterModelSource")
+		setupLog.Error(err, "unable to create controller", "controller", "AIMModel")
 		os.Exit(1)
 	}
 
 	if err := (&controller.AIMArtifactReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Clientset: clientset,
+		Client:           k8sClient,
+		Scheme:           mgr.GetScheme(),
+		Clientset:        clientset,
+		WorkqueueMonitor: workqueueMonitor,
+		PauseSwitch:      pauseSwitch,
+		StatusBatcher:    statusBatcher,
+		CircuitBreaker:   circuitBreaker,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AIMArtifact")
 		os.Exit(1)
 	}
 
 	if err := (&controller.AIMTemplateCacheReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Clientset: clientset,
+		Client:           k8sClient,
+		Scheme:           mgr.GetScheme(),
+		Clientset:        clientset,
+		NamespacedOnly:   namespacedOnly,
+		WorkqueueMonitor: workqueueMonitor,
+		PauseSwitch:      pauseSwitch,
+		StatusBatcher:    statusBatcher,
+		CircuitBreaker:   circuitBreaker,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AIMTemplateCache")
 		os.Exit(1)
 	}
 
 	if err := (&controller.AIMServiceTemplateReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Clientset: clientset,
+		Client:           k8sClient,
+		Scheme:           mgr.GetScheme(),
+		Clientset:        clientset,
+		NamespacedOnly:   namespacedOnly,
+		WorkqueueMonitor: workqueueMonitor,
+		PauseSwitch:      pauseSwitch,
+		StatusBatcher:    statusBatcher,
+		CircuitBreaker:   circuitBreaker,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AIMServiceTemplate")
 		os.Exit(1)
 	}
 
-	if err := (&controller.AIMClusterServiceTemplateReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Clientset: clientset,
+	if err := (&controller.AIMServiceReconciler{
+		Client:               k8sClient,
+		Scheme:               mgr.GetScheme(),
+		Clientset:            clientset,
+		APIReader:            controllerutils.NewAPIReader(mgr.GetAPIReader()),
+		NamespacedOnly:       namespacedOnly,
+		KServeNamespace:      kserveNamespace,
+		WorkqueueMonitor:     workqueueMonitor,
+		PauseSwitch:          pauseSwitch,
+		StatusBatcher:        statusBatcher,
+		CircuitBreaker:       circuitBreaker,
+		StatusUpdateDebounce: statusUpdateDebounce,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AIMClusterServiceTemplate")
+		setupLog.Error(err, "unable to create controller", "controller", "AIMService")
 		os.Exit(1)
 	}
 
-	if err := (&controller.AIMServiceReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Clientset: clientset,
+	if err := (&controller.AIMBatchJobReconciler{
+		Client:           k8sClient,
+		Scheme:           mgr.GetScheme(),
+		NamespacedOnly:   namespacedOnly,
+		WorkqueueMonitor: workqueueMonitor,
+		PauseSwitch:      pauseSwitch,
+		StatusBatcher:    statusBatcher,
+		CircuitBreaker:   circuitBreaker,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "AIMService")
+		setupLog.Error(err, "unable to create controller", "controller", "AIMBatchJob")
 		os.Exit(1)
 	}
 	// +kubebuilder:scaffold:builder
 
+	if enableWebhooks {
+		if err := webhookv1alpha1.SetupAIMRuntimeConfigWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AIMRuntimeConfig")
+			os.Exit(1)
+		}
+	}
+
+	// Publish per-service gauges (GPUs, replicas, cached bytes, readiness) for chargeback dashboards
+	ctrlmetrics.Registry.MustRegister(aimservice.NewServiceMetricsCollector(k8sClient))
+	ctrlmetrics.Registry.MustRegister(aimtemplatecache.NewTemplateCacheMetricsCollector(k8sClient))
+	ctrlmetrics.Registry.MustRegister(aimservicetemplate.NewDiscoveryMetricsCollector(k8sClient))
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -290,6 +577,12 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if rbacAuditor != nil {
+		if err := mgr.AddReadyzCheck("rbac", rbacAuditor.Checker); err != nil {
+			setupLog.Error(err, "unable to set up RBAC ready check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -297,3 +590,114 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// cacheOptions returns the informer cache options. With filterWorkloads, Pods and Jobs are only
+// cached when the operator created them, instead of every Pod and Job in the cluster.
+// With namespaces, namespaced objects are only cached in those namespaces.
+func cacheOptions(syncPeriod time.Duration, filterWorkloads bool, namespaces []string) cache.Options {
+	opts := cache.Options{SyncPeriod: &syncPeriod}
+	if len(namespaces) > 0 {
+		opts.DefaultNamespaces = make(map[string]cache.Config, len(namespaces))
+		for _, ns := range namespaces {
+			opts.DefaultNamespaces[ns] = cache.Config{}
+		}
+	}
+	if filterWorkloads {
+		selector := labels.SelectorFromSet(labels.Set{constants.LabelKeyManagedBy: constants.LabelValueManagedBy})
+		opts.ByObject = map[client.Object]cache.ByObject{
+			&corev1.Pod{}:  {Label: selector},
+			&batchv1.Job{}: {Label: selector},
+		}
+	}
+	return opts
+}
+
+// parseNamespaces splits a comma-separated namespace list, dropping blanks and duplicates.
+func parseNamespaces(value string) []string {
+	var namespaces []string
+	seen := map[string]bool{}
+	for _, ns := range strings.Split(value, ",") {
+		ns = strings.TrimSpace(ns)
+		if ns == "" || seen[ns] {
+			continue
+		}
+		seen[ns] = true
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces
+}
+
+// newStartupGate creates the startup gate for the KServe and GPU operator deployments.
+func newStartupGate(
+	clientset kubernetes.Interface,
+	timeout time.Duration,
+	kserve, gpuOperator string,
+) (*controllerutils.StartupGate, error) {
+	var dependencies []controllerutils.StartupDependency
+	for _, ref := range []struct{ name, value string }{
+		{"kserve", kserve},
+		{"gpu-operator", gpuOperator},
+	} {
+		dep, ok, err := controllerutils.ParseStartupDependency(ref.name, ref.value)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			dependencies = append(dependencies, dep)
+		}
+	}
+	return controllerutils.NewStartupGate(clientset, timeout, dependencies...), nil
+}
+
+// checkCRDSchemas compares the installed CRDs with the compiled API types and reports missing
+// fields in the log and the aim_crd_schema_missing_fields metric. It returns false when the
+// operator must not start. If the CRDs cannot be read, for example because the operator lacks
+// cluster RBAC in namespaced-only mode, the check is skipped with a warning.
+// In namespaced-only mode, the CRDs of cluster-scoped kinds are not required and not checked.
+func checkCRDSchemas(mgr ctrl.Manager, mode controllerutils.CRDSchemaCheckMode, namespacedOnly bool) bool {
+	switch mode {
+	case controllerutils.CRDSchemaCheckOff:
+		return true
+	case controllerutils.CRDSchemaCheckEnforce, controllerutils.CRDSchemaCheckWarn:
+	default:
+		setupLog.Error(nil, "invalid --crd-schema-check value, expected enforce, warn or off", "value", mode)
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	var skip func(kind string) bool
+	if namespacedOnly {
+		skip = func(kind string) bool { return strings.HasPrefix(kind, "AIMCluster") }
+	}
+	gaps, err := controllerutils.CheckCRDSchemas(ctx, mgr.GetAPIReader(), mgr.GetScheme(), aimv1alpha1.GroupVersion, skip)
+	if err != nil {
+		if apierrors.IsForbidden(err) {
+			setupLog.Info("skipping CRD schema check, CRDs cannot be read", "error", err.Error())
+			return true
+		}
+		setupLog.Error(err, "CRD schema check failed")
+		return mode != controllerutils.CRDSchemaCheckEnforce
+	}
+
+	missing := map[string]int{}
+	for _, gap := range gaps {
+		missing[gap.CRD]++
+		setupLog.Error(nil, "installed CRD does not match the operator version", "crd", gap.CRD,
+			"field", gap.Path, "problem", gap.Reason)
+	}
+	for crd, count := range missing {
+		controllerutils.CRDSchemaMissingFields.WithLabelValues(crd).Set(float64(count))
+	}
+	if len(gaps) == 0 {
+		return true
+	}
+	if mode == controllerutils.CRDSchemaCheckEnforce {
+		setupLog.Error(nil, "refusing to start: upgrade the CRDs to match this operator version, "+
+			"or set --crd-schema-check=warn to start anyway", "mismatches", len(gaps))
+		return false
+	}
+	setupLog.Info("starting despite CRD mismatches; fields missing from the CRDs are dropped on write",
+		"mismatches", len(gaps))
+	return true
+}
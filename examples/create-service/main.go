@@ -0,0 +1,103 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// Command create-service shows how to deploy a model with the typed AIM client: it creates an
+// AIMService for a model and waits until the service is running.
+//
+// Usage:
+//
+//	go run ./examples/create-service -namespace team-a -name llama -model meta-llama-3-8b-instruct
+//
+// The cluster is selected with the usual kubeconfig resolution (--kubeconfig, KUBECONFIG,
+// in-cluster).
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	aimclient "github.com/amd-enterprise-ai/aim-engine/pkg/client"
+)
+
+func main() {
+	namespace := flag.String("namespace", "default", "Namespace of the service.")
+	name := flag.String("name", "example", "Name of the service.")
+	model := flag.String("model", "", "Name of the AIMModel or AIMClusterModel to deploy.")
+	timeout := flag.Duration("timeout", 30*time.Minute, "How long to wait for the service to run.")
+	flag.Parse()
+
+	if *model == "" {
+		fmt.Fprintln(os.Stderr, "-model is required")
+		os.Exit(2)
+	}
+	if err := run(context.Background(), *namespace, *name, *model, *timeout); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, namespace, name, model string, timeout time.Duration) error {
+	c, err := aimclient.New(ctrl.GetConfigOrDie())
+	if err != nil {
+		return err
+	}
+
+	service := &aimv1alpha1.AIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: aimv1alpha1.AIMServiceSpec{
+			Model: aimv1alpha1.AIMServiceModel{Name: ptr.To(model)},
+		},
+	}
+	if err := c.AIMServices(namespace).Create(ctx, service); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create service: %w", err)
+		}
+		fmt.Printf("service %s/%s already exists, waiting for it\n", namespace, name)
+	} else {
+		fmt.Printf("created service %s/%s\n", namespace, name)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	running, err := c.WaitForServiceRunning(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("service %s/%s is running", namespace, name)
+	if running.Status.ResolvedTemplate != nil {
+		fmt.Printf(" with template %s", running.Status.ResolvedTemplate.Name)
+	}
+	if running.Status.Routing != nil && running.Status.Routing.Path != "" {
+		fmt.Printf(" at %s", running.Status.Routing.Path)
+	}
+	fmt.Println()
+	return nil
+}
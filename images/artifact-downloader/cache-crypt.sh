@@ -0,0 +1,78 @@
+#!/bin/sh
+# MIT License
+
+# Copyright (c) 2026 Advanced Micro Devices, Inc.
+
+# Permission is hereby granted, free of charge, to any person obtaining a copy
+# of this software and associated documentation files (the "Software"), to deal
+# in the Software without restriction, including without limitation the rights
+# to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+# copies of the Software, and to permit persons to whom the Software is
+# furnished to do so, subject to the following conditions:
+
+# The above copyright notice and this permission notice shall be included in all
+# copies or substantial portions of the Software.
+
+# THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+# IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+# FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+# AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+# LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+# OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+# SOFTWARE.
+
+# Encrypts or decrypts cached model weights at rest.
+#
+#   cache-crypt.sh encrypt <dir>        Encrypts every file in <dir> in place (<file> -> <file>.aimenc)
+#   cache-crypt.sh decrypt <src> <dst>  Copies <src> to <dst>, decrypting *.aimenc files on the way
+#
+# The passphrase is read from the file in AIM_CACHE_ENCRYPTION_KEY_FILE. Files are encrypted with
+# AES-256-CTR and a PBKDF2-derived key. Encryption is idempotent: already encrypted files are skipped.
+
+set -eu
+
+MODE="${1:?Usage: $0 encrypt <dir> | decrypt <src> <dst>}"
+KEY_FILE="${AIM_CACHE_ENCRYPTION_KEY_FILE:?AIM_CACHE_ENCRYPTION_KEY_FILE must be set}"
+SUFFIX=".aimenc"
+
+if [ ! -s "$KEY_FILE" ]; then
+    echo "Error: encryption key file $KEY_FILE is missing or empty" >&2
+    exit 1
+fi
+
+case "$MODE" in
+    encrypt)
+        DIR="${2:?Usage: $0 encrypt <dir>}"
+        echo "Encrypting files in $DIR"
+        find "$DIR" -type f ! -name "*$SUFFIX" | while IFS= read -r f; do
+            openssl enc -aes-256-ctr -pbkdf2 -salt -pass "file:$KEY_FILE" -in "$f" -out "$f$SUFFIX.tmp"
+            mv "$f$SUFFIX.tmp" "$f$SUFFIX"
+            rm -f "$f"
+        done
+        echo "Encryption complete"
+        ;;
+    decrypt)
+        SRC="${2:?Usage: $0 decrypt <src> <dst>}"
+        DST="${3:?Usage: $0 decrypt <src> <dst>}"
+        echo "Decrypting $SRC into $DST"
+        cd "$SRC"
+        find . -type d | while IFS= read -r d; do
+            mkdir -p "$DST/$d"
+        done
+        find . -type f | while IFS= read -r f; do
+            case "$f" in
+                *"$SUFFIX")
+                    openssl enc -d -aes-256-ctr -pbkdf2 -pass "file:$KEY_FILE" -in "$f" -out "$DST/${f%"$SUFFIX"}"
+                    ;;
+                *)
+                    cp -p "$f" "$DST/$f"
+                    ;;
+            esac
+        done
+        echo "Decryption complete"
+        ;;
+    *)
+        echo "Error: unknown mode $MODE, expected encrypt or decrypt" >&2
+        exit 1
+        ;;
+esac
@@ -26,6 +26,18 @@ set -eu
 URL="${1:?Usage: $0 <hf://org/model or s3://bucket/path>}"
 TARGET_DIR="${TARGET_DIR:-/cache}"
 
+# A download cancelled because its source changed leaves the old source's files behind.
+# Start over in that case, so the cache never mixes files from two sources.
+SOURCE_HASH_FILE="$TARGET_DIR/.cache/aim-source-hash"
+if [ -n "${SOURCE_HASH:-}" ]; then
+    if [ -f "$SOURCE_HASH_FILE" ] && [ "$(cat "$SOURCE_HASH_FILE")" != "$SOURCE_HASH" ]; then
+        echo "Download source changed, removing files of the previous download from $TARGET_DIR"
+        find "$TARGET_DIR" -mindepth 1 -delete
+    fi
+    mkdir -p "$TARGET_DIR/.cache"
+    echo "$SOURCE_HASH" > "$SOURCE_HASH_FILE"
+fi
+
 # Start progress monitor in background
 MONITOR_PID=""
 if [ -f /progress-monitor.sh ]; then
@@ -131,4 +143,12 @@ case "$URL" in
         echo "Error: Unknown protocol. URL must start with hf:// or s3:// - was $URL" >&2
         exit 1
         ;;
-esac
\ No newline at end of file
+esac
+
+# Record checksums and the upstream revision before the weights are encrypted
+/provenance.sh "$URL" "$TARGET_DIR"
+
+# Encrypt the downloaded weights at rest when a key is provided
+if [ -n "${AIM_CACHE_ENCRYPTION_KEY_FILE:-}" ]; then
+    /cache-crypt.sh encrypt "$TARGET_DIR"
+fi
@@ -0,0 +1,97 @@
+#!/bin/sh
+# MIT License
+
+# Copyright (c) 2026 Advanced Micro Devices, Inc.
+
+# Permission is hereby granted, free of charge, to any person obtaining a copy
+# of this software and associated documentation files (the "Software"), to deal
+# in the Software without restriction, including without limitation the rights
+# to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+# copies of the Software, and to permit persons to whom the Software is
+# furnished to do so, subject to the following conditions:
+
+# The above copyright notice and this permission notice shall be included in all
+# copies or substantial portions of the Software.
+
+# THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+# IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+# FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+# AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+# LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+# OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+# SOFTWARE.
+
+set -eu
+
+URL="$1"
+TARGET_DIR="$2"
+
+# Provenance is patched onto the artifact status; skip when running outside an artifact pod
+if [ -z "${ARTIFACT_NAME:-}" ] || [ -z "${ARTIFACT_NAMESPACE:-}" ]; then
+    echo "ARTIFACT_NAME or ARTIFACT_NAMESPACE not set, skipping provenance recording"
+    exit 0
+fi
+
+echo "Recording provenance (computing file checksums)..."
+
+# Checksums are computed over the plaintext files, skipping the Hugging Face download metadata.
+# The revision is the commit hash that hf download records as the first line of each .metadata file.
+PATCH=$(python3 - "$URL" "$TARGET_DIR" <<'PYEOF'
+import datetime
+import glob
+import hashlib
+import json
+import os
+import sys
+
+url, target = sys.argv[1], sys.argv[2]
+max_files = 1000
+
+files = []
+for root, dirs, names in os.walk(target):
+    dirs[:] = sorted(d for d in dirs if d != ".cache")
+    for name in names:
+        path = os.path.join(root, name)
+        if os.path.islink(path) or not os.path.isfile(path):
+            continue
+        digest = hashlib.sha256()
+        with open(path, "rb") as f:
+            for chunk in iter(lambda: f.read(1 << 20), b""):
+                digest.update(chunk)
+        files.append({
+            "path": os.path.relpath(path, target),
+            "sizeBytes": os.path.getsize(path),
+            "sha256": digest.hexdigest(),
+        })
+files.sort(key=lambda f: f["path"])
+
+manifest = "".join("%s  %s\n" % (f["sha256"], f["path"]) for f in files)
+
+revision = ""
+if url.startswith("hf://"):
+    for metadata in sorted(glob.glob(os.path.join(target, ".cache", "huggingface", "download", "**", "*.metadata"), recursive=True)):
+        with open(metadata) as f:
+            revision = f.readline().strip()
+        if revision:
+            break
+
+if len(files) > max_files:
+    print("WARN: %d files downloaded, recording the first %d in status" % (len(files), max_files), file=sys.stderr)
+
+print(json.dumps({"status": {"provenance": {
+    "sourceUri": url,
+    "revision": revision,
+    "manifestDigest": "sha256:" + hashlib.sha256(manifest.encode()).hexdigest(),
+    "recordedAt": datetime.datetime.now(datetime.timezone.utc).strftime("%Y-%m-%dT%H:%M:%SZ"),
+    "files": files[:max_files],
+}}}))
+PYEOF
+)
+
+# A merge patch replaces the files list as a whole, so a retried download never keeps stale entries
+if ! printf '%s' "$PATCH" | kubectl patch aimartifact "$ARTIFACT_NAME" -n "$ARTIFACT_NAMESPACE" \
+    --type=merge --subresource=status --patch-file=/dev/stdin; then
+    echo "WARN: Failed to record provenance" >&2
+    exit 0
+fi
+echo "Provenance recorded"
@@ -23,7 +23,9 @@
 package aimartifact
 
 import (
+	"crypto/sha256"
 	_ "embed"
+	"encoding/json"
 	"fmt"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -62,12 +64,39 @@ func getDownloadJobName(mc *aimv1alpha1.AIMArtifact) string {
 	return name
 }
 
-func buildDownloadJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alpha1.AIMRuntimeConfigCommon, expectedSizeBytes int64) *batchv1.Job {
-	mountPath := "/cache"
-	downloadImage := aimv1alpha1.DefaultDownloadImage
+// getDownloadImage returns the image of the download and check-size jobs.
+func getDownloadImage(mc *aimv1alpha1.AIMArtifact) string {
 	if len(mc.Spec.ModelDownloadImage) > 0 {
-		downloadImage = mc.Spec.ModelDownloadImage
+		return mc.Spec.ModelDownloadImage
 	}
+	return aimv1alpha1.DefaultDownloadImage
+}
+
+// downloadSource is everything that decides which files a download job writes to the volume.
+type downloadSource struct {
+	Image     string          `json:"image"`
+	SourceURI string          `json:"sourceUri"`
+	ModelID   string          `json:"modelId"`
+	Env       []corev1.EnvVar `json:"env"`
+}
+
+// computeDownloadSourceHash returns a short hash of the download source of the artifact.
+// Files downloaded under a different hash must not be mixed with those of the current one.
+func computeDownloadSourceHash(mc *aimv1alpha1.AIMArtifact) string {
+	data, _ := json.Marshal(downloadSource{
+		Image:     getDownloadImage(mc),
+		SourceURI: mc.Spec.SourceURI,
+		ModelID:   mc.Spec.ModelID,
+		Env:       mc.Spec.Env,
+	})
+	hash := sha256.Sum256(data)
+	return fmt.Sprintf("%x", hash[:8])
+}
+
+func buildDownloadJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alpha1.AIMRuntimeConfigCommon, expectedSizeBytes int64) *batchv1.Job {
+	mountPath := "/cache"
+	downloadImage := getDownloadImage(mc)
+	sourceHash := computeDownloadSourceHash(mc)
 
 	// Get env vars from runtime config, or empty slice if nil
 	var runtimeEnv []corev1.EnvVar
@@ -75,7 +104,7 @@ func buildDownloadJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alpha
 		runtimeEnv = runtimeConfigSpec.Env
 	}
 
-	// Merge env vars with precedence: mc.Spec.Env > runtimeConfigSpec.Env > defaults
+	// Merge env vars with precedence: mc.Spec.Env > runtimeConfigSpec.Env > proxy > defaults
 	defaultEnv := []corev1.EnvVar{
 		{Name: "AIM_DOWNLOADER_PROTOCOL", Value: "XET,HF_TRANSFER"},
 		{Name: "TMPDIR", Value: "/tmp/"},
@@ -86,11 +115,13 @@ func buildDownloadJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alpha
 		{Name: "ARTIFACT_NAMESPACE", Value: mc.Namespace},
 		{Name: "STALL_TIMEOUT", Value: "120"},
 		{Name: "TARGET_DIR", Value: mountPath},
+		// The downloader clears files left behind by an interrupted download of another source
+		{Name: "SOURCE_HASH", Value: sourceHash},
 	}
-	newEnv := utils.MergeEnvVars(defaultEnv, runtimeEnv)
+	newEnv := utils.MergeEnvVars(defaultEnv, utils.WithProxyEnv(runtimeEnv, runtimeConfigSpec))
 	newEnv = utils.MergeEnvVars(newEnv, mc.Spec.Env)
 
-	return &batchv1.Job{
+	job := &batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: batchv1.SchemeGroupVersion.String(),
 			Kind:       "Job",
@@ -102,6 +133,10 @@ func buildDownloadJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alpha
 				constants.LabelKeyCacheName: mc.Name,
 				constants.LabelKeyCacheType: "artifact",
 				constants.LabelKeyComponent: "download",
+				constants.LabelKeyManagedBy: constants.LabelValueManagedBy,
+			},
+			Annotations: map[string]string{
+				constants.AnnotationDownloadSourceHash: sourceHash,
 			},
 		},
 		Spec: batchv1.JobSpec{
@@ -113,6 +148,7 @@ func buildDownloadJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alpha
 						constants.LabelKeyCacheName: mc.Name,
 						constants.LabelKeyCacheType: "artifact",
 						constants.LabelKeyComponent: "download",
+						constants.LabelKeyManagedBy: constants.LabelValueManagedBy,
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -152,6 +188,19 @@ func buildDownloadJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alpha
 			},
 		},
 	}
+
+	// Encrypt the downloaded files at rest with the referenced key
+	if mc.Spec.Encryption != nil {
+		podSpec := &job.Spec.Template.Spec
+		podSpec.Volumes = append(podSpec.Volumes, utils.CacheEncryptionKeyVolume(mc.Spec.Encryption))
+		container := &podSpec.Containers[0]
+		container.VolumeMounts = append(container.VolumeMounts, utils.CacheEncryptionKeyVolumeMount())
+		container.Env = utils.MergeEnvVars(container.Env, []corev1.EnvVar{utils.CacheEncryptionKeyEnvVar()})
+	}
+
+	// Place the job on the configured node pool (e.g. tolerate tainted GPU nodes)
+	utils.ApplySchedulingToPodSpec(&job.Spec.Template.Spec, utils.ResolveScheduling(nil, runtimeConfigSpec))
+	return job
 }
 
 func getCheckSizeJobName(mc *aimv1alpha1.AIMArtifact) string {
@@ -160,19 +209,16 @@ func getCheckSizeJobName(mc *aimv1alpha1.AIMArtifact) string {
 }
 
 func buildCheckSizeJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alpha1.AIMRuntimeConfigCommon) *batchv1.Job {
-	downloadImage := aimv1alpha1.DefaultDownloadImage
-	if len(mc.Spec.ModelDownloadImage) > 0 {
-		downloadImage = mc.Spec.ModelDownloadImage
-	}
+	downloadImage := getDownloadImage(mc)
 
 	// Get auth env vars from runtime config and spec
 	var runtimeEnv []corev1.EnvVar
 	if runtimeConfigSpec != nil {
 		runtimeEnv = runtimeConfigSpec.Env
 	}
-	envVars := utils.MergeEnvVars(runtimeEnv, mc.Spec.Env)
+	envVars := utils.MergeEnvVars(utils.WithProxyEnv(runtimeEnv, runtimeConfigSpec), mc.Spec.Env)
 
-	return &batchv1.Job{
+	job := &batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: batchv1.SchemeGroupVersion.String(),
 			Kind:       "Job",
@@ -184,6 +230,7 @@ func buildCheckSizeJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alph
 				constants.LabelKeyCacheName: mc.Name,
 				constants.LabelKeyCacheType: "artifact",
 				constants.LabelKeyComponent: "check-size",
+				constants.LabelKeyManagedBy: constants.LabelValueManagedBy,
 			},
 		},
 		Spec: batchv1.JobSpec{
@@ -195,6 +242,7 @@ func buildCheckSizeJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alph
 						constants.LabelKeyCacheName: mc.Name,
 						constants.LabelKeyCacheType: "artifact",
 						constants.LabelKeyComponent: "check-size",
+						constants.LabelKeyManagedBy: constants.LabelValueManagedBy,
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -219,4 +267,59 @@ func buildCheckSizeJob(mc *aimv1alpha1.AIMArtifact, runtimeConfigSpec *aimv1alph
 			},
 		},
 	}
+
+	// Place the job on the configured node pool (e.g. tolerate tainted GPU nodes)
+	utils.ApplySchedulingToPodSpec(&job.Spec.Template.Spec, utils.ResolveScheduling(nil, runtimeConfigSpec))
+	return job
+}
+
+// downloadSourceChange describes an unfinished download job that fetches an outdated source.
+type downloadSourceChange struct {
+	job                *batchv1.Job
+	previousSourceHash string
+	sourceHash         string
+	message            string
+}
+
+// detectDownloadSourceChange returns the change when the download job has not succeeded and was
+// built for a different source than the artifact now specifies. Jobs created before source
+// hashes were recorded are left alone.
+func detectDownloadSourceChange(mc *aimv1alpha1.AIMArtifact, job *batchv1.Job) *downloadSourceChange {
+	if job == nil || job.DeletionTimestamp != nil || utils.IsJobSucceeded(job) {
+		return nil
+	}
+	previous := job.Annotations[constants.AnnotationDownloadSourceHash]
+	current := computeDownloadSourceHash(mc)
+	if previous == "" || previous == current {
+		return nil
+	}
+
+	what := "download source or env changed"
+	if containers := job.Spec.Template.Spec.Containers; len(containers) > 0 && containers[0].Image != getDownloadImage(mc) {
+		what = fmt.Sprintf("download image changed from %s to %s", containers[0].Image, getDownloadImage(mc))
+	}
+	return &downloadSourceChange{
+		job:                job,
+		previousSourceHash: previous,
+		sourceHash:         current,
+		message:            fmt.Sprintf("Cancelled download job %s because the %s; restarting the download", job.Name, what),
+	}
+}
+
+// setDownloadInterruption records the cancelled download and clears its progress, since the
+// restarted download begins from scratch. A change already recorded keeps its original time.
+func setDownloadInterruption(status *aimv1alpha1.AIMArtifactStatus, change *downloadSourceChange, now metav1.Time) {
+	if last := status.LastInterruption; last != nil &&
+		last.PreviousSourceHash == change.previousSourceHash && last.SourceHash == change.sourceHash {
+		return
+	}
+	status.LastInterruption = &aimv1alpha1.AIMDownloadInterruption{
+		Time:               now,
+		JobName:            change.job.Name,
+		PreviousSourceHash: change.previousSourceHash,
+		SourceHash:         change.sourceHash,
+		Message:            change.message,
+	}
+	status.Progress = nil
+	status.Download = nil
 }
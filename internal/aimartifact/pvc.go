@@ -23,14 +23,18 @@
 package aimartifact
 
 import (
+	"context"
+	"fmt"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
 	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
 )
 
@@ -98,3 +102,72 @@ func extractModelFromSourceURI(sourceURI string) string {
 	}
 	return sourceURI
 }
+
+// fetchPvcEvents lists the events of a PVC so that provisioning problems can be reported.
+// Events are best-effort: failures to list them return nil and the PVC is treated as pending.
+func fetchPvcEvents(ctx context.Context, clientset kubernetes.Interface, pvc *corev1.PersistentVolumeClaim) []corev1.Event {
+	if clientset == nil || pvc == nil {
+		return nil
+	}
+	events, err := clientset.CoreV1().Events(pvc.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.uid=%s", pvc.Name, pvc.UID),
+	})
+	if err != nil || events == nil {
+		return nil
+	}
+	return events.Items
+}
+
+// setStorageProvisionedCondition reports whether the cache PVC could be provisioned.
+// The condition is only set once the PVC exists.
+func setStorageProvisionedCondition(cm *controllerutils.ConditionManager, obs ArtifactObservation) {
+	if !obs.cachePvc.OK() || obs.cachePvc.Value == nil {
+		return
+	}
+
+	if obs.cachePvc.Value.Status.Phase == corev1.ClaimBound {
+		cm.MarkTrue(aimv1alpha1.ArtifactConditionStorageProvisioned, aimv1alpha1.ArtifactReasonStorageBound, "PVC is bound")
+		return
+	}
+
+	health := controllerutils.GetPvcHealthWithEvents(obs.cachePvc.Value, obs.cachePvcEvents)
+	if len(health.Errors) > 0 {
+		cm.MarkFalse(aimv1alpha1.ArtifactConditionStorageProvisioned, health.GetReason(), health.GetMessage(), controllerutils.AsWarning())
+		return
+	}
+	cm.MarkFalse(aimv1alpha1.ArtifactConditionStorageProvisioned, aimv1alpha1.ArtifactReasonStoragePending, "PVC is pending")
+}
+
+// pvcCapacity returns the storage the PVC provides: the bound capacity when known,
+// otherwise the requested size.
+func pvcCapacity(pvc *corev1.PersistentVolumeClaim) (resource.Quantity, bool) {
+	if qty, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		return qty, true
+	}
+	qty, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	return qty, ok
+}
+
+// setStorageUndersizedCondition flags a cache PVC that cannot hold the model.
+// PVCs are never resized by the controller, so a PVC created with a stale or
+// manual size stays undersized until it is expanded or recreated.
+func setStorageUndersizedCondition(cm *controllerutils.ConditionManager, obs ArtifactObservation) {
+	if !obs.cachePvc.OK() || obs.cachePvc.Value == nil || !obs.IsSizeKnown() {
+		return
+	}
+
+	needed := obs.GetEffectiveSize()
+	capacity, ok := pvcCapacity(obs.cachePvc.Value)
+	if !ok || needed <= 0 || capacity.Value() >= needed {
+		cm.Delete(aimv1alpha1.ArtifactConditionStorageUndersized)
+		return
+	}
+
+	neededDisplay, err := utils.FormatBytesHumanReadable(needed)
+	if err != nil {
+		neededDisplay = fmt.Sprintf("%d bytes", needed)
+	}
+	cm.MarkTrue(aimv1alpha1.ArtifactConditionStorageUndersized, aimv1alpha1.ArtifactReasonInsufficientCapacity,
+		fmt.Sprintf("PVC %s provides %s but the model needs %s", obs.cachePvc.Value.Name, capacity.String(), neededDisplay),
+		controllerutils.AsWarning())
+}
@@ -39,6 +39,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,6 +56,7 @@ type ArtifactFetchResult struct {
 
 	mergedRuntimeConfig controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]
 	cachePvc            controllerutils.FetchResult[*corev1.PersistentVolumeClaim]
+	cachePvcEvents      []corev1.Event // Only fetched while the PVC is pending
 
 	// Check-size job (fetched when spec.size is empty and not yet discovered)
 	checkSizeJob     *controllerutils.FetchResult[*batchv1.Job]
@@ -177,6 +179,11 @@ func (r *ArtifactReconciler) FetchRemoteState(
 		),
 	}
 
+	// Fetch PVC events while the claim is pending to explain provisioning problems
+	if result.cachePvc.OK() && result.cachePvc.Value.Status.Phase == corev1.ClaimPending {
+		result.cachePvcEvents = fetchPvcEvents(ctx, r.Clientset, result.cachePvc.Value)
+	}
+
 	// Fetch check-size job if size not in spec AND not yet discovered
 	if mc.Spec.Size.IsZero() && mc.Status.DiscoveredSizeBytes == nil {
 		checkSizeJobName := getCheckSizeJobName(mc)
@@ -272,7 +279,9 @@ func (obs ArtifactObservation) GetComponentHealth(ctx context.Context, clientset
 	// Phase 2+: PVC and download job health (only after size is known)
 	if obs.IsSizeKnown() {
 		health = append(health,
-			obs.cachePvc.ToDownstreamComponentHealth("CachePvc", controllerutils.GetPvcHealth))
+			obs.cachePvc.ToDownstreamComponentHealth("CachePvc", func(pvc *corev1.PersistentVolumeClaim) controllerutils.ComponentHealth {
+				return controllerutils.GetPvcHealthWithEvents(pvc, obs.cachePvcEvents)
+			}))
 
 		if obs.artifact.Status.Status != constants.AIMStatusReady {
 			if obs.downloadJob != nil {
@@ -306,6 +315,9 @@ type ArtifactObservation struct {
 	// Discovered size bytes and parse error from check-size job
 	discoveredSizeBytes *int64
 	sizeParseError      error
+
+	// sourceChange is set when the unfinished download job fetches an outdated source
+	sourceChange *downloadSourceChange
 }
 
 func (r *ArtifactReconciler) ComposeState(
@@ -330,6 +342,10 @@ func (r *ArtifactReconciler) ComposeState(
 		}
 	}
 
+	if fetch.downloadJob != nil && fetch.downloadJob.OK() {
+		obs.sourceChange = detectDownloadSourceChange(fetch.artifact, fetch.downloadJob.Value)
+	}
+
 	return obs
 }
 
@@ -379,6 +395,18 @@ func (r *ArtifactReconciler) PlanResources(
 		return result
 	}
 
+	// Cancel a download whose source changed mid-flight; the job is recreated once it is gone
+	if obs.sourceChange != nil {
+		// A plain Job delete orphans its pods, so remove them explicitly
+		result.Delete(obs.sourceChange.job)
+		if obs.downloadJobPods != nil && obs.downloadJobPods.OK() {
+			for i := range obs.downloadJobPods.Value.Items {
+				result.Delete(&obs.downloadJobPods.Value.Items[i])
+			}
+		}
+		return result
+	}
+
 	// Phase 3: Download job creation - size is known and PVC, rolebinding exists
 	if mc.Status.Status != constants.AIMStatusReady &&
 		obs.downloadJob != nil && obs.downloadJob.IsNotFound() && obs.roleBinding.OK() {
@@ -462,6 +490,9 @@ func (r *ArtifactReconciler) DecorateStatus(
 		}
 	}
 
+	setStorageProvisionedCondition(cm, obs)
+	setStorageUndersizedCondition(cm, obs)
+
 	// --- Download phase tracking ---
 
 	r.decorateDownloadPhase(status, cm, obs, podFailed)
@@ -473,6 +504,13 @@ func (r *ArtifactReconciler) decorateDownloadPhase(
 	obs ArtifactObservation,
 	podFailed bool,
 ) {
+	if change := obs.sourceChange; change != nil {
+		setDownloadInterruption(status, change, metav1.Now())
+		cm.MarkFalse(aimv1alpha1.ArtifactConditionDownloadComplete,
+			aimv1alpha1.ArtifactReasonSourceChanged, change.message, controllerutils.AsWarning())
+		return
+	}
+
 	// The download pod sets progress to 100% after the download finishes (before verification).
 	// The controller uses this signal to derive the DownloadComplete condition.
 	jobExists := obs.downloadJob != nil && !obs.downloadJob.IsNotFound() && obs.downloadJob.Value != nil
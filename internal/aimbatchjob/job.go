@@ -0,0 +1,366 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimbatchjob
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservicetemplate"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+const (
+	containerName = "batch"
+
+	volumeDatasetPrefix = "batch-data-"
+
+	mountPathInput  = "/workspace/batch/input"
+	mountPathOutput = "/workspace/batch/output"
+
+	// completionIndexAnnotation is set by the Job controller on the pods of an Indexed Job.
+	completionIndexAnnotation = "batch.kubernetes.io/job-completion-index"
+)
+
+// defaultArgs starts the offline batch mode of the AIM runtime.
+var defaultArgs = []string{"batch"}
+
+// JobName returns the name of the Job that runs a batch job.
+func JobName(batchJob *aimv1alpha1.AIMBatchJob) string {
+	name, _ := utils.GenerateDerivedName([]string{batchJob.Name, "batch"}, utils.WithHashSource(batchJob.UID))
+	return name
+}
+
+// jobInputs holds everything the Job is built from.
+type jobInputs struct {
+	batchJob      *aimv1alpha1.AIMBatchJob
+	template      *resolvedTemplate
+	model         *aimservicetemplate.ModelLookupResult
+	templateCache *aimv1alpha1.AIMTemplateCache
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon
+}
+
+// buildJob builds the Indexed Job that runs a batch job, one completion per shard.
+func buildJob(in jobInputs) *batchv1.Job {
+	batchJob := in.batchJob
+	shards := batchJob.GetShards()
+
+	labels := map[string]string{
+		constants.LabelKeyBatchJob:  batchJob.Name,
+		constants.LabelKeyTemplate:  in.template.ref.Name,
+		constants.LabelKeyComponent: constants.LabelValueComponentBatch,
+		constants.LabelKeyManagedBy: constants.LabelValueManagedBy,
+	}
+
+	volumes, mounts := datasetVolumes(batchJob)
+	cacheVolumes, cacheMounts := cacheArtifactVolumes(in.templateCache)
+	volumes = append(volumes, cacheVolumes...)
+	mounts = append(mounts, cacheMounts...)
+	volumes = append(volumes, corev1.Volume{
+		Name: constants.VolumeSharedMemory,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium:    corev1.StorageMediumMemory,
+				SizeLimit: ptr.To(resource.MustParse(constants.DefaultSharedMemorySize)),
+			},
+		},
+	})
+	mounts = append(mounts, corev1.VolumeMount{Name: constants.VolumeSharedMemory, MountPath: constants.MountPathSharedMemory})
+
+	args := defaultArgs
+	if len(batchJob.Spec.Args) > 0 {
+		args = batchJob.Spec.Args
+	}
+
+	serviceAccountName := in.model.ServiceAccountName
+	if batchJob.Spec.ServiceAccountName != "" {
+		serviceAccountName = batchJob.Spec.ServiceAccountName
+	}
+
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      JobName(batchJob),
+			Namespace: batchJob.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			CompletionMode:       ptr.To(batchv1.IndexedCompletion),
+			Completions:          ptr.To(shards),
+			Parallelism:          ptr.To(shards),
+			BackoffLimitPerIndex: ptr.To(ptr.Deref(batchJob.Spec.BackoffLimitPerShard, 2)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ImagePullSecrets:   utils.MergePullSecretRefs(in.model.ImagePullSecrets, batchJob.Spec.ImagePullSecrets),
+					ServiceAccountName: serviceAccountName,
+					Containers: []corev1.Container{
+						{
+							Name:         containerName,
+							Image:        in.model.Image,
+							Args:         args,
+							Env:          buildEnv(in),
+							Resources:    buildResources(in),
+							VolumeMounts: mounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+
+	podSpec := &job.Spec.Template.Spec
+	if in.template.status.ResolvedNodeAffinity != nil {
+		podSpec.Affinity = &corev1.Affinity{NodeAffinity: in.template.status.ResolvedNodeAffinity.DeepCopy()}
+	}
+	utils.ApplySchedulingToPodSpec(podSpec, utils.ResolveScheduling(nil, in.runtimeConfig))
+
+	return job
+}
+
+// buildEnv builds the container environment. Precedence from lowest to highest: defaults,
+// proxy and runtime config env, template profile and env, batch settings, batch job env.
+func buildEnv(in jobInputs) []corev1.EnvVar {
+	batchJob := in.batchJob
+	spec := in.template.spec
+
+	env := []corev1.EnvVar{
+		{Name: constants.EnvAIMCachePath, Value: constants.AIMCacheBasePath},
+	}
+	env = utils.WithProxyEnv(env, in.runtimeConfig)
+	if in.runtimeConfig != nil && len(in.runtimeConfig.Env) > 0 {
+		env = utils.MergeEnvVars(env, in.runtimeConfig.Env, utils.EnvVarAIMEngineArgs)
+	}
+
+	var profileEnv []corev1.EnvVar
+	if spec.ProfileId != "" {
+		profileEnv = append(profileEnv, corev1.EnvVar{Name: constants.EnvAIMProfileID, Value: spec.ProfileId})
+	}
+	if spec.Metric != nil {
+		profileEnv = append(profileEnv, corev1.EnvVar{Name: constants.EnvAIMMetric, Value: string(*spec.Metric)})
+	}
+	if spec.Precision != nil {
+		profileEnv = append(profileEnv, corev1.EnvVar{Name: constants.EnvAIMPrecision, Value: string(*spec.Precision)})
+	}
+	if len(spec.ModelSources) > 0 {
+		profileEnv = append(profileEnv, corev1.EnvVar{Name: constants.EnvAIMModelID, Value: spec.ModelSources[0].ModelID})
+	}
+	env = utils.MergeEnvVars(env, profileEnv)
+	if len(spec.Env) > 0 {
+		env = utils.MergeEnvVars(env, spec.Env, utils.EnvVarAIMEngineArgs)
+	}
+
+	env = utils.MergeEnvVars(env, []corev1.EnvVar{
+		{Name: constants.EnvAIMBatchInput, Value: datasetPath(batchJob.Spec.Input, mountPathInput)},
+		{Name: constants.EnvAIMBatchOutput, Value: datasetPath(batchJob.Spec.Output, mountPathOutput)},
+		{Name: constants.EnvAIMBatchShardCount, Value: strconv.Itoa(int(batchJob.GetShards()))},
+		{
+			Name: constants.EnvAIMBatchShardIndex,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.annotations['" + completionIndexAnnotation + "']",
+				},
+			},
+		},
+	})
+	if len(batchJob.Spec.Env) > 0 {
+		env = utils.MergeEnvVars(env, batchJob.Spec.Env, utils.EnvVarAIMEngineArgs)
+	}
+
+	slices.SortFunc(env, func(a, b corev1.EnvVar) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return env
+}
+
+// buildResources requests the GPUs resolved for the template, then applies the template and
+// batch job resources on top.
+func buildResources(in jobInputs) corev1.ResourceRequirements {
+	resources := corev1.ResourceRequirements{}
+
+	hardware := in.template.status.ResolvedHardware
+	if in.template.spec.Compute != aimv1alpha1.AIMComputeModeCPU && hardware != nil && hardware.GPU != nil && hardware.GPU.Requests > 0 {
+		name := corev1.ResourceName(constants.DefaultGPUResourceName)
+		if hardware.GPU.ResourceName != "" {
+			name = corev1.ResourceName(hardware.GPU.ResourceName)
+		}
+		qty := *resource.NewQuantity(int64(hardware.GPU.Requests), resource.DecimalSI)
+		resources.Requests = corev1.ResourceList{name: qty}
+		resources.Limits = corev1.ResourceList{name: qty.DeepCopy()}
+	}
+
+	resources = mergeResources(resources, in.template.spec.Resources)
+	resources = mergeResources(resources, in.batchJob.Spec.Resources)
+	return resources
+}
+
+// mergeResources sets each request and limit of override on base.
+func mergeResources(base corev1.ResourceRequirements, override *corev1.ResourceRequirements) corev1.ResourceRequirements {
+	if override == nil {
+		return base
+	}
+	for name, qty := range override.Requests {
+		if base.Requests == nil {
+			base.Requests = corev1.ResourceList{}
+		}
+		base.Requests[name] = qty.DeepCopy()
+	}
+	for name, qty := range override.Limits {
+		if base.Limits == nil {
+			base.Limits = corev1.ResourceList{}
+		}
+		base.Limits[name] = qty.DeepCopy()
+	}
+	return base
+}
+
+// datasetVolumes mounts the input claim read-only and the output claim read-write.
+// A claim used for both is mounted once per role from a single volume.
+func datasetVolumes(batchJob *aimv1alpha1.AIMBatchJob) ([]corev1.Volume, []corev1.VolumeMount) {
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+
+	addClaim := func(claimName string) string {
+		volumeName := utils.MakeRFC1123Compliant(volumeDatasetPrefix + claimName)
+		for _, v := range volumes {
+			if v.Name == volumeName {
+				return volumeName
+			}
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+			},
+		})
+		return volumeName
+	}
+
+	if input := batchJob.Spec.Input.PersistentVolumeClaim; input != nil {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      addClaim(input.ClaimName),
+			MountPath: mountPathInput,
+			ReadOnly:  true,
+		})
+	}
+	if output := batchJob.Spec.Output.PersistentVolumeClaim; output != nil {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      addClaim(output.ClaimName),
+			MountPath: mountPathOutput,
+		})
+	}
+
+	return volumes, mounts
+}
+
+// datasetPath returns the path of a claim dataset below its mount path, or the S3 URI.
+func datasetPath(dataset aimv1alpha1.AIMBatchJobDataset, mountPath string) string {
+	if dataset.PersistentVolumeClaim != nil {
+		return path.Join(mountPath, dataset.PersistentVolumeClaim.Path)
+	}
+	return dataset.S3URI
+}
+
+// cacheArtifactVolumes mounts the Ready artifacts of the template cache read-only at the paths
+// the inference runtime expects them, the same way services mount them.
+func cacheArtifactVolumes(cache *aimv1alpha1.AIMTemplateCache) ([]corev1.Volume, []corev1.VolumeMount) {
+	if cache == nil {
+		return nil, nil
+	}
+
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	for _, key := range slices.Sorted(maps.Keys(cache.Status.Artifacts)) {
+		artifact := cache.Status.Artifacts[key]
+		if artifact.Status != constants.AIMStatusReady || artifact.PersistentVolumeClaim == "" {
+			continue
+		}
+		volumeName := utils.ArtifactVolumeName(artifact)
+		volumes = append(volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: artifact.PersistentVolumeClaim,
+					ReadOnly:  true,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: utils.ArtifactMountPath(artifact),
+			ReadOnly:  true,
+		})
+	}
+	return volumes, mounts
+}
+
+// jobProgress counts the shards of an Indexed Job by state.
+func jobProgress(job *batchv1.Job, shards int32) *aimv1alpha1.AIMBatchJobProgress {
+	progress := &aimv1alpha1.AIMBatchJobProgress{
+		Total:     shards,
+		Succeeded: int32(countIndexes(job.Status.CompletedIndexes)),
+		Active:    job.Status.Active,
+	}
+	if job.Status.FailedIndexes != nil {
+		progress.Failed = int32(countIndexes(*job.Status.FailedIndexes))
+	}
+	return progress
+}
+
+// countIndexes counts the indexes in a Job index list such as "1,3-5,7".
+func countIndexes(indexes string) int {
+	count := 0
+	for _, part := range strings.Split(indexes, ",") {
+		if part == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(part, "-")
+		if !isRange {
+			count++
+			continue
+		}
+		from, errFrom := strconv.Atoi(first)
+		to, errTo := strconv.Atoi(last)
+		if errFrom != nil || errTo != nil || to < from {
+			continue
+		}
+		count += to - from + 1
+	}
+	return count
+}
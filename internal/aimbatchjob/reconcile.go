@@ -0,0 +1,341 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimbatchjob
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservicetemplate"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const (
+	templateComponentName = "Template"
+	modelComponentName    = "Model"
+	jobComponentName      = "Job"
+)
+
+// BatchJobReconciler implements domain reconciliation for AIMBatchJob.
+type BatchJobReconciler struct {
+	Scheme *runtime.Scheme
+}
+
+// resolvedTemplate is the namespace or cluster template a batch job runs.
+type resolvedTemplate struct {
+	ref    aimv1alpha1.AIMResolvedReference
+	spec   *aimv1alpha1.AIMServiceTemplateSpecCommon
+	status *aimv1alpha1.AIMServiceTemplateStatus
+}
+
+// ============================================================================
+// FETCH
+// ============================================================================
+
+type BatchJobFetchResult struct {
+	batchJob *aimv1alpha1.AIMBatchJob
+
+	job controllerutils.FetchResult[*batchv1.Job]
+
+	// The fields below are only fetched until the Job exists, since its pod template is immutable
+	template      controllerutils.FetchResult[*resolvedTemplate]
+	model         controllerutils.FetchResult[*aimservicetemplate.ModelLookupResult]
+	templateCache controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]
+}
+
+func (r *BatchJobReconciler) FetchRemoteState(
+	ctx context.Context,
+	c client.Client,
+	reconcileCtx controllerutils.ReconcileContext[*aimv1alpha1.AIMBatchJob],
+) BatchJobFetchResult {
+	batchJob := reconcileCtx.Object
+	result := BatchJobFetchResult{batchJob: batchJob}
+
+	result.job = controllerutils.Fetch(ctx, c,
+		client.ObjectKey{Namespace: batchJob.Namespace, Name: JobName(batchJob)},
+		&batchv1.Job{})
+	if !result.job.IsNotFound() {
+		return result
+	}
+
+	result.template = fetchTemplate(ctx, c, batchJob)
+	if !result.template.OK() {
+		return result
+	}
+
+	template := result.template.Value
+	if template.ref.Scope == aimv1alpha1.AIMResolutionScopeCluster {
+		result.model = aimservicetemplate.LookupModelForClusterTemplate(ctx, c, template.spec.ModelName)
+	} else {
+		result.model = aimservicetemplate.LookupModelForNamespaceTemplate(ctx, c, batchJob.Namespace, template.spec.ModelName)
+	}
+	result.templateCache = fetchTemplateCache(ctx, c, batchJob.Namespace, template.ref.Name)
+
+	return result
+}
+
+// fetchTemplate looks up the namespace-scoped template first and falls back to the cluster-scoped
+// template with the same name, if its namespaceSelector allows the namespace of the batch job.
+func fetchTemplate(
+	ctx context.Context,
+	c client.Client,
+	batchJob *aimv1alpha1.AIMBatchJob,
+) controllerutils.FetchResult[*resolvedTemplate] {
+	template := &aimv1alpha1.AIMServiceTemplate{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: batchJob.Namespace, Name: batchJob.Spec.Template}, template)
+	if err == nil {
+		return controllerutils.FetchResult[*resolvedTemplate]{Value: &resolvedTemplate{
+			ref: aimv1alpha1.AIMResolvedReference{
+				Name:      template.Name,
+				Namespace: template.Namespace,
+				Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
+				Kind:      "AIMServiceTemplate",
+				UID:       template.UID,
+			},
+			spec:   &template.Spec.AIMServiceTemplateSpecCommon,
+			status: &template.Status,
+		}}
+	}
+	if !apierrors.IsNotFound(err) {
+		return controllerutils.FetchResult[*resolvedTemplate]{Error: err}
+	}
+
+	clusterTemplate := &aimv1alpha1.AIMClusterServiceTemplate{}
+	err = c.Get(ctx, client.ObjectKey{Name: batchJob.Spec.Template}, clusterTemplate)
+	if apierrors.IsNotFound(err) {
+		return controllerutils.FetchResult[*resolvedTemplate]{
+			Error: controllerutils.NewMissingUpstreamDependencyError(
+				aimv1alpha1.AIMBatchJobReasonTemplateNotFound,
+				fmt.Sprintf("no AIMServiceTemplate or AIMClusterServiceTemplate found with name %q", batchJob.Spec.Template),
+				err,
+			),
+		}
+	}
+	if err != nil {
+		return controllerutils.FetchResult[*resolvedTemplate]{Error: err}
+	}
+
+	if clusterTemplate.Spec.NamespaceSelector != nil {
+		namespace := &corev1.Namespace{}
+		if err := c.Get(ctx, client.ObjectKey{Name: batchJob.Namespace}, namespace); err != nil && !apierrors.IsNotFound(err) {
+			return controllerutils.FetchResult[*resolvedTemplate]{Error: err}
+		}
+		if !clusterTemplate.AllowsNamespace(namespace.Labels) {
+			return controllerutils.FetchResult[*resolvedTemplate]{
+				Error: controllerutils.NewInvalidSpecFieldError(
+					aimv1alpha1.AIMBatchJobReasonTemplateNamespaceDenied,
+					"spec.template",
+					fmt.Sprintf("cluster template %q is not available in namespace %q", clusterTemplate.Name, batchJob.Namespace),
+					nil,
+				),
+			}
+		}
+	}
+
+	return controllerutils.FetchResult[*resolvedTemplate]{Value: &resolvedTemplate{
+		ref: aimv1alpha1.AIMResolvedReference{
+			Name:  clusterTemplate.Name,
+			Scope: aimv1alpha1.AIMResolutionScopeCluster,
+			Kind:  "AIMClusterServiceTemplate",
+			UID:   clusterTemplate.UID,
+		},
+		spec:   &clusterTemplate.Spec.AIMServiceTemplateSpecCommon,
+		status: &clusterTemplate.Status,
+	}}
+}
+
+// fetchTemplateCache returns a Ready shared template cache of the template, or nil if there is none.
+// Encrypted caches are skipped, since batch pods do not run the decrypt init containers.
+func fetchTemplateCache(
+	ctx context.Context,
+	c client.Client,
+	namespace, templateName string,
+) controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache] {
+	caches := controllerutils.FetchList(ctx, c, &aimv1alpha1.AIMTemplateCacheList{}, client.InNamespace(namespace))
+	if caches.HasError() {
+		return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Error: caches.Error}
+	}
+
+	var matching []aimv1alpha1.AIMTemplateCache
+	for _, cache := range caches.Value.Items {
+		if cache.Spec.TemplateName == templateName && cache.Spec.Mode == aimv1alpha1.TemplateCacheModeShared &&
+			cache.Spec.Encryption == nil && cache.Status.Status == constants.AIMStatusReady {
+			matching = append(matching, cache)
+		}
+	}
+
+	if len(matching) == 0 {
+		return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{}
+	}
+
+	// Pick the first by name so the choice is stable across reconciles
+	slices.SortFunc(matching, func(a, b aimv1alpha1.AIMTemplateCache) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: &matching[0]}
+}
+
+// GetComponentHealth returns the health of the template and model until the Job exists,
+// and the health of the Job afterwards.
+func (result BatchJobFetchResult) GetComponentHealth() []controllerutils.ComponentHealth {
+	if !result.job.IsNotFound() {
+		return []controllerutils.ComponentHealth{
+			result.job.ToDownstreamComponentHealth(jobComponentName, controllerutils.GetJobHealth),
+		}
+	}
+
+	health := []controllerutils.ComponentHealth{
+		result.template.ToUpstreamComponentHealth(templateComponentName, getTemplateHealth),
+	}
+	if !result.template.OK() {
+		return health
+	}
+
+	health = append(health, result.model.ToUpstreamComponentHealth(modelComponentName, aimservicetemplate.GetModelLookupHealth))
+	// The cache is optional, so only list failures are reported
+	if result.templateCache.HasError() {
+		health = append(health, result.templateCache.ToUpstreamComponentHealth("TemplateCache", nil))
+	}
+	if result.dependenciesReady() {
+		health = append(health, result.job.ToDownstreamComponentHealth(jobComponentName, controllerutils.GetJobHealth))
+	}
+	return health
+}
+
+// getTemplateHealth reports a template that is not Ready as the dependency the batch job waits on.
+func getTemplateHealth(template *resolvedTemplate) controllerutils.ComponentHealth {
+	switch template.status.Status {
+	case constants.AIMStatusReady:
+		return controllerutils.ComponentHealth{State: constants.AIMStatusReady, Reason: "TemplateReady"}
+	case constants.AIMStatusFailed, constants.AIMStatusNotAvailable:
+		return controllerutils.ComponentHealth{
+			State:   template.status.Status,
+			Reason:  aimv1alpha1.AIMBatchJobReasonTemplateNotReady,
+			Message: fmt.Sprintf("%s %s is %s", template.ref.Kind, template.ref.Name, template.status.Status),
+		}
+	default:
+		return controllerutils.ComponentHealth{
+			Reason: aimv1alpha1.AIMBatchJobReasonTemplateNotReady,
+			WaitingOn: &controllerutils.ObjectRef{
+				Kind:      template.ref.Kind,
+				Namespace: template.ref.Namespace,
+				Name:      template.ref.Name,
+			},
+		}
+	}
+}
+
+// dependenciesReady returns true once the template is Ready and the model image is known.
+func (result BatchJobFetchResult) dependenciesReady() bool {
+	return result.template.OK() && result.template.Value != nil &&
+		result.template.Value.status.Status == constants.AIMStatusReady &&
+		result.model.OK() && result.model.Value.Image != "" &&
+		!result.templateCache.HasError()
+}
+
+// ============================================================================
+// OBSERVATION
+// ============================================================================
+
+type BatchJobObservation struct {
+	BatchJobFetchResult
+}
+
+func (r *BatchJobReconciler) ComposeState(
+	_ context.Context,
+	_ controllerutils.ReconcileContext[*aimv1alpha1.AIMBatchJob],
+	fetch BatchJobFetchResult,
+) BatchJobObservation {
+	return BatchJobObservation{BatchJobFetchResult: fetch}
+}
+
+// ============================================================================
+// PLAN
+// ============================================================================
+
+func (r *BatchJobReconciler) PlanResources(
+	_ context.Context,
+	reconcileCtx controllerutils.ReconcileContext[*aimv1alpha1.AIMBatchJob],
+	obs BatchJobObservation,
+) controllerutils.PlanResult {
+	result := controllerutils.PlanResult{}
+
+	// The pod template of a Job is immutable, so the Job is only planned while it does not exist
+	if !obs.job.IsNotFound() || !obs.dependenciesReady() {
+		return result
+	}
+
+	result.Apply(buildJob(jobInputs{
+		batchJob:      reconcileCtx.Object,
+		template:      obs.template.Value,
+		model:         obs.model.Value,
+		templateCache: obs.templateCache.Value,
+		runtimeConfig: reconcileCtx.MergedRuntimeConfig.Value,
+	}))
+
+	return result
+}
+
+// ============================================================================
+// STATUS
+// ============================================================================
+
+func (r *BatchJobReconciler) DecorateStatus(
+	status *aimv1alpha1.AIMBatchJobStatus,
+	_ *controllerutils.ConditionManager,
+	obs BatchJobObservation,
+) {
+	batchJob := obs.batchJob
+	status.OutputLocation = batchJob.Spec.Output.Location()
+
+	// Template and cache are only resolved until the Job exists; keep the values it was built from
+	if obs.template.OK() && obs.template.Value != nil {
+		ref := obs.template.Value.ref
+		status.ResolvedTemplate = &ref
+		status.TemplateCache = ""
+		if obs.templateCache.OK() && obs.templateCache.Value != nil {
+			status.TemplateCache = obs.templateCache.Value.Name
+		}
+	}
+
+	if !obs.job.OK() {
+		status.Progress = &aimv1alpha1.AIMBatchJobProgress{Total: batchJob.GetShards()}
+		return
+	}
+
+	job := obs.job.Value
+	status.Job = job.Name
+	status.Progress = jobProgress(job, batchJob.GetShards())
+	status.StartTime = job.Status.StartTime
+	status.CompletionTime = job.Status.CompletionTime
+}
@@ -0,0 +1,334 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimbatchjob
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservicetemplate"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const testNamespace = "team-a"
+
+var modelLookupForTest = aimservicetemplate.ModelLookupResult{Image: "ghcr.io/amd/aim-qwen:1.0"}
+
+func newTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = aimv1alpha1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newTestBatchJob() *aimv1alpha1.AIMBatchJob {
+	return &aimv1alpha1.AIMBatchJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "summarize", Namespace: testNamespace, UID: "batch-uid"},
+		Spec: aimv1alpha1.AIMBatchJobSpec{
+			Template: "qwen-latency",
+			Input: aimv1alpha1.AIMBatchJobDataset{
+				PersistentVolumeClaim: &aimv1alpha1.AIMBatchJobVolumeDataset{ClaimName: "data", Path: "prompts.jsonl"},
+			},
+			Output: aimv1alpha1.AIMBatchJobDataset{
+				PersistentVolumeClaim: &aimv1alpha1.AIMBatchJobVolumeDataset{ClaimName: "data", Path: "results"},
+			},
+			Shards: ptr.To(int32(3)),
+			Env:    []corev1.EnvVar{{Name: constants.EnvAIMMetric, Value: "throughput"}},
+		},
+	}
+}
+
+func newTestTemplateStatus() aimv1alpha1.AIMServiceTemplateStatus {
+	return aimv1alpha1.AIMServiceTemplateStatus{
+		Status: constants.AIMStatusReady,
+		ResolvedHardware: &aimv1alpha1.AIMHardwareRequirements{
+			GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 2, Model: "MI300X"},
+		},
+	}
+}
+
+func newTestTemplate() *aimv1alpha1.AIMServiceTemplate {
+	latency := aimv1alpha1.AIMMetric("latency")
+	return &aimv1alpha1.AIMServiceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "qwen-latency", Namespace: testNamespace},
+		Spec: aimv1alpha1.AIMServiceTemplateSpec{
+			AIMServiceTemplateSpecCommon: aimv1alpha1.AIMServiceTemplateSpecCommon{
+				ModelName:            "qwen",
+				ProfileId:            "profile-1",
+				AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Metric: &latency},
+			},
+		},
+		Status: newTestTemplateStatus(),
+	}
+}
+
+func newTestCache(name string, status constants.AIMStatus) *aimv1alpha1.AIMTemplateCache {
+	return &aimv1alpha1.AIMTemplateCache{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: aimv1alpha1.AIMTemplateCacheSpec{
+			TemplateName: "qwen-latency",
+			Mode:         aimv1alpha1.TemplateCacheModeShared,
+		},
+		Status: aimv1alpha1.AIMTemplateCacheStatus{
+			Status: status,
+			Artifacts: map[string]aimv1alpha1.AIMResolvedArtifact{
+				"Qwen/Qwen2-0.5B": {
+					Name:                  "qwen2-artifact",
+					Model:                 "Qwen/Qwen2-0.5B",
+					Status:                constants.AIMStatusReady,
+					PersistentVolumeClaim: "qwen2-artifact-pvc",
+				},
+			},
+		},
+	}
+}
+
+func fetchForTest(t *testing.T, c client.Client, batchJob *aimv1alpha1.AIMBatchJob) BatchJobFetchResult {
+	t.Helper()
+	reconciler := &BatchJobReconciler{}
+	return reconciler.FetchRemoteState(context.Background(), c,
+		controllerutils.ReconcileContext[*aimv1alpha1.AIMBatchJob]{Object: batchJob})
+}
+
+func TestFetchRemoteState_ResolvesTemplateModelAndCache(t *testing.T) {
+	batchJob := newTestBatchJob()
+	model := &aimv1alpha1.AIMClusterModel{
+		ObjectMeta: metav1.ObjectMeta{Name: "qwen"},
+		Spec:       aimv1alpha1.AIMModelSpec{Image: "ghcr.io/amd/aim-qwen:1.0"},
+	}
+	encrypted := newTestCache("encrypted", constants.AIMStatusReady)
+	encrypted.Spec.Encryption = &aimv1alpha1.AIMStorageEncryptionConfig{}
+	c := newTestClient(batchJob, newTestTemplate(), model,
+		newTestCache("b-warm", constants.AIMStatusReady),
+		newTestCache("a-warming", constants.AIMStatusProgressing),
+		encrypted)
+
+	fetch := fetchForTest(t, c, batchJob)
+
+	if !fetch.job.IsNotFound() {
+		t.Fatalf("expected the job to be missing, got %v", fetch.job.Error)
+	}
+	if !fetch.template.OK() || fetch.template.Value.ref.Scope != aimv1alpha1.AIMResolutionScopeNamespace {
+		t.Fatalf("expected the namespace template, got %+v", fetch.template)
+	}
+	if !fetch.model.OK() || fetch.model.Value.Image != "ghcr.io/amd/aim-qwen:1.0" {
+		t.Fatalf("expected the cluster model image, got %+v", fetch.model)
+	}
+	if fetch.templateCache.Value == nil || fetch.templateCache.Value.Name != "b-warm" {
+		t.Errorf("expected the Ready unencrypted cache, got %+v", fetch.templateCache.Value)
+	}
+	if !fetch.dependenciesReady() {
+		t.Error("expected dependencies to be ready")
+	}
+}
+
+func TestFetchRemoteState_ClusterTemplateNamespaceDenied(t *testing.T) {
+	batchJob := newTestBatchJob()
+	clusterTemplate := &aimv1alpha1.AIMClusterServiceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "qwen-latency"},
+		Spec: aimv1alpha1.AIMClusterServiceTemplateSpec{
+			AIMServiceTemplateSpecCommon: aimv1alpha1.AIMServiceTemplateSpecCommon{ModelName: "qwen"},
+			NamespaceSelector:            &metav1.LabelSelector{MatchLabels: map[string]string{"aim": "enabled"}},
+		},
+		Status: newTestTemplateStatus(),
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}}
+	c := newTestClient(batchJob, clusterTemplate, namespace)
+
+	fetch := fetchForTest(t, c, batchJob)
+
+	health := fetch.GetComponentHealth()
+	if len(health) != 1 || len(health[0].Errors) != 1 {
+		t.Fatalf("expected a single template error, got %+v", health)
+	}
+	if fetch.template.OK() {
+		t.Error("expected the cluster template to be denied")
+	}
+
+	namespace.Labels = map[string]string{"aim": "enabled"}
+	fetch = fetchForTest(t, newTestClient(batchJob, clusterTemplate, namespace), batchJob)
+	if !fetch.template.OK() || fetch.template.Value.ref.Scope != aimv1alpha1.AIMResolutionScopeCluster {
+		t.Errorf("expected the cluster template once the namespace matches, got %+v", fetch.template)
+	}
+}
+
+func TestFetchRemoteState_ExistingJobSkipsDependencies(t *testing.T) {
+	batchJob := newTestBatchJob()
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: JobName(batchJob), Namespace: testNamespace}}
+	c := newTestClient(batchJob, job)
+
+	fetch := fetchForTest(t, c, batchJob)
+
+	if !fetch.job.OK() {
+		t.Fatalf("expected the job, got %v", fetch.job.Error)
+	}
+	if fetch.template.Value != nil || fetch.template.Error != nil {
+		t.Error("expected the template not to be fetched once the job exists")
+	}
+
+	reconciler := &BatchJobReconciler{}
+	reconcileCtx := controllerutils.ReconcileContext[*aimv1alpha1.AIMBatchJob]{Object: batchJob}
+	obs := reconciler.ComposeState(context.Background(), reconcileCtx, fetch)
+	if plan := reconciler.PlanResources(context.Background(), reconcileCtx, obs); len(plan.GetToApply()) != 0 {
+		t.Errorf("expected nothing to be applied for an existing job, got %d objects", len(plan.GetToApply()))
+	}
+}
+
+func TestBuildJob(t *testing.T) {
+	batchJob := newTestBatchJob()
+	template := newTestTemplate()
+	job := buildJob(jobInputs{
+		batchJob: batchJob,
+		template: &resolvedTemplate{
+			ref:    aimv1alpha1.AIMResolvedReference{Name: template.Name, Scope: aimv1alpha1.AIMResolutionScopeNamespace},
+			spec:   &template.Spec.AIMServiceTemplateSpecCommon,
+			status: &template.Status,
+		},
+		model:         &modelLookupForTest,
+		templateCache: newTestCache("warm", constants.AIMStatusReady),
+	})
+
+	if job.Spec.CompletionMode == nil || *job.Spec.CompletionMode != batchv1.IndexedCompletion {
+		t.Errorf("expected an Indexed job, got %v", job.Spec.CompletionMode)
+	}
+	if *job.Spec.Completions != 3 || *job.Spec.Parallelism != 3 {
+		t.Errorf("expected 3 completions and parallelism, got %d/%d", *job.Spec.Completions, *job.Spec.Parallelism)
+	}
+	if job.Labels[constants.LabelKeyManagedBy] != constants.LabelValueManagedBy {
+		t.Error("expected the managed-by label so the job is in the manager's cache")
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	if container.Image != modelLookupForTest.Image {
+		t.Errorf("expected the model image, got %s", container.Image)
+	}
+	env := map[string]corev1.EnvVar{}
+	for _, e := range container.Env {
+		env[e.Name] = e
+	}
+	for name, want := range map[string]string{
+		constants.EnvAIMBatchInput:      "/workspace/batch/input/prompts.jsonl",
+		constants.EnvAIMBatchOutput:     "/workspace/batch/output/results",
+		constants.EnvAIMBatchShardCount: "3",
+		constants.EnvAIMProfileID:       "profile-1",
+		constants.EnvAIMMetric:          "throughput", // batch job env overrides the template metric
+	} {
+		if env[name].Value != want {
+			t.Errorf("expected %s=%q, got %q", name, want, env[name].Value)
+		}
+	}
+	if ref := env[constants.EnvAIMBatchShardIndex].ValueFrom; ref == nil || ref.FieldRef == nil {
+		t.Error("expected the shard index from the completion index annotation")
+	}
+
+	gpus := container.Resources.Limits[corev1.ResourceName(constants.DefaultGPUResourceName)]
+	if gpus.Value() != 2 {
+		t.Errorf("expected 2 GPUs from the resolved hardware, got %s", gpus.String())
+	}
+
+	mounts := map[string]corev1.VolumeMount{}
+	for _, m := range container.VolumeMounts {
+		mounts[m.MountPath] = m
+	}
+	if m := mounts["/workspace/batch/input"]; !m.ReadOnly {
+		t.Error("expected the input to be mounted read-only")
+	}
+	if m := mounts["/workspace/batch/output"]; m.ReadOnly || m.Name != mounts["/workspace/batch/input"].Name {
+		t.Errorf("expected a writable output mount sharing the input volume, got %+v", m)
+	}
+	if m, ok := mounts["/workspace/cache/Qwen/Qwen2-0.5B"]; !ok || !m.ReadOnly {
+		t.Errorf("expected the cached artifact at the service mount path, got %+v", container.VolumeMounts)
+	}
+
+	claims := 0
+	for _, v := range job.Spec.Template.Spec.Volumes {
+		if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == "data" {
+			claims++
+		}
+	}
+	if claims != 1 {
+		t.Errorf("expected the shared data claim to be a single volume, got %d", claims)
+	}
+}
+
+func TestDecorateStatus(t *testing.T) {
+	batchJob := newTestBatchJob()
+	batchJob.Spec.Output = aimv1alpha1.AIMBatchJobDataset{S3URI: "s3://results/out/"}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: JobName(batchJob)},
+		Status: batchv1.JobStatus{
+			Active:           1,
+			CompletedIndexes: "0,2",
+			FailedIndexes:    ptr.To(""),
+			StartTime:        &metav1.Time{},
+		},
+	}
+	obs := BatchJobObservation{BatchJobFetchResult: BatchJobFetchResult{
+		batchJob: batchJob,
+		job:      controllerutils.FetchResult[*batchv1.Job]{Value: job},
+	}}
+	status := &aimv1alpha1.AIMBatchJobStatus{TemplateCache: "warm"}
+
+	(&BatchJobReconciler{}).DecorateStatus(status, nil, obs)
+
+	if status.OutputLocation != "s3://results/out/" {
+		t.Errorf("expected the S3 output location, got %q", status.OutputLocation)
+	}
+	if status.Job != job.Name {
+		t.Errorf("expected job %s, got %s", job.Name, status.Job)
+	}
+	want := aimv1alpha1.AIMBatchJobProgress{Total: 3, Succeeded: 2, Active: 1}
+	if status.Progress == nil || *status.Progress != want {
+		t.Errorf("expected progress %+v, got %+v", want, status.Progress)
+	}
+	if status.TemplateCache != "warm" {
+		t.Error("expected the template cache to be kept once the job exists")
+	}
+}
+
+func TestCountIndexes(t *testing.T) {
+	tests := map[string]int{
+		"":          0,
+		"3":         1,
+		"0,2":       2,
+		"1,3-5,7":   5,
+		"0-9":       10,
+		"5-3,bogus": 1,
+	}
+	for indexes, want := range tests {
+		if got := countIndexes(indexes); got != want {
+			t.Errorf("countIndexes(%q) = %d, want %d", indexes, got, want)
+		}
+	}
+}
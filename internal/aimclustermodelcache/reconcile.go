@@ -0,0 +1,275 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimclustermodelcache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const (
+	artifactComponentName = "Artifact"
+	volumeComponentName   = "Volume"
+)
+
+// ClusterModelCacheReconciler implements domain reconciliation for AIMClusterModelCache.
+type ClusterModelCacheReconciler struct {
+	Scheme            *runtime.Scheme
+	OperatorNamespace string
+}
+
+// ============================================================================
+// FETCH
+// ============================================================================
+
+type ClusterModelCacheFetchResult struct {
+	cache *aimv1alpha1.AIMClusterModelCache
+
+	artifact controllerutils.FetchResult[*aimv1alpha1.AIMArtifact]
+
+	// claim is the PVC of the artifact, fetched once the artifact reports it
+	claim controllerutils.FetchResult[*corev1.PersistentVolumeClaim]
+
+	// sharedVolumes are the per-namespace PersistentVolumes created for this cache
+	sharedVolumes controllerutils.FetchResult[*corev1.PersistentVolumeList]
+
+	namespaces controllerutils.FetchResult[*corev1.NamespaceList]
+}
+
+func (r *ClusterModelCacheReconciler) FetchRemoteState(
+	ctx context.Context,
+	c client.Client,
+	reconcileCtx controllerutils.ReconcileContext[*aimv1alpha1.AIMClusterModelCache],
+) ClusterModelCacheFetchResult {
+	cache := reconcileCtx.Object
+	result := ClusterModelCacheFetchResult{cache: cache}
+
+	result.artifact = controllerutils.Fetch(ctx, c,
+		client.ObjectKey{Namespace: r.OperatorNamespace, Name: ArtifactName(cache)},
+		&aimv1alpha1.AIMArtifact{})
+	if result.artifact.OK() && result.artifact.Value.Status.PersistentVolumeClaim != "" {
+		result.claim = controllerutils.Fetch(ctx, c,
+			client.ObjectKey{Namespace: r.OperatorNamespace, Name: result.artifact.Value.Status.PersistentVolumeClaim},
+			&corev1.PersistentVolumeClaim{})
+	}
+
+	result.sharedVolumes = controllerutils.FetchList(ctx, c, &corev1.PersistentVolumeList{},
+		client.MatchingLabels{constants.LabelKeyClusterModelCache: cache.Name})
+	result.namespaces = controllerutils.FetchList(ctx, c, &corev1.NamespaceList{})
+
+	return result
+}
+
+// GetComponentHealth returns the health of the artifact and of the volume it provides.
+func (result ClusterModelCacheFetchResult) GetComponentHealth() []controllerutils.ComponentHealth {
+	health := []controllerutils.ComponentHealth{
+		result.artifact.ToDownstreamComponentHealth(artifactComponentName,
+			func(artifact *aimv1alpha1.AIMArtifact) controllerutils.ComponentHealth {
+				return controllerutils.ComponentHealth{
+					State:   artifact.Status.Status,
+					Reason:  string(artifact.Status.Status),
+					Message: fmt.Sprintf("Artifact %s is %s", artifact.Name, artifact.Status.Status),
+				}
+			}),
+	}
+
+	if result.artifact.OK() && result.artifact.Value.Status.Status == constants.AIMStatusReady {
+		if result.claim.HasError() && !result.claim.IsNotFound() {
+			health = append(health, result.claim.ToDownstreamComponentHealth(volumeComponentName, nil))
+		} else if result.volumeName() == "" {
+			health = append(health, controllerutils.ComponentHealth{
+				Component:      volumeComponentName,
+				State:          constants.AIMStatusProgressing,
+				Reason:         aimv1alpha1.ClusterModelCacheReasonVolumeNotBound,
+				DependencyType: controllerutils.DependencyTypeDownstream,
+				WaitingOn: &controllerutils.ObjectRef{
+					Kind:      "PersistentVolumeClaim",
+					Namespace: result.artifact.Value.Namespace,
+					Name:      result.artifact.Value.Status.PersistentVolumeClaim,
+				},
+			})
+		}
+	}
+
+	// List failures only block revocation, so they are reported without a success entry
+	if result.sharedVolumes.HasError() {
+		health = append(health, result.sharedVolumes.ToDownstreamComponentHealth("SharedVolumes", nil))
+	}
+	if result.namespaces.HasError() {
+		health = append(health, result.namespaces.ToUpstreamComponentHealth("Namespaces", nil))
+	}
+
+	return health
+}
+
+// volumeName returns the name of the PersistentVolume bound to the artifact claim, if any.
+func (result ClusterModelCacheFetchResult) volumeName() string {
+	if !result.claim.OK() || result.claim.Value.Status.Phase != corev1.ClaimBound {
+		return ""
+	}
+	return result.claim.Value.Spec.VolumeName
+}
+
+// ============================================================================
+// OBSERVATION
+// ============================================================================
+
+type ClusterModelCacheObservation struct {
+	ClusterModelCacheFetchResult
+
+	// revokedVolumes are shared volumes whose namespace no longer matches the selector
+	revokedVolumes []*corev1.PersistentVolume
+
+	// sharedNamespaces are the namespaces that keep their shared volume, sorted
+	sharedNamespaces []string
+}
+
+func (r *ClusterModelCacheReconciler) ComposeState(
+	_ context.Context,
+	_ controllerutils.ReconcileContext[*aimv1alpha1.AIMClusterModelCache],
+	fetch ClusterModelCacheFetchResult,
+) ClusterModelCacheObservation {
+	obs := ClusterModelCacheObservation{ClusterModelCacheFetchResult: fetch}
+
+	// Without both lists the revocation decision would be based on partial data
+	if !fetch.sharedVolumes.OK() || !fetch.namespaces.OK() {
+		return obs
+	}
+
+	// Terminating namespaces lose their shared volumes like deleted ones, so that a volume is not
+	// left behind when the namespace is gone
+	namespaceLabels := make(map[string]map[string]string, len(fetch.namespaces.Value.Items))
+	for _, ns := range fetch.namespaces.Value.Items {
+		if ns.DeletionTimestamp == nil {
+			namespaceLabels[ns.Name] = ns.Labels
+		}
+	}
+
+	for i := range fetch.sharedVolumes.Value.Items {
+		pv := &fetch.sharedVolumes.Value.Items[i]
+		if pv.Spec.ClaimRef == nil {
+			continue
+		}
+		namespace := pv.Spec.ClaimRef.Namespace
+		nsLabels, exists := namespaceLabels[namespace]
+		if !exists || !fetch.cache.AllowsNamespace(nsLabels) {
+			obs.revokedVolumes = append(obs.revokedVolumes, pv)
+			continue
+		}
+		if !slices.Contains(obs.sharedNamespaces, namespace) {
+			obs.sharedNamespaces = append(obs.sharedNamespaces, namespace)
+		}
+	}
+	slices.Sort(obs.sharedNamespaces)
+
+	return obs
+}
+
+// ============================================================================
+// PLAN
+// ============================================================================
+
+func (r *ClusterModelCacheReconciler) PlanResources(
+	_ context.Context,
+	reconcileCtx controllerutils.ReconcileContext[*aimv1alpha1.AIMClusterModelCache],
+	obs ClusterModelCacheObservation,
+) controllerutils.PlanResult {
+	cache := reconcileCtx.Object
+	result := controllerutils.PlanResult{}
+
+	result.Apply(buildArtifact(cache, r.OperatorNamespace))
+
+	// Revoking access removes the claim first so the volume is released, then the volume once
+	// the claim is gone. The volumes use the Retain policy, so the shared data is never touched.
+	for _, pv := range obs.revokedVolumes {
+		result.Delete(&corev1.PersistentVolumeClaim{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pv.Spec.ClaimRef.Name,
+				Namespace: pv.Spec.ClaimRef.Namespace,
+			},
+		})
+		result.Delete(pv, controllerutils.WithWeight(1))
+	}
+
+	return result
+}
+
+// buildArtifact builds the AIMArtifact that downloads the model of a cluster cache into
+// the operator namespace. The artifact is owned by the cluster cache.
+func buildArtifact(cache *aimv1alpha1.AIMClusterModelCache, operatorNamespace string) *aimv1alpha1.AIMArtifact {
+	return &aimv1alpha1.AIMArtifact{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: aimv1alpha1.GroupVersion.String(),
+			Kind:       "AIMArtifact",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ArtifactName(cache),
+			Namespace: operatorNamespace,
+			Labels: map[string]string{
+				constants.LabelKeyClusterModelCache: cache.Name,
+			},
+		},
+		Spec: aimv1alpha1.AIMArtifactSpec{
+			SourceURI:          cache.Spec.SourceURI,
+			ModelID:            cache.Spec.ModelID,
+			StorageClassName:   cache.Spec.StorageClassName,
+			Size:               cache.Spec.Size,
+			Env:                cache.Spec.Env,
+			ModelDownloadImage: cache.Spec.ModelDownloadImage,
+			ImagePullSecrets:   cache.Spec.ImagePullSecrets,
+		},
+	}
+}
+
+// ============================================================================
+// STATUS
+// ============================================================================
+
+func (r *ClusterModelCacheReconciler) DecorateStatus(
+	status *aimv1alpha1.AIMClusterModelCacheStatus,
+	_ *controllerutils.ConditionManager,
+	obs ClusterModelCacheObservation,
+) {
+	status.Artifact = ArtifactName(obs.cache)
+	status.PersistentVolume = obs.volumeName()
+	status.SharedNamespaces = obs.sharedNamespaces
+	if obs.artifact.OK() {
+		status.DisplaySize = obs.artifact.Value.Status.DisplaySize
+		status.Revision, status.ManifestDigest = "", ""
+		if provenance := obs.artifact.Value.Status.Provenance; provenance != nil {
+			status.Revision = provenance.Revision
+			status.ManifestDigest = provenance.ManifestDigest
+		}
+	}
+}
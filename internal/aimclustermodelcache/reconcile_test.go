@@ -0,0 +1,290 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimclustermodelcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const testOperatorNamespace = "aim-system"
+
+func newTestCache(selector *metav1.LabelSelector) *aimv1alpha1.AIMClusterModelCache {
+	return &aimv1alpha1.AIMClusterModelCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "qwen", UID: "cache-uid"},
+		Spec: aimv1alpha1.AIMClusterModelCacheSpec{
+			SourceURI:         "hf://Qwen/Qwen2-0.5B",
+			StorageClassName:  "nfs",
+			Size:              resource.MustParse("10Gi"),
+			NamespaceSelector: selector,
+		},
+	}
+}
+
+func newSourceVolume() *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1234"},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				NFS: &corev1.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports/pvc-1234"},
+			},
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			StorageClassName: "nfs",
+			MountOptions:     []string{"nfsvers=4.1"},
+		},
+	}
+}
+
+func namespace(name string, labels map[string]string) corev1.Namespace {
+	return corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestAllowsNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector *metav1.LabelSelector
+		labels   map[string]string
+		want     bool
+	}{
+		{name: "nil selector allows nothing", selector: nil, labels: map[string]string{"team": "a"}, want: false},
+		{name: "empty selector allows all", selector: &metav1.LabelSelector{}, labels: nil, want: true},
+		{
+			name:     "matching labels",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			labels:   map[string]string{"team": "a", "env": "prod"},
+			want:     true,
+		},
+		{
+			name:     "non-matching labels",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			labels:   map[string]string{"team": "b"},
+			want:     false,
+		},
+		{
+			name: "invalid selector allows nothing",
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "team", Operator: "Bogus"},
+			}},
+			labels: map[string]string{"team": "a"},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newTestCache(tt.selector).AllowsNamespace(tt.labels); got != tt.want {
+				t.Errorf("AllowsNamespace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildSharedVolume(t *testing.T) {
+	cache := newTestCache(&metav1.LabelSelector{})
+	pv, pvc := BuildSharedVolume(cache, newSourceVolume(), "team-a")
+
+	if pv.Spec.NFS == nil || pv.Spec.NFS.Path != "/exports/pvc-1234" {
+		t.Errorf("expected the source NFS volume, got %+v", pv.Spec.PersistentVolumeSource)
+	}
+	if pv.Spec.PersistentVolumeReclaimPolicy != corev1.PersistentVolumeReclaimRetain {
+		t.Errorf("expected Retain reclaim policy, got %s", pv.Spec.PersistentVolumeReclaimPolicy)
+	}
+	if len(pv.Spec.AccessModes) != 1 || pv.Spec.AccessModes[0] != corev1.ReadOnlyMany {
+		t.Errorf("expected ReadOnlyMany access, got %v", pv.Spec.AccessModes)
+	}
+	if pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.Namespace != "team-a" || pv.Spec.ClaimRef.Name != pvc.Name {
+		t.Errorf("expected volume pre-bound to claim team-a/%s, got %+v", pvc.Name, pv.Spec.ClaimRef)
+	}
+	if pvc.Namespace != "team-a" || pvc.Spec.VolumeName != pv.Name {
+		t.Errorf("expected claim in team-a bound to %s, got %s/%s", pv.Name, pvc.Namespace, pvc.Spec.VolumeName)
+	}
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != "" {
+		t.Errorf("expected empty storage class to skip provisioning, got %v", pvc.Spec.StorageClassName)
+	}
+	for _, obj := range []metav1.Object{pv, pvc} {
+		if obj.GetLabels()[constants.LabelKeyClusterModelCache] != cache.Name {
+			t.Errorf("%s: expected cluster cache label", obj.GetName())
+		}
+		if refs := obj.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != cache.UID {
+			t.Errorf("%s: expected owner reference to the cluster cache, got %v", obj.GetName(), refs)
+		}
+	}
+
+	otherPV, otherPVC := BuildSharedVolume(cache, newSourceVolume(), "team-b")
+	if otherPV.Name == pv.Name {
+		t.Error("expected a distinct volume per namespace")
+	}
+	if otherPVC.Name != pvc.Name {
+		t.Error("expected the same claim name in every namespace")
+	}
+}
+
+func TestComposeAndPlan_RevokesNamespaces(t *testing.T) {
+	cache := newTestCache(&metav1.LabelSelector{MatchLabels: map[string]string{"shared-cache": "true"}})
+	source := newSourceVolume()
+	allowedPV, _ := BuildSharedVolume(cache, source, "team-a")
+	unlabeledPV, _ := BuildSharedVolume(cache, source, "team-b")
+	deletedPV, _ := BuildSharedVolume(cache, source, "team-c")
+
+	fetch := ClusterModelCacheFetchResult{
+		cache: cache,
+		sharedVolumes: controllerutils.FetchResult[*corev1.PersistentVolumeList]{
+			Value: &corev1.PersistentVolumeList{Items: []corev1.PersistentVolume{*allowedPV, *unlabeledPV, *deletedPV}},
+		},
+		namespaces: controllerutils.FetchResult[*corev1.NamespaceList]{
+			Value: &corev1.NamespaceList{Items: []corev1.Namespace{
+				namespace("team-a", map[string]string{"shared-cache": "true"}),
+				namespace("team-b", nil),
+			}},
+		},
+	}
+	reconciler := &ClusterModelCacheReconciler{OperatorNamespace: testOperatorNamespace}
+	reconcileCtx := controllerutils.ReconcileContext[*aimv1alpha1.AIMClusterModelCache]{Object: cache}
+
+	obs := reconciler.ComposeState(context.Background(), reconcileCtx, fetch)
+	if len(obs.sharedNamespaces) != 1 || obs.sharedNamespaces[0] != "team-a" {
+		t.Errorf("expected sharedNamespaces [team-a], got %v", obs.sharedNamespaces)
+	}
+
+	plan := reconciler.PlanResources(context.Background(), reconcileCtx, obs)
+
+	applied := plan.GetToApply()
+	if len(applied) != 1 {
+		t.Fatalf("expected only the artifact to be applied, got %d objects", len(applied))
+	}
+	artifact, ok := applied[0].(*aimv1alpha1.AIMArtifact)
+	if !ok {
+		t.Fatalf("expected an AIMArtifact, got %T", applied[0])
+	}
+	if artifact.Namespace != testOperatorNamespace || artifact.Spec.SourceURI != cache.Spec.SourceURI {
+		t.Errorf("unexpected artifact %s/%s for %s", artifact.Namespace, artifact.Name, artifact.Spec.SourceURI)
+	}
+
+	deleted := map[string]bool{}
+	for _, obj := range plan.GetToDelete() {
+		deleted[obj.GetNamespace()+"/"+obj.GetName()] = true
+		// Claims are deleted before the volumes they bind
+		_, isVolume := obj.(*corev1.PersistentVolume)
+		if wantWeight := map[bool]int{false: 0, true: 1}[isVolume]; plan.GetWeight(obj) != wantWeight {
+			t.Errorf("expected %s to have weight %d, got %d", obj.GetName(), wantWeight, plan.GetWeight(obj))
+		}
+	}
+	claimName := SharedVolumeClaimName(cache)
+	for _, key := range []string{
+		"team-b/" + claimName, "/" + unlabeledPV.Name,
+		"team-c/" + claimName, "/" + deletedPV.Name,
+	} {
+		if !deleted[key] {
+			t.Errorf("expected %s to be deleted, got %v", key, deleted)
+		}
+	}
+	if deleted["/"+allowedPV.Name] || deleted["team-a/"+claimName] {
+		t.Error("expected the allowed namespace to keep its volume")
+	}
+}
+
+func TestComposeState_RevokesTerminatingNamespace(t *testing.T) {
+	labels := map[string]string{"shared-cache": "true"}
+	cache := newTestCache(&metav1.LabelSelector{MatchLabels: labels})
+	source := newSourceVolume()
+	activePV, _ := BuildSharedVolume(cache, source, "team-a")
+	terminatingPV, _ := BuildSharedVolume(cache, source, "team-b")
+
+	terminating := namespace("team-b", labels)
+	terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	fetch := ClusterModelCacheFetchResult{
+		cache: cache,
+		sharedVolumes: controllerutils.FetchResult[*corev1.PersistentVolumeList]{
+			Value: &corev1.PersistentVolumeList{Items: []corev1.PersistentVolume{*activePV, *terminatingPV}},
+		},
+		namespaces: controllerutils.FetchResult[*corev1.NamespaceList]{
+			Value: &corev1.NamespaceList{Items: []corev1.Namespace{namespace("team-a", labels), terminating}},
+		},
+	}
+	reconciler := &ClusterModelCacheReconciler{OperatorNamespace: testOperatorNamespace}
+	obs := reconciler.ComposeState(context.Background(),
+		controllerutils.ReconcileContext[*aimv1alpha1.AIMClusterModelCache]{Object: cache}, fetch)
+
+	if len(obs.sharedNamespaces) != 1 || obs.sharedNamespaces[0] != "team-a" {
+		t.Errorf("expected sharedNamespaces [team-a], got %v", obs.sharedNamespaces)
+	}
+	if len(obs.revokedVolumes) != 1 || obs.revokedVolumes[0].Name != terminatingPV.Name {
+		t.Errorf("expected the volume of the terminating namespace to be revoked, got %d volumes", len(obs.revokedVolumes))
+	}
+}
+
+func TestComposeState_ListErrorKeepsVolumes(t *testing.T) {
+	cache := newTestCache(nil)
+	pv, _ := BuildSharedVolume(cache, newSourceVolume(), "team-a")
+	fetch := ClusterModelCacheFetchResult{
+		cache: cache,
+		sharedVolumes: controllerutils.FetchResult[*corev1.PersistentVolumeList]{
+			Value: &corev1.PersistentVolumeList{Items: []corev1.PersistentVolume{*pv}},
+		},
+		namespaces: controllerutils.FetchResult[*corev1.NamespaceList]{Error: context.DeadlineExceeded},
+	}
+	reconciler := &ClusterModelCacheReconciler{OperatorNamespace: testOperatorNamespace}
+	obs := reconciler.ComposeState(context.Background(),
+		controllerutils.ReconcileContext[*aimv1alpha1.AIMClusterModelCache]{Object: cache}, fetch)
+
+	if len(obs.revokedVolumes) != 0 {
+		t.Errorf("expected no revocation without the namespace list, got %d", len(obs.revokedVolumes))
+	}
+}
+
+func TestGetComponentHealth_VolumeNotBound(t *testing.T) {
+	fetch := ClusterModelCacheFetchResult{
+		cache: newTestCache(nil),
+		artifact: controllerutils.FetchResult[*aimv1alpha1.AIMArtifact]{
+			Value: &aimv1alpha1.AIMArtifact{Status: aimv1alpha1.AIMArtifactStatus{
+				Status:                constants.AIMStatusReady,
+				PersistentVolumeClaim: "artifact-pvc",
+			}},
+		},
+		claim: controllerutils.FetchResult[*corev1.PersistentVolumeClaim]{
+			Value: &corev1.PersistentVolumeClaim{Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending}},
+		},
+		sharedVolumes: controllerutils.FetchResult[*corev1.PersistentVolumeList]{Value: &corev1.PersistentVolumeList{}},
+		namespaces:    controllerutils.FetchResult[*corev1.NamespaceList]{Value: &corev1.NamespaceList{}},
+	}
+
+	health := fetch.GetComponentHealth()
+	if len(health) != 2 {
+		t.Fatalf("expected artifact and volume health, got %d components", len(health))
+	}
+	if health[1].Reason != aimv1alpha1.ClusterModelCacheReasonVolumeNotBound ||
+		health[1].State != constants.AIMStatusProgressing {
+		t.Errorf("expected Progressing %s, got %s %s", aimv1alpha1.ClusterModelCacheReasonVolumeNotBound,
+			health[1].State, health[1].Reason)
+	}
+}
@@ -0,0 +1,125 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimclustermodelcache
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// ArtifactName returns the name of the AIMArtifact that downloads the model of a cluster cache.
+// The artifact lives in the operator namespace.
+func ArtifactName(cache *aimv1alpha1.AIMClusterModelCache) string {
+	name, _ := utils.GenerateDerivedName([]string{"cluster", cache.Name}, utils.WithHashSource(cache.UID))
+	return name
+}
+
+// SharedVolumeClaimName returns the name of the PersistentVolumeClaim through which services
+// in a namespace mount a cluster cache.
+func SharedVolumeClaimName(cache *aimv1alpha1.AIMClusterModelCache) string {
+	name, _ := utils.GenerateDerivedName([]string{"shared", cache.Name}, utils.WithHashSource(cache.UID))
+	return name
+}
+
+// SharedVolumeName returns the name of the PersistentVolume that binds a cluster cache
+// into a namespace.
+func SharedVolumeName(cache *aimv1alpha1.AIMClusterModelCache, namespace string) string {
+	name, _ := utils.GenerateDerivedName([]string{"shared", cache.Name, namespace},
+		utils.WithHashSource(cache.UID, namespace))
+	return name
+}
+
+// BuildSharedVolume builds the PersistentVolume and PersistentVolumeClaim pair that mounts
+// the volume of a cluster cache read-only in a namespace. The PersistentVolume points at the
+// same storage as the source volume and is pre-bound to the claim, so no provisioner is involved.
+// It uses the Retain reclaim policy, so releasing the pair never deletes the shared data.
+// Both objects are owned by the cluster cache and garbage collected with it.
+func BuildSharedVolume(
+	cache *aimv1alpha1.AIMClusterModelCache,
+	source *corev1.PersistentVolume,
+	namespace string,
+) (*corev1.PersistentVolume, *corev1.PersistentVolumeClaim) {
+	claimName := SharedVolumeClaimName(cache)
+	labels := map[string]string{
+		constants.LabelKeyClusterModelCache: cache.Name,
+		constants.LabelK8sManagedBy:         constants.LabelValueManagedBy,
+	}
+	ownerRefs := []metav1.OwnerReference{{
+		APIVersion:         aimv1alpha1.GroupVersion.String(),
+		Kind:               "AIMClusterModelCache",
+		Name:               cache.Name,
+		UID:                cache.UID,
+		BlockOwnerDeletion: ptr.To(true),
+	}}
+	capacity := source.Spec.Capacity[corev1.ResourceStorage]
+
+	pv := &corev1.PersistentVolume{
+		TypeMeta: metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "PersistentVolume"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            SharedVolumeName(cache, namespace),
+			Labels:          labels,
+			OwnerReferences: ownerRefs,
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity:                      corev1.ResourceList{corev1.ResourceStorage: capacity},
+			PersistentVolumeSource:        *source.Spec.PersistentVolumeSource.DeepCopy(),
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			MountOptions:                  source.Spec.MountOptions,
+			VolumeMode:                    source.Spec.VolumeMode,
+			NodeAffinity:                  source.Spec.NodeAffinity.DeepCopy(),
+			StorageClassName:              "",
+			ClaimRef: &corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "PersistentVolumeClaim",
+				Namespace:  namespace,
+				Name:       claimName,
+			},
+		},
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "PersistentVolumeClaim"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            claimName,
+			Namespace:       namespace,
+			Labels:          labels,
+			OwnerReferences: ownerRefs,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: capacity},
+			},
+			StorageClassName: ptr.To(""),
+			VolumeName:       pv.Name,
+			VolumeMode:       source.Spec.VolumeMode,
+		},
+	}
+	return pv, pvc
+}
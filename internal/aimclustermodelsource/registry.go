@@ -212,7 +212,12 @@ func (c *RegistryClient) fetchImageTags(
 		return nil, fmt.Errorf("invalid repository %s: %w", repository, err)
 	}
 
-	tags, err := remote.List(repoRef, remote.WithAuthFromKeychain(keychain), remote.WithContext(ctx))
+	var tags []string
+	err = utils.Retry(ctx, utils.DefaultRetryPolicy, func(ctx context.Context) error {
+		var listErr error
+		tags, listErr = remote.List(repoRef, remote.WithAuthFromKeychain(keychain), remote.WithContext(ctx))
+		return listErr
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list tags for %s: %w", repository, err)
 	}
@@ -314,34 +319,37 @@ func (c *RegistryClient) fetchDockerHubRepositories(ctx context.Context, namespa
 }
 
 // fetchJSON performs an HTTP GET and decodes the JSON response.
+// Transient failures (network errors, rate limiting, server errors) are retried.
 func (c *RegistryClient) fetchJSON(ctx context.Context, url, authToken string, target interface{}) error {
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
+	return utils.Retry(ctx, utils.DefaultRetryPolicy, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
 
-	if authToken != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
-	}
+		if authToken != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
+		}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to fetch %s: %w", url, err)
-	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", url, err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status %d from %s: %s", resp.StatusCode, url, string(body))
-	}
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			return utils.NewHTTPStatusError(resp.StatusCode, url, string(body))
+		}
 
-	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
-		return fmt.Errorf("failed to decode JSON response: %w", err)
-	}
+		if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+			return fmt.Errorf("failed to decode JSON response: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // listRegistryV2Images uses the Registry v2 API to list repositories and tags.
@@ -366,7 +374,12 @@ func (c *RegistryClient) listRegistryV2Images(
 		return nil, fmt.Errorf("invalid registry %s: %w", registry, err)
 	}
 
-	repos, err := remote.Catalog(ctx, registryRef, remote.WithAuthFromKeychain(keychain))
+	var repos []string
+	err = utils.Retry(ctx, utils.DefaultRetryPolicy, func(ctx context.Context) error {
+		var catalogErr error
+		repos, catalogErr = remote.Catalog(ctx, registryRef, remote.WithAuthFromKeychain(keychain))
+		return catalogErr
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list catalog for %s: %w", registry, err)
 	}
@@ -598,34 +611,39 @@ func (c *RegistryClient) fetchGitHubPackages(ctx context.Context, token, org str
 		url := fmt.Sprintf("https://api.github.com/orgs/%s/packages?package_type=container&per_page=%d&page=%d",
 			org, perPage, page)
 
-		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
+		var result []struct {
+			Name string `json:"name"`
 		}
+		err := utils.Retry(ctx, utils.DefaultRetryPolicy, func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+			if err != nil {
+				return fmt.Errorf("failed to create request: %w", err)
+			}
 
-		req.Header.Set("Accept", "application/vnd.github+json")
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-		req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+			req.Header.Set("Accept", "application/vnd.github+json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
 
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch packages: %w", err)
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			_ = resp.Body.Close()
-			return nil, fmt.Errorf("unexpected status %d from GitHub API", resp.StatusCode)
-		}
+			resp, err := c.httpClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("failed to fetch packages: %w", err)
+			}
+			defer func() {
+				_ = resp.Body.Close()
+			}()
 
-		var result []struct {
-			Name string `json:"name"`
-		}
+			if resp.StatusCode != http.StatusOK {
+				return utils.NewHTTPStatusError(resp.StatusCode, "GitHub API", "")
+			}
 
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			_ = resp.Body.Close()
-			return nil, fmt.Errorf("failed to decode JSON response: %w", err)
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				return fmt.Errorf("failed to decode JSON response: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
-		_ = resp.Body.Close()
 
 		for _, pkg := range result {
 			packages = append(packages, pkg.Name)
@@ -31,7 +31,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -57,6 +59,7 @@ import (
 //
 // Returns:
 //   - *imageMetadata: Extracted metadata if successful
+//   - *AIMImageSecurity: SBOM and CVE scan information from labels and manifest annotations, if any
 //   - error: Any error encountered during inspection (authentication, network, parsing, etc.)
 //     Registry access errors are wrapped in ImageRegistryError for categorization.
 func inspectImage(
@@ -65,7 +68,7 @@ func inspectImage(
 	imagePullSecrets []corev1.LocalObjectReference,
 	clientset kubernetes.Interface,
 	secretNamespace string,
-) (*aimv1alpha1.ImageMetadata, error) {
+) (*aimv1alpha1.ImageMetadata, *aimv1alpha1.AIMImageSecurity, error) {
 	logger := ctrl.LoggerFrom(ctx)
 
 	// Parse the image reference
@@ -73,7 +76,7 @@ func inspectImage(
 	if err != nil {
 		// Parse errors are user errors (invalid image format) - log at Info level without stack trace
 		logger.Info("Invalid image reference format", "imageURI", imageURI, "error", err.Error())
-		return nil, fmt.Errorf("failed to parse image reference %q: %w", imageURI, err)
+		return nil, nil, fmt.Errorf("failed to parse image reference %q: %w", imageURI, err)
 	}
 
 	// Pre-validate that all referenced imagePullSecrets exist.
@@ -91,7 +94,7 @@ func inspectImage(
 					logger.Info("Referenced imagePullSecret not found",
 						"secret", secret.Name,
 						"namespace", secretNamespace)
-					return nil, controllerutils.NewMissingUpstreamDependencyError(
+					return nil, nil, controllerutils.NewMissingUpstreamDependencyError(
 						"SecretNotFound",
 						fmt.Sprintf("imagePullSecret %q not found in namespace %q", secret.Name, secretNamespace),
 						err,
@@ -101,7 +104,7 @@ func inspectImage(
 				logger.Error(err, "Failed to verify imagePullSecret",
 					"secret", secret.Name,
 					"namespace", secretNamespace)
-				return nil, controllerutils.NewInfrastructureError(
+				return nil, nil, controllerutils.NewInfrastructureError(
 					"SecretAccessFailed",
 					fmt.Sprintf("failed to access imagePullSecret %q: %v", secret.Name, err),
 					err,
@@ -113,85 +116,99 @@ func inspectImage(
 
 	keychain, err := utils.BuildKeychain(ctx, clientset, secretNamespace, imagePullSecrets)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Fetch the image descriptor
-	desc, err := remote.Get(ref, remote.WithAuthFromKeychain(keychain), remote.WithContext(ctx))
+	// Fetch the image config, retrying transient registry failures
+	var configFile *v1.ConfigFile
+	var annotations map[string]string
+	err = utils.Retry(ctx, utils.DefaultRetryPolicy, func(ctx context.Context) error {
+		var fetchErr error
+		configFile, annotations, fetchErr = fetchImageConfigFile(ctx, ref, keychain, imageURI)
+		return fetchErr
+	})
 	if err != nil {
 		errType := utils.CategorizeRegistryError(err)
 		// Log user errors (auth, not-found) at Info level without stack trace
 		// Log system errors (generic) at ListError level with stack trace
 		if errType == utils.ImagePullErrorAuth || errType == utils.ImagePullErrorNotFound {
-			logger.Info("Failed to fetch image descriptor",
+			logger.Info("Failed to fetch image config",
 				"imageURI", imageURI,
 				"errorType", errType,
 				"error", err.Error())
 		} else {
-			logger.Error(err, "Failed to fetch image descriptor",
+			logger.Error(err, "Failed to fetch image config",
 				"imageURI", imageURI,
 				"errorType", errType)
 		}
-		return nil, &utils.ImageRegistryError{
-			Type:    errType,
+		return nil, nil, err
+	}
+
+	// Fail fast on images that can never run on the GPU nodes
+	if err := checkImagePlatform(imageURI, configFile); err != nil {
+		logger.Info("Image platform not supported", "imageURI", imageURI,
+			"os", configFile.OS, "architecture", configFile.Architecture)
+		return nil, nil, err
+	}
+
+	// Extract metadata from labels
+	labelCount := len(configFile.Config.Labels)
+	metadata, err := parseImageLabels(configFile.Config.Labels)
+	if err != nil {
+		logger.Error(err, "Failed to parse image labels", "imageURI", imageURI, "labelCount", labelCount)
+		return nil, nil, fmt.Errorf("failed to parse image labels: %w", err)
+	}
+
+	logger.V(1).Info("Successfully extracted image metadata", "imageURI", imageURI,
+		"canonicalName", metadata.Model.CanonicalName,
+		"recommendedDeploymentCount", len(metadata.Model.RecommendedDeployments))
+
+	return metadata, parseImageSecurity(configFile.Config.Labels, annotations), nil
+}
+
+// fetchImageConfigFile fetches the config file and manifest annotations of an image. The
+// descriptor, image and config are fetched within a single call so that a retry covers all of
+// them with the same context. Registry access errors are wrapped in ImageRegistryError for
+// categorization.
+func fetchImageConfigFile(
+	ctx context.Context,
+	ref name.Reference,
+	keychain authn.Keychain,
+	imageURI string,
+) (*v1.ConfigFile, map[string]string, error) {
+	desc, err := remote.Get(ref, remote.WithAuthFromKeychain(keychain), remote.WithContext(ctx))
+	if err != nil {
+		return nil, nil, &utils.ImageRegistryError{
+			Type:    utils.CategorizeRegistryError(err),
 			Message: fmt.Sprintf("failed to fetch image %q: %v", imageURI, err),
 			Cause:   err,
 		}
 	}
 
-	// Get the image
 	img, err := desc.Image()
 	if err != nil {
-		errType := utils.CategorizeRegistryError(err)
-		// Log user errors at Info level, system errors at ListError level
-		if errType == utils.ImagePullErrorAuth || errType == utils.ImagePullErrorNotFound {
-			logger.Info("Failed to get image from descriptor",
-				"imageURI", imageURI,
-				"errorType", errType,
-				"error", err.Error())
-		} else {
-			logger.Error(err, "Failed to get image from descriptor", "imageURI", imageURI, "errorType", errType)
-		}
-		return nil, &utils.ImageRegistryError{
-			Type:    errType,
+		return nil, nil, &utils.ImageRegistryError{
+			Type:    utils.CategorizeRegistryError(err),
 			Message: fmt.Sprintf("failed to get image from descriptor: %v", err),
 			Cause:   err,
 		}
 	}
 
-	// Get the config file which contains labels
 	configFile, err := img.ConfigFile()
 	if err != nil {
-		errType := utils.CategorizeRegistryError(err)
-		// Log user errors at Info level, system errors at ListError level
-		if errType == utils.ImagePullErrorAuth || errType == utils.ImagePullErrorNotFound {
-			logger.Info("Failed to get image config file",
-				"imageURI", imageURI,
-				"errorType", errType,
-				"error", err.Error())
-		} else {
-			logger.Error(err, "Failed to get image config file", "imageURI", imageURI, "errorType", errType)
-		}
-		return nil, &utils.ImageRegistryError{
-			Type:    errType,
+		return nil, nil, &utils.ImageRegistryError{
+			Type:    utils.CategorizeRegistryError(err),
 			Message: fmt.Sprintf("failed to get image config: %v", err),
 			Cause:   err,
 		}
 	}
 
-	// Extract metadata from labels
-	labelCount := len(configFile.Config.Labels)
-	metadata, err := parseImageLabels(configFile.Config.Labels)
-	if err != nil {
-		logger.Error(err, "Failed to parse image labels", "imageURI", imageURI, "labelCount", labelCount)
-		return nil, fmt.Errorf("failed to parse image labels: %w", err)
+	// Manifest annotations are best-effort; the manifest was already fetched with the image
+	var annotations map[string]string
+	if manifest, err := img.Manifest(); err == nil && manifest != nil {
+		annotations = manifest.Annotations
 	}
-
-	logger.V(1).Info("Successfully extracted image metadata", "imageURI", imageURI,
-		"canonicalName", metadata.Model.CanonicalName,
-		"recommendedDeploymentCount", len(metadata.Model.RecommendedDeployments))
-
-	return metadata, nil
+	return configFile, annotations, nil
 }
 
 // metadataFormatError indicates the image metadata is malformed and cannot be processed.
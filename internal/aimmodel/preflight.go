@@ -0,0 +1,120 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimmodel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// supportedImagePlatform is the platform AIM inference images must be built for.
+// GPU nodes running AIM workloads are linux/amd64.
+var supportedImagePlatform = v1.Platform{OS: "linux", Architecture: "amd64"}
+
+// checkImagePlatform validates that the image config targets the supported platform.
+// Empty OS or architecture fields are accepted, as some builders omit them.
+func checkImagePlatform(imageURI string, configFile *v1.ConfigFile) error {
+	if configFile == nil {
+		return nil
+	}
+	if (configFile.OS == "" || configFile.OS == supportedImagePlatform.OS) &&
+		(configFile.Architecture == "" || configFile.Architecture == supportedImagePlatform.Architecture) {
+		return nil
+	}
+	return controllerutils.NewInvalidSpecError(
+		aimv1alpha1.AIMModelReasonImagePlatformUnsupported,
+		fmt.Sprintf("image %q is built for %s/%s, but %s is required",
+			imageURI, configFile.OS, configFile.Architecture, supportedImagePlatform.String()),
+		nil,
+	)
+}
+
+// classifyImagePreflightError categorizes errors from the image preflight so that images that
+// cannot work as specified (typos, missing tags, wrong platform, malformed AIM labels) fail fast
+// with InvalidSpec instead of being retried or reaching a discovery job.
+// Errors that may resolve on their own (registry outages, network) are returned unchanged.
+func classifyImagePreflightError(imageURI string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var stateEngineErr controllerutils.StateEngineError
+	if errors.As(err, &stateEngineErr) {
+		return err
+	}
+
+	var badName *name.ErrBadName
+	if errors.As(err, &badName) {
+		return controllerutils.NewInvalidSpecError(
+			aimv1alpha1.AIMModelReasonInvalidImageReference,
+			fmt.Sprintf("image reference %q is invalid: %v", imageURI, badName),
+			err,
+		)
+	}
+
+	var formatErr *metadataFormatError
+	if errors.As(err, &formatErr) {
+		return controllerutils.NewInvalidSpecError(
+			aimv1alpha1.AIMModelReasonInvalidImageMetadata,
+			fmt.Sprintf("image %q has malformed AIM metadata labels: %s", imageURI, formatErr.Error()),
+			err,
+		)
+	}
+
+	var registryErr *utils.ImageRegistryError
+	if errors.As(err, &registryErr) {
+		switch registryErr.Type {
+		case utils.ImagePullErrorNotFound:
+			return controllerutils.NewInvalidSpecError(
+				aimv1alpha1.AIMModelReasonImageNotFound,
+				fmt.Sprintf("image %q not found in registry", imageURI),
+				err,
+			)
+		case utils.ImagePullErrorGeneric:
+			// Multi-platform indexes without a linux/amd64 child fail when resolving the image
+			if strings.Contains(registryErr.Error(), "no child with platform") {
+				return controllerutils.NewInvalidSpecError(
+					aimv1alpha1.AIMModelReasonImagePlatformUnsupported,
+					fmt.Sprintf("image %q has no %s variant", imageURI, supportedImagePlatform.String()),
+					err,
+				)
+			}
+		case utils.ImagePullErrorAuth:
+			return controllerutils.NewAuthError(
+				aimv1alpha1.AIMModelReasonImageRegistryAuthFailed,
+				fmt.Sprintf("not authorized to access image %q; check imagePullSecrets", imageURI),
+				err,
+			)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,139 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimmodel
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+func TestCheckImagePlatform(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *v1.ConfigFile
+		expectErr bool
+	}{
+		{name: "nil config", config: nil},
+		{name: "linux/amd64", config: &v1.ConfigFile{OS: "linux", Architecture: "amd64"}},
+		{name: "platform fields omitted", config: &v1.ConfigFile{}},
+		{name: "arm64 image", config: &v1.ConfigFile{OS: "linux", Architecture: "arm64"}, expectErr: true},
+		{name: "windows image", config: &v1.ConfigFile{OS: "windows", Architecture: "amd64"}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkImagePlatform("registry.example.com/aim:1.0", tt.config)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error=%v, got %v", tt.expectErr, err)
+			}
+			if err != nil {
+				categorized := controllerutils.CategorizeError(err)
+				if categorized.Category() != controllerutils.ErrorCategoryInvalidSpec ||
+					categorized.Reason() != aimv1alpha1.AIMModelReasonImagePlatformUnsupported {
+					t.Errorf("expected InvalidSpec/%s, got %s/%s",
+						aimv1alpha1.AIMModelReasonImagePlatformUnsupported, categorized.Category(), categorized.Reason())
+				}
+			}
+		})
+	}
+}
+
+func TestClassifyImagePreflightError(t *testing.T) {
+	_, badNameErr := name.ParseReference("registry.example.com/Invalid Image:tag")
+	if badNameErr == nil {
+		t.Fatal("expected reference parse error")
+	}
+	transientErr := &utils.ImageRegistryError{Type: utils.ImagePullErrorGeneric, Message: "connection reset"}
+
+	tests := []struct {
+		name             string
+		err              error
+		expectedCategory controllerutils.ErrorCategory
+		expectedReason   string
+	}{
+		{
+			name:             "invalid reference",
+			err:              fmt.Errorf("failed to parse image reference: %w", badNameErr),
+			expectedCategory: controllerutils.ErrorCategoryInvalidSpec,
+			expectedReason:   aimv1alpha1.AIMModelReasonInvalidImageReference,
+		},
+		{
+			name:             "image not found",
+			err:              &utils.ImageRegistryError{Type: utils.ImagePullErrorNotFound, Message: "manifest unknown"},
+			expectedCategory: controllerutils.ErrorCategoryInvalidSpec,
+			expectedReason:   aimv1alpha1.AIMModelReasonImageNotFound,
+		},
+		{
+			name: "index without supported platform",
+			err: &utils.ImageRegistryError{
+				Type:    utils.ImagePullErrorGeneric,
+				Message: "failed to get image from descriptor: no child with platform linux/amd64 in index",
+			},
+			expectedCategory: controllerutils.ErrorCategoryInvalidSpec,
+			expectedReason:   aimv1alpha1.AIMModelReasonImagePlatformUnsupported,
+		},
+		{
+			name:             "malformed labels",
+			err:              fmt.Errorf("failed to parse image labels: %w", &metadataFormatError{Reason: "InvalidFormat", Message: "bad json"}),
+			expectedCategory: controllerutils.ErrorCategoryInvalidSpec,
+			expectedReason:   aimv1alpha1.AIMModelReasonInvalidImageMetadata,
+		},
+		{
+			name:             "registry auth failure",
+			err:              &utils.ImageRegistryError{Type: utils.ImagePullErrorAuth, Message: "401 unauthorized"},
+			expectedCategory: controllerutils.ErrorCategoryAuth,
+			expectedReason:   aimv1alpha1.AIMModelReasonImageRegistryAuthFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classified := classifyImagePreflightError("registry.example.com/aim:1.0", tt.err)
+			if !errors.Is(classified, tt.err) {
+				t.Errorf("expected the original error to be wrapped")
+			}
+			categorized := controllerutils.CategorizeError(classified)
+			if categorized.Category() != tt.expectedCategory {
+				t.Errorf("expected category %s, got %s", tt.expectedCategory, categorized.Category())
+			}
+			if categorized.Reason() != tt.expectedReason {
+				t.Errorf("expected reason %s, got %s", tt.expectedReason, categorized.Reason())
+			}
+		})
+	}
+
+	if classifyImagePreflightError("img", nil) != nil {
+		t.Error("expected nil for nil error")
+	}
+	if got := classifyImagePreflightError("img", transientErr); got != transientErr {
+		t.Errorf("expected transient registry errors to be returned unchanged, got %v", got)
+	}
+}
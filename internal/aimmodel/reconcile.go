@@ -55,7 +55,11 @@ type ClusterModelFetchResult struct {
 
 	mergedRuntimeConfig     controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]
 	imageMetadata           controllerutils.FetchResult[*aimv1alpha1.ImageMetadata]
+	imageSecurity           *aimv1alpha1.AIMImageSecurity
 	clusterServiceTemplates controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplateList]
+
+	// AIMServices across all namespaces that resolved to this model
+	consumers controllerutils.FetchResult[*aimv1alpha1.AIMServiceList]
 }
 
 func (r *ClusterModelReconciler) FetchRemoteState(
@@ -78,12 +82,16 @@ func (r *ClusterModelReconciler) FetchRemoteState(
 	result.mergedRuntimeConfig = reconcileCtx.MergedRuntimeConfig
 
 	// Image metadata
-	result.imageMetadata = fetchImageMetadata(ctx, r.Clientset, clusterModel.Spec, &clusterModel.Status, constants.GetOperatorNamespace())
+	result.imageMetadata, result.imageSecurity = fetchImageMetadata(ctx, r.Clientset, clusterModel.Spec, &clusterModel.Status, constants.GetOperatorNamespace())
 
 	// Cluster service templates
 	templates := &aimv1alpha1.AIMClusterServiceTemplateList{}
 	result.clusterServiceTemplates = controllerutils.FetchList(ctx, c, templates, client.MatchingFields{aimv1alpha1.ServiceTemplateModelNameIndexKey: clusterModel.Name})
 
+	// Services in any namespace using this model, for the consumers summary
+	result.consumers = controllerutils.FetchClusterScopedConsumers(ctx, c,
+		aimv1alpha1.AIMServiceResolvedModelIndexKey, clusterModel.Name, controllerutils.ResolvedModelRef)
+
 	return result
 }
 
@@ -121,6 +129,7 @@ type ModelFetchResult struct {
 
 	mergedRuntimeConfig controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]
 	imageMetadata       controllerutils.FetchResult[*aimv1alpha1.ImageMetadata]
+	imageSecurity       *aimv1alpha1.AIMImageSecurity
 	serviceTemplates    controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplateList]
 }
 
@@ -163,7 +172,7 @@ func (r *ModelReconciler) FetchRemoteState(
 	result.mergedRuntimeConfig = reconcileCtx.MergedRuntimeConfig
 
 	// Image metadata
-	result.imageMetadata = fetchImageMetadata(ctx, r.Clientset, model.Spec, &model.Status, model.Namespace)
+	result.imageMetadata, result.imageSecurity = fetchImageMetadata(ctx, r.Clientset, model.Spec, &model.Status, model.Namespace)
 
 	// Service templates
 	templates := &aimv1alpha1.AIMServiceTemplateList{}
@@ -298,9 +307,15 @@ func aggregateTemplateStatuses(expectsTemplates *bool, statuses []constants.AIMS
 // fetchImageMetadata determines how to obtain image metadata for a model.
 // It handles these cases:
 //  1. Extraction explicitly disabled - skip fetch entirely
-//  2. Spec-provided metadata (air-gapped environments) - returns the spec value directly
+//  2. Spec-provided metadata (air-gapped environments) - returns the spec value directly,
+//     with security information parsed from its original labels
 //  3. Already cached in status - returns empty result (no fetch needed)
-//  4. Needs remote fetch - calls inspectImage to fetch from registry
+//  4. Needs remote fetch - calls inspectImage to fetch from registry. This doubles as the image
+//     preflight: errors for images that cannot work as specified are classified as InvalidSpec,
+//     which keeps templates from scheduling discovery jobs for them.
+//
+// The returned image security is nil when nothing was fetched, so a previously recorded
+// status.security is kept.
 //
 // For custom models (with modelSources), the fetched metadata is used only for
 // image validation — templates are still built from customTemplates in PlanResources.
@@ -310,11 +325,11 @@ func fetchImageMetadata(
 	spec aimv1alpha1.AIMModelSpec,
 	status *aimv1alpha1.AIMModelStatus,
 	secretNamespace string,
-) controllerutils.FetchResult[*aimv1alpha1.ImageMetadata] {
+) (controllerutils.FetchResult[*aimv1alpha1.ImageMetadata], *aimv1alpha1.AIMImageSecurity) {
 	// Case 1: Extraction explicitly disabled - skip fetch entirely
 	if spec.Discovery != nil && !spec.Discovery.ExtractMetadata {
 		log.FromContext(ctx).V(1).Info("metadata extraction disabled in spec")
-		return controllerutils.FetchResult[*aimv1alpha1.ImageMetadata]{}
+		return controllerutils.FetchResult[*aimv1alpha1.ImageMetadata]{}, nil
 	}
 
 	// Case 2: Use spec-provided metadata (air-gapped environments)
@@ -322,16 +337,16 @@ func fetchImageMetadata(
 		log.FromContext(ctx).V(1).Info("using spec-provided imageMetadata")
 		return controllerutils.FetchResult[*aimv1alpha1.ImageMetadata]{
 			Value: spec.ImageMetadata,
-		}
+		}, parseImageSecurity(spec.ImageMetadata.OriginalLabels, nil)
 	}
 
 	// Case 3: Already cached in status - no fetch needed
 	if !shouldExtractMetadata(status) {
-		return controllerutils.FetchResult[*aimv1alpha1.ImageMetadata]{}
+		return controllerutils.FetchResult[*aimv1alpha1.ImageMetadata]{}, nil
 	}
 
 	// Case 4: Fetch from registry
-	metadata, err := inspectImage(
+	metadata, security, err := inspectImage(
 		ctx,
 		spec.Image,
 		spec.ImagePullSecrets,
@@ -340,8 +355,8 @@ func fetchImageMetadata(
 	)
 	return controllerutils.FetchResult[*aimv1alpha1.ImageMetadata]{
 		Value: metadata,
-		Error: err,
-	}
+		Error: classifyImagePreflightError(spec.Image, err),
+	}, security
 }
 
 // ============================================================================
@@ -487,7 +502,12 @@ func (r *ClusterModelReconciler) DecorateStatus(
 	cm *controllerutils.ConditionManager,
 	obs ClusterModelObservation,
 ) {
-	decorateModelStatus(status, cm, &obs.model.Spec, obs.imageMetadata)
+	decorateModelStatus(status, cm, &obs.model.Spec, obs.imageMetadata, obs.imageSecurity, getSecurityPolicy(obs.mergedRuntimeConfig))
+
+	// Summarize consuming services; keep the previous summary if listing failed
+	if obs.consumers.OK() {
+		status.Consumers = controllerutils.BuildConsumersStatus(obs.consumers.Value.Items)
+	}
 }
 
 func (r *ModelReconciler) DecorateStatus(
@@ -495,15 +515,17 @@ func (r *ModelReconciler) DecorateStatus(
 	cm *controllerutils.ConditionManager,
 	obs ModelObservation,
 ) {
-	decorateModelStatus(status, cm, &obs.model.Spec, obs.imageMetadata)
+	decorateModelStatus(status, cm, &obs.model.Spec, obs.imageMetadata, obs.imageSecurity, getSecurityPolicy(obs.mergedRuntimeConfig))
 }
 
 // decorateModelStatus handles common status decoration for both cluster and namespace-scoped models.
 func decorateModelStatus(
 	status *aimv1alpha1.AIMModelStatus,
-	_ *controllerutils.ConditionManager,
+	cm *controllerutils.ConditionManager,
 	spec *aimv1alpha1.AIMModelSpec,
 	imageMetadataResult controllerutils.FetchResult[*aimv1alpha1.ImageMetadata],
+	imageSecurity *aimv1alpha1.AIMImageSecurity,
+	securityPolicy *aimv1alpha1.AIMModelSecurityPolicy,
 ) {
 	// Set source type based on whether this is a custom model
 	if IsCustomModel(spec) {
@@ -516,4 +538,9 @@ func decorateModelStatus(
 	if imageMetadataResult.OK() && imageMetadataResult.Value != nil {
 		status.ImageMetadata = imageMetadataResult.Value
 	}
+
+	if imageSecurity != nil {
+		status.Security = imageSecurity
+	}
+	setSecurityPolicyViolatedCondition(cm, status.Security, securityPolicy)
 }
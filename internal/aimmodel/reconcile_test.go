@@ -295,7 +295,7 @@ func TestFetchImageMetadata_SpecProvided(t *testing.T) {
 	}
 	status := &aimv1alpha1.AIMModelStatus{}
 
-	result := fetchImageMetadata(context.Background(), nil, spec, status, "default")
+	result, _ := fetchImageMetadata(context.Background(), nil, spec, status, "default")
 
 	if result.HasError() {
 		t.Errorf("expected no error, got %v", result.Error)
@@ -318,7 +318,7 @@ func TestFetchImageMetadata_AlreadyCached(t *testing.T) {
 		},
 	}
 
-	result := fetchImageMetadata(context.Background(), nil, spec, status, "default")
+	result, _ := fetchImageMetadata(context.Background(), nil, spec, status, "default")
 
 	// Should return empty result (no fetch needed)
 	if result.HasError() {
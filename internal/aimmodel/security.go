@@ -0,0 +1,143 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimmodel
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// cveSeverityRank orders severities from least to most severe.
+var cveSeverityRank = map[aimv1alpha1.AIMCVESeverity]int{
+	aimv1alpha1.AIMCVESeverityLow:      1,
+	aimv1alpha1.AIMCVESeverityMedium:   2,
+	aimv1alpha1.AIMCVESeverityHigh:     3,
+	aimv1alpha1.AIMCVESeverityCritical: 4,
+}
+
+// parseImageSecurity extracts SBOM references and the CVE scan summary from image labels
+// and manifest annotations. Annotations take precedence over labels, since scanners usually
+// attach results to the manifest after the image was built. Returns nil if neither carries
+// any security information.
+func parseImageSecurity(labels, annotations map[string]string) *aimv1alpha1.AIMImageSecurity {
+	merged := make(map[string]string, len(labels)+len(annotations))
+	maps.Copy(merged, labels)
+	maps.Copy(merged, annotations)
+
+	security := &aimv1alpha1.AIMImageSecurity{
+		Scanner:   getAMDLabel(merged, "security.scanner"),
+		ScannedAt: getAMDLabel(merged, "security.scanned"),
+	}
+	if sbom := getAMDLabel(merged, "security.sbom"); sbom != "" {
+		security.SBOMRefs = parseCommaSeparated(sbom)
+	}
+
+	var cves aimv1alpha1.AIMCVESummary
+	found := false
+	for _, entry := range []struct {
+		suffix string
+		count  *int32
+	}{
+		{"security.cve.critical", &cves.Critical},
+		{"security.cve.high", &cves.High},
+		{"security.cve.medium", &cves.Medium},
+		{"security.cve.low", &cves.Low},
+	} {
+		value := getAMDLabel(merged, entry.suffix)
+		if value == "" {
+			continue
+		}
+		// Malformed counts are ignored rather than failing the preflight
+		if n, err := strconv.ParseInt(value, 10, 32); err == nil && n >= 0 {
+			*entry.count = int32(n)
+			found = true
+		}
+	}
+	if found {
+		security.CVEs = &cves
+		security.HighestSeverity = highestCVESeverity(cves)
+	}
+
+	if security.CVEs == nil && len(security.SBOMRefs) == 0 && security.Scanner == "" && security.ScannedAt == "" {
+		return nil
+	}
+	return security
+}
+
+// highestCVESeverity returns the most severe level with at least one vulnerability.
+func highestCVESeverity(cves aimv1alpha1.AIMCVESummary) aimv1alpha1.AIMCVESeverity {
+	switch {
+	case cves.Critical > 0:
+		return aimv1alpha1.AIMCVESeverityCritical
+	case cves.High > 0:
+		return aimv1alpha1.AIMCVESeverityHigh
+	case cves.Medium > 0:
+		return aimv1alpha1.AIMCVESeverityMedium
+	case cves.Low > 0:
+		return aimv1alpha1.AIMCVESeverityLow
+	}
+	return ""
+}
+
+// getSecurityPolicy returns the model security policy from the merged runtime config, or nil.
+func getSecurityPolicy(config controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]) *aimv1alpha1.AIMModelSecurityPolicy {
+	if !config.OK() || config.Value == nil || config.Value.Model == nil {
+		return nil
+	}
+	return config.Value.Model.Security
+}
+
+// setSecurityPolicyViolatedCondition compares the image's CVE summary against the runtime
+// config's security policy. The condition is removed when no policy is configured.
+func setSecurityPolicyViolatedCondition(
+	cm *controllerutils.ConditionManager,
+	security *aimv1alpha1.AIMImageSecurity,
+	policy *aimv1alpha1.AIMModelSecurityPolicy,
+) {
+	if policy == nil {
+		cm.Delete(aimv1alpha1.AIMModelConditionSecurityPolicyViolated)
+		return
+	}
+
+	if security == nil || security.CVEs == nil {
+		cm.MarkFalse(aimv1alpha1.AIMModelConditionSecurityPolicyViolated, aimv1alpha1.AIMModelReasonNoScanSummary,
+			"Image carries no CVE scan summary")
+		return
+	}
+
+	if cveSeverityRank[security.HighestSeverity] > cveSeverityRank[policy.MaxCVESeverity] {
+		cm.MarkTrue(aimv1alpha1.AIMModelConditionSecurityPolicyViolated, aimv1alpha1.AIMModelReasonCVESeverityExceeded,
+			fmt.Sprintf("Image has %s vulnerabilities (critical=%d, high=%d, medium=%d, low=%d), above the allowed maximum of %s",
+				security.HighestSeverity, security.CVEs.Critical, security.CVEs.High, security.CVEs.Medium, security.CVEs.Low,
+				policy.MaxCVESeverity),
+			controllerutils.AsWarning())
+		return
+	}
+
+	cm.MarkFalse(aimv1alpha1.AIMModelConditionSecurityPolicyViolated, aimv1alpha1.AIMModelReasonWithinPolicy,
+		fmt.Sprintf("No vulnerabilities above %s", policy.MaxCVESeverity))
+}
@@ -0,0 +1,147 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimmodel
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func TestParseImageSecurity(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		expected    *aimv1alpha1.AIMImageSecurity
+	}{
+		{
+			name:   "no security information",
+			labels: map[string]string{"org.opencontainers.image.title": "aim"},
+		},
+		{
+			name: "labels with scan summary",
+			labels: map[string]string{
+				"com.amd.aim.security.sbom":         "oci://registry.example.com/aim:sbom, https://example.com/sbom.json",
+				"com.amd.aim.security.scanner":      "trivy",
+				"com.amd.aim.security.cve.critical": "0",
+				"com.amd.aim.security.cve.high":     "2",
+				"com.amd.aim.security.cve.low":      "5",
+			},
+			expected: &aimv1alpha1.AIMImageSecurity{
+				SBOMRefs:        []string{"oci://registry.example.com/aim:sbom", "https://example.com/sbom.json"},
+				Scanner:         "trivy",
+				CVEs:            &aimv1alpha1.AIMCVESummary{High: 2, Low: 5},
+				HighestSeverity: aimv1alpha1.AIMCVESeverityHigh,
+			},
+		},
+		{
+			name:        "annotations override labels",
+			labels:      map[string]string{"com.amd.aim.security.cve.critical": "1"},
+			annotations: map[string]string{"com.amd.aim.security.cve.critical": "0", "com.amd.aim.security.scanned": "2026-01-01T00:00:00Z"},
+			expected: &aimv1alpha1.AIMImageSecurity{
+				ScannedAt: "2026-01-01T00:00:00Z",
+				CVEs:      &aimv1alpha1.AIMCVESummary{},
+			},
+		},
+		{
+			name:   "legacy prefix",
+			labels: map[string]string{"org.amd.silogen.security.cve.medium": "3"},
+			expected: &aimv1alpha1.AIMImageSecurity{
+				CVEs:            &aimv1alpha1.AIMCVESummary{Medium: 3},
+				HighestSeverity: aimv1alpha1.AIMCVESeverityMedium,
+			},
+		},
+		{
+			name:   "malformed counts are ignored",
+			labels: map[string]string{"com.amd.aim.security.cve.high": "many"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseImageSecurity(tt.labels, tt.annotations)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetSecurityPolicyViolatedCondition(t *testing.T) {
+	critical := &aimv1alpha1.AIMImageSecurity{
+		CVEs:            &aimv1alpha1.AIMCVESummary{Critical: 1},
+		HighestSeverity: aimv1alpha1.AIMCVESeverityCritical,
+	}
+	medium := &aimv1alpha1.AIMImageSecurity{
+		CVEs:            &aimv1alpha1.AIMCVESummary{Medium: 4},
+		HighestSeverity: aimv1alpha1.AIMCVESeverityMedium,
+	}
+	clean := &aimv1alpha1.AIMImageSecurity{CVEs: &aimv1alpha1.AIMCVESummary{}}
+	high := &aimv1alpha1.AIMModelSecurityPolicy{MaxCVESeverity: aimv1alpha1.AIMCVESeverityHigh}
+
+	tests := []struct {
+		name         string
+		security     *aimv1alpha1.AIMImageSecurity
+		policy       *aimv1alpha1.AIMModelSecurityPolicy
+		expectStatus metav1.ConditionStatus
+		expectReason string
+	}{
+		{name: "no policy removes condition", security: critical},
+		{name: "above threshold", security: critical, policy: high,
+			expectStatus: metav1.ConditionTrue, expectReason: aimv1alpha1.AIMModelReasonCVESeverityExceeded},
+		{name: "below threshold", security: medium, policy: high,
+			expectStatus: metav1.ConditionFalse, expectReason: aimv1alpha1.AIMModelReasonWithinPolicy},
+		{name: "no vulnerabilities", security: clean, policy: high,
+			expectStatus: metav1.ConditionFalse, expectReason: aimv1alpha1.AIMModelReasonWithinPolicy},
+		{name: "no scan summary", policy: high,
+			expectStatus: metav1.ConditionFalse, expectReason: aimv1alpha1.AIMModelReasonNoScanSummary},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := controllerutils.NewConditionManager([]metav1.Condition{{
+				Type:   aimv1alpha1.AIMModelConditionSecurityPolicyViolated,
+				Status: metav1.ConditionTrue,
+				Reason: "Stale",
+			}})
+
+			setSecurityPolicyViolatedCondition(cm, tt.security, tt.policy)
+
+			cond := cm.Get(aimv1alpha1.AIMModelConditionSecurityPolicyViolated)
+			if tt.expectReason == "" {
+				if cond != nil {
+					t.Errorf("expected condition to be removed, got %+v", cond)
+				}
+				return
+			}
+			if cond == nil || cond.Status != tt.expectStatus || cond.Reason != tt.expectReason {
+				t.Errorf("expected %s/%s, got %+v", tt.expectStatus, tt.expectReason, cond)
+			}
+		})
+	}
+}
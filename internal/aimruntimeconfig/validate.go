@@ -0,0 +1,280 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimruntimeconfig
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// proxySchemes are the proxy URL schemes understood by the tools reading the proxy variables.
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// Validate checks a runtime config for mistakes the CRD schema cannot catch, so that they are
+// rejected at admission instead of failing the reconciles of every resource using the config.
+// Storage classes are looked up with c; when a lookup fails for another reason than NotFound,
+// a warning is returned instead of an error. clusterScoped is true for AIMClusterRuntimeConfig.
+func Validate(
+	ctx context.Context,
+	c client.Reader,
+	config *aimv1alpha1.AIMRuntimeConfigCommon,
+	clusterScoped bool,
+) (warnings []string, errs field.ErrorList) {
+	spec := field.NewPath("spec")
+
+	warnings, errs = validateStorageClasses(ctx, c, config, spec)
+	errs = append(errs, validateHeadroom(config, spec)...)
+	errs = append(errs, validateProxy(config.Proxy, spec.Child("proxy"))...)
+	errs = append(errs, validatePolicies(config, spec)...)
+	errs = append(errs, validateGPUResources(config, spec)...)
+
+	if config.TemplateSelection != nil && config.TemplateSelection.SelectionPolicyPlugin != nil {
+		path := spec.Child("templateSelection", "selectionPolicyPlugin")
+		errs = append(errs, validateSelectionPolicyPlugin(config.TemplateSelection.SelectionPolicyPlugin, path)...)
+		if !clusterScoped {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s is only honored on the AIMClusterRuntimeConfig and is ignored here", path))
+		}
+	}
+	return warnings, errs
+}
+
+// validateStorageClasses checks that every storage class named by the config exists.
+func validateStorageClasses(
+	ctx context.Context,
+	c client.Reader,
+	config *aimv1alpha1.AIMRuntimeConfigCommon,
+	spec *field.Path,
+) (warnings []string, errs field.ErrorList) {
+	type reference struct {
+		path *field.Path
+		name string
+	}
+	var refs []reference
+	if config.Storage != nil {
+		storage := spec.Child("storage")
+		if config.Storage.DefaultStorageClassName != nil && *config.Storage.DefaultStorageClassName != "" {
+			refs = append(refs, reference{storage.Child("defaultStorageClassName"), *config.Storage.DefaultStorageClassName})
+		}
+		for i, entry := range config.Storage.TopologyStorageClasses {
+			refs = append(refs, reference{storage.Child("topologyStorageClasses").Index(i).Child("storageClassName"), entry.StorageClassName})
+		}
+	}
+	if config.DefaultStorageClassName != "" {
+		refs = append(refs, reference{spec.Child("defaultStorageClassName"), config.DefaultStorageClassName})
+	}
+
+	for _, ref := range refs {
+		if ref.name == "" {
+			continue
+		}
+		err := c.Get(ctx, client.ObjectKey{Name: ref.name}, &storagev1.StorageClass{})
+		switch {
+		case err == nil:
+		case apierrors.IsNotFound(err):
+			errs = append(errs, field.NotFound(ref.path, ref.name))
+		default:
+			warnings = append(warnings, fmt.Sprintf("%s: could not verify storage class %q: %v", ref.path, ref.name, err))
+		}
+	}
+	return warnings, errs
+}
+
+// validateHeadroom bounds the PVC headroom, which the schema only bounds from below.
+func validateHeadroom(config *aimv1alpha1.AIMRuntimeConfigCommon, spec *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	check := func(path *field.Path, value *int32) {
+		if value != nil && (*value < 0 || *value > 100) {
+			errs = append(errs, field.Invalid(path, *value, "must be between 0 and 100"))
+		}
+	}
+	if config.Storage != nil {
+		check(spec.Child("storage", "pvcHeadroomPercent"), config.Storage.PVCHeadroomPercent)
+	}
+	check(spec.Child("pvcHeadroomPercent"), config.PVCHeadroomPercent)
+	return errs
+}
+
+// validateProxy checks that the proxy URLs parse and that the NO_PROXY entries survive being
+// joined into a comma-separated list.
+func validateProxy(proxy *aimv1alpha1.AIMProxyConfig, path *field.Path) field.ErrorList {
+	if proxy == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	for _, p := range []struct {
+		name  string
+		value string
+	}{
+		{"httpProxy", proxy.HTTPProxy},
+		{"httpsProxy", proxy.HTTPSProxy},
+	} {
+		if p.value == "" {
+			continue
+		}
+		if msg := proxyURLError(p.value); msg != "" {
+			errs = append(errs, field.Invalid(path.Child(p.name), p.value, msg))
+		}
+	}
+	for i, entry := range proxy.NoProxy {
+		if entry == "" || strings.ContainsAny(entry, ", \t") {
+			errs = append(errs, field.Invalid(path.Child("noProxy").Index(i), entry,
+				"must be a single non-empty host, domain or CIDR"))
+		}
+	}
+	return errs
+}
+
+// proxyURLError returns why value is not a usable proxy URL, or an empty string if it is.
+func proxyURLError(value string) string {
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Sprintf("must be a valid URL: %v", err)
+	}
+	if !slices.Contains(proxySchemes, u.Scheme) {
+		return fmt.Sprintf("must be a URL with scheme %s, e.g. http://proxy.example.com:3128",
+			strings.Join(proxySchemes, ", "))
+	}
+	if u.Hostname() == "" {
+		return "must include a host"
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return "must not have a path, query or fragment"
+	}
+	return ""
+}
+
+// validateSelectionPolicyPlugin checks the plugin endpoint, which is only checked for its
+// prefix by the schema.
+func validateSelectionPolicyPlugin(plugin *aimv1alpha1.AIMSelectionPolicyPlugin, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	switch {
+	case plugin.Exec == "" && plugin.URL == "":
+		errs = append(errs, field.Required(path, "exactly one of exec and url must be set"))
+	case plugin.Exec != "" && plugin.URL != "":
+		errs = append(errs, field.Forbidden(path.Child("url"), "exactly one of exec and url must be set"))
+	}
+	if plugin.URL != "" {
+		u, err := url.Parse(plugin.URL)
+		switch {
+		case err != nil:
+			errs = append(errs, field.Invalid(path.Child("url"), plugin.URL, fmt.Sprintf("must be a valid URL: %v", err)))
+		case u.Scheme != "http" && u.Scheme != "https":
+			errs = append(errs, field.Invalid(path.Child("url"), plugin.URL, "must use http or https"))
+		case u.Hostname() == "":
+			errs = append(errs, field.Invalid(path.Child("url"), plugin.URL, "must include a host"))
+		}
+	}
+	if plugin.TimeoutSeconds != nil && (*plugin.TimeoutSeconds < 1 || *plugin.TimeoutSeconds > 30) {
+		errs = append(errs, field.Invalid(path.Child("timeoutSeconds"), *plugin.TimeoutSeconds, "must be between 1 and 30"))
+	}
+	return errs
+}
+
+// validatePolicies checks the policy enums, so that objects written while the schema lagged
+// behind the operator are reported with the supported values.
+func validatePolicies(config *aimv1alpha1.AIMRuntimeConfigCommon, spec *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if config.AdoptionPolicy != "" {
+		supported := []string{
+			string(aimv1alpha1.AIMAdoptionPolicyAdopt),
+			string(aimv1alpha1.AIMAdoptionPolicyConflict),
+			string(aimv1alpha1.AIMAdoptionPolicyIgnore),
+		}
+		if !slices.Contains(supported, string(config.AdoptionPolicy)) {
+			errs = append(errs, field.NotSupported(spec.Child("adoptionPolicy"), config.AdoptionPolicy, supported))
+		}
+	}
+	if config.Spreading != nil && config.Spreading.WhenUnsatisfiable != "" {
+		supported := []string{string(corev1.DoNotSchedule), string(corev1.ScheduleAnyway)}
+		if !slices.Contains(supported, string(config.Spreading.WhenUnsatisfiable)) {
+			errs = append(errs, field.NotSupported(spec.Child("spreading", "whenUnsatisfiable"),
+				config.Spreading.WhenUnsatisfiable, supported))
+		}
+	}
+	if config.Storage != nil && config.Storage.Encryption != nil && config.Storage.Encryption.Decryption != "" {
+		supported := []string{
+			string(aimv1alpha1.CacheDecryptionModeInitContainer),
+			string(aimv1alpha1.CacheDecryptionModeTransparent),
+		}
+		if !slices.Contains(supported, string(config.Storage.Encryption.Decryption)) {
+			errs = append(errs, field.NotSupported(spec.Child("storage", "encryption", "decryption"),
+				config.Storage.Encryption.Decryption, supported))
+		}
+	}
+	return errs
+}
+
+// validateGPUResources checks the GPU resource name and the GPU model keys of the per-GPU
+// resource ratios. GPU models are matched against the normalized names resolved on templates,
+// so a key like "mi300x" would silently never match.
+func validateGPUResources(config *aimv1alpha1.AIMRuntimeConfigCommon, spec *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if config.GPUSharing != nil && config.GPUSharing.ResourceName != "" {
+		path := spec.Child("gpuSharing", "resourceName")
+		name := config.GPUSharing.ResourceName
+		if msgs := validation.IsQualifiedName(name); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(path, name, strings.Join(msgs, "; ")))
+		} else if !strings.Contains(name, "/") {
+			errs = append(errs, field.Invalid(path, name,
+				"must be an extended resource name with a domain prefix, e.g. amd.com/gpu"))
+		}
+	}
+	if config.TemplateSelection != nil && config.TemplateSelection.MaxFreeGPUPercent != nil {
+		if percent := *config.TemplateSelection.MaxFreeGPUPercent; percent < 1 || percent > 100 {
+			errs = append(errs, field.Invalid(spec.Child("templateSelection", "maxFreeGPUPercent"), percent,
+				"must be between 1 and 100"))
+		}
+	}
+	if config.InferenceResources != nil {
+		path := spec.Child("inferenceResources", "gpuModels")
+		seen := map[string]bool{}
+		for i, entry := range config.InferenceResources.GPUModels {
+			entryPath := path.Index(i).Child("gpuModel")
+			switch normalized := utils.NormalizeGPUModel(entry.GPUModel); {
+			case entry.GPUModel == "":
+				errs = append(errs, field.Required(entryPath, "GPU model name is required"))
+			case normalized != entry.GPUModel:
+				errs = append(errs, field.Invalid(entryPath, entry.GPUModel,
+					fmt.Sprintf("GPU models are matched by normalized name, use %q", normalized)))
+			case seen[entry.GPUModel]:
+				errs = append(errs, field.Duplicate(entryPath, entry.GPUModel))
+			}
+			seen[entry.GPUModel] = true
+		}
+	}
+	return errs
+}
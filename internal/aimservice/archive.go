@@ -0,0 +1,180 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// ServiceArchiveRecordKey is the ConfigMap data key holding the archive record.
+const ServiceArchiveRecordKey = "record.json"
+
+// ServiceArchiveRecord is the compact record kept for a deleted service.
+type ServiceArchiveRecord struct {
+	Name                 string                             `json:"name"`
+	Namespace            string                             `json:"namespace"`
+	UID                  types.UID                          `json:"uid"`
+	CreatedAt            metav1.Time                        `json:"createdAt"`
+	DeletedAt            metav1.Time                        `json:"deletedAt"`
+	Status               constants.AIMStatus                `json:"status,omitempty"`
+	ReadyReason          string                             `json:"readyReason,omitempty"`
+	ReadyMessage         string                             `json:"readyMessage,omitempty"`
+	ResolvedModel        *aimv1alpha1.AIMResolvedReference  `json:"resolvedModel,omitempty"`
+	ResolvedModelVersion string                             `json:"resolvedModelVersion,omitempty"`
+	ResolvedTemplate     *aimv1alpha1.AIMResolvedReference  `json:"resolvedTemplate,omitempty"`
+	RuntimeConfigSources []aimv1alpha1.AIMResolvedReference `json:"runtimeConfigSources,omitempty"`
+}
+
+// ResolveServiceArchiveRetention returns how long archive records are kept, and whether
+// archiving is enabled at all.
+func ResolveServiceArchiveRetention(config *aimv1alpha1.AIMRuntimeConfigCommon) (time.Duration, bool) {
+	if config == nil || config.ServiceArchive == nil || config.ServiceArchive.RetentionDays <= 0 {
+		return 0, false
+	}
+	return time.Duration(config.ServiceArchive.RetentionDays) * 24 * time.Hour, true
+}
+
+// BuildServiceArchive builds the archive ConfigMap for a service that is being deleted.
+// The ConfigMap is deliberately not owned by the service, so it outlives it.
+func BuildServiceArchive(service *aimv1alpha1.AIMService, retention time.Duration, now time.Time) (*corev1.ConfigMap, error) {
+	deletedAt := now
+	if service.DeletionTimestamp != nil {
+		deletedAt = service.DeletionTimestamp.Time
+	}
+
+	record := ServiceArchiveRecord{
+		Name:                 service.Name,
+		Namespace:            service.Namespace,
+		UID:                  service.UID,
+		CreatedAt:            service.CreationTimestamp,
+		DeletedAt:            metav1.NewTime(deletedAt),
+		Status:               service.Status.Status,
+		ResolvedModel:        service.Status.ResolvedModel,
+		ResolvedModelVersion: service.Status.ResolvedModelVersion,
+		ResolvedTemplate:     service.Status.ResolvedTemplate,
+		RuntimeConfigSources: service.Status.RuntimeConfigSources,
+	}
+	for _, cond := range service.Status.Conditions {
+		if cond.Type == controllerutils.ConditionTypeReady {
+			record.ReadyReason = cond.Reason
+			record.ReadyMessage = cond.Message
+		}
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal service archive record: %w", err)
+	}
+
+	name, err := utils.GenerateDerivedName(
+		[]string{"aim-archive", service.Name},
+		utils.WithHashSource(string(service.UID)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceLabelValue, err := utils.SanitizeLabelValue(service.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: service.Namespace,
+			Labels: map[string]string{
+				constants.LabelK8sManagedBy: constants.LabelValueManagedByController,
+				constants.LabelKeyManagedBy: constants.LabelValueManagedBy,
+				constants.LabelKeyComponent: constants.LabelValueComponentServiceArchive,
+				constants.LabelKeyService:   serviceLabelValue,
+			},
+			Annotations: map[string]string{
+				constants.AnnotationArchiveExpiresAt: deletedAt.Add(retention).UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string]string{ServiceArchiveRecordKey: string(data)},
+	}, nil
+}
+
+// ArchiveService writes the archive record of a deleted service and removes expired records
+// in the same namespace. An existing record for the same service UID is left untouched.
+// Archives are listed through reader, so that ConfigMaps need not be cached.
+func ArchiveService(
+	ctx context.Context,
+	c client.Client,
+	reader client.Reader,
+	service *aimv1alpha1.AIMService,
+	retention time.Duration,
+	now time.Time,
+) error {
+	archive, err := BuildServiceArchive(service, retention, now)
+	if err != nil {
+		return err
+	}
+	if err := c.Create(ctx, archive); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create service archive %s: %w", archive.Name, err)
+	}
+	return PruneServiceArchives(ctx, reader, c, service.Namespace, now)
+}
+
+// PruneServiceArchives deletes archive records in the namespace whose retention has expired.
+// Records with a missing or unparsable expiry are kept.
+func PruneServiceArchives(ctx context.Context, reader client.Reader, c client.Writer, namespace string, now time.Time) error {
+	logger := log.FromContext(ctx)
+
+	var archives corev1.ConfigMapList
+	if err := reader.List(ctx, &archives, client.InNamespace(namespace), client.MatchingLabels{
+		constants.LabelKeyComponent: constants.LabelValueComponentServiceArchive,
+	}); err != nil {
+		return fmt.Errorf("failed to list service archives: %w", err)
+	}
+
+	for i := range archives.Items {
+		archive := &archives.Items[i]
+		expiresAt, err := time.Parse(time.RFC3339, archive.Annotations[constants.AnnotationArchiveExpiresAt])
+		if err != nil || now.Before(expiresAt) {
+			continue
+		}
+		if err := c.Delete(ctx, archive); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete expired service archive %s: %w", archive.Name, err)
+		}
+		logger.V(1).Info("Deleted expired service archive", "archive", archive.Name)
+	}
+	return nil
+}
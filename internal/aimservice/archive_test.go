@@ -0,0 +1,130 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func TestResolveServiceArchiveRetention(t *testing.T) {
+	if _, enabled := ResolveServiceArchiveRetention(nil); enabled {
+		t.Error("expected archiving to be disabled without runtime config")
+	}
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{
+		ServiceArchive: &aimv1alpha1.AIMServiceArchiveConfig{RetentionDays: 7},
+	}
+	retention, enabled := ResolveServiceArchiveRetention(config)
+	if !enabled || retention != 7*24*time.Hour {
+		t.Errorf("expected 7 day retention, got %v (enabled=%v)", retention, enabled)
+	}
+}
+
+func TestBuildServiceArchive(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := NewService("archived").WithModelName(testModelName).Build()
+	service.UID = "uid-1"
+	service.DeletionTimestamp = &metav1.Time{Time: now}
+	service.Status.Status = constants.AIMStatusReady
+	service.Status.ResolvedTemplate = &aimv1alpha1.AIMResolvedReference{Name: "tpl", Scope: aimv1alpha1.AIMResolutionScopeCluster}
+	service.Status.Conditions = []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionTrue, Reason: "AllComponentsReady", Message: "ready"},
+	}
+
+	archive, err := BuildServiceArchive(service, 24*time.Hour, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(archive.OwnerReferences) != 0 {
+		t.Error("archive must not be owned by the service")
+	}
+	if archive.Labels[constants.LabelKeyComponent] != constants.LabelValueComponentServiceArchive {
+		t.Errorf("unexpected component label: %v", archive.Labels)
+	}
+	if got := archive.Annotations[constants.AnnotationArchiveExpiresAt]; got != "2026-03-02T12:00:00Z" {
+		t.Errorf("unexpected expiry annotation %q", got)
+	}
+
+	var record ServiceArchiveRecord
+	if err := json.Unmarshal([]byte(archive.Data[ServiceArchiveRecordKey]), &record); err != nil {
+		t.Fatalf("failed to decode record: %v", err)
+	}
+	if record.Name != "archived" || record.UID != "uid-1" || record.Status != constants.AIMStatusReady {
+		t.Errorf("unexpected record: %+v", record)
+	}
+	if record.ResolvedTemplate == nil || record.ResolvedTemplate.Name != "tpl" {
+		t.Errorf("expected resolved template in record, got %+v", record.ResolvedTemplate)
+	}
+	if record.ReadyReason != "AllComponentsReady" || !record.DeletedAt.Time.Equal(now) {
+		t.Errorf("unexpected ready reason or deletion time: %+v", record)
+	}
+}
+
+func TestArchiveService_PrunesExpiredRecords(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	archiveLabels := map[string]string{constants.LabelKeyComponent: constants.LabelValueComponentServiceArchive}
+	expired := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name: "expired", Namespace: testNamespace, Labels: archiveLabels,
+		Annotations: map[string]string{constants.AnnotationArchiveExpiresAt: now.Add(-time.Hour).Format(time.RFC3339)},
+	}}
+	current := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name: "current", Namespace: testNamespace, Labels: archiveLabels,
+		Annotations: map[string]string{constants.AnnotationArchiveExpiresAt: now.Add(time.Hour).Format(time.RFC3339)},
+	}}
+	c := newFakeClient(expired, current)
+
+	service := NewService("archived").WithModelName(testModelName).Build()
+	service.UID = "uid-1"
+	if err := ArchiveService(testContext(), c, c, service, 24*time.Hour, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Archiving again for the same service is a no-op
+	if err := ArchiveService(testContext(), c, c, service, 24*time.Hour, now); err != nil {
+		t.Fatalf("unexpected error on repeated archive: %v", err)
+	}
+
+	err := c.Get(testContext(), client.ObjectKeyFromObject(expired), &corev1.ConfigMap{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected expired archive to be deleted, got %v", err)
+	}
+	if err := c.Get(testContext(), client.ObjectKeyFromObject(current), &corev1.ConfigMap{}); err != nil {
+		t.Errorf("expected unexpired archive to be kept, got %v", err)
+	}
+
+	var archives corev1.ConfigMapList
+	if err := c.List(testContext(), &archives, client.MatchingLabels(archiveLabels)); err != nil {
+		t.Fatalf("failed to list archives: %v", err)
+	}
+	if len(archives.Items) != 2 {
+		t.Errorf("expected the new archive and the unexpired one, got %d", len(archives.Items))
+	}
+}
@@ -0,0 +1,232 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// ============================================================================
+// CACHE MIGRATION
+// ============================================================================
+//
+// When the caching settings of a running service change (spec.caching.mode or the
+// storage encryption key), the cache it was using no longer matches. Instead of
+// leaving the InferenceService on the old volumes forever, the service migrates:
+//
+//  1. Provisioning: the new cache is planned as usual; the ISVC keeps the old volumes.
+//  2. Switching: once the new cache is Ready, the ISVC volumes are rebuilt from it
+//     and the service waits for the ISVC to become Ready again.
+//  3. Releasing: the old cache is deleted if it is a dedicated cache owned by the service.
+//     Shared caches are left in place for other services.
+
+// cacheMigration is the migration state derived in ComposeState.
+type cacheMigration struct {
+	// source is the cache being migrated away from. Nil once it has been deleted.
+	source    *aimv1alpha1.AIMTemplateCache
+	sourceRef aimv1alpha1.AIMResolvedReference
+	fromMode  aimv1alpha1.AIMTemplateCacheMode
+	startTime metav1.Time
+
+	// phase is empty once the migration has completed
+	phase aimv1alpha1.AIMCacheMigrationPhase
+}
+
+// switchesVolumes returns true when the InferenceService volumes must be rebuilt from the new cache.
+func (m *cacheMigration) switchesVolumes() bool {
+	return m != nil && m.phase == aimv1alpha1.CacheMigrationPhaseSwitching
+}
+
+// fetchCacheMigrationSource fetches the cache an in-progress migration started from, or the cache
+// last recorded in status, which is where a new migration would start from.
+func fetchCacheMigrationSource(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+) controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache] {
+	if service.Status.Cache == nil {
+		return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{}
+	}
+	ref := service.Status.Cache.TemplateCacheRef
+	if service.Status.Cache.Migration != nil {
+		ref = &service.Status.Cache.Migration.Source
+	}
+	if ref == nil {
+		return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{}
+	}
+	return controllerutils.Fetch(ctx, c, ref.NamespacedName(), &aimv1alpha1.AIMTemplateCache{})
+}
+
+// composeCacheMigration derives the migration state. Returns nil when no migration is in progress,
+// including when the caching settings were reverted to match the source cache again.
+func composeCacheMigration(
+	service *aimv1alpha1.AIMService,
+	source controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache],
+	target *aimv1alpha1.AIMTemplateCache,
+	isvc *servingv1beta1.InferenceService,
+	isvcReady bool,
+	encryption *aimv1alpha1.AIMStorageEncryptionConfig,
+	topologyStorageClass string,
+	now time.Time,
+) *cacheMigration {
+	if source.HasError() && !source.IsNotFound() {
+		// Keep the recorded state until the source can be read
+		return nil
+	}
+
+	var existing *aimv1alpha1.AIMServiceCacheMigrationStatus
+	if service.Status.Cache != nil {
+		existing = service.Status.Cache.Migration
+	}
+
+	m := &cacheMigration{source: source.Value}
+	if source.IsNotFound() {
+		m.source = nil
+	}
+
+	switch {
+	case m.source != nil && isTemplateCacheUsableForService(m.source, service, encryption, topologyStorageClass):
+		// Settings match the source cache (again), nothing to migrate
+		return nil
+	case existing != nil:
+		m.sourceRef = existing.Source
+		m.fromMode = existing.FromMode
+		m.startTime = existing.StartTime
+	case m.source == nil:
+		return nil
+	case service.Status.ResolvedTemplate == nil || m.source.Spec.TemplateName != service.Status.ResolvedTemplate.Name:
+		// A cache for another template is replaced by template resolution, not migrated
+		return nil
+	default:
+		m.sourceRef = aimv1alpha1.AIMResolvedReference{
+			Name:      m.source.Name,
+			Namespace: m.source.Namespace,
+			UID:       m.source.UID,
+		}
+		m.fromMode = m.source.Spec.Mode
+		m.startTime = metav1.NewTime(now)
+	}
+
+	switch {
+	case target == nil || target.Status.Status != constants.AIMStatusReady:
+		m.phase = aimv1alpha1.CacheMigrationPhaseProvisioning
+	case !isvcUsesTemplateCache(isvc, target) || !isvcReady:
+		m.phase = aimv1alpha1.CacheMigrationPhaseSwitching
+	case m.source != nil && m.source.Spec.Mode == aimv1alpha1.TemplateCacheModeDedicated &&
+		hasOwnerReferenceUID(m.source.GetOwnerReferences(), service.UID):
+		m.phase = aimv1alpha1.CacheMigrationPhaseReleasing
+	}
+	return m
+}
+
+// isvcUsesTemplateCache returns true if the InferenceService mounts the PVCs of all ready artifacts of the cache.
+func isvcUsesTemplateCache(isvc *servingv1beta1.InferenceService, cache *aimv1alpha1.AIMTemplateCache) bool {
+	if isvc == nil {
+		return false
+	}
+	claims := map[string]bool{}
+	for _, v := range isvc.Spec.Predictor.Volumes {
+		if v.PersistentVolumeClaim != nil {
+			claims[v.PersistentVolumeClaim.ClaimName] = true
+		}
+	}
+
+	found := false
+	for _, artifact := range cache.Status.Artifacts {
+		if artifact.Status != constants.AIMStatusReady || artifact.PersistentVolumeClaim == "" {
+			continue
+		}
+		if !claims[artifact.PersistentVolumeClaim] {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// planCacheMigrationRelease deletes the source cache once the service has switched away from it.
+func planCacheMigrationRelease(obs ServiceObservation) client.Object {
+	m := obs.cacheMigration
+	if m == nil || m.phase != aimv1alpha1.CacheMigrationPhaseReleasing {
+		return nil
+	}
+	return m.source
+}
+
+// setCacheMigrationStatus records the migration in status.cache.migration and the CacheMigration condition.
+func setCacheMigrationStatus(
+	status *aimv1alpha1.AIMServiceStatus,
+	cm *controllerutils.ConditionManager,
+	service *aimv1alpha1.AIMService,
+	m *cacheMigration,
+) {
+	if m == nil || m.phase == "" {
+		if status.Cache != nil {
+			status.Cache.Migration = nil
+		}
+		if cond := cm.Get(aimv1alpha1.AIMServiceConditionCacheMigration); cond != nil && cond.Status == metav1.ConditionTrue {
+			message := "Service uses a template cache that matches its caching settings"
+			if m != nil {
+				message = fmt.Sprintf("Migrated away from template cache %q", m.sourceRef.Name)
+			}
+			cm.MarkFalse(aimv1alpha1.AIMServiceConditionCacheMigration, aimv1alpha1.AIMServiceReasonMigrationCompleted, message)
+		}
+		return
+	}
+
+	if status.Cache == nil {
+		status.Cache = &aimv1alpha1.AIMServiceCacheStatus{}
+	}
+	status.Cache.Migration = &aimv1alpha1.AIMServiceCacheMigrationStatus{
+		Source:    m.sourceRef,
+		FromMode:  m.fromMode,
+		ToMode:    service.Spec.GetCachingMode(),
+		Phase:     m.phase,
+		StartTime: m.startTime,
+	}
+
+	var reason, message string
+	switch m.phase {
+	case aimv1alpha1.CacheMigrationPhaseProvisioning:
+		reason = aimv1alpha1.AIMServiceReasonProvisioningCache
+		message = fmt.Sprintf("Provisioning a %s cache; still serving from template cache %q",
+			service.Spec.GetCachingMode(), m.sourceRef.Name)
+	case aimv1alpha1.CacheMigrationPhaseSwitching:
+		reason = aimv1alpha1.AIMServiceReasonSwitchingVolumes
+		message = "Switching the InferenceService to the new template cache"
+	case aimv1alpha1.CacheMigrationPhaseReleasing:
+		reason = aimv1alpha1.AIMServiceReasonReleasingCache
+		message = fmt.Sprintf("Deleting previous dedicated template cache %q", m.sourceRef.Name)
+	}
+	cm.MarkTrue(aimv1alpha1.AIMServiceConditionCacheMigration, reason, message)
+}
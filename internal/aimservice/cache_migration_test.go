@@ -0,0 +1,269 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func newMigrationCache(name string, mode aimv1alpha1.AIMTemplateCacheMode, status constants.AIMStatus, ownerUID string) *aimv1alpha1.AIMTemplateCache {
+	cache := &aimv1alpha1.AIMTemplateCache{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, UID: types.UID("uid-" + name)},
+		Spec:       aimv1alpha1.AIMTemplateCacheSpec{TemplateName: "tmpl", Mode: mode},
+		Status: aimv1alpha1.AIMTemplateCacheStatus{
+			Status: status,
+			Artifacts: map[string]aimv1alpha1.AIMResolvedArtifact{
+				"model": {Name: name + "-artifact", Status: constants.AIMStatusReady, PersistentVolumeClaim: name + "-pvc"},
+			},
+		},
+	}
+	if ownerUID != "" {
+		cache.OwnerReferences = []metav1.OwnerReference{{Name: "svc", UID: types.UID(ownerUID)}}
+	}
+	return cache
+}
+
+func newMigrationISVC(claims ...string) *servingv1beta1.InferenceService {
+	isvc := &servingv1beta1.InferenceService{}
+	for _, claim := range claims {
+		isvc.Spec.Predictor.Volumes = append(isvc.Spec.Predictor.Volumes, corev1.Volume{
+			Name: claim,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+			},
+		})
+	}
+	return isvc
+}
+
+func newMigratingService(mode aimv1alpha1.AIMCachingMode, source *aimv1alpha1.AIMTemplateCache) *aimv1alpha1.AIMService {
+	service := NewService("svc").WithCachingMode(mode).Build()
+	service.Status.ResolvedTemplate = &aimv1alpha1.AIMResolvedReference{Name: "tmpl"}
+	service.Status.Cache = &aimv1alpha1.AIMServiceCacheStatus{
+		TemplateCacheRef: &aimv1alpha1.AIMResolvedReference{Name: source.Name, Namespace: source.Namespace, UID: source.UID},
+	}
+	return service
+}
+
+func TestComposeCacheMigration_Phases(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	dedicated := newMigrationCache("dedicated", aimv1alpha1.TemplateCacheModeDedicated, constants.AIMStatusReady, "test-service-uid")
+	sharedReady := newMigrationCache("shared", aimv1alpha1.TemplateCacheModeShared, constants.AIMStatusReady, "")
+	sharedPending := newMigrationCache("shared", aimv1alpha1.TemplateCacheModeShared, constants.AIMStatusProgressing, "")
+
+	tests := []struct {
+		name      string
+		target    *aimv1alpha1.AIMTemplateCache
+		isvc      *servingv1beta1.InferenceService
+		isvcReady bool
+		expected  aimv1alpha1.AIMCacheMigrationPhase
+	}{
+		{
+			name:     "target not ready keeps serving from source",
+			target:   sharedPending,
+			isvc:     newMigrationISVC("dedicated-pvc"),
+			expected: aimv1alpha1.CacheMigrationPhaseProvisioning,
+		},
+		{
+			name:      "target ready but isvc still on source volumes",
+			target:    sharedReady,
+			isvc:      newMigrationISVC("dedicated-pvc"),
+			isvcReady: true,
+			expected:  aimv1alpha1.CacheMigrationPhaseSwitching,
+		},
+		{
+			name:     "isvc switched but not ready yet",
+			target:   sharedReady,
+			isvc:     newMigrationISVC("shared-pvc"),
+			expected: aimv1alpha1.CacheMigrationPhaseSwitching,
+		},
+		{
+			name:      "isvc ready on target releases dedicated source",
+			target:    sharedReady,
+			isvc:      newMigrationISVC("shared-pvc"),
+			isvcReady: true,
+			expected:  aimv1alpha1.CacheMigrationPhaseReleasing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newMigratingService(aimv1alpha1.CachingModeShared, dedicated)
+			source := controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: dedicated}
+
+			m := composeCacheMigration(service, source, tt.target, tt.isvc, tt.isvcReady, nil, "", now)
+			if m == nil {
+				t.Fatal("expected a migration")
+			}
+			if m.phase != tt.expected {
+				t.Errorf("expected phase %q, got %q", tt.expected, m.phase)
+			}
+			if m.fromMode != aimv1alpha1.TemplateCacheModeDedicated || m.sourceRef.Name != "dedicated" {
+				t.Errorf("unexpected source %q (%s)", m.sourceRef.Name, m.fromMode)
+			}
+			if !m.startTime.Time.Equal(now) {
+				t.Errorf("expected start time %v, got %v", now, m.startTime)
+			}
+		})
+	}
+}
+
+func TestComposeCacheMigration_NoMigration(t *testing.T) {
+	now := time.Now()
+	shared := newMigrationCache("shared", aimv1alpha1.TemplateCacheModeShared, constants.AIMStatusReady, "")
+
+	t.Run("settings match source cache", func(t *testing.T) {
+		service := newMigratingService(aimv1alpha1.CachingModeShared, shared)
+		source := controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: shared}
+		if m := composeCacheMigration(service, source, shared, newMigrationISVC("shared-pvc"), true, nil, "", now); m != nil {
+			t.Errorf("expected no migration, got phase %q", m.phase)
+		}
+	})
+
+	t.Run("source belongs to another template", func(t *testing.T) {
+		service := newMigratingService(aimv1alpha1.CachingModeDedicated, shared)
+		service.Status.ResolvedTemplate.Name = "other-tmpl"
+		source := controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: shared}
+		if m := composeCacheMigration(service, source, nil, nil, false, nil, "", now); m != nil {
+			t.Errorf("expected no migration, got phase %q", m.phase)
+		}
+	})
+
+	t.Run("no cache recorded yet", func(t *testing.T) {
+		service := NewService("svc").Build()
+		if m := composeCacheMigration(service, controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{}, shared, nil, false, nil, "", now); m != nil {
+			t.Errorf("expected no migration, got phase %q", m.phase)
+		}
+	})
+}
+
+func TestComposeCacheMigration_CompletesAfterSourceDeleted(t *testing.T) {
+	dedicated := newMigrationCache("dedicated", aimv1alpha1.TemplateCacheModeDedicated, constants.AIMStatusReady, "test-service-uid")
+	shared := newMigrationCache("shared", aimv1alpha1.TemplateCacheModeShared, constants.AIMStatusReady, "")
+	start := metav1.NewTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	service := newMigratingService(aimv1alpha1.CachingModeShared, dedicated)
+	service.Status.Cache.Migration = &aimv1alpha1.AIMServiceCacheMigrationStatus{
+		Source:    *service.Status.Cache.TemplateCacheRef,
+		FromMode:  aimv1alpha1.TemplateCacheModeDedicated,
+		ToMode:    aimv1alpha1.CachingModeShared,
+		Phase:     aimv1alpha1.CacheMigrationPhaseReleasing,
+		StartTime: start,
+	}
+	source := controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{
+		Error: apierrors.NewNotFound(aimv1alpha1.GroupVersion.WithResource("aimtemplatecaches").GroupResource(), "dedicated"),
+	}
+
+	m := composeCacheMigration(service, source, shared, newMigrationISVC("shared-pvc"), true, nil, "", time.Now())
+	if m == nil {
+		t.Fatal("expected a migration")
+	}
+	if m.phase != "" {
+		t.Errorf("expected completed migration, got phase %q", m.phase)
+	}
+	if !m.startTime.Equal(&start) {
+		t.Errorf("expected start time to be preserved, got %v", m.startTime)
+	}
+}
+
+func TestComposeCacheMigration_SharedSourceNotReleased(t *testing.T) {
+	shared := newMigrationCache("shared", aimv1alpha1.TemplateCacheModeShared, constants.AIMStatusReady, "")
+	dedicated := newMigrationCache("dedicated", aimv1alpha1.TemplateCacheModeDedicated, constants.AIMStatusReady, "test-service-uid")
+
+	service := newMigratingService(aimv1alpha1.CachingModeDedicated, shared)
+	source := controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: shared}
+
+	m := composeCacheMigration(service, source, dedicated, newMigrationISVC("dedicated-pvc"), true, nil, "", time.Now())
+	if m == nil {
+		t.Fatal("expected a migration")
+	}
+	if m.phase != "" {
+		t.Errorf("expected shared source to be left in place, got phase %q", m.phase)
+	}
+	if planCacheMigrationRelease(ServiceObservation{cacheMigration: m}) != nil {
+		t.Error("expected shared source not to be deleted")
+	}
+}
+
+func TestPlanCacheMigrationRelease(t *testing.T) {
+	dedicated := newMigrationCache("dedicated", aimv1alpha1.TemplateCacheModeDedicated, constants.AIMStatusReady, "test-service-uid")
+
+	obs := ServiceObservation{cacheMigration: &cacheMigration{source: dedicated, phase: aimv1alpha1.CacheMigrationPhaseReleasing}}
+	if got := planCacheMigrationRelease(obs); got != dedicated {
+		t.Errorf("expected source cache to be released, got %v", got)
+	}
+
+	obs.cacheMigration.phase = aimv1alpha1.CacheMigrationPhaseSwitching
+	if got := planCacheMigrationRelease(obs); got != nil {
+		t.Errorf("expected nothing to release while switching, got %v", got)
+	}
+	if !obs.cacheMigration.switchesVolumes() {
+		t.Error("expected volumes to be switched")
+	}
+}
+
+func TestSetCacheMigrationStatus(t *testing.T) {
+	service := NewService("svc").WithCachingMode(aimv1alpha1.CachingModeShared).Build()
+	status := &aimv1alpha1.AIMServiceStatus{}
+	cm := controllerutils.NewConditionManager(nil)
+
+	m := &cacheMigration{
+		sourceRef: aimv1alpha1.AIMResolvedReference{Name: "dedicated"},
+		fromMode:  aimv1alpha1.TemplateCacheModeDedicated,
+		phase:     aimv1alpha1.CacheMigrationPhaseProvisioning,
+	}
+	setCacheMigrationStatus(status, cm, service, m)
+
+	if status.Cache == nil || status.Cache.Migration == nil {
+		t.Fatal("expected migration status")
+	}
+	if status.Cache.Migration.ToMode != aimv1alpha1.CachingModeShared {
+		t.Errorf("expected toMode Shared, got %q", status.Cache.Migration.ToMode)
+	}
+	cond := cm.Get(aimv1alpha1.AIMServiceConditionCacheMigration)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != aimv1alpha1.AIMServiceReasonProvisioningCache {
+		t.Fatalf("unexpected condition: %+v", cond)
+	}
+
+	m.phase = ""
+	setCacheMigrationStatus(status, cm, service, m)
+
+	if status.Cache.Migration != nil {
+		t.Error("expected migration status to be cleared")
+	}
+	cond = cm.Get(aimv1alpha1.AIMServiceConditionCacheMigration)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != aimv1alpha1.AIMServiceReasonMigrationCompleted {
+		t.Fatalf("unexpected condition: %+v", cond)
+	}
+}
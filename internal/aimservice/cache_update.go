@@ -0,0 +1,420 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const (
+	// engineReloadPath is the endpoint of the inference engine that reloads the model weights
+	// from disk without restarting the server.
+	engineReloadPath = "/v1/reload"
+
+	// weightsReloadTimeout bounds the reload request to a single predictor pod.
+	weightsReloadTimeout = 30 * time.Second
+
+	// weightsReloadRecheckInterval is how often a service is reconciled while reloads run in the
+	// background, to record their outcome.
+	weightsReloadRecheckInterval = 5 * time.Second
+)
+
+// reloadClient sends the reload requests; weightsReloadTimeout also bounds requests whose
+// context has no deadline.
+var reloadClient = &http.Client{Timeout: weightsReloadTimeout}
+
+// WeightsReloader asks the inference engine of a running predictor pod to reload its weights.
+type WeightsReloader func(ctx context.Context, pod *corev1.Pod) error
+
+// ReloadWeights is the default WeightsReloader. It posts to the engine's reload endpoint on
+// the pod and fails unless the engine answers with a success status.
+func ReloadWeights(ctx context.Context, pod *corev1.Pod) error {
+	address := net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(constants.DefaultHTTPPort))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://"+address+engineReloadPath, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := reloadClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed:
+		return errors.New("engine does not support reloading weights")
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return fmt.Errorf("engine answered %s", resp.Status)
+	}
+	return nil
+}
+
+// weightsRefresh is a cache refreshed in place whose weights the running predictor pods have not
+// loaded yet, i.e. the mounted revisions differ from status.cache.loadedWeightsDigest.
+type weightsRefresh struct {
+	policy aimv1alpha1.AIMCacheUpdatePolicy
+
+	// mounted are the artifacts mounted now, with the revisions the pods must load
+	mounted []aimv1alpha1.AIMMountedArtifact
+
+	// digest identifies the mounted revisions, see weightsDigest
+	digest string
+
+	// reloadFailed is set under the Reload policy once a reload failed, so the pods are
+	// restarted instead until the restart is applied
+	reloadFailed bool
+
+	// reload is the background reload of the digest, nil when it was not started yet
+	reload *weightsReload
+
+	// restarted is true once the InferenceService rolled the pods to load the digest
+	restarted bool
+}
+
+// restart returns true if the predictor pods must be rolled to load the refreshed weights.
+func (w *weightsRefresh) restart() bool {
+	if w == nil {
+		return false
+	}
+	return w.policy == aimv1alpha1.CacheUpdatePolicyRestart ||
+		(w.policy == aimv1alpha1.CacheUpdatePolicyReload && w.reloadFailed)
+}
+
+// reloading returns true if the engines of the running predictor pods are asked to reload.
+func (w *weightsRefresh) reloading() bool {
+	return w != nil && w.policy == aimv1alpha1.CacheUpdatePolicyReload && !w.reloadFailed
+}
+
+// currentMountedArtifacts returns the cached weights the inference service mounts, following
+// the same cache selection as DecorateStatus.
+func currentMountedArtifacts(f ServiceFetchResult) []aimv1alpha1.AIMMountedArtifact {
+	if shared := f.sharedModelCaches.Value; len(shared) > 0 {
+		return mountedArtifacts(f.service, nil, shared)
+	}
+	if f.templateCache.Value != nil && isTemplateCacheMountable(f.service, f.templateCache.Value) {
+		return mountedArtifacts(f.service, f.templateCache.Value, nil)
+	}
+	return nil
+}
+
+// weightsDigest returns a short digest identifying the revisions of the mounted models, or an
+// empty string when nothing is mounted. The artifact holding a revision is not part of the
+// digest, so a cache migration to the same revision is not a refresh. The artifacts are
+// expected sorted by model.
+func weightsDigest(mounted []aimv1alpha1.AIMMountedArtifact) string {
+	if len(mounted) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	for _, artifact := range mounted {
+		_, _ = fmt.Fprintf(h, "%s\x00%s\x00%s\n", artifact.Model, artifact.Revision, artifact.ManifestDigest)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// composeWeightsRefresh compares the mounted revisions with those the running predictor pods
+// loaded, and picks up the reload running in the background for them. Returns nil when the pods serve the mounted revisions, when the service is not running
+// yet, or when the revisions the pods loaded were never recorded.
+func (r *ServiceReconciler) composeWeightsRefresh(f ServiceFetchResult) *weightsRefresh {
+	service := f.service
+	isvc := f.inferenceService.Value
+	if !f.inferenceService.OK() || isvc == nil || service.Status.Cache == nil {
+		return nil
+	}
+	loaded := service.Status.Cache.LoadedWeightsDigest
+	mounted := currentMountedArtifacts(f)
+	digest := weightsDigest(mounted)
+	if loaded == "" || digest == "" || loaded == digest {
+		r.weightsReloads.forget(service.UID)
+		return nil
+	}
+
+	refresh := &weightsRefresh{policy: service.Spec.GetCacheUpdatePolicy(), mounted: mounted, digest: digest}
+	if refresh.policy == aimv1alpha1.CacheUpdatePolicyReload {
+		// A failed reload falls back to a restart, which may wait for a maintenance window
+		if cond := meta.FindStatusCondition(service.Status.Conditions, aimv1alpha1.AIMServiceConditionWeightsRefreshed); cond != nil {
+			refresh.reloadFailed = cond.Reason == aimv1alpha1.AIMServiceReasonReloadFailed ||
+				cond.Reason == aimv1alpha1.AIMServiceReasonRestartingPods
+		}
+	}
+	if refresh.reloading() {
+		refresh.reload = r.weightsReloads.get(service.UID, digest)
+	}
+	refresh.restarted = refresh.restart() && isvc.Spec.Predictor.Annotations[constants.AnnotationWeightsDigest] == digest
+	return refresh
+}
+
+// recordLoadedWeights sets status.cache.loadedWeightsDigest to the revisions the running predictor
+// pods serve. It follows the mounted revisions when the pods start with them or the refresh is
+// ignored, once a restart was applied, and once every ready pod reloaded.
+func recordLoadedWeights(status *aimv1alpha1.AIMServiceStatus, previous string, obs ServiceObservation) {
+	if status.Cache == nil {
+		return
+	}
+	status.Cache.LoadedWeightsDigest = previous
+	refresh := obs.weightsRefresh
+	switch {
+	case refresh == nil:
+		// New pods load the mounted revisions; existing pods are assumed to serve them when
+		// nothing was recorded before
+		if previous == "" || (obs.inferenceService.OK() && obs.inferenceService.Value == nil) {
+			status.Cache.LoadedWeightsDigest = weightsDigest(status.Cache.MountedArtifacts)
+		}
+	case refresh.policy == aimv1alpha1.CacheUpdatePolicyIgnore || refresh.restarted || refresh.reload.succeeded():
+		status.Cache.LoadedWeightsDigest = refresh.digest
+	}
+}
+
+// applyWeightsDigest sets the weights digest annotation of the predictor pods, which rolls them
+// when it changes. It follows the mounted revisions while a refresh requires a restart, so a
+// restart deferred by a maintenance window is applied when the window opens, and is otherwise
+// kept as it is.
+func applyWeightsDigest(isvc *servingv1beta1.InferenceService, obs ServiceObservation) {
+	digest := ""
+	if obs.inferenceService.Value != nil {
+		digest = obs.inferenceService.Value.Spec.Predictor.Annotations[constants.AnnotationWeightsDigest]
+	}
+	if obs.weightsRefresh.restart() {
+		digest = obs.weightsRefresh.digest
+	}
+	if digest == "" {
+		return
+	}
+	if isvc.Spec.Predictor.Annotations == nil {
+		isvc.Spec.Predictor.Annotations = map[string]string{}
+	}
+	isvc.Spec.Predictor.Annotations[constants.AnnotationWeightsDigest] = digest
+}
+
+// planWeightsReload starts the reload of the refreshed weights in the running predictor pods.
+// Like any change, the reload is only started when the plan is applied. It runs in the background,
+// and the service is reconciled again to record its outcome.
+func (r *ServiceReconciler) planWeightsReload(planResult *controllerutils.PlanResult, obs ServiceObservation) {
+	refresh := obs.weightsRefresh
+	if !refresh.reloading() || refresh.reload.finished() {
+		return
+	}
+	if planResult.RequeueAfter == 0 || planResult.RequeueAfter > weightsReloadRecheckInterval {
+		planResult.RequeueAfter = weightsReloadRecheckInterval
+	}
+	if refresh.reload != nil {
+		return
+	}
+	uid := obs.service.UID
+	pods := obs.inferenceServicePods
+	reloader := r.WeightsReloader
+	planResult.Run(func(ctx context.Context, _ *controllerutils.ConditionManager) {
+		r.weightsReloads.start(ctx, uid, refresh.digest, pods, reloader)
+	})
+}
+
+// weightsReloads tracks the reloads running in the background, one per service. A reload is
+// forgotten once the pods serve the mounted revisions or a newer revision is reloaded.
+type weightsReloads struct {
+	mu      sync.Mutex
+	reloads map[types.UID]*weightsReload
+}
+
+// weightsReload is the reload of a digest in the ready predictor pods of a service.
+type weightsReload struct {
+	digest string
+	// pods is the number of ready pods asked to reload
+	pods int
+	// done is set once all requests returned; err is set if any of them failed
+	done bool
+	err  error
+}
+
+// finished returns true once the reload completed, successfully or not.
+func (w *weightsReload) finished() bool {
+	return w != nil && w.done
+}
+
+// succeeded returns true once every ready pod reloaded.
+func (w *weightsReload) succeeded() bool {
+	return w.finished() && w.err == nil
+}
+
+// get returns a copy of the reload of digest for the service, or nil if none was started.
+func (t *weightsReloads) get(uid types.UID, digest string) *weightsReload {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	reload, ok := t.reloads[uid]
+	if !ok || reload.digest != digest {
+		return nil
+	}
+	copied := *reload
+	return &copied
+}
+
+// forget drops the reload of the service.
+func (t *weightsReloads) forget(uid types.UID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.reloads, uid)
+}
+
+// start asks the engine of each ready predictor pod to reload its weights, concurrently and in
+// the background, unless the digest is already being reloaded. Each request is bounded by
+// weightsReloadTimeout, so the reconcile that starts the reload does not wait for the engines.
+func (t *weightsReloads) start(
+	ctx context.Context,
+	uid types.UID,
+	digest string,
+	pods *controllerutils.FetchResult[*corev1.PodList],
+	reloader WeightsReloader,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if reload, ok := t.reloads[uid]; ok && reload.digest == digest {
+		return
+	}
+	if t.reloads == nil {
+		t.reloads = make(map[types.UID]*weightsReload)
+	}
+	reload := &weightsReload{digest: digest}
+	t.reloads[uid] = reload
+
+	switch {
+	case reloader == nil:
+		reload.done, reload.err = true, errors.New("reloading weights is not available")
+		return
+	case pods == nil || !pods.OK():
+		reload.done, reload.err = true, errors.New("predictor pods could not be listed")
+		return
+	}
+
+	var serving []*corev1.Pod
+	for i := range pods.Value.Items {
+		pod := &pods.Value.Items[i]
+		// Pods that are not serving yet load the refreshed weights when they start
+		if pod.DeletionTimestamp != nil || pod.Status.PodIP == "" || !isPodReady(pod) {
+			continue
+		}
+		serving = append(serving, pod.DeepCopy())
+	}
+	reload.pods = len(serving)
+
+	// The reload outlives the reconcile that started it
+	ctx = context.WithoutCancel(ctx)
+	logger := log.FromContext(ctx)
+	go func() {
+		errs := make([]error, len(serving))
+		var wg sync.WaitGroup
+		for i, pod := range serving {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				podCtx, cancel := context.WithTimeout(ctx, weightsReloadTimeout)
+				defer cancel()
+				if err := reloader(podCtx, pod); err != nil {
+					errs[i] = fmt.Errorf("pod %s: %w", pod.Name, err)
+					return
+				}
+				logger.V(1).Info("Reloaded model weights", "pod", pod.Name)
+			}()
+		}
+		wg.Wait()
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		reload.done, reload.err = true, errors.Join(errs...)
+	}()
+}
+
+// describeRefreshed lists the refreshed artifacts with their new revision.
+func describeRefreshed(refreshed []aimv1alpha1.AIMMountedArtifact) string {
+	parts := make([]string, 0, len(refreshed))
+	for _, artifact := range refreshed {
+		switch {
+		case artifact.Revision != "":
+			parts = append(parts, fmt.Sprintf("%s revision %s", artifact.Model, artifact.Revision))
+		default:
+			parts = append(parts, fmt.Sprintf("%s digest %s", artifact.Model, artifact.ManifestDigest))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// setWeightsRefreshedCondition reports how the running pods are updated after a cache was
+// refreshed in place. A ModelReloaded event is emitted once the pods load the refresh; the
+// condition is left unchanged afterwards.
+func setWeightsRefreshedCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	refresh := obs.weightsRefresh
+	if refresh == nil {
+		return
+	}
+	revisions := describeRefreshed(refresh.mounted)
+	switch {
+	case refresh.policy == aimv1alpha1.CacheUpdatePolicyIgnore:
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionWeightsRefreshed, aimv1alpha1.AIMServiceReasonRefreshNotApplied,
+			fmt.Sprintf("Cache refreshed with %s; running pods keep the previous weights until they are replaced", revisions))
+	case refresh.restarted:
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionWeightsRefreshed, aimv1alpha1.AIMServiceReasonPodsRestarted,
+			fmt.Sprintf("Restarted predictor pods to load %s", revisions),
+			controllerutils.WithEventReason(aimv1alpha1.AIMServiceReasonModelReloaded), controllerutils.WithRecurring())
+	case refresh.restart():
+		message := fmt.Sprintf("Restarting predictor pods to load %s", revisions)
+		if refresh.reloadFailed {
+			message = fmt.Sprintf("Reloading failed, restarting predictor pods to load %s", revisions)
+		}
+		if obs.maintenance != nil && obs.maintenance.deferred {
+			message += " when the maintenance window opens"
+		}
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionWeightsRefreshed, aimv1alpha1.AIMServiceReasonRestartingPods, message)
+	case refresh.reload.succeeded():
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionWeightsRefreshed, aimv1alpha1.AIMServiceReasonModelReloaded,
+			fmt.Sprintf("Reloaded %s in %d predictor pods", revisions, refresh.reload.pods),
+			controllerutils.WithEventReason(aimv1alpha1.AIMServiceReasonModelReloaded), controllerutils.WithRecurring())
+	case refresh.reload.finished():
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionWeightsRefreshed, aimv1alpha1.AIMServiceReasonReloadFailed,
+			fmt.Sprintf("Reloading %s failed, restarting predictor pods instead: %v", revisions, refresh.reload.err),
+			controllerutils.AsWarning())
+	case refresh.reload != nil:
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionWeightsRefreshed, aimv1alpha1.AIMServiceReasonReloadPending,
+			fmt.Sprintf("Reloading %s in %d predictor pods", revisions, refresh.reload.pods))
+	default:
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionWeightsRefreshed, aimv1alpha1.AIMServiceReasonReloadPending,
+			fmt.Sprintf("Reloading %s in the running predictor pods", revisions))
+	}
+}
@@ -0,0 +1,665 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// refreshFetch returns a fetch result for a running service whose pods loaded revision "old" of
+// a shared cache that now holds the given revision.
+func refreshFetch(policy aimv1alpha1.AIMCacheUpdatePolicy, revision string, pods ...corev1.Pod) ServiceFetchResult {
+	service := NewService("svc").WithTemplateName("tmpl").Build()
+	service.Spec.CacheUpdatePolicy = policy
+	service.Status.Cache = &aimv1alpha1.AIMServiceCacheStatus{
+		LoadedWeightsDigest: weightsDigest([]aimv1alpha1.AIMMountedArtifact{{Model: "Qwen/Qwen2-0.5B", Revision: "old"}}),
+	}
+	template := NewTemplate("tmpl").WithModelSources(aimv1alpha1.AIMModelSource{
+		ModelID:   "Qwen/Qwen2-0.5B",
+		SourceURI: testSharedSourceURI,
+	}).Build()
+	cache, volume := newSharedCacheObjects(&metav1.LabelSelector{})
+	cache.Status.Artifact = "shared"
+	cache.Status.Revision = revision
+	podList := controllerutils.FetchResult[*corev1.PodList]{Value: &corev1.PodList{Items: pods}}
+	return ServiceFetchResult{
+		service:  service,
+		template: controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: template},
+		inferenceService: controllerutils.FetchResult[*servingv1beta1.InferenceService]{
+			Value: &servingv1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: testNamespace}},
+		},
+		inferenceServicePods: &podList,
+		sharedModelCaches: controllerutils.FetchResult[[]sharedModelCache]{Value: []sharedModelCache{{
+			cache: cache, volume: volume, modelSource: template.Status.ModelSources[0],
+		}}},
+	}
+}
+
+func servingPod(name string, ready bool) corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Status: corev1.PodStatus{
+			PodIP:      "10.0.0.1",
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+// setWeightsDigestAnnotation sets the weights digest on the predictor of the fetched InferenceService.
+func setWeightsDigestAnnotation(fetch ServiceFetchResult, digest string) {
+	fetch.inferenceService.Value.Spec.Predictor.Annotations = map[string]string{constants.AnnotationWeightsDigest: digest}
+}
+
+func TestWeightsDigest(t *testing.T) {
+	revision := []aimv1alpha1.AIMMountedArtifact{{Model: "a", Artifact: "a-1", Revision: "r1", ManifestDigest: "sha256:1"}}
+	if weightsDigest(nil) != "" {
+		t.Error("expected no digest without mounted artifacts")
+	}
+	migrated := []aimv1alpha1.AIMMountedArtifact{{Model: "a", Artifact: "a-2", Revision: "r1", ManifestDigest: "sha256:1"}}
+	if weightsDigest(revision) != weightsDigest(migrated) {
+		t.Error("expected a move to another artifact with the same revision to keep the digest")
+	}
+	refreshed := []aimv1alpha1.AIMMountedArtifact{{Model: "a", Artifact: "a-1", Revision: "r1", ManifestDigest: "sha256:2"}}
+	if weightsDigest(revision) == weightsDigest(refreshed) {
+		t.Error("expected a new manifest digest to change the digest")
+	}
+}
+
+func TestComposeWeightsRefresh(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        aimv1alpha1.AIMCacheUpdatePolicy
+		revision      string
+		loaded        *string
+		previous      string
+		annotation    bool
+		expectRefresh bool
+		expectRestart bool
+		expectReload  bool
+		expectDone    bool
+	}{
+		{name: "pods serve the mounted revision", policy: aimv1alpha1.CacheUpdatePolicyReload, revision: "old"},
+		{name: "loaded revision unknown", policy: aimv1alpha1.CacheUpdatePolicyReload, revision: "new", loaded: new(string)},
+		{name: "ignore", policy: aimv1alpha1.CacheUpdatePolicyIgnore, revision: "new", expectRefresh: true},
+		{
+			name: "restart", policy: aimv1alpha1.CacheUpdatePolicyRestart, revision: "new",
+			expectRefresh: true, expectRestart: true,
+		},
+		{
+			name: "restart applied", policy: aimv1alpha1.CacheUpdatePolicyRestart, revision: "new", annotation: true,
+			expectRefresh: true, expectRestart: true, expectDone: true,
+		},
+		{
+			name: "reload", policy: aimv1alpha1.CacheUpdatePolicyReload, revision: "new",
+			expectRefresh: true, expectReload: true,
+		},
+		{
+			name: "reload failed", policy: aimv1alpha1.CacheUpdatePolicyReload, revision: "new",
+			previous:      aimv1alpha1.AIMServiceReasonReloadFailed,
+			expectRefresh: true, expectRestart: true,
+		},
+		{
+			name: "restart after failed reload", policy: aimv1alpha1.CacheUpdatePolicyReload, revision: "new",
+			previous:      aimv1alpha1.AIMServiceReasonRestartingPods,
+			expectRefresh: true, expectRestart: true,
+		},
+		{
+			name: "reload after an earlier reload", policy: aimv1alpha1.CacheUpdatePolicyReload, revision: "new",
+			previous:      aimv1alpha1.AIMServiceReasonModelReloaded,
+			expectRefresh: true, expectReload: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch := refreshFetch(tt.policy, tt.revision)
+			if tt.loaded != nil {
+				fetch.service.Status.Cache.LoadedWeightsDigest = *tt.loaded
+			}
+			if tt.previous != "" {
+				fetch.service.Status.Conditions = []metav1.Condition{{
+					Type: aimv1alpha1.AIMServiceConditionWeightsRefreshed, Status: metav1.ConditionFalse, Reason: tt.previous,
+				}}
+			}
+			if tt.annotation {
+				setWeightsDigestAnnotation(fetch, weightsDigest(currentMountedArtifacts(fetch)))
+			}
+
+			refresh := (&ServiceReconciler{}).composeWeightsRefresh(fetch)
+			if (refresh != nil) != tt.expectRefresh {
+				t.Fatalf("expected refresh=%v, got %+v", tt.expectRefresh, refresh)
+			}
+			if refresh.restart() != tt.expectRestart || refresh.reloading() != tt.expectReload {
+				t.Errorf("restart=%v reload=%v, want %v/%v", refresh.restart(), refresh.reloading(), tt.expectRestart, tt.expectReload)
+			}
+			if refresh != nil && refresh.restarted != tt.expectDone {
+				t.Errorf("expected restarted=%v, got %v", tt.expectDone, refresh.restarted)
+			}
+		})
+	}
+}
+
+// waitForReload waits until the background reload of digest finished.
+func waitForReload(t *testing.T, r *ServiceReconciler, uid types.UID, digest string) *weightsReload {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if reload := r.weightsReloads.get(uid, digest); reload.finished() {
+			return reload
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("reload of %s did not finish", digest)
+	return nil
+}
+
+func TestWeightsReloads_Start(t *testing.T) {
+	pods := controllerutils.FetchResult[*corev1.PodList]{Value: &corev1.PodList{Items: []corev1.Pod{
+		servingPod("ready-1", true), servingPod("starting", false), servingPod("ready-2", true),
+	}}}
+
+	release := make(chan struct{})
+	var calls atomic.Int32
+	reloader := func(ctx context.Context, pod *corev1.Pod) error {
+		calls.Add(1)
+		if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > weightsReloadTimeout {
+			t.Error("expected each reload to be bounded by the request timeout")
+		}
+		<-release
+		if pod.Name == "ready-2" {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	r := &ServiceReconciler{}
+	r.weightsReloads.start(testContext(), "uid", "digest", &pods, reloader)
+	if reload := r.weightsReloads.get("uid", "digest"); reload == nil || reload.finished() || reload.pods != 2 {
+		t.Fatalf("expected the reload of both ready pods to run in the background, got %+v", reload)
+	}
+	// Starting the same digest again does not send more requests
+	r.weightsReloads.start(testContext(), "uid", "digest", &pods, reloader)
+	close(release)
+
+	reload := waitForReload(t, r, "uid", "digest")
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 reload requests, got %d", calls.Load())
+	}
+	if reload.succeeded() || !strings.Contains(reload.err.Error(), "pod ready-2") {
+		t.Errorf("expected the failed pod to be reported, got %v", reload.err)
+	}
+	if r.weightsReloads.get("uid", "other") != nil {
+		t.Error("expected no reload for another digest")
+	}
+
+	r.weightsReloads.start(testContext(), "nil-reloader", "digest", &pods, nil)
+	if reload := r.weightsReloads.get("nil-reloader", "digest"); !reload.finished() || reload.err == nil {
+		t.Errorf("expected the reload to fail without a reloader, got %+v", reload)
+	}
+}
+
+func TestPlanWeightsReload(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        aimv1alpha1.AIMCacheUpdatePolicy
+		reload        *weightsReload
+		expectAction  bool
+		expectRequeue bool
+	}{
+		{name: "restart", policy: aimv1alpha1.CacheUpdatePolicyRestart},
+		{name: "not started", policy: aimv1alpha1.CacheUpdatePolicyReload, expectAction: true, expectRequeue: true},
+		{name: "running", policy: aimv1alpha1.CacheUpdatePolicyReload, reload: &weightsReload{pods: 1}, expectRequeue: true},
+		{name: "finished", policy: aimv1alpha1.CacheUpdatePolicyReload, reload: &weightsReload{pods: 1, done: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ServiceReconciler{WeightsReloader: func(context.Context, *corev1.Pod) error { return nil }}
+			fetch := refreshFetch(tt.policy, "new", servingPod("ready", true))
+			fetch.service.UID = "svc-uid"
+			obs := ServiceObservation{ServiceFetchResult: fetch, weightsRefresh: r.composeWeightsRefresh(fetch)}
+			if obs.weightsRefresh.reloading() {
+				obs.weightsRefresh.reload = tt.reload
+			}
+
+			var plan controllerutils.PlanResult
+			r.planWeightsReload(&plan, obs)
+			if (len(plan.GetActions()) == 1) != tt.expectAction {
+				t.Fatalf("expected action=%v, got %d actions", tt.expectAction, len(plan.GetActions()))
+			}
+			if (plan.RequeueAfter == weightsReloadRecheckInterval) != tt.expectRequeue {
+				t.Errorf("expected requeue=%v, got %v", tt.expectRequeue, plan.RequeueAfter)
+			}
+			if !tt.expectAction {
+				return
+			}
+
+			plan.GetActions()[0](testContext(), controllerutils.NewConditionManager(nil))
+			if reload := waitForReload(t, r, "svc-uid", obs.weightsRefresh.digest); !reload.succeeded() {
+				t.Errorf("expected the action to reload the pods, got %+v", reload)
+			}
+		})
+	}
+}
+
+// TestWeightsRefresh_Reloaded records a background reload that succeeded in a later reconcile.
+func TestWeightsRefresh_Reloaded(t *testing.T) {
+	r := &ServiceReconciler{WeightsReloader: func(context.Context, *corev1.Pod) error { return nil }}
+	fetch := refreshFetch(aimv1alpha1.CacheUpdatePolicyReload, "new", servingPod("ready", true))
+	fetch.service.UID = "svc-uid"
+	digest := weightsDigest(currentMountedArtifacts(fetch))
+	r.weightsReloads.start(testContext(), "svc-uid", digest, fetch.inferenceServicePods, r.WeightsReloader)
+	waitForReload(t, r, "svc-uid", digest)
+
+	obs := ServiceObservation{ServiceFetchResult: fetch, weightsRefresh: r.composeWeightsRefresh(fetch)}
+	cm := controllerutils.NewConditionManager(nil)
+	r.DecorateStatus(&fetch.service.Status, cm, obs)
+	if fetch.service.Status.Cache.LoadedWeightsDigest != digest {
+		t.Errorf("expected the loaded digest %s, got %s", digest, fetch.service.Status.Cache.LoadedWeightsDigest)
+	}
+	if cond := cm.Get(aimv1alpha1.AIMServiceConditionWeightsRefreshed); cond == nil ||
+		cond.Reason != aimv1alpha1.AIMServiceReasonModelReloaded {
+		t.Errorf("expected a ModelReloaded condition, got %+v", cond)
+	}
+
+	// The reload is forgotten once the pods serve the mounted revisions
+	if r.composeWeightsRefresh(fetch) != nil || r.weightsReloads.get("svc-uid", digest) != nil {
+		t.Error("expected no refresh and no tracked reload after the reload was recorded")
+	}
+}
+
+// TestWeightsRefresh_ReloadFailureDeferredByMaintenanceWindow walks a failed reload through the
+// reconciles that follow: the fallback restart waits for the maintenance window, and the loaded
+// digest only advances once the restart was applied.
+func TestWeightsRefresh_ReloadFailureDeferredByMaintenanceWindow(t *testing.T) {
+	helsinki, err := time.LoadLocation("Europe/Helsinki")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	// 2026-01-03 is a Saturday
+	windowOpen := time.Date(2026, 1, 3, 3, 0, 0, 0, helsinki)
+	windowClosed := windowOpen.Add(-2 * time.Hour)
+
+	ctx := testContext()
+	r := &ServiceReconciler{WeightsReloader: func(context.Context, *corev1.Pod) error {
+		return errors.New("engine does not support reloading weights")
+	}}
+	fetch := refreshFetch(aimv1alpha1.CacheUpdatePolicyReload, "new", servingPod("ready", true))
+	service := fetch.service
+	service.UID = "svc-uid"
+	service.Spec.MaintenanceWindow = weekendWindow()
+	loaded := service.Status.Cache.LoadedWeightsDigest
+
+	// The running InferenceService matches the plan apart from the refreshed weights
+	fetch.inferenceService.Value = ServiceObservation{ServiceFetchResult: fetch}.desiredInferenceService(ctx)
+	if fetch.inferenceService.Value == nil {
+		t.Fatal("expected an InferenceService to be planned")
+	}
+
+	reconcile := func(now time.Time) (ServiceObservation, controllerutils.PlanResult, *controllerutils.ConditionManager) {
+		obs := ServiceObservation{ServiceFetchResult: fetch}
+		obs.weightsRefresh = r.composeWeightsRefresh(fetch)
+		obs.maintenance = obs.checkMaintenanceWindow(ctx, now)
+		plan := r.PlanResources(ctx, controllerutils.ReconcileContext[*aimv1alpha1.AIMService]{Object: service}, obs)
+		cm := controllerutils.NewConditionManager(service.Status.Conditions)
+		r.DecorateStatus(&service.Status, cm, obs)
+		for _, action := range plan.GetActions() {
+			action(ctx, cm)
+		}
+		service.Status.Conditions = cm.Conditions()
+		return obs, plan, cm
+	}
+	plannedDigest := func(plan controllerutils.PlanResult) (string, bool) {
+		for _, obj := range plan.GetToApply() {
+			if isvc, ok := obj.(*servingv1beta1.InferenceService); ok {
+				return isvc.Spec.Predictor.Annotations[constants.AnnotationWeightsDigest], true
+			}
+		}
+		return "", false
+	}
+	expectReason := func(cm *controllerutils.ConditionManager, reason string) {
+		t.Helper()
+		if cond := cm.Get(aimv1alpha1.AIMServiceConditionWeightsRefreshed); cond == nil || cond.Reason != reason {
+			t.Fatalf("expected reason %s, got %+v", reason, cond)
+		}
+	}
+
+	// The reload is started in the background
+	obs, plan, cm := reconcile(windowClosed)
+	if len(plan.GetActions()) != 1 {
+		t.Fatalf("expected a reload action, got %d", len(plan.GetActions()))
+	}
+	expectReason(cm, aimv1alpha1.AIMServiceReasonReloadPending)
+	current := obs.weightsRefresh.digest
+	waitForReload(t, r, service.UID, current)
+
+	// The next reconcile records the failure, so the loaded digest stays behind
+	_, plan, cm = reconcile(windowClosed)
+	if len(plan.GetActions()) != 0 {
+		t.Error("expected a failed reload not to be started again")
+	}
+	expectReason(cm, aimv1alpha1.AIMServiceReasonReloadFailed)
+	if service.Status.Cache.LoadedWeightsDigest != loaded {
+		t.Fatal("expected the loaded digest to stay behind after a failed reload")
+	}
+
+	// The fallback restart is deferred by the maintenance window and not reloaded again
+	obs, plan, cm = reconcile(windowClosed)
+	if obs.maintenance == nil || !obs.maintenance.deferred {
+		t.Fatal("expected the restart to be deferred by the maintenance window")
+	}
+	if len(plan.GetActions()) != 0 {
+		t.Error("expected no reload while the restart is pending")
+	}
+	if _, planned := plannedDigest(plan); planned {
+		t.Error("expected the InferenceService change to be deferred")
+	}
+	expectReason(cm, aimv1alpha1.AIMServiceReasonRestartingPods)
+	if service.Status.Cache.LoadedWeightsDigest != loaded {
+		t.Fatal("expected the loaded digest to stay behind while the restart is deferred")
+	}
+
+	// Once the window opens the restart is planned
+	_, plan, cm = reconcile(windowOpen)
+	digest, planned := plannedDigest(plan)
+	if !planned || digest != current {
+		t.Fatalf("expected the InferenceService to be planned with digest %s, got %q (planned=%v)", current, digest, planned)
+	}
+	expectReason(cm, aimv1alpha1.AIMServiceReasonRestartingPods)
+	if service.Status.Cache.LoadedWeightsDigest != loaded {
+		t.Fatal("expected the loaded digest to stay behind until the restart is applied")
+	}
+
+	// The applied restart completes the refresh
+	setWeightsDigestAnnotation(fetch, digest)
+	_, _, cm = reconcile(windowOpen)
+	expectReason(cm, aimv1alpha1.AIMServiceReasonPodsRestarted)
+	if service.Status.Cache.LoadedWeightsDigest != current {
+		t.Errorf("expected the loaded digest %s after the restart, got %s", current, service.Status.Cache.LoadedWeightsDigest)
+	}
+	if r.composeWeightsRefresh(fetch) != nil {
+		t.Error("expected no refresh once the pods loaded the mounted revision")
+	}
+}
+
+func TestApplyWeightsDigest(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   aimv1alpha1.AIMCacheUpdatePolicy
+		existing string
+		creating bool
+		previous string
+		expected string
+	}{
+		{
+			name:     "ignore keeps pods untouched",
+			policy:   aimv1alpha1.CacheUpdatePolicyIgnore,
+			expected: "",
+		},
+		{
+			name:     "ignore preserves an existing digest",
+			policy:   aimv1alpha1.CacheUpdatePolicyIgnore,
+			existing: "previous",
+			expected: "previous",
+		},
+		{
+			name:     "no digest on creation",
+			policy:   aimv1alpha1.CacheUpdatePolicyRestart,
+			creating: true,
+			expected: "",
+		},
+		{
+			name:     "restart follows the mounted revisions",
+			policy:   aimv1alpha1.CacheUpdatePolicyRestart,
+			existing: "previous",
+			expected: "current",
+		},
+		{
+			name:     "failed reload rolls the pods",
+			policy:   aimv1alpha1.CacheUpdatePolicyReload,
+			previous: aimv1alpha1.AIMServiceReasonReloadFailed,
+			expected: "current",
+		},
+		{
+			name:     "reload keeps the pods",
+			policy:   aimv1alpha1.CacheUpdatePolicyReload,
+			existing: "previous",
+			expected: "previous",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch := refreshFetch(tt.policy, "new")
+			if tt.previous != "" {
+				fetch.service.Status.Conditions = []metav1.Condition{{
+					Type: aimv1alpha1.AIMServiceConditionWeightsRefreshed, Status: metav1.ConditionFalse, Reason: tt.previous,
+				}}
+			}
+			if tt.existing != "" {
+				setWeightsDigestAnnotation(fetch, tt.existing)
+			}
+			obs := ServiceObservation{ServiceFetchResult: fetch, weightsRefresh: (&ServiceReconciler{}).composeWeightsRefresh(fetch)}
+			if tt.creating {
+				obs.inferenceService = controllerutils.FetchResult[*servingv1beta1.InferenceService]{}
+				obs.weightsRefresh = nil
+			}
+			expected := tt.expected
+			if expected == "current" {
+				expected = weightsDigest(currentMountedArtifacts(fetch))
+			}
+
+			isvc := &servingv1beta1.InferenceService{}
+			applyWeightsDigest(isvc, obs)
+
+			if got := isvc.Spec.Predictor.Annotations[constants.AnnotationWeightsDigest]; got != expected {
+				t.Errorf("expected digest %q, got %q", expected, got)
+			}
+		})
+	}
+}
+
+func TestRecordLoadedWeights(t *testing.T) {
+	mounted := []aimv1alpha1.AIMMountedArtifact{{Model: "Qwen/Qwen2-0.5B", Revision: "new"}}
+	current := weightsDigest(mounted)
+
+	tests := []struct {
+		name     string
+		previous string
+		creating bool
+		refresh  *weightsRefresh
+		expected string
+	}{
+		{name: "first recorded", expected: current},
+		{name: "new InferenceService", previous: "old", creating: true, expected: current},
+		{name: "nothing refreshed", previous: current, expected: current},
+		{
+			name: "ignored refresh", previous: "old", expected: current,
+			refresh: &weightsRefresh{policy: aimv1alpha1.CacheUpdatePolicyIgnore, digest: current},
+		},
+		{
+			name: "restart pending", previous: "old", expected: "old",
+			refresh: &weightsRefresh{policy: aimv1alpha1.CacheUpdatePolicyRestart, digest: current},
+		},
+		{
+			name: "restart applied", previous: "old", expected: current,
+			refresh: &weightsRefresh{policy: aimv1alpha1.CacheUpdatePolicyRestart, digest: current, restarted: true},
+		},
+		{
+			name: "reload pending", previous: "old", expected: "old",
+			refresh: &weightsRefresh{policy: aimv1alpha1.CacheUpdatePolicyReload, digest: current},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &aimv1alpha1.AIMServiceStatus{Cache: &aimv1alpha1.AIMServiceCacheStatus{MountedArtifacts: mounted}}
+			obs := ServiceObservation{weightsRefresh: tt.refresh}
+			if !tt.creating {
+				obs.inferenceService.Value = &servingv1beta1.InferenceService{}
+			}
+			recordLoadedWeights(status, tt.previous, obs)
+			if status.Cache.LoadedWeightsDigest != tt.expected {
+				t.Errorf("expected loaded digest %q, got %q", tt.expected, status.Cache.LoadedWeightsDigest)
+			}
+		})
+	}
+}
+
+func TestSetWeightsRefreshedCondition(t *testing.T) {
+	mounted := []aimv1alpha1.AIMMountedArtifact{{Model: "org/model", Artifact: "shared", Revision: "new"}}
+
+	tests := []struct {
+		name         string
+		refresh      *weightsRefresh
+		deferred     bool
+		expectStatus metav1.ConditionStatus
+		expectReason string
+		expectEvent  bool
+		expectText   string
+	}{
+		{
+			name: "no refresh",
+		},
+		{
+			name:         "ignored",
+			refresh:      &weightsRefresh{policy: aimv1alpha1.CacheUpdatePolicyIgnore, mounted: mounted},
+			expectStatus: metav1.ConditionFalse,
+			expectReason: aimv1alpha1.AIMServiceReasonRefreshNotApplied,
+		},
+		{
+			name:         "restarting",
+			refresh:      &weightsRefresh{policy: aimv1alpha1.CacheUpdatePolicyRestart, mounted: mounted},
+			expectStatus: metav1.ConditionFalse,
+			expectReason: aimv1alpha1.AIMServiceReasonRestartingPods,
+		},
+		{
+			name: "restart after failed reload deferred",
+			refresh: &weightsRefresh{
+				policy: aimv1alpha1.CacheUpdatePolicyReload, mounted: mounted, reloadFailed: true,
+			},
+			deferred:     true,
+			expectStatus: metav1.ConditionFalse,
+			expectReason: aimv1alpha1.AIMServiceReasonRestartingPods,
+			expectText:   "maintenance window",
+		},
+		{
+			name:         "restarted",
+			refresh:      &weightsRefresh{policy: aimv1alpha1.CacheUpdatePolicyRestart, mounted: mounted, restarted: true},
+			expectStatus: metav1.ConditionTrue,
+			expectReason: aimv1alpha1.AIMServiceReasonPodsRestarted,
+			expectEvent:  true,
+		},
+		{
+			name:         "reload pending",
+			refresh:      &weightsRefresh{policy: aimv1alpha1.CacheUpdatePolicyReload, mounted: mounted},
+			expectStatus: metav1.ConditionFalse,
+			expectReason: aimv1alpha1.AIMServiceReasonReloadPending,
+		},
+		{
+			name: "reload running",
+			refresh: &weightsRefresh{
+				policy: aimv1alpha1.CacheUpdatePolicyReload, mounted: mounted, reload: &weightsReload{pods: 2},
+			},
+			expectStatus: metav1.ConditionFalse,
+			expectReason: aimv1alpha1.AIMServiceReasonReloadPending,
+			expectText:   "2 predictor pods",
+		},
+		{
+			name: "reload failed",
+			refresh: &weightsRefresh{
+				policy: aimv1alpha1.CacheUpdatePolicyReload, mounted: mounted,
+				reload: &weightsReload{pods: 2, done: true, err: errors.New("connection refused")},
+			},
+			expectStatus: metav1.ConditionFalse,
+			expectReason: aimv1alpha1.AIMServiceReasonReloadFailed,
+			expectText:   "connection refused",
+		},
+		{
+			name: "reloaded",
+			refresh: &weightsRefresh{
+				policy: aimv1alpha1.CacheUpdatePolicyReload, mounted: mounted, reload: &weightsReload{pods: 2, done: true},
+			},
+			expectStatus: metav1.ConditionTrue,
+			expectReason: aimv1alpha1.AIMServiceReasonModelReloaded,
+			expectEvent:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := controllerutils.NewConditionManager(nil)
+			obs := ServiceObservation{
+				ServiceFetchResult: ServiceFetchResult{service: NewService("svc").Build()},
+				weightsRefresh:     tt.refresh,
+			}
+			if tt.deferred {
+				obs.maintenance = &maintenanceCheck{deferred: true}
+			}
+			setWeightsRefreshedCondition(cm, obs)
+
+			cond := cm.Get(aimv1alpha1.AIMServiceConditionWeightsRefreshed)
+			if tt.expectStatus == "" {
+				if cond != nil {
+					t.Fatalf("expected no condition, got %+v", cond)
+				}
+				return
+			}
+			if cond == nil {
+				t.Fatal("expected condition to be set")
+			}
+			if cond.Status != tt.expectStatus || cond.Reason != tt.expectReason {
+				t.Errorf("condition = %s/%s, want %s/%s", cond.Status, cond.Reason, tt.expectStatus, tt.expectReason)
+			}
+			if !strings.Contains(cond.Message, tt.expectText) {
+				t.Errorf("expected message to mention %q, got %q", tt.expectText, cond.Message)
+			}
+
+			recorder := record.NewFakeRecorder(10)
+			controllerutils.EmitRecurringEvents(recorder, NewService("svc").Build(), cm)
+			emitted := len(recorder.Events) > 0 && strings.Contains(<-recorder.Events, " ModelReloaded ")
+			if emitted != tt.expectEvent {
+				t.Errorf("expected ModelReloaded event=%v, got %v", tt.expectEvent, emitted)
+			}
+		})
+	}
+}
@@ -29,11 +29,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimtemplatecache"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
 	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
@@ -51,9 +53,12 @@ const (
 // GenerateTemplateCacheName creates a deterministic name for a template cache.
 // For dedicated mode, serviceIdentity should be the service UID to avoid
 // conflicts when a service is deleted and recreated with the same name.
+// Encrypted shared caches are scoped to their key so they never collide with plain ones.
 func GenerateTemplateCacheName(
 	templateName, namespace, serviceName, serviceIdentity string,
 	cachingMode aimv1alpha1.AIMCachingMode,
+	encryption *aimv1alpha1.AIMStorageEncryptionConfig,
+	topologyStorageClass string,
 ) (string, error) {
 	if cachingMode == aimv1alpha1.CachingModeDedicated {
 		// Keep the visible name readable while including service identity
@@ -64,7 +69,16 @@ func GenerateTemplateCacheName(
 		)
 	}
 
-	return utils.GenerateDerivedName([]string{templateName}, utils.WithHashSource(namespace))
+	// Shared caches of services in different zones must not collide, but the name of caches
+	// without a topology storage class stays unchanged.
+	hashSource := []any{namespace}
+	if encryption != nil {
+		hashSource = append(hashSource, "encrypted", encryption.KMSSecretRef.Name, encryption.KMSSecretRef.Key)
+	}
+	if topologyStorageClass != "" {
+		hashSource = append(hashSource, "storage-class", topologyStorageClass)
+	}
+	return utils.GenerateDerivedName([]string{templateName}, utils.WithHashSource(hashSource...))
 }
 
 // planTemplateCache creates a template cache for all caching modes.
@@ -103,8 +117,9 @@ func planTemplateCache(
 		cacheMode = aimv1alpha1.TemplateCacheModeShared
 	}
 
-	// Resolve storage class
+	// Resolve storage class and encryption
 	storageClassName := resolveStorageClassName(service, obs)
+	encryption := resolveStorageEncryption(service, obs.mergedRuntimeConfig.Value)
 
 	cacheName, err := GenerateTemplateCacheName(
 		templateName,
@@ -112,6 +127,8 @@ func planTemplateCache(
 		service.Name,
 		string(service.UID),
 		cachingMode,
+		encryption,
+		resolveTopologyStorageClass(service, obs.mergedRuntimeConfig.Value),
 	)
 	if err != nil {
 		// Name generation failed - this would be a programming error
@@ -161,6 +178,7 @@ func planTemplateCache(
 			TemplateName:     templateName,
 			TemplateScope:    templateScope,
 			StorageClassName: storageClassName,
+			Encryption:       encryption.DeepCopy(),
 			RuntimeConfigRef: service.Spec.RuntimeConfigRef,
 			Mode:             cacheMode,
 			Env:              cacheEnv,
@@ -177,14 +195,16 @@ func fetchTemplateCache(
 	ctx context.Context,
 	c client.Client,
 	service *aimv1alpha1.AIMService,
+	encryption *aimv1alpha1.AIMStorageEncryptionConfig,
+	topologyStorageClass string,
 ) controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache] {
 	// Try to use previously resolved cache if Ready
-	if result, shouldContinue := tryFetchResolvedTemplateCache(ctx, c, service); !shouldContinue {
+	if result, shouldContinue := tryFetchResolvedTemplateCache(ctx, c, service, encryption, topologyStorageClass); !shouldContinue {
 		return result
 	}
 
 	// Search for best available cache
-	return searchTemplateCaches(ctx, c, service)
+	return searchTemplateCaches(ctx, c, service, encryption, topologyStorageClass)
 }
 
 // tryFetchResolvedTemplateCache attempts to fetch a previously resolved template cache reference.
@@ -193,6 +213,8 @@ func tryFetchResolvedTemplateCache(
 	ctx context.Context,
 	c client.Client,
 	service *aimv1alpha1.AIMService,
+	encryption *aimv1alpha1.AIMStorageEncryptionConfig,
+	topologyStorageClass string,
 ) (result controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache], shouldContinue bool) {
 	if service.Status.Cache == nil || service.Status.Cache.TemplateCacheRef == nil {
 		return result, true
@@ -202,14 +224,14 @@ func tryFetchResolvedTemplateCache(
 	ref := service.Status.Cache.TemplateCacheRef
 	result = controllerutils.Fetch(ctx, c, ref.NamespacedName(), &aimv1alpha1.AIMTemplateCache{})
 
-	if result.OK() && result.Value.Status.Status == constants.AIMStatusReady && isTemplateCacheUsableForService(result.Value, service) {
+	if result.OK() && result.Value.Status.Status == constants.AIMStatusReady && isTemplateCacheUsableForService(result.Value, service, encryption, topologyStorageClass) {
 		logger.V(1).Info("using resolved template cache", "name", ref.Name)
 		return result, false
 	}
 
 	// Not Ready or deleted - log and continue to search
 	if result.OK() {
-		if !isTemplateCacheUsableForService(result.Value, service) {
+		if !isTemplateCacheUsableForService(result.Value, service, encryption, topologyStorageClass) {
 			logger.V(1).Info("resolved template cache incompatible with service mode, searching for alternatives",
 				"name", ref.Name, "mode", result.Value.Spec.Mode, "serviceMode", service.Spec.GetCachingMode())
 		} else {
@@ -230,6 +252,8 @@ func searchTemplateCaches(
 	ctx context.Context,
 	c client.Client,
 	service *aimv1alpha1.AIMService,
+	encryption *aimv1alpha1.AIMStorageEncryptionConfig,
+	topologyStorageClass string,
 ) controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache] {
 	logger := log.FromContext(ctx)
 
@@ -251,6 +275,8 @@ func searchTemplateCaches(
 			service.Name,
 			string(service.UID),
 			cachingMode,
+			encryption,
+			topologyStorageClass,
 		)
 		if err != nil {
 			return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{}
@@ -264,7 +290,7 @@ func searchTemplateCaches(
 			return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Error: cacheResult.Error}
 		}
 
-		if isTemplateCacheUsableForService(cacheResult.Value, service) {
+		if isTemplateCacheUsableForService(cacheResult.Value, service, encryption, topologyStorageClass) {
 			return cacheResult
 		}
 		return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{}
@@ -279,7 +305,8 @@ func searchTemplateCaches(
 	// Filter caches matching our template
 	var matchingCaches []aimv1alpha1.AIMTemplateCache
 	for _, cache := range cacheListResult.Value.Items {
-		if cache.Spec.TemplateName == templateName && cache.Spec.Mode == aimv1alpha1.TemplateCacheModeShared {
+		if cache.Spec.TemplateName == templateName && cache.Spec.Mode == aimv1alpha1.TemplateCacheModeShared &&
+			encryption.UsesSameKey(cache.Spec.Encryption) && matchesTopologyStorageClass(&cache, topologyStorageClass) {
 			matchingCaches = append(matchingCaches, cache)
 		}
 	}
@@ -300,11 +327,21 @@ func searchTemplateCaches(
 	return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: best}
 }
 
-func isTemplateCacheUsableForService(cache *aimv1alpha1.AIMTemplateCache, service *aimv1alpha1.AIMService) bool {
+func isTemplateCacheUsableForService(
+	cache *aimv1alpha1.AIMTemplateCache,
+	service *aimv1alpha1.AIMService,
+	encryption *aimv1alpha1.AIMStorageEncryptionConfig,
+	topologyStorageClass string,
+) bool {
 	if cache == nil || service == nil {
 		return false
 	}
 
+	// Caches encrypted with a different key (or not encrypted as requested) cannot be used
+	if !encryption.UsesSameKey(cache.Spec.Encryption) {
+		return false
+	}
+
 	switch service.Spec.GetCachingMode() {
 	case aimv1alpha1.CachingModeDedicated:
 		if cache.Spec.Mode != aimv1alpha1.TemplateCacheModeDedicated {
@@ -312,7 +349,9 @@ func isTemplateCacheUsableForService(cache *aimv1alpha1.AIMTemplateCache, servic
 		}
 		return hasOwnerReferenceUID(cache.GetOwnerReferences(), service.UID)
 	case aimv1alpha1.CachingModeShared:
-		return cache.Spec.Mode == aimv1alpha1.TemplateCacheModeShared
+		// Shared caches provisioned for another node pool or zone cannot be attached where the service runs
+		return cache.Spec.Mode == aimv1alpha1.TemplateCacheModeShared &&
+			matchesTopologyStorageClass(cache, topologyStorageClass)
 	default:
 		return cache.Spec.Mode == aimv1alpha1.TemplateCacheModeShared
 	}
@@ -334,6 +373,11 @@ func resolveStorageClassName(service *aimv1alpha1.AIMService, obs ServiceObserva
 		return *service.Spec.Storage.DefaultStorageClassName
 	}
 
+	// Then the storage class for the node pool or zone the service is placed on
+	if storageClass := resolveTopologyStorageClass(service, obs.mergedRuntimeConfig.Value); storageClass != "" {
+		return storageClass
+	}
+
 	// Fall back to runtime config
 	if obs.mergedRuntimeConfig.Value != nil && obs.mergedRuntimeConfig.Value.Storage != nil {
 		if obs.mergedRuntimeConfig.Value.Storage.DefaultStorageClassName != nil {
@@ -344,6 +388,52 @@ func resolveStorageClassName(service *aimv1alpha1.AIMService, obs ServiceObserva
 	return ""
 }
 
+// resolveTopologyStorageClass returns the storage class for the node pool or zone the service is
+// placed on, or "" when the service sets its own storage class or no entry matches. Service-level
+// entries replace the runtime config entries. An entry matches when all its node labels are pinned
+// by the service's resolved scheduling.
+func resolveTopologyStorageClass(service *aimv1alpha1.AIMService, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) string {
+	var entries []aimv1alpha1.AIMTopologyStorageClass
+	if service.Spec.Storage != nil {
+		if service.Spec.Storage.DefaultStorageClassName != nil {
+			return ""
+		}
+		entries = service.Spec.Storage.TopologyStorageClasses
+	}
+	if len(entries) == 0 && runtimeConfig != nil && runtimeConfig.Storage != nil {
+		entries = runtimeConfig.Storage.TopologyStorageClasses
+	}
+	if len(entries) == 0 {
+		return ""
+	}
+
+	pinned := utils.PinnedNodeLabels(utils.ResolveScheduling(service.Spec.Scheduling, runtimeConfig))
+	for _, entry := range entries {
+		if len(entry.NodeLabels) > 0 && labels.SelectorFromSet(entry.NodeLabels).Matches(labels.Set(pinned)) {
+			return entry.StorageClassName
+		}
+	}
+	return ""
+}
+
+// matchesTopologyStorageClass reports whether the cache can be used by a service placed on a
+// node pool or zone with the given storage class. Without a topology storage class any cache matches.
+func matchesTopologyStorageClass(cache *aimv1alpha1.AIMTemplateCache, topologyStorageClass string) bool {
+	return topologyStorageClass == "" || cache.Spec.StorageClassName == topologyStorageClass
+}
+
+// resolveStorageEncryption determines the cache encryption for the service.
+func resolveStorageEncryption(
+	service *aimv1alpha1.AIMService,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) *aimv1alpha1.AIMStorageEncryptionConfig {
+	var explicit *aimv1alpha1.AIMStorageEncryptionConfig
+	if service.Spec.Storage != nil {
+		explicit = service.Spec.Storage.Encryption
+	}
+	return utils.ResolveStorageEncryption(explicit, runtimeConfig)
+}
+
 // resolvePVCHeadroomPercent determines the PVC headroom percentage.
 func resolvePVCHeadroomPercent(service *aimv1alpha1.AIMService, obs ServiceObservation) int32 {
 	// Service-level storage config takes precedence
@@ -398,3 +488,77 @@ func quantityWithHeadroom(bytes int64, headroomPercent int32) resource.Quantity
 
 	return resource.MustParse(fmt.Sprintf("%dGi", roundedGi))
 }
+
+// setCacheLookup records, once per template cache, whether the cache was already Ready when
+// the service first found it. The template cache controller counts these lookups.
+func setCacheLookup(
+	status *aimv1alpha1.AIMServiceStatus,
+	previous *aimv1alpha1.AIMServiceCacheLookup,
+	templateCache controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache],
+) {
+	lookup := previous
+	if cache := templateCache.Value; templateCache.OK() && cache != nil &&
+		(previous == nil || previous.TemplateCache != cache.Name) {
+		result := aimv1alpha1.CacheLookupMiss
+		if cache.Status.Status == constants.AIMStatusReady {
+			result = aimv1alpha1.CacheLookupHit
+		}
+		lookup = &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: cache.Name, Result: result}
+	}
+	if lookup == nil {
+		return
+	}
+	if status.Cache == nil {
+		status.Cache = &aimv1alpha1.AIMServiceCacheStatus{}
+	}
+	status.Cache.Lookup = lookup
+}
+
+// =======================================================
+// CACHE READINESS
+// =======================================================
+
+// gatesOnProvisionedCache reports whether the InferenceService may be created once the volumes
+// of the template cache exist, before the artifacts are downloaded. This only applies to
+// dedicated caches that are not encrypted: the pod downloads the missing files itself, which
+// other services sharing the cache or a decrypting init container could not tolerate.
+func gatesOnProvisionedCache(service *aimv1alpha1.AIMService, cache *aimv1alpha1.AIMTemplateCache) bool {
+	return cache != nil && cache.Spec.Encryption == nil &&
+		service.Spec.GetCacheReadinessGate() == aimv1alpha1.CacheReadinessGateProvisioned &&
+		service.Spec.GetCachingMode() == aimv1alpha1.CachingModeDedicated
+}
+
+// isTemplateCacheMountable reports whether the template cache can be mounted into the
+// InferenceService: once it is warm, or once it is provisioned when the service gates on that.
+func isTemplateCacheMountable(service *aimv1alpha1.AIMService, cache *aimv1alpha1.AIMTemplateCache) bool {
+	if aimtemplatecache.IsWarm(cache) {
+		return true
+	}
+	return aimtemplatecache.IsProvisioned(cache) && gatesOnProvisionedCache(service, cache)
+}
+
+// isArtifactMountable reports whether an artifact of a mountable template cache is mounted.
+// Artifacts of a cache that is not warm yet are mounted while they download.
+func isArtifactMountable(artifact aimv1alpha1.AIMResolvedArtifact, cache *aimv1alpha1.AIMTemplateCache) bool {
+	if artifact.PersistentVolumeClaim == "" {
+		return false
+	}
+	return artifact.Status == constants.AIMStatusReady || !aimtemplatecache.IsWarm(cache)
+}
+
+// setCacheReadinessConditions sets CacheProvisioned and CacheWarm from the cache the service
+// uses. Shared cluster model caches are only used once they are ready, so both are True.
+// The conditions are kept unchanged when the template cache could not be fetched.
+func setCacheReadinessConditions(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	if len(obs.sharedModelCaches.Value) > 0 {
+		cm.MarkTrue(aimv1alpha1.AIMTemplateCacheConditionProvisioned, aimv1alpha1.AIMTemplateCacheReasonProvisioned,
+			"Cluster model caches are shared with the namespace")
+		cm.MarkTrue(aimv1alpha1.AIMTemplateCacheConditionWarm, aimv1alpha1.AIMTemplateCacheReasonWarm,
+			"Cluster model caches are shared with the namespace")
+		return
+	}
+	if obs.templateCache.HasError() {
+		return
+	}
+	aimtemplatecache.SetReadinessConditions(cm, obs.templateCache.Value)
+}
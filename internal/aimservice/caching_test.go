@@ -27,8 +27,10 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
 )
 
@@ -72,7 +74,7 @@ func TestGenerateTemplateCacheName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := GenerateTemplateCacheName(tt.templateName, tt.namespace, tt.serviceName, tt.serviceID, tt.mode)
+			result, err := GenerateTemplateCacheName(tt.templateName, tt.namespace, tt.serviceName, tt.serviceID, tt.mode, nil, "")
 			if err != nil {
 				t.Errorf("unexpected error: %v", err)
 				return
@@ -92,12 +94,12 @@ func TestGenerateTemplateCacheName_DedicatedDifferentUIDs(t *testing.T) {
 	namespace := "my-namespace"
 	serviceName := "my-svc"
 
-	nameA, err := GenerateTemplateCacheName(templateName, namespace, serviceName, "service-uid-a", aimv1alpha1.CachingModeDedicated)
+	nameA, err := GenerateTemplateCacheName(templateName, namespace, serviceName, "service-uid-a", aimv1alpha1.CachingModeDedicated, nil, "")
 	if err != nil {
 		t.Fatalf("unexpected error generating nameA: %v", err)
 	}
 
-	nameB, err := GenerateTemplateCacheName(templateName, namespace, serviceName, "service-uid-b", aimv1alpha1.CachingModeDedicated)
+	nameB, err := GenerateTemplateCacheName(templateName, namespace, serviceName, "service-uid-b", aimv1alpha1.CachingModeDedicated, nil, "")
 	if err != nil {
 		t.Fatalf("unexpected error generating nameB: %v", err)
 	}
@@ -107,6 +109,25 @@ func TestGenerateTemplateCacheName_DedicatedDifferentUIDs(t *testing.T) {
 	}
 }
 
+func TestGenerateTemplateCacheName_EncryptedShared(t *testing.T) {
+	encryption := &aimv1alpha1.AIMStorageEncryptionConfig{
+		KMSSecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "model-key"}, Key: "k"},
+	}
+
+	plain, err := GenerateTemplateCacheName("llama-template", "ns", "svc", "uid", aimv1alpha1.CachingModeShared, nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	encrypted, err := GenerateTemplateCacheName("llama-template", "ns", "svc", "uid", aimv1alpha1.CachingModeShared, encryption, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if plain == encrypted {
+		t.Fatalf("expected encrypted shared cache to get its own name, got %q for both", plain)
+	}
+}
+
 // ============================================================================
 // CALCULATE REQUIRED STORAGE SIZE TESTS
 // ============================================================================
@@ -319,6 +340,39 @@ func TestResolveStorageClassName(t *testing.T) {
 			},
 			expectedName: "service-storage",
 		},
+		{
+			name: "topology storage class for pinned zone",
+			service: func() *aimv1alpha1.AIMService {
+				svc := NewService("svc").Build()
+				svc.Spec.Scheduling = &aimv1alpha1.AIMSchedulingConfig{
+					NodeSelector: map[string]string{testZoneLabel: "zone-b"},
+				}
+				return svc
+			}(),
+			obs:          newTopologyStorageObservation(),
+			expectedName: "zone-b-storage",
+		},
+		{
+			name:         "runtime default when placement does not match a topology",
+			service:      NewService("svc").Build(),
+			obs:          newTopologyStorageObservation(),
+			expectedName: "runtime-storage",
+		},
+		{
+			name: "service storage class overrides topology",
+			service: func() *aimv1alpha1.AIMService {
+				svc := NewService("svc").Build()
+				svc.Spec.Scheduling = &aimv1alpha1.AIMSchedulingConfig{
+					NodeSelector: map[string]string{testZoneLabel: "zone-b"},
+				}
+				svc.Spec.Storage = &aimv1alpha1.AIMStorageConfig{
+					DefaultStorageClassName: stringPtr("service-storage"),
+				}
+				return svc
+			}(),
+			obs:          newTopologyStorageObservation(),
+			expectedName: "service-storage",
+		},
 	}
 
 	for _, tt := range tests {
@@ -332,6 +386,61 @@ func TestResolveStorageClassName(t *testing.T) {
 	}
 }
 
+const testZoneLabel = "topology.kubernetes.io/zone"
+
+func newTopologyStorageObservation() ServiceObservation {
+	return ServiceObservation{
+		ServiceFetchResult: ServiceFetchResult{
+			mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
+				Value: &aimv1alpha1.AIMRuntimeConfigCommon{
+					AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+						Storage: &aimv1alpha1.AIMStorageConfig{
+							DefaultStorageClassName: stringPtr("runtime-storage"),
+							TopologyStorageClasses: []aimv1alpha1.AIMTopologyStorageClass{
+								{NodeLabels: map[string]string{testZoneLabel: "zone-a"}, StorageClassName: "zone-a-storage"},
+								{NodeLabels: map[string]string{testZoneLabel: "zone-b"}, StorageClassName: "zone-b-storage"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestTopologyStorageClassSeparatesSharedCaches(t *testing.T) {
+	plain, err := GenerateTemplateCacheName("llama-template", "ns", "svc", "uid", aimv1alpha1.CachingModeShared, nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	zoneA, err := GenerateTemplateCacheName("llama-template", "ns", "svc", "uid", aimv1alpha1.CachingModeShared, nil, "zone-a-storage")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	zoneB, err := GenerateTemplateCacheName("llama-template", "ns", "svc", "uid", aimv1alpha1.CachingModeShared, nil, "zone-b-storage")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plain == zoneA || zoneA == zoneB {
+		t.Errorf("expected distinct cache names per topology storage class, got %q, %q and %q", plain, zoneA, zoneB)
+	}
+
+	service := NewService("svc").Build()
+	cache := &aimv1alpha1.AIMTemplateCache{Spec: aimv1alpha1.AIMTemplateCacheSpec{
+		Mode:             aimv1alpha1.TemplateCacheModeShared,
+		StorageClassName: "zone-a-storage",
+	}}
+	if !isTemplateCacheUsableForService(cache, service, nil, "") {
+		t.Error("expected cache to be usable without a topology storage class")
+	}
+	if !isTemplateCacheUsableForService(cache, service, nil, "zone-a-storage") {
+		t.Error("expected cache to be usable in its own zone")
+	}
+	if isTemplateCacheUsableForService(cache, service, nil, "zone-b-storage") {
+		t.Error("expected cache from another zone not to be usable")
+	}
+}
+
 // ============================================================================
 // RESOLVE PVC HEADROOM PERCENT TESTS
 // ============================================================================
@@ -799,3 +908,168 @@ func stringPtr(s string) *string {
 func int32Ptr(i int32) *int32 {
 	return &i
 }
+
+func TestSetCacheLookup(t *testing.T) {
+	cacheWithStatus := func(name string, status constants.AIMStatus) controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache] {
+		cache := &aimv1alpha1.AIMTemplateCache{}
+		cache.Name = name
+		cache.Status.Status = status
+		return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: cache}
+	}
+
+	tests := []struct {
+		name     string
+		previous *aimv1alpha1.AIMServiceCacheLookup
+		cache    controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]
+		expected *aimv1alpha1.AIMServiceCacheLookup
+	}{
+		{
+			name:     "no cache",
+			cache:    controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{},
+			expected: nil,
+		},
+		{
+			name:     "ready cache is a hit",
+			cache:    cacheWithStatus("cache-a", constants.AIMStatusReady),
+			expected: &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: "cache-a", Result: aimv1alpha1.CacheLookupHit},
+		},
+		{
+			name:     "pending cache is a miss",
+			cache:    cacheWithStatus("cache-a", constants.AIMStatusPending),
+			expected: &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: "cache-a", Result: aimv1alpha1.CacheLookupMiss},
+		},
+		{
+			name:     "miss is kept once the cache becomes ready",
+			previous: &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: "cache-a", Result: aimv1alpha1.CacheLookupMiss},
+			cache:    cacheWithStatus("cache-a", constants.AIMStatusReady),
+			expected: &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: "cache-a", Result: aimv1alpha1.CacheLookupMiss},
+		},
+		{
+			name:     "switching caches records a new lookup",
+			previous: &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: "cache-a", Result: aimv1alpha1.CacheLookupMiss},
+			cache:    cacheWithStatus("cache-b", constants.AIMStatusReady),
+			expected: &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: "cache-b", Result: aimv1alpha1.CacheLookupHit},
+		},
+		{
+			name:     "previous lookup kept when cache is not found",
+			previous: &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: "cache-a", Result: aimv1alpha1.CacheLookupHit},
+			cache:    controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{},
+			expected: &aimv1alpha1.AIMServiceCacheLookup{TemplateCache: "cache-a", Result: aimv1alpha1.CacheLookupHit},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &aimv1alpha1.AIMServiceStatus{}
+			setCacheLookup(status, tt.previous, tt.cache)
+
+			if tt.expected == nil {
+				if status.Cache != nil && status.Cache.Lookup != nil {
+					t.Errorf("expected no lookup, got %+v", *status.Cache.Lookup)
+				}
+				return
+			}
+			if status.Cache == nil || status.Cache.Lookup == nil {
+				t.Fatalf("expected lookup %+v, got none", *tt.expected)
+			}
+			if *status.Cache.Lookup != *tt.expected {
+				t.Errorf("expected %+v, got %+v", *tt.expected, *status.Cache.Lookup)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// CACHE READINESS TESTS
+// ============================================================================
+
+func provisioningTemplateCache() *aimv1alpha1.AIMTemplateCache {
+	return &aimv1alpha1.AIMTemplateCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: testNamespace},
+		Status: aimv1alpha1.AIMTemplateCacheStatus{
+			Status: constants.AIMStatusProgressing,
+			Artifacts: map[string]aimv1alpha1.AIMResolvedArtifact{
+				"artifact-a": {Name: "artifact-a", Model: "model-a", Status: constants.AIMStatusReady, PersistentVolumeClaim: "pvc-a"},
+				"artifact-b": {Name: "artifact-b", Model: "model-b", Status: constants.AIMStatusProgressing, PersistentVolumeClaim: "pvc-b"},
+			},
+		},
+	}
+}
+
+func TestIsTemplateCacheMountable(t *testing.T) {
+	provisionedGate := func(mode aimv1alpha1.AIMCachingMode) *aimv1alpha1.AIMService {
+		return NewService("svc").WithCachingMode(mode).
+			WithCacheReadinessGate(aimv1alpha1.CacheReadinessGateProvisioned).Build()
+	}
+	encrypted := provisioningTemplateCache()
+	encrypted.Spec.Encryption = &aimv1alpha1.AIMStorageEncryptionConfig{}
+	unprovisioned := provisioningTemplateCache()
+	unprovisioned.Status.Artifacts["artifact-b"] = aimv1alpha1.AIMResolvedArtifact{
+		Name: "artifact-b", Model: "model-b", Status: constants.AIMStatusPending,
+	}
+
+	tests := []struct {
+		name     string
+		service  *aimv1alpha1.AIMService
+		cache    *aimv1alpha1.AIMTemplateCache
+		expected bool
+	}{
+		{"no cache", provisionedGate(aimv1alpha1.CachingModeDedicated), nil, false},
+		{"warm gate waits for download", NewService("svc").WithCachingMode(aimv1alpha1.CachingModeDedicated).Build(), provisioningTemplateCache(), false},
+		{"provisioned gate on dedicated cache", provisionedGate(aimv1alpha1.CachingModeDedicated), provisioningTemplateCache(), true},
+		{"provisioned gate ignored for shared cache", provisionedGate(aimv1alpha1.CachingModeShared), provisioningTemplateCache(), false},
+		{"provisioned gate ignored for encrypted cache", provisionedGate(aimv1alpha1.CachingModeDedicated), encrypted, false},
+		{"artifact without claim", provisionedGate(aimv1alpha1.CachingModeDedicated), unprovisioned, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTemplateCacheMountable(tt.service, tt.cache); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetCacheReadinessConditions(t *testing.T) {
+	cache := provisioningTemplateCache()
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		templateCache: controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: cache},
+	}}
+
+	cm := controllerutils.NewConditionManager(nil)
+	setCacheReadinessConditions(cm, obs)
+	if cond := cm.Get(aimv1alpha1.AIMTemplateCacheConditionProvisioned); cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Errorf("expected CacheProvisioned=True, got %+v", cond)
+	}
+	warm := cm.Get(aimv1alpha1.AIMTemplateCacheConditionWarm)
+	if warm == nil || warm.Status != metav1.ConditionFalse || warm.Reason != aimv1alpha1.AIMTemplateCacheReasonWarming {
+		t.Fatalf("expected CacheWarm=False/Warming, got %+v", warm)
+	}
+	if !strings.Contains(warm.Message, "1 of 2") {
+		t.Errorf("expected download progress in message, got %q", warm.Message)
+	}
+
+	cache.Status.Status = constants.AIMStatusReady
+	setCacheReadinessConditions(cm, obs)
+	if cond := cm.Get(aimv1alpha1.AIMTemplateCacheConditionWarm); cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Errorf("expected CacheWarm=True, got %+v", cond)
+	}
+
+	obs.templateCache = controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{}
+	setCacheReadinessConditions(cm, obs)
+	if cm.Get(aimv1alpha1.AIMTemplateCacheConditionProvisioned) != nil || cm.Get(aimv1alpha1.AIMTemplateCacheConditionWarm) != nil {
+		t.Error("expected both conditions removed without a template cache")
+	}
+}
+
+func TestGetCacheHealth_ProvisionedGate(t *testing.T) {
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service: NewService("svc").WithCachingMode(aimv1alpha1.CachingModeDedicated).
+			WithCacheReadinessGate(aimv1alpha1.CacheReadinessGateProvisioned).Build(),
+		templateCache: controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: provisioningTemplateCache()},
+	}}
+	health := obs.getCacheHealth()
+	if health.State != constants.AIMStatusReady {
+		t.Errorf("expected cache component Ready once provisioned, got %s", health.State)
+	}
+}
@@ -0,0 +1,166 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const (
+	// DefaultKServeNamespace is the namespace KServe is installed into by default.
+	DefaultKServeNamespace = "kserve"
+
+	// kserveConfigMapName is the KServe ConfigMap holding the default deployment mode.
+	kserveConfigMapName = "inferenceservice-config"
+
+	// kserveDeployConfigKey is the ConfigMap key of the deployment settings.
+	kserveDeployConfigKey = "deploy"
+
+	// knativeServingGroupVersion is the API that is only served when Knative Serving is installed.
+	knativeServingGroupVersion = "serving.knative.dev/v1"
+
+	// deploymentModeRecheckInterval bounds how long a detected deployment mode is reused,
+	// so that installing or removing Knative is picked up without a restart.
+	deploymentModeRecheckInterval = 5 * time.Minute
+)
+
+// deploymentModeCache remembers the cluster default deployment mode between reconciles.
+type deploymentModeCache struct {
+	mu        sync.Mutex
+	mode      aimv1alpha1.AIMKServeDeploymentMode
+	checkedAt time.Time
+}
+
+// get returns the cached mode, detecting it again once the recheck interval has passed.
+func (c *deploymentModeCache) get(
+	now time.Time,
+	detect func() aimv1alpha1.AIMKServeDeploymentMode,
+) aimv1alpha1.AIMKServeDeploymentMode {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.checkedAt.IsZero() || now.Sub(c.checkedAt) >= deploymentModeRecheckInterval {
+		c.mode = detect()
+		c.checkedAt = now
+	}
+	return c.mode
+}
+
+// clusterDeploymentMode returns the deployment mode KServe uses for InferenceServices that do
+// not pin one, or an empty mode if it cannot be determined.
+func (r *ServiceReconciler) clusterDeploymentMode(ctx context.Context) aimv1alpha1.AIMKServeDeploymentMode {
+	if r.Clientset == nil {
+		return ""
+	}
+	namespace := r.KServeNamespace
+	if namespace == "" {
+		namespace = DefaultKServeNamespace
+	}
+	return r.deploymentMode.get(time.Now(), func() aimv1alpha1.AIMKServeDeploymentMode {
+		return detectDeploymentMode(ctx, r.Clientset, namespace)
+	})
+}
+
+// detectDeploymentMode determines the default KServe deployment mode of the cluster.
+// Without Knative Serving only RawDeployment can work, whatever KServe is configured with.
+// Otherwise the defaultDeploymentMode of the KServe ConfigMap decides, which KServe
+// treats as Serverless when unset.
+func detectDeploymentMode(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	namespace string,
+) aimv1alpha1.AIMKServeDeploymentMode {
+	logger := log.FromContext(ctx)
+
+	if _, err := clientset.Discovery().ServerResourcesForGroupVersion(knativeServingGroupVersion); err != nil {
+		if apierrors.IsNotFound(err) {
+			return aimv1alpha1.KServeDeploymentModeRawDeployment
+		}
+		logger.V(1).Info("Unable to discover Knative Serving, KServe deployment mode unknown", "error", err.Error())
+		return ""
+	}
+
+	cm, err := clientset.CoreV1().ConfigMaps(namespace).Get(ctx, kserveConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		logger.V(1).Info("Unable to read the KServe ConfigMap, KServe deployment mode unknown",
+			"namespace", namespace, "error", err.Error())
+		return ""
+	}
+	var deploy struct {
+		DefaultDeploymentMode string `json:"defaultDeploymentMode"`
+	}
+	if raw := cm.Data[kserveDeployConfigKey]; raw != "" {
+		if err := json.Unmarshal([]byte(raw), &deploy); err != nil {
+			logger.V(1).Info("Invalid deploy settings in the KServe ConfigMap, KServe deployment mode unknown",
+				"namespace", namespace, "error", err.Error())
+			return ""
+		}
+	}
+	if deploy.DefaultDeploymentMode == "" {
+		return aimv1alpha1.KServeDeploymentModeServerless
+	}
+	return normalizeDeploymentMode(deploy.DefaultDeploymentMode)
+}
+
+// normalizeDeploymentMode maps the legacy and current KServe names of a deployment mode to the
+// AIM mode. Modes the operator does not plan for, such as ModelMesh, map to an empty mode.
+func normalizeDeploymentMode(mode string) aimv1alpha1.AIMKServeDeploymentMode {
+	switch mode {
+	case "Serverless", "Knative":
+		return aimv1alpha1.KServeDeploymentModeServerless
+	case "RawDeployment", "Standard":
+		return aimv1alpha1.KServeDeploymentModeRawDeployment
+	default:
+		return ""
+	}
+}
+
+// resolveDeploymentMode returns the deployment mode of the service's InferenceService.
+// KServe does not switch the mode of an existing InferenceService, so the mode it reports
+// or the mode pinned by its annotation wins over the cluster default.
+func (r *ServiceReconciler) resolveDeploymentMode(
+	ctx context.Context,
+	isvc controllerutils.FetchResult[*servingv1beta1.InferenceService],
+) aimv1alpha1.AIMKServeDeploymentMode {
+	if isvc.OK() && isvc.Value != nil {
+		if mode := normalizeDeploymentMode(isvc.Value.Status.DeploymentMode); mode != "" {
+			return mode
+		}
+		if mode := normalizeDeploymentMode(isvc.Value.Annotations[constants.AnnotationKServeDeploymentMode]); mode != "" {
+			return mode
+		}
+	}
+	return r.clusterDeploymentMode(ctx)
+}
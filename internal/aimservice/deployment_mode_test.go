@@ -0,0 +1,255 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func kserveConfigMap(deploy string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: kserveConfigMapName, Namespace: DefaultKServeNamespace},
+		Data:       map[string]string{kserveDeployConfigKey: deploy},
+	}
+}
+
+func clientsetWithKnative(knative bool, objects ...*corev1.ConfigMap) *kubefake.Clientset {
+	clientset := kubefake.NewClientset()
+	for _, obj := range objects {
+		_ = clientset.Tracker().Add(obj)
+	}
+	if knative {
+		clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+			{GroupVersion: knativeServingGroupVersion},
+		}
+	}
+	return clientset
+}
+
+func TestDetectDeploymentMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		knative   bool
+		configMap *corev1.ConfigMap
+		want      aimv1alpha1.AIMKServeDeploymentMode
+	}{
+		{
+			name:      "no knative forces raw deployment",
+			configMap: kserveConfigMap(`{"defaultDeploymentMode":"Serverless"}`),
+			want:      aimv1alpha1.KServeDeploymentModeRawDeployment,
+		},
+		{
+			name:      "configured raw deployment",
+			knative:   true,
+			configMap: kserveConfigMap(`{"defaultDeploymentMode":"RawDeployment"}`),
+			want:      aimv1alpha1.KServeDeploymentModeRawDeployment,
+		},
+		{
+			name:      "current name of raw deployment",
+			knative:   true,
+			configMap: kserveConfigMap(`{"defaultDeploymentMode":"Standard"}`),
+			want:      aimv1alpha1.KServeDeploymentModeRawDeployment,
+		},
+		{
+			name:      "unset mode defaults to serverless",
+			knative:   true,
+			configMap: kserveConfigMap(`{}`),
+			want:      aimv1alpha1.KServeDeploymentModeServerless,
+		},
+		{
+			name:      "unsupported mode is unknown",
+			knative:   true,
+			configMap: kserveConfigMap(`{"defaultDeploymentMode":"ModelMesh"}`),
+			want:      "",
+		},
+		{
+			name:      "invalid deploy settings are unknown",
+			knative:   true,
+			configMap: kserveConfigMap(`not json`),
+			want:      "",
+		},
+		{
+			name:    "missing config map is unknown",
+			knative: true,
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objects []*corev1.ConfigMap
+			if tt.configMap != nil {
+				objects = append(objects, tt.configMap)
+			}
+			clientset := clientsetWithKnative(tt.knative, objects...)
+			if got := detectDeploymentMode(testContext(), clientset, DefaultKServeNamespace); got != tt.want {
+				t.Errorf("detectDeploymentMode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeploymentModeCache(t *testing.T) {
+	var cache deploymentModeCache
+	calls := 0
+	detect := func() aimv1alpha1.AIMKServeDeploymentMode {
+		calls++
+		return aimv1alpha1.KServeDeploymentModeRawDeployment
+	}
+
+	now := time.Now()
+	cache.get(now, detect)
+	cache.get(now.Add(time.Minute), detect)
+	if calls != 1 {
+		t.Fatalf("expected the mode to be detected once within the interval, got %d", calls)
+	}
+	cache.get(now.Add(deploymentModeRecheckInterval), detect)
+	if calls != 2 {
+		t.Errorf("expected the mode to be detected again after the interval, got %d", calls)
+	}
+}
+
+func TestResolveDeploymentMode(t *testing.T) {
+	r := &ServiceReconciler{Clientset: clientsetWithKnative(false)}
+
+	reported := &servingv1beta1.InferenceService{}
+	reported.Status.DeploymentMode = "Serverless"
+	annotated := &servingv1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{constants.AnnotationKServeDeploymentMode: "Knative"},
+	}}
+
+	tests := []struct {
+		name string
+		isvc *servingv1beta1.InferenceService
+		want aimv1alpha1.AIMKServeDeploymentMode
+	}{
+		{name: "reported mode wins", isvc: reported, want: aimv1alpha1.KServeDeploymentModeServerless},
+		{name: "annotation is used before the cluster default", isvc: annotated, want: aimv1alpha1.KServeDeploymentModeServerless},
+		{name: "new service uses the cluster default", want: aimv1alpha1.KServeDeploymentModeRawDeployment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch := controllerutils.FetchResult[*servingv1beta1.InferenceService]{Value: tt.isvc}
+			if got := r.resolveDeploymentMode(testContext(), fetch); got != tt.want {
+				t.Errorf("resolveDeploymentMode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildInferenceService_DeploymentMode(t *testing.T) {
+	service := NewService("svc").WithModelImage("test-image:v1").Build()
+	service.Spec.MinReplicas = ptr.To(int32(1))
+	service.Spec.MaxReplicas = ptr.To(int32(3))
+	service.Spec.AutoScaling = &aimv1alpha1.AIMServiceAutoScaling{}
+	service.Spec.UpdateStrategy = &aimv1alpha1.AIMServiceUpdateStrategy{Type: aimv1alpha1.AIMServiceUpdateStrategyRecreate}
+	templateSpec := &aimv1alpha1.AIMServiceTemplateSpecCommon{ModelName: testModelName}
+	templateStatus := &aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady}
+
+	build := func(mode aimv1alpha1.AIMKServeDeploymentMode) *servingv1beta1.InferenceService {
+		obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{service: service, deploymentMode: mode}}
+		return buildInferenceService(service, "test-template", templateSpec, templateStatus, obs)
+	}
+
+	raw := build(aimv1alpha1.KServeDeploymentModeRawDeployment)
+	if got := raw.Annotations[constants.AnnotationKServeDeploymentMode]; got != "Standard" {
+		t.Errorf("expected Standard mode annotation, got %q", got)
+	}
+	if got := raw.Annotations[constants.AnnotationKServeAutoscalerClass]; got != constants.AutoscalerClassKeda {
+		t.Errorf("expected KEDA autoscaler class in RawDeployment mode, got %q", got)
+	}
+	if raw.Spec.Predictor.AutoScaling == nil || raw.Spec.Predictor.DeploymentStrategy == nil {
+		t.Error("expected KEDA autoscaling and a deployment strategy in RawDeployment mode")
+	}
+
+	serverless := build(aimv1alpha1.KServeDeploymentModeServerless)
+	if got := serverless.Annotations[constants.AnnotationKServeDeploymentMode]; got != "Knative" {
+		t.Errorf("expected Knative mode annotation, got %q", got)
+	}
+	for _, key := range []string{constants.AnnotationKServeAutoscalerClass, constants.AnnotationOTelSidecarInject} {
+		if _, ok := serverless.Annotations[key]; ok {
+			t.Errorf("expected no %s annotation in Serverless mode", key)
+		}
+	}
+	if serverless.Spec.Predictor.AutoScaling != nil || serverless.Spec.Predictor.DeploymentStrategy != nil {
+		t.Error("expected no KEDA autoscaling or deployment strategy in Serverless mode")
+	}
+	if serverless.Spec.Predictor.MaxReplicas != 3 {
+		t.Errorf("expected max replicas 3 in Serverless mode, got %d", serverless.Spec.Predictor.MaxReplicas)
+	}
+
+	unknown := build("")
+	if _, ok := unknown.Annotations[constants.AnnotationKServeDeploymentMode]; ok {
+		t.Error("expected no mode annotation when the mode is unknown")
+	}
+}
+
+func TestBuildInferenceService_DeploymentModeOfExistingService(t *testing.T) {
+	service := NewService("svc").WithModelImage("test-image:v1").Build()
+	templateSpec := &aimv1alpha1.AIMServiceTemplateSpecCommon{ModelName: testModelName}
+	templateStatus := &aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady}
+
+	tests := []struct {
+		name       string
+		annotation string
+		reported   string
+		want       string
+	}{
+		{name: "reported mode is kept as is", annotation: "RawDeployment", reported: "Standard", want: "Standard"},
+		{name: "reported mode without annotation", reported: "Standard", want: "Standard"},
+		{name: "annotation kept until a mode is reported", annotation: "RawDeployment", want: "RawDeployment"},
+		{name: "unpinned service stays unpinned", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := &servingv1beta1.InferenceService{}
+			if tt.annotation != "" {
+				existing.Annotations = map[string]string{constants.AnnotationKServeDeploymentMode: tt.annotation}
+			}
+			existing.Status.DeploymentMode = tt.reported
+			obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+				service:          service,
+				deploymentMode:   aimv1alpha1.KServeDeploymentModeRawDeployment,
+				inferenceService: controllerutils.FetchResult[*servingv1beta1.InferenceService]{Value: existing},
+			}}
+
+			isvc := buildInferenceService(service, "test-template", templateSpec, templateStatus, obs)
+			if got := isvc.Annotations[constants.AnnotationKServeDeploymentMode]; got != tt.want {
+				t.Errorf("expected mode annotation %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
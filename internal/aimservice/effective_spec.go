@@ -0,0 +1,118 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// buildEffectiveRuntimeSpec plans the InferenceService the same way PlanResources does and records
+// its inference container. The previously recorded spec is kept while no InferenceService can be
+// planned, so the last deployed spec stays available while the template or cache is not ready.
+func buildEffectiveRuntimeSpec(
+	obs ServiceObservation,
+	previous *aimv1alpha1.AIMServiceEffectiveRuntimeSpec,
+	now time.Time,
+) *aimv1alpha1.AIMServiceEffectiveRuntimeSpec {
+	service := obs.service
+	templateName, _, templateSpec, templateStatus := obs.getResolvedTemplate()
+	if templateName == "" || templateStatus == nil || templateStatus.Status != constants.AIMStatusReady ||
+		!isReadyForInferenceService(service, obs) {
+		return previous
+	}
+
+	isvcTemplateSpec, isvcTemplateStatus := applyOverridesInPlace(service, templateSpec, templateStatus)
+	isvc := buildInferenceService(service, templateName, isvcTemplateSpec, isvcTemplateStatus, obs)
+	if len(isvc.Spec.Predictor.Containers) == 0 {
+		return previous
+	}
+	container := isvc.Spec.Predictor.Containers[0]
+
+	env := slices.Clone(container.Env)
+	slices.SortFunc(env, func(a, b corev1.EnvVar) int { return strings.Compare(a.Name, b.Name) })
+
+	spec := &aimv1alpha1.AIMServiceEffectiveRuntimeSpec{
+		Template:  templateName,
+		Image:     container.Image,
+		Env:       env,
+		Args:      container.Args,
+		Resources: container.Resources,
+	}
+	if isvcTemplateSpec != nil {
+		spec.ProfileID = isvcTemplateSpec.ProfileId
+	}
+	spec.Hash = effectiveRuntimeSpecHash(spec)
+
+	if obs.inferenceServicePods != nil && obs.inferenceServicePods.OK() {
+		spec.ImageDigest = runningImageDigest(obs.inferenceServicePods.Value.Items, spec.Image)
+	}
+	if previous != nil && previous.Hash == spec.Hash {
+		spec.LastChangedTime = previous.LastChangedTime
+		if spec.ImageDigest == "" {
+			spec.ImageDigest = previous.ImageDigest
+		}
+	} else {
+		changed := metav1.NewTime(now)
+		spec.LastChangedTime = &changed
+	}
+	return spec
+}
+
+// effectiveRuntimeSpecHash hashes the planned fields of the spec. The image digest and
+// timestamps are excluded, since they are observed rather than planned.
+func effectiveRuntimeSpecHash(spec *aimv1alpha1.AIMServiceEffectiveRuntimeSpec) string {
+	data, _ := json.Marshal([]any{spec.Template, spec.ProfileID, spec.Image, spec.Env, spec.Args, spec.Resources})
+	hash := sha256.Sum256(data)
+	return fmt.Sprintf("%x", hash[:8])
+}
+
+// runningImageDigest returns the digest a started inference container resolved the image to,
+// or an empty string if no container running the image has started yet.
+func runningImageDigest(pods []corev1.Pod, image string) string {
+	for _, pod := range pods {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Name != constants.ContainerKServe || cs.Image == "" || cs.ImageID == "" {
+				continue
+			}
+			// The runtime may report the image normalized (e.g. with a docker.io/ prefix)
+			if cs.Image != image && !strings.HasSuffix(cs.Image, "/"+image) {
+				continue
+			}
+			if _, digest, ok := strings.Cut(cs.ImageID, "@"); ok {
+				return digest
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func effectiveSpecObservation(service *aimv1alpha1.AIMService, template *aimv1alpha1.AIMServiceTemplate) ServiceObservation {
+	return ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service:  service,
+		template: controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: template},
+		// An existing InferenceService puts planning on the update path
+		inferenceService: controllerutils.FetchResult[*servingv1beta1.InferenceService]{
+			Value: &servingv1beta1.InferenceService{},
+		},
+	}}
+}
+
+func TestBuildEffectiveRuntimeSpec(t *testing.T) {
+	service := NewService("svc").WithTemplateName("tmpl").Build()
+	service.Spec.Env = []corev1.EnvVar{{Name: "ZZZ_LAST", Value: "1"}, {Name: "AAA_FIRST", Value: "2"}}
+	template := NewTemplate("tmpl").Build()
+	template.Spec.ProfileId = "profile-123"
+	obs := effectiveSpecObservation(service, template)
+
+	first := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	spec := buildEffectiveRuntimeSpec(obs, nil, first)
+	if spec == nil {
+		t.Fatal("expected an effective runtime spec")
+	}
+	if spec.Template != "tmpl" || spec.ProfileID != "profile-123" || spec.Hash == "" {
+		t.Errorf("unexpected spec %+v", spec)
+	}
+	for i := 1; i < len(spec.Env); i++ {
+		if spec.Env[i-1].Name > spec.Env[i].Name {
+			t.Fatalf("expected env sorted by name, got %s before %s", spec.Env[i-1].Name, spec.Env[i].Name)
+		}
+	}
+	if spec.LastChangedTime == nil || !spec.LastChangedTime.Time.Equal(first) {
+		t.Errorf("expected LastChangedTime %v, got %v", first, spec.LastChangedTime)
+	}
+
+	// Replanning the same spec keeps the hash and change time
+	again := buildEffectiveRuntimeSpec(obs, spec, first.Add(time.Hour))
+	if again.Hash != spec.Hash || !again.LastChangedTime.Time.Equal(first) {
+		t.Errorf("expected an unchanged spec, got hash %s changed %v", again.Hash, again.LastChangedTime)
+	}
+
+	// Changing the env changes the hash
+	service.Spec.Env = append(service.Spec.Env, corev1.EnvVar{Name: "NEW", Value: "x"})
+	changed := buildEffectiveRuntimeSpec(effectiveSpecObservation(service, template), spec, first.Add(time.Hour))
+	if changed.Hash == spec.Hash || changed.LastChangedTime.Time.Equal(first) {
+		t.Error("expected a new hash and change time after an env change")
+	}
+}
+
+func TestBuildEffectiveRuntimeSpec_KeepsPreviousWhenNotPlanned(t *testing.T) {
+	service := NewService("svc").WithTemplateName("tmpl").Build()
+	template := NewTemplate("tmpl").WithStatus(constants.AIMStatusProgressing).Build()
+	previous := &aimv1alpha1.AIMServiceEffectiveRuntimeSpec{Template: "tmpl", Hash: "abc"}
+
+	if got := buildEffectiveRuntimeSpec(effectiveSpecObservation(service, template), previous, time.Now()); got != previous {
+		t.Errorf("expected the previous spec to be kept, got %+v", got)
+	}
+}
+
+func TestRunningImageDigest(t *testing.T) {
+	pods := []corev1.Pod{
+		{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:    "sidecar",
+			Image:   "ghcr.io/amd/aim:1.0",
+			ImageID: "ghcr.io/amd/aim@sha256:sidecar",
+		}}}},
+		{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:    constants.ContainerKServe,
+			Image:   "ghcr.io/amd/aim:0.9",
+			ImageID: "ghcr.io/amd/aim@sha256:old",
+		}}}},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "current"},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    constants.ContainerKServe,
+				Image:   "docker.io/amd/aim:1.0",
+				ImageID: "docker.io/amd/aim@sha256:current",
+			}}},
+		},
+	}
+
+	if got := runningImageDigest(pods, "amd/aim:1.0"); got != "sha256:current" {
+		t.Errorf("expected sha256:current, got %q", got)
+	}
+	if got := runningImageDigest(pods, "amd/aim:2.0"); got != "" {
+		t.Errorf("expected no digest for an image that is not running, got %q", got)
+	}
+}
@@ -0,0 +1,164 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// fetchEndpoint fetches the AIMEndpoint of the service, which has the name of the service.
+func fetchEndpoint(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+) controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint] {
+	return controllerutils.Fetch(ctx, c, client.ObjectKey{
+		Namespace: service.Namespace,
+		Name:      service.Name,
+	}, &aimv1alpha1.AIMEndpoint{})
+}
+
+// planEndpoint creates the AIMEndpoint once the InferenceService is first ready and keeps its
+// status up to date afterwards, so consumers see the endpoint become unavailable rather than
+// disappear. An endpoint that is not controlled by the service is left alone.
+func planEndpoint(planResult *controllerutils.PlanResult, obs ServiceObservation) {
+	if obs.endpoint.HasError() && !obs.endpoint.IsNotFound() {
+		return
+	}
+	existing := obs.endpoint.Value
+	ready := obs.isInferenceServiceReady()
+	if existing == nil && !ready {
+		return
+	}
+	if existing != nil && !metav1.IsControlledBy(existing, obs.service) {
+		return
+	}
+
+	endpoint := buildEndpoint(obs, ready)
+	planResult.Apply(endpoint)
+	if existing == nil || existing.Status != endpoint.Status {
+		// The status subresource is not written by the apply, and the applied object is
+		// overwritten with the server response, so the patch gets its own copy
+		planResult.PatchStatus(endpoint.DeepCopy(), client.Merge)
+	}
+}
+
+// buildEndpoint constructs the AIMEndpoint of the service from the InferenceService, the
+// HTTPRoute and the resolved model.
+func buildEndpoint(obs ServiceObservation, ready bool) *aimv1alpha1.AIMEndpoint {
+	service := obs.service
+	serviceLabelValue, _ := utils.SanitizeLabelValue(service.Name)
+
+	status := aimv1alpha1.AIMEndpointStatus{
+		Status:   constants.AIMStatusNotAvailable,
+		Protocol: aimv1alpha1.AIMEndpointProtocolOpenAI,
+		ModelID:  endpointModelID(obs),
+		AuthMode: aimv1alpha1.AIMEndpointAuthModeNone,
+	}
+	if ready {
+		status.Status = constants.AIMStatusReady
+	}
+	if isvc := obs.inferenceService.Value; isvc != nil {
+		if isvc.Status.URL != nil {
+			status.URL = isvc.Status.URL.String()
+		}
+		if isvc.Status.Address != nil && isvc.Status.Address.URL != nil {
+			status.InternalURL = isvc.Status.Address.URL.String()
+		}
+		// Without Knative, KServe may not report URLs until an ingress is configured,
+		// while the predictor Service is always reachable inside the cluster
+		if obs.deploymentMode == aimv1alpha1.KServeDeploymentModeRawDeployment {
+			if status.InternalURL == "" {
+				status.InternalURL = fmt.Sprintf("http://%s%s.%s.svc.cluster.local",
+					isvc.Name, constants.PredictorServiceSuffix, isvc.Namespace)
+			}
+			if status.URL == "" {
+				status.URL = status.InternalURL
+			}
+		}
+	}
+	if route := obs.httpRoute.Value; route != nil {
+		status.RoutePath = routePath(route)
+		status.AuthMode = aimv1alpha1.AIMEndpointAuthModeGateway
+	}
+
+	return &aimv1alpha1.AIMEndpoint{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: aimv1alpha1.GroupVersion.String(),
+			Kind:       "AIMEndpoint",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service.Name,
+			Namespace: service.Namespace,
+			Labels: map[string]string{
+				constants.LabelK8sComponent: constants.ComponentInference,
+				constants.LabelK8sManagedBy: constants.LabelValueManagedBy,
+				constants.LabelService:      serviceLabelValue,
+			},
+		},
+		Spec: aimv1alpha1.AIMEndpointSpec{
+			ServiceName: service.Name,
+		},
+		Status: status,
+	}
+}
+
+// endpointModelID returns the identifier clients pass as model: the source model ID of a custom
+// model, the canonical name from the image labels, or the template's model name.
+func endpointModelID(obs ServiceObservation) string {
+	_, _, spec, _ := obs.getResolvedTemplate()
+	if spec != nil && len(spec.ModelSources) > 0 && spec.ModelSources[0].ModelID != "" {
+		return spec.ModelSources[0].ModelID
+	}
+	if _, status, _ := obs.getResolvedModel(); status != nil &&
+		status.ImageMetadata != nil && status.ImageMetadata.Model != nil &&
+		status.ImageMetadata.Model.CanonicalName != "" {
+		return status.ImageMetadata.Model.CanonicalName
+	}
+	if spec != nil {
+		return spec.ModelName
+	}
+	return ""
+}
+
+// routePath returns the path prefix the HTTPRoute matches, empty if it has none.
+func routePath(route *gatewayapiv1.HTTPRoute) string {
+	for _, rule := range route.Spec.Rules {
+		for _, match := range rule.Matches {
+			if match.Path != nil && match.Path.Value != nil {
+				return *match.Path.Value
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,197 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func endpointISVC(ready bool) *servingv1beta1.InferenceService {
+	isvc := &servingv1beta1.InferenceService{}
+	isvc.Status.URL = apis.HTTP("svc.test-ns.example.com")
+	isvc.Status.Address = &duckv1.Addressable{URL: apis.HTTP("svc-predictor.test-ns.svc.cluster.local")}
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	isvc.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: status}}
+	return isvc
+}
+
+func endpointObservation(
+	service *aimv1alpha1.AIMService,
+	isvc *servingv1beta1.InferenceService,
+	endpoint controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint],
+) ServiceObservation {
+	return ServiceObservation{
+		ServiceFetchResult: ServiceFetchResult{
+			service:          service,
+			inferenceService: controllerutils.FetchResult[*servingv1beta1.InferenceService]{Value: isvc},
+			endpoint:         endpoint,
+		},
+	}
+}
+
+func notFoundEndpoint() controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint] {
+	return controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint]{
+		Error: apierrors.NewNotFound(schema.GroupResource{Group: "aim.eai.amd.com", Resource: "aimendpoints"}, "svc"),
+	}
+}
+
+func TestPlanEndpoint(t *testing.T) {
+	service := NewService("svc").Build()
+	controlled := buildEndpoint(endpointObservation(service, endpointISVC(true), notFoundEndpoint()), true)
+	controlled.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: aimv1alpha1.GroupVersion.String(), Kind: "AIMService", Name: service.Name,
+		UID: service.UID, Controller: ptr.To(true),
+	}}
+	foreign := controlled.DeepCopy()
+	foreign.OwnerReferences = nil
+
+	tests := []struct {
+		name       string
+		isvc       *servingv1beta1.InferenceService
+		endpoint   controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint]
+		wantApply  bool
+		wantPatch  bool
+		wantStatus constants.AIMStatus
+	}{
+		{name: "not created before the service is ready", isvc: endpointISVC(false), endpoint: notFoundEndpoint()},
+		{name: "created once ready", isvc: endpointISVC(true), endpoint: notFoundEndpoint(),
+			wantApply: true, wantPatch: true, wantStatus: constants.AIMStatusReady},
+		{name: "unchanged status is not patched", isvc: endpointISVC(true),
+			endpoint:  controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint]{Value: controlled},
+			wantApply: true, wantStatus: constants.AIMStatusReady},
+		{name: "kept while the service is not ready", isvc: endpointISVC(false),
+			endpoint:  controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint]{Value: controlled},
+			wantApply: true, wantPatch: true, wantStatus: constants.AIMStatusNotAvailable},
+		{name: "foreign endpoint is left alone", isvc: endpointISVC(true),
+			endpoint: controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint]{Value: foreign}},
+		{name: "skipped on fetch errors", isvc: endpointISVC(true),
+			endpoint: controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint]{Error: apierrors.NewServiceUnavailable("down")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := controllerutils.PlanResult{}
+			planEndpoint(&plan, endpointObservation(service, tt.isvc, tt.endpoint))
+
+			if got := len(plan.GetToApply()) == 1; got != tt.wantApply {
+				t.Fatalf("expected apply %v, got %d objects", tt.wantApply, len(plan.GetToApply()))
+			}
+			patches := plan.GetToPatchStatus()
+			if got := len(patches) == 1; got != tt.wantPatch {
+				t.Fatalf("expected status patch %v, got %d patches", tt.wantPatch, len(patches))
+			}
+			if tt.wantPatch {
+				if status := patches[0].(*aimv1alpha1.AIMEndpoint).Status.Status; status != tt.wantStatus {
+					t.Errorf("expected status %s, got %s", tt.wantStatus, status)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildEndpoint(t *testing.T) {
+	service := NewService("svc").Build()
+	obs := endpointObservation(service, endpointISVC(true), notFoundEndpoint())
+	obs.modelResult.Model = controllerutils.FetchResult[*aimv1alpha1.AIMModel]{Value: &aimv1alpha1.AIMModel{
+		Status: aimv1alpha1.AIMModelStatus{ImageMetadata: &aimv1alpha1.ImageMetadata{
+			Model: &aimv1alpha1.ModelMetadata{CanonicalName: "meta-llama/Llama-3.1-8B-Instruct"},
+		}},
+	}}
+
+	endpoint := buildEndpoint(obs, true)
+	if endpoint.Name != "svc" || endpoint.Spec.ServiceName != "svc" {
+		t.Errorf("expected the endpoint to be named after the service, got %s for %s", endpoint.Name, endpoint.Spec.ServiceName)
+	}
+	want := aimv1alpha1.AIMEndpointStatus{
+		Status:      constants.AIMStatusReady,
+		URL:         "http://svc.test-ns.example.com",
+		InternalURL: "http://svc-predictor.test-ns.svc.cluster.local",
+		Protocol:    aimv1alpha1.AIMEndpointProtocolOpenAI,
+		ModelID:     "meta-llama/Llama-3.1-8B-Instruct",
+		AuthMode:    aimv1alpha1.AIMEndpointAuthModeNone,
+	}
+	if endpoint.Status != want {
+		t.Errorf("expected status %+v, got %+v", want, endpoint.Status)
+	}
+
+	// Routed services are reached through the gateway
+	obs.httpRoute = controllerutils.FetchResult[*gatewayapiv1.HTTPRoute]{Value: &gatewayapiv1.HTTPRoute{
+		Spec: gatewayapiv1.HTTPRouteSpec{Rules: []gatewayapiv1.HTTPRouteRule{{
+			Matches: []gatewayapiv1.HTTPRouteMatch{{Path: &gatewayapiv1.HTTPPathMatch{Value: ptr.To("/test-ns/svc")}}},
+		}}},
+	}}
+	endpoint = buildEndpoint(obs, true)
+	if endpoint.Status.RoutePath != "/test-ns/svc" || endpoint.Status.AuthMode != aimv1alpha1.AIMEndpointAuthModeGateway {
+		t.Errorf("expected gateway route /test-ns/svc, got %q with auth %s", endpoint.Status.RoutePath, endpoint.Status.AuthMode)
+	}
+}
+
+func TestBuildEndpoint_RawDeploymentURLs(t *testing.T) {
+	isvc := &servingv1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "test-ns"}}
+	obs := endpointObservation(NewService("svc").Build(), isvc, notFoundEndpoint())
+
+	// Serverless URLs are only taken from the InferenceService status
+	if endpoint := buildEndpoint(obs, false); endpoint.Status.URL != "" || endpoint.Status.InternalURL != "" {
+		t.Errorf("expected no URLs without a reported address, got %q and %q", endpoint.Status.URL, endpoint.Status.InternalURL)
+	}
+
+	obs.deploymentMode = aimv1alpha1.KServeDeploymentModeRawDeployment
+	endpoint := buildEndpoint(obs, false)
+	want := "http://svc-predictor.test-ns.svc.cluster.local"
+	if endpoint.Status.InternalURL != want || endpoint.Status.URL != want {
+		t.Errorf("expected both URLs to fall back to %s, got %q and %q", want, endpoint.Status.URL, endpoint.Status.InternalURL)
+	}
+}
+
+func TestEndpointModelID_CustomModel(t *testing.T) {
+	template := &aimv1alpha1.AIMServiceTemplate{}
+	template.Spec.ModelName = "custom-model"
+	template.Spec.ModelSources = []aimv1alpha1.AIMModelSource{{ModelID: "org/custom"}}
+	obs := endpointObservation(NewService("svc").Build(), nil, notFoundEndpoint())
+	obs.template = controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: template}
+
+	if got := endpointModelID(obs); got != "org/custom" {
+		t.Errorf("expected source model ID, got %q", got)
+	}
+	template.Spec.ModelSources = nil
+	if got := endpointModelID(obs); got != "custom-model" {
+		t.Errorf("expected template model name, got %q", got)
+	}
+}
@@ -0,0 +1,257 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// variantFetchResult holds the template and InferenceService of an ensemble variant.
+type variantFetchResult struct {
+	variant          aimv1alpha1.AIMServiceVariant
+	isvcName         string
+	template         controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
+	clusterTemplate  controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]
+	inferenceService controllerutils.FetchResult[*servingv1beta1.InferenceService]
+}
+
+// ensembleFetchResult holds the ensemble variants of a service and the variant
+// InferenceServices that exist, so removed variants can be cleaned up.
+type ensembleFetchResult struct {
+	variants []variantFetchResult
+	existing controllerutils.FetchResult[*servingv1beta1.InferenceServiceList]
+}
+
+// GenerateVariantInferenceServiceName creates the InferenceService name of an ensemble variant.
+func GenerateVariantInferenceServiceName(serviceName, variantName, namespace string) (string, error) {
+	return GenerateInferenceServiceName(serviceName+"-"+variantName, namespace)
+}
+
+// fetchEnsemble fetches the templates and InferenceServices of the service's ensemble variants.
+// Returns nil when the service has no ensemble and reported no variants.
+func fetchEnsemble(ctx context.Context, c client.Client, service *aimv1alpha1.AIMService) *ensembleFetchResult {
+	if service.Spec.Ensemble == nil && len(service.Status.Variants) == 0 {
+		return nil
+	}
+	result := &ensembleFetchResult{}
+
+	serviceLabelValue, _ := utils.SanitizeLabelValue(service.Name)
+	result.existing = controllerutils.FetchList(ctx, c, &servingv1beta1.InferenceServiceList{},
+		client.InNamespace(service.Namespace),
+		client.MatchingLabels{constants.LabelService: serviceLabelValue},
+		client.HasLabels{constants.LabelKeyVariant},
+	)
+
+	if service.Spec.Ensemble == nil {
+		return result
+	}
+	for _, variant := range service.Spec.Ensemble.Variants {
+		v := variantFetchResult{variant: variant}
+		name, err := GenerateVariantInferenceServiceName(service.Name, variant.Name, service.Namespace)
+		if err != nil {
+			v.inferenceService.Error = err
+			result.variants = append(result.variants, v)
+			continue
+		}
+		v.isvcName = name
+		v.template, v.clusterTemplate = fetchVariantTemplate(ctx, c, service.Namespace, variant.Template)
+		v.inferenceService = controllerutils.Fetch(ctx, c, client.ObjectKey{
+			Namespace: service.Namespace,
+			Name:      name,
+		}, &servingv1beta1.InferenceService{})
+		result.variants = append(result.variants, v)
+	}
+	return result
+}
+
+// fetchVariantTemplate looks up a variant template, preferring the namespace-scoped one.
+func fetchVariantTemplate(
+	ctx context.Context,
+	c client.Client,
+	namespace, name string,
+) (
+	controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate],
+	controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate],
+) {
+	template := controllerutils.Fetch(ctx, c, client.ObjectKey{Namespace: namespace, Name: name}, &aimv1alpha1.AIMServiceTemplate{})
+	if !template.IsNotFound() {
+		return template, controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{}
+	}
+	clusterTemplate := controllerutils.Fetch(ctx, c, client.ObjectKey{Name: name}, &aimv1alpha1.AIMClusterServiceTemplate{})
+	if clusterTemplate.OK() {
+		if err := checkClusterTemplateNamespace(ctx, c, clusterTemplate.Value, namespace); err != nil {
+			clusterTemplate = controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{Error: err}
+		}
+	}
+	return controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{}, clusterTemplate
+}
+
+// variantEvaluation is the state of an ensemble variant and, when it can be deployed,
+// the template to build its InferenceService from.
+type variantEvaluation struct {
+	status         constants.AIMStatus
+	message        string
+	deploy         bool
+	templateSpec   *aimv1alpha1.AIMServiceTemplateSpecCommon
+	templateStatus *aimv1alpha1.AIMServiceTemplateStatus
+}
+
+// evaluateVariant decides whether a variant can be deployed and how ready it is.
+func (obs ServiceObservation) evaluateVariant(v variantFetchResult) variantEvaluation {
+	if !isRoutingEnabled(obs.service, obs.mergedRuntimeConfig.Value) {
+		return variantEvaluation{status: constants.AIMStatusPending,
+			message: "Routing must be enabled to send requests to variants"}
+	}
+	if v.isvcName == "" {
+		return variantEvaluation{status: constants.AIMStatusFailed, message: v.inferenceService.Error.Error()}
+	}
+
+	var (
+		spec   *aimv1alpha1.AIMServiceTemplateSpecCommon
+		status *aimv1alpha1.AIMServiceTemplateStatus
+	)
+	switch {
+	case v.template.OK():
+		spec, status = &v.template.Value.Spec.AIMServiceTemplateSpecCommon, &v.template.Value.Status
+	case v.clusterTemplate.OK():
+		spec, status = &v.clusterTemplate.Value.Spec.AIMServiceTemplateSpecCommon, &v.clusterTemplate.Value.Status
+	case v.template.HasError() && !v.template.IsNotFound():
+		return variantEvaluation{status: constants.AIMStatusFailed, message: v.template.Error.Error()}
+	case v.clusterTemplate.IsNotFound():
+		return variantEvaluation{status: constants.AIMStatusFailed,
+			message: fmt.Sprintf("Template %s not found", v.variant.Template)}
+	default:
+		return variantEvaluation{status: constants.AIMStatusFailed,
+			message: controllerutils.CategorizeError(v.clusterTemplate.Error).UserMessage()}
+	}
+
+	modelName, _, _ := obs.getResolvedModel()
+	if modelName == "" {
+		return variantEvaluation{status: constants.AIMStatusPending, message: "Waiting for the service's model to resolve"}
+	}
+	if spec.ModelName != modelName {
+		return variantEvaluation{status: constants.AIMStatusFailed,
+			message: fmt.Sprintf("Template %s is for model %s, not %s", v.variant.Template, spec.ModelName, modelName)}
+	}
+	if status.Status != constants.AIMStatusReady {
+		return variantEvaluation{status: constants.AIMStatusPending,
+			message: fmt.Sprintf("Template %s is not ready", v.variant.Template)}
+	}
+
+	eval := variantEvaluation{deploy: true, templateSpec: spec, templateStatus: status}
+	switch {
+	case v.inferenceService.OK() && v.inferenceService.Value != nil:
+		if err := obs.checkNameCollision(v.inferenceService.Value, "InferenceService"); err != nil {
+			return variantEvaluation{status: constants.AIMStatusFailed, message: controllerutils.CategorizeError(err).UserMessage()}
+		}
+		if v.inferenceService.Value.Status.IsReady() {
+			eval.status, eval.message = constants.AIMStatusRunning, "InferenceService is ready"
+		} else {
+			eval.status, eval.message = constants.AIMStatusStarting, "InferenceService is not ready yet"
+		}
+	case v.inferenceService.IsNotFound():
+		eval.status, eval.message = constants.AIMStatusStarting, "InferenceService is being created"
+	default:
+		// Do not re-apply on transient errors
+		eval.deploy = false
+		eval.status, eval.message = constants.AIMStatusPending, v.inferenceService.Error.Error()
+	}
+	return eval
+}
+
+// planEnsemble plans the InferenceServices of the ensemble variants and deletes those of
+// variants that were removed.
+func planEnsemble(planResult *controllerutils.PlanResult, obs ServiceObservation) {
+	ensemble := obs.ensemble
+	if ensemble == nil {
+		return
+	}
+	service := obs.service
+
+	desired := map[string]struct{}{}
+	for _, v := range ensemble.variants {
+		if v.isvcName != "" {
+			desired[v.isvcName] = struct{}{}
+		}
+		eval := obs.evaluateVariant(v)
+		if !eval.deploy {
+			continue
+		}
+		// Variants share the service's model and runtime settings, but not its cache,
+		// which belongs to the resolved template.
+		variantObs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+			service:             service,
+			mergedRuntimeConfig: obs.mergedRuntimeConfig,
+			modelResult:         obs.modelResult,
+			syncedPullSecrets:   obs.syncedPullSecrets,
+		}}
+		isvc := buildInferenceService(service, v.variant.Template, eval.templateSpec, eval.templateStatus, variantObs)
+		isvc.Name = v.isvcName
+		isvc.Labels[constants.LabelKeyVariant] = v.variant.Name
+		planResult.Apply(isvc)
+	}
+
+	// Only clean up once the existing variants are known
+	if !ensemble.existing.OK() {
+		return
+	}
+	for i := range ensemble.existing.Value.Items {
+		existing := &ensemble.existing.Value.Items[i]
+		if _, ok := desired[existing.Name]; ok || !metav1.IsControlledBy(existing, service) {
+			continue
+		}
+		planResult.Delete(&servingv1beta1.InferenceService{
+			TypeMeta:   metav1.TypeMeta{APIVersion: servingv1beta1.SchemeGroupVersion.String(), Kind: "InferenceService"},
+			ObjectMeta: metav1.ObjectMeta{Name: existing.Name, Namespace: existing.Namespace},
+		})
+	}
+}
+
+// buildVariantStatuses reports the readiness of each ensemble variant.
+func buildVariantStatuses(obs ServiceObservation) []aimv1alpha1.AIMServiceVariantStatus {
+	if obs.ensemble == nil || len(obs.ensemble.variants) == 0 {
+		return nil
+	}
+	statuses := make([]aimv1alpha1.AIMServiceVariantStatus, 0, len(obs.ensemble.variants))
+	for _, v := range obs.ensemble.variants {
+		eval := obs.evaluateVariant(v)
+		statuses = append(statuses, aimv1alpha1.AIMServiceVariantStatus{
+			Name:             v.variant.Name,
+			Template:         v.variant.Template,
+			InferenceService: v.isvcName,
+			Status:           eval.status,
+			Message:          eval.message,
+		})
+	}
+	return statuses
+}
@@ -0,0 +1,206 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func ensembleService(variants ...aimv1alpha1.AIMServiceVariant) *aimv1alpha1.AIMService {
+	service := NewService("svc").WithModelName("llama").Build()
+	service.Spec.Routing = &aimv1alpha1.AIMRuntimeRoutingConfig{Enabled: ptr.To(true)}
+	service.Spec.Ensemble = &aimv1alpha1.AIMServiceEnsemble{Variants: variants}
+	return service
+}
+
+func ensembleObservation(service *aimv1alpha1.AIMService, ensemble *ensembleFetchResult) ServiceObservation {
+	return ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service: service,
+		modelResult: ModelFetchResult{
+			Model: controllerutils.FetchResult[*aimv1alpha1.AIMModel]{
+				Value: NewModel("llama").WithStatus(constants.AIMStatusReady).Build(),
+			},
+		},
+		ensemble: ensemble,
+	}}
+}
+
+func variantResult(name string, template *aimv1alpha1.AIMServiceTemplate, isvc *servingv1beta1.InferenceService) variantFetchResult {
+	isvcName, _ := GenerateVariantInferenceServiceName("svc", name, testNamespace)
+	v := variantFetchResult{
+		variant:  aimv1alpha1.AIMServiceVariant{Name: name, Template: "tmpl-" + name},
+		isvcName: isvcName,
+	}
+	if template != nil {
+		v.template.Value = template
+	} else {
+		v.template.Error = apierrors.NewNotFound(aimv1alpha1.GroupVersion.WithResource("aimservicetemplates").GroupResource(), v.variant.Template)
+		v.clusterTemplate.Error = apierrors.NewNotFound(aimv1alpha1.GroupVersion.WithResource("aimclusterservicetemplates").GroupResource(), v.variant.Template)
+	}
+	if isvc != nil {
+		v.inferenceService.Value = isvc
+	} else {
+		v.inferenceService.Error = apierrors.NewNotFound(servingv1beta1.Resource("inferenceservices"), isvcName)
+	}
+	return v
+}
+
+func variantISVC(name string, ready bool) *servingv1beta1.InferenceService {
+	service := ensembleService()
+	isvcName, _ := GenerateVariantInferenceServiceName("svc", name, testNamespace)
+	isvc := &servingv1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{
+		Name:            isvcName,
+		Namespace:       testNamespace,
+		Labels:          map[string]string{constants.LabelKeyVariant: name},
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(service, aimv1alpha1.GroupVersion.WithKind("AIMService"))},
+	}}
+	if ready {
+		isvc.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}}
+	}
+	return isvc
+}
+
+func TestEvaluateVariant(t *testing.T) {
+	readyTemplate := NewTemplate("tmpl-fp8").WithModelName("llama").WithStatus(constants.AIMStatusReady).Build()
+
+	tests := []struct {
+		name       string
+		routing    bool
+		variant    variantFetchResult
+		wantStatus constants.AIMStatus
+		wantDeploy bool
+	}{
+		{name: "routing disabled", variant: variantResult("fp8", readyTemplate, nil), wantStatus: constants.AIMStatusPending},
+		{name: "template missing", routing: true, variant: variantResult("fp8", nil, nil), wantStatus: constants.AIMStatusFailed},
+		{
+			name:       "template for another model",
+			routing:    true,
+			variant:    variantResult("fp8", NewTemplate("tmpl-fp8").WithModelName("mistral").WithStatus(constants.AIMStatusReady).Build(), nil),
+			wantStatus: constants.AIMStatusFailed,
+		},
+		{
+			name:       "template not ready",
+			routing:    true,
+			variant:    variantResult("fp8", NewTemplate("tmpl-fp8").WithModelName("llama").WithStatus(constants.AIMStatusPending).Build(), nil),
+			wantStatus: constants.AIMStatusPending,
+		},
+		{name: "inference service missing", routing: true, variant: variantResult("fp8", readyTemplate, nil), wantStatus: constants.AIMStatusStarting, wantDeploy: true},
+		{name: "inference service starting", routing: true, variant: variantResult("fp8", readyTemplate, variantISVC("fp8", false)), wantStatus: constants.AIMStatusStarting, wantDeploy: true},
+		{name: "inference service ready", routing: true, variant: variantResult("fp8", readyTemplate, variantISVC("fp8", true)), wantStatus: constants.AIMStatusRunning, wantDeploy: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := ensembleService(tt.variant.variant)
+			service.Spec.Routing.Enabled = ptr.To(tt.routing)
+			eval := ensembleObservation(service, nil).evaluateVariant(tt.variant)
+			if eval.status != tt.wantStatus {
+				t.Errorf("status = %s (%s), want %s", eval.status, eval.message, tt.wantStatus)
+			}
+			if eval.deploy != tt.wantDeploy {
+				t.Errorf("deploy = %v, want %v", eval.deploy, tt.wantDeploy)
+			}
+		})
+	}
+}
+
+func TestPlanEnsemble(t *testing.T) {
+	fp8 := variantResult("fp8", NewTemplate("tmpl-fp8").WithModelName("llama").WithStatus(constants.AIMStatusReady).Build(), nil)
+	service := ensembleService(fp8.variant)
+	stale := variantISVC("fp16", true)
+	ensemble := &ensembleFetchResult{
+		variants: []variantFetchResult{fp8},
+		existing: controllerutils.FetchResult[*servingv1beta1.InferenceServiceList]{
+			Value: &servingv1beta1.InferenceServiceList{Items: []servingv1beta1.InferenceService{*stale}},
+		},
+	}
+
+	var planResult controllerutils.PlanResult
+	planEnsemble(&planResult, ensembleObservation(service, ensemble))
+
+	toApply := planResult.GetToApply()
+	if len(toApply) != 1 {
+		t.Fatalf("expected one variant InferenceService, got %d objects", len(toApply))
+	}
+	isvc, ok := toApply[0].(*servingv1beta1.InferenceService)
+	if !ok || isvc.Name != fp8.isvcName {
+		t.Fatalf("expected InferenceService %s, got %+v", fp8.isvcName, toApply[0])
+	}
+	if isvc.Labels[constants.LabelKeyVariant] != "fp8" {
+		t.Errorf("variant label = %q, want fp8", isvc.Labels[constants.LabelKeyVariant])
+	}
+
+	toDelete := planResult.GetToDelete()
+	if len(toDelete) != 1 || toDelete[0].GetName() != stale.Name {
+		t.Errorf("expected the removed variant %s to be deleted, got %v", stale.Name, toDelete)
+	}
+
+	statuses := buildVariantStatuses(ensembleObservation(service, ensemble))
+	if len(statuses) != 1 || statuses[0].Name != "fp8" || statuses[0].InferenceService != fp8.isvcName ||
+		statuses[0].Status != constants.AIMStatusStarting {
+		t.Errorf("unexpected variant statuses %+v", statuses)
+	}
+}
+
+func TestBuildHTTPRouteEnsembleRules(t *testing.T) {
+	service := ensembleService(
+		aimv1alpha1.AIMServiceVariant{Name: "fp8", Template: "tmpl-fp8"},
+		aimv1alpha1.AIMServiceVariant{Name: "fp16", Template: "tmpl-fp16"},
+	)
+	service.Spec.Ensemble.Header = "X-Precision"
+
+	route := buildHTTPRoute(service, &gatewayapiv1.ParentReference{Name: "gateway"}, nil)
+
+	rules := route.Spec.Rules
+	if len(rules) != 3 {
+		t.Fatalf("expected a default rule and one rule per variant, got %d rules", len(rules))
+	}
+	if len(rules[0].Matches[0].Headers) != 0 {
+		t.Errorf("expected the default rule to match without headers")
+	}
+	for i, name := range []string{"fp8", "fp16"} {
+		rule := rules[i+1]
+		headers := rule.Matches[0].Headers
+		if len(headers) != 1 || headers[0].Name != "X-Precision" || headers[0].Value != name {
+			t.Errorf("variant %s: unexpected header match %+v", name, headers)
+		}
+		if *rule.Matches[0].Path.Value != *rules[0].Matches[0].Path.Value {
+			t.Errorf("variant %s: expected the service path", name)
+		}
+		isvcName, _ := GenerateVariantInferenceServiceName("svc", name, testNamespace)
+		if got := string(rule.BackendRefs[0].Name); got != isvcName+constants.PredictorServiceSuffix {
+			t.Errorf("variant %s: backend = %s", name, got)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// Variables that env values of the inference container can reference as $(NAME)
+const (
+	envTemplateNamespace    = "NAMESPACE"
+	envTemplateServiceName  = "SERVICE_NAME"
+	envTemplateTemplateName = "TEMPLATE_NAME"
+	envTemplateModelName    = "MODEL_NAME"
+	envTemplateGPUCount     = "GPU_COUNT"
+	envTemplateGPUModel     = "GPU_MODEL"
+)
+
+// envTemplateCheck is the result of expanding the env templates of the planned inference
+// container. It is nil when no env value references a variable.
+type envTemplateCheck struct {
+	err error
+}
+
+// envTemplateVariables returns the values of the env template variables for the service
+// running on the given template.
+func envTemplateVariables(
+	service *aimv1alpha1.AIMService,
+	templateName string,
+	templateSpec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+	templateStatus *aimv1alpha1.AIMServiceTemplateStatus,
+	obs ServiceObservation,
+) map[string]string {
+	modelName, _, _ := obs.getResolvedModel()
+	gpuCount, gpuModel := 0, ""
+	cpuMode := resolveComputeMode(service, templateSpec) == aimv1alpha1.AIMComputeModeCPU
+	if !cpuMode && templateStatus != nil && templateStatus.ResolvedHardware != nil && templateStatus.ResolvedHardware.GPU != nil {
+		gpuCount = int(templateStatus.ResolvedHardware.GPU.Requests)
+		gpuModel = templateStatus.ResolvedHardware.GPU.Model
+	}
+	return map[string]string{
+		envTemplateNamespace:    service.Namespace,
+		envTemplateServiceName:  service.Name,
+		envTemplateTemplateName: templateName,
+		envTemplateModelName:    modelName,
+		envTemplateGPUCount:     strconv.Itoa(gpuCount),
+		envTemplateGPUModel:     gpuModel,
+	}
+}
+
+// expandEnvTemplates substitutes the env template variables in the merged env vars of the
+// inference container. Unknown variables are left unexpanded; checkEnvTemplates reports them
+// before anything is planned.
+func expandEnvTemplates(
+	envVars []corev1.EnvVar,
+	service *aimv1alpha1.AIMService,
+	templateName string,
+	templateSpec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+	templateStatus *aimv1alpha1.AIMServiceTemplateStatus,
+	obs ServiceObservation,
+) []corev1.EnvVar {
+	expanded, _ := utils.ExpandEnvVarTemplates(envVars,
+		envTemplateVariables(service, templateName, templateSpec, templateStatus, obs))
+	return expanded
+}
+
+// checkEnvTemplates validates the env templates of the inference container that would be
+// planned for the resolved template. Returns nil when no template is resolved or no env
+// value references a variable.
+func (obs ServiceObservation) checkEnvTemplates() *envTemplateCheck {
+	templateName, _, templateSpec, templateStatus := obs.getResolvedTemplate()
+	if templateName == "" {
+		return nil
+	}
+	templateSpec, templateStatus = applyOverridesInPlace(obs.service, templateSpec, templateStatus)
+	envVars := buildMergedEnvVars(obs.service, templateSpec, obs)
+	expanded, err := utils.ExpandEnvVarTemplates(envVars,
+		envTemplateVariables(obs.service, templateName, templateSpec, templateStatus, obs))
+	if err == nil && slices.Equal(expanded, envVars) {
+		return nil
+	}
+	return &envTemplateCheck{err: err}
+}
+
+// getEnvTemplateHealth reports env values that reference unknown variables, which block the
+// InferenceService from being planned with unexpanded values.
+func (obs ServiceObservation) getEnvTemplateHealth() controllerutils.ComponentHealth {
+	health := controllerutils.ComponentHealth{
+		Component:      "EnvTemplate",
+		DependencyType: controllerutils.DependencyTypeUpstream,
+	}
+	if err := obs.envTemplates.err; err != nil {
+		health.State = constants.AIMStatusFailed
+		health.Errors = []error{controllerutils.NewInvalidSpecError(
+			aimv1alpha1.AIMServiceReasonInvalidEnvTemplate, err.Error(), err)}
+		return health
+	}
+	health.State = constants.AIMStatusReady
+	health.Reason = aimv1alpha1.AIMServiceReasonEnvTemplatesResolved
+	return health
+}
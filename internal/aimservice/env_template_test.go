@@ -0,0 +1,98 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func newEnvTemplateObservation(service *aimv1alpha1.AIMService, runtimeEnv, templateEnv []corev1.EnvVar) ServiceObservation {
+	template := &aimv1alpha1.AIMServiceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-mi300x", Namespace: service.Namespace},
+		Spec: aimv1alpha1.AIMServiceTemplateSpec{
+			AIMServiceTemplateSpecCommon: aimv1alpha1.AIMServiceTemplateSpecCommon{ModelName: testModelName, Env: templateEnv},
+		},
+		Status: aimv1alpha1.AIMServiceTemplateStatus{
+			Status: constants.AIMStatusReady,
+			ResolvedHardware: &aimv1alpha1.AIMHardwareRequirements{
+				GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 4, Model: "MI300X"},
+			},
+		},
+	}
+	return ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service:  service,
+		template: controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: template},
+		mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Value: &aimv1alpha1.AIMRuntimeConfigCommon{
+			AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{Env: runtimeEnv},
+		}},
+	}}
+}
+
+func TestBuildInferenceService_EnvTemplates(t *testing.T) {
+	service := NewService("svc").WithModelImage("test-image:v1").Build()
+	obs := newEnvTemplateObservation(service,
+		[]corev1.EnvVar{{Name: "HF_HOME", Value: "/cache/$(NAMESPACE)/$(SERVICE_NAME)"}},
+		[]corev1.EnvVar{{Name: "AIM_TP", Value: "$(GPU_COUNT)x$(GPU_MODEL)"}, {Name: "AIM_TEMPLATE", Value: "$$(TEMPLATE_NAME)"}},
+	)
+	if obs.checkEnvTemplates() == nil || obs.checkEnvTemplates().err != nil {
+		t.Fatalf("expected valid env templates, got %+v", obs.checkEnvTemplates())
+	}
+
+	template := obs.template.Value
+	isvc := buildInferenceService(service, template.Name, &template.Spec.AIMServiceTemplateSpecCommon, &template.Status, obs)
+	want := map[string]string{
+		"HF_HOME":      "/cache/" + service.Namespace + "/svc",
+		"AIM_TP":       "4xMI300X",
+		"AIM_TEMPLATE": "$$(TEMPLATE_NAME)",
+	}
+	for _, env := range isvc.Spec.Predictor.Containers[0].Env {
+		if value, ok := want[env.Name]; ok && env.Value != value {
+			t.Errorf("expected %s=%q, got %q", env.Name, value, env.Value)
+		}
+	}
+}
+
+func TestCheckEnvTemplates(t *testing.T) {
+	service := NewService("svc").WithModelImage("test-image:v1").Build()
+
+	if check := newEnvTemplateObservation(service, []corev1.EnvVar{{Name: "FOO", Value: "bar"}}, nil).checkEnvTemplates(); check != nil {
+		t.Errorf("expected no check without variables, got %+v", check)
+	}
+
+	obs := newEnvTemplateObservation(service, nil, []corev1.EnvVar{{Name: "CLUSTER", Value: "$(CLUSTER_NAME)"}})
+	obs.envTemplates = obs.checkEnvTemplates()
+	if obs.envTemplates == nil || obs.envTemplates.err == nil {
+		t.Fatalf("expected an unknown variable error, got %+v", obs.envTemplates)
+	}
+	health := obs.getEnvTemplateHealth()
+	if health.State != constants.AIMStatusFailed || health.GetReason() != aimv1alpha1.AIMServiceReasonInvalidEnvTemplate {
+		t.Errorf("expected a failed EnvTemplate component, got %s/%s", health.State, health.GetReason())
+	}
+}
@@ -0,0 +1,109 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// applyEphemeralStorage requests ephemeral storage for the model weights the serving pod keeps
+// on node disk, so the kubelet does not evict it under disk pressure. The request is sized from
+// the model sources with the PVC headroom of the runtime config. An ephemeral-storage request
+// set through the service or template resources is kept. While the storage volumes of a
+// running InferenceService are preserved, its request is preserved too, so that a cache
+// becoming briefly unavailable does not restart the predictor pods.
+func applyEphemeralStorage(
+	isvc *servingv1beta1.InferenceService,
+	modelSources []aimv1alpha1.AIMModelSource,
+	obs ServiceObservation,
+	preserve bool,
+) {
+	if len(isvc.Spec.Predictor.Containers) == 0 {
+		return
+	}
+	container := &isvc.Spec.Predictor.Containers[0]
+	if _, ok := container.Resources.Requests[corev1.ResourceEphemeralStorage]; ok {
+		return
+	}
+
+	if preserve {
+		existing := obs.inferenceService.Value.Spec.Predictor.Containers
+		if len(existing) == 0 {
+			return
+		}
+		if request, ok := existing[0].Resources.Requests[corev1.ResourceEphemeralStorage]; ok {
+			setEphemeralStorageRequest(container, request)
+		}
+		return
+	}
+
+	localBytes := localModelBytes(modelSources, obs)
+	if localBytes == 0 {
+		return
+	}
+	headroom := utils.GetPVCHeadroomPercent(obs.mergedRuntimeConfig.Value)
+	setEphemeralStorageRequest(container, utils.QuantityWithHeadroom(localBytes, headroom))
+}
+
+// localModelBytes returns the size of the model weights that end up on node disk. Model
+// sources without a ready cache volume are downloaded into the container by the runtime, and
+// caches decrypted by an init container are copied into an emptyDir. Shared cluster model
+// caches are mounted directly. Sources of unknown size are not counted.
+func localModelBytes(modelSources []aimv1alpha1.AIMModelSource, obs ServiceObservation) int64 {
+	if len(obs.sharedModelCaches.Value) > 0 {
+		return 0
+	}
+
+	mounted := map[string]bool{}
+	if cache := obs.templateCache.Value; cache != nil && isTemplateCacheMountable(obs.service, cache) {
+		encryption := resolveCacheEncryption(obs)
+		decrypted := encryption != nil && encryption.GetDecryption() == aimv1alpha1.CacheDecryptionModeInitContainer
+		for _, artifact := range cache.Status.Artifacts {
+			if isArtifactMountable(artifact, cache) && !decrypted {
+				mounted[artifact.Model] = true
+			}
+		}
+	}
+
+	var total int64
+	for _, source := range modelSources {
+		if source.Size == nil || mounted[source.ModelID] {
+			continue
+		}
+		total += source.Size.Value()
+	}
+	return total
+}
+
+// setEphemeralStorageRequest sets the ephemeral-storage request of the container.
+func setEphemeralStorageRequest(container *corev1.Container, request resource.Quantity) {
+	if container.Resources.Requests == nil {
+		container.Resources.Requests = corev1.ResourceList{}
+	}
+	container.Resources.Requests[corev1.ResourceEphemeralStorage] = request
+}
@@ -0,0 +1,123 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func TestBuildInferenceService_EphemeralStorage(t *testing.T) {
+	sources := []aimv1alpha1.AIMModelSource{
+		{ModelID: "org/llama", SourceURI: "hf://org/llama", Size: ptr.To(resource.MustParse("20Gi"))},
+		{ModelID: "org/draft", SourceURI: "hf://org/draft", Size: ptr.To(resource.MustParse("5Gi"))},
+	}
+	readyCache := func(encryption *aimv1alpha1.AIMStorageEncryptionConfig, models ...string) controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache] {
+		cache := &aimv1alpha1.AIMTemplateCache{}
+		cache.Spec.Encryption = encryption
+		cache.Status.Status = constants.AIMStatusReady
+		cache.Status.Artifacts = map[string]aimv1alpha1.AIMResolvedArtifact{}
+		for _, model := range models {
+			cache.Status.Artifacts[model] = aimv1alpha1.AIMResolvedArtifact{
+				Name: model, Model: model, Status: constants.AIMStatusReady, PersistentVolumeClaim: "pvc",
+			}
+		}
+		return controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: cache}
+	}
+
+	tests := []struct {
+		name       string
+		resources  *corev1.ResourceRequirements
+		cache      controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]
+		existing   *servingv1beta1.InferenceService
+		wantLocal  string
+		wantAbsent bool
+	}{
+		{
+			name:      "no cache downloads every model into the pod",
+			wantLocal: "28Gi",
+		},
+		{
+			name:      "cached models are not counted",
+			cache:     readyCache(nil, "org/llama"),
+			wantLocal: "6Gi",
+		},
+		{
+			name:       "fully cached needs no request",
+			cache:      readyCache(nil, "org/llama", "org/draft"),
+			wantAbsent: true,
+		},
+		{
+			name: "init container decryption copies the cache to disk",
+			cache: readyCache(&aimv1alpha1.AIMStorageEncryptionConfig{
+				Decryption: aimv1alpha1.CacheDecryptionModeInitContainer,
+			}, "org/llama", "org/draft"),
+			wantLocal: "28Gi",
+		},
+		{
+			name: "explicit request is kept",
+			resources: &corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+			}},
+			wantLocal: "100Gi",
+		},
+		{
+			name:       "running service without a request keeps none",
+			existing:   &servingv1beta1.InferenceService{Spec: servingv1beta1.InferenceServiceSpec{Predictor: servingv1beta1.PredictorSpec{PodSpec: servingv1beta1.PodSpec{Containers: []corev1.Container{{}}}}}},
+			wantAbsent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService("svc").Build()
+			service.Spec.Resources = tt.resources
+			obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+				service:          service,
+				templateCache:    tt.cache,
+				inferenceService: controllerutils.FetchResult[*servingv1beta1.InferenceService]{Value: tt.existing},
+			}}
+			status := &aimv1alpha1.AIMServiceTemplateStatus{ModelSources: sources}
+
+			isvc := buildInferenceService(service, "tmpl", nil, status, obs)
+			request, ok := isvc.Spec.Predictor.Containers[0].Resources.Requests[corev1.ResourceEphemeralStorage]
+			if tt.wantAbsent {
+				if ok {
+					t.Errorf("expected no ephemeral-storage request, got %s", request.String())
+				}
+				return
+			}
+			if !ok || request.Cmp(resource.MustParse(tt.wantLocal)) != 0 {
+				t.Errorf("ephemeral-storage request = %s, want %s", request.String(), tt.wantLocal)
+			}
+		})
+	}
+}
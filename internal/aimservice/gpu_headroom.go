@@ -0,0 +1,129 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
+)
+
+// gpuHeadroomRetryInterval is how often selection is retried while no template fits the GPU headroom.
+const gpuHeadroomRetryInterval = time.Minute
+
+// gpuQuotaResource is the ResourceQuota key that limits GPU requests in a namespace.
+const gpuQuotaResource = corev1.ResourceName("requests." + constants.DefaultGPUResourceName)
+
+// computeGPUHeadroom computes the free GPUs per GPU model for the headroom check.
+// GPUs requested by the pods of excludeISVC are counted as free, so a running service
+// does not crowd out its own template on re-selection.
+func computeGPUHeadroom(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	excludeISVC string,
+	percent int32,
+) (*selection.GPUHeadroom, error) {
+	gpuResource := corev1.ResourceName(constants.DefaultGPUResourceName)
+	headroom := &selection.GPUHeadroom{Percent: percent, Free: map[string]int64{}}
+
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	nodeModels := make(map[string]string, len(nodes.Items))
+	for _, node := range nodes.Items {
+		model := utils.NormalizeGPUModel(utils.ExtractAMDModel(node.Labels))
+		if model == "" || node.Spec.Unschedulable {
+			continue
+		}
+		nodeModels[node.Name] = model
+		if qty, ok := node.Status.Allocatable[gpuResource]; ok {
+			headroom.Free[model] += qty.Value()
+		}
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		model, ok := nodeModels[pod.Spec.NodeName]
+		if !ok || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if excludeISVC != "" && pod.Namespace == namespace &&
+			pod.Labels[constants.LabelKServeInferenceService] == excludeISVC {
+			continue
+		}
+		headroom.Free[model] -= podGPURequests(pod, gpuResource)
+	}
+	for model, free := range headroom.Free {
+		if free < 0 {
+			headroom.Free[model] = 0
+		}
+	}
+
+	quotas := &corev1.ResourceQuotaList{}
+	if err := c.List(ctx, quotas, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+	for _, quota := range quotas.Items {
+		hard, ok := quota.Status.Hard[gpuQuotaResource]
+		if !ok {
+			hard, ok = quota.Spec.Hard[gpuQuotaResource]
+		}
+		if !ok {
+			continue
+		}
+		used := quota.Status.Used[gpuQuotaResource]
+		remaining := max(hard.Value()-used.Value(), 0)
+		if headroom.QuotaRemaining == nil || remaining < *headroom.QuotaRemaining {
+			headroom.QuotaRemaining = &remaining
+		}
+	}
+
+	return headroom, nil
+}
+
+// podGPURequests returns the GPUs requested by the pod's containers.
+// Limits are used for containers that only set a limit, since extended resources default requests to limits.
+func podGPURequests(pod *corev1.Pod, gpuResource corev1.ResourceName) int64 {
+	var total int64
+	for _, container := range pod.Spec.Containers {
+		if qty, ok := container.Resources.Requests[gpuResource]; ok {
+			total += qty.Value()
+		} else if qty, ok := container.Resources.Limits[gpuResource]; ok {
+			total += qty.Value()
+		}
+	}
+	return total
+}
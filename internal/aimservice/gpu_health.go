@@ -0,0 +1,205 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// gpuHealthRescheduleInterval is how soon the service is checked again after a predictor pod
+// was deleted from a node with unhealthy GPUs, to move the next one once it is running.
+const gpuHealthRescheduleInterval = 30 * time.Second
+
+// defaultGPUHealthNodeConditions are the node conditions that report unhealthy GPUs when the
+// runtime config does not list its own.
+var defaultGPUHealthNodeConditions = []string{"AMDGPUUnhealthy", "AMDGPUECCError", "AMDGPUThermalThrottling"}
+
+// gpuHealthCheck is the GPU health of the nodes running the inference pods.
+// It is nil when no inference pod is scheduled to a node.
+type gpuHealthCheck struct {
+	// unhealthyNodes maps each node with unhealthy GPUs to what it reported, sorted
+	unhealthyNodes map[string][]string
+
+	// reschedule is the pod to delete so it is scheduled again, nil when no pod is moved
+	reschedule *corev1.Pod
+
+	err error
+}
+
+// fetchPodNodes fetches the nodes the pods are scheduled to. Nodes that no longer exist are skipped.
+func fetchPodNodes(ctx context.Context, c client.Client, pods []corev1.Pod) controllerutils.FetchResult[[]corev1.Node] {
+	var names []string
+	for i := range pods {
+		if name := pods[i].Spec.NodeName; name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	nodes := make([]corev1.Node, 0, len(names))
+	for _, name := range names {
+		node := &corev1.Node{}
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, node); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return controllerutils.FetchResult[[]corev1.Node]{Error: err}
+		}
+		nodes = append(nodes, *node)
+	}
+	return controllerutils.FetchResult[[]corev1.Node]{Value: nodes}
+}
+
+// unhealthyGPUReports returns what the node reports about unhealthy GPUs: the listed node
+// conditions that are True and the GPUs the AMD device metrics exporter labeled unhealthy.
+func unhealthyGPUReports(node *corev1.Node, conditionTypes []string) []string {
+	var reports []string
+	for _, cond := range node.Status.Conditions {
+		if cond.Status == corev1.ConditionTrue && slices.Contains(conditionTypes, string(cond.Type)) {
+			reports = append(reports, string(cond.Type))
+		}
+	}
+	for key, value := range node.Labels {
+		if !strings.HasPrefix(key, constants.NodeLabelAMDGPUHealthPrefix) ||
+			!strings.HasSuffix(key, constants.NodeLabelAMDGPUHealthSuffix) ||
+			value != constants.NodeLabelValueGPUUnhealthy {
+			continue
+		}
+		gpu := strings.TrimSuffix(strings.TrimPrefix(key, constants.NodeLabelAMDGPUHealthPrefix), constants.NodeLabelAMDGPUHealthSuffix)
+		reports = append(reports, "GPU "+gpu+" unhealthy")
+	}
+	sort.Strings(reports)
+	return reports
+}
+
+// checkGPUHealth evaluates the GPU health of the nodes running the inference pods. Returns nil
+// when the pods were not fetched or none is scheduled to a node.
+func (obs ServiceObservation) checkGPUHealth() *gpuHealthCheck {
+	if obs.inferenceServicePods == nil || !obs.inferenceServicePods.OK() || obs.inferenceServicePods.Value == nil {
+		return nil
+	}
+	if obs.podNodes.HasError() {
+		return &gpuHealthCheck{err: obs.podNodes.Error}
+	}
+	if len(obs.podNodes.Value) == 0 {
+		return nil
+	}
+
+	conditionTypes := defaultGPUHealthNodeConditions
+	reschedule := false
+	if cfg := obs.mergedRuntimeConfig.Value; cfg != nil && cfg.GPUHealth != nil {
+		if len(cfg.GPUHealth.NodeConditions) > 0 {
+			conditionTypes = cfg.GPUHealth.NodeConditions
+		}
+		reschedule = cfg.GPUHealth.ReschedulePods
+	}
+
+	check := &gpuHealthCheck{unhealthyNodes: map[string][]string{}}
+	for i := range obs.podNodes.Value {
+		node := &obs.podNodes.Value[i]
+		if reports := unhealthyGPUReports(node, conditionTypes); len(reports) > 0 {
+			check.unhealthyNodes[node.Name] = reports
+		}
+	}
+	if reschedule && len(check.unhealthyNodes) > 0 {
+		check.reschedule = podToReschedule(obs.inferenceServicePods.Value.Items, check.unhealthyNodes)
+	}
+	return check
+}
+
+// podToReschedule picks the first pod on a node with unhealthy GPUs to delete. No pod is picked
+// while a pod is terminating or a pod on a healthy node is not ready, so pods are moved one at
+// a time and a moved pod is running again before the next one is deleted.
+func podToReschedule(pods []corev1.Pod, unhealthyNodes map[string][]string) *corev1.Pod {
+	var candidate *corev1.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil {
+			return nil
+		}
+		if _, unhealthy := unhealthyNodes[pod.Spec.NodeName]; unhealthy {
+			if candidate == nil || pod.Name < candidate.Name {
+				candidate = pod
+			}
+			continue
+		}
+		if !isPodReady(pod) {
+			return nil
+		}
+	}
+	return candidate
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// setHardwareHealthyCondition reports whether the nodes running the inference pods have healthy
+// GPUs. The condition is removed when no pod is scheduled to a node or the nodes could not be read.
+func setHardwareHealthyCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	check := obs.gpuHealth
+	if check == nil || check.err != nil {
+		cm.Delete(aimv1alpha1.AIMServiceConditionHardwareHealthy)
+		return
+	}
+	if len(check.unhealthyNodes) == 0 {
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionHardwareHealthy, aimv1alpha1.AIMServiceReasonGPUsHealthy,
+			"No node running the service reports unhealthy GPUs")
+		return
+	}
+
+	nodes := make([]string, 0, len(check.unhealthyNodes))
+	for name := range check.unhealthyNodes {
+		nodes = append(nodes, name)
+	}
+	sort.Strings(nodes)
+	details := make([]string, 0, len(nodes))
+	for _, name := range nodes {
+		details = append(details, fmt.Sprintf("%s (%s)", name, strings.Join(check.unhealthyNodes[name], ", ")))
+	}
+	message := "Nodes report unhealthy GPUs: " + strings.Join(details, "; ")
+	reason := aimv1alpha1.AIMServiceReasonGPUsUnhealthy
+	if check.reschedule != nil {
+		reason = aimv1alpha1.AIMServiceReasonReschedulingPods
+		message += fmt.Sprintf(". Rescheduling pod %s", check.reschedule.Name)
+	}
+	cm.MarkFalse(aimv1alpha1.AIMServiceConditionHardwareHealthy, reason, message, controllerutils.AsWarning())
+}
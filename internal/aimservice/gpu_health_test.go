@@ -0,0 +1,214 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func gpuNode(name string, labels map[string]string, conditions ...corev1.NodeConditionType) *corev1.Node {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	node.Status.Conditions = append(node.Status.Conditions,
+		corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue})
+	for _, condType := range conditions {
+		node.Status.Conditions = append(node.Status.Conditions,
+			corev1.NodeCondition{Type: condType, Status: corev1.ConditionTrue})
+	}
+	return node
+}
+
+func scheduledPod(name, nodeName string, ready bool) corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: status},
+		}},
+	}
+}
+
+func gpuHealthObservation(cfg *aimv1alpha1.AIMGPUHealthConfig, pods []corev1.Pod, nodes ...*corev1.Node) ServiceObservation {
+	obs := ServiceObservation{}
+	obs.service = NewService("svc").Build()
+	obs.mergedRuntimeConfig = controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
+		Value: &aimv1alpha1.AIMRuntimeConfigCommon{GPUHealth: cfg},
+	}
+	obs.inferenceServicePods = &controllerutils.FetchResult[*corev1.PodList]{Value: &corev1.PodList{Items: pods}}
+	for _, node := range nodes {
+		obs.podNodes.Value = append(obs.podNodes.Value, *node)
+	}
+	return obs
+}
+
+func TestUnhealthyGPUReports(t *testing.T) {
+	node := gpuNode("n1", map[string]string{
+		"metricsexporter.amd.com.gpu.3.state": "unhealthy",
+		"metricsexporter.amd.com.gpu.1.state": "healthy",
+		"amd.com/gpu.device-id":               "74a1",
+	}, "AMDGPUECCError", "CustomGPUFault")
+
+	got := unhealthyGPUReports(node, defaultGPUHealthNodeConditions)
+	want := []string{"AMDGPUECCError", "GPU 3 unhealthy"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("reports = %v, want %v", got, want)
+	}
+
+	got = unhealthyGPUReports(node, []string{"CustomGPUFault"})
+	want = []string{"CustomGPUFault", "GPU 3 unhealthy"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("reports with custom conditions = %v, want %v", got, want)
+	}
+
+	if got := unhealthyGPUReports(gpuNode("n2", nil), defaultGPUHealthNodeConditions); len(got) != 0 {
+		t.Errorf("expected a healthy node to report nothing, got %v", got)
+	}
+}
+
+func TestCheckGPUHealth(t *testing.T) {
+	pods := []corev1.Pod{scheduledPod("p-b", "bad", true), scheduledPod("p-a", "good", true)}
+	bad := gpuNode("bad", nil, "AMDGPUThermalThrottling")
+	good := gpuNode("good", nil)
+
+	check := gpuHealthObservation(nil, pods, bad, good).checkGPUHealth()
+	if check == nil || len(check.unhealthyNodes) != 1 || check.unhealthyNodes["bad"] == nil {
+		t.Fatalf("expected node bad to be unhealthy, got %+v", check)
+	}
+	if check.reschedule != nil {
+		t.Error("expected no pod to be rescheduled without reschedulePods")
+	}
+
+	cfg := &aimv1alpha1.AIMGPUHealthConfig{ReschedulePods: true}
+	check = gpuHealthObservation(cfg, pods, bad, good).checkGPUHealth()
+	if check.reschedule == nil || check.reschedule.Name != "p-b" {
+		t.Errorf("expected pod p-b to be rescheduled, got %+v", check.reschedule)
+	}
+
+	if check := gpuHealthObservation(cfg, nil).checkGPUHealth(); check != nil {
+		t.Errorf("expected no check without scheduled pods, got %+v", check)
+	}
+}
+
+func TestPodToReschedule(t *testing.T) {
+	unhealthy := map[string][]string{"bad": {"AMDGPUECCError"}}
+
+	pods := []corev1.Pod{
+		scheduledPod("p-2", "bad", true),
+		scheduledPod("p-1", "bad", false),
+		scheduledPod("p-0", "good", true),
+	}
+	if pod := podToReschedule(pods, unhealthy); pod == nil || pod.Name != "p-1" {
+		t.Errorf("expected p-1 to be rescheduled first, got %+v", pod)
+	}
+
+	// A pod on a healthy node that is not ready yet holds back the next move
+	pods[2] = scheduledPod("p-0", "good", false)
+	if pod := podToReschedule(pods, unhealthy); pod != nil {
+		t.Errorf("expected no pod while p-0 is not ready, got %s", pod.Name)
+	}
+
+	// A terminating pod holds back the next move
+	pods[2] = scheduledPod("p-0", "good", true)
+	pods[2].DeletionTimestamp = &metav1.Time{}
+	if pod := podToReschedule(pods, unhealthy); pod != nil {
+		t.Errorf("expected no pod while p-0 is terminating, got %s", pod.Name)
+	}
+}
+
+func TestSetHardwareHealthyCondition(t *testing.T) {
+	cfg := &aimv1alpha1.AIMGPUHealthConfig{ReschedulePods: true}
+	pods := []corev1.Pod{scheduledPod("p-0", "bad", true)}
+	obs := gpuHealthObservation(cfg, pods, gpuNode("bad", map[string]string{
+		"metricsexporter.amd.com.gpu.0.state": "unhealthy",
+	}))
+	obs.gpuHealth = obs.checkGPUHealth()
+
+	cm := controllerutils.NewConditionManager(nil)
+	setHardwareHealthyCondition(cm, obs)
+	cond := cm.Get(aimv1alpha1.AIMServiceConditionHardwareHealthy)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != aimv1alpha1.AIMServiceReasonReschedulingPods {
+		t.Fatalf("expected HardwareHealthy=False with reason ReschedulingPods, got %+v", cond)
+	}
+	want := "Nodes report unhealthy GPUs: bad (GPU 0 unhealthy). Rescheduling pod p-0"
+	if cond.Message != want {
+		t.Errorf("message = %q, want %q", cond.Message, want)
+	}
+
+	obs = gpuHealthObservation(nil, pods, gpuNode("bad", nil))
+	obs.gpuHealth = obs.checkGPUHealth()
+	setHardwareHealthyCondition(cm, obs)
+	if cond := cm.Get(aimv1alpha1.AIMServiceConditionHardwareHealthy); cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Errorf("expected HardwareHealthy=True once the node recovered, got %+v", cond)
+	}
+
+	obs.gpuHealth = nil
+	setHardwareHealthyCondition(cm, obs)
+	if cm.Get(aimv1alpha1.AIMServiceConditionHardwareHealthy) != nil {
+		t.Error("expected the condition to be removed without scheduled pods")
+	}
+}
+
+func TestFetchPodNodesSkipsMissingNodes(t *testing.T) {
+	c := newFakeClient(gpuNode("n1", nil))
+	pods := []corev1.Pod{
+		scheduledPod("p-0", "n1", true),
+		scheduledPod("p-1", "n1", true),
+		scheduledPod("p-2", "gone", true),
+		scheduledPod("p-3", "", false),
+	}
+
+	result := fetchPodNodes(testContext(), c, pods)
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.Value) != 1 || result.Value[0].Name != "n1" {
+		t.Errorf("expected only node n1, got %+v", result.Value)
+	}
+}
+
+func TestPlanResourcesReschedulesPod(t *testing.T) {
+	pod := scheduledPod("p-0", "bad", true)
+	obs := ServiceObservation{}
+	obs.service = NewService("svc").Build()
+	obs.gpuHealth = &gpuHealthCheck{unhealthyNodes: map[string][]string{"bad": {"AMDGPUECCError"}}, reschedule: &pod}
+
+	r := &ServiceReconciler{}
+	plan := r.PlanResources(testContext(), controllerutils.ReconcileContext[*aimv1alpha1.AIMService]{}, obs)
+	deletes := plan.GetToDelete()
+	if len(deletes) != 1 || deletes[0].GetName() != "p-0" {
+		t.Fatalf("expected pod p-0 to be deleted, got %v", deletes)
+	}
+	if plan.RequeueAfter != gpuHealthRescheduleInterval {
+		t.Errorf("RequeueAfter = %v, want %v", plan.RequeueAfter, gpuHealthRescheduleInterval)
+	}
+}
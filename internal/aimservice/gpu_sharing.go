@@ -0,0 +1,106 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"fmt"
+	"strconv"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// defaultServicesPerGPU is how many services share a GPU when the runtime config does not say.
+const defaultServicesPerGPU = 2
+
+// gpuSharing is how the GPU of a single-GPU service is shared with other services.
+type gpuSharing struct {
+	resourceName   corev1.ResourceName
+	servicesPerGPU int32
+}
+
+// resolveGPUSharing returns how the service's GPU is shared, or nil if it gets exclusive GPUs.
+// Only services that need exactly one GPU are shared.
+func resolveGPUSharing(runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon, gpuCount int64) *gpuSharing {
+	if runtimeConfig == nil || runtimeConfig.GPUSharing == nil || !runtimeConfig.GPUSharing.Enabled || gpuCount != 1 {
+		return nil
+	}
+	sharing := &gpuSharing{
+		resourceName:   constants.DefaultGPUResourceName,
+		servicesPerGPU: ptr.Deref(runtimeConfig.GPUSharing.ServicesPerGPU, defaultServicesPerGPU),
+	}
+	if runtimeConfig.GPUSharing.ResourceName != "" {
+		sharing.resourceName = corev1.ResourceName(runtimeConfig.GPUSharing.ResourceName)
+	}
+	return sharing
+}
+
+// fraction is the share of the GPU each service may use, e.g. "0.5" or "0.333".
+func (s *gpuSharing) fraction() string {
+	return strconv.FormatFloat(1/float64(s.servicesPerGPU), 'g', 3, 64)
+}
+
+// applyGPUSharing annotates the predictor pods with their fraction of the shared GPU.
+func applyGPUSharing(isvc *servingv1beta1.InferenceService, sharing *gpuSharing) {
+	if sharing == nil {
+		return
+	}
+	if isvc.Spec.Predictor.Annotations == nil {
+		isvc.Spec.Predictor.Annotations = map[string]string{}
+	}
+	isvc.Spec.Predictor.Annotations[constants.AnnotationGPUFraction] = sharing.fraction()
+}
+
+// setGPUSharedCondition warns that the service shares its GPU with other services. The condition
+// is only set while GPU sharing is enabled for the namespace and a GPU template is resolved.
+func setGPUSharedCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	runtimeConfig := obs.mergedRuntimeConfig.Value
+	if runtimeConfig == nil || runtimeConfig.GPUSharing == nil || !runtimeConfig.GPUSharing.Enabled {
+		cm.Delete(aimv1alpha1.AIMServiceConditionGPUShared)
+		return
+	}
+
+	name, _, spec, status := obs.getResolvedTemplate()
+	if name == "" || status == nil || status.ResolvedHardware == nil || status.ResolvedHardware.GPU == nil ||
+		resolveComputeMode(obs.service, spec) == aimv1alpha1.AIMComputeModeCPU {
+		cm.Delete(aimv1alpha1.AIMServiceConditionGPUShared)
+		return
+	}
+
+	gpuCount := int64(status.ResolvedHardware.GPU.Requests)
+	sharing := resolveGPUSharing(runtimeConfig, gpuCount)
+	if sharing == nil {
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionGPUShared, aimv1alpha1.AIMServiceReasonExclusiveGPUs,
+			fmt.Sprintf("Template %s needs %d GPUs; only single-GPU services share GPUs", name, gpuCount))
+		return
+	}
+	cm.MarkTrue(aimv1alpha1.AIMServiceConditionGPUShared, aimv1alpha1.AIMServiceReasonTimeSliced,
+		fmt.Sprintf("The GPU is time-sliced between up to %d services; latency and throughput depend on "+
+			"the load of the other services, so do not use this for production or benchmarks", sharing.servicesPerGPU),
+		controllerutils.AsWarning())
+}
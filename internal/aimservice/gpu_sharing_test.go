@@ -0,0 +1,178 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func sharingRuntimeConfig(sharing *aimv1alpha1.AIMGPUSharingConfig) controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon] {
+	return controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
+		Value: &aimv1alpha1.AIMRuntimeConfigCommon{GPUSharing: sharing},
+	}
+}
+
+func gpuTemplate(gpus int32) *aimv1alpha1.AIMServiceTemplate {
+	template := NewTemplate("tmpl").WithModelName(testModelName).Build()
+	template.Status.ResolvedHardware = &aimv1alpha1.AIMHardwareRequirements{
+		GPU: &aimv1alpha1.AIMGpuRequirements{Requests: gpus, Model: "MI300X"},
+	}
+	return template
+}
+
+func TestBuildInferenceService_GPUSharing(t *testing.T) {
+	service := NewService("svc").WithModelImage("test-image:v1").Build()
+
+	tests := []struct {
+		name             string
+		sharing          *aimv1alpha1.AIMGPUSharingConfig
+		gpus             int32
+		expectedResource corev1.ResourceName
+		expectedFraction string
+	}{
+		{
+			name:             "sharing disabled",
+			gpus:             1,
+			expectedResource: constants.DefaultGPUResourceName,
+		},
+		{
+			name:             "single GPU shared by default between two services",
+			sharing:          &aimv1alpha1.AIMGPUSharingConfig{Enabled: true},
+			gpus:             1,
+			expectedResource: constants.DefaultGPUResourceName,
+			expectedFraction: "0.5",
+		},
+		{
+			name: "custom resource and services per GPU",
+			sharing: &aimv1alpha1.AIMGPUSharingConfig{
+				Enabled: true, ServicesPerGPU: ptr.To[int32](3), ResourceName: "amd.com/gpu-shared",
+			},
+			gpus:             1,
+			expectedResource: "amd.com/gpu-shared",
+			expectedFraction: "0.333",
+		},
+		{
+			name:             "multi-GPU service keeps exclusive GPUs",
+			sharing:          &aimv1alpha1.AIMGPUSharingConfig{Enabled: true, ResourceName: "amd.com/gpu-shared"},
+			gpus:             2,
+			expectedResource: constants.DefaultGPUResourceName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := gpuTemplate(tt.gpus)
+			obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+				service:             service,
+				mergedRuntimeConfig: sharingRuntimeConfig(tt.sharing),
+			}}
+
+			isvc := buildInferenceService(service, template.Name, &template.Spec.AIMServiceTemplateSpecCommon, &template.Status, obs)
+
+			limits := isvc.Spec.Predictor.Containers[0].Resources.Limits
+			if got := limits[tt.expectedResource]; got.Value() != int64(tt.gpus) {
+				t.Errorf("expected %d %s, got limits %v", tt.gpus, tt.expectedResource, limits)
+			}
+			if got := isvc.Spec.Predictor.Annotations[constants.AnnotationGPUFraction]; got != tt.expectedFraction {
+				t.Errorf("expected GPU fraction %q, got %q", tt.expectedFraction, got)
+			}
+		})
+	}
+}
+
+func TestSetGPUSharedCondition(t *testing.T) {
+	enabled := &aimv1alpha1.AIMGPUSharingConfig{Enabled: true, ServicesPerGPU: ptr.To[int32](4)}
+
+	tests := []struct {
+		name       string
+		sharing    *aimv1alpha1.AIMGPUSharingConfig
+		template   *aimv1alpha1.AIMServiceTemplate
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "single-GPU service is time-sliced",
+			sharing:    enabled,
+			template:   gpuTemplate(1),
+			wantStatus: metav1.ConditionTrue,
+			wantReason: aimv1alpha1.AIMServiceReasonTimeSliced,
+		},
+		{
+			name:       "multi-GPU service keeps exclusive GPUs",
+			sharing:    enabled,
+			template:   gpuTemplate(4),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: aimv1alpha1.AIMServiceReasonExclusiveGPUs,
+		},
+		{
+			name:     "sharing disabled",
+			sharing:  &aimv1alpha1.AIMGPUSharingConfig{},
+			template: gpuTemplate(1),
+		},
+		{
+			name:    "no template resolved",
+			sharing: enabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := controllerutils.NewConditionManager([]metav1.Condition{{
+				Type:   aimv1alpha1.AIMServiceConditionGPUShared,
+				Status: metav1.ConditionTrue,
+				Reason: aimv1alpha1.AIMServiceReasonTimeSliced,
+			}})
+			obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+				service:             NewService("svc").Build(),
+				mergedRuntimeConfig: sharingRuntimeConfig(tt.sharing),
+			}}
+			if tt.template != nil {
+				obs.template = controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: tt.template}
+			}
+
+			setGPUSharedCondition(cm, obs)
+
+			cond := cm.Get(aimv1alpha1.AIMServiceConditionGPUShared)
+			if tt.wantStatus == "" {
+				if cond != nil {
+					t.Fatalf("expected no condition, got %+v", cond)
+				}
+				return
+			}
+			if cond == nil {
+				t.Fatal("expected condition to be set")
+			}
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("condition = %s/%s, want %s/%s", cond.Status, cond.Reason, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
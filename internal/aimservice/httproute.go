@@ -24,7 +24,10 @@ package aimservice
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -129,6 +132,7 @@ func buildHTTPRoute(
 
 	// Build annotations
 	annotations := mergeRouteAnnotations(runtimeConfig)
+	resolveRouteSettings(service, runtimeConfig).annotate(annotations)
 
 	// Resolve path using JSONPath template
 	path, err := ResolveServiceRoutePath(service, runtimeConfig)
@@ -193,6 +197,27 @@ func buildHTTPRoute(
 		}
 	}
 
+	// Ensemble variants get a rule per variant that matches the variant header on the same path.
+	// Header matches take precedence over the path-only rule, which keeps serving requests
+	// without the header.
+	rules := []gatewayapiv1.HTTPRouteRule{rule}
+	if ensemble := service.Spec.Ensemble; ensemble != nil {
+		for _, variant := range ensemble.Variants {
+			variantISVCName, err := GenerateVariantInferenceServiceName(service.Name, variant.Name, service.Namespace)
+			if err != nil {
+				continue
+			}
+			variantRule := *rule.DeepCopy()
+			variantRule.Matches[0].Headers = []gatewayapiv1.HTTPHeaderMatch{{
+				Type:  ptr.To(gatewayapiv1.HeaderMatchExact),
+				Name:  gatewayapiv1.HTTPHeaderName(ensemble.GetHeader()),
+				Value: variant.Name,
+			}}
+			variantRule.BackendRefs[0].Name = gatewayapiv1.ObjectName(variantISVCName + constants.PredictorServiceSuffix)
+			rules = append(rules, variantRule)
+		}
+	}
+
 	route := &gatewayapiv1.HTTPRoute{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: gatewayapiv1.GroupVersion.String(),
@@ -218,7 +243,7 @@ func buildHTTPRoute(
 			CommonRouteSpec: gatewayapiv1.CommonRouteSpec{
 				ParentRefs: parentRefs,
 			},
-			Rules: []gatewayapiv1.HTTPRouteRule{rule},
+			Rules: rules,
 		},
 	}
 
@@ -255,16 +280,104 @@ func mergeRouteAnnotations(runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) ma
 }
 
 // resolveRequestTimeout gets the request timeout to use.
+// Either requestTimeout or timeoutSeconds may set it; the service level takes precedence.
 func resolveRequestTimeout(service *aimv1alpha1.AIMService, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) *metav1.Duration {
 	// Service-level override
-	if service.Spec.Routing != nil && service.Spec.Routing.RequestTimeout != nil {
-		return service.Spec.Routing.RequestTimeout
+	if timeout := routingTimeout(service.Spec.Routing); timeout != nil {
+		return timeout
 	}
 
 	// Runtime config default
-	if runtimeConfig != nil && runtimeConfig.Routing != nil && runtimeConfig.Routing.RequestTimeout != nil {
-		return runtimeConfig.Routing.RequestTimeout
+	if runtimeConfig != nil {
+		return routingTimeout(runtimeConfig.Routing)
+	}
+
+	return nil
+}
+
+// routingTimeout returns the request timeout set by requestTimeout or timeoutSeconds.
+func routingTimeout(routing *aimv1alpha1.AIMRuntimeRoutingConfig) *metav1.Duration {
+	if routing == nil {
+		return nil
 	}
+	if routing.RequestTimeout != nil {
+		return routing.RequestTimeout
+	}
+	if routing.TimeoutSeconds != nil {
+		return &metav1.Duration{Duration: time.Duration(*routing.TimeoutSeconds) * time.Second}
+	}
+	return nil
+}
 
+// resolvePredictorTimeout gets the KServe predictor timeout, only set through timeoutSeconds.
+// requestTimeout predates it and stays limited to the route, so existing services are unchanged.
+func resolvePredictorTimeout(service *aimv1alpha1.AIMService, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) *int64 {
+	if routing := service.Spec.Routing; routing != nil && (routing.TimeoutSeconds != nil || routing.RequestTimeout != nil) {
+		return routing.TimeoutSeconds
+	}
+	if runtimeConfig != nil && runtimeConfig.Routing != nil {
+		return runtimeConfig.Routing.TimeoutSeconds
+	}
 	return nil
 }
+
+// routeSettings are the route settings Gateway API has no field for, with the annotation
+// keys the gateway implementation reads them from.
+type routeSettings struct {
+	idleTimeout           *metav1.Duration
+	maxRequestBodySize    *resource.Quantity
+	idleTimeoutKey        string
+	maxRequestBodySizeKey string
+}
+
+// resolveRouteSettings merges the service routing settings over the runtime config ones, field by field.
+func resolveRouteSettings(service *aimv1alpha1.AIMService, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) routeSettings {
+	var settings routeSettings
+	apply := func(routing *aimv1alpha1.AIMRuntimeRoutingConfig) {
+		if routing == nil {
+			return
+		}
+		if routing.IdleTimeout != nil {
+			settings.idleTimeout = routing.IdleTimeout
+		}
+		if routing.MaxRequestBodySize != nil {
+			settings.maxRequestBodySize = routing.MaxRequestBodySize
+		}
+		if keys := routing.AnnotationKeys; keys != nil {
+			if keys.IdleTimeout != "" {
+				settings.idleTimeoutKey = keys.IdleTimeout
+			}
+			if keys.MaxRequestBodySize != "" {
+				settings.maxRequestBodySizeKey = keys.MaxRequestBodySize
+			}
+		}
+	}
+	if runtimeConfig != nil {
+		apply(runtimeConfig.Routing)
+	}
+	apply(service.Spec.Routing)
+	return settings
+}
+
+// validate returns an InvalidSpec error for settings the gateway has no annotation for.
+func (s routeSettings) validate() error {
+	if s.idleTimeout != nil && s.idleTimeoutKey == "" {
+		return controllerutils.NewInvalidSpecError(aimv1alpha1.AIMServiceReasonRouteSettingUnsupported,
+			"routing.idleTimeout is set but no routing.annotationKeys.idleTimeout names the annotation the gateway reads it from", nil)
+	}
+	if s.maxRequestBodySize != nil && s.maxRequestBodySizeKey == "" {
+		return controllerutils.NewInvalidSpecError(aimv1alpha1.AIMServiceReasonRouteSettingUnsupported,
+			"routing.maxRequestBodySize is set but no routing.annotationKeys.maxRequestBodySize names the annotation the gateway reads it from", nil)
+	}
+	return nil
+}
+
+// annotate writes the settings to the route annotations.
+func (s routeSettings) annotate(annotations map[string]string) {
+	if s.idleTimeout != nil && s.idleTimeoutKey != "" {
+		annotations[s.idleTimeoutKey] = fmt.Sprintf("%ds", int64(s.idleTimeout.Seconds()))
+	}
+	if s.maxRequestBodySize != nil && s.maxRequestBodySizeKey != "" {
+		annotations[s.maxRequestBodySizeKey] = s.maxRequestBodySize.String()
+	}
+}
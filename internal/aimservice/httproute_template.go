@@ -85,28 +85,6 @@ func DefaultRoutePath(service *aimv1alpha1.AIMService) string {
 	return path
 }
 
-// ResolveServiceRouteTimeout resolves the HTTP route timeout using service and runtime config context.
-// The precedence order is:
-// 1. Service.Spec.Routing.RequestTimeout (highest priority)
-// 2. RuntimeConfig.Routing.RequestTimeout (base layer)
-// Returns nil if no timeout is configured at any level.
-func ResolveServiceRouteTimeout(service *aimv1alpha1.AIMService, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) *string {
-	// Highest priority: AIMService.Spec.Routing.RequestTimeout
-	if service.Spec.Routing != nil && service.Spec.Routing.RequestTimeout != nil {
-		timeout := service.Spec.Routing.RequestTimeout.Duration.String()
-		return &timeout
-	}
-
-	// Base priority: RuntimeConfig.Routing.RequestTimeout
-	if runtimeConfig != nil && runtimeConfig.Routing != nil && runtimeConfig.Routing.RequestTimeout != nil {
-		timeout := runtimeConfig.Routing.RequestTimeout.Duration.String()
-		return &timeout
-	}
-
-	// If not defined at any level, no timeout is set
-	return nil
-}
-
 func renderRouteTemplate(template string, service *aimv1alpha1.AIMService) (string, error) {
 	matches := routeTemplatePattern.FindAllStringSubmatchIndex(template, -1)
 	if len(matches) == 0 {
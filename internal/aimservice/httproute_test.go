@@ -28,6 +28,7 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -37,6 +38,72 @@ import (
 	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
 )
 
+func TestResolvePredictorTimeout(t *testing.T) {
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{
+		AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+			Routing: &aimv1alpha1.AIMRuntimeRoutingConfig{TimeoutSeconds: ptr.To[int64](600)},
+		},
+	}
+
+	if timeout := resolvePredictorTimeout(NewService("svc").Build(), runtimeConfig); timeout == nil || *timeout != 600 {
+		t.Errorf("expected the runtime config timeoutSeconds, got %v", timeout)
+	}
+
+	// A service-level requestTimeout replaces the runtime config timeout and only applies to the route
+	svc := NewService("svc").Build()
+	svc.Spec.Routing = &aimv1alpha1.AIMRuntimeRoutingConfig{RequestTimeout: &metav1.Duration{Duration: time.Minute}}
+	if timeout := resolvePredictorTimeout(svc, runtimeConfig); timeout != nil {
+		t.Errorf("expected no predictor timeout with a service requestTimeout, got %d", *timeout)
+	}
+
+	isvc := buildInferenceService(NewService("svc").Build(), "tmpl", nil, nil, ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Value: runtimeConfig},
+	}})
+	if isvc.Spec.Predictor.TimeoutSeconds == nil || *isvc.Spec.Predictor.TimeoutSeconds != 600 {
+		t.Errorf("expected predictor timeout 600, got %v", isvc.Spec.Predictor.TimeoutSeconds)
+	}
+}
+
+func TestRouteSettings(t *testing.T) {
+	bodySize := resource.MustParse("10Mi")
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{
+		AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+			Routing: &aimv1alpha1.AIMRuntimeRoutingConfig{
+				IdleTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+				AnnotationKeys: &aimv1alpha1.AIMRouteAnnotationKeys{
+					IdleTimeout:        "gateway.example.com/idle-timeout",
+					MaxRequestBodySize: "gateway.example.com/max-body-size",
+				},
+			},
+		},
+	}
+	svc := NewService("svc").Build()
+	svc.Spec.Routing = &aimv1alpha1.AIMRuntimeRoutingConfig{MaxRequestBodySize: &bodySize}
+
+	settings := resolveRouteSettings(svc, runtimeConfig)
+	if err := settings.validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	annotations := map[string]string{}
+	settings.annotate(annotations)
+	if annotations["gateway.example.com/idle-timeout"] != "300s" {
+		t.Errorf("idle timeout annotation = %q, want 300s", annotations["gateway.example.com/idle-timeout"])
+	}
+	if annotations["gateway.example.com/max-body-size"] != "10Mi" {
+		t.Errorf("body size annotation = %q, want 10Mi", annotations["gateway.example.com/max-body-size"])
+	}
+
+	// Without an annotation key the gateway would ignore the setting
+	unsupported := resolveRouteSettings(svc, nil)
+	err := unsupported.validate()
+	if err == nil {
+		t.Fatal("expected a validation error without annotation keys")
+	}
+	if reason := controllerutils.CategorizeError(err).Reason(); reason != aimv1alpha1.AIMServiceReasonRouteSettingUnsupported {
+		t.Errorf("reason = %q, want %q", reason, aimv1alpha1.AIMServiceReasonRouteSettingUnsupported)
+	}
+}
+
 // ============================================================================
 // GENERATE HTTPROUTE NAME TESTS
 // ============================================================================
@@ -352,6 +419,24 @@ func TestResolveRequestTimeout(t *testing.T) {
 			},
 			expectSeconds: 30,
 		},
+		{
+			name: "service timeoutSeconds overrides runtime config",
+			service: func() *aimv1alpha1.AIMService {
+				svc := NewService("svc").Build()
+				svc.Spec.Routing = &aimv1alpha1.AIMRuntimeRoutingConfig{
+					TimeoutSeconds: ptr.To[int64](45),
+				}
+				return svc
+			}(),
+			runtimeConfig: &aimv1alpha1.AIMRuntimeConfigCommon{
+				AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+					Routing: &aimv1alpha1.AIMRuntimeRoutingConfig{
+						RequestTimeout: runtimeTimeout,
+					},
+				},
+			},
+			expectSeconds: 45,
+		},
 	}
 
 	for _, tt := range tests {
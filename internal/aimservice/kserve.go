@@ -25,11 +25,11 @@ package aimservice
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 	"sort"
 	"strings"
 
 	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	kserveconstants "github.com/kserve/kserve/pkg/constants"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -146,7 +146,7 @@ func planInferenceService(
 }
 
 // isReadyForInferenceService checks if all prerequisites are met to create or update the InferenceService.
-func isReadyForInferenceService(_ *aimv1alpha1.AIMService, obs ServiceObservation) bool {
+func isReadyForInferenceService(service *aimv1alpha1.AIMService, obs ServiceObservation) bool {
 	// If the ISVC already exists, we're on the update path - always proceed.
 	// Mutable fields (replicas, autoscaling, env, resources, etc.) should propagate
 	// even if model or cache are transiently unhealthy.
@@ -167,14 +167,15 @@ func isReadyForInferenceService(_ *aimv1alpha1.AIMService, obs ServiceObservatio
 		return false
 	}
 
-	// All caching modes now use template cache (both Dedicated and Shared modes)
-	// Template cache must be ready before creating InferenceService
-	if obs.templateCache.Value == nil ||
-		obs.templateCache.Value.Status.Status != constants.AIMStatusReady {
-		return false
+	// Shared cluster model caches are only returned once they are ready
+	if len(obs.sharedModelCaches.Value) > 0 {
+		return true
 	}
 
-	return true
+	// All caching modes now use template cache (both Dedicated and Shared modes)
+	// Template cache must be warm before creating InferenceService, or only provisioned
+	// when a dedicated cache gates on that and the pod downloads the missing files
+	return isTemplateCacheMountable(service, obs.templateCache.Value)
 }
 
 // buildInferenceService constructs a KServe InferenceService with inline container spec.
@@ -219,8 +220,9 @@ func buildInferenceService(
 		}
 	}
 
-	// Build environment variables
-	envVars := buildMergedEnvVars(service, templateSpec, obs)
+	// Build environment variables, substituting $(NAME) variables such as $(NAMESPACE) or $(GPU_COUNT)
+	envVars := expandEnvTemplates(buildMergedEnvVars(service, templateSpec, obs),
+		service, templateName, templateSpec, templateStatus, obs)
 
 	// Determine image from the resolved model
 	image := ""
@@ -232,20 +234,36 @@ func buildInferenceService(
 
 	// Get GPU count and resource name from template status.resolvedHardware.
 	// The template controller computes resolvedHardware from discovery + spec fallback.
+	// CPU-only services never request GPUs.
+	cpuMode := resolveComputeMode(service, templateSpec) == aimv1alpha1.AIMComputeModeCPU
 	gpuCount := int64(0)
+	gpuModel := ""
 	gpuResourceName := corev1.ResourceName(constants.DefaultGPUResourceName)
-	if templateStatus != nil && templateStatus.ResolvedHardware != nil && templateStatus.ResolvedHardware.GPU != nil {
+	if !cpuMode && templateStatus != nil && templateStatus.ResolvedHardware != nil && templateStatus.ResolvedHardware.GPU != nil {
 		gpuCount = int64(templateStatus.ResolvedHardware.GPU.Requests)
+		gpuModel = templateStatus.ResolvedHardware.GPU.Model
 		if templateStatus.ResolvedHardware.GPU.ResourceName != "" {
 			gpuResourceName = corev1.ResourceName(templateStatus.ResolvedHardware.GPU.ResourceName)
 		}
 	}
 
-	// Build resource requirements
-	resources := resolveResources(service, templateSpec, gpuCount, gpuResourceName)
+	// Single-GPU services request a time-sliced GPU when the namespace opted into GPU sharing
+	sharing := resolveGPUSharing(obs.mergedRuntimeConfig.Value, gpuCount)
+	if sharing != nil {
+		gpuResourceName = sharing.resourceName
+	}
 
-	// Build shared memory volume
-	dshmSizeLimit := resource.MustParse(constants.DefaultSharedMemorySize)
+	// Build resource requirements
+	var modelSources []aimv1alpha1.AIMModelSource
+	if templateStatus != nil {
+		modelSources = templateStatus.ModelSources
+	}
+	modelClass := resolveModelClass(templateSpec, obs)
+	ratios := resolveGPUResourceRatios(obs.mergedRuntimeConfig.Value, gpuModel, modelSources, modelClass)
+	resources := resolveResources(service, templateSpec, gpuCount, gpuResourceName, ratios)
+	if cpuMode && templateStatus != nil && templateStatus.ResolvedHardware != nil {
+		resources = applyCPURequirements(resources, templateStatus.ResolvedHardware.CPU)
+	}
 
 	inferenceService := &servingv1beta1.InferenceService{
 		TypeMeta: metav1.TypeMeta{
@@ -270,9 +288,11 @@ func buildInferenceService(
 		},
 		Spec: servingv1beta1.InferenceServiceSpec{
 			Predictor: servingv1beta1.PredictorSpec{
-				ComponentExtensionSpec: servingv1beta1.ComponentExtensionSpec{},
+				ComponentExtensionSpec: servingv1beta1.ComponentExtensionSpec{
+					Labels: map[string]string{constants.LabelKeyManagedBy: constants.LabelValueManagedBy},
+				},
 				PodSpec: servingv1beta1.PodSpec{
-					ImagePullSecrets:   utils.CopyPullSecrets(service.Spec.ImagePullSecrets),
+					ImagePullSecrets:   append(utils.CopyPullSecrets(service.Spec.ImagePullSecrets), obs.syncedPullSecrets.refs()...),
 					ServiceAccountName: service.Spec.ServiceAccountName,
 					Containers: []corev1.Container{
 						{
@@ -287,23 +307,6 @@ func buildInferenceService(
 									Protocol:      corev1.ProtocolTCP,
 								},
 							},
-							VolumeMounts: []corev1.VolumeMount{
-								{
-									Name:      constants.VolumeSharedMemory,
-									MountPath: constants.MountPathSharedMemory,
-								},
-							},
-						},
-					},
-					Volumes: []corev1.Volume{
-						{
-							Name: constants.VolumeSharedMemory,
-							VolumeSource: corev1.VolumeSource{
-								EmptyDir: &corev1.EmptyDirVolumeSource{
-									Medium:    corev1.StorageMediumMemory,
-									SizeLimit: &dshmSizeLimit,
-								},
-							},
 						},
 					},
 				},
@@ -311,27 +314,78 @@ func buildInferenceService(
 		},
 	}
 
+	// Tell the device plugin which fraction of the shared GPU the predictor pods may use
+	applyGPUSharing(inferenceService, sharing)
+
+	// Roll the predictor pods when a refreshed cache must be loaded by restarting them
+	applyWeightsDigest(inferenceService, obs)
+
+	// Tensor parallel workers of generative models exchange data through /dev/shm
+	if modelClass == aimv1alpha1.AIMModelClassLLM {
+		addSharedMemoryVolume(inferenceService)
+	}
+
+	// Gate container startup on the model's "weights loaded" signal
+	inferenceService.Spec.Predictor.Containers[0].StartupProbe = buildModelLoadedStartupProbe(service.Spec.ModelReadiness)
+
+	// Embedding and reranker models only receive traffic once their endpoint answers
+	inferenceService.Spec.Predictor.Containers[0].ReadinessProbe = buildModelClassReadinessProbe(modelClass)
+
+	// Keep pods out of Service endpoints until the operator has verified them
+	applyServingReadinessGate(inferenceService, service.Spec.ServingReadinessGate)
+
+	// Give in-flight generations time to finish when pods are replaced or scaled down
+	applyTermination(inferenceService, resolveTermination(service, obs.mergedRuntimeConfig.Value))
+
+	// Route requests to the engine's request logs or the logging sidecar
+	applyRequestLogging(inferenceService, requestLoggingOf(service), obs.mergedRuntimeConfig.Value)
+
+	// Knative enforces the predictor timeout in Serverless mode
+	inferenceService.Spec.Predictor.TimeoutSeconds = resolvePredictorTimeout(service, obs.mergedRuntimeConfig.Value)
+
+	// Pin the deployment mode so the planned autoscaling and strategy match what KServe runs
+	setDeploymentMode(inferenceService, obs.deploymentMode, obs.inferenceService.Value)
+
 	// Configure replicas and autoscaling
-	configureReplicasAndAutoscaling(inferenceService, service)
+	configureReplicasAndAutoscaling(inferenceService, service, obs.deploymentMode)
+
+	// Control how predictor pods are replaced when the planned InferenceService changes.
+	// Knative rolls out revisions itself, so the strategy only applies in RawDeployment mode.
+	if obs.deploymentMode != aimv1alpha1.KServeDeploymentModeServerless {
+		inferenceService.Spec.Predictor.DeploymentStrategy = buildDeploymentStrategy(service.Spec.UpdateStrategy)
+	}
 
 	// Apply GPU node affinity from template status.
 	// The template controller computes resolvedNodeAffinity from GPU requirements
 	// and actual cluster GPU resources (including VRAM from node labels).
-	if templateStatus != nil && templateStatus.ResolvedNodeAffinity != nil {
+	if !cpuMode && templateStatus != nil && templateStatus.ResolvedNodeAffinity != nil {
 		applyNodeAffinity(inferenceService, templateStatus.ResolvedNodeAffinity)
 	}
 
+	// Merge node placement from the runtime config and service (e.g. tolerations for tainted GPU pools).
+	// Node affinity is combined with the GPU node affinity above so that both must be satisfied.
+	applyScheduling(inferenceService, utils.ResolveScheduling(service.Spec.Scheduling, obs.mergedRuntimeConfig.Value))
+
+	// Spread multi-replica services across the configured failure domains
+	applySpreading(inferenceService, spreadingConfig(service, obs.mergedRuntimeConfig.Value))
+
 	// Add storage volumes (cache or PVC).
 	// On the update path (ISVC already exists), preserve the existing volume spec
 	// rather than re-resolving from artifacts. Artifacts or their PVCs may be
 	// transiently unavailable, and re-resolving would cause SSA to strip the
-	// storage volumes off the running ISVC.
-	if obs.inferenceService.OK() && obs.inferenceService.Value != nil {
+	// storage volumes off the running ISVC. A cache migration and a revoked cluster
+	// model cache are the exceptions: once the new cache is ready, the volumes are rebuilt from it.
+	preserveStorage := obs.inferenceService.OK() && obs.inferenceService.Value != nil &&
+		!obs.cacheMigration.switchesVolumes() && !obs.switchesFromSharedModelCaches()
+	if preserveStorage {
 		preserveExistingStorageVolumes(inferenceService, obs.inferenceService.Value)
 	} else {
 		addStorageVolumes(inferenceService, obs)
 	}
 
+	// Reserve node disk for weights that are downloaded or decrypted into the pod
+	applyEphemeralStorage(inferenceService, modelSources, obs, preserveStorage)
+
 	return inferenceService
 }
 
@@ -349,9 +403,24 @@ func buildMergedEnvVars(
 	// Start with system defaults
 	envVars := []corev1.EnvVar{
 		{Name: constants.EnvAIMCachePath, Value: constants.AIMCacheBasePath},
-		{Name: constants.EnvVLLMEnableMetrics, Value: "true"},
 	}
 
+	// Token generation metrics only apply to generative models
+	if resolveModelClass(templateSpec, obs) == aimv1alpha1.AIMModelClassLLM {
+		envVars = append(envVars, corev1.EnvVar{Name: constants.EnvVLLMEnableMetrics, Value: "true"})
+	}
+
+	// Configure the runtime for CPU inference in CPU mode
+	if resolveComputeMode(service, templateSpec) == aimv1alpha1.AIMComputeModeCPU {
+		envVars = append(envVars,
+			corev1.EnvVar{Name: constants.EnvAIMDevice, Value: string(aimv1alpha1.AIMComputeModeCPU)},
+			corev1.EnvVar{Name: utils.EnvVarAIMEngineArgs, Value: cpuEngineArgs},
+		)
+	}
+
+	// Route egress through the runtime config's proxy, unless env vars below set it explicitly
+	envVars = utils.WithProxyEnv(envVars, obs.mergedRuntimeConfig.Value)
+
 	// Merge runtime config env vars
 	// AIM_ENGINE_ARGS is deep-merged as JSON to preserve contributions from all sources
 	if obs.mergedRuntimeConfig.Value != nil && len(obs.mergedRuntimeConfig.Value.Env) > 0 {
@@ -385,6 +454,11 @@ func buildMergedEnvVars(
 		envVars = append(envVars, modelIDEnvVar)
 	}
 
+	// Logging settings map to the AIM logging env vars, below explicit service env vars
+	if logging := loggingEnvVars(resolveLogging(service, obs.mergedRuntimeConfig.Value)); len(logging) > 0 {
+		envVars = utils.MergeEnvVars(envVars, logging)
+	}
+
 	// Merge service-level env vars (highest precedence)
 	// AIM_ENGINE_ARGS is deep-merged as JSON to preserve contributions from all sources
 	if len(service.Spec.Env) > 0 {
@@ -399,20 +473,91 @@ func buildMergedEnvVars(
 	return envVars
 }
 
+// cpuEngineArgs are the default engine arguments for CPU-only serving.
+// They are deep-merged with AIM_ENGINE_ARGS from runtime config, template and service.
+const cpuEngineArgs = `{"device":"cpu"}`
+
+// resolveComputeMode returns the effective compute mode for the service.
+// An explicit service setting wins; otherwise the template's compute mode is used.
+func resolveComputeMode(service *aimv1alpha1.AIMService, templateSpec *aimv1alpha1.AIMServiceTemplateSpecCommon) aimv1alpha1.AIMComputeMode {
+	if service.Spec.Compute != "" {
+		return service.Spec.Compute
+	}
+	if templateSpec != nil {
+		return templateSpec.GetCompute()
+	}
+	return aimv1alpha1.AIMComputeModeGPU
+}
+
+// resolveModelClass returns the effective model class for the service.
+// The template's class wins; otherwise the class of the resolved model is used.
+func resolveModelClass(templateSpec *aimv1alpha1.AIMServiceTemplateSpecCommon, obs ServiceObservation) aimv1alpha1.AIMModelClass {
+	if templateSpec != nil && templateSpec.ModelClass != "" {
+		return templateSpec.ModelClass
+	}
+	if obs.modelResult.Model.Value != nil && obs.modelResult.Model.Value.Spec.ModelClass != "" {
+		return obs.modelResult.Model.Value.Spec.ModelClass
+	}
+	if obs.modelResult.ClusterModel.Value != nil && obs.modelResult.ClusterModel.Value.Spec.ModelClass != "" {
+		return obs.modelResult.ClusterModel.Value.Spec.ModelClass
+	}
+	return aimv1alpha1.AIMModelClassLLM
+}
+
+// addSharedMemoryVolume mounts a memory-backed emptyDir at /dev/shm in the inference container.
+func addSharedMemoryVolume(isvc *servingv1beta1.InferenceService) {
+	isvc.Spec.Predictor.Volumes = append(isvc.Spec.Predictor.Volumes, corev1.Volume{
+		Name: constants.VolumeSharedMemory,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium:    corev1.StorageMediumMemory,
+				SizeLimit: ptr.To(resource.MustParse(constants.DefaultSharedMemorySize)),
+			},
+		},
+	})
+	container := &isvc.Spec.Predictor.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      constants.VolumeSharedMemory,
+		MountPath: constants.MountPathSharedMemory,
+	})
+}
+
+// applyCPURequirements fills in CPU requests and limits from the template's resolved CPU
+// requirements for CPU-only services. CPU values set explicitly on the template or service are kept.
+func applyCPURequirements(resources corev1.ResourceRequirements, cpu *aimv1alpha1.AIMCpuRequirements) corev1.ResourceRequirements {
+	if cpu == nil {
+		return resources
+	}
+	if _, ok := resources.Requests[corev1.ResourceCPU]; !ok {
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		resources.Requests[corev1.ResourceCPU] = cpu.Requests.DeepCopy()
+	}
+	if _, ok := resources.Limits[corev1.ResourceCPU]; !ok && cpu.Limits != nil {
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Limits[corev1.ResourceCPU] = cpu.Limits.DeepCopy()
+	}
+	return resources
+}
+
 // resolveResources builds resource requirements for the inference container.
 // Priority order (highest to lowest):
 // 1. Service spec resources (user override)
 // 2. Template spec resources
 // 3. Default GPU resources from profile
-// 4. Default CPU/memory based on GPU count
+// 4. Default CPU/memory based on GPU count and the per-GPU ratios
 func resolveResources(
 	service *aimv1alpha1.AIMService,
 	templateSpec *aimv1alpha1.AIMServiceTemplateSpecCommon,
 	gpuCount int64,
 	gpuResourceName corev1.ResourceName,
+	ratios gpuResourceRatios,
 ) corev1.ResourceRequirements {
 	// Start with defaults based on GPU count
-	resources := defaultResourceRequirementsForGPU(gpuCount)
+	resources := defaultResourceRequirementsForGPU(gpuCount, ratios)
 
 	// Set default GPU resources from template profile
 	if gpuCount > 0 {
@@ -440,19 +585,30 @@ func resolveResources(
 	return resources
 }
 
-// defaultResourceRequirementsForGPU returns default CPU/memory based on GPU count.
-func defaultResourceRequirementsForGPU(gpuCount int64) corev1.ResourceRequirements {
+// defaultResourceRequirementsForGPU returns default CPU/memory based on GPU count and the per-GPU ratios.
+// When the memory request would be below the model size plus overhead, it is raised to that
+// floor, and the limit is raised by the same amount.
+func defaultResourceRequirementsForGPU(gpuCount int64, ratios gpuResourceRatios) corev1.ResourceRequirements {
 	if gpuCount <= 0 {
 		return corev1.ResourceRequirements{}
 	}
 
+	memoryRequest := scaleQuantity(ratios.memoryRequest, gpuCount)
+	memoryLimit := scaleQuantity(ratios.memoryLimit, gpuCount)
+	if memoryRequest.Cmp(ratios.minMemory) < 0 {
+		bump := ratios.minMemory.DeepCopy()
+		bump.Sub(memoryRequest)
+		memoryRequest = ratios.minMemory.DeepCopy()
+		memoryLimit.Add(bump)
+	}
+
 	return corev1.ResourceRequirements{
 		Requests: corev1.ResourceList{
-			corev1.ResourceCPU:    *resource.NewQuantity(gpuCount*4, resource.DecimalSI),
-			corev1.ResourceMemory: resource.MustParse(fmt.Sprintf("%dGi", gpuCount*32)),
+			corev1.ResourceCPU:    scaleQuantity(ratios.cpu, gpuCount),
+			corev1.ResourceMemory: memoryRequest,
 		},
 		Limits: corev1.ResourceList{
-			corev1.ResourceMemory: resource.MustParse(fmt.Sprintf("%dGi", gpuCount*48)),
+			corev1.ResourceMemory: memoryLimit,
 		},
 	}
 }
@@ -484,8 +640,46 @@ func mergeResourceRequirements(base corev1.ResourceRequirements, override *corev
 	return base
 }
 
+// setDeploymentMode pins the KServe deployment mode of a new InferenceService, using the names
+// of the KServe version the operator is built against. KServe rejects updates whose annotation
+// differs from the mode it reports, so an existing InferenceService keeps the reported mode,
+// or its own annotation until a mode is reported. The annotation is left unset when the mode is
+// unknown, so KServe falls back to its default.
+func setDeploymentMode(
+	isvc *servingv1beta1.InferenceService,
+	mode aimv1alpha1.AIMKServeDeploymentMode,
+	existing *servingv1beta1.InferenceService,
+) {
+	value := ""
+	switch {
+	case existing != nil && existing.Status.DeploymentMode != "":
+		value = existing.Status.DeploymentMode
+	case existing != nil:
+		value = existing.Annotations[constants.AnnotationKServeDeploymentMode]
+	case mode == aimv1alpha1.KServeDeploymentModeServerless:
+		value = string(kserveconstants.Knative)
+	case mode == aimv1alpha1.KServeDeploymentModeRawDeployment:
+		value = string(kserveconstants.Standard)
+	}
+	if value == "" {
+		return
+	}
+	if isvc.Annotations == nil {
+		isvc.Annotations = make(map[string]string)
+	}
+	isvc.Annotations[constants.AnnotationKServeDeploymentMode] = value
+}
+
 // configureReplicasAndAutoscaling sets up replica counts and autoscaling configuration.
-func configureReplicasAndAutoscaling(isvc *servingv1beta1.InferenceService, service *aimv1alpha1.AIMService) {
+// In Serverless mode Knative scales the predictor between the replica bounds, so the KEDA
+// annotations and metrics are only planned for RawDeployment mode.
+func configureReplicasAndAutoscaling(
+	isvc *servingv1beta1.InferenceService,
+	service *aimv1alpha1.AIMService,
+	mode aimv1alpha1.AIMKServeDeploymentMode,
+) {
+	serverless := mode == aimv1alpha1.KServeDeploymentModeServerless
+
 	// Check if autoscaling is configured (new fields take precedence)
 	hasAutoscaling := service.Spec.AutoScaling != nil ||
 		service.Spec.MinReplicas != nil ||
@@ -493,7 +687,9 @@ func configureReplicasAndAutoscaling(isvc *servingv1beta1.InferenceService, serv
 
 	if hasAutoscaling {
 		// Enable KEDA autoscaling
-		injectAutoscalingAnnotations(isvc)
+		if !serverless {
+			injectAutoscalingAnnotations(isvc)
+		}
 
 		// Set min replicas
 		if service.Spec.MinReplicas != nil {
@@ -514,17 +710,21 @@ func configureReplicasAndAutoscaling(isvc *servingv1beta1.InferenceService, serv
 		}
 
 		// Apply autoscaling configuration if provided
-		if service.Spec.AutoScaling != nil {
+		if service.Spec.AutoScaling != nil && !serverless {
 			isvc.Spec.Predictor.AutoScaling = convertToKServeAutoScaling(service.Spec.AutoScaling)
 		}
 	} else if service.Spec.Replicas != nil {
 		// Legacy: fixed replica count, disable HPA
-		disableHPA(isvc)
+		if !serverless {
+			disableHPA(isvc)
+		}
 		isvc.Spec.Predictor.MinReplicas = service.Spec.Replicas
 		isvc.Spec.Predictor.MaxReplicas = *service.Spec.Replicas
 	} else {
 		// Default: 1 replica, disable HPA
-		disableHPA(isvc)
+		if !serverless {
+			disableHPA(isvc)
+		}
 		one := int32(1)
 		isvc.Spec.Predictor.MinReplicas = &one
 		isvc.Spec.Predictor.MaxReplicas = 1
@@ -621,24 +821,95 @@ func addStorageVolumes(isvc *servingv1beta1.InferenceService, obs ServiceObserva
 	}
 	container := &isvc.Spec.Predictor.Containers[0]
 
+	if shared := obs.sharedModelCaches.Value; len(shared) > 0 {
+		addSharedCacheVolumes(isvc, container, shared)
+		return
+	}
+
 	// All caching now flows through template cache
-	if obs.templateCache.Value == nil ||
-		obs.templateCache.Value.Status.Status != constants.AIMStatusReady {
+	if !isTemplateCacheMountable(obs.service, obs.templateCache.Value) {
 		return
 	}
 
+	// Encrypted caches need the key; the service decides how the weights are decrypted
+	encryption := resolveCacheEncryption(obs)
+	if encryption != nil {
+		isvc.Spec.Predictor.Volumes = append(isvc.Spec.Predictor.Volumes, utils.CacheEncryptionKeyVolume(encryption))
+		if encryption.GetDecryption() == aimv1alpha1.CacheDecryptionModeTransparent {
+			container.VolumeMounts = append(container.VolumeMounts, utils.CacheEncryptionKeyVolumeMount())
+			container.Env = utils.MergeEnvVars(container.Env, []corev1.EnvVar{utils.CacheEncryptionKeyEnvVar()})
+		}
+	}
+	decryptImage := obs.templateCache.Value.Spec.DownloadImage
+	if decryptImage == "" {
+		decryptImage = aimv1alpha1.DefaultDownloadImage
+	}
+
 	// Use resolved artifacts from template cache status
 	// This avoids fetching artifacts separately and keeps the CRD relationships explicit
 	for _, resolvedCache := range obs.templateCache.Value.Status.Artifacts {
-		if resolvedCache.Status != constants.AIMStatusReady {
+		if !isArtifactMountable(resolvedCache, obs.templateCache.Value) {
 			continue
 		}
-		if resolvedCache.PersistentVolumeClaim == "" {
+
+		volumeName, mountPath := addResolvedCacheVolume(isvc, resolvedCache)
+		if encryption != nil && encryption.GetDecryption() == aimv1alpha1.CacheDecryptionModeInitContainer {
+			addDecryptedCacheMount(isvc, container, volumeName, mountPath, decryptImage)
 			continue
 		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: mountPath,
+		})
+	}
+}
 
-		addResolvedCacheMount(isvc, container, resolvedCache)
+// resolveCacheEncryption returns the encryption settings used to mount the template cache,
+// nil when the cache is not encrypted. The service decides how the weights are decrypted.
+func resolveCacheEncryption(obs ServiceObservation) *aimv1alpha1.AIMStorageEncryptionConfig {
+	encryption := obs.templateCache.Value.Spec.Encryption
+	if encryption == nil {
+		return nil
+	}
+	if resolved := resolveStorageEncryption(obs.service, obs.mergedRuntimeConfig.Value); resolved != nil {
+		return resolved
 	}
+	return encryption
+}
+
+// addDecryptedCacheMount adds an init container that decrypts the cache volume into an emptyDir,
+// and mounts the emptyDir into the inference container in place of the encrypted volume.
+func addDecryptedCacheMount(
+	isvc *servingv1beta1.InferenceService,
+	container *corev1.Container,
+	volumeName, mountPath, image string,
+) {
+	decryptedVolumeName, _ := utils.GenerateDerivedName([]string{volumeName, "plain"}, utils.WithHashSource(volumeName))
+	initContainerName, _ := utils.GenerateDerivedName(
+		[]string{strings.TrimSuffix(constants.ContainerCacheDecryptPrefix, "-"), volumeName},
+		utils.WithHashSource(volumeName),
+	)
+
+	isvc.Spec.Predictor.Volumes = append(isvc.Spec.Predictor.Volumes, corev1.Volume{
+		Name:         decryptedVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	isvc.Spec.Predictor.InitContainers = append(isvc.Spec.Predictor.InitContainers, corev1.Container{
+		Name:            initContainerName,
+		Image:           image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"/cache-crypt.sh", "decrypt", "/encrypted", "/decrypted"},
+		Env:             []corev1.EnvVar{utils.CacheEncryptionKeyEnvVar()},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: volumeName, MountPath: "/encrypted", ReadOnly: true},
+			{Name: decryptedVolumeName, MountPath: "/decrypted"},
+			utils.CacheEncryptionKeyVolumeMount(),
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      decryptedVolumeName,
+		MountPath: mountPath,
+	})
 }
 
 // preserveExistingStorageVolumes copies storage volumes and volume mounts from the existing
@@ -655,6 +926,8 @@ func preserveExistingStorageVolumes(newISVC, existingISVC *servingv1beta1.Infere
 	for _, v := range newISVC.Spec.Predictor.Volumes {
 		existingVolumeNames[v.Name] = true
 	}
+	// The shared memory volume depends on the model class and is planned, not preserved
+	existingVolumeNames[constants.VolumeSharedMemory] = true
 	for _, v := range existingISVC.Spec.Predictor.Volumes {
 		if !existingVolumeNames[v.Name] {
 			newISVC.Spec.Predictor.Volumes = append(newISVC.Spec.Predictor.Volumes, *v.DeepCopy())
@@ -667,19 +940,39 @@ func preserveExistingStorageVolumes(newISVC, existingISVC *servingv1beta1.Infere
 	for _, vm := range newContainer.VolumeMounts {
 		existingMountNames[vm.Name] = true
 	}
+	existingMountNames[constants.VolumeSharedMemory] = true
 	existingContainer := &existingISVC.Spec.Predictor.Containers[0]
 	for _, vm := range existingContainer.VolumeMounts {
 		if !existingMountNames[vm.Name] {
 			newContainer.VolumeMounts = append(newContainer.VolumeMounts, *vm.DeepCopy())
 		}
 	}
+
+	// Encrypted caches also carry decrypt init containers and the key location
+	for _, ic := range existingISVC.Spec.Predictor.InitContainers {
+		if strings.HasPrefix(ic.Name, constants.ContainerCacheDecryptPrefix) {
+			newISVC.Spec.Predictor.InitContainers = append(newISVC.Spec.Predictor.InitContainers, *ic.DeepCopy())
+		}
+	}
+	for _, env := range existingContainer.Env {
+		if env.Name == constants.EnvAIMCacheEncryptionKeyFile {
+			newContainer.Env = utils.MergeEnvVars(newContainer.Env, []corev1.EnvVar{env})
+		}
+	}
+
+	// Cluster model cache volumes are recognized by the annotation listing the caches
+	if caches := existingISVC.Annotations[constants.AnnotationClusterModelCaches]; caches != "" {
+		if newISVC.Annotations == nil {
+			newISVC.Annotations = make(map[string]string)
+		}
+		newISVC.Annotations[constants.AnnotationClusterModelCaches] = caches
+	}
 }
 
-// addResolvedCacheMount adds a resolved artifact PVC volume mount.
-func addResolvedCacheMount(isvc *servingv1beta1.InferenceService, container *corev1.Container, cache aimv1alpha1.AIMResolvedArtifact) {
-	// Sanitize volume name from the artifact name
-	volumeName := utils.MakeRFC1123Compliant(cache.Name)
-	volumeName = strings.ReplaceAll(volumeName, ".", "-")
+// addResolvedCacheVolume adds a resolved artifact PVC volume and returns its name and the path
+// the inference container expects the model at.
+func addResolvedCacheVolume(isvc *servingv1beta1.InferenceService, cache aimv1alpha1.AIMResolvedArtifact) (string, string) {
+	volumeName := utils.ArtifactVolumeName(cache)
 
 	isvc.Spec.Predictor.Volumes = append(isvc.Spec.Predictor.Volumes, corev1.Volume{
 		Name: volumeName,
@@ -690,23 +983,7 @@ func addResolvedCacheMount(isvc *servingv1beta1.InferenceService, container *cor
 		},
 	})
 
-	// TODO: Consider removing MountPoint field if it's never used
-	// Use mount point from resolved cache if available, otherwise derive from model ID
-	mountPath := cache.MountPoint
-	if mountPath == "" {
-		// Use full model ID (e.g., "Qwen/Qwen2-0.5B") as mount path
-		// Sanitize to prevent path traversal (remove ".." sequences)
-		safeModelName := strings.ReplaceAll(cache.Model, "..", "")
-		if safeModelName == "" || safeModelName == "." {
-			safeModelName = volumeName // Fall back to volume name if model name is invalid
-		}
-		mountPath = filepath.Join(constants.AIMCacheBasePath, safeModelName)
-	}
-
-	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
-		Name:      volumeName,
-		MountPath: mountPath,
-	})
+	return volumeName, utils.ArtifactMountPath(cache)
 }
 
 // applyNodeAffinity applies the pre-computed node affinity from the template status to the InferenceService.
@@ -726,3 +1003,14 @@ func applyNodeAffinity(isvc *servingv1beta1.InferenceService, nodeAffinity *core
 	// TODO: In the future, merge with any existing service-level affinity if needed
 	isvc.Spec.Predictor.Affinity.NodeAffinity = nodeAffinity.DeepCopy()
 }
+
+// applyScheduling merges node selector, tolerations, and affinity into the predictor pod spec.
+func applyScheduling(isvc *servingv1beta1.InferenceService, scheduling *aimv1alpha1.AIMSchedulingConfig) {
+	if scheduling == nil {
+		return
+	}
+	predictor := &isvc.Spec.Predictor
+	predictor.NodeSelector = utils.MergeNodeSelector(predictor.NodeSelector, scheduling.NodeSelector)
+	predictor.Tolerations = utils.MergeTolerations(predictor.Tolerations, scheduling.Tolerations)
+	predictor.Affinity = utils.ApplyAffinity(predictor.Affinity, scheduling.Affinity)
+}
@@ -0,0 +1,136 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"fmt"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// getPredictorHealth breaks the InferenceService PredictorReady condition and the predictor
+// revisions out into their own component, so a failed revision is visible on the service.
+// Returns false if the InferenceService does not exist or has not reported predictor status yet.
+func (f ServiceFetchResult) getPredictorHealth() (controllerutils.ComponentHealth, bool) {
+	if !f.inferenceService.OK() || f.inferenceService.Value == nil {
+		return controllerutils.ComponentHealth{}, false
+	}
+	isvc := f.inferenceService.Value
+	cond := isvc.Status.GetCondition(servingv1beta1.PredictorReady)
+	if cond == nil {
+		return controllerutils.ComponentHealth{}, false
+	}
+
+	health := controllerutils.ComponentHealth{
+		Component:      "Predictor",
+		DependencyType: controllerutils.DependencyTypeDownstream,
+	}
+	predictor := isvc.Status.Components[servingv1beta1.PredictorComponent]
+
+	switch {
+	case cond.Status == corev1.ConditionTrue:
+		health.State = constants.AIMStatusReady
+		health.Reason = aimv1alpha1.AIMServiceReasonPredictorReady
+		health.Message = "Predictor is ready"
+		if predictor.LatestReadyRevision != "" {
+			health.Message = fmt.Sprintf("Predictor revision %s is ready", predictor.LatestReadyRevision)
+		}
+	case latestRevisionFailed(cond, predictor):
+		// A failed revision does not recover without a spec change. The previous revision
+		// keeps serving in serverless mode, so the service is degraded rather than failed.
+		health.State = constants.AIMStatusFailed
+		if predictor.LatestReadyRevision != "" {
+			health.State = constants.AIMStatusDegraded
+		}
+		health.Reason = aimv1alpha1.AIMServiceReasonRevisionFailed
+		health.Message = fmt.Sprintf("Predictor revision %s failed: %s",
+			predictor.LatestCreatedRevision, conditionDetail(cond))
+	default:
+		health.State = constants.AIMStatusProgressing
+		health.Reason = aimv1alpha1.AIMServiceReasonPredictorNotReady
+		health.Message = "Predictor is not ready: " + conditionDetail(cond)
+	}
+	return health, true
+}
+
+// getRoutesHealth reports the InferenceService routing readiness. Serverless mode reports
+// RoutesReady, RawDeployment mode only IngressReady. Returns false if neither is reported.
+func (f ServiceFetchResult) getRoutesHealth() (controllerutils.ComponentHealth, bool) {
+	if !f.inferenceService.OK() || f.inferenceService.Value == nil {
+		return controllerutils.ComponentHealth{}, false
+	}
+	isvc := f.inferenceService.Value
+	cond := isvc.Status.GetCondition(servingv1beta1.RoutesReady)
+	if cond == nil {
+		cond = isvc.Status.GetCondition(servingv1beta1.IngressReady)
+	}
+	if cond == nil {
+		return controllerutils.ComponentHealth{}, false
+	}
+
+	health := controllerutils.ComponentHealth{
+		Component:      "Routes",
+		DependencyType: controllerutils.DependencyTypeDownstream,
+	}
+	if cond.Status == corev1.ConditionTrue {
+		health.State = constants.AIMStatusReady
+		health.Reason = aimv1alpha1.AIMServiceReasonRoutesReady
+		health.Message = "InferenceService routes are ready"
+		if isvc.Status.URL != nil {
+			health.Message = "InferenceService routes are ready at " + isvc.Status.URL.String()
+		}
+		return health, true
+	}
+	health.State = constants.AIMStatusProgressing
+	health.Reason = aimv1alpha1.AIMServiceReasonRoutesNotReady
+	health.Message = "InferenceService routes are not ready: " + conditionDetail(cond)
+	return health, true
+}
+
+// latestRevisionFailed reports whether the predictor's latest created revision failed,
+// as opposed to still starting up.
+func latestRevisionFailed(cond *apis.Condition, predictor servingv1beta1.ComponentStatusSpec) bool {
+	if cond.Status != corev1.ConditionFalse || predictor.LatestCreatedRevision == "" {
+		return false
+	}
+	return predictor.LatestCreatedRevision != predictor.LatestReadyRevision
+}
+
+// conditionDetail formats the reason and message of a KServe condition.
+func conditionDetail(cond *apis.Condition) string {
+	switch {
+	case cond.Reason != "" && cond.Message != "":
+		return cond.Reason + ": " + cond.Message
+	case cond.Message != "":
+		return cond.Message
+	case cond.Reason != "":
+		return cond.Reason
+	}
+	return "no details reported"
+}
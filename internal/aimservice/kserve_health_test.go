@@ -0,0 +1,150 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func isvcWithStatus(predictor servingv1beta1.ComponentStatusSpec, conds ...apis.Condition) ServiceFetchResult {
+	isvc := &servingv1beta1.InferenceService{}
+	isvc.Status.Conditions = conds
+	isvc.Status.Components = map[servingv1beta1.ComponentType]servingv1beta1.ComponentStatusSpec{
+		servingv1beta1.PredictorComponent: predictor,
+	}
+	return ServiceFetchResult{
+		inferenceService: controllerutils.FetchResult[*servingv1beta1.InferenceService]{Value: isvc},
+	}
+}
+
+func TestGetPredictorHealth(t *testing.T) {
+	tests := []struct {
+		name       string
+		predictor  servingv1beta1.ComponentStatusSpec
+		cond       *apis.Condition
+		wantState  constants.AIMStatus
+		wantReason string
+	}{
+		{
+			name:       "ready",
+			predictor:  servingv1beta1.ComponentStatusSpec{LatestReadyRevision: "rev-1", LatestCreatedRevision: "rev-1"},
+			cond:       &apis.Condition{Type: servingv1beta1.PredictorReady, Status: corev1.ConditionTrue},
+			wantState:  constants.AIMStatusReady,
+			wantReason: aimv1alpha1.AIMServiceReasonPredictorReady,
+		},
+		{
+			name:      "starting",
+			predictor: servingv1beta1.ComponentStatusSpec{LatestCreatedRevision: "rev-1"},
+			cond: &apis.Condition{Type: servingv1beta1.PredictorReady, Status: corev1.ConditionUnknown,
+				Reason: "RevisionMissing"},
+			wantState:  constants.AIMStatusProgressing,
+			wantReason: aimv1alpha1.AIMServiceReasonPredictorNotReady,
+		},
+		{
+			name:      "first revision failed",
+			predictor: servingv1beta1.ComponentStatusSpec{LatestCreatedRevision: "rev-1"},
+			cond: &apis.Condition{Type: servingv1beta1.PredictorReady, Status: corev1.ConditionFalse,
+				Reason: "RevisionFailed", Message: "container exited"},
+			wantState:  constants.AIMStatusFailed,
+			wantReason: aimv1alpha1.AIMServiceReasonRevisionFailed,
+		},
+		{
+			name:      "new revision failed while previous serves",
+			predictor: servingv1beta1.ComponentStatusSpec{LatestReadyRevision: "rev-1", LatestCreatedRevision: "rev-2"},
+			cond: &apis.Condition{Type: servingv1beta1.PredictorReady, Status: corev1.ConditionFalse,
+				Reason: "RevisionFailed"},
+			wantState:  constants.AIMStatusDegraded,
+			wantReason: aimv1alpha1.AIMServiceReasonRevisionFailed,
+		},
+		{
+			name:      "raw deployment not ready",
+			predictor: servingv1beta1.ComponentStatusSpec{},
+			cond: &apis.Condition{Type: servingv1beta1.PredictorReady, Status: corev1.ConditionFalse,
+				Reason: "MinimumReplicasUnavailable"},
+			wantState:  constants.AIMStatusProgressing,
+			wantReason: aimv1alpha1.AIMServiceReasonPredictorNotReady,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch := isvcWithStatus(tt.predictor, *tt.cond)
+			health, ok := fetch.getPredictorHealth()
+			if !ok {
+				t.Fatal("expected predictor health")
+			}
+			if health.State != tt.wantState || health.Reason != tt.wantReason {
+				t.Errorf("health = %s/%s, want %s/%s (%s)", health.State, health.Reason, tt.wantState, tt.wantReason, health.Message)
+			}
+		})
+	}
+
+	t.Run("not reported", func(t *testing.T) {
+		if _, ok := isvcWithStatus(servingv1beta1.ComponentStatusSpec{}).getPredictorHealth(); ok {
+			t.Error("expected no predictor health without a PredictorReady condition")
+		}
+	})
+}
+
+func TestGetRoutesHealth(t *testing.T) {
+	t.Run("serverless routes not ready", func(t *testing.T) {
+		fetch := isvcWithStatus(servingv1beta1.ComponentStatusSpec{},
+			apis.Condition{Type: servingv1beta1.IngressReady, Status: corev1.ConditionTrue},
+			apis.Condition{Type: servingv1beta1.RoutesReady, Status: corev1.ConditionFalse, Message: "traffic not migrated"},
+		)
+		health, ok := fetch.getRoutesHealth()
+		if !ok {
+			t.Fatal("expected routes health")
+		}
+		if health.State != constants.AIMStatusProgressing || health.Reason != aimv1alpha1.AIMServiceReasonRoutesNotReady {
+			t.Errorf("health = %s/%s", health.State, health.Reason)
+		}
+		if health.Message != "InferenceService routes are not ready: traffic not migrated" {
+			t.Errorf("unexpected message %q", health.Message)
+		}
+	})
+
+	t.Run("raw deployment ingress ready", func(t *testing.T) {
+		fetch := isvcWithStatus(servingv1beta1.ComponentStatusSpec{},
+			apis.Condition{Type: servingv1beta1.IngressReady, Status: corev1.ConditionTrue},
+		)
+		health, ok := fetch.getRoutesHealth()
+		if !ok || health.State != constants.AIMStatusReady || health.Reason != aimv1alpha1.AIMServiceReasonRoutesReady {
+			t.Errorf("health = %+v, ok = %v", health, ok)
+		}
+	})
+
+	t.Run("not reported", func(t *testing.T) {
+		if _, ok := isvcWithStatus(servingv1beta1.ComponentStatusSpec{}).getRoutesHealth(); ok {
+			t.Error("expected no routes health without routing conditions")
+		}
+	})
+}
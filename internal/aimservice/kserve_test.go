@@ -401,6 +401,132 @@ func TestPreserveExistingStorageVolumes(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// ENCRYPTED CACHE VOLUME TESTS
+// ============================================================================
+
+func TestAddStorageVolumes_EncryptedCache(t *testing.T) {
+	encryption := &aimv1alpha1.AIMStorageEncryptionConfig{
+		KMSSecretRef: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "model-key"},
+			Key:                  "passphrase",
+		},
+	}
+
+	newObs := func(decryption aimv1alpha1.AIMCacheDecryptionMode) ServiceObservation {
+		service := NewService("svc").Build()
+		service.Spec.Storage = &aimv1alpha1.AIMStorageConfig{Encryption: encryption.DeepCopy()}
+		service.Spec.Storage.Encryption.Decryption = decryption
+
+		obs := ServiceObservation{}
+		obs.service = service
+		obs.templateCache.Value = &aimv1alpha1.AIMTemplateCache{
+			Spec: aimv1alpha1.AIMTemplateCacheSpec{Encryption: encryption.DeepCopy()},
+			Status: aimv1alpha1.AIMTemplateCacheStatus{
+				Status: constants.AIMStatusReady,
+				Artifacts: map[string]aimv1alpha1.AIMResolvedArtifact{
+					"artifact-a": {
+						Name:                  "artifact-a",
+						Model:                 "org/model-a",
+						Status:                constants.AIMStatusReady,
+						PersistentVolumeClaim: "pvc-a",
+					},
+				},
+			},
+		}
+		return obs
+	}
+	newISVC := func() *servingv1beta1.InferenceService {
+		return &servingv1beta1.InferenceService{
+			Spec: servingv1beta1.InferenceServiceSpec{
+				Predictor: servingv1beta1.PredictorSpec{
+					PodSpec: servingv1beta1.PodSpec{Containers: []corev1.Container{{Name: constants.ContainerKServe}}},
+				},
+			},
+		}
+	}
+
+	t.Run("init container decrypts into an emptyDir", func(t *testing.T) {
+		isvc := newISVC()
+		addStorageVolumes(isvc, newObs(aimv1alpha1.CacheDecryptionModeInitContainer))
+
+		initContainers := isvc.Spec.Predictor.InitContainers
+		if len(initContainers) != 1 || !strings.HasPrefix(initContainers[0].Name, constants.ContainerCacheDecryptPrefix) {
+			t.Fatalf("expected one decrypt init container, got %+v", initContainers)
+		}
+
+		mounts := isvc.Spec.Predictor.Containers[0].VolumeMounts
+		if len(mounts) != 1 || mounts[0].MountPath != constants.AIMCacheBasePath+"/org/model-a" {
+			t.Fatalf("unexpected inference container mounts: %+v", mounts)
+		}
+		var mounted *corev1.Volume
+		var keySecret string
+		for i, v := range isvc.Spec.Predictor.Volumes {
+			if v.Name == mounts[0].Name {
+				mounted = &isvc.Spec.Predictor.Volumes[i]
+			}
+			if v.Secret != nil {
+				keySecret = v.Secret.SecretName
+			}
+		}
+		if mounted == nil || mounted.EmptyDir == nil {
+			t.Errorf("expected the inference container to mount the decrypted emptyDir, got %+v", mounted)
+		}
+		if keySecret != "model-key" {
+			t.Errorf("key secret = %q, want model-key", keySecret)
+		}
+	})
+
+	t.Run("transparent mode mounts the encrypted volume and the key", func(t *testing.T) {
+		isvc := newISVC()
+		addStorageVolumes(isvc, newObs(aimv1alpha1.CacheDecryptionModeTransparent))
+
+		if len(isvc.Spec.Predictor.InitContainers) != 0 {
+			t.Errorf("expected no init containers, got %d", len(isvc.Spec.Predictor.InitContainers))
+		}
+		container := isvc.Spec.Predictor.Containers[0]
+		if len(container.VolumeMounts) != 2 {
+			t.Errorf("expected cache and key mounts, got %+v", container.VolumeMounts)
+		}
+		found := false
+		for _, env := range container.Env {
+			found = found || env.Name == constants.EnvAIMCacheEncryptionKeyFile
+		}
+		if !found {
+			t.Error("expected the key file env var on the inference container")
+		}
+	})
+}
+
+func TestIsTemplateCacheUsableForService_Encryption(t *testing.T) {
+	service := NewService("svc").Build()
+	key := &aimv1alpha1.AIMStorageEncryptionConfig{
+		KMSSecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "model-key"}, Key: "k"},
+	}
+	otherKey := key.DeepCopy()
+	otherKey.KMSSecretRef.Name = "other-key"
+
+	plain := &aimv1alpha1.AIMTemplateCache{Spec: aimv1alpha1.AIMTemplateCacheSpec{Mode: aimv1alpha1.TemplateCacheModeShared}}
+	encrypted := plain.DeepCopy()
+	encrypted.Spec.Encryption = key.DeepCopy()
+
+	if !isTemplateCacheUsableForService(plain, service, nil, "") {
+		t.Error("expected plain cache to be usable without encryption")
+	}
+	if isTemplateCacheUsableForService(plain, service, key, "") {
+		t.Error("expected plain cache to be rejected when encryption is required")
+	}
+	if isTemplateCacheUsableForService(encrypted, service, nil, "") {
+		t.Error("expected encrypted cache to be rejected without encryption")
+	}
+	if isTemplateCacheUsableForService(encrypted, service, otherKey, "") {
+		t.Error("expected cache encrypted with another key to be rejected")
+	}
+	if !isTemplateCacheUsableForService(encrypted, service, key, "") {
+		t.Error("expected cache encrypted with the same key to be usable")
+	}
+}
+
 // ============================================================================
 // PLAN INFERENCE SERVICE TESTS - MUTABLE FIELD PROPAGATION
 // ============================================================================
@@ -500,6 +626,203 @@ func TestPlanInferenceService_UpdatesReplicasWhenISVCExists(t *testing.T) {
 	}
 }
 
+func TestBuildInferenceService_CPUCompute(t *testing.T) {
+	service := NewService("svc").WithModelImage("test-image:v1").Build()
+	templateSpec := &aimv1alpha1.AIMServiceTemplateSpecCommon{
+		ModelName: testModelName,
+		Compute:   aimv1alpha1.AIMComputeModeCPU,
+	}
+	cpuLimit := resource.MustParse("8")
+	templateStatus := &aimv1alpha1.AIMServiceTemplateStatus{
+		Status: constants.AIMStatusReady,
+		ResolvedHardware: &aimv1alpha1.AIMHardwareRequirements{
+			GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 1, Model: "MI300X"},
+			CPU: &aimv1alpha1.AIMCpuRequirements{Requests: resource.MustParse("4"), Limits: &cpuLimit},
+		},
+		ResolvedNodeAffinity: &corev1.NodeAffinity{},
+	}
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{service: service}}
+
+	isvc := buildInferenceService(service, "test-template", templateSpec, templateStatus, obs)
+	container := isvc.Spec.Predictor.Containers[0]
+
+	if _, ok := container.Resources.Requests[corev1.ResourceName(constants.DefaultGPUResourceName)]; ok {
+		t.Error("expected no GPU request in CPU mode")
+	}
+	if got := container.Resources.Requests[corev1.ResourceCPU]; got.Cmp(resource.MustParse("4")) != 0 {
+		t.Errorf("expected CPU request 4, got %s", got.String())
+	}
+	if got := container.Resources.Limits[corev1.ResourceCPU]; got.Cmp(cpuLimit) != 0 {
+		t.Errorf("expected CPU limit 8, got %s", got.String())
+	}
+	if isvc.Spec.Predictor.Affinity != nil {
+		t.Error("expected no GPU node affinity in CPU mode")
+	}
+
+	env := map[string]string{}
+	for _, e := range container.Env {
+		env[e.Name] = e.Value
+	}
+	if env[constants.EnvAIMDevice] != "cpu" {
+		t.Errorf("expected %s=cpu, got %q", constants.EnvAIMDevice, env[constants.EnvAIMDevice])
+	}
+	if !strings.Contains(env["AIM_ENGINE_ARGS"], `"device":"cpu"`) {
+		t.Errorf("expected CPU engine args, got %q", env["AIM_ENGINE_ARGS"])
+	}
+}
+
+func TestBuildInferenceService_Scheduling(t *testing.T) {
+	service := NewService("svc").WithModelImage("test-image:v1").Build()
+	service.Spec.Scheduling = &aimv1alpha1.AIMSchedulingConfig{
+		NodeSelector: map[string]string{"pool": "inference"},
+	}
+	templateSpec := &aimv1alpha1.AIMServiceTemplateSpecCommon{ModelName: testModelName}
+	templateStatus := &aimv1alpha1.AIMServiceTemplateStatus{
+		Status: constants.AIMStatusReady,
+		ResolvedNodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key: "gpu", Operator: corev1.NodeSelectorOpIn, Values: []string{"MI300X"},
+					}},
+				}},
+			},
+		},
+	}
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service: service,
+		mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
+			Value: &aimv1alpha1.AIMRuntimeConfigCommon{
+				AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+					Scheduling: &aimv1alpha1.AIMSchedulingConfig{
+						NodeSelector: map[string]string{"pool": "gpu", "zone": "a"},
+						Tolerations: []corev1.Toleration{{
+							Key: "amd.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule,
+						}},
+						Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+									MatchExpressions: []corev1.NodeSelectorRequirement{{
+										Key: "rack", Operator: corev1.NodeSelectorOpExists,
+									}},
+								}},
+							},
+						}},
+					},
+				},
+			},
+		},
+	}}
+
+	isvc := buildInferenceService(service, "test-template", templateSpec, templateStatus, obs)
+	predictor := isvc.Spec.Predictor
+
+	if predictor.NodeSelector["pool"] != "inference" || predictor.NodeSelector["zone"] != "a" {
+		t.Errorf("expected merged node selector with service precedence, got %v", predictor.NodeSelector)
+	}
+	if len(predictor.Tolerations) != 1 || predictor.Tolerations[0].Key != "amd.com/gpu" {
+		t.Errorf("expected runtime config toleration, got %v", predictor.Tolerations)
+	}
+	terms := predictor.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) != 1 || len(terms[0].MatchExpressions) != 2 {
+		t.Errorf("expected GPU and configured node affinity to be combined, got %+v", terms)
+	}
+}
+
+func TestBuildInferenceService_ModelClass(t *testing.T) {
+	service := NewService("svc").WithModelImage("test-image:v1").Build()
+	templateStatus := &aimv1alpha1.AIMServiceTemplateStatus{
+		Status: constants.AIMStatusReady,
+		ResolvedHardware: &aimv1alpha1.AIMHardwareRequirements{
+			GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 1, Model: "MI300X"},
+		},
+	}
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{service: service}}
+
+	tests := []struct {
+		name       string
+		modelClass aimv1alpha1.AIMModelClass
+		wantShm    bool
+		wantProbe  string
+		wantCPU    string
+	}{
+		{name: "llm by default", wantShm: true, wantCPU: "4"},
+		{name: "embedding", modelClass: aimv1alpha1.AIMModelClassEmbedding, wantProbe: "/v1/embeddings", wantCPU: "2"},
+		{name: "reranker", modelClass: aimv1alpha1.AIMModelClassReranker, wantProbe: "/v1/rerank", wantCPU: "2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templateSpec := &aimv1alpha1.AIMServiceTemplateSpecCommon{ModelName: testModelName, ModelClass: tt.modelClass}
+			isvc := buildInferenceService(service, "test-template", templateSpec, templateStatus, obs)
+			container := isvc.Spec.Predictor.Containers[0]
+
+			hasShm := false
+			for _, v := range isvc.Spec.Predictor.Volumes {
+				hasShm = hasShm || v.Name == constants.VolumeSharedMemory
+			}
+			if hasShm != tt.wantShm {
+				t.Errorf("expected shared memory volume %v, got %v", tt.wantShm, hasShm)
+			}
+
+			hasMetrics := false
+			for _, e := range container.Env {
+				hasMetrics = hasMetrics || e.Name == constants.EnvVLLMEnableMetrics
+			}
+			if hasMetrics != tt.wantShm {
+				t.Errorf("expected %s only for LLMs, got %v", constants.EnvVLLMEnableMetrics, hasMetrics)
+			}
+
+			gotProbe := ""
+			if container.ReadinessProbe != nil {
+				gotProbe = container.ReadinessProbe.HTTPGet.Path
+			}
+			if gotProbe != tt.wantProbe {
+				t.Errorf("expected readiness probe on %q, got %q", tt.wantProbe, gotProbe)
+			}
+
+			if got := container.Resources.Requests[corev1.ResourceCPU]; got.Cmp(resource.MustParse(tt.wantCPU)) != 0 {
+				t.Errorf("expected CPU request %s, got %s", tt.wantCPU, got.String())
+			}
+		})
+	}
+}
+
+func TestResolveModelClass(t *testing.T) {
+	obs := ServiceObservation{}
+	if got := resolveModelClass(nil, obs); got != aimv1alpha1.AIMModelClassLLM {
+		t.Errorf("expected llm default, got %s", got)
+	}
+
+	obs.modelResult.ClusterModel.Value = &aimv1alpha1.AIMClusterModel{
+		Spec: aimv1alpha1.AIMModelSpec{ModelClass: aimv1alpha1.AIMModelClassEmbedding},
+	}
+	if got := resolveModelClass(&aimv1alpha1.AIMServiceTemplateSpecCommon{}, obs); got != aimv1alpha1.AIMModelClassEmbedding {
+		t.Errorf("expected the model class, got %s", got)
+	}
+
+	template := &aimv1alpha1.AIMServiceTemplateSpecCommon{ModelClass: aimv1alpha1.AIMModelClassReranker}
+	if got := resolveModelClass(template, obs); got != aimv1alpha1.AIMModelClassReranker {
+		t.Errorf("expected the template class to win, got %s", got)
+	}
+}
+
+func TestResolveComputeMode(t *testing.T) {
+	cpuTemplate := &aimv1alpha1.AIMServiceTemplateSpecCommon{Compute: aimv1alpha1.AIMComputeModeCPU}
+
+	service := NewService("svc").Build()
+	if got := resolveComputeMode(service, nil); got != aimv1alpha1.AIMComputeModeGPU {
+		t.Errorf("expected gpu default, got %s", got)
+	}
+	if got := resolveComputeMode(service, cpuTemplate); got != aimv1alpha1.AIMComputeModeCPU {
+		t.Errorf("expected template compute mode, got %s", got)
+	}
+	service.Spec.Compute = aimv1alpha1.AIMComputeModeGPU
+	if got := resolveComputeMode(service, cpuTemplate); got != aimv1alpha1.AIMComputeModeGPU {
+		t.Errorf("expected service compute mode to win, got %s", got)
+	}
+}
+
 // ============================================================================
 // RESOLVE RESOURCES TESTS
 // ============================================================================
@@ -582,7 +905,7 @@ func TestResolveResources(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := resolveResources(tt.service, tt.templateSpec, tt.gpuCount, corev1.ResourceName(constants.DefaultGPUResourceName))
+			result := resolveResources(tt.service, tt.templateSpec, tt.gpuCount, corev1.ResourceName(constants.DefaultGPUResourceName), defaultGPUResourceRatios())
 
 			if tt.expectGPU {
 				gpuQty := result.Requests[corev1.ResourceName(constants.DefaultGPUResourceName)]
@@ -645,7 +968,7 @@ func TestDefaultResourceRequirementsForGPU(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := defaultResourceRequirementsForGPU(tt.gpuCount)
+			result := defaultResourceRequirementsForGPU(tt.gpuCount, defaultGPUResourceRatios())
 
 			if tt.expectZero {
 				if len(result.Requests) != 0 || len(result.Limits) != 0 {
@@ -924,6 +1247,126 @@ func TestBuildMergedEnvVars_ServiceOverridesAll(t *testing.T) {
 	}
 }
 
+func TestBuildMergedEnvVars_Proxy(t *testing.T) {
+	service := &aimv1alpha1.AIMService{
+		Spec: aimv1alpha1.AIMServiceSpec{
+			AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+				Env: []corev1.EnvVar{
+					{Name: "NO_PROXY", Value: "from-service"},
+				},
+			},
+		},
+	}
+	obs := ServiceObservation{
+		ServiceFetchResult: ServiceFetchResult{
+			mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
+				Value: &aimv1alpha1.AIMRuntimeConfigCommon{
+					Proxy: &aimv1alpha1.AIMProxyConfig{
+						HTTPSProxy: "http://proxy.corp:3128",
+						NoProxy:    []string{".svc", ".cluster.local"},
+					},
+				},
+			},
+		},
+	}
+
+	result := buildMergedEnvVars(service, &aimv1alpha1.AIMServiceTemplateSpecCommon{}, obs)
+
+	envMap := make(map[string]string)
+	for _, env := range result {
+		envMap[env.Name] = env.Value
+	}
+
+	if envMap["HTTPS_PROXY"] != "http://proxy.corp:3128" || envMap["https_proxy"] != "http://proxy.corp:3128" {
+		t.Errorf("expected HTTPS proxy in both cases, got %q and %q", envMap["HTTPS_PROXY"], envMap["https_proxy"])
+	}
+	if _, ok := envMap["HTTP_PROXY"]; ok {
+		t.Error("expected HTTP_PROXY not to be set when httpProxy is empty")
+	}
+	// Explicit env vars win over the proxy settings
+	if envMap["NO_PROXY"] != "from-service" {
+		t.Errorf("expected NO_PROXY='from-service', got %q", envMap["NO_PROXY"])
+	}
+	if envMap["no_proxy"] != ".svc,.cluster.local" {
+		t.Errorf("expected no_proxy='.svc,.cluster.local', got %q", envMap["no_proxy"])
+	}
+}
+
+func TestBuildMergedEnvVars_Logging(t *testing.T) {
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{
+		AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+			Env: []corev1.EnvVar{{Name: constants.EnvAIMLogLevel, Value: "ERROR"}},
+			Logging: &aimv1alpha1.AIMLoggingConfig{
+				Level:  aimv1alpha1.LogLevelWarning,
+				Format: aimv1alpha1.LogFormatJSON,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		logging  *aimv1alpha1.AIMLoggingConfig
+		env      []corev1.EnvVar
+		expected map[string]string
+	}{
+		{
+			name: "runtime config logging overrides runtime config env",
+			expected: map[string]string{
+				constants.EnvAIMLogLevel:  "WARNING",
+				constants.EnvAIMLogFormat: "json",
+			},
+		},
+		{
+			name:    "service fields override runtime config fields",
+			logging: &aimv1alpha1.AIMLoggingConfig{Level: aimv1alpha1.LogLevelInfo},
+			expected: map[string]string{
+				constants.EnvAIMLogLevel:  "INFO",
+				constants.EnvAIMLogFormat: "json",
+			},
+		},
+		{
+			name:    "debug overrides levels",
+			logging: &aimv1alpha1.AIMLoggingConfig{Level: aimv1alpha1.LogLevelInfo, Debug: ptr.To(true)},
+			expected: map[string]string{
+				constants.EnvAIMLogLevel:     "DEBUG",
+				constants.EnvAIMLogLevelRoot: "DEBUG",
+			},
+		},
+		{
+			name:    "explicit service env wins",
+			logging: &aimv1alpha1.AIMLoggingConfig{Debug: ptr.To(true)},
+			env:     []corev1.EnvVar{{Name: constants.EnvAIMLogLevel, Value: "CRITICAL"}},
+			expected: map[string]string{
+				constants.EnvAIMLogLevel:     "CRITICAL",
+				constants.EnvAIMLogLevelRoot: "DEBUG",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService("svc").Build()
+			service.Spec.Logging = tt.logging
+			service.Spec.Env = tt.env
+			obs := ServiceObservation{
+				ServiceFetchResult: ServiceFetchResult{
+					mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Value: runtimeConfig},
+				},
+			}
+
+			envMap := make(map[string]string)
+			for _, env := range buildMergedEnvVars(service, nil, obs) {
+				envMap[env.Name] = env.Value
+			}
+			for name, value := range tt.expected {
+				if envMap[name] != value {
+					t.Errorf("expected %s=%q, got %q", name, value, envMap[name])
+				}
+			}
+		})
+	}
+}
+
 func TestBuildMergedEnvVars_ClusterTemplateEnv(t *testing.T) {
 	// Test that env vars from cluster template spec (via common spec) propagate to inference service
 	service := &aimv1alpha1.AIMService{}
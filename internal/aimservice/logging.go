@@ -0,0 +1,89 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// resolveLogging merges the service logging settings over the runtime config ones, field by field.
+func resolveLogging(
+	service *aimv1alpha1.AIMService,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) *aimv1alpha1.AIMLoggingConfig {
+	var resolved *aimv1alpha1.AIMLoggingConfig
+	if runtimeConfig != nil && runtimeConfig.Logging != nil {
+		resolved = runtimeConfig.Logging.DeepCopy()
+	}
+
+	override := service.Spec.Logging
+	if override == nil {
+		return resolved
+	}
+	if resolved == nil {
+		return override.DeepCopy()
+	}
+
+	if override.Level != "" {
+		resolved.Level = override.Level
+	}
+	if override.RootLevel != "" {
+		resolved.RootLevel = override.RootLevel
+	}
+	if override.Format != "" {
+		resolved.Format = override.Format
+	}
+	if override.Debug != nil {
+		resolved.Debug = override.Debug
+	}
+	return resolved
+}
+
+// loggingEnvVars returns the AIM logging env vars for the settings. Unset fields keep the image defaults.
+func loggingEnvVars(logging *aimv1alpha1.AIMLoggingConfig) []corev1.EnvVar {
+	if logging == nil {
+		return nil
+	}
+
+	level, rootLevel := logging.Level, logging.RootLevel
+	if logging.Debug != nil && *logging.Debug {
+		level, rootLevel = aimv1alpha1.LogLevelDebug, aimv1alpha1.LogLevelDebug
+	}
+
+	var envVars []corev1.EnvVar
+	if level != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: constants.EnvAIMLogLevel, Value: string(level)})
+	}
+	if rootLevel != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: constants.EnvAIMLogLevelRoot, Value: string(rootLevel)})
+	}
+	if logging.Format != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: constants.EnvAIMLogFormat, Value: strings.ToLower(string(logging.Format))})
+	}
+	return envVars
+}
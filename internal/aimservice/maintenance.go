@@ -0,0 +1,173 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// maintenanceCheck is the state of the service's maintenance window at reconcile time.
+type maintenanceCheck struct {
+	// err is set when the schedule or time zone is invalid
+	err error
+	// open is true while a window is open; closesAt is when it closes
+	open     bool
+	closesAt time.Time
+	// opensAt is when the next window opens, zero if the schedule never fires
+	opensAt time.Time
+	// deferred is true when a disruptive InferenceService change waits for the next window
+	deferred bool
+}
+
+// evaluateMaintenanceWindow checks whether the window is open at now.
+// Returns nil when the service has no maintenance window.
+func evaluateMaintenanceWindow(window *aimv1alpha1.AIMMaintenanceWindow, now time.Time) *maintenanceCheck {
+	if window == nil {
+		return nil
+	}
+	schedule, err := utils.ParseCronSchedule(window.Schedule)
+	if err != nil {
+		return &maintenanceCheck{err: invalidMaintenanceWindow(err)}
+	}
+	timeZone := window.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return &maintenanceCheck{err: invalidMaintenanceWindow(fmt.Errorf("unknown time zone %q", timeZone))}
+	}
+
+	now = now.In(loc)
+	check := &maintenanceCheck{opensAt: schedule.Next(now)}
+	if start, ok := schedule.LastWithin(now, window.Duration.Duration); ok {
+		check.open = true
+		check.closesAt = start.Add(window.Duration.Duration)
+	}
+	return check
+}
+
+func invalidMaintenanceWindow(err error) error {
+	return controllerutils.NewInvalidSpecFieldError(aimv1alpha1.AIMServiceReasonInvalidMaintenanceWindow,
+		"spec.maintenanceWindow", fmt.Sprintf("Invalid maintenance window: %v", err), err)
+}
+
+// checkMaintenanceWindow evaluates the window and, while it is closed, whether the planned
+// InferenceService would restart the running predictor pods.
+func (obs ServiceObservation) checkMaintenanceWindow(ctx context.Context, now time.Time) *maintenanceCheck {
+	check := evaluateMaintenanceWindow(obs.service.Spec.MaintenanceWindow, now)
+	if check == nil || check.err != nil || check.open {
+		return check
+	}
+	if !obs.inferenceService.OK() || obs.inferenceService.Value == nil {
+		// Creating the InferenceService does not disrupt anything
+		return check
+	}
+	if desired := obs.desiredInferenceService(ctx); desired != nil {
+		check.deferred = isDisruptiveChange(desired, obs.inferenceService.Value)
+		if check.deferred {
+			log.FromContext(ctx).V(1).Info("deferring disruptive InferenceService change until the maintenance window",
+				"opensAt", check.opensAt)
+		}
+	}
+	return check
+}
+
+// desiredInferenceService builds the InferenceService that PlanResources would apply, or nil
+// when the template is not resolved and ready.
+func (obs ServiceObservation) desiredInferenceService(ctx context.Context) *servingv1beta1.InferenceService {
+	templateName, _, templateSpec, templateStatus := obs.getResolvedTemplate()
+	if templateName == "" || templateStatus == nil || templateStatus.Status != constants.AIMStatusReady {
+		return nil
+	}
+	isvcTemplateSpec, isvcTemplateStatus := applyOverridesInPlace(obs.service, templateSpec, templateStatus)
+	isvc, _ := planInferenceService(ctx, obs.service, templateName, isvcTemplateSpec, isvcTemplateStatus, obs).(*servingv1beta1.InferenceService)
+	return isvc
+}
+
+// isDisruptiveChange reports whether applying desired would replace the predictor pods of
+// existing. Only fields set in desired are compared, so server-side defaults do not count as
+// changes. Replica counts, autoscaling and the deployment strategy are not disruptive.
+func isDisruptiveChange(desired, existing *servingv1beta1.InferenceService) bool {
+	d, e := &desired.Spec.Predictor, &existing.Spec.Predictor
+	return !equality.Semantic.DeepDerivative(d.PodSpec, e.PodSpec) ||
+		!equality.Semantic.DeepDerivative(d.Labels, e.Labels) ||
+		!equality.Semantic.DeepDerivative(d.Annotations, e.Annotations) ||
+		!equality.Semantic.DeepDerivative(d.Logger, e.Logger)
+}
+
+// getMaintenanceWindowHealth reports an invalid maintenance window.
+func (obs ServiceObservation) getMaintenanceWindowHealth() controllerutils.ComponentHealth {
+	health := controllerutils.ComponentHealth{
+		Component:      "MaintenanceWindow",
+		DependencyType: controllerutils.DependencyTypeUpstream,
+	}
+	if err := obs.maintenance.err; err != nil {
+		health.State = constants.AIMStatusFailed
+		health.Errors = []error{err}
+		return health
+	}
+	health.State = constants.AIMStatusReady
+	health.Reason = "MaintenanceWindowValid"
+	return health
+}
+
+// setChangesPendingCondition reports whether disruptive changes wait for the maintenance window.
+// The condition is removed when no window is configured or the window is invalid.
+func setChangesPendingCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	check := obs.maintenance
+	if check == nil || check.err != nil {
+		cm.Delete(aimv1alpha1.AIMServiceConditionChangesPending)
+		return
+	}
+	switch {
+	case check.deferred:
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionChangesPending, aimv1alpha1.AIMServiceReasonAwaitingMaintenance,
+			fmt.Sprintf("Changes that restart the predictor pods are deferred until the maintenance window opens at %s",
+				formatWindowTime(check.opensAt)))
+	case check.open:
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionChangesPending, aimv1alpha1.AIMServiceReasonNoChangesPending,
+			fmt.Sprintf("Maintenance window is open until %s", formatWindowTime(check.closesAt)))
+	default:
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionChangesPending, aimv1alpha1.AIMServiceReasonNoChangesPending,
+			fmt.Sprintf("No changes are pending; the next maintenance window opens at %s", formatWindowTime(check.opensAt)))
+	}
+}
+
+func formatWindowTime(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return t.Format(time.RFC3339)
+}
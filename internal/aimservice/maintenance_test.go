@@ -0,0 +1,160 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func weekendWindow() *aimv1alpha1.AIMMaintenanceWindow {
+	return &aimv1alpha1.AIMMaintenanceWindow{
+		Schedule: "0 2 * * SAT",
+		Duration: metav1.Duration{Duration: 4 * time.Hour},
+		TimeZone: "Europe/Helsinki",
+	}
+}
+
+func TestEvaluateMaintenanceWindow(t *testing.T) {
+	helsinki, err := time.LoadLocation("Europe/Helsinki")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	// 2026-01-03 is a Saturday
+	opens := time.Date(2026, 1, 3, 2, 0, 0, 0, helsinki)
+
+	open := evaluateMaintenanceWindow(weekendWindow(), opens.Add(time.Hour).UTC())
+	if open.err != nil || !open.open || !open.closesAt.Equal(opens.Add(4*time.Hour)) {
+		t.Errorf("expected the window to be open until 06:00, got %+v", open)
+	}
+
+	closed := evaluateMaintenanceWindow(weekendWindow(), opens.Add(-time.Hour).UTC())
+	if closed.err != nil || closed.open || !closed.opensAt.Equal(opens) {
+		t.Errorf("expected the window to be closed until 02:00, got %+v", closed)
+	}
+
+	if evaluateMaintenanceWindow(nil, opens) != nil {
+		t.Error("expected no check without a maintenance window")
+	}
+
+	for _, window := range []*aimv1alpha1.AIMMaintenanceWindow{
+		{Schedule: "0 2 * *", Duration: metav1.Duration{Duration: time.Hour}},
+		{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+	} {
+		check := evaluateMaintenanceWindow(window, opens)
+		if check.err == nil {
+			t.Fatalf("expected %+v to be invalid", window)
+		}
+		if reason := controllerutils.CategorizeError(check.err).Reason(); reason != aimv1alpha1.AIMServiceReasonInvalidMaintenanceWindow {
+			t.Errorf("reason = %q, want %q", reason, aimv1alpha1.AIMServiceReasonInvalidMaintenanceWindow)
+		}
+	}
+}
+
+func TestIsDisruptiveChange(t *testing.T) {
+	service := NewService("svc").Build()
+	desired := buildInferenceService(service, "tmpl", nil, nil, ServiceObservation{})
+
+	// The API server fills in defaults that the operator does not plan
+	existing := desired.DeepCopy()
+	existing.Spec.Predictor.Containers[0].TerminationMessagePath = corev1.TerminationMessagePathDefault
+	existing.Spec.Predictor.Containers[0].ImagePullPolicy = corev1.PullIfNotPresent
+	if isDisruptiveChange(desired, existing) {
+		t.Error("expected server defaults not to count as a change")
+	}
+
+	scaled := desired.DeepCopy()
+	scaled.Spec.Predictor.MinReplicas = ptr.To(int32(3))
+	if isDisruptiveChange(scaled, existing) {
+		t.Error("expected a replica change not to be disruptive")
+	}
+
+	changed := desired.DeepCopy()
+	changed.Spec.Predictor.Containers[0].Image = "registry.example.com/aim:new"
+	if !isDisruptiveChange(changed, existing) {
+		t.Error("expected an image change to be disruptive")
+	}
+
+	withEnv := desired.DeepCopy()
+	withEnv.Spec.Predictor.Containers[0].Env = append(withEnv.Spec.Predictor.Containers[0].Env,
+		corev1.EnvVar{Name: "EXTRA", Value: "1"})
+	if !isDisruptiveChange(withEnv, existing) {
+		t.Error("expected an added env var to be disruptive")
+	}
+}
+
+func TestSetChangesPendingCondition(t *testing.T) {
+	opensAt := time.Date(2026, 1, 3, 2, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		check      *maintenanceCheck
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{name: "no window"},
+		{name: "invalid", check: &maintenanceCheck{err: invalidMaintenanceWindow(errors.New("bad schedule"))}},
+		{name: "deferred", check: &maintenanceCheck{opensAt: opensAt, deferred: true},
+			wantStatus: metav1.ConditionTrue, wantReason: aimv1alpha1.AIMServiceReasonAwaitingMaintenance},
+		{name: "open", check: &maintenanceCheck{open: true, closesAt: opensAt},
+			wantStatus: metav1.ConditionFalse, wantReason: aimv1alpha1.AIMServiceReasonNoChangesPending},
+		{name: "closed without changes", check: &maintenanceCheck{opensAt: opensAt},
+			wantStatus: metav1.ConditionFalse, wantReason: aimv1alpha1.AIMServiceReasonNoChangesPending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := controllerutils.NewConditionManager(nil)
+			setChangesPendingCondition(cm, ServiceObservation{maintenance: tt.check})
+			cond := cm.Get(aimv1alpha1.AIMServiceConditionChangesPending)
+			if tt.wantStatus == "" {
+				if cond != nil {
+					t.Errorf("expected no condition, got %+v", cond)
+				}
+				return
+			}
+			if cond == nil || cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("got %+v, want %s/%s", cond, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestPlanResourcesRequeuesForMaintenanceWindow(t *testing.T) {
+	existing := &servingv1beta1.InferenceService{}
+	obs := ServiceObservation{maintenance: &maintenanceCheck{deferred: true, opensAt: time.Now().Add(time.Hour)}}
+	obs.service = NewService("svc").Build()
+	obs.inferenceService.Value = existing
+
+	result := (&ServiceReconciler{}).PlanResources(testContext(), controllerutils.ReconcileContext[*aimv1alpha1.AIMService]{}, obs)
+	if result.RequeueAfter <= 55*time.Minute || result.RequeueAfter > time.Hour {
+		t.Errorf("RequeueAfter = %s, want about an hour", result.RequeueAfter)
+	}
+}
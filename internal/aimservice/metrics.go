@@ -0,0 +1,216 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// metricsCollectTimeout bounds how long a single scrape may spend reading from the cache.
+const metricsCollectTimeout = 10 * time.Second
+
+var serviceMetricLabels = []string{"namespace", "service", "model"}
+
+var (
+	serviceReadyDesc = prometheus.NewDesc(
+		"aim_service_ready",
+		"Whether the AIMService Ready condition is true (1) or not (0).",
+		serviceMetricLabels, nil,
+	)
+	serviceRequestedGPUsDesc = prometheus.NewDesc(
+		"aim_service_requested_gpus",
+		"Total GPUs requested by the AIMService (GPUs per replica times desired replicas).",
+		serviceMetricLabels, nil,
+	)
+	serviceDesiredReplicasDesc = prometheus.NewDesc(
+		"aim_service_desired_replicas",
+		"Desired replica count of the AIMService.",
+		serviceMetricLabels, nil,
+	)
+	serviceCurrentReplicasDesc = prometheus.NewDesc(
+		"aim_service_current_replicas",
+		"Current replica count of the AIMService.",
+		serviceMetricLabels, nil,
+	)
+	serviceCachedModelBytesDesc = prometheus.NewDesc(
+		"aim_service_model_cached_bytes",
+		"Size in bytes of the model artifacts cached for the AIMService.",
+		serviceMetricLabels, nil,
+	)
+)
+
+// ServiceMetricsCollector publishes per-AIMService gauges for chargeback dashboards.
+// Values are computed from the manager cache at scrape time, so series for deleted
+// services disappear without explicit cleanup.
+type ServiceMetricsCollector struct {
+	reader client.Reader
+}
+
+// NewServiceMetricsCollector creates a collector reading from the given (typically cached) reader.
+func NewServiceMetricsCollector(reader client.Reader) *ServiceMetricsCollector {
+	return &ServiceMetricsCollector{reader: reader}
+}
+
+// Describe implements prometheus.Collector.
+func (c *ServiceMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- serviceReadyDesc
+	ch <- serviceRequestedGPUsDesc
+	ch <- serviceDesiredReplicasDesc
+	ch <- serviceCurrentReplicasDesc
+	ch <- serviceCachedModelBytesDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *ServiceMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancel()
+	logger := logf.FromContext(ctx).WithName("aimservice-metrics")
+
+	var services aimv1alpha1.AIMServiceList
+	if err := c.reader.List(ctx, &services); err != nil {
+		logger.Error(err, "failed to list AIMServices for metrics")
+		return
+	}
+
+	for i := range services.Items {
+		c.collectService(ctx, ch, &services.Items[i])
+	}
+}
+
+func (c *ServiceMetricsCollector) collectService(ctx context.Context, ch chan<- prometheus.Metric, service *aimv1alpha1.AIMService) {
+	model := ""
+	if service.Status.ResolvedModel != nil {
+		model = service.Status.ResolvedModel.Name
+	}
+	labels := []string{service.Namespace, service.Name, model}
+
+	ready := 0.0
+	if meta.IsStatusConditionTrue(service.Status.Conditions, controllerutils.ConditionTypeReady) {
+		ready = 1
+	}
+
+	desired, current := serviceReplicas(service)
+	gpus := float64(c.gpusPerReplica(ctx, service)) * float64(desired)
+
+	ch <- prometheus.MustNewConstMetric(serviceReadyDesc, prometheus.GaugeValue, ready, labels...)
+	ch <- prometheus.MustNewConstMetric(serviceRequestedGPUsDesc, prometheus.GaugeValue, gpus, labels...)
+	ch <- prometheus.MustNewConstMetric(serviceDesiredReplicasDesc, prometheus.GaugeValue, float64(desired), labels...)
+	ch <- prometheus.MustNewConstMetric(serviceCurrentReplicasDesc, prometheus.GaugeValue, float64(current), labels...)
+	ch <- prometheus.MustNewConstMetric(serviceCachedModelBytesDesc, prometheus.GaugeValue, float64(c.cachedModelBytes(ctx, service)), labels...)
+}
+
+// serviceReplicas returns the desired and current replica counts, falling back to the
+// spec when the runtime status has not been populated yet.
+func serviceReplicas(service *aimv1alpha1.AIMService) (desired, current int32) {
+	if rt := service.Status.Runtime; rt != nil {
+		return rt.DesiredReplicas, rt.CurrentReplicas
+	}
+	if service.Spec.Replicas != nil {
+		return *service.Spec.Replicas, 0
+	}
+	if service.Spec.MinReplicas != nil {
+		return *service.Spec.MinReplicas, 0
+	}
+	return 1, 0
+}
+
+// gpusPerReplica returns the GPU count of the resolved template, or 0 when unresolved
+// or when the service runs in CPU mode.
+func (c *ServiceMetricsCollector) gpusPerReplica(ctx context.Context, service *aimv1alpha1.AIMService) int32 {
+	ref := service.Status.ResolvedTemplate
+	if ref == nil || ref.Name == "" || service.Spec.Compute == aimv1alpha1.AIMComputeModeCPU {
+		return 0
+	}
+
+	var status *aimv1alpha1.AIMServiceTemplateStatus
+	if ref.Scope == aimv1alpha1.AIMResolutionScopeCluster {
+		var tmpl aimv1alpha1.AIMClusterServiceTemplate
+		if err := c.reader.Get(ctx, types.NamespacedName{Name: ref.Name}, &tmpl); err != nil {
+			return 0
+		}
+		status = &tmpl.Status
+	} else {
+		var tmpl aimv1alpha1.AIMServiceTemplate
+		if err := c.reader.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: ref.Name}, &tmpl); err != nil {
+			return 0
+		}
+		status = &tmpl.Status
+	}
+
+	if status.ResolvedHardware == nil || status.ResolvedHardware.GPU == nil {
+		return 0
+	}
+	return status.ResolvedHardware.GPU.Requests
+}
+
+// cachedModelBytes sums the effective size of the Ready artifacts backing the service's template cache.
+func (c *ServiceMetricsCollector) cachedModelBytes(ctx context.Context, service *aimv1alpha1.AIMService) int64 {
+	if service.Status.Cache == nil || service.Status.Cache.TemplateCacheRef == nil {
+		return 0
+	}
+	ref := service.Status.Cache.TemplateCacheRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = service.Namespace
+	}
+
+	var cache aimv1alpha1.AIMTemplateCache
+	if err := c.reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &cache); err != nil {
+		return 0
+	}
+
+	var total int64
+	for _, resolved := range cache.Status.Artifacts {
+		if resolved.Status != constants.AIMStatusReady {
+			continue
+		}
+		var artifact aimv1alpha1.AIMArtifact
+		if err := c.reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resolved.Name}, &artifact); err != nil {
+			continue
+		}
+		total += artifactSizeBytes(&artifact)
+	}
+	return total
+}
+
+// artifactSizeBytes returns the spec size when set, otherwise the discovered size.
+func artifactSizeBytes(artifact *aimv1alpha1.AIMArtifact) int64 {
+	if !artifact.Spec.Size.IsZero() {
+		return artifact.Spec.Size.Value()
+	}
+	if artifact.Status.DiscoveredSizeBytes != nil {
+		return *artifact.Status.DiscoveredSizeBytes
+	}
+	return 0
+}
@@ -0,0 +1,112 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func TestServiceMetricsCollector(t *testing.T) {
+	template := NewTemplate("tmpl").Build()
+	template.Status.ResolvedHardware = &aimv1alpha1.AIMHardwareRequirements{
+		GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 4, Model: "MI300X"},
+	}
+
+	discovered := int64(1000)
+	sized := &aimv1alpha1.AIMArtifact{
+		ObjectMeta: metav1.ObjectMeta{Name: "art-sized", Namespace: testNamespace},
+		Spec:       aimv1alpha1.AIMArtifactSpec{Size: resource.MustParse("2k")},
+	}
+	discoveredArtifact := &aimv1alpha1.AIMArtifact{
+		ObjectMeta: metav1.ObjectMeta{Name: "art-discovered", Namespace: testNamespace},
+		Status:     aimv1alpha1.AIMArtifactStatus{DiscoveredSizeBytes: &discovered},
+	}
+	pending := &aimv1alpha1.AIMArtifact{
+		ObjectMeta: metav1.ObjectMeta{Name: "art-pending", Namespace: testNamespace},
+		Spec:       aimv1alpha1.AIMArtifactSpec{Size: resource.MustParse("5k")},
+	}
+	cache := &aimv1alpha1.AIMTemplateCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: testNamespace},
+		Status: aimv1alpha1.AIMTemplateCacheStatus{
+			Status: constants.AIMStatusReady,
+			Artifacts: map[string]aimv1alpha1.AIMResolvedArtifact{
+				"a": {Name: "art-sized", Status: constants.AIMStatusReady},
+				"b": {Name: "art-discovered", Status: constants.AIMStatusReady},
+				"c": {Name: "art-pending", Status: constants.AIMStatusProgressing},
+			},
+		},
+	}
+
+	running := NewService("running").Build()
+	running.Status.ResolvedModel = &aimv1alpha1.AIMResolvedReference{Name: "llama"}
+	running.Status.ResolvedTemplate = &aimv1alpha1.AIMResolvedReference{
+		Name:  "tmpl",
+		Scope: aimv1alpha1.AIMResolutionScopeNamespace,
+	}
+	running.Status.Cache = &aimv1alpha1.AIMServiceCacheStatus{
+		TemplateCacheRef: &aimv1alpha1.AIMResolvedReference{Name: "cache", Namespace: testNamespace},
+	}
+	running.Status.Runtime = &aimv1alpha1.AIMServiceRuntimeStatus{DesiredReplicas: 2, CurrentReplicas: 1}
+	running.Status.Conditions = []metav1.Condition{{
+		Type:   string(constants.AIMStatusReady),
+		Status: metav1.ConditionTrue,
+	}}
+
+	pendingService := NewService("pending").Build()
+
+	collector := NewServiceMetricsCollector(newFakeClient(template, sized, discoveredArtifact, pending, cache, running, pendingService))
+
+	expected := `
+# HELP aim_service_current_replicas Current replica count of the AIMService.
+# TYPE aim_service_current_replicas gauge
+aim_service_current_replicas{model="",namespace="test-ns",service="pending"} 0
+aim_service_current_replicas{model="llama",namespace="test-ns",service="running"} 1
+# HELP aim_service_desired_replicas Desired replica count of the AIMService.
+# TYPE aim_service_desired_replicas gauge
+aim_service_desired_replicas{model="",namespace="test-ns",service="pending"} 1
+aim_service_desired_replicas{model="llama",namespace="test-ns",service="running"} 2
+# HELP aim_service_model_cached_bytes Size in bytes of the model artifacts cached for the AIMService.
+# TYPE aim_service_model_cached_bytes gauge
+aim_service_model_cached_bytes{model="",namespace="test-ns",service="pending"} 0
+aim_service_model_cached_bytes{model="llama",namespace="test-ns",service="running"} 3000
+# HELP aim_service_ready Whether the AIMService Ready condition is true (1) or not (0).
+# TYPE aim_service_ready gauge
+aim_service_ready{model="",namespace="test-ns",service="pending"} 0
+aim_service_ready{model="llama",namespace="test-ns",service="running"} 1
+# HELP aim_service_requested_gpus Total GPUs requested by the AIMService (GPUs per replica times desired replicas).
+# TYPE aim_service_requested_gpus gauge
+aim_service_requested_gpus{model="",namespace="test-ns",service="pending"} 0
+aim_service_requested_gpus{model="llama",namespace="test-ns",service="running"} 8
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected)); err != nil {
+		t.Fatal(err)
+	}
+}
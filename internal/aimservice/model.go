@@ -53,13 +53,15 @@ type ModelFetchResult struct {
 }
 
 // fetchModel resolves the model for the service.
-// It handles three modes:
+// It handles four modes:
 // 1. Model.Name - reference to existing AIMModel or AIMClusterModel
 // 2. Model.Image - container image URI (signals creation needed if no match)
 // 3. Model.Custom - custom model configuration
+// 4. Model.Alias - family or alias resolved to a concrete model version
 //
 // If a resolved model reference exists in status (which implies it was Ready when stored)
 // and is still Ready, it uses that directly. Otherwise, it re-resolves.
+// Alias references with the Latest version policy always re-resolve.
 func fetchModel(
 	ctx context.Context,
 	c client.Client,
@@ -67,9 +69,17 @@ func fetchModel(
 ) ModelFetchResult {
 	logger := log.FromContext(ctx)
 
+	alias := ""
+	if service.Spec.Model.Alias != nil {
+		alias = strings.TrimSpace(*service.Spec.Model.Alias)
+	}
+	followLatest := alias != "" && service.Spec.GetModelVersionPolicy() == aimv1alpha1.ModelVersionPolicyLatest
+
 	// Try to use previously resolved model if Ready
-	if result, shouldContinue := tryFetchResolvedModel(ctx, c, service); !shouldContinue {
-		return result
+	if !followLatest {
+		if result, shouldContinue := tryFetchResolvedModel(ctx, c, service); !shouldContinue {
+			return result
+		}
 	}
 
 	var result ModelFetchResult
@@ -136,9 +146,17 @@ func fetchModel(
 		return result
 	}
 
+	// Case 4: Model.Alias - resolve family or alias to a concrete model
+	if alias != "" {
+		logger.V(1).Info("resolving model from alias", "alias", alias, "policy", service.Spec.GetModelVersionPolicy())
+		result.Model, result.ClusterModel = resolveModelFromAlias(ctx, c, service, alias)
+		return result
+	}
+
 	// No model specified
-	result.Model.Error = controllerutils.NewInvalidSpecError(
+	result.Model.Error = controllerutils.NewInvalidSpecFieldError(
 		aimv1alpha1.AIMServiceReasonModelNotFound,
+		"spec.model",
 		"no model specified in service spec",
 		nil,
 	)
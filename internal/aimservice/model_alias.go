@@ -0,0 +1,180 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// aliasCandidate captures a model that matches an alias reference.
+type aliasCandidate struct {
+	Name    string
+	Version string
+	Scope   aimv1alpha1.AIMResolutionScope
+	Status  constants.AIMStatus
+}
+
+// resolveModelFromAlias resolves a family or alias reference to a concrete model.
+// Among the matching models, the highest Ready version wins. When no matching model is Ready,
+// the highest version overall is returned so that its progress is visible in the service health.
+func resolveModelFromAlias(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+	alias string,
+) (controllerutils.FetchResult[*aimv1alpha1.AIMModel], controllerutils.FetchResult[*aimv1alpha1.AIMClusterModel]) {
+	logger := log.FromContext(ctx)
+
+	var modelResult controllerutils.FetchResult[*aimv1alpha1.AIMModel]
+	var clusterModelResult controllerutils.FetchResult[*aimv1alpha1.AIMClusterModel]
+
+	candidates, err := findModelsWithAlias(ctx, c, service.Namespace, alias)
+	if err != nil {
+		modelResult.Error = fmt.Errorf("failed to search for models: %w", err)
+		return modelResult, clusterModelResult
+	}
+
+	if len(candidates) == 0 {
+		modelResult.Error = controllerutils.NewMissingUpstreamDependencyError(
+			aimv1alpha1.AIMServiceReasonModelAliasNotFound,
+			fmt.Sprintf("no model with family or alias %q found in namespace %s or cluster scope", alias, service.Namespace),
+			nil,
+		)
+		return modelResult, clusterModelResult
+	}
+
+	selected := selectAliasCandidate(candidates)
+	logger.V(1).Info("resolved model alias",
+		"alias", alias, "model", selected.Name, "version", selected.Version, "scope", selected.Scope)
+
+	if selected.Scope == aimv1alpha1.AIMResolutionScopeNamespace {
+		modelResult = controllerutils.Fetch(ctx, c, client.ObjectKey{
+			Namespace: service.Namespace,
+			Name:      selected.Name,
+		}, &aimv1alpha1.AIMModel{})
+	} else {
+		clusterModelResult = controllerutils.Fetch(ctx, c, client.ObjectKey{
+			Name: selected.Name,
+		}, &aimv1alpha1.AIMClusterModel{})
+	}
+	return modelResult, clusterModelResult
+}
+
+// findModelsWithAlias lists AIMModel and AIMClusterModel resources whose family or aliases match.
+func findModelsWithAlias(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	alias string,
+) ([]aliasCandidate, error) {
+	var results []aliasCandidate
+
+	if namespace != "" {
+		var modelList aimv1alpha1.AIMModelList
+		if err := c.List(ctx, &modelList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list AIMModels: %w", err)
+		}
+		for i := range modelList.Items {
+			m := &modelList.Items[i]
+			if m.Spec.MatchesAlias(alias) {
+				results = append(results, aliasCandidate{
+					Name:    m.Name,
+					Version: m.Spec.Version,
+					Scope:   aimv1alpha1.AIMResolutionScopeNamespace,
+					Status:  m.Status.Status,
+				})
+			}
+		}
+	}
+
+	var clusterModelList aimv1alpha1.AIMClusterModelList
+	if err := c.List(ctx, &clusterModelList); err != nil {
+		return nil, fmt.Errorf("failed to list AIMClusterModels: %w", err)
+	}
+	for i := range clusterModelList.Items {
+		m := &clusterModelList.Items[i]
+		if m.Spec.MatchesAlias(alias) {
+			results = append(results, aliasCandidate{
+				Name:    m.Name,
+				Version: m.Spec.Version,
+				Scope:   aimv1alpha1.AIMResolutionScopeCluster,
+				Status:  m.Status.Status,
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// selectAliasCandidate picks the best candidate for an alias reference.
+// Ordering: Ready before not Ready, then highest version, then namespace scope before cluster scope,
+// then name for determinism.
+func selectAliasCandidate(candidates []aliasCandidate) aliasCandidate {
+	sorted := make([]aliasCandidate, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		aReady := a.Status == constants.AIMStatusReady
+		bReady := b.Status == constants.AIMStatusReady
+		if aReady != bReady {
+			return aReady
+		}
+		if cmp := compareModelVersions(a.Version, b.Version); cmp != 0 {
+			return cmp > 0
+		}
+		if a.Scope != b.Scope {
+			return a.Scope == aimv1alpha1.AIMResolutionScopeNamespace
+		}
+		return a.Name < b.Name
+	})
+	return sorted[0]
+}
+
+// compareModelVersions compares two model versions.
+// Semantic versions are compared numerically and rank above non-semantic versions,
+// which are compared lexically. Returns 1 if a > b, -1 if a < b, 0 if equal.
+func compareModelVersions(a, b string) int {
+	va, errA := semver.ParseTolerant(a)
+	vb, errB := semver.ParseTolerant(b)
+	switch {
+	case errA == nil && errB == nil:
+		return va.Compare(vb)
+	case errA == nil:
+		return 1
+	case errB == nil:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
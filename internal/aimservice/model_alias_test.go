@@ -0,0 +1,160 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// ============================================================================
+// VERSION COMPARISON TESTS
+// ============================================================================
+
+func TestCompareModelVersions(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{name: "semver greater", a: "1.10.0", b: "1.9.0", want: 1},
+		{name: "semver less", a: "0.8.4", b: "0.9.0", want: -1},
+		{name: "semver equal with v prefix", a: "v1.2.0", b: "1.2.0", want: 0},
+		{name: "prerelease below release", a: "1.0.0-rc1", b: "1.0.0", want: -1},
+		{name: "semver above non-semver", a: "1.0.0", b: "nightly", want: 1},
+		{name: "non-semver below semver", a: "", b: "0.1.0", want: -1},
+		{name: "non-semver lexical", a: "beta", b: "alpha", want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareModelVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("compareModelVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// FETCH MODEL BY ALIAS TESTS
+// ============================================================================
+
+func TestFetchModel_ByAlias_SelectsHighestReadyVersion(t *testing.T) {
+	ctx := testContext()
+
+	older := NewModel("llama-v1").WithFamily("llama-3.1-8b", "1.0.0").Build()
+	newer := NewModel("llama-v2").WithFamily("llama-3.1-8b", "1.2.0").Build()
+	newestNotReady := NewModel("llama-v3").WithFamily("llama-3.1-8b", "2.0.0").
+		WithStatus(constants.AIMStatusProgressing).Build()
+	unrelated := NewModel("mistral").WithFamily("mistral-7b", "9.0.0").Build()
+	service := NewService("svc").WithModelAlias("llama-3.1-8b", aimv1alpha1.ModelVersionPolicyPinned).Build()
+
+	c := newFakeClient(older, newer, newestNotReady, unrelated)
+	result := fetchModel(ctx, c, service)
+
+	if result.Model.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Model.Error)
+	}
+	if result.Model.Value == nil || result.Model.Value.Name != "llama-v2" {
+		t.Errorf("expected llama-v2 to be selected, got %+v", result.Model.Value)
+	}
+}
+
+func TestFetchModel_ByAlias_MatchesAliasesAndPrefersNamespace(t *testing.T) {
+	ctx := testContext()
+
+	nsModel := NewModel("ns-llama").WithFamily("llama", "1.0.0").WithAliases("chat-default").Build()
+	clusterModel := NewClusterModel("cluster-llama").WithFamily("llama", "1.0.0").Build()
+	clusterModel.Spec.Aliases = []string{"chat-default"}
+	service := NewService("svc").WithModelAlias("chat-default", "").Build()
+
+	c := newFakeClient(nsModel, clusterModel)
+	result := fetchModel(ctx, c, service)
+
+	if result.Model.Value == nil || result.Model.Value.Name != "ns-llama" {
+		t.Errorf("expected namespace model ns-llama, got %+v", result.Model.Value)
+	}
+	if result.ClusterModel.Value != nil {
+		t.Error("expected cluster model to not be selected")
+	}
+}
+
+func TestFetchModel_ByAlias_NotFound(t *testing.T) {
+	ctx := testContext()
+
+	service := NewService("svc").WithModelAlias("missing", aimv1alpha1.ModelVersionPolicyPinned).Build()
+
+	c := newFakeClient()
+	result := fetchModel(ctx, c, service)
+
+	if result.Model.Error == nil {
+		t.Fatal("expected error for unknown alias")
+	}
+	se := controllerutils.CategorizeError(result.Model.Error)
+	if se.Reason() != aimv1alpha1.AIMServiceReasonModelAliasNotFound {
+		t.Errorf("expected reason %s, got %s", aimv1alpha1.AIMServiceReasonModelAliasNotFound, se.Reason())
+	}
+}
+
+func TestFetchModel_ByAlias_PinnedKeepsResolvedModel(t *testing.T) {
+	ctx := testContext()
+
+	older := NewModel("llama-v1").WithFamily("llama", "1.0.0").Build()
+	newer := NewModel("llama-v2").WithFamily("llama", "2.0.0").Build()
+	service := NewService("svc").WithModelAlias("llama", aimv1alpha1.ModelVersionPolicyPinned).Build()
+	service.Status.ResolvedModel = &aimv1alpha1.AIMResolvedReference{
+		Name:      "llama-v1",
+		Namespace: testNamespace,
+		Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
+	}
+
+	c := newFakeClient(older, newer)
+	result := fetchModel(ctx, c, service)
+
+	if result.Model.Value == nil || result.Model.Value.Name != "llama-v1" {
+		t.Errorf("expected pinned model llama-v1, got %+v", result.Model.Value)
+	}
+}
+
+func TestFetchModel_ByAlias_LatestFollowsNewVersion(t *testing.T) {
+	ctx := testContext()
+
+	older := NewModel("llama-v1").WithFamily("llama", "1.0.0").Build()
+	newer := NewModel("llama-v2").WithFamily("llama", "2.0.0").Build()
+	service := NewService("svc").WithModelAlias("llama", aimv1alpha1.ModelVersionPolicyLatest).Build()
+	service.Status.ResolvedModel = &aimv1alpha1.AIMResolvedReference{
+		Name:      "llama-v1",
+		Namespace: testNamespace,
+		Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
+	}
+
+	c := newFakeClient(older, newer)
+	result := fetchModel(ctx, c, service)
+
+	if result.Model.Value == nil || result.Model.Value.Name != "llama-v2" {
+		t.Errorf("expected latest model llama-v2, got %+v", result.Model.Value)
+	}
+}
@@ -0,0 +1,168 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const (
+	// DefaultModelReadinessPeriodSeconds is the default interval between "weights loaded" checks.
+	DefaultModelReadinessPeriodSeconds int32 = 10
+
+	// DefaultModelReadinessFailureThreshold is the default number of failed checks before restart.
+	// Together with the default period this allows 30 minutes for weights to load.
+	DefaultModelReadinessFailureThreshold int32 = 180
+)
+
+// buildModelLoadedStartupProbe converts the service's model readiness check into a startupProbe
+// for the serving container. Returns nil when no check is configured.
+func buildModelLoadedStartupProbe(check *aimv1alpha1.AIMModelReadinessCheck) *corev1.Probe {
+	if check == nil {
+		return nil
+	}
+
+	probe := &corev1.Probe{
+		PeriodSeconds:    ptr.Deref(check.PeriodSeconds, DefaultModelReadinessPeriodSeconds),
+		FailureThreshold: ptr.Deref(check.FailureThreshold, DefaultModelReadinessFailureThreshold),
+	}
+	if check.HTTPPath != "" {
+		probe.HTTPGet = &corev1.HTTPGetAction{
+			Path: check.HTTPPath,
+			Port: intstr.FromInt32(constants.DefaultHTTPPort),
+		}
+	} else {
+		probe.Exec = &corev1.ExecAction{
+			Command: []string{"test", "-f", check.File},
+		}
+	}
+	return probe
+}
+
+// buildModelClassReadinessProbe returns a readiness probe on the health endpoint of the model
+// class. Returns nil for LLMs, which keep KServe's default readiness handling.
+func buildModelClassReadinessProbe(modelClass aimv1alpha1.AIMModelClass) *corev1.Probe {
+	path := modelClass.HealthPath()
+	if path == "" {
+		return nil
+	}
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: path,
+				Port: intstr.FromInt32(constants.DefaultHTTPPort),
+			},
+		},
+		PeriodSeconds:    DefaultModelReadinessPeriodSeconds,
+		FailureThreshold: 3,
+	}
+}
+
+// podModelReadiness summarizes predictor pods for the PodReady and ModelLoaded conditions.
+type podModelReadiness struct {
+	total       int
+	running     int
+	modelLoaded int
+}
+
+// evaluatePodModelReadiness counts running pods and pods whose serving container reports the
+// model as loaded. With a startupProbe the container's Started flag only flips once the
+// weights are loaded; without one, the container's readiness is the best available signal.
+func evaluatePodModelReadiness(pods *corev1.PodList, hasStartupProbe bool) podModelReadiness {
+	var result podModelReadiness
+	if pods == nil {
+		return result
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		result.total++
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		result.running++
+
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Name != constants.ContainerKServe {
+				continue
+			}
+			loaded := cs.Ready
+			if hasStartupProbe {
+				loaded = ptr.Deref(cs.Started, false)
+			}
+			if loaded {
+				result.modelLoaded++
+			}
+		}
+	}
+	return result
+}
+
+// ReadinessGates makes Ready require ModelLoaded, since PodReady alone only says a pod is running.
+func (r *ServiceReconciler) ReadinessGates() []string {
+	return []string{aimv1alpha1.AIMServiceConditionModelLoaded}
+}
+
+// setPodAndModelConditions sets PodReady and ModelLoaded so users can tell a pod that is
+// running from a model that is actually usable. The conditions are removed when no
+// InferenceService pods were observed.
+func setPodAndModelConditions(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	if obs.inferenceServicePods == nil || !obs.inferenceServicePods.OK() {
+		cm.Delete(aimv1alpha1.AIMServiceConditionPodReady)
+		cm.Delete(aimv1alpha1.AIMServiceConditionModelLoaded)
+		return
+	}
+
+	readiness := evaluatePodModelReadiness(obs.inferenceServicePods.Value, obs.service.Spec.ModelReadiness != nil)
+
+	if readiness.running > 0 {
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionPodReady, aimv1alpha1.AIMServiceReasonPodsRunning,
+			fmt.Sprintf("%d of %d pods are running", readiness.running, readiness.total))
+	} else {
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionPodReady, aimv1alpha1.AIMServiceReasonPodsNotRunning,
+			fmt.Sprintf("0 of %d pods are running", readiness.total))
+	}
+
+	switch {
+	case readiness.modelLoaded > 0:
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionModelLoaded, aimv1alpha1.AIMServiceReasonModelLoaded,
+			fmt.Sprintf("Model is loaded in %d of %d pods", readiness.modelLoaded, readiness.total))
+	case readiness.running > 0:
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionModelLoaded, aimv1alpha1.AIMServiceReasonModelLoading,
+			"Pods are running but model weights are still loading")
+	default:
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionModelLoaded, aimv1alpha1.AIMServiceReasonPodsNotRunning,
+			"Waiting for pods to start")
+	}
+}
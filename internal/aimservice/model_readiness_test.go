@@ -0,0 +1,199 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func TestBuildModelLoadedStartupProbe(t *testing.T) {
+	if probe := buildModelLoadedStartupProbe(nil); probe != nil {
+		t.Fatalf("expected no probe without a readiness check, got %+v", probe)
+	}
+
+	httpProbe := buildModelLoadedStartupProbe(&aimv1alpha1.AIMModelReadinessCheck{HTTPPath: "/v1/models"})
+	if httpProbe.HTTPGet == nil || httpProbe.HTTPGet.Path != "/v1/models" || httpProbe.HTTPGet.Port.IntValue() != constants.DefaultHTTPPort {
+		t.Errorf("unexpected HTTP probe: %+v", httpProbe.HTTPGet)
+	}
+	if httpProbe.PeriodSeconds != DefaultModelReadinessPeriodSeconds || httpProbe.FailureThreshold != DefaultModelReadinessFailureThreshold {
+		t.Errorf("expected default timings, got period=%d threshold=%d", httpProbe.PeriodSeconds, httpProbe.FailureThreshold)
+	}
+
+	fileProbe := buildModelLoadedStartupProbe(&aimv1alpha1.AIMModelReadinessCheck{
+		File:             "/tmp/model-loaded",
+		PeriodSeconds:    ptr.To[int32](5),
+		FailureThreshold: ptr.To[int32](12),
+	})
+	if fileProbe.Exec == nil || len(fileProbe.Exec.Command) != 3 || fileProbe.Exec.Command[2] != "/tmp/model-loaded" {
+		t.Errorf("unexpected exec probe: %+v", fileProbe.Exec)
+	}
+	if fileProbe.PeriodSeconds != 5 || fileProbe.FailureThreshold != 12 {
+		t.Errorf("expected custom timings, got period=%d threshold=%d", fileProbe.PeriodSeconds, fileProbe.FailureThreshold)
+	}
+}
+
+func TestBuildInferenceService_StartupProbe(t *testing.T) {
+	service := NewService("svc").Build()
+	service.Spec.ModelReadiness = &aimv1alpha1.AIMModelReadinessCheck{HTTPPath: "/v1/models"}
+
+	isvc := buildInferenceService(service, "tmpl", nil, nil, ServiceObservation{})
+	if isvc.Spec.Predictor.Containers[0].StartupProbe == nil {
+		t.Fatal("expected startupProbe on the serving container")
+	}
+}
+
+func predictorPod(name string, phase corev1.PodPhase, started, ready bool) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    constants.ContainerKServe,
+				Started: ptr.To(started),
+				Ready:   ready,
+			}},
+		},
+	}
+}
+
+func TestSetPodAndModelConditions(t *testing.T) {
+	tests := []struct {
+		name            string
+		pods            []corev1.Pod
+		withCheck       bool
+		wantPodReady    metav1.ConditionStatus
+		wantModelLoaded metav1.ConditionStatus
+		wantModelReason string
+	}{
+		{
+			name:            "pod pending",
+			pods:            []corev1.Pod{predictorPod("p1", corev1.PodPending, false, false)},
+			withCheck:       true,
+			wantPodReady:    metav1.ConditionFalse,
+			wantModelLoaded: metav1.ConditionFalse,
+			wantModelReason: aimv1alpha1.AIMServiceReasonPodsNotRunning,
+		},
+		{
+			name:            "pod running, weights loading",
+			pods:            []corev1.Pod{predictorPod("p1", corev1.PodRunning, false, false)},
+			withCheck:       true,
+			wantPodReady:    metav1.ConditionTrue,
+			wantModelLoaded: metav1.ConditionFalse,
+			wantModelReason: aimv1alpha1.AIMServiceReasonModelLoading,
+		},
+		{
+			name:            "startup probe passed",
+			pods:            []corev1.Pod{predictorPod("p1", corev1.PodRunning, true, false)},
+			withCheck:       true,
+			wantPodReady:    metav1.ConditionTrue,
+			wantModelLoaded: metav1.ConditionTrue,
+			wantModelReason: aimv1alpha1.AIMServiceReasonModelLoaded,
+		},
+		{
+			name:            "no check configured - started alone is not enough",
+			pods:            []corev1.Pod{predictorPod("p1", corev1.PodRunning, true, false)},
+			wantPodReady:    metav1.ConditionTrue,
+			wantModelLoaded: metav1.ConditionFalse,
+			wantModelReason: aimv1alpha1.AIMServiceReasonModelLoading,
+		},
+		{
+			name:            "no check configured - container ready",
+			pods:            []corev1.Pod{predictorPod("p1", corev1.PodRunning, true, true)},
+			wantPodReady:    metav1.ConditionTrue,
+			wantModelLoaded: metav1.ConditionTrue,
+			wantModelReason: aimv1alpha1.AIMServiceReasonModelLoaded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService("svc").Build()
+			if tt.withCheck {
+				service.Spec.ModelReadiness = &aimv1alpha1.AIMModelReadinessCheck{File: "/tmp/loaded"}
+			}
+			pods := controllerutils.FetchResult[*corev1.PodList]{Value: &corev1.PodList{Items: tt.pods}}
+			obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{service: service, inferenceServicePods: &pods}}
+
+			cm := controllerutils.NewConditionManager(nil)
+			setPodAndModelConditions(cm, obs)
+
+			podReady := cm.Get(aimv1alpha1.AIMServiceConditionPodReady)
+			if podReady == nil || podReady.Status != tt.wantPodReady {
+				t.Errorf("PodReady = %+v, want %s", podReady, tt.wantPodReady)
+			}
+			modelLoaded := cm.Get(aimv1alpha1.AIMServiceConditionModelLoaded)
+			if modelLoaded == nil || modelLoaded.Status != tt.wantModelLoaded || modelLoaded.Reason != tt.wantModelReason {
+				t.Errorf("ModelLoaded = %+v, want %s/%s", modelLoaded, tt.wantModelLoaded, tt.wantModelReason)
+			}
+		})
+	}
+}
+
+func TestSetPodAndModelConditions_RemovedWithoutPods(t *testing.T) {
+	cm := controllerutils.NewConditionManager([]metav1.Condition{
+		{Type: aimv1alpha1.AIMServiceConditionPodReady, Status: metav1.ConditionTrue},
+		{Type: aimv1alpha1.AIMServiceConditionModelLoaded, Status: metav1.ConditionTrue},
+	})
+	setPodAndModelConditions(cm, ServiceObservation{ServiceFetchResult: ServiceFetchResult{service: NewService("svc").Build()}})
+
+	if cm.Get(aimv1alpha1.AIMServiceConditionPodReady) != nil || cm.Get(aimv1alpha1.AIMServiceConditionModelLoaded) != nil {
+		t.Error("expected PodReady and ModelLoaded to be removed when no pods are observed")
+	}
+}
+
+func TestServiceReconciler_ReadyRequiresModelLoaded(t *testing.T) {
+	var r any = &ServiceReconciler{}
+	gates, ok := r.(controllerutils.ReadinessGateProvider)
+	if !ok {
+		t.Fatal("expected ServiceReconciler to provide readiness gates")
+	}
+
+	service := NewService("svc").Build()
+	service.Spec.ModelReadiness = &aimv1alpha1.AIMModelReadinessCheck{File: "/tmp/loaded"}
+	pods := controllerutils.FetchResult[*corev1.PodList]{Value: &corev1.PodList{
+		Items: []corev1.Pod{predictorPod("p1", corev1.PodRunning, false, true)},
+	}}
+	cm := controllerutils.NewConditionManager(nil)
+	setPodAndModelConditions(cm, ServiceObservation{ServiceFetchResult: ServiceFetchResult{service: service, inferenceServicePods: &pods}})
+
+	if !cm.AllConditionsTrue(aimv1alpha1.AIMServiceConditionPodReady) {
+		t.Fatal("expected PodReady to be True")
+	}
+	for _, gate := range gates.ReadinessGates() {
+		if cm.AllConditionsTrue(gate) {
+			t.Errorf("expected readiness gate %s to hold Ready while the model is loading", gate)
+		}
+	}
+	if !slices.Contains(gates.ReadinessGates(), aimv1alpha1.AIMServiceConditionModelLoaded) {
+		t.Error("expected ModelLoaded to gate Ready")
+	}
+}
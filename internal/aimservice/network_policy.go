@@ -0,0 +1,250 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// dnsPort is the port inference pods may always reach for name resolution.
+const dnsPort = 53
+
+// defaultSystemNamespaces are admitted when networkPolicy.systemNamespaces is unset: the Knative
+// activator and ingress proxy requests in Serverless mode, and Prometheus scrapes the metrics
+// KEDA scales on.
+var defaultSystemNamespaces = []string{"knative-serving", "monitoring"}
+
+// GenerateNetworkPolicyName creates a deterministic name for the service's NetworkPolicy.
+func GenerateNetworkPolicyName(serviceName, namespace string) (string, error) {
+	return utils.GenerateDerivedName([]string{serviceName}, utils.WithHashSource(namespace))
+}
+
+// resolveNetworkPolicy merges the service network policy settings over the runtime config ones, field by field.
+func resolveNetworkPolicy(
+	service *aimv1alpha1.AIMService,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) *aimv1alpha1.AIMNetworkPolicyConfig {
+	var resolved *aimv1alpha1.AIMNetworkPolicyConfig
+	if runtimeConfig != nil && runtimeConfig.NetworkPolicy != nil {
+		resolved = runtimeConfig.NetworkPolicy.DeepCopy()
+	}
+
+	override := service.Spec.NetworkPolicy
+	if override == nil {
+		return resolved
+	}
+	if resolved == nil {
+		return override.DeepCopy()
+	}
+
+	if override.Enabled != nil {
+		resolved.Enabled = ptr.To(*override.Enabled)
+	}
+	if override.IngressFrom != nil {
+		resolved.IngressFrom = override.DeepCopy().IngressFrom
+	}
+	if override.SystemNamespaces != nil {
+		resolved.SystemNamespaces = append([]string(nil), override.SystemNamespaces...)
+	}
+	if override.RegistryCIDRs != nil {
+		resolved.RegistryCIDRs = append([]string(nil), override.RegistryCIDRs...)
+	}
+	return resolved
+}
+
+// validateNetworkPolicy returns an InvalidSpec error when the settings cannot be planned.
+func validateNetworkPolicy(policy *aimv1alpha1.AIMNetworkPolicyConfig) error {
+	for _, cidr := range policy.RegistryCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return controllerutils.NewInvalidSpecError(aimv1alpha1.AIMServiceReasonInvalidNetworkPolicy,
+				fmt.Sprintf("Invalid networkPolicy.registryCIDRs entry %q: must be in CIDR notation", cidr), err)
+		}
+	}
+	return nil
+}
+
+// fetchNetworkPolicy fetches the existing NetworkPolicy of the service. It is fetched even
+// when the policy is disabled, so that a policy created earlier can be removed.
+func fetchNetworkPolicy(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+) controllerutils.FetchResult[*networkingv1.NetworkPolicy] {
+	name, err := GenerateNetworkPolicyName(service.Name, service.Namespace)
+	if err != nil {
+		return controllerutils.FetchResult[*networkingv1.NetworkPolicy]{Error: err}
+	}
+	return controllerutils.Fetch(ctx, c, client.ObjectKey{
+		Namespace: service.Namespace,
+		Name:      name,
+	}, &networkingv1.NetworkPolicy{})
+}
+
+// planNetworkPolicy applies the NetworkPolicy when it is enabled and deletes a policy
+// owned by the service once it is disabled.
+func planNetworkPolicy(planResult *controllerutils.PlanResult, obs ServiceObservation) {
+	service := obs.service
+	runtimeConfig := obs.mergedRuntimeConfig.Value
+	policy := resolveNetworkPolicy(service, runtimeConfig)
+
+	if policy.IsEnabled() {
+		if validateNetworkPolicy(policy) == nil {
+			planResult.Apply(buildNetworkPolicy(service, policy, resolveGatewayNamespace(service, runtimeConfig)))
+		}
+		return
+	}
+
+	if existing := obs.networkPolicy.Value; existing != nil && metav1.IsControlledBy(existing, service) {
+		planResult.Delete(existing)
+	}
+}
+
+// resolveGatewayNamespace returns the namespace of the gateway routing to the service,
+// empty when routing is disabled or the gateway lives in the service's namespace.
+func resolveGatewayNamespace(service *aimv1alpha1.AIMService, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) string {
+	if !isRoutingEnabled(service, runtimeConfig) {
+		return ""
+	}
+	gatewayRef := resolveGatewayRef(service, runtimeConfig)
+	if gatewayRef == nil || gatewayRef.Namespace == nil || string(*gatewayRef.Namespace) == service.Namespace {
+		return ""
+	}
+	return string(*gatewayRef.Namespace)
+}
+
+// buildNetworkPolicy constructs the NetworkPolicy selecting the service's inference pods.
+// Ingress is admitted from the service's namespace, the operator namespace, which posts weight
+// reloads to the pods, the gateway namespace, the system namespaces and the configured peers.
+// Egress is limited to DNS and the registry address ranges.
+func buildNetworkPolicy(
+	service *aimv1alpha1.AIMService,
+	policy *aimv1alpha1.AIMNetworkPolicyConfig,
+	gatewayNamespace string,
+) *networkingv1.NetworkPolicy {
+	name, _ := GenerateNetworkPolicyName(service.Name, service.Namespace)
+	isvcName, _ := GenerateInferenceServiceName(service.Name, service.Namespace)
+
+	serviceLabelValue, _ := utils.SanitizeLabelValue(service.Name)
+	labels := map[string]string{
+		constants.LabelK8sComponent: constants.ComponentInference,
+		constants.LabelK8sManagedBy: constants.LabelValueManagedBy,
+		constants.LabelService:      serviceLabelValue,
+	}
+
+	systemNamespaces := policy.SystemNamespaces
+	if systemNamespaces == nil {
+		systemNamespaces = defaultSystemNamespaces
+	}
+	namespaces := append([]string{service.Namespace, constants.GetOperatorNamespace(), gatewayNamespace}, systemNamespaces...)
+
+	var ingressFrom []networkingv1.NetworkPolicyPeer
+	seen := map[string]bool{}
+	for _, namespace := range namespaces {
+		if namespace == "" || seen[namespace] {
+			continue
+		}
+		seen[namespace] = true
+		ingressFrom = append(ingressFrom, namespacePeer(namespace))
+	}
+	ingressFrom = append(ingressFrom, policy.IngressFrom...)
+
+	egress := []networkingv1.NetworkPolicyEgressRule{{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(dnsPort))},
+			{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(dnsPort))},
+		},
+	}}
+	if len(policy.RegistryCIDRs) > 0 {
+		registries := networkingv1.NetworkPolicyEgressRule{}
+		for _, cidr := range policy.RegistryCIDRs {
+			registries.To = append(registries.To, networkingv1.NetworkPolicyPeer{
+				IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+			})
+		}
+		egress = append(egress, registries)
+	}
+
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: service.Namespace,
+			Labels:    labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{constants.LabelKServeInferenceService: isvcName},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Ingress:     []networkingv1.NetworkPolicyIngressRule{{From: ingressFrom}},
+			Egress:      egress,
+		},
+	}
+}
+
+// namespacePeer selects all pods in the namespace through the name label Kubernetes sets on namespaces.
+func namespacePeer(namespace string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{corev1.LabelMetadataName: namespace},
+		},
+	}
+}
+
+// getNetworkPolicyHealth reports invalid network policy settings, which block the
+// InferenceService from being planned without its isolation.
+func (obs ServiceObservation) getNetworkPolicyHealth() (controllerutils.ComponentHealth, bool) {
+	policy := resolveNetworkPolicy(obs.service, obs.mergedRuntimeConfig.Value)
+	if !policy.IsEnabled() {
+		return controllerutils.ComponentHealth{}, false
+	}
+	health := controllerutils.ComponentHealth{
+		Component:      "NetworkPolicy",
+		DependencyType: controllerutils.DependencyTypeUpstream,
+	}
+	if err := validateNetworkPolicy(policy); err != nil {
+		health.State = constants.AIMStatusFailed
+		health.Errors = []error{err}
+		return health, true
+	}
+	health.State = constants.AIMStatusReady
+	health.Reason = "NetworkPolicyValid"
+	return health, true
+}
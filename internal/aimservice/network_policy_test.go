@@ -0,0 +1,234 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func networkPolicyObservation(
+	service *aimv1alpha1.AIMService,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+	existing *networkingv1.NetworkPolicy,
+) ServiceObservation {
+	return ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service:             service,
+		mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Value: runtimeConfig},
+		networkPolicy:       controllerutils.FetchResult[*networkingv1.NetworkPolicy]{Value: existing},
+	}}
+}
+
+func TestResolveNetworkPolicy(t *testing.T) {
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{}
+	runtimeConfig.NetworkPolicy = &aimv1alpha1.AIMNetworkPolicyConfig{
+		Enabled:       ptr.To(true),
+		RegistryCIDRs: []string{"10.0.0.0/8"},
+	}
+
+	service := NewService("svc").Build()
+	if resolveNetworkPolicy(service, nil) != nil {
+		t.Error("expected no network policy settings when none are configured")
+	}
+
+	service.Spec.NetworkPolicy = &aimv1alpha1.AIMNetworkPolicyConfig{Enabled: ptr.To(false)}
+	resolved := resolveNetworkPolicy(service, runtimeConfig)
+	if resolved.IsEnabled() || len(resolved.RegistryCIDRs) != 1 {
+		t.Errorf("expected service fields merged over runtime config, got %+v", resolved)
+	}
+	if !runtimeConfig.NetworkPolicy.IsEnabled() {
+		t.Error("runtime config must not be mutated")
+	}
+}
+
+func TestPlanNetworkPolicy(t *testing.T) {
+	service := NewService("svc").Build()
+	service.Spec.NetworkPolicy = &aimv1alpha1.AIMNetworkPolicyConfig{
+		Enabled:       ptr.To(true),
+		RegistryCIDRs: []string{"10.20.0.0/16"},
+		IngressFrom: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "monitoring"}},
+		}},
+	}
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{}
+	runtimeConfig.Routing = &aimv1alpha1.AIMRuntimeRoutingConfig{
+		Enabled: ptr.To(true),
+		GatewayRef: &gatewayapiv1.ParentReference{
+			Name:      "gateway",
+			Namespace: ptr.To(gatewayapiv1.Namespace("gateway-system")),
+		},
+	}
+
+	var planResult controllerutils.PlanResult
+	planNetworkPolicy(&planResult, networkPolicyObservation(service, runtimeConfig, nil))
+
+	toApply := planResult.GetToApply()
+	if len(toApply) != 1 {
+		t.Fatalf("expected one NetworkPolicy, got %d objects", len(toApply))
+	}
+	policy, ok := toApply[0].(*networkingv1.NetworkPolicy)
+	if !ok {
+		t.Fatalf("expected a NetworkPolicy, got %T", toApply[0])
+	}
+	isvcName, _ := GenerateInferenceServiceName(service.Name, service.Namespace)
+	if policy.Spec.PodSelector.MatchLabels[constants.LabelKServeInferenceService] != isvcName {
+		t.Errorf("pod selector = %v, want the predictor pods of %s", policy.Spec.PodSelector.MatchLabels, isvcName)
+	}
+	if len(policy.Spec.Ingress) != 1 {
+		t.Fatalf("expected one ingress rule, got %+v", policy.Spec.Ingress)
+	}
+	wantNamespaces := []string{service.Namespace, constants.GetOperatorNamespace(), "gateway-system", "knative-serving", "monitoring"}
+	if got := ingressNamespaces(policy); !slices.Equal(got, wantNamespaces) {
+		t.Errorf("ingress namespaces = %v, want %v", got, wantNamespaces)
+	}
+	from := policy.Spec.Ingress[0].From
+	if last := from[len(from)-1]; last.NamespaceSelector == nil || last.NamespaceSelector.MatchLabels["team"] != "monitoring" {
+		t.Errorf("expected the configured peer last, got %+v", last)
+	}
+	if len(policy.Spec.Egress) != 2 || policy.Spec.Egress[1].To[0].IPBlock.CIDR != "10.20.0.0/16" {
+		t.Errorf("expected egress to DNS and the registry range, got %+v", policy.Spec.Egress)
+	}
+}
+
+// ingressNamespaces returns the namespaces admitted by name label, in order.
+func ingressNamespaces(policy *networkingv1.NetworkPolicy) []string {
+	var namespaces []string
+	for _, peer := range policy.Spec.Ingress[0].From {
+		if peer.NamespaceSelector != nil {
+			if name, ok := peer.NamespaceSelector.MatchLabels[corev1.LabelMetadataName]; ok {
+				namespaces = append(namespaces, name)
+			}
+		}
+	}
+	return namespaces
+}
+
+func TestBuildNetworkPolicy_SystemNamespaces(t *testing.T) {
+	service := NewService("svc").Build()
+	operatorNamespace := constants.GetOperatorNamespace()
+
+	tests := []struct {
+		name             string
+		serviceNamespace string
+		systemNamespaces []string
+		want             []string
+	}{
+		{
+			name: "defaults admit knative and monitoring",
+			want: []string{service.Namespace, operatorNamespace, "knative-serving", "monitoring"},
+		},
+		{
+			name:             "configured namespaces replace the defaults",
+			systemNamespaces: []string{"kourier-system", "prometheus"},
+			want:             []string{service.Namespace, operatorNamespace, "kourier-system", "prometheus"},
+		},
+		{
+			name:             "empty list keeps only the operator namespace",
+			systemNamespaces: []string{},
+			want:             []string{service.Namespace, operatorNamespace},
+		},
+		{
+			name:             "duplicates are admitted once",
+			serviceNamespace: operatorNamespace,
+			systemNamespaces: []string{"monitoring", operatorNamespace, "monitoring"},
+			want:             []string{operatorNamespace, "monitoring"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := service.DeepCopy()
+			if tt.serviceNamespace != "" {
+				svc.Namespace = tt.serviceNamespace
+			}
+			policy := buildNetworkPolicy(svc, &aimv1alpha1.AIMNetworkPolicyConfig{
+				Enabled:          ptr.To(true),
+				SystemNamespaces: tt.systemNamespaces,
+			}, "")
+			if got := ingressNamespaces(policy); !slices.Equal(got, tt.want) {
+				t.Errorf("ingress namespaces = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanNetworkPolicy_DisabledDeletesOwnedPolicy(t *testing.T) {
+	service := NewService("svc").Build()
+	name, _ := GenerateNetworkPolicyName(service.Name, service.Namespace)
+	existing := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: service.Namespace,
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: aimv1alpha1.GroupVersion.String(),
+			Kind:       "AIMService",
+			Name:       service.Name,
+			UID:        service.UID,
+			Controller: ptr.To(true),
+		}},
+	}}
+
+	var planResult controllerutils.PlanResult
+	planNetworkPolicy(&planResult, networkPolicyObservation(service, nil, existing))
+	if toDelete := planResult.GetToDelete(); len(toDelete) != 1 || toDelete[0].GetName() != name {
+		t.Errorf("expected the disabled policy to be deleted, got %v", toDelete)
+	}
+
+	existing.OwnerReferences = nil
+	planResult = controllerutils.PlanResult{}
+	planNetworkPolicy(&planResult, networkPolicyObservation(service, nil, existing))
+	if toDelete := planResult.GetToDelete(); len(toDelete) != 0 {
+		t.Errorf("expected a policy not owned by the service to be kept, got %v", toDelete)
+	}
+}
+
+func TestGetNetworkPolicyHealth_InvalidCIDR(t *testing.T) {
+	service := NewService("svc").Build()
+	service.Spec.NetworkPolicy = &aimv1alpha1.AIMNetworkPolicyConfig{
+		Enabled:       ptr.To(true),
+		RegistryCIDRs: []string{"10.20.0.0"},
+	}
+	obs := networkPolicyObservation(service, nil, nil)
+
+	health, ok := obs.getNetworkPolicyHealth()
+	if !ok || health.State != constants.AIMStatusFailed || len(health.Errors) != 1 {
+		t.Fatalf("expected failed network policy health, got %+v", health)
+	}
+	if reason := controllerutils.CategorizeError(health.Errors[0]).Reason(); reason != aimv1alpha1.AIMServiceReasonInvalidNetworkPolicy {
+		t.Errorf("reason = %q, want %q", reason, aimv1alpha1.AIMServiceReasonInvalidNetworkPolicy)
+	}
+
+	var planResult controllerutils.PlanResult
+	planNetworkPolicy(&planResult, obs)
+	if len(planResult.GetToApply()) != 0 {
+		t.Error("expected no NetworkPolicy for invalid settings")
+	}
+}
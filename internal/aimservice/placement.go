@@ -0,0 +1,166 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
+)
+
+// placementRecheckInterval is how often a service that is not on its most preferred GPU model
+// checks whether that model gained free capacity.
+const placementRecheckInterval = 5 * time.Minute
+
+// placementEvaluation compares the GPU model of the service template with its GPU preference.
+type placementEvaluation struct {
+	optimal bool
+	reason  string
+	message string
+
+	// recheck is set while a more preferred GPU model could still gain capacity.
+	recheck bool
+
+	// rebalanceTo is the selection the service switches to under the Rebalance policy.
+	rebalanceTo *TemplateSelectionResult
+}
+
+// evaluatePlacement checks whether an auto-selected service with a GPU preference runs on the
+// most preferred GPU model that has free capacity. A template selected in this reconcile is
+// optimal by construction. For a previously resolved template, selection is re-run to find out
+// whether a more preferred model has gained capacity. Returns nil when the service has no GPU
+// preference or no template yet.
+func evaluatePlacement(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+	template controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate],
+	clusterTemplate controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate],
+	selected *TemplateSelectionResult,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) *placementEvaluation {
+	preference := service.Spec.Template.GPUPreference
+	if len(preference) == 0 || service.Spec.Template.Name != "" || service.Spec.Compute == aimv1alpha1.AIMComputeModeCPU {
+		return nil
+	}
+
+	var current selection.Candidate
+	var modelName string
+	switch {
+	case template.OK() && template.Value != nil && template.Value.Name != "":
+		current = selection.NamespaceCandidate(template.Value)
+		modelName = template.Value.Spec.ModelName
+	case clusterTemplate.OK() && clusterTemplate.Value != nil && clusterTemplate.Value.Name != "":
+		current = selection.ClusterCandidate(clusterTemplate.Value, nil)
+		modelName = clusterTemplate.Value.Spec.ModelName
+	default:
+		return nil
+	}
+
+	currentRank := rankOrLast(selection.GPUPreferenceRank(current, preference, nil), preference)
+	eval := &placementEvaluation{
+		optimal: true,
+		reason:  aimv1alpha1.AIMServiceReasonPreferredPlacement,
+		recheck: currentRank > 0,
+		message: fmt.Sprintf("Template %q runs on %s", current.Name, describeGPUPreference(currentRank, preference)),
+	}
+	if selected != nil || currentRank == 0 {
+		return eval
+	}
+
+	best := selectTemplateForModel(ctx, c, service, modelName, runtimeConfig)
+	if best.Error != nil {
+		log.FromContext(ctx).V(1).Info("placement check failed, keeping current template", "error", best.Error.Error())
+		return eval
+	}
+	var bestName string
+	var bestCandidate selection.Candidate
+	switch {
+	case best.SelectedTemplate != nil:
+		bestName = best.SelectedTemplate.Name
+		bestCandidate = selection.NamespaceCandidate(best.SelectedTemplate)
+	case best.SelectedClusterTemplate != nil:
+		bestName = best.SelectedClusterTemplate.Name
+		bestCandidate = selection.ClusterCandidate(best.SelectedClusterTemplate, nil)
+	default:
+		return eval
+	}
+	bestRank := rankOrLast(selection.GPUPreferenceRank(bestCandidate, preference, nil), preference)
+	if bestName == current.Name || bestRank >= currentRank {
+		return eval
+	}
+
+	eval.optimal = false
+	if service.Spec.GetPlacementPolicy() == aimv1alpha1.PlacementPolicyRebalance {
+		eval.reason = aimv1alpha1.AIMServiceReasonRebalancing
+		eval.message = fmt.Sprintf("Moving from template %q on %s to template %q on %s",
+			current.Name, describeGPUPreference(currentRank, preference), bestName, describeGPUPreference(bestRank, preference))
+		eval.rebalanceTo = best
+		return eval
+	}
+	eval.reason = aimv1alpha1.AIMServiceReasonBetterPlacementAvailable
+	eval.message = fmt.Sprintf("Template %q on %s has capacity; the service uses template %q on %s. "+
+		"Set placementPolicy to Rebalance to move automatically",
+		bestName, describeGPUPreference(bestRank, preference), current.Name, describeGPUPreference(currentRank, preference))
+	return eval
+}
+
+// rankOrLast maps candidates outside the preference to the rank after the last preferred model.
+func rankOrLast(rank int, preference []string) int {
+	if rank < 0 {
+		return len(preference)
+	}
+	return rank
+}
+
+func describeGPUPreference(rank int, preference []string) string {
+	if rank >= len(preference) {
+		return "a GPU model outside the preference"
+	}
+	return fmt.Sprintf("%s (GPU preference %d of %d)", preference[rank], rank+1, len(preference))
+}
+
+// setPlacementStatus sets the PlacementOptimal condition for services with a GPU preference.
+// The condition is kept as is while the placement could not be evaluated, e.g. before a
+// template is resolved or after a transient fetch error.
+func setPlacementStatus(cm *controllerutils.ConditionManager, service *aimv1alpha1.AIMService, placement *placementEvaluation) {
+	if len(service.Spec.Template.GPUPreference) == 0 || service.Spec.Template.Name != "" {
+		cm.Delete(aimv1alpha1.AIMServiceConditionPlacementOptimal)
+		return
+	}
+	if placement == nil {
+		return
+	}
+	if placement.optimal {
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionPlacementOptimal, placement.reason, placement.message)
+	} else {
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionPlacementOptimal, placement.reason, placement.message)
+	}
+}
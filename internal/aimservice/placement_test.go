@@ -0,0 +1,138 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func TestEvaluatePlacement(t *testing.T) {
+	ctx := testContext()
+	mi325Node := NewNode("mi325-node").WithGPUProductID("0x74a5").WithAllocatableGPUs(8).Build()
+	mi300Node := NewNode("mi300-node").WithGPUProductID("0x74a1").WithAllocatableGPUs(8).Build()
+	mi325 := NewClusterTemplate("mi325").WithModelName(testModelName).WithGPU("MI325X", 4).Build()
+	mi300 := NewClusterTemplate("mi300").WithModelName(testModelName).WithGPU("MI300X", 4).Build()
+	placed := controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{Value: mi300}
+	noTemplate := controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{}
+
+	t.Run("no preference", func(t *testing.T) {
+		service := NewService("svc").WithModelName(testModelName).Build()
+		c := newFakeClient(mi325Node, mi300Node, mi325, mi300)
+		if eval := evaluatePlacement(ctx, c, service, noTemplate, placed, nil, nil); eval != nil {
+			t.Errorf("expected no evaluation, got %+v", eval)
+		}
+	})
+
+	t.Run("better placement reported", func(t *testing.T) {
+		service := NewService("svc").WithModelName(testModelName).
+			WithGPUPreference("", "MI325X", "MI300X").Build()
+		c := newFakeClient(mi325Node, mi300Node, mi325, mi300)
+
+		eval := evaluatePlacement(ctx, c, service, noTemplate, placed, nil, nil)
+		if eval == nil || eval.optimal || eval.reason != aimv1alpha1.AIMServiceReasonBetterPlacementAvailable {
+			t.Fatalf("expected BetterPlacementAvailable, got %+v", eval)
+		}
+		if eval.rebalanceTo != nil {
+			t.Error("expected no rebalance under the Report policy")
+		}
+	})
+
+	t.Run("rebalance switches template", func(t *testing.T) {
+		service := NewService("svc").WithModelName(testModelName).
+			WithGPUPreference(aimv1alpha1.PlacementPolicyRebalance, "MI325X", "MI300X").Build()
+		c := newFakeClient(mi325Node, mi300Node, mi325, mi300)
+
+		eval := evaluatePlacement(ctx, c, service, noTemplate, placed, nil, nil)
+		if eval == nil || eval.reason != aimv1alpha1.AIMServiceReasonRebalancing || eval.rebalanceTo == nil {
+			t.Fatalf("expected rebalance, got %+v", eval)
+		}
+		if eval.rebalanceTo.SelectedClusterTemplate == nil || eval.rebalanceTo.SelectedClusterTemplate.Name != "mi325" {
+			t.Errorf("expected rebalance to mi325, got %+v", eval.rebalanceTo.SelectedClusterTemplate)
+		}
+	})
+
+	t.Run("preferred pool without capacity", func(t *testing.T) {
+		service := NewService("svc").WithModelName(testModelName).
+			WithGPUPreference(aimv1alpha1.PlacementPolicyRebalance, "MI325X", "MI300X").Build()
+		busy := gpuPod("other", "busy", "mi325-node", 6, nil)
+		c := newFakeClient(mi325Node, mi300Node, mi325, mi300, busy)
+
+		eval := evaluatePlacement(ctx, c, service, noTemplate, placed, nil, nil)
+		if eval == nil || !eval.optimal || eval.rebalanceTo != nil {
+			t.Fatalf("expected optimal placement, got %+v", eval)
+		}
+		if !eval.recheck {
+			t.Error("expected recheck while not on the most preferred model")
+		}
+	})
+
+	t.Run("fresh selection is optimal", func(t *testing.T) {
+		service := NewService("svc").WithModelName(testModelName).
+			WithGPUPreference("", "MI325X", "MI300X").Build()
+		c := newFakeClient(mi325Node, mi300Node, mi325, mi300)
+
+		eval := evaluatePlacement(ctx, c, service, noTemplate, placed, &TemplateSelectionResult{}, nil)
+		if eval == nil || !eval.optimal {
+			t.Fatalf("expected optimal placement, got %+v", eval)
+		}
+	})
+}
+
+func TestSetPlacementStatus(t *testing.T) {
+	existing := []metav1.Condition{{
+		Type:   aimv1alpha1.AIMServiceConditionPlacementOptimal,
+		Status: metav1.ConditionFalse,
+		Reason: aimv1alpha1.AIMServiceReasonBetterPlacementAvailable,
+	}}
+
+	t.Run("kept while not evaluated", func(t *testing.T) {
+		cm := controllerutils.NewConditionManager(existing)
+		service := NewService("svc").WithGPUPreference("", "MI325X").Build()
+		setPlacementStatus(cm, service, nil)
+		if cond := cm.Get(aimv1alpha1.AIMServiceConditionPlacementOptimal); cond == nil || cond.Status != metav1.ConditionFalse {
+			t.Errorf("expected condition to be kept, got %+v", cond)
+		}
+	})
+
+	t.Run("removed without preference", func(t *testing.T) {
+		cm := controllerutils.NewConditionManager(existing)
+		setPlacementStatus(cm, NewService("svc").Build(), nil)
+		if cond := cm.Get(aimv1alpha1.AIMServiceConditionPlacementOptimal); cond != nil {
+			t.Errorf("expected condition to be removed, got %+v", cond)
+		}
+	})
+
+	t.Run("optimal placement", func(t *testing.T) {
+		cm := controllerutils.NewConditionManager(existing)
+		service := NewService("svc").WithGPUPreference("", "MI325X").Build()
+		setPlacementStatus(cm, service, &placementEvaluation{optimal: true, reason: aimv1alpha1.AIMServiceReasonPreferredPlacement})
+		if cond := cm.Get(aimv1alpha1.AIMServiceConditionPlacementOptimal); cond == nil || cond.Status != metav1.ConditionTrue {
+			t.Errorf("expected True condition, got %+v", cond)
+		}
+	})
+}
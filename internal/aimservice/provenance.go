@@ -0,0 +1,66 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"sort"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// mountedArtifacts returns the cached weights mounted into the inference service, sorted by model.
+// It follows the same selection as addStorageVolumes, so only the artifacts of a mountable
+// template cache are listed.
+func mountedArtifacts(
+	service *aimv1alpha1.AIMService,
+	templateCache *aimv1alpha1.AIMTemplateCache,
+	shared []sharedModelCache,
+) []aimv1alpha1.AIMMountedArtifact {
+	var mounted []aimv1alpha1.AIMMountedArtifact
+	if len(shared) > 0 {
+		for _, s := range shared {
+			mounted = append(mounted, aimv1alpha1.AIMMountedArtifact{
+				Model:          s.modelSource.ModelID,
+				Artifact:       s.cache.Status.Artifact,
+				Revision:       s.cache.Status.Revision,
+				ManifestDigest: s.cache.Status.ManifestDigest,
+			})
+		}
+	} else if isTemplateCacheMountable(service, templateCache) {
+		for _, artifact := range templateCache.Status.Artifacts {
+			if !isArtifactMountable(artifact, templateCache) {
+				continue
+			}
+			mounted = append(mounted, aimv1alpha1.AIMMountedArtifact{
+				Model:          artifact.Model,
+				Artifact:       artifact.Name,
+				Revision:       artifact.Revision,
+				ManifestDigest: artifact.ManifestDigest,
+			})
+		}
+	}
+	sort.Slice(mounted, func(i, j int) bool {
+		return mounted[i].Model < mounted[j].Model
+	})
+	return mounted
+}
@@ -0,0 +1,102 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func TestMountedArtifacts_TemplateCache(t *testing.T) {
+	service := NewService("svc").Build()
+	cache := &aimv1alpha1.AIMTemplateCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: testNamespace},
+		Status: aimv1alpha1.AIMTemplateCacheStatus{
+			Status: constants.AIMStatusReady,
+			Artifacts: map[string]aimv1alpha1.AIMResolvedArtifact{
+				"artifact-b": {
+					Name: "artifact-b", Model: "model-b", Status: constants.AIMStatusReady,
+					PersistentVolumeClaim: "pvc-b", Revision: "abc123", ManifestDigest: "sha256:b",
+				},
+				"artifact-a": {
+					Name: "artifact-a", Model: "model-a", Status: constants.AIMStatusReady,
+					PersistentVolumeClaim: "pvc-a",
+				},
+				"artifact-c": {
+					Name: "artifact-c", Model: "model-c", Status: constants.AIMStatusProgressing,
+					PersistentVolumeClaim: "pvc-c",
+				},
+			},
+		},
+	}
+
+	mounted := mountedArtifacts(service, cache, nil)
+	if len(mounted) != 2 {
+		t.Fatalf("expected the 2 ready artifacts, got %+v", mounted)
+	}
+	if mounted[0].Model != "model-a" || mounted[0].ManifestDigest != "" {
+		t.Errorf("expected model-a without provenance first, got %+v", mounted[0])
+	}
+	expected := aimv1alpha1.AIMMountedArtifact{
+		Model: "model-b", Artifact: "artifact-b", Revision: "abc123", ManifestDigest: "sha256:b",
+	}
+	if mounted[1] != expected {
+		t.Errorf("expected %+v, got %+v", expected, mounted[1])
+	}
+
+	cache.Status.Status = constants.AIMStatusProgressing
+	if mounted := mountedArtifacts(service, cache, nil); len(mounted) != 0 {
+		t.Errorf("expected nothing mounted from a cache that is not ready, got %+v", mounted)
+	}
+
+	provisioned := NewService("svc").WithCachingMode(aimv1alpha1.CachingModeDedicated).
+		WithCacheReadinessGate(aimv1alpha1.CacheReadinessGateProvisioned).Build()
+	if mounted := mountedArtifacts(provisioned, cache, nil); len(mounted) != 3 {
+		t.Errorf("expected all provisioned artifacts mounted when gating on Provisioned, got %+v", mounted)
+	}
+}
+
+func TestMountedArtifacts_SharedModelCaches(t *testing.T) {
+	service := NewService("svc").Build()
+	shared := []sharedModelCache{{
+		cache: &aimv1alpha1.AIMClusterModelCache{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+			Status: aimv1alpha1.AIMClusterModelCacheStatus{
+				Artifact: "shared-artifact", Revision: "abc123", ManifestDigest: "sha256:s",
+			},
+		},
+		modelSource: aimv1alpha1.AIMModelSource{ModelID: "org/model"},
+	}}
+
+	mounted := mountedArtifacts(service, nil, shared)
+	expected := aimv1alpha1.AIMMountedArtifact{
+		Model: "org/model", Artifact: "shared-artifact", Revision: "abc123", ManifestDigest: "sha256:s",
+	}
+	if len(mounted) != 1 || mounted[0] != expected {
+		t.Errorf("expected [%+v], got %+v", expected, mounted)
+	}
+}
@@ -0,0 +1,209 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// syncedPullSecret is an operator namespace pull secret to be copied into the service's namespace.
+type syncedPullSecret struct {
+	// name is the name of the copy in the service's namespace.
+	name   string
+	source *corev1.Secret
+}
+
+// pullSecretSync is the result of resolving the pull secrets the cluster runtime config
+// asks to be synced into the service's namespace.
+type pullSecretSync struct {
+	desired []syncedPullSecret
+	// existing are the copies currently present for the service, to remove stale ones.
+	existing []metav1.PartialObjectMetadata
+	err      error
+}
+
+// syncedPullSecretName returns the name of the copy of source made for the service.
+func syncedPullSecretName(serviceName, namespace, source string) (string, error) {
+	return utils.GenerateDerivedName([]string{serviceName, "pull", source},
+		utils.WithHashSource(namespace, source))
+}
+
+// fetchSyncedPullSecrets reads the syncedImagePullSecrets of the cluster runtime config
+// the service resolved, and the copies that already exist for the service.
+// Source secrets are read uncached from the operator namespace, like referenced secrets.
+// Returns nil when nothing is configured and no copies are left to clean up.
+func fetchSyncedPullSecrets(
+	ctx context.Context,
+	c client.Client,
+	clientset kubernetes.Interface,
+	service *aimv1alpha1.AIMService,
+	sources []aimv1alpha1.AIMResolvedReference,
+) *pullSecretSync {
+	result := &pullSecretSync{}
+
+	serviceLabelValue, _ := utils.SanitizeLabelValue(service.Name)
+	existing := &metav1.PartialObjectMetadataList{}
+	existing.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("SecretList"))
+	if err := c.List(ctx, existing,
+		client.InNamespace(service.Namespace),
+		client.MatchingLabels{constants.LabelKeyService: serviceLabelValue},
+		client.HasLabels{constants.LabelKeyPullSecretSource},
+	); err != nil {
+		result.err = err
+		return result
+	}
+	result.existing = existing.Items
+
+	var configName string
+	for _, src := range sources {
+		if src.Kind == "AIMClusterRuntimeConfig" {
+			configName = src.Name
+		}
+	}
+	if configName == "" || clientset == nil {
+		return result.orNil()
+	}
+
+	config := &aimv1alpha1.AIMClusterRuntimeConfig{}
+	if err := c.Get(ctx, client.ObjectKey{Name: configName}, config); err != nil {
+		if !apierrors.IsNotFound(err) {
+			result.err = err
+		}
+		return result.orNil()
+	}
+
+	operatorNamespace := constants.GetOperatorNamespace()
+	for _, ref := range config.Spec.SyncedImagePullSecrets {
+		if ref.Name == "" {
+			continue
+		}
+		source, err := clientset.CoreV1().Secrets(operatorNamespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			result.err = controllerutils.NewMissingUpstreamDependencyError(
+				aimv1alpha1.AIMServiceReasonSecretNotFound,
+				fmt.Sprintf("Secret %s/%s listed in syncedImagePullSecrets of AIMClusterRuntimeConfig %s not found",
+					operatorNamespace, ref.Name, configName),
+				err,
+			)
+			return result
+		}
+		if err != nil {
+			result.err = err
+			return result
+		}
+		check := secretReference{name: ref.Name, source: "syncedImagePullSecrets of AIMClusterRuntimeConfig " + configName}
+		if msg := checkSecretReference(source, check); msg != "" {
+			result.err = controllerutils.NewInvalidSpecError(aimv1alpha1.AIMServiceReasonInvalidSecret, msg, nil)
+			return result
+		}
+		name, err := syncedPullSecretName(service.Name, service.Namespace, ref.Name)
+		if err != nil {
+			result.err = controllerutils.NewInvalidSpecError(aimv1alpha1.AIMServiceReasonInvalidSecret, err.Error(), err)
+			return result
+		}
+		result.desired = append(result.desired, syncedPullSecret{name: name, source: source})
+	}
+	return result.orNil()
+}
+
+// orNil returns nil when there is nothing to sync, clean up or report.
+func (s *pullSecretSync) orNil() *pullSecretSync {
+	if s.err == nil && len(s.desired) == 0 && len(s.existing) == 0 {
+		return nil
+	}
+	return s
+}
+
+// refs returns the pull secret references of the copies for the inference pods.
+func (s *pullSecretSync) refs() []corev1.LocalObjectReference {
+	if s == nil || s.err != nil {
+		return nil
+	}
+	refs := make([]corev1.LocalObjectReference, 0, len(s.desired))
+	for _, d := range s.desired {
+		refs = append(refs, corev1.LocalObjectReference{Name: d.name})
+	}
+	return refs
+}
+
+// buildSyncedPullSecret copies the source secret into the service's namespace. The copy is
+// re-applied on every reconcile, so changes to the source data are carried over.
+func buildSyncedPullSecret(service *aimv1alpha1.AIMService, s syncedPullSecret) *corev1.Secret {
+	serviceLabelValue, _ := utils.SanitizeLabelValue(service.Name)
+	data := make(map[string][]byte, len(s.source.Data))
+	for k, v := range s.source.Data {
+		data[k] = v
+	}
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.name,
+			Namespace: service.Namespace,
+			Labels: map[string]string{
+				constants.LabelKeyManagedBy:        constants.LabelValueManagedBy,
+				constants.LabelKeyService:          serviceLabelValue,
+				constants.LabelKeyPullSecretSource: s.source.Name,
+			},
+		},
+		Type: s.source.Type,
+		Data: data,
+	}
+}
+
+// planSyncedPullSecrets applies the copies of the synced pull secrets and deletes copies
+// whose source is no longer listed in the cluster runtime config.
+func planSyncedPullSecrets(planResult *controllerutils.PlanResult, service *aimv1alpha1.AIMService, s *pullSecretSync) {
+	if s == nil || s.err != nil {
+		return
+	}
+	desired := make(map[string]struct{}, len(s.desired))
+	for _, d := range s.desired {
+		desired[d.name] = struct{}{}
+		planResult.Apply(buildSyncedPullSecret(service, d))
+	}
+	for i := range s.existing {
+		if _, ok := desired[s.existing[i].Name]; ok {
+			continue
+		}
+		stale := &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.existing[i].Name,
+				Namespace: s.existing[i].Namespace,
+			},
+		}
+		planResult.Delete(stale)
+	}
+}
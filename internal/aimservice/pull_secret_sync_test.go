@@ -0,0 +1,138 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func clusterRuntimeConfigWithPullSecrets(names ...string) *aimv1alpha1.AIMClusterRuntimeConfig {
+	config := &aimv1alpha1.AIMClusterRuntimeConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	for _, name := range names {
+		config.Spec.SyncedImagePullSecrets = append(config.Spec.SyncedImagePullSecrets, corev1.LocalObjectReference{Name: name})
+	}
+	return config
+}
+
+func operatorPullSecret(name, auth string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.GetOperatorNamespace()},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(auth)},
+	}
+}
+
+var clusterConfigSources = []aimv1alpha1.AIMResolvedReference{
+	{Kind: "AIMClusterRuntimeConfig", Scope: aimv1alpha1.AIMResolutionScopeCluster, Name: "default"},
+}
+
+func TestFetchSyncedPullSecrets(t *testing.T) {
+	service := NewService("svc").Build()
+	c := newFakeClient(clusterRuntimeConfigWithPullSecrets("registry"))
+	clientset := kubefake.NewClientset(operatorPullSecret("registry", `{"auths":{}}`))
+
+	sync := fetchSyncedPullSecrets(testContext(), c, clientset, service, clusterConfigSources)
+	if sync == nil || sync.err != nil {
+		t.Fatalf("expected synced pull secrets, got %+v", sync)
+	}
+	if len(sync.desired) != 1 || sync.desired[0].source.Name != "registry" {
+		t.Fatalf("expected registry to be synced, got %+v", sync.desired)
+	}
+	refs := sync.refs()
+	if len(refs) != 1 || refs[0].Name != sync.desired[0].name {
+		t.Errorf("refs = %+v, want the copy %s", refs, sync.desired[0].name)
+	}
+
+	copied := buildSyncedPullSecret(service, sync.desired[0])
+	if copied.Namespace != service.Namespace || copied.Type != corev1.SecretTypeDockerConfigJson {
+		t.Errorf("unexpected copy %s/%s of type %s", copied.Namespace, copied.Name, copied.Type)
+	}
+	if string(copied.Data[corev1.DockerConfigJsonKey]) != `{"auths":{}}` {
+		t.Errorf("copy data = %q", copied.Data[corev1.DockerConfigJsonKey])
+	}
+	if copied.Labels[constants.LabelKeyPullSecretSource] != "registry" || copied.Labels[constants.LabelKeyService] != "svc" {
+		t.Errorf("unexpected copy labels %v", copied.Labels)
+	}
+}
+
+func TestFetchSyncedPullSecretsNotConfigured(t *testing.T) {
+	service := NewService("svc").Build()
+	c := newFakeClient()
+
+	if sync := fetchSyncedPullSecrets(testContext(), c, kubefake.NewClientset(), service, nil); sync != nil {
+		t.Errorf("expected nil without a cluster runtime config, got %+v", sync)
+	}
+}
+
+func TestFetchSyncedPullSecretsMissingSource(t *testing.T) {
+	service := NewService("svc").Build()
+	c := newFakeClient(clusterRuntimeConfigWithPullSecrets("missing"))
+
+	sync := fetchSyncedPullSecrets(testContext(), c, kubefake.NewClientset(), service, clusterConfigSources)
+	if sync == nil || sync.err == nil {
+		t.Fatalf("expected an error for a missing source, got %+v", sync)
+	}
+	if reason := controllerutils.CategorizeError(sync.err).Reason(); reason != aimv1alpha1.AIMServiceReasonSecretNotFound {
+		t.Errorf("reason = %q, want %q", reason, aimv1alpha1.AIMServiceReasonSecretNotFound)
+	}
+	if refs := sync.refs(); len(refs) != 0 {
+		t.Errorf("expected no refs on error, got %+v", refs)
+	}
+}
+
+func TestPlanSyncedPullSecretsDeletesStaleCopies(t *testing.T) {
+	service := NewService("svc").Build()
+	staleName, _ := syncedPullSecretName(service.Name, service.Namespace, "old")
+	stale := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      staleName,
+			Namespace: service.Namespace,
+			Labels: map[string]string{
+				constants.LabelKeyService:          "svc",
+				constants.LabelKeyPullSecretSource: "old",
+			},
+		},
+	}
+	c := newFakeClient(clusterRuntimeConfigWithPullSecrets("registry"), stale)
+	clientset := kubefake.NewClientset(operatorPullSecret("registry", `{"auths":{}}`))
+
+	sync := fetchSyncedPullSecrets(testContext(), c, clientset, service, clusterConfigSources)
+	planResult := controllerutils.PlanResult{}
+	planSyncedPullSecrets(&planResult, service, sync)
+
+	if applied := planResult.GetToApply(); len(applied) != 1 || applied[0].GetName() != sync.desired[0].name {
+		t.Errorf("expected the registry copy to be applied, got %d objects", len(applied))
+	}
+	deleted := planResult.GetToDelete()
+	if len(deleted) != 1 || deleted[0].GetName() != staleName {
+		t.Errorf("expected stale copy %s to be deleted, got %d objects", staleName, len(deleted))
+	}
+}
@@ -0,0 +1,318 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// ServingReadyConditionType is the pod condition behind the serving readiness gate. It is set by
+// the operator once the serving container mounts the model caches and its endpoint answers.
+const ServingReadyConditionType corev1.PodConditionType = constants.AimLabelDomain + "/ServingReady"
+
+const (
+	servingReadyReasonVerified         = "ServingVerified"
+	servingReadyReasonCacheNotMounted  = "CacheNotMounted"
+	servingReadyReasonEndpointNotReady = "EndpointNotReady"
+)
+
+const (
+	// servingProbePath is the endpoint the operator requests to verify that the engine serves
+	// the model; it lists the served models of OpenAI-compatible engines.
+	servingProbePath = "/v1/models"
+
+	// servingProbeTimeout bounds each probe, which runs while the service is fetched.
+	servingProbeTimeout = 2 * time.Second
+
+	// servingProbeRecheckInterval is how often a pod whose endpoint did not answer is probed again.
+	servingProbeRecheckInterval = 5 * time.Second
+)
+
+// servingProbeClient sends the probes; servingProbeTimeout also bounds requests whose context
+// has no deadline.
+var servingProbeClient = &http.Client{Timeout: servingProbeTimeout}
+
+// EndpointProber checks that the serving endpoint of a predictor pod answers.
+type EndpointProber func(ctx context.Context, pod *corev1.Pod) error
+
+// probeServingEndpoint is the default EndpointProber. It requests the served models from the
+// engine on the pod and fails unless the engine answers with a success status.
+func probeServingEndpoint(ctx context.Context, pod *corev1.Pod) error {
+	address := net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(constants.DefaultHTTPPort))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+servingProbePath, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := servingProbeClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("engine answered %s", resp.Status)
+	}
+	return nil
+}
+
+// applyServingReadinessGate adds the serving readiness gate to the predictor pods when the
+// service enables it.
+func applyServingReadinessGate(isvc *servingv1beta1.InferenceService, enabled bool) {
+	if !enabled {
+		return
+	}
+	isvc.Spec.Predictor.ReadinessGates = append(isvc.Spec.Predictor.ReadinessGates,
+		corev1.PodReadinessGate{ConditionType: ServingReadyConditionType})
+}
+
+// hasServingReadinessGate reports whether the pod was created with the serving readiness gate.
+func hasServingReadinessGate(pod *corev1.Pod) bool {
+	for _, gate := range pod.Spec.ReadinessGates {
+		if gate.ConditionType == ServingReadyConditionType {
+			return true
+		}
+	}
+	return false
+}
+
+// awaitsServingGate reports whether the operator still has to verify the pod.
+func awaitsServingGate(pod *corev1.Pod) bool {
+	return pod.DeletionTimestamp == nil && hasServingReadinessGate(pod) && !isServingReady(pod)
+}
+
+// servingContainer returns the serving container of a pod, nil when it has none.
+func servingContainer(containers []corev1.Container) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == constants.ContainerKServe {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+// servingContainerReady reports whether the serving container passes its readiness probe.
+func servingContainerReady(pod *corev1.Pod) bool {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == constants.ContainerKServe {
+			return cs.Ready
+		}
+	}
+	return false
+}
+
+// cacheMount is a model cache the serving container loads weights from. Claim is the cache PVC,
+// empty for an encrypted cache that an init container decrypts into an emptyDir.
+type cacheMount struct {
+	path  string
+	claim string
+}
+
+// predictorCacheMounts returns the model cache mounts of the serving container planned on the
+// InferenceService.
+func predictorCacheMounts(isvc *servingv1beta1.InferenceService) []cacheMount {
+	container := servingContainer(isvc.Spec.Predictor.Containers)
+	if container == nil {
+		return nil
+	}
+	claims := map[string]string{}
+	for _, volume := range isvc.Spec.Predictor.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	decrypted := map[string]bool{}
+	for _, ic := range isvc.Spec.Predictor.InitContainers {
+		if strings.HasPrefix(ic.Name, constants.ContainerCacheDecryptPrefix) {
+			for _, mount := range ic.VolumeMounts {
+				decrypted[mount.Name] = true
+			}
+		}
+	}
+
+	var mounts []cacheMount
+	for _, mount := range container.VolumeMounts {
+		if claim, ok := claims[mount.Name]; ok {
+			mounts = append(mounts, cacheMount{path: mount.MountPath, claim: claim})
+		} else if decrypted[mount.Name] {
+			mounts = append(mounts, cacheMount{path: mount.MountPath})
+		}
+	}
+	return mounts
+}
+
+// missingCacheMount returns the first model cache the serving container of the pod does not
+// mount at the planned path, nil when all are mounted.
+func missingCacheMount(pod *corev1.Pod, mounts []cacheMount) *cacheMount {
+	container := servingContainer(pod.Spec.Containers)
+	claims := map[string]string{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	for i, want := range mounts {
+		found := false
+		if container != nil {
+			for _, mount := range container.VolumeMounts {
+				if mount.MountPath == want.path && (want.claim == "" || claims[mount.Name] == want.claim) {
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			return &mounts[i]
+		}
+	}
+	return nil
+}
+
+// probeServingEndpoints probes the pods awaiting the serving readiness gate whose serving
+// container is ready, concurrently. Returns the probe errors by pod name, nil for a pod whose
+// endpoint answered; pods that were not probed are absent.
+func probeServingEndpoints(ctx context.Context, probe EndpointProber, pods []corev1.Pod) map[string]error {
+	if probe == nil {
+		probe = probeServingEndpoint
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results map[string]error
+	)
+	for i := range pods {
+		pod := &pods[i]
+		if !awaitsServingGate(pod) || !servingContainerReady(pod) || pod.Status.PodIP == "" {
+			continue
+		}
+		if results == nil {
+			results = map[string]error{}
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, servingProbeTimeout)
+			defer cancel()
+			err := probe(probeCtx, pod)
+			mu.Lock()
+			results[pod.Name] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// servingReadyCondition evaluates the serving readiness gate of a pod. The serving container
+// must mount every model cache of the InferenceService, and the operator must have reached its
+// serving endpoint. probed reports whether the endpoint was probed, probeErr the outcome.
+func servingReadyCondition(pod *corev1.Pod, mounts []cacheMount, probeErr error, probed bool) corev1.PodCondition {
+	if missing := missingCacheMount(pod, mounts); missing != nil {
+		message := fmt.Sprintf("Model cache is not mounted at %s", missing.path)
+		if missing.claim != "" {
+			message = fmt.Sprintf("Model cache %s is not mounted at %s", missing.claim, missing.path)
+		}
+		return corev1.PodCondition{
+			Type:    ServingReadyConditionType,
+			Status:  corev1.ConditionFalse,
+			Reason:  servingReadyReasonCacheNotMounted,
+			Message: message,
+		}
+	}
+
+	switch {
+	case !probed:
+		return corev1.PodCondition{
+			Type:    ServingReadyConditionType,
+			Status:  corev1.ConditionFalse,
+			Reason:  servingReadyReasonEndpointNotReady,
+			Message: "Serving container has not passed its readiness probe",
+		}
+	case probeErr != nil:
+		return corev1.PodCondition{
+			Type:    ServingReadyConditionType,
+			Status:  corev1.ConditionFalse,
+			Reason:  servingReadyReasonEndpointNotReady,
+			Message: fmt.Sprintf("Serving endpoint did not answer: %v", probeErr),
+		}
+	}
+	return corev1.PodCondition{
+		Type:    ServingReadyConditionType,
+		Status:  corev1.ConditionTrue,
+		Reason:  servingReadyReasonVerified,
+		Message: "Model caches are mounted and the serving endpoint answered",
+	}
+}
+
+// planServingReadinessGates plans the serving condition of the predictor pods that carry the
+// readiness gate. A condition that is already True is kept, so the gate only holds back pods
+// while they initialize; afterwards the readiness probe alone decides. Pods whose endpoint did
+// not answer are probed again after servingProbeRecheckInterval.
+func planServingReadinessGates(planResult *controllerutils.PlanResult, obs ServiceObservation) {
+	if obs.inferenceServicePods == nil || !obs.inferenceServicePods.OK() || obs.inferenceServicePods.Value == nil {
+		return
+	}
+	if !obs.inferenceService.OK() || obs.inferenceService.Value == nil {
+		return
+	}
+	mounts := predictorCacheMounts(obs.inferenceService.Value)
+
+	for i := range obs.inferenceServicePods.Value.Items {
+		pod := &obs.inferenceServicePods.Value.Items[i]
+		if !awaitsServingGate(pod) {
+			continue
+		}
+		probeErr, probed := obs.servingProbes[pod.Name]
+		condition := servingReadyCondition(pod, mounts, probeErr, probed)
+		if probed && probeErr != nil && condition.Reason == servingReadyReasonEndpointNotReady &&
+			(planResult.RequeueAfter == 0 || planResult.RequeueAfter > servingProbeRecheckInterval) {
+			planResult.RequeueAfter = servingProbeRecheckInterval
+		}
+		updated := pod.DeepCopy()
+		if controllerutils.SetPodCondition(updated, condition) {
+			planResult.PatchStatus(updated, client.StrategicMergeFrom(pod))
+		}
+	}
+}
+
+func isServingReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == ServingReadyConditionType {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
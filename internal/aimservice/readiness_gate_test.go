@@ -0,0 +1,267 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// cacheMountPath is where the test caches are mounted in the serving container.
+func cacheMountPath(claim string) string {
+	return "/workspace/model-cache/" + claim
+}
+
+// gatedPod returns a predictor pod with the serving readiness gate whose serving container
+// mounts the given cache claims.
+func gatedPod(name string, ready bool, claims ...string) corev1.Pod {
+	pod := predictorPod(name, corev1.PodRunning, true, ready)
+	pod.Status.PodIP = "10.0.0.1"
+	pod.Spec.ReadinessGates = []corev1.PodReadinessGate{{ConditionType: ServingReadyConditionType}}
+	container := corev1.Container{Name: constants.ContainerKServe}
+	for _, claim := range claims {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: claim,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: claim, MountPath: cacheMountPath(claim)})
+	}
+	pod.Spec.Containers = []corev1.Container{container}
+	return pod
+}
+
+// cachedInferenceService returns an InferenceService whose serving container mounts the given cache claims.
+func cachedInferenceService(claims ...string) *servingv1beta1.InferenceService {
+	isvc := &servingv1beta1.InferenceService{}
+	container := corev1.Container{Name: constants.ContainerKServe}
+	for _, claim := range claims {
+		volumeName := "cache-" + claim
+		isvc.Spec.Predictor.Volumes = append(isvc.Spec.Predictor.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: volumeName, MountPath: cacheMountPath(claim)})
+	}
+	isvc.Spec.Predictor.Containers = []corev1.Container{container}
+	return isvc
+}
+
+func readinessGateObservation(isvc *servingv1beta1.InferenceService, pods ...corev1.Pod) ServiceObservation {
+	obs := ServiceObservation{}
+	obs.service = NewService("svc").Build()
+	obs.inferenceService = controllerutils.FetchResult[*servingv1beta1.InferenceService]{Value: isvc}
+	obs.inferenceServicePods = &controllerutils.FetchResult[*corev1.PodList]{Value: &corev1.PodList{Items: pods}}
+	return obs
+}
+
+func TestBuildInferenceService_ServingReadinessGate(t *testing.T) {
+	service := NewService("svc").Build()
+	isvc := buildInferenceService(service, "tmpl", nil, nil, ServiceObservation{})
+	if len(isvc.Spec.Predictor.ReadinessGates) != 0 {
+		t.Fatalf("expected no readiness gate by default, got %v", isvc.Spec.Predictor.ReadinessGates)
+	}
+
+	service.Spec.ServingReadinessGate = true
+	isvc = buildInferenceService(service, "tmpl", nil, nil, ServiceObservation{})
+	gates := isvc.Spec.Predictor.ReadinessGates
+	if len(gates) != 1 || gates[0].ConditionType != ServingReadyConditionType {
+		t.Errorf("expected the serving readiness gate, got %v", gates)
+	}
+}
+
+func TestPredictorCacheMounts(t *testing.T) {
+	isvc := cachedInferenceService("cache-pvc")
+	container := &isvc.Spec.Predictor.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts,
+		corev1.VolumeMount{Name: constants.VolumeSharedMemory, MountPath: constants.MountPathSharedMemory},
+		corev1.VolumeMount{Name: "plain", MountPath: "/workspace/model-cache/encrypted"},
+	)
+	isvc.Spec.Predictor.Volumes = append(isvc.Spec.Predictor.Volumes,
+		corev1.Volume{Name: constants.VolumeSharedMemory, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		corev1.Volume{Name: "plain", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	)
+	isvc.Spec.Predictor.InitContainers = []corev1.Container{{
+		Name:         constants.ContainerCacheDecryptPrefix + "x",
+		VolumeMounts: []corev1.VolumeMount{{Name: "plain", MountPath: "/decrypted"}},
+	}}
+
+	want := []cacheMount{
+		{path: cacheMountPath("cache-pvc"), claim: "cache-pvc"},
+		{path: "/workspace/model-cache/encrypted"},
+	}
+	if got := predictorCacheMounts(isvc); !slices.Equal(got, want) {
+		t.Errorf("cache mounts = %+v, want %+v", got, want)
+	}
+}
+
+func TestServingReadyCondition(t *testing.T) {
+	mounts := predictorCacheMounts(cachedInferenceService("cache-pvc"))
+	wrongClaim := gatedPod("p", true, "other-pvc")
+	wrongClaim.Spec.Containers[0].VolumeMounts[0].MountPath = cacheMountPath("cache-pvc")
+	volumeOnly := gatedPod("p", true, "cache-pvc")
+	volumeOnly.Spec.Containers[0].VolumeMounts = nil
+
+	tests := []struct {
+		name       string
+		pod        corev1.Pod
+		probed     bool
+		probeErr   error
+		wantStatus corev1.ConditionStatus
+		wantReason string
+	}{
+		{name: "cache missing", pod: gatedPod("p", true), probed: true,
+			wantStatus: corev1.ConditionFalse, wantReason: servingReadyReasonCacheNotMounted},
+		{name: "volume not mounted in the serving container", pod: volumeOnly, probed: true,
+			wantStatus: corev1.ConditionFalse, wantReason: servingReadyReasonCacheNotMounted},
+		{name: "another claim at the cache path", pod: wrongClaim, probed: true,
+			wantStatus: corev1.ConditionFalse, wantReason: servingReadyReasonCacheNotMounted},
+		{name: "endpoint not probed", pod: gatedPod("p", false, "cache-pvc"),
+			wantStatus: corev1.ConditionFalse, wantReason: servingReadyReasonEndpointNotReady},
+		{name: "endpoint did not answer", pod: gatedPod("p", true, "cache-pvc"), probed: true, probeErr: errors.New("connection refused"),
+			wantStatus: corev1.ConditionFalse, wantReason: servingReadyReasonEndpointNotReady},
+		{name: "verified", pod: gatedPod("p", true, "cache-pvc"), probed: true,
+			wantStatus: corev1.ConditionTrue, wantReason: servingReadyReasonVerified},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond := servingReadyCondition(&tt.pod, mounts, tt.probeErr, tt.probed)
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("got %s/%s, want %s/%s", cond.Status, cond.Reason, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestProbeServingEndpoints(t *testing.T) {
+	verified := gatedPod("p-verified", true, "cache-pvc")
+	verified.Status.Conditions = []corev1.PodCondition{{Type: ServingReadyConditionType, Status: corev1.ConditionTrue}}
+	noIP := gatedPod("p-no-ip", true, "cache-pvc")
+	noIP.Status.PodIP = ""
+	pods := []corev1.Pod{
+		gatedPod("p-ready", true, "cache-pvc"),
+		gatedPod("p-failing", true, "cache-pvc"),
+		gatedPod("p-loading", false, "cache-pvc"),
+		verified,
+		noIP,
+		predictorPod("p-ungated", corev1.PodRunning, true, true),
+	}
+
+	var probed atomic.Int32
+	results := probeServingEndpoints(testContext(), func(ctx context.Context, pod *corev1.Pod) error {
+		probed.Add(1)
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("expected a probe deadline")
+		}
+		if pod.Name == "p-failing" {
+			return errors.New("connection refused")
+		}
+		return nil
+	}, pods)
+
+	if probed.Load() != 2 || len(results) != 2 {
+		t.Fatalf("expected only the ready pods awaiting the gate to be probed, got %v", results)
+	}
+	if err, ok := results["p-ready"]; !ok || err != nil {
+		t.Errorf("expected p-ready to answer, got %v (probed %t)", err, ok)
+	}
+	if err := results["p-failing"]; err == nil {
+		t.Error("expected p-failing to fail its probe")
+	}
+	if results := probeServingEndpoints(testContext(), nil, []corev1.Pod{gatedPod("p-loading", false)}); results != nil {
+		t.Errorf("expected no probes, got %v", results)
+	}
+}
+
+func TestPlanServingReadinessGates(t *testing.T) {
+	isvc := cachedInferenceService("cache-pvc")
+
+	verified := gatedPod("p-verified", true, "cache-pvc")
+	verified.Status.Conditions = []corev1.PodCondition{{Type: ServingReadyConditionType, Status: corev1.ConditionTrue}}
+	ungated := predictorPod("p-ungated", corev1.PodRunning, true, true)
+
+	obs := readinessGateObservation(isvc,
+		gatedPod("p-ready", true, "cache-pvc"),
+		gatedPod("p-loading", false, "cache-pvc"),
+		verified,
+		ungated,
+	)
+	obs.servingProbes = map[string]error{"p-ready": nil}
+
+	var plan controllerutils.PlanResult
+	planServingReadinessGates(&plan, obs)
+	patched := plan.GetToPatchStatus()
+	if len(patched) != 2 || patched[0].GetName() != "p-ready" || patched[1].GetName() != "p-loading" {
+		t.Fatalf("expected p-ready and p-loading to be patched, got %v", patched)
+	}
+	pod := patched[0].(*corev1.Pod)
+	if !isServingReady(pod) {
+		t.Errorf("expected p-ready to be verified, got %+v", pod.Status.Conditions)
+	}
+	if plan.RequeueAfter != 0 {
+		t.Errorf("expected no requeue without failed probes, got %s", plan.RequeueAfter)
+	}
+
+	// A pod whose condition is unchanged is not patched again
+	loading := *patched[1].(*corev1.Pod)
+	plan = controllerutils.PlanResult{}
+	planServingReadinessGates(&plan, readinessGateObservation(isvc, loading))
+	if len(plan.GetToPatchStatus()) != 0 {
+		t.Errorf("expected no patch for an unchanged condition, got %v", plan.GetToPatchStatus())
+	}
+
+	// A pod whose endpoint did not answer is probed again
+	failing := readinessGateObservation(isvc, gatedPod("p-failing", true, "cache-pvc"))
+	failing.servingProbes = map[string]error{"p-failing": errors.New("connection refused")}
+	plan = controllerutils.PlanResult{}
+	planServingReadinessGates(&plan, failing)
+	if plan.RequeueAfter != servingProbeRecheckInterval {
+		t.Errorf("RequeueAfter = %s, want %s", plan.RequeueAfter, servingProbeRecheckInterval)
+	}
+	if patched := plan.GetToPatchStatus(); len(patched) != 1 || isServingReady(patched[0].(*corev1.Pod)) {
+		t.Errorf("expected p-failing to stay unverified, got %v", patched)
+	}
+}
+
+func TestPlanResources_ServingReadinessGate(t *testing.T) {
+	obs := readinessGateObservation(&servingv1beta1.InferenceService{}, gatedPod("p-0", true))
+
+	r := &ServiceReconciler{}
+	plan := r.PlanResources(testContext(), controllerutils.ReconcileContext[*aimv1alpha1.AIMService]{}, obs)
+	if patched := plan.GetToPatchStatus(); len(patched) != 1 || patched[0].GetName() != "p-0" {
+		t.Errorf("expected pod p-0 to be patched, got %v", patched)
+	}
+}
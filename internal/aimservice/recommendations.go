@@ -0,0 +1,266 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const (
+	// recommendationWarmup is how long a service must have been running before its usage is analyzed,
+	// so that model loading does not skew the samples.
+	recommendationWarmup = 15 * time.Minute
+
+	// recommendationInterval is how often usage is re-analyzed once a service is running.
+	recommendationInterval = time.Hour
+
+	// recommendationIncreaseRatio is the usage/request ratio above which an increase is suggested.
+	recommendationIncreaseRatio = 0.9
+
+	// recommendationDecreaseRatio is the usage/request ratio below which a decrease is suggested.
+	recommendationDecreaseRatio = 0.5
+
+	// recommendationHeadroomPercent is applied to the observed usage to compute the suggested request.
+	recommendationHeadroomPercent = 130
+)
+
+// podMetricsListGVK identifies the metrics-server PodMetrics list. It is read as unstructured
+// to avoid depending on the metrics client for a single read.
+var podMetricsListGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+
+// recommendedResources are the resources recommendations are computed for.
+var recommendedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// metricsBackoff suppresses metrics reads for a while after the metrics API failed,
+// so that clusters without metrics-server are not queried on every reconcile.
+type metricsBackoff struct {
+	until atomic.Int64
+}
+
+func (b *metricsBackoff) allowed(now time.Time) bool {
+	return now.UnixNano() >= b.until.Load()
+}
+
+func (b *metricsBackoff) fail(now time.Time) {
+	b.until.Store(now.Add(recommendationInterval).UnixNano())
+}
+
+// recommendationAnalysisWait returns how long until usage should next be analyzed.
+// ok is false when the service is not running, in which case no analysis is scheduled.
+func recommendationAnalysisWait(service *aimv1alpha1.AIMService, now time.Time) (wait time.Duration, ok bool) {
+	if service.Status.Status != constants.AIMStatusRunning {
+		return 0, false
+	}
+	ready := meta.FindStatusCondition(service.Status.Conditions, controllerutils.ConditionTypeReady)
+	if ready == nil || ready.Status != metav1.ConditionTrue {
+		return 0, false
+	}
+
+	next := ready.LastTransitionTime.Add(recommendationWarmup)
+	if recs := service.Status.Recommendations; recs != nil {
+		if analyzed := recs.LastAnalyzedTime.Add(recommendationInterval); analyzed.After(next) {
+			next = analyzed
+		}
+	}
+	if wait = next.Sub(now); wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
+
+// fetchPodMetrics reads the current usage of the InferenceService predictor pods from the metrics API.
+func fetchPodMetrics(
+	ctx context.Context,
+	r client.Reader,
+	namespace, isvcName string,
+) controllerutils.FetchResult[*unstructured.UnstructuredList] {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(podMetricsListGVK)
+	return controllerutils.FetchListLive(ctx, r, list,
+		client.InNamespace(namespace),
+		client.MatchingLabels{constants.LabelKServeInferenceService: isvcName},
+	)
+}
+
+// computeRecommendations compares the peak usage of the inference container across the sampled pods
+// with its request and suggests changes for requests that are nearly exhausted or mostly unused.
+func computeRecommendations(
+	pods []corev1.Pod,
+	podMetrics []unstructured.Unstructured,
+	now time.Time,
+) *aimv1alpha1.AIMServiceRecommendations {
+	podsByName := make(map[string]*corev1.Pod, len(pods))
+	for i := range pods {
+		podsByName[pods[i].Name] = &pods[i]
+	}
+
+	observed := map[string]corev1.ResourceList{}
+	requests := map[string]corev1.ResourceList{}
+	var sampled int32
+	for _, item := range podMetrics {
+		pod, found := podsByName[item.GetName()]
+		if !found {
+			continue
+		}
+		containers, _, _ := unstructured.NestedSlice(item.Object, "containers")
+		if len(containers) == 0 {
+			continue
+		}
+		sampled++
+
+		for _, c := range containers {
+			entry, isMap := c.(map[string]any)
+			if !isMap {
+				continue
+			}
+			// Sidecars are injected by other controllers, so only the inference container is sized here
+			name, _, _ := unstructured.NestedString(entry, "name")
+			if name != constants.ContainerKServe {
+				continue
+			}
+			usage, _, _ := unstructured.NestedStringMap(entry, "usage")
+			for _, res := range recommendedResources {
+				q, err := resource.ParseQuantity(usage[string(res)])
+				if err != nil {
+					continue
+				}
+				if observed[name] == nil {
+					observed[name] = corev1.ResourceList{}
+				}
+				if peak, seen := observed[name][res]; !seen || q.Cmp(peak) > 0 {
+					observed[name][res] = q
+				}
+			}
+			if _, seen := requests[name]; !seen {
+				requests[name] = containerRequests(pod, name)
+			}
+		}
+	}
+
+	recs := &aimv1alpha1.AIMServiceRecommendations{
+		LastAnalyzedTime: metav1.NewTime(now),
+		SampledPods:      sampled,
+	}
+
+	containerNames := make([]string, 0, len(observed))
+	for name := range observed {
+		containerNames = append(containerNames, name)
+	}
+	sort.Strings(containerNames)
+
+	for _, name := range containerNames {
+		for _, res := range recommendedResources {
+			usage, seen := observed[name][res]
+			if !seen {
+				continue
+			}
+			var current *resource.Quantity
+			if req, hasRequest := requests[name][res]; hasRequest {
+				current = &req
+			}
+			if rec := recommendResource(name, res, current, usage); rec != nil {
+				recs.Items = append(recs.Items, *rec)
+			}
+		}
+	}
+
+	return recs
+}
+
+// recommendResource returns a recommendation for one container resource, or nil if the request fits.
+func recommendResource(
+	container string,
+	res corev1.ResourceName,
+	current *resource.Quantity,
+	usage resource.Quantity,
+) *aimv1alpha1.AIMResourceRecommendation {
+	rec := &aimv1alpha1.AIMResourceRecommendation{
+		Container:   container,
+		Resource:    res,
+		Current:     current,
+		Observed:    usage,
+		Recommended: withHeadroom(res, usage),
+	}
+
+	if current == nil || current.IsZero() {
+		rec.Action = aimv1alpha1.RecommendationActionIncrease
+		rec.Message = fmt.Sprintf("No %s request is set; request at least the observed usage of %s", res, usage.String())
+		return rec
+	}
+
+	ratio := usage.AsApproximateFloat64() / current.AsApproximateFloat64()
+	switch {
+	case ratio >= recommendationIncreaseRatio:
+		rec.Action = aimv1alpha1.RecommendationActionIncrease
+		rec.Message = fmt.Sprintf("Observed %s usage is %.0f%% of the request", res, ratio*100)
+	case ratio <= recommendationDecreaseRatio && rec.Recommended.Cmp(*current) < 0:
+		rec.Action = aimv1alpha1.RecommendationActionDecrease
+		rec.Message = fmt.Sprintf("Observed %s usage is only %.0f%% of the request", res, ratio*100)
+	default:
+		return nil
+	}
+	return rec
+}
+
+// withHeadroom adds headroom to the observed usage and rounds it to a readable quantity:
+// CPU up to the next 10 millicores, memory up to the next MiB.
+func withHeadroom(res corev1.ResourceName, usage resource.Quantity) resource.Quantity {
+	if res == corev1.ResourceCPU {
+		milli := roundUp(usage.MilliValue()*recommendationHeadroomPercent, 100*10) / 100
+		return *resource.NewMilliQuantity(milli, resource.DecimalSI)
+	}
+	const mi = 1 << 20
+	bytes := roundUp(usage.Value()*recommendationHeadroomPercent, 100*mi) / 100
+	return *resource.NewQuantity(bytes, resource.BinarySI)
+}
+
+// roundUp rounds v up to the next multiple of step.
+func roundUp(v, step int64) int64 {
+	return (v + step - 1) / step * step
+}
+
+// containerRequests returns the requests of the named container in the pod.
+func containerRequests(pod *corev1.Pod, name string) corev1.ResourceList {
+	for _, c := range pod.Spec.Containers {
+		if c.Name == name {
+			return c.Resources.Requests
+		}
+	}
+	return nil
+}
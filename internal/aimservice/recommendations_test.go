@@ -0,0 +1,207 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func newRecommendationPod(name string, requests corev1.ResourceList) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:      constants.ContainerKServe,
+			Resources: corev1.ResourceRequirements{Requests: requests},
+		}}},
+	}
+}
+
+func newPodMetrics(name, cpu, memory string) unstructured.Unstructured {
+	item := unstructured.Unstructured{Object: map[string]any{
+		"containers": []any{
+			map[string]any{
+				"name":  constants.ContainerKServe,
+				"usage": map[string]any{"cpu": cpu, "memory": memory},
+			},
+			map[string]any{
+				"name":  "istio-proxy",
+				"usage": map[string]any{"cpu": "5m", "memory": "40Mi"},
+			},
+		},
+	}}
+	item.SetName(name)
+	return item
+}
+
+func TestRecommendationAnalysisWait(t *testing.T) {
+	now := time.Now()
+	running := func(readySince time.Time, lastAnalyzed *time.Time) *aimv1alpha1.AIMService {
+		service := NewService("svc").Build()
+		service.Status.Status = constants.AIMStatusRunning
+		service.Status.Conditions = []metav1.Condition{{
+			Type:               controllerutils.ConditionTypeReady,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(readySince),
+		}}
+		if lastAnalyzed != nil {
+			service.Status.Recommendations = &aimv1alpha1.AIMServiceRecommendations{
+				LastAnalyzedTime: metav1.NewTime(*lastAnalyzed),
+			}
+		}
+		return service
+	}
+	recent := now.Add(-10 * time.Minute)
+
+	tests := []struct {
+		name     string
+		service  *aimv1alpha1.AIMService
+		wantOK   bool
+		wantWait time.Duration
+	}{
+		{
+			name:    "not running",
+			service: NewService("svc").Build(),
+			wantOK:  false,
+		},
+		{
+			name:     "within warmup",
+			service:  running(now.Add(-5*time.Minute), nil),
+			wantOK:   true,
+			wantWait: 10 * time.Minute,
+		},
+		{
+			name:     "due after warmup",
+			service:  running(now.Add(-time.Hour), nil),
+			wantOK:   true,
+			wantWait: 0,
+		},
+		{
+			name:     "analyzed recently",
+			service:  running(now.Add(-2*time.Hour), &recent),
+			wantOK:   true,
+			wantWait: 50 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, ok := recommendationAnalysisWait(tt.service, now)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if wait.Round(time.Second) != tt.wantWait {
+				t.Errorf("wait = %v, want %v", wait, tt.wantWait)
+			}
+		})
+	}
+}
+
+func TestComputeRecommendations(t *testing.T) {
+	now := time.Now()
+	pods := []corev1.Pod{
+		newRecommendationPod("pod-a", corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("4"),
+			corev1.ResourceMemory: resource.MustParse("64Gi"),
+		}),
+		newRecommendationPod("pod-b", corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("4"),
+			corev1.ResourceMemory: resource.MustParse("64Gi"),
+		}),
+	}
+	metrics := []unstructured.Unstructured{
+		newPodMetrics("pod-a", "3800m", "10Gi"),
+		newPodMetrics("pod-b", "2", "12Gi"),
+		newPodMetrics("pod-gone", "8", "100Gi"),
+	}
+
+	recs := computeRecommendations(pods, metrics, now)
+
+	if recs.SampledPods != 2 {
+		t.Errorf("SampledPods = %d, want 2", recs.SampledPods)
+	}
+	if !recs.LastAnalyzedTime.Time.Equal(now) {
+		t.Errorf("LastAnalyzedTime = %v, want %v", recs.LastAnalyzedTime, now)
+	}
+	if len(recs.Items) != 2 {
+		t.Fatalf("expected 2 recommendations, got %+v", recs.Items)
+	}
+
+	cpu := recs.Items[0]
+	if cpu.Resource != corev1.ResourceCPU || cpu.Action != aimv1alpha1.RecommendationActionIncrease {
+		t.Errorf("expected CPU increase, got %+v", cpu)
+	}
+	if cpu.Observed.Cmp(resource.MustParse("3800m")) != 0 {
+		t.Errorf("observed CPU = %s, want the peak of 3800m", cpu.Observed.String())
+	}
+	if cpu.Recommended.Cmp(resource.MustParse("4940m")) != 0 {
+		t.Errorf("recommended CPU = %s, want 4940m", cpu.Recommended.String())
+	}
+
+	memory := recs.Items[1]
+	if memory.Resource != corev1.ResourceMemory || memory.Action != aimv1alpha1.RecommendationActionDecrease {
+		t.Errorf("expected memory decrease, got %+v", memory)
+	}
+	if memory.Container != constants.ContainerKServe {
+		t.Errorf("container = %q, want %q", memory.Container, constants.ContainerKServe)
+	}
+}
+
+func TestComputeRecommendations_NoRequests(t *testing.T) {
+	pods := []corev1.Pod{newRecommendationPod("pod-a", nil)}
+	metrics := []unstructured.Unstructured{newPodMetrics("pod-a", "500m", "1Gi")}
+
+	recs := computeRecommendations(pods, metrics, time.Now())
+
+	if len(recs.Items) != 2 {
+		t.Fatalf("expected a recommendation per resource, got %+v", recs.Items)
+	}
+	for _, item := range recs.Items {
+		if item.Current != nil || item.Action != aimv1alpha1.RecommendationActionIncrease {
+			t.Errorf("expected an increase without current request, got %+v", item)
+		}
+	}
+}
+
+func TestComputeRecommendations_RequestsFit(t *testing.T) {
+	pods := []corev1.Pod{newRecommendationPod("pod-a", corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("16Gi"),
+	})}
+	metrics := []unstructured.Unstructured{newPodMetrics("pod-a", "1200m", "11Gi")}
+
+	recs := computeRecommendations(pods, metrics, time.Now())
+
+	if len(recs.Items) != 0 {
+		t.Errorf("expected no recommendations, got %+v", recs.Items)
+	}
+}
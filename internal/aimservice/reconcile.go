@@ -26,10 +26,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,14 +42,41 @@ import (
 	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimclustermodelcache"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservicetemplate"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimtemplatecache"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
 )
 
 // ServiceReconciler implements the domain logic for AIMService reconciliation.
 type ServiceReconciler struct {
 	Clientset kubernetes.Interface
 	Scheme    *runtime.Scheme
+
+	// MetricsReader reads pod usage from the metrics API to compute resource recommendations.
+	// Recommendations are disabled when nil.
+	MetricsReader client.Reader
+
+	// SinkDialer checks that request log sinks accept connections. A TCP dial is used when nil.
+	SinkDialer SinkDialer
+
+	// KServeNamespace is the namespace of the KServe ConfigMap that holds the default deployment
+	// mode. DefaultKServeNamespace is used when empty.
+	KServeNamespace string
+
+	// WeightsReloader reloads the weights of running predictor pods under the Reload cache
+	// update policy. Pods are restarted instead when nil.
+	WeightsReloader WeightsReloader
+
+	// EndpointProber verifies the serving endpoint of predictor pods behind the serving
+	// readiness gate. An HTTP request for the served models is used when nil.
+	EndpointProber EndpointProber
+
+	metricsBackoff metricsBackoff
+	deploymentMode deploymentModeCache
+	weightsReloads weightsReloads
 }
 
 // ============================================================================
@@ -56,7 +88,8 @@ type ServiceFetchResult struct {
 	service *aimv1alpha1.AIMService
 
 	// Merged runtime config (provided by reconcile context)
-	mergedRuntimeConfig controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]
+	mergedRuntimeConfig  controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]
+	runtimeConfigSources []aimv1alpha1.AIMResolvedReference
 
 	// Model resolution result (includes existing model or signals creation needed)
 	modelResult ModelFetchResult
@@ -65,16 +98,52 @@ type ServiceFetchResult struct {
 	template        controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
 	clusterTemplate controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]
 
+	// Base of a derived template (only fetched under the Regenerate base change policy)
+	baseTemplate controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
+
 	// Template selection results (when auto-selecting)
 	templateSelection *TemplateSelectionResult
 
+	// GPU placement of the template compared to the service's GPU preference
+	placement *placementEvaluation
+
+	// Validation of image pull and env secrets, nil when the service references none
+	referencedSecrets *referencedSecretsCheck
+
+	// Pull secrets copied from the operator namespace, nil when none are configured or left over
+	syncedPullSecrets *pullSecretSync
+
+	// Request logging validation and sink reachability, nil when request logging is not configured
+	requestLogging *requestLoggingCheck
+
+	// Ensemble variant templates and InferenceServices, nil when the service has no ensemble
+	ensemble *ensembleFetchResult
+
+	// KServe deployment mode of the InferenceService, empty when unknown
+	deploymentMode aimv1alpha1.AIMKServeDeploymentMode
+
 	// Existing downstream resources
 	inferenceService       controllerutils.FetchResult[*servingv1beta1.InferenceService]
 	inferenceServiceEvents controllerutils.FetchResult[*corev1.EventList]
 	inferenceServicePods   *controllerutils.FetchResult[*corev1.PodList]
+	podNodes               controllerutils.FetchResult[[]corev1.Node]
 	hpa                    controllerutils.FetchResult[*autoscalingv2.HorizontalPodAutoscaler]
 	httpRoute              controllerutils.FetchResult[*gatewayapiv1.HTTPRoute]
+	networkPolicy          controllerutils.FetchResult[*networkingv1.NetworkPolicy]
+	endpoint               controllerutils.FetchResult[*aimv1alpha1.AIMEndpoint]
 	templateCache          controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]
+
+	// Serving endpoint probes of predictor pods awaiting the serving readiness gate, by pod name
+	servingProbes map[string]error
+
+	// Cluster model caches shared with the namespace, used in place of the template cache
+	sharedModelCaches controllerutils.FetchResult[[]sharedModelCache]
+
+	// Cache recorded in status, where a cache migration starts from
+	cacheMigrationSource controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]
+
+	// Predictor pod usage, only fetched when a resource recommendation analysis is due
+	podMetrics *controllerutils.FetchResult[*unstructured.UnstructuredList]
 }
 
 // FetchRemoteState fetches all resources needed for AIMService reconciliation.
@@ -95,57 +164,173 @@ func (r *ServiceReconciler) FetchRemoteState(
 	ctx = log.IntoContext(ctx, logger)
 
 	result := ServiceFetchResult{
-		service:             service,
-		mergedRuntimeConfig: reconcileCtx.MergedRuntimeConfig,
-	}
+		service:              service,
+		mergedRuntimeConfig:  reconcileCtx.MergedRuntimeConfig,
+		runtimeConfigSources: reconcileCtx.RuntimeConfigSources,
+	}
+
+	// 1. Fetch independent resources concurrently. Model and template resolution only
+	// reads upstream resources, so it runs alongside the InferenceService fetch and its
+	// result is discarded below if the InferenceService fetch failed transiently.
+	var (
+		modelResult       ModelFetchResult
+		template          controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
+		clusterTemplate   controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]
+		templateSelection *TemplateSelectionResult
+		placement         *placementEvaluation
+	)
+	g := controllerutils.NewFetchGroup(ctx, controllerutils.DefaultFetchConcurrency)
+	g.Go(func(ctx context.Context) {
+		result.inferenceService = fetchInferenceService(ctx, c, service)
+	})
+	// HTTPRoute if routing might be enabled (we own this, always check)
+	g.Go(func(ctx context.Context) {
+		result.httpRoute = fetchHTTPRoute(ctx, c, service, reconcileCtx.MergedRuntimeConfig.Value)
+	})
+	// NetworkPolicy (always fetch - a policy left over after disabling it is removed)
+	g.Go(func(ctx context.Context) {
+		result.networkPolicy = fetchNetworkPolicy(ctx, c, service)
+	})
+	// AIMEndpoint (always fetch - once created, it is kept up to date while the service is not ready)
+	g.Go(func(ctx context.Context) {
+		result.endpoint = fetchEndpoint(ctx, c, service)
+	})
+	// TemplateCache (always fetch - cascades health from Artifact/PVC)
+	// artifact status is resolved through TemplateCache.Status.Artifacts
+	g.Go(func(ctx context.Context) {
+		result.templateCache = fetchTemplateCache(ctx, c, service,
+			resolveStorageEncryption(service, reconcileCtx.MergedRuntimeConfig.Value),
+			resolveTopologyStorageClass(service, reconcileCtx.MergedRuntimeConfig.Value))
+	})
+	g.Go(func(ctx context.Context) {
+		result.cacheMigrationSource = fetchCacheMigrationSource(ctx, c, service)
+	})
+	g.Go(func(ctx context.Context) {
+		result.requestLogging = checkRequestLogging(ctx, r.SinkDialer, service, reconcileCtx.MergedRuntimeConfig.Value)
+	})
+	g.Go(func(ctx context.Context) {
+		result.ensemble = fetchEnsemble(ctx, c, service)
+	})
+	// Model (handles ref, image, custom and alias modes), then template (explicit or auto-select)
+	g.Go(func(ctx context.Context) {
+		modelResult = fetchModel(ctx, c, service)
+		template, clusterTemplate, templateSelection = fetchTemplate(
+			ctx, c, service, modelResult.Model, modelResult.ClusterModel,
+			reconcileCtx.MergedRuntimeConfig.Value,
+		)
+		placement = evaluatePlacement(ctx, c, service, template, clusterTemplate, templateSelection,
+			reconcileCtx.MergedRuntimeConfig.Value)
+		if placement != nil && placement.rebalanceTo != nil {
+			templateSelection = placement.rebalanceTo
+			template = controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: templateSelection.SelectedTemplate}
+			clusterTemplate = controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{Value: templateSelection.SelectedClusterTemplate}
+		}
+		// A service that does not use the template yet is refused once it is at capacity
+		if capacity := checkTemplateCapacity(ctx, c, service, template, clusterTemplate); capacity != nil {
+			templateSelection = capacity
+			template = controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{}
+			clusterTemplate = controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{}
+		}
+	})
+	g.Wait()
 
-	// 1. Fetch existing InferenceService first (gates other fetches)
-	result.inferenceService = fetchInferenceService(ctx, c, service)
+	// Planned annotations, autoscaling and URLs depend on whether KServe runs with Knative
+	if result.inferenceService.IsNotFound() || result.inferenceService.OK() {
+		result.deploymentMode = r.resolveDeploymentMode(ctx, result.inferenceService)
+	}
 
-	// 1b. Fetch events and pods for InferenceService to detect configuration errors
+	// 2. Fetch events, pods and HPA for the InferenceService to detect configuration errors
 	if result.inferenceService.OK() && result.inferenceService.Value != nil {
-		result.inferenceServiceEvents = fetchInferenceServiceEvents(ctx, c, result.inferenceService.Value)
-
-		// Fetch predictor pods to detect ImagePull errors, pending states, etc.
 		isvc := result.inferenceService.Value
-		podsFetchResult := controllerutils.FetchList(ctx, c, &corev1.PodList{},
+		var podsFetchResult controllerutils.FetchResult[*corev1.PodList]
+
+		g := controllerutils.NewFetchGroup(ctx, controllerutils.DefaultFetchConcurrency)
+		g.Go(func(ctx context.Context) {
+			result.inferenceServiceEvents = fetchInferenceServiceEvents(ctx, c, isvc)
+		})
+		// Predictor pods to detect ImagePull errors, pending states, etc.
+		controllerutils.GoFetchList(g, c, &corev1.PodList{}, &podsFetchResult,
 			client.InNamespace(isvc.Namespace),
 			client.MatchingLabels{constants.LabelKServeInferenceService: isvc.Name},
 		)
-		result.inferenceServicePods = &podsFetchResult
+		// HPA to get replica status (KEDA creates HPA with name: keda-hpa-{isvc-name}-predictor)
+		g.Go(func(ctx context.Context) {
+			result.hpa = fetchHPA(ctx, c, isvc)
+		})
+		// Pod usage for resource recommendations, periodically once the service is running
+		now := time.Now()
+		if wait, ok := recommendationAnalysisWait(service, now); ok && wait == 0 &&
+			r.MetricsReader != nil && r.metricsBackoff.allowed(now) {
+			g.Go(func(ctx context.Context) {
+				podMetrics := fetchPodMetrics(ctx, r.MetricsReader, isvc.Namespace, isvc.Name)
+				result.podMetrics = &podMetrics
+			})
+		}
+		g.Wait()
 
-		// Fetch HPA to get replica status (KEDA creates HPA with name: keda-hpa-{isvc-name}-predictor)
-		result.hpa = fetchHPA(ctx, c, isvc)
+		result.inferenceServicePods = &podsFetchResult
+		// Nodes running the predictor pods, to detect unhealthy GPUs
+		if podsFetchResult.OK() && podsFetchResult.Value != nil {
+			result.podNodes = fetchPodNodes(ctx, c, podsFetchResult.Value.Items)
+			// Endpoints of pods behind the serving readiness gate are verified by the operator
+			result.servingProbes = probeServingEndpoints(ctx, r.EndpointProber, podsFetchResult.Value.Items)
+		}
+		if result.podMetrics != nil && result.podMetrics.HasError() {
+			// The metrics API is optional, so failures only postpone the analysis
+			logger.V(1).Info("Pod metrics unavailable, postponing resource recommendations",
+				"error", result.podMetrics.Error.Error())
+			r.metricsBackoff.fail(now)
+		}
 	}
 
-	// 2. Fetch HTTPRoute if routing might be enabled (we own this, always check)
-	result.httpRoute = fetchHTTPRoute(ctx, c, service, reconcileCtx.MergedRuntimeConfig.Value)
-
-	// 3. Fetch TemplateCache (always fetch - cascades health from Artifact/PVC)
-	// artifact status is resolved through TemplateCache.Status.Artifacts
-	result.templateCache = fetchTemplateCache(ctx, c, service)
-
-	// 4. Fetch Model and Template for both creation and update of the InferenceService.
+	// 3. Use Model and Template for both creation and update of the InferenceService.
 	// Mutable fields (replicas, autoscaling, env, resources, etc.) must propagate to an
 	// existing ISVC via SSA, so we always resolve upstream resources when the ISVC fetch
 	// succeeded (OK) or when the ISVC doesn't exist yet (NotFound).
 	// Skip only on transient fetch errors to avoid re-resolving with stale data, which
 	// could cause SSA to update an existing resource unintentionally.
 	if result.inferenceService.IsNotFound() || result.inferenceService.OK() {
-		logger.V(1).Info("Fetching upstream resources",
+		logger.V(1).Info("Using upstream resources",
 			"isvcExists", result.inferenceService.OK(),
 			"isvcNotFound", result.inferenceService.IsNotFound(),
 		)
+		result.modelResult = modelResult
+		result.template, result.clusterTemplate, result.templateSelection = template, clusterTemplate, templateSelection
+		result.placement = placement
+
+		// The base of a derived template is only needed to regenerate it after the base changed
+		if service.Spec.GetBaseChangePolicy() == aimv1alpha1.BaseTemplateChangePolicyRegenerate &&
+			result.template.OK() && aimservicetemplate.IsDerivedTemplate(result.template.Value) {
+			result.baseTemplate = aimservicetemplate.FetchBaseTemplate(ctx, c, result.template.Value)
+		}
 
-		// Resolve model (handles ref, image, and custom modes)
-		result.modelResult = fetchModel(ctx, c, service)
+		// Secrets are checked before the InferenceService is applied, so a missing or malformed
+		// secret surfaces on the service instead of as image pull or startup failures
+		var templateSpec *aimv1alpha1.AIMServiceTemplateSpecCommon
+		if result.template.OK() && result.template.Value != nil {
+			templateSpec = &result.template.Value.Spec.AIMServiceTemplateSpecCommon
+		} else if result.clusterTemplate.OK() && result.clusterTemplate.Value != nil {
+			templateSpec = &result.clusterTemplate.Value.Spec.AIMServiceTemplateSpecCommon
+		}
+		refs := collectSecretReferences(service, templateSpec, reconcileCtx.MergedRuntimeConfig.Value)
+		if len(refs) > 0 && r.Clientset != nil {
+			result.referencedSecrets = &referencedSecretsCheck{
+				count: countSecrets(refs),
+				err:   validateReferencedSecrets(ctx, r.Clientset, service.Namespace, refs),
+			}
+		}
+		result.syncedPullSecrets = fetchSyncedPullSecrets(ctx, c, r.Clientset, service, reconcileCtx.RuntimeConfigSources)
 
-		// Resolve template (explicit or auto-select)
-		result.template, result.clusterTemplate, result.templateSelection = fetchTemplate(
-			ctx, c, service, result.modelResult.Model, result.modelResult.ClusterModel,
-		)
+		var templateStatus *aimv1alpha1.AIMServiceTemplateStatus
+		if result.template.OK() && result.template.Value != nil {
+			templateStatus = &result.template.Value.Status
+		} else if result.clusterTemplate.OK() && result.clusterTemplate.Value != nil {
+			templateStatus = &result.clusterTemplate.Value.Status
+		}
+		result.sharedModelCaches = fetchSharedModelCaches(ctx, c, service, templateStatus,
+			result.inferenceService.Value, reconcileCtx.MergedRuntimeConfig.Value)
 	} else {
-		logger.V(1).Info("Transient error fetching InferenceService, skipping upstream fetch to avoid accidental changes")
+		logger.V(1).Info("Transient error fetching InferenceService, skipping upstream resources to avoid accidental changes")
 	}
 
 	return result
@@ -178,11 +363,44 @@ func (obs ServiceObservation) GetComponentHealth(ctx context.Context, clientset
 		))
 	}
 
+	// Referenced secrets health (upstream)
+	if obs.referencedSecrets != nil || obs.syncedPullSecrets != nil {
+		health = append(health, obs.getSecretsHealth())
+	}
+
+	// Request logging settings (upstream)
+	if obs.requestLogging != nil {
+		health = append(health, obs.getRequestLoggingHealth())
+	}
+
+	// Network policy settings (upstream)
+	if networkPolicy, ok := obs.getNetworkPolicyHealth(); ok {
+		health = append(health, networkPolicy)
+	}
+
+	// Env template variables (upstream)
+	if obs.envTemplates != nil {
+		health = append(health, obs.getEnvTemplateHealth())
+	}
+
+	// Maintenance window settings (upstream)
+	if obs.maintenance != nil {
+		health = append(health, obs.getMaintenanceWindowHealth())
+	}
+
 	// InferenceService health (downstream)
 	if obs.inferenceService.Value != nil || obs.inferenceService.Error != nil {
 		health = append(health, obs.getInferenceServiceHealth())
 	}
 
+	// Predictor and routes health (downstream) - KServe readiness details behind the ISVC Ready condition
+	if predictor, ok := obs.getPredictorHealth(); ok {
+		health = append(health, predictor)
+	}
+	if routes, ok := obs.getRoutesHealth(); ok {
+		health = append(health, routes)
+	}
+
 	// InferenceService pod health (downstream) - for ImagePull errors, pending states, etc.
 	if obs.inferenceServicePods != nil {
 		health = append(health, obs.inferenceServicePods.ToComponentHealthWithContext(
@@ -235,7 +453,12 @@ func (obs ServiceObservation) getModelHealth() controllerutils.ComponentHealth {
 	}
 	// Check OK() and that model was actually populated (Name != "" guards against empty Fetch result)
 	if mr.Model.OK() && mr.Model.Value != nil && mr.Model.Value.Name != "" {
-		return evaluateModelStatus(mr.Model.Value.Status.Status, "AIMModel", mr.Model.Value.Name)
+		if health, violated := checkModelSecurityPolicy(mr.Model.Value.Status.Conditions, "AIMModel", mr.Model.Value.Name); violated {
+			return health
+		}
+		return evaluateModelStatus(mr.Model.Value.Status.Status, controllerutils.ObjectRef{
+			Kind: "AIMModel", Namespace: mr.Model.Value.Namespace, Name: mr.Model.Value.Name,
+		})
 	}
 
 	// Check cluster-scoped model
@@ -251,7 +474,18 @@ func (obs ServiceObservation) getModelHealth() controllerutils.ComponentHealth {
 	}
 	// Check OK() and that model was actually populated (Name != "" guards against empty Fetch result)
 	if mr.ClusterModel.OK() && mr.ClusterModel.Value != nil && mr.ClusterModel.Value.Name != "" {
-		return evaluateModelStatus(mr.ClusterModel.Value.Status.Status, "AIMClusterModel", mr.ClusterModel.Value.Name)
+		name := mr.ClusterModel.Value.Name
+		if health, denied := checkTenancy("Model", "AIMClusterModel", name, obs.service.Namespace,
+			aimv1alpha1.AIMServiceReasonClusterModelForbidden,
+			clusterModelAllowedByTenancy(obs.mergedRuntimeConfig.Value, name)); denied {
+			return health
+		}
+		if health, violated := checkModelSecurityPolicy(mr.ClusterModel.Value.Status.Conditions, "AIMClusterModel", mr.ClusterModel.Value.Name); violated {
+			return health
+		}
+		return evaluateModelStatus(mr.ClusterModel.Value.Status.Status, controllerutils.ObjectRef{
+			Kind: "AIMClusterModel", Name: mr.ClusterModel.Value.Name,
+		})
 	}
 
 	// If InferenceService exists and model was previously resolved, report as ready.
@@ -277,11 +511,32 @@ func (obs ServiceObservation) getModelHealth() controllerutils.ComponentHealth {
 	}
 }
 
-func evaluateModelStatus(status constants.AIMStatus, kind, name string) controllerutils.ComponentHealth {
+// checkModelSecurityPolicy refuses models whose image violates the runtime config's security
+// policy. The violation is reported as an invalid spec, which keeps the InferenceService from
+// being created until the image is replaced or the policy is relaxed.
+func checkModelSecurityPolicy(conditions []metav1.Condition, kind, name string) (controllerutils.ComponentHealth, bool) {
+	cond := meta.FindStatusCondition(conditions, aimv1alpha1.AIMModelConditionSecurityPolicyViolated)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		return controllerutils.ComponentHealth{}, false
+	}
+	return controllerutils.ComponentHealth{
+		Component:      "Model",
+		State:          constants.AIMStatusFailed,
+		DependencyType: controllerutils.DependencyTypeUpstream,
+		Errors: []error{controllerutils.NewInvalidSpecError(
+			aimv1alpha1.AIMServiceReasonModelSecurityPolicyViolated,
+			fmt.Sprintf("%s %s violates the security policy: %s", kind, name, cond.Message),
+			nil,
+		)},
+	}, true
+}
+
+func evaluateModelStatus(status constants.AIMStatus, ref controllerutils.ObjectRef) controllerutils.ComponentHealth {
 	health := controllerutils.ComponentHealth{
 		Component:      "Model",
 		DependencyType: controllerutils.DependencyTypeUpstream,
 	}
+	kind, name := ref.Kind, ref.Name
 
 	switch status {
 	case constants.AIMStatusReady:
@@ -291,7 +546,7 @@ func evaluateModelStatus(status constants.AIMStatus, kind, name string) controll
 	case constants.AIMStatusPending, constants.AIMStatusProgressing:
 		health.State = constants.AIMStatusProgressing
 		health.Reason = aimv1alpha1.AIMServiceReasonModelNotReady
-		health.Message = kind + " " + name + " is not ready yet"
+		health.WaitingOn = &ref
 	case constants.AIMStatusFailed, constants.AIMStatusDegraded:
 		health.State = constants.AIMStatusFailed
 		health.Reason = aimv1alpha1.AIMServiceReasonModelNotReady
@@ -322,12 +577,30 @@ func (obs ServiceObservation) getTemplateHealth() controllerutils.ComponentHealt
 
 	// Check namespace-scoped template (OK() means no error, Name != "" guards against empty Fetch result)
 	if obs.template.OK() && obs.template.Value != nil && obs.template.Value.Name != "" {
-		return evaluateTemplateStatus(obs.template.Value.Status.Status, "AIMServiceTemplate", obs.template.Value.Name)
+		// Under the Derive policy the base template stands in until the derived one is created
+		if derivedName, pending := obs.pendingDerivedTemplate(); pending && obs.template.Value.Status.Status == constants.AIMStatusReady {
+			health.State = constants.AIMStatusProgressing
+			health.Reason = aimv1alpha1.AIMServiceReasonDerivedTemplatePending
+			health.Message = "Waiting for derived template " + derivedName + " to be created from " + obs.template.Value.Name
+			health.WaitingOn = &controllerutils.ObjectRef{Kind: "AIMServiceTemplate", Namespace: obs.service.Namespace, Name: derivedName}
+			return health
+		}
+		return evaluateTemplateStatus(obs.template.Value.Status.Status, controllerutils.ObjectRef{
+			Kind: "AIMServiceTemplate", Namespace: obs.template.Value.Namespace, Name: obs.template.Value.Name,
+		})
 	}
 
 	// Check cluster-scoped template (same guards for empty Fetch result)
 	if obs.clusterTemplate.OK() && obs.clusterTemplate.Value != nil && obs.clusterTemplate.Value.Name != "" {
-		return evaluateTemplateStatus(obs.clusterTemplate.Value.Status.Status, "AIMClusterServiceTemplate", obs.clusterTemplate.Value.Name)
+		name := obs.clusterTemplate.Value.Name
+		if health, denied := checkTenancy("Template", "AIMClusterServiceTemplate", name, obs.service.Namespace,
+			aimv1alpha1.AIMServiceReasonClusterTemplateForbidden,
+			clusterTemplateAllowedByTenancy(obs.mergedRuntimeConfig.Value, name)); denied {
+			return health
+		}
+		return evaluateTemplateStatus(obs.clusterTemplate.Value.Status.Status, controllerutils.ObjectRef{
+			Kind: "AIMClusterServiceTemplate", Name: obs.clusterTemplate.Value.Name,
+		})
 	}
 
 	// Check for selection errors
@@ -339,6 +612,20 @@ func (obs ServiceObservation) getTemplateHealth() controllerutils.ComponentHealt
 			health.Errors = []error{obs.templateSelection.Error}
 			return health
 		}
+		if obs.templateSelection.SelectionReason == aimv1alpha1.AIMServiceReasonTemplateNamespaceDenied {
+			health.State = constants.AIMStatusFailed
+			health.Reason = obs.templateSelection.SelectionReason
+			health.Message = obs.templateSelection.SelectionMessage
+			return health
+		}
+		if obs.templateSelection.SelectionReason == aimv1alpha1.AIMServiceReasonInsufficientGPUHeadroom ||
+			obs.templateSelection.SelectionReason == aimv1alpha1.AIMServiceReasonTemplateAtCapacity {
+			// GPUs and template slots may free up, so this is not a failure
+			health.State = constants.AIMStatusPending
+			health.Reason = obs.templateSelection.SelectionReason
+			health.Message = obs.templateSelection.SelectionMessage
+			return health
+		}
 		if obs.templateSelection.TemplatesExistButNotReady {
 			health.State = constants.AIMStatusProgressing
 			health.Reason = aimv1alpha1.AIMServiceReasonTemplateNotReady
@@ -367,11 +654,12 @@ func (obs ServiceObservation) getTemplateHealth() controllerutils.ComponentHealt
 	return health
 }
 
-func evaluateTemplateStatus(status constants.AIMStatus, kind, name string) controllerutils.ComponentHealth {
+func evaluateTemplateStatus(status constants.AIMStatus, ref controllerutils.ObjectRef) controllerutils.ComponentHealth {
 	health := controllerutils.ComponentHealth{
 		Component:      "Template",
 		DependencyType: controllerutils.DependencyTypeUpstream,
 	}
+	kind, name := ref.Kind, ref.Name
 
 	switch status {
 	case constants.AIMStatusReady:
@@ -381,7 +669,7 @@ func evaluateTemplateStatus(status constants.AIMStatus, kind, name string) contr
 	case constants.AIMStatusPending, constants.AIMStatusProgressing:
 		health.State = constants.AIMStatusProgressing
 		health.Reason = aimv1alpha1.AIMServiceReasonTemplateNotReady
-		health.Message = kind + " " + name + " is not ready yet"
+		health.WaitingOn = &ref
 	case constants.AIMStatusNotAvailable:
 		health.State = constants.AIMStatusNotAvailable
 		health.Reason = aimv1alpha1.AIMServiceReasonTemplateNotReady
@@ -422,6 +710,13 @@ func (f ServiceFetchResult) getInferenceServiceHealth() controllerutils.Componen
 
 	isvc := f.inferenceService.Value
 
+	// Never adopt an InferenceService that another owner created under the generated name
+	if err := f.checkNameCollision(isvc, "InferenceService"); err != nil {
+		health.State = constants.AIMStatusFailed
+		health.Errors = []error{err}
+		return health
+	}
+
 	// Check for fatal configuration errors in events (e.g., ServerlessModeRejected, InternalError)
 	if f.inferenceServiceEvents.OK() && f.inferenceServiceEvents.Value != nil {
 		for _, event := range f.inferenceServiceEvents.Value.Items {
@@ -501,8 +796,9 @@ func (obs ServiceObservation) getHTTPRouteHealth() controllerutils.ComponentHeal
 		health.Reason = "GatewayNotConfigured"
 		health.Message = "Routing is enabled but no gatewayRef is configured in service or runtime config"
 		health.Errors = []error{
-			controllerutils.NewInvalidSpecError(
+			controllerutils.NewInvalidSpecFieldError(
 				"GatewayNotConfigured",
+				"spec.routing.gatewayRef",
 				"Routing is enabled but no gatewayRef is configured. Set spec.routing.gatewayRef on the service or runtimeConfig.routing.gatewayRef on the runtime config.",
 				nil,
 			),
@@ -510,6 +806,13 @@ func (obs ServiceObservation) getHTTPRouteHealth() controllerutils.ComponentHeal
 		return health
 	}
 
+	// Settings without a Gateway API field need an annotation the gateway understands
+	if err := resolveRouteSettings(obs.service, runtimeConfig).validate(); err != nil {
+		health.State = constants.AIMStatusFailed
+		health.Errors = []error{err}
+		return health
+	}
+
 	// Gateway is configured - check the HTTPRoute status
 	if obs.httpRoute.Error != nil {
 		if obs.httpRoute.IsNotFound() {
@@ -527,6 +830,15 @@ func (obs ServiceObservation) getHTTPRouteHealth() controllerutils.ComponentHeal
 		return health
 	}
 
+	// Never adopt an HTTPRoute that another owner created under the generated name
+	if obs.httpRoute.Value != nil {
+		if err := obs.checkNameCollision(obs.httpRoute.Value, "HTTPRoute"); err != nil {
+			health.State = constants.AIMStatusFailed
+			health.Errors = []error{err}
+			return health
+		}
+	}
+
 	// Delegate to the standard HTTPRoute health check
 	return obs.httpRoute.ToComponentHealth("HTTPRoute", controllerutils.GetHTTPRouteHealth)
 }
@@ -621,6 +933,36 @@ func (obs ServiceObservation) getHPAHealth() controllerutils.ComponentHealth {
 	return health
 }
 
+// checkNameCollision returns an InvalidSpec error if obj exists under a generated name but is
+// not controlled by the service.
+func (f ServiceFetchResult) checkNameCollision(obj metav1.Object, kind string) error {
+	if err := utils.CheckNameCollision(obj, kind, f.service.UID); err != nil {
+		return controllerutils.NewInvalidSpecError(aimv1alpha1.AIMServiceReasonNameCollision, err.Error(), err)
+	}
+	return nil
+}
+
+// setNameCollisionCondition reports generated names that are taken by objects the service does not control.
+func setNameCollisionCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	var messages []string
+	if obs.inferenceService.OK() && obs.inferenceService.Value != nil {
+		if err := obs.checkNameCollision(obs.inferenceService.Value, "InferenceService"); err != nil {
+			messages = append(messages, controllerutils.CategorizeError(err).UserMessage())
+		}
+	}
+	if obs.httpRoute.OK() && obs.httpRoute.Value != nil {
+		if err := obs.checkNameCollision(obs.httpRoute.Value, "HTTPRoute"); err != nil {
+			messages = append(messages, controllerutils.CategorizeError(err).UserMessage())
+		}
+	}
+	if len(messages) == 0 {
+		cm.Delete(aimv1alpha1.AIMServiceConditionNameCollision)
+		return
+	}
+	cm.Set(aimv1alpha1.AIMServiceConditionNameCollision, metav1.ConditionTrue, aimv1alpha1.AIMServiceReasonNameCollision,
+		strings.Join(messages, "; ")+". Rename or delete the existing object, or rename the service.", controllerutils.AsError())
+}
+
 // isInferenceServiceReady checks if the InferenceService has Ready=True condition.
 func (obs ServiceObservation) isInferenceServiceReady() bool {
 	if obs.inferenceService.Error != nil || obs.inferenceService.Value == nil {
@@ -645,14 +987,53 @@ func getHPACondition(hpa *autoscalingv2.HorizontalPodAutoscaler, condType autosc
 	return nil
 }
 
+// templateCacheRef identifies a template cache the service is waiting on.
+func templateCacheRef(cache *aimv1alpha1.AIMTemplateCache) *controllerutils.ObjectRef {
+	return &controllerutils.ObjectRef{Kind: "AIMTemplateCache", Namespace: cache.Namespace, Name: cache.Name}
+}
+
 func (obs ServiceObservation) getCacheHealth() controllerutils.ComponentHealth {
 	health := controllerutils.ComponentHealth{
 		Component:      "Cache",
 		DependencyType: controllerutils.DependencyTypeDownstream,
 	}
 
+	if obs.sharedModelCaches.HasError() {
+		health.Errors = []error{obs.sharedModelCaches.Error}
+		return health
+	}
+	if len(obs.sharedModelCaches.Value) > 0 {
+		health.State = constants.AIMStatusReady
+		health.Reason = aimv1alpha1.AIMServiceReasonCacheReady
+		health.Message = "Cluster model caches are shared with the namespace"
+		return health
+	}
+	if obs.lostSharedModelCaches() && !obs.switchesFromSharedModelCaches() {
+		health.State = constants.AIMStatusDegraded
+		health.Reason = aimv1alpha1.AIMServiceReasonCacheLost
+		health.Message = "Cluster model cache is no longer shared with the namespace, switching to a template cache"
+		return health
+	}
+
+	// While migrating, the service keeps serving from the previous cache until the new one is ready
+	if m := obs.cacheMigration; m != nil && m.phase == aimv1alpha1.CacheMigrationPhaseProvisioning &&
+		m.source != nil && m.source.Status.Status == constants.AIMStatusReady &&
+		(obs.templateCache.Value == nil || obs.templateCache.Value.Status.Status != constants.AIMStatusFailed) {
+		health.State = constants.AIMStatusReady
+		health.Reason = aimv1alpha1.AIMServiceReasonCacheReady
+		health.Message = fmt.Sprintf("Serving from template cache %q while the new cache is provisioned", m.source.Name)
+		return health
+	}
+
 	// All caching now goes through template cache (both Shared and Dedicated modes)
 	if obs.templateCache.Value != nil {
+		// The pod downloads the missing files itself once the cache volumes exist
+		if !aimtemplatecache.IsWarm(obs.templateCache.Value) && isTemplateCacheMountable(obs.service, obs.templateCache.Value) {
+			health.State = constants.AIMStatusReady
+			health.Reason = aimv1alpha1.AIMServiceReasonCacheReady
+			health.Message = "Template cache is provisioned, the inference pod downloads the missing model files"
+			return health
+		}
 		switch obs.templateCache.Value.Status.Status {
 		case constants.AIMStatusReady:
 			health.State = constants.AIMStatusReady
@@ -661,7 +1042,7 @@ func (obs ServiceObservation) getCacheHealth() controllerutils.ComponentHealth {
 		case constants.AIMStatusProgressing:
 			health.State = constants.AIMStatusProgressing
 			health.Reason = aimv1alpha1.AIMServiceReasonCacheNotReady
-			health.Message = "Template cache is progressing"
+			health.WaitingOn = templateCacheRef(obs.templateCache.Value)
 		case constants.AIMStatusFailed:
 			health.State = constants.AIMStatusFailed
 			health.Reason = aimv1alpha1.AIMServiceReasonCacheFailed
@@ -669,7 +1050,7 @@ func (obs ServiceObservation) getCacheHealth() controllerutils.ComponentHealth {
 		default:
 			health.State = constants.AIMStatusProgressing
 			health.Reason = aimv1alpha1.AIMServiceReasonCacheCreating
-			health.Message = "Template cache status: " + string(obs.templateCache.Value.Status.Status)
+			health.WaitingOn = templateCacheRef(obs.templateCache.Value)
 		}
 		return health
 	}
@@ -734,11 +1115,30 @@ type ServiceObservation struct {
 	// runtimeStatus captures the computed runtime status including replica counts and resource usage.
 	// Derived in ComposeState from the InferenceService and pods.
 	runtimeStatus *aimv1alpha1.AIMServiceRuntimeStatus
+
+	// cacheMigration is set while the service moves to a cache matching changed caching settings.
+	cacheMigration *cacheMigration
+
+	// weightsRefresh is set while the running pods have not loaded a cache refreshed in place.
+	// Derived in ComposeState before the maintenance window, which may defer a restart.
+	weightsRefresh *weightsRefresh
+
+	// maintenance is the maintenance window state, nil when the service has no maintenance window.
+	// Derived in ComposeState after the cache migration, which affects the planned volumes.
+	maintenance *maintenanceCheck
+
+	// envTemplates is the result of expanding $(NAME) variables in the inference container's env,
+	// nil when no env value references one.
+	envTemplates *envTemplateCheck
+
+	// gpuHealth is the GPU health of the nodes running the predictor pods,
+	// nil when no predictor pod is scheduled to a node.
+	gpuHealth *gpuHealthCheck
 }
 
 // ComposeState creates the observation from fetched data, deriving semantic state.
 func (r *ServiceReconciler) ComposeState(
-	_ context.Context,
+	ctx context.Context,
 	_ controllerutils.ReconcileContext[*aimv1alpha1.AIMService],
 	fetch ServiceFetchResult,
 ) ServiceObservation {
@@ -780,6 +1180,26 @@ func (r *ServiceReconciler) ComposeState(
 	// Compute runtime status from InferenceService and pods
 	obs.runtimeStatus = r.computeRuntimeStatus(fetch)
 
+	// Track moves to a new cache after the caching settings changed
+	obs.cacheMigration = composeCacheMigration(
+		fetch.service, fetch.cacheMigrationSource, fetch.templateCache.Value,
+		fetch.inferenceService.Value, obs.isInferenceServiceReady(),
+		resolveStorageEncryption(fetch.service, fetch.mergedRuntimeConfig.Value),
+		resolveTopologyStorageClass(fetch.service, fetch.mergedRuntimeConfig.Value), time.Now(),
+	)
+
+	// Compare the mounted revisions with those the running pods loaded
+	obs.weightsRefresh = r.composeWeightsRefresh(fetch)
+
+	// Reject env values that reference unknown variables before the InferenceService is planned
+	obs.envTemplates = obs.checkEnvTemplates()
+
+	// Defer changes that restart the predictor pods outside the maintenance window
+	obs.maintenance = obs.checkMaintenanceWindow(ctx, time.Now())
+
+	// Detect unhealthy GPUs on the nodes running the predictor pods
+	obs.gpuHealth = obs.checkGPUHealth()
+
 	return obs
 }
 
@@ -843,6 +1263,62 @@ func (r *ServiceReconciler) PlanResources(
 
 	planResult := controllerutils.PlanResult{}
 
+	// Schedule the next resource recommendation analysis while the service is running
+	if obs.podMetrics != nil {
+		planResult.RequeueAfter = recommendationInterval
+	} else if wait, ok := recommendationAnalysisWait(service, time.Now()); ok && wait > 0 {
+		planResult.RequeueAfter = wait
+	}
+
+	// A more preferred GPU model may gain capacity, so check the placement periodically
+	if obs.placement != nil && obs.placement.recheck &&
+		(planResult.RequeueAfter == 0 || planResult.RequeueAfter > placementRecheckInterval) {
+		planResult.RequeueAfter = placementRecheckInterval
+	}
+
+	// Free GPUs change without events on the service, so retry selection periodically
+	if obs.templateSelection != nil &&
+		obs.templateSelection.SelectionReason == aimv1alpha1.AIMServiceReasonInsufficientGPUHeadroom &&
+		(planResult.RequeueAfter == 0 || planResult.RequeueAfter > gpuHeadroomRetryInterval) {
+		planResult.RequeueAfter = gpuHeadroomRetryInterval
+	}
+
+	// Template slots free up without events on the service, so retry while at capacity
+	if obs.templateSelection != nil &&
+		obs.templateSelection.SelectionReason == aimv1alpha1.AIMServiceReasonTemplateAtCapacity &&
+		(planResult.RequeueAfter == 0 || planResult.RequeueAfter > templateCapacityRetryInterval) {
+		planResult.RequeueAfter = templateCapacityRetryInterval
+	}
+
+	// The request log sink may come up without events on the service, so check it periodically
+	if obs.requestLogging != nil && obs.requestLogging.sinkErr != nil &&
+		(planResult.RequeueAfter == 0 || planResult.RequeueAfter > requestLogSinkRetryInterval) {
+		planResult.RequeueAfter = requestLogSinkRetryInterval
+	}
+
+	// Apply deferred changes when the next maintenance window opens
+	if m := obs.maintenance; m != nil && m.deferred && !m.opensAt.IsZero() {
+		if wait := time.Until(m.opensAt); planResult.RequeueAfter == 0 || planResult.RequeueAfter > wait {
+			planResult.RequeueAfter = max(wait, time.Second)
+		}
+	}
+
+	// Move a predictor pod off a node with unhealthy GPUs, then check the next one once it is running
+	if obs.gpuHealth != nil && obs.gpuHealth.reschedule != nil {
+		logger.Info("Rescheduling predictor pod from node with unhealthy GPUs",
+			"pod", obs.gpuHealth.reschedule.Name, "node", obs.gpuHealth.reschedule.Spec.NodeName)
+		planResult.Delete(obs.gpuHealth.reschedule)
+		if planResult.RequeueAfter == 0 || planResult.RequeueAfter > gpuHealthRescheduleInterval {
+			planResult.RequeueAfter = gpuHealthRescheduleInterval
+		}
+	}
+
+	// Open the serving readiness gate of predictor pods once their cache and endpoint are verified
+	planServingReadinessGates(&planResult, obs)
+
+	// Reload a cache refreshed in place in the running predictor pods
+	r.planWeightsReload(&planResult, obs)
+
 	// 0. Plan model creation if needed (before template check - model can be created independently)
 	// Both custom and image-based models are shared (no owner reference)
 	// Custom models use service label for reconciliation tracking
@@ -856,6 +1332,15 @@ func (r *ServiceReconciler) PlanResources(
 		planResult.Apply(route)
 	}
 
+	// Plan the NetworkPolicy isolating the inference pods, or remove it once disabled
+	planNetworkPolicy(&planResult, obs)
+
+	// Plan ensemble variants, which only depend on the resolved model and their own templates
+	planEnsemble(&planResult, obs)
+
+	// Publish the consumer contract once the service is ready
+	planEndpoint(&planResult, obs)
+
 	// Get resolved template info
 	templateName, templateNamespace, templateSpec, templateStatus := obs.getResolvedTemplate()
 	_ = templateNamespace // Used for future enhancements
@@ -877,11 +1362,27 @@ func (r *ServiceReconciler) PlanResources(
 		}
 	}
 
+	// Nothing is deployed from the base template while the derived template is pending
+	if derivedName, pending := obs.pendingDerivedTemplate(); pending {
+		logger.V(1).Info("derived template pending, skipping template-dependent resource planning", "derivedTemplate", derivedName)
+		return planResult
+	}
+
 	// 3. Plan template cache for all caching modes
 	// Ownership depends on caching mode:
 	// - Shared: no owner reference, cache persists independently
 	// - Dedicated: owned by service, garbage collected with it
-	if templateCache := planTemplateCache(service, templateName, templateSpec, templateStatus, obs); templateCache != nil {
+	// Cluster model caches shared with the namespace replace the template cache; they are
+	// mounted through a per-namespace volume pair owned by the cluster cache.
+	if shared := obs.sharedModelCaches.Value; len(shared) > 0 {
+		for _, s := range shared {
+			pv, pvc := aimclustermodelcache.BuildSharedVolume(s.cache, s.volume, service.Namespace)
+			planResult.ApplyWithoutOwnerRef(pv)
+			planResult.ApplyWithoutOwnerRef(pvc)
+		}
+	} else if obs.sharedModelCaches.HasError() {
+		logger.V(1).Info("cluster model caches unavailable, skipping template cache planning")
+	} else if templateCache := planTemplateCache(service, templateName, templateSpec, templateStatus, obs); templateCache != nil {
 		cachingMode := service.Spec.GetCachingMode()
 		if cachingMode == aimv1alpha1.CachingModeShared {
 			// Shared mode: cache persists independently
@@ -892,8 +1393,22 @@ func (r *ServiceReconciler) PlanResources(
 		}
 	}
 
-	// 4. Plan InferenceService
-	if isvc := planInferenceService(ctx, service, templateName, templateSpec, templateStatus, obs); isvc != nil {
+	// 4. Release the previous cache once a cache migration has switched away from it.
+	// It is only deleted after the InferenceService using the new cache was applied.
+	if source := planCacheMigrationRelease(obs); source != nil {
+		planResult.DeleteAfterApply(source)
+	}
+
+	// 5. Copy pull secrets synced from the operator namespace before the pods reference them
+	planSyncedPullSecrets(&planResult, service, obs.syncedPullSecrets)
+
+	// 6. Plan InferenceService
+	// Under the ApplyInPlace overrides policy, overrides only affect the InferenceService.
+	isvcTemplateSpec, isvcTemplateStatus := applyOverridesInPlace(service, templateSpec, templateStatus)
+	// Outside the maintenance window, changes that restart the predictor pods are deferred.
+	if obs.maintenance != nil && obs.maintenance.deferred {
+		logger.V(1).Info("InferenceService changes deferred until the maintenance window opens")
+	} else if isvc := planInferenceService(ctx, service, templateName, isvcTemplateSpec, isvcTemplateStatus, obs); isvc != nil {
 		planResult.Apply(isvc)
 	}
 
@@ -936,9 +1451,13 @@ func (obs ServiceObservation) getResolvedModel() (name string, status *aimv1alph
 // allowing the fetch logic to re-search for better alternatives on subsequent reconciles.
 func (r *ServiceReconciler) DecorateStatus(
 	status *aimv1alpha1.AIMServiceStatus,
-	_ *controllerutils.ConditionManager,
+	cm *controllerutils.ConditionManager,
 	obs ServiceObservation,
 ) {
+	if obs.mergedRuntimeConfig.OK() {
+		status.RuntimeConfigSources = obs.runtimeConfigSources
+	}
+
 	// Set resolved model reference (only if Ready)
 	modelName, modelStatus, isClusterScoped := obs.getResolvedModel()
 	if modelName != "" && modelStatus != nil && modelStatus.Status == constants.AIMStatusReady {
@@ -952,12 +1471,18 @@ func (r *ServiceReconciler) DecorateStatus(
 		}
 		if obs.modelResult.Model.Value != nil {
 			status.ResolvedModel.UID = obs.modelResult.Model.Value.UID
+			status.ResolvedModelVersion = obs.modelResult.Model.Value.Spec.Version
 		} else if obs.modelResult.ClusterModel.Value != nil {
 			status.ResolvedModel.UID = obs.modelResult.ClusterModel.Value.UID
+			status.ResolvedModelVersion = obs.modelResult.ClusterModel.Value.Spec.Version
 		}
 	}
 
 	// Set resolved template reference (only if Ready)
+	status.SelectionMode = obs.service.GetTemplateSelectionMode()
+	if obs.deploymentMode != "" {
+		status.DeploymentMode = obs.deploymentMode
+	}
 	templateName, templateNamespace, _, templateStatus := obs.getResolvedTemplate()
 	if templateName != "" && templateStatus != nil && templateStatus.Status == constants.AIMStatusReady {
 		scope := aimv1alpha1.AIMResolutionScopeCluster
@@ -977,15 +1502,29 @@ func (r *ServiceReconciler) DecorateStatus(
 	}
 
 	// Set cache status (only if Ready)
-	if obs.templateCache.Value != nil && obs.templateCache.Value.Status.Status == constants.AIMStatusReady {
+	var previousLookup *aimv1alpha1.AIMServiceCacheLookup
+	var previousLoadedWeights string
+	if status.Cache != nil {
+		previousLookup = status.Cache.Lookup
+		previousLoadedWeights = status.Cache.LoadedWeightsDigest
+	}
+	if shared := obs.sharedModelCaches.Value; len(shared) > 0 {
+		status.Cache = &aimv1alpha1.AIMServiceCacheStatus{
+			ClusterModelCacheRefs: sharedModelCacheRefs(shared),
+			MountedArtifacts:      mountedArtifacts(obs.service, nil, shared),
+		}
+	} else if obs.templateCache.Value != nil && isTemplateCacheMountable(obs.service, obs.templateCache.Value) {
 		status.Cache = &aimv1alpha1.AIMServiceCacheStatus{
 			TemplateCacheRef: &aimv1alpha1.AIMResolvedReference{
 				Name:      obs.templateCache.Value.Name,
 				Namespace: obs.templateCache.Value.Namespace,
 				UID:       obs.templateCache.Value.UID,
 			},
+			MountedArtifacts: mountedArtifacts(obs.service, obs.templateCache.Value, nil),
 		}
 	}
+	setCacheLookup(status, previousLookup, obs.templateCache)
+	recordLoadedWeights(status, previousLoadedWeights, obs)
 
 	// Set routing status
 	if obs.httpRoute.Value != nil {
@@ -997,4 +1536,39 @@ func (r *ServiceReconciler) DecorateStatus(
 	if obs.runtimeStatus != nil {
 		status.Runtime = obs.runtimeStatus
 	}
+
+	// Report the readiness of each ensemble variant
+	status.Variants = buildVariantStatuses(obs)
+
+	// Report how the predictor pods are spread across failure domains
+	setSpreadStatus(status, obs)
+
+	// Report how overrides were combined with an explicit template
+	status.Overrides = buildOverridesStatus(obs.service, templateName)
+
+	// Record the merged container spec so the deployment can be reproduced
+	status.EffectiveRuntimeSpec = buildEffectiveRuntimeSpec(obs, status.EffectiveRuntimeSpec, time.Now())
+
+	// Publish resource recommendations when usage was sampled in this reconcile
+	if obs.podMetrics != nil && obs.podMetrics.OK() && obs.inferenceServicePods != nil && obs.inferenceServicePods.OK() {
+		status.Recommendations = computeRecommendations(
+			obs.inferenceServicePods.Value.Items, obs.podMetrics.Value.Items, obs.podMetrics.FetchedAt)
+	}
+
+	// Distinguish running pods from a loaded model
+	if cm != nil {
+		setCacheMigrationStatus(status, cm, obs.service, obs.cacheMigration)
+		setCacheReadinessConditions(cm, obs)
+		setPodAndModelConditions(cm, obs)
+		setBaseTemplateChangedCondition(cm, obs)
+		setPlacementStatus(cm, obs.service, obs.placement)
+		setRunningUnoptimizedCondition(cm, obs)
+		setNameCollisionCondition(cm, obs)
+		setRequestLogSinkCondition(cm, obs)
+		setChangesPendingCondition(cm, obs)
+		setHardwareHealthyCondition(cm, obs)
+		setGPUSharedCondition(cm, obs)
+		setWeightsRefreshedCondition(cm, obs)
+		setUpdateStrategyCondition(cm, obs)
+	}
 }
@@ -28,6 +28,8 @@ import (
 
 	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
@@ -273,6 +275,41 @@ func TestGetComponentHealth_ModelHealth(t *testing.T) {
 	}
 }
 
+func TestGetComponentHealth_ModelSecurityPolicyViolated(t *testing.T) {
+	model := NewModel("m").WithStatus(constants.AIMStatusReady).Build()
+	model.Status.Conditions = []metav1.Condition{{
+		Type:    aimv1alpha1.AIMModelConditionSecurityPolicyViolated,
+		Status:  metav1.ConditionTrue,
+		Reason:  aimv1alpha1.AIMModelReasonCVESeverityExceeded,
+		Message: "Image has Critical vulnerabilities",
+	}}
+	obs := ServiceObservation{
+		ServiceFetchResult: ServiceFetchResult{
+			service: NewService("svc").Build(),
+			modelResult: ModelFetchResult{
+				Model: controllerutils.FetchResult[*aimv1alpha1.AIMModel]{Value: model},
+			},
+		},
+	}
+
+	for _, health := range obs.GetComponentHealth(context.Background(), nil) {
+		if health.Component != "Model" {
+			continue
+		}
+		if health.State != constants.AIMStatusFailed || len(health.Errors) != 1 {
+			t.Fatalf("expected failed model health with one error, got %+v", health)
+		}
+		categorized := controllerutils.CategorizeError(health.Errors[0])
+		if categorized.Category() != controllerutils.ErrorCategoryInvalidSpec ||
+			categorized.Reason() != aimv1alpha1.AIMServiceReasonModelSecurityPolicyViolated {
+			t.Errorf("expected InvalidSpec/%s, got %s/%s", aimv1alpha1.AIMServiceReasonModelSecurityPolicyViolated,
+				categorized.Category(), categorized.Reason())
+		}
+		return
+	}
+	t.Fatal("Model health not found")
+}
+
 func TestGetComponentHealth_CacheHealth(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -486,6 +523,44 @@ func TestGetComponentHealth_CacheHealth(t *testing.T) {
 	}
 }
 
+func TestGetComponentHealth_NameCollision(t *testing.T) {
+	service := NewService("svc").Build()
+	isvc := &servingv1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{
+		Name:      "svc-1a2b3c4d",
+		Namespace: testNamespace,
+		OwnerReferences: []metav1.OwnerReference{
+			{Kind: "AIMService", Name: "other", UID: "other-uid", Controller: ptr.To(true)},
+		},
+	}}
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service:          service,
+		inferenceService: controllerutils.FetchResult[*servingv1beta1.InferenceService]{Value: isvc},
+	}}
+
+	health := obs.getInferenceServiceHealth()
+	if health.State != constants.AIMStatusFailed || len(health.Errors) != 1 {
+		t.Fatalf("expected failed health with one error, got %+v", health)
+	}
+	cerr := controllerutils.CategorizeError(health.Errors[0])
+	if cerr.Category() != controllerutils.ErrorCategoryInvalidSpec || cerr.Reason() != aimv1alpha1.AIMServiceReasonNameCollision {
+		t.Errorf("expected NameCollision invalid spec error, got %v", health.Errors[0])
+	}
+
+	cm := controllerutils.NewConditionManager(nil)
+	setNameCollisionCondition(cm, obs)
+	cond := cm.Get(aimv1alpha1.AIMServiceConditionNameCollision)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Fatalf("expected NameCollision=True, got %+v", cond)
+	}
+
+	// Once the service controls the object, the condition is removed
+	isvc.OwnerReferences[0].UID = service.UID
+	setNameCollisionCondition(cm, obs)
+	if cond := cm.Get(aimv1alpha1.AIMServiceConditionNameCollision); cond != nil {
+		t.Errorf("expected no NameCollision condition, got %+v", cond)
+	}
+}
+
 // ============================================================================
 // PLAN RESOURCES TESTS
 // ============================================================================
@@ -0,0 +1,265 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+const (
+	// requestLogSinkRetryInterval is how often an unreachable request log sink is checked again.
+	requestLogSinkRetryInterval = time.Minute
+
+	// requestLogSinkDialTimeout bounds the reachability check of an OTLP sink.
+	requestLogSinkDialTimeout = 3 * time.Second
+
+	// engineArgsLogRequests enables the inference engine's own request logging.
+	engineArgsLogRequests = `{"enable-log-requests":true}`
+)
+
+// Environment variables of the request logging sidecar
+const (
+	envRequestLogPort         = "AIM_REQUEST_LOG_PORT"
+	envRequestLogSampleRate   = "AIM_REQUEST_LOG_SAMPLE_RATE"
+	envRequestLogRedactFields = "AIM_REQUEST_LOG_REDACT_FIELDS"
+	envRequestLogSink         = "AIM_REQUEST_LOG_SINK"
+	envOTLPEndpoint           = "OTEL_EXPORTER_OTLP_ENDPOINT"
+)
+
+// SinkDialer checks that a network address accepts connections.
+type SinkDialer func(ctx context.Context, address string) error
+
+// dialSink is the default SinkDialer, opening and closing a TCP connection.
+func dialSink(ctx context.Context, address string) error {
+	ctx, cancel := context.WithTimeout(ctx, requestLogSinkDialTimeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// requestLoggingCheck is the result of validating the service's request logging settings
+// and checking that the sink is reachable.
+type requestLoggingCheck struct {
+	err     error
+	sinkErr error
+}
+
+// checkRequestLogging validates the request logging settings of the service and, for an
+// OTLP sink, checks that the collector accepts connections. Returns nil when request
+// logging is not configured.
+func checkRequestLogging(
+	ctx context.Context,
+	dial SinkDialer,
+	service *aimv1alpha1.AIMService,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) *requestLoggingCheck {
+	logging := requestLoggingOf(service)
+	if logging == nil {
+		return nil
+	}
+	check := &requestLoggingCheck{err: validateRequestLogging(logging, runtimeConfig)}
+	if check.err != nil || logging.GetSink() != aimv1alpha1.RequestLogSinkOTLP {
+		return check
+	}
+	if dial == nil {
+		dial = dialSink
+	}
+	check.sinkErr = dial(ctx, logging.OTLP.Endpoint)
+	return check
+}
+
+// requestLoggingOf returns the request logging settings of the service, nil when unset.
+func requestLoggingOf(service *aimv1alpha1.AIMService) *aimv1alpha1.AIMRequestLogging {
+	if service.Spec.Observability == nil {
+		return nil
+	}
+	return service.Spec.Observability.RequestLogging
+}
+
+// validateRequestLogging returns an InvalidSpec error when the settings cannot be planned.
+func validateRequestLogging(logging *aimv1alpha1.AIMRequestLogging, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) error {
+	if _, err := requestLogSampleRate(logging); err != nil {
+		return controllerutils.NewInvalidSpecFieldError(aimv1alpha1.AIMServiceReasonInvalidRequestLogging,
+			"spec.observability.requestLogging.sampleRate",
+			fmt.Sprintf("Invalid request logging sampleRate %q: must be a number between 0 and 1", logging.SampleRate), err)
+	}
+	if logging.GetSink() == aimv1alpha1.RequestLogSinkOTLP && (logging.OTLP == nil || logging.OTLP.Endpoint == "") {
+		return controllerutils.NewInvalidSpecFieldError(aimv1alpha1.AIMServiceReasonInvalidRequestLogging,
+			"spec.observability.requestLogging.otlp.endpoint", "Request logging to otlp requires otlp.endpoint", nil)
+	}
+	if needsRequestLoggerSidecar(logging) && requestLoggerImage(runtimeConfig) == "" {
+		return controllerutils.NewInvalidSpecError(aimv1alpha1.AIMServiceReasonInvalidRequestLogging,
+			"Request logging with sampling, redaction or the otlp sink needs a sidecar image, "+
+				"but no runtime config sets requestLogger.image", nil)
+	}
+	return nil
+}
+
+// requestLogSampleRate parses the sample rate, defaulting to 1.
+func requestLogSampleRate(logging *aimv1alpha1.AIMRequestLogging) (float64, error) {
+	if logging.SampleRate == "" {
+		return 1, nil
+	}
+	rate, err := strconv.ParseFloat(logging.SampleRate, 64)
+	if err != nil {
+		return 0, err
+	}
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("sample rate %v out of range", rate)
+	}
+	return rate, nil
+}
+
+// needsRequestLoggerSidecar reports whether the settings go beyond what the inference
+// engine's own request logging supports, which logs every request to stdout unredacted.
+func needsRequestLoggerSidecar(logging *aimv1alpha1.AIMRequestLogging) bool {
+	rate, err := requestLogSampleRate(logging)
+	return err != nil || rate < 1 || len(logging.RedactFields) > 0 || logging.GetSink() != aimv1alpha1.RequestLogSinkStdout
+}
+
+// requestLoggerImage returns the sidecar image from the runtime config, empty when unset.
+func requestLoggerImage(runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) string {
+	if runtimeConfig == nil || runtimeConfig.RequestLogger == nil {
+		return ""
+	}
+	return runtimeConfig.RequestLogger.Image
+}
+
+// applyRequestLogging plans request logging on the InferenceService. Plain stdout logging
+// enables the engine's request logs; anything else routes requests through the KServe
+// inference logger to the logging sidecar, which samples, redacts and writes to the sink.
+func applyRequestLogging(
+	isvc *servingv1beta1.InferenceService,
+	logging *aimv1alpha1.AIMRequestLogging,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) {
+	if logging == nil {
+		return
+	}
+	predictor := &isvc.Spec.Predictor
+	if !needsRequestLoggerSidecar(logging) {
+		container := &predictor.Containers[0]
+		container.Env = utils.MergeEnvVars(container.Env,
+			[]corev1.EnvVar{{Name: utils.EnvVarAIMEngineArgs, Value: engineArgsLogRequests}},
+			utils.EnvVarAIMEngineArgs)
+		return
+	}
+	image := requestLoggerImage(runtimeConfig)
+	if image == "" {
+		return
+	}
+
+	predictor.Logger = &servingv1beta1.LoggerSpec{
+		URL:  ptr.To(fmt.Sprintf("http://localhost:%d", constants.RequestLoggerPort)),
+		Mode: servingv1beta1.LogAll,
+	}
+
+	rate, _ := requestLogSampleRate(logging)
+	env := []corev1.EnvVar{
+		{Name: envRequestLogPort, Value: strconv.Itoa(constants.RequestLoggerPort)},
+		{Name: envRequestLogSampleRate, Value: strconv.FormatFloat(rate, 'f', -1, 64)},
+		{Name: envRequestLogSink, Value: string(logging.GetSink())},
+	}
+	if len(logging.RedactFields) > 0 {
+		env = append(env, corev1.EnvVar{Name: envRequestLogRedactFields, Value: strings.Join(logging.RedactFields, ",")})
+	}
+	if logging.GetSink() == aimv1alpha1.RequestLogSinkOTLP && logging.OTLP != nil {
+		env = append(env, corev1.EnvVar{Name: envOTLPEndpoint, Value: "http://" + logging.OTLP.Endpoint})
+	}
+	predictor.Containers = append(predictor.Containers, corev1.Container{
+		Name:  constants.ContainerRequestLogger,
+		Image: image,
+		Env:   env,
+		Ports: []corev1.ContainerPort{{
+			Name:          "request-log",
+			ContainerPort: constants.RequestLoggerPort,
+			Protocol:      corev1.ProtocolTCP,
+		}},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("50m"),
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+			},
+		},
+	})
+}
+
+// getRequestLoggingHealth reports invalid request logging settings. An unreachable sink
+// does not block the service; it is reported through the RequestLogSinkReachable condition.
+func (f ServiceFetchResult) getRequestLoggingHealth() controllerutils.ComponentHealth {
+	health := controllerutils.ComponentHealth{
+		Component:      "RequestLogging",
+		DependencyType: controllerutils.DependencyTypeUpstream,
+	}
+	if err := f.requestLogging.err; err != nil {
+		health.State = constants.AIMStatusFailed
+		health.Errors = []error{err}
+		return health
+	}
+	health.State = constants.AIMStatusReady
+	health.Reason = "RequestLoggingValid"
+	return health
+}
+
+// setRequestLogSinkCondition reports whether the request log sink is reachable. The condition
+// is removed when request logging is not configured or its settings are invalid.
+func setRequestLogSinkCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	check := obs.requestLogging
+	if check == nil || check.err != nil {
+		cm.Delete(aimv1alpha1.AIMServiceConditionRequestLogSinkReachable)
+		return
+	}
+	logging := requestLoggingOf(obs.service)
+	switch {
+	case logging.GetSink() == aimv1alpha1.RequestLogSinkStdout:
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionRequestLogSinkReachable, aimv1alpha1.AIMServiceReasonSinkReachable,
+			"Request logs are written to the container output")
+	case check.sinkErr != nil:
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionRequestLogSinkReachable, aimv1alpha1.AIMServiceReasonSinkUnreachable,
+			fmt.Sprintf("OTLP endpoint %s is not reachable: %v", logging.OTLP.Endpoint, check.sinkErr))
+	default:
+		cm.MarkTrue(aimv1alpha1.AIMServiceConditionRequestLogSinkReachable, aimv1alpha1.AIMServiceReasonSinkReachable,
+			fmt.Sprintf("OTLP endpoint %s is reachable", logging.OTLP.Endpoint))
+	}
+}
@@ -0,0 +1,180 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+func serviceWithRequestLogging(logging aimv1alpha1.AIMRequestLogging) *aimv1alpha1.AIMService {
+	service := NewService("svc").Build()
+	service.Spec.Observability = &aimv1alpha1.AIMServiceObservability{RequestLogging: &logging}
+	return service
+}
+
+func requestLoggerRuntimeConfig(image string) *aimv1alpha1.AIMRuntimeConfigCommon {
+	return &aimv1alpha1.AIMRuntimeConfigCommon{RequestLogger: &aimv1alpha1.AIMRequestLoggerConfig{Image: image}}
+}
+
+func envValue(env []corev1.EnvVar, name string) string {
+	for _, e := range env {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+func TestApplyRequestLoggingStdoutUsesEngineFlag(t *testing.T) {
+	service := serviceWithRequestLogging(aimv1alpha1.AIMRequestLogging{})
+	isvc := buildInferenceService(service, "tmpl", nil, nil, ServiceObservation{})
+
+	if len(isvc.Spec.Predictor.Containers) != 1 || isvc.Spec.Predictor.Logger != nil {
+		t.Fatalf("expected no sidecar or inference logger for plain stdout logging")
+	}
+	if args := envValue(isvc.Spec.Predictor.Containers[0].Env, utils.EnvVarAIMEngineArgs); args != engineArgsLogRequests {
+		t.Errorf("%s = %q, want %q", utils.EnvVarAIMEngineArgs, args, engineArgsLogRequests)
+	}
+}
+
+func TestApplyRequestLoggingSidecar(t *testing.T) {
+	service := serviceWithRequestLogging(aimv1alpha1.AIMRequestLogging{
+		SampleRate:   "0.25",
+		RedactFields: []string{"prompt", "messages"},
+		Sink:         aimv1alpha1.RequestLogSinkOTLP,
+		OTLP:         &aimv1alpha1.AIMRequestLogOTLPSink{Endpoint: "collector.obs:4317"},
+	})
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
+			Value: requestLoggerRuntimeConfig("registry.example.com/request-logger:v1"),
+		},
+	}}
+	isvc := buildInferenceService(service, "tmpl", nil, nil, obs)
+
+	logger := isvc.Spec.Predictor.Logger
+	if logger == nil || logger.URL == nil || *logger.URL != "http://localhost:9081" {
+		t.Fatalf("expected the inference logger to target the sidecar, got %+v", logger)
+	}
+	containers := isvc.Spec.Predictor.Containers
+	if len(containers) != 2 || containers[1].Name != constants.ContainerRequestLogger {
+		t.Fatalf("expected the request logger sidecar, got %d containers", len(containers))
+	}
+	sidecar := containers[1]
+	for name, want := range map[string]string{
+		envRequestLogSampleRate:   "0.25",
+		envRequestLogRedactFields: "prompt,messages",
+		envRequestLogSink:         "otlp",
+		envOTLPEndpoint:           "http://collector.obs:4317",
+	} {
+		if got := envValue(sidecar.Env, name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if args := envValue(containers[0].Env, utils.EnvVarAIMEngineArgs); args != "" {
+		t.Errorf("expected no engine request logging with the sidecar, got %q", args)
+	}
+}
+
+func TestCheckRequestLogging(t *testing.T) {
+	unreachable := errors.New("connection refused")
+	otlp := aimv1alpha1.AIMRequestLogging{
+		Sink: aimv1alpha1.RequestLogSinkOTLP,
+		OTLP: &aimv1alpha1.AIMRequestLogOTLPSink{Endpoint: "collector.obs:4317"},
+	}
+
+	tests := []struct {
+		name          string
+		logging       aimv1alpha1.AIMRequestLogging
+		runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon
+		dialErr       error
+		wantErr       bool
+		wantSinkErr   bool
+	}{
+		{name: "stdout", logging: aimv1alpha1.AIMRequestLogging{}},
+		{name: "invalid sample rate", logging: aimv1alpha1.AIMRequestLogging{SampleRate: "1.5"}, wantErr: true},
+		{name: "sampling without sidecar image", logging: aimv1alpha1.AIMRequestLogging{SampleRate: "0.5"}, wantErr: true},
+		{name: "otlp reachable", logging: otlp, runtimeConfig: requestLoggerRuntimeConfig("logger:v1")},
+		{name: "otlp unreachable", logging: otlp, runtimeConfig: requestLoggerRuntimeConfig("logger:v1"), dialErr: unreachable, wantSinkErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dialed string
+			dial := func(_ context.Context, address string) error {
+				dialed = address
+				return tt.dialErr
+			}
+			check := checkRequestLogging(testContext(), dial, serviceWithRequestLogging(tt.logging), tt.runtimeConfig)
+			if (check.err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", check.err, tt.wantErr)
+			}
+			if (check.sinkErr != nil) != tt.wantSinkErr {
+				t.Errorf("sinkErr = %v, wantSinkErr %v", check.sinkErr, tt.wantSinkErr)
+			}
+			if tt.logging.Sink == aimv1alpha1.RequestLogSinkOTLP && dialed != "collector.obs:4317" {
+				t.Errorf("dialed %q, want the otlp endpoint", dialed)
+			}
+			if tt.wantErr {
+				if reason := controllerutils.CategorizeError(check.err).Reason(); reason != aimv1alpha1.AIMServiceReasonInvalidRequestLogging {
+					t.Errorf("reason = %q, want %q", reason, aimv1alpha1.AIMServiceReasonInvalidRequestLogging)
+				}
+			}
+
+			cm := controllerutils.NewConditionManager(nil)
+			setRequestLogSinkCondition(cm, ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+				service:        serviceWithRequestLogging(tt.logging),
+				requestLogging: check,
+			}})
+			cond := cm.Get(aimv1alpha1.AIMServiceConditionRequestLogSinkReachable)
+			switch {
+			case tt.wantErr:
+				if cond != nil {
+					t.Errorf("expected no sink condition for invalid settings, got %+v", cond)
+				}
+			case tt.wantSinkErr:
+				if cond == nil || cond.Status != metav1.ConditionFalse {
+					t.Errorf("expected sink condition False, got %+v", cond)
+				}
+			default:
+				if cond == nil || cond.Status != metav1.ConditionTrue {
+					t.Errorf("expected sink condition True, got %+v", cond)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckRequestLoggingNotConfigured(t *testing.T) {
+	if check := checkRequestLogging(testContext(), nil, NewService("svc").Build(), nil); check != nil {
+		t.Errorf("expected nil without request logging, got %+v", check)
+	}
+}
@@ -0,0 +1,139 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// Built-in per-GPU defaults for inference containers, used when the runtime config sets none.
+var (
+	defaultCPUPerGPU           = resource.MustParse("4")
+	defaultMemoryRequestPerGPU = resource.MustParse("32Gi")
+	defaultMemoryLimitPerGPU   = resource.MustParse("48Gi")
+	defaultModelMemoryOverhead = resource.MustParse("8Gi")
+)
+
+// Built-in per-GPU defaults for embedding and reranker models, which need far less host CPU
+// and memory than generative models.
+var (
+	compactCPUPerGPU           = resource.MustParse("2")
+	compactMemoryRequestPerGPU = resource.MustParse("8Gi")
+	compactMemoryLimitPerGPU   = resource.MustParse("16Gi")
+)
+
+// gpuResourceRatios holds the resolved per-GPU CPU and memory defaults, and the minimum
+// memory request derived from the model size.
+type gpuResourceRatios struct {
+	cpu           resource.Quantity
+	memoryRequest resource.Quantity
+	memoryLimit   resource.Quantity
+
+	// minMemory is the model size plus overhead; zero when the model size is unknown
+	minMemory resource.Quantity
+}
+
+// defaultGPUResourceRatios returns the built-in ratios without a model size floor.
+func defaultGPUResourceRatios() gpuResourceRatios {
+	return gpuResourceRatios{
+		cpu:           defaultCPUPerGPU.DeepCopy(),
+		memoryRequest: defaultMemoryRequestPerGPU.DeepCopy(),
+		memoryLimit:   defaultMemoryLimitPerGPU.DeepCopy(),
+	}
+}
+
+// defaultGPUResourceRatiosForClass returns the built-in ratios for the model class.
+func defaultGPUResourceRatiosForClass(modelClass aimv1alpha1.AIMModelClass) gpuResourceRatios {
+	if modelClass == aimv1alpha1.AIMModelClassLLM {
+		return defaultGPUResourceRatios()
+	}
+	return gpuResourceRatios{
+		cpu:           compactCPUPerGPU.DeepCopy(),
+		memoryRequest: compactMemoryRequestPerGPU.DeepCopy(),
+		memoryLimit:   compactMemoryLimitPerGPU.DeepCopy(),
+	}
+}
+
+// resolveGPUResourceRatios resolves the per-GPU ratios for the given GPU model from the runtime
+// config, falling back to the config default and then to the built-in values of the model class
+// for unset fields. The memory floor is the total size of the template's model sources plus the
+// configured overhead.
+func resolveGPUResourceRatios(
+	config *aimv1alpha1.AIMRuntimeConfigCommon,
+	gpuModel string,
+	modelSources []aimv1alpha1.AIMModelSource,
+	modelClass aimv1alpha1.AIMModelClass,
+) gpuResourceRatios {
+	ratios := defaultGPUResourceRatiosForClass(modelClass)
+	overhead := defaultModelMemoryOverhead.DeepCopy()
+
+	if config != nil && config.InferenceResources != nil {
+		cfg := config.InferenceResources
+		applyPerGPUResources(&ratios, cfg.Default)
+		for i := range cfg.GPUModels {
+			if gpuModel != "" && cfg.GPUModels[i].GPUModel == gpuModel {
+				applyPerGPUResources(&ratios, &cfg.GPUModels[i].AIMPerGPUResources)
+				break
+			}
+		}
+		if cfg.ModelMemoryOverhead != nil {
+			overhead = cfg.ModelMemoryOverhead.DeepCopy()
+		}
+	}
+
+	var modelSize resource.Quantity
+	for _, source := range modelSources {
+		if source.Size != nil {
+			modelSize.Add(*source.Size)
+		}
+	}
+	if !modelSize.IsZero() {
+		modelSize.Add(overhead)
+		ratios.minMemory = modelSize
+	}
+
+	return ratios
+}
+
+// applyPerGPUResources overrides the ratios with the fields set in per.
+func applyPerGPUResources(ratios *gpuResourceRatios, per *aimv1alpha1.AIMPerGPUResources) {
+	if per == nil {
+		return
+	}
+	if per.CPU != nil {
+		ratios.cpu = per.CPU.DeepCopy()
+	}
+	if per.MemoryRequest != nil {
+		ratios.memoryRequest = per.MemoryRequest.DeepCopy()
+	}
+	if per.MemoryLimit != nil {
+		ratios.memoryLimit = per.MemoryLimit.DeepCopy()
+	}
+}
+
+// scaleQuantity returns q multiplied by n.
+func scaleQuantity(q resource.Quantity, n int64) resource.Quantity {
+	return *resource.NewMilliQuantity(q.MilliValue()*n, q.Format)
+}
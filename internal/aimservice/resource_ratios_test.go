@@ -0,0 +1,125 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"cmp"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+func TestResolveGPUResourceRatios(t *testing.T) {
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{
+		InferenceResources: &aimv1alpha1.AIMInferenceResourcesConfig{
+			Default: &aimv1alpha1.AIMPerGPUResources{
+				CPU: ptr.To(resource.MustParse("6")),
+			},
+			GPUModels: []aimv1alpha1.AIMGPUModelResources{
+				{
+					GPUModel: "MI325X",
+					AIMPerGPUResources: aimv1alpha1.AIMPerGPUResources{
+						MemoryRequest: ptr.To(resource.MustParse("48Gi")),
+						MemoryLimit:   ptr.To(resource.MustParse("64Gi")),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		config     *aimv1alpha1.AIMRuntimeConfigCommon
+		gpuModel   string
+		modelClass aimv1alpha1.AIMModelClass
+		wantCPU    string
+		wantMemReq string
+		wantMemLim string
+	}{
+		{name: "built-in defaults", gpuModel: "MI300X", wantCPU: "4", wantMemReq: "32Gi", wantMemLim: "48Gi"},
+		{name: "config default for other models", config: config, gpuModel: "MI300X", wantCPU: "6", wantMemReq: "32Gi", wantMemLim: "48Gi"},
+		{name: "per-model ratios over config default", config: config, gpuModel: "MI325X", wantCPU: "6", wantMemReq: "48Gi", wantMemLim: "64Gi"},
+		{name: "compact defaults for embedding models", gpuModel: "MI300X", modelClass: aimv1alpha1.AIMModelClassEmbedding, wantCPU: "2", wantMemReq: "8Gi", wantMemLim: "16Gi"},
+		{name: "config default over compact defaults", config: config, gpuModel: "MI300X", modelClass: aimv1alpha1.AIMModelClassReranker, wantCPU: "6", wantMemReq: "8Gi", wantMemLim: "16Gi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratios := resolveGPUResourceRatios(tt.config, tt.gpuModel, nil, cmp.Or(tt.modelClass, aimv1alpha1.AIMModelClassLLM))
+			for name, pair := range map[string][2]resource.Quantity{
+				"cpu":           {ratios.cpu, resource.MustParse(tt.wantCPU)},
+				"memoryRequest": {ratios.memoryRequest, resource.MustParse(tt.wantMemReq)},
+				"memoryLimit":   {ratios.memoryLimit, resource.MustParse(tt.wantMemLim)},
+			} {
+				if pair[0].Cmp(pair[1]) != 0 {
+					t.Errorf("%s = %s, want %s", name, pair[0].String(), pair[1].String())
+				}
+			}
+			if !ratios.minMemory.IsZero() {
+				t.Errorf("expected no memory floor without model sources, got %s", ratios.minMemory.String())
+			}
+		})
+	}
+}
+
+func TestDefaultResourceRequirementsForGPU_ModelSizeFloor(t *testing.T) {
+	sources := []aimv1alpha1.AIMModelSource{
+		{ModelID: "big", Size: ptr.To(resource.MustParse("120Gi"))},
+	}
+
+	// 2 GPUs at 32Gi would request 64Gi, below 120Gi + 8Gi overhead
+	ratios := resolveGPUResourceRatios(nil, "MI300X", sources, aimv1alpha1.AIMModelClassLLM)
+	resources := defaultResourceRequirementsForGPU(2, ratios)
+
+	memReq := resources.Requests[corev1.ResourceMemory]
+	if memReq.Cmp(resource.MustParse("128Gi")) != 0 {
+		t.Errorf("expected memory request raised to 128Gi, got %s", memReq.String())
+	}
+	memLim := resources.Limits[corev1.ResourceMemory]
+	if memLim.Cmp(resource.MustParse("160Gi")) != 0 {
+		t.Errorf("expected memory limit raised by the same amount to 160Gi, got %s", memLim.String())
+	}
+
+	// 8 GPUs already request 256Gi, above the floor
+	resources = defaultResourceRequirementsForGPU(8, ratios)
+	memReq = resources.Requests[corev1.ResourceMemory]
+	if memReq.Cmp(resource.MustParse("256Gi")) != 0 {
+		t.Errorf("expected ratio-based memory request of 256Gi, got %s", memReq.String())
+	}
+
+	// A custom overhead changes the floor
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{
+		InferenceResources: &aimv1alpha1.AIMInferenceResourcesConfig{
+			ModelMemoryOverhead: ptr.To(resource.MustParse("24Gi")),
+		},
+	}
+	resources = defaultResourceRequirementsForGPU(2, resolveGPUResourceRatios(config, "MI300X", sources, aimv1alpha1.AIMModelClassLLM))
+	memReq = resources.Requests[corev1.ResourceMemory]
+	if memReq.Cmp(resource.MustParse("144Gi")) != 0 {
+		t.Errorf("expected memory request of 144Gi with custom overhead, got %s", memReq.String())
+	}
+}
@@ -0,0 +1,218 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// secretReference is a secret the predictor pod depends on.
+type secretReference struct {
+	name string
+	// key is the data key the predictor reads, empty for image pull secrets.
+	key string
+	// source describes where the secret is referenced, for status messages.
+	source   string
+	optional bool
+}
+
+// referencedSecretsCheck is the result of validating the secrets a service references.
+type referencedSecretsCheck struct {
+	count int
+	err   error
+}
+
+// collectSecretReferences returns the secrets referenced by the service's image pull secrets
+// and by secretKeyRef env vars of the service, template and runtime config.
+func collectSecretReferences(
+	service *aimv1alpha1.AIMService,
+	templateSpec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) []secretReference {
+	var refs []secretReference
+	for _, ref := range service.Spec.ImagePullSecrets {
+		if ref.Name != "" {
+			refs = append(refs, secretReference{name: ref.Name, source: "imagePullSecrets"})
+		}
+	}
+
+	addEnv := func(env []corev1.EnvVar, source string) {
+		for _, e := range env {
+			if e.ValueFrom == nil || e.ValueFrom.SecretKeyRef == nil || e.ValueFrom.SecretKeyRef.Name == "" {
+				continue
+			}
+			ref := e.ValueFrom.SecretKeyRef
+			refs = append(refs, secretReference{
+				name:     ref.Name,
+				key:      ref.Key,
+				source:   fmt.Sprintf("%s env %s", source, e.Name),
+				optional: ref.Optional != nil && *ref.Optional,
+			})
+		}
+	}
+	if runtimeConfig != nil {
+		addEnv(runtimeConfig.Env, "runtime config")
+	}
+	if templateSpec != nil {
+		addEnv(templateSpec.Env, "template")
+	}
+	addEnv(service.Spec.Env, "service")
+
+	return refs
+}
+
+// validateReferencedSecrets fetches each referenced secret and checks that it contains the
+// keys the predictor needs. A missing secret is a MissingUpstreamDependency error and a
+// secret without the expected key is an InvalidSpec error, so the InferenceService is not
+// created with credentials that would only fail later as image pull or startup errors.
+// Other API errors are returned as-is for categorization.
+func validateReferencedSecrets(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	namespace string,
+	refs []secretReference,
+) error {
+	byName := map[string][]secretReference{}
+	for _, ref := range refs {
+		byName[ref.name] = append(byName[ref.name], ref)
+	}
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			if required := requiredReferences(byName[name]); len(required) > 0 {
+				return controllerutils.NewMissingUpstreamDependencyError(
+					aimv1alpha1.AIMServiceReasonSecretNotFound,
+					fmt.Sprintf("Secret %s referenced by %s not found", name, required[0].source),
+					err,
+				)
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, ref := range byName[name] {
+			if msg := checkSecretReference(secret, ref); msg != "" {
+				return controllerutils.NewInvalidSpecError(aimv1alpha1.AIMServiceReasonInvalidSecret, msg, nil)
+			}
+		}
+	}
+	return nil
+}
+
+// requiredReferences filters out optional secretKeyRef references.
+func requiredReferences(refs []secretReference) []secretReference {
+	var required []secretReference
+	for _, ref := range refs {
+		if !ref.optional {
+			required = append(required, ref)
+		}
+	}
+	return required
+}
+
+// countSecrets returns the number of distinct secrets in refs.
+func countSecrets(refs []secretReference) int {
+	names := map[string]struct{}{}
+	for _, ref := range refs {
+		names[ref.name] = struct{}{}
+	}
+	return len(names)
+}
+
+// checkSecretReference returns a message describing why the secret does not satisfy the reference,
+// or an empty string if it does.
+func checkSecretReference(secret *corev1.Secret, ref secretReference) string {
+	if ref.key != "" {
+		if _, ok := secret.Data[ref.key]; !ok && !ref.optional {
+			return fmt.Sprintf("Secret %s referenced by %s has no key %q", secret.Name, ref.source, ref.key)
+		}
+		return ""
+	}
+
+	// Image pull secret: the kubelet reads .dockerconfigjson, or .dockercfg for the legacy type
+	key := corev1.DockerConfigJsonKey
+	if secret.Type == corev1.SecretTypeDockercfg {
+		key = corev1.DockerConfigKey
+	}
+	data, ok := secret.Data[key]
+	if !ok {
+		return fmt.Sprintf("Secret %s referenced by %s has no key %q", secret.Name, ref.source, key)
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return fmt.Sprintf("Secret %s referenced by %s has an empty %q", secret.Name, ref.source, key)
+	}
+	return ""
+}
+
+// getSecretsHealth reports the result of the referenced secret validation and of the
+// pull secrets synced from the operator namespace.
+func (f ServiceFetchResult) getSecretsHealth() controllerutils.ComponentHealth {
+	health := controllerutils.ComponentHealth{
+		Component:      "Secrets",
+		DependencyType: controllerutils.DependencyTypeUpstream,
+	}
+	var err error
+	if f.referencedSecrets != nil {
+		err = f.referencedSecrets.err
+	}
+	if err == nil && f.syncedPullSecrets != nil {
+		err = f.syncedPullSecrets.err
+	}
+	if err != nil {
+		if controllerutils.IsStateEngineError(err) {
+			health.State = constants.AIMStatusFailed
+		}
+		health.Errors = []error{err}
+		return health
+	}
+	health.State = constants.AIMStatusReady
+	health.Reason = aimv1alpha1.AIMServiceReasonSecretsValid
+	var referenced int
+	if f.referencedSecrets != nil {
+		referenced = f.referencedSecrets.count
+	}
+	health.Message = fmt.Sprintf("%d referenced secret(s) are valid", referenced)
+	if f.syncedPullSecrets != nil && len(f.syncedPullSecrets.desired) > 0 {
+		health.Message += fmt.Sprintf(", %d pull secret(s) synced", len(f.syncedPullSecrets.desired))
+	}
+	return health
+}
@@ -0,0 +1,176 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func secretKeyEnv(name, secret, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+				Key:                  key,
+			},
+		},
+	}
+}
+
+func TestCollectSecretReferences(t *testing.T) {
+	service := NewService("svc").Build()
+	service.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "registry"}}
+	service.Spec.Env = []corev1.EnvVar{
+		secretKeyEnv("HF_TOKEN", "hf", "token"),
+		{Name: "PLAIN", Value: "value"},
+	}
+	templateSpec := &aimv1alpha1.AIMServiceTemplateSpecCommon{}
+	templateSpec.Env = []corev1.EnvVar{secretKeyEnv("S3_KEY", "s3", "key")}
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{}
+	runtimeConfig.Env = []corev1.EnvVar{secretKeyEnv("PROXY", "proxy", "url")}
+
+	refs := collectSecretReferences(service, templateSpec, runtimeConfig)
+
+	want := []secretReference{
+		{name: "registry", source: "imagePullSecrets"},
+		{name: "proxy", key: "url", source: "runtime config env PROXY"},
+		{name: "s3", key: "key", source: "template env S3_KEY"},
+		{name: "hf", key: "token", source: "service env HF_TOKEN"},
+	}
+	if len(refs) != len(want) {
+		t.Fatalf("got %d references, want %d: %+v", len(refs), len(want), refs)
+	}
+	for i := range want {
+		if refs[i] != want[i] {
+			t.Errorf("reference %d = %+v, want %+v", i, refs[i], want[i])
+		}
+	}
+}
+
+func TestValidateReferencedSecrets(t *testing.T) {
+	pullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: testNamespace},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+	}
+	opaquePullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: testNamespace},
+		Data:       map[string][]byte{"config": []byte("{}")},
+	}
+	hfSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "hf", Namespace: testNamespace},
+		Data:       map[string][]byte{"token": []byte("hf_abc")},
+	}
+
+	tests := []struct {
+		name         string
+		refs         []secretReference
+		wantCategory controllerutils.ErrorCategory
+		wantReason   string
+		wantMessage  string
+	}{
+		{
+			name: "valid pull and token secrets",
+			refs: []secretReference{
+				{name: "registry", source: "imagePullSecrets"},
+				{name: "hf", key: "token", source: "service env HF_TOKEN"},
+			},
+		},
+		{
+			name:         "missing pull secret",
+			refs:         []secretReference{{name: "absent", source: "imagePullSecrets"}},
+			wantCategory: controllerutils.ErrorCategoryMissingUpstreamDependency,
+			wantReason:   aimv1alpha1.AIMServiceReasonSecretNotFound,
+			wantMessage:  "Secret absent referenced by imagePullSecrets not found",
+		},
+		{
+			name:        "missing optional secret",
+			refs:        []secretReference{{name: "absent", key: "token", source: "service env HF_TOKEN", optional: true}},
+			wantMessage: "",
+		},
+		{
+			name:         "pull secret without dockerconfigjson",
+			refs:         []secretReference{{name: "opaque", source: "imagePullSecrets"}},
+			wantCategory: controllerutils.ErrorCategoryInvalidSpec,
+			wantReason:   aimv1alpha1.AIMServiceReasonInvalidSecret,
+			wantMessage:  `Secret opaque referenced by imagePullSecrets has no key ".dockerconfigjson"`,
+		},
+		{
+			name:         "token secret without key",
+			refs:         []secretReference{{name: "hf", key: "hf-token", source: "service env HF_TOKEN"}},
+			wantCategory: controllerutils.ErrorCategoryInvalidSpec,
+			wantReason:   aimv1alpha1.AIMServiceReasonInvalidSecret,
+			wantMessage:  `Secret hf referenced by service env HF_TOKEN has no key "hf-token"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := kubefake.NewClientset(pullSecret, opaquePullSecret, hfSecret)
+			err := validateReferencedSecrets(testContext(), clientset, testNamespace, tt.refs)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			categorized := controllerutils.CategorizeError(err)
+			if categorized.Category() != tt.wantCategory {
+				t.Errorf("category = %v, want %v", categorized.Category(), tt.wantCategory)
+			}
+			if categorized.Reason() != tt.wantReason {
+				t.Errorf("reason = %q, want %q", categorized.Reason(), tt.wantReason)
+			}
+			if categorized.UserMessage() != tt.wantMessage {
+				t.Errorf("message = %q, want %q", categorized.UserMessage(), tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestCheckSecretReferenceLegacyDockercfg(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy"},
+		Type:       corev1.SecretTypeDockercfg,
+		Data:       map[string][]byte{corev1.DockerConfigKey: []byte("{}")},
+	}
+	if msg := checkSecretReference(secret, secretReference{name: "legacy", source: "imagePullSecrets"}); msg != "" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	optional := secretReference{name: "legacy", key: "token", source: "service env HF_TOKEN", optional: true}
+	if msg := checkSecretReference(secret, optional); msg != "" {
+		t.Errorf("optional key reported as invalid: %s", msg)
+	}
+}
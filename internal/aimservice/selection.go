@@ -25,26 +25,20 @@ package aimservice
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
-	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
 	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
 )
 
-// TemplateCandidate captures the information needed to evaluate a template during selection.
-type TemplateCandidate struct {
-	Name      string
-	Namespace string
-	Scope     aimv1alpha1.AIMResolutionScope
-	Spec      aimv1alpha1.AIMServiceTemplateSpecCommon
-	Status    aimv1alpha1.AIMServiceTemplateStatus
-}
-
 // TemplateSelectionResult captures the result of template auto-selection.
 type TemplateSelectionResult struct {
 	SelectedTemplate          *aimv1alpha1.AIMServiceTemplate
@@ -54,25 +48,10 @@ type TemplateSelectionResult struct {
 	SelectionReason           string
 	SelectionMessage          string
 	MatchingResults           []aimv1alpha1.AIMTemplateCandidateResult
-	Error                     error
-}
-
-// SelectionDiagnostics provides detailed information about why template selection failed.
-type SelectionDiagnostics struct {
-	TotalCandidates                  int
-	AfterAvailabilityFilter          int
-	AfterUnoptimizedFilter           int
-	AfterOverridesFilter             int
-	AfterGPUAvailabilityFilter       int
-	UnoptimizedTemplatesWereFiltered bool
-}
-
-// CandidateEvaluation captures why a specific candidate was chosen or rejected.
-type CandidateEvaluation struct {
-	Candidate TemplateCandidate
-	Status    string // "chosen" or "rejected"
-	Reason    string // CamelCase reason
-	Rank      int    // For candidates that passed all filters
+	// UnoptimizedFallback is true when no optimized template could be selected and the
+	// selection was repeated with unoptimized templates allowed.
+	UnoptimizedFallback bool
+	Error               error
 }
 
 // selectTemplateForModel selects the best template for a given model.
@@ -81,6 +60,7 @@ func selectTemplateForModel(
 	c client.Client,
 	service *aimv1alpha1.AIMService,
 	modelName string,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
 ) *TemplateSelectionResult {
 	logger := log.FromContext(ctx)
 	result := &TemplateSelectionResult{}
@@ -98,58 +78,72 @@ func selectTemplateForModel(
 		return result
 	}
 
-	// Get available GPUs in the cluster
-	availableGPUs, err := listAvailableGPUs(ctx, c)
-	if err != nil {
-		result.Error = fmt.Errorf("failed to list available GPUs: %w", err)
+	// Cluster templates outside the namespace's tenancy policy are treated like namespace-denied ones
+	denyCandidatesByTenancy(candidates, runtimeConfig)
+
+	if err := markCandidatesAtCapacity(ctx, c, service, candidates); err != nil {
+		result.Error = err
 		return result
 	}
 
-	// Determine if unoptimized templates are allowed
-	allowUnoptimized := service.Spec.Template.AllowUnoptimized
-
-	// Select the best template
-	selected, count, diag, evaluations := selectBestTemplate(
-		candidates,
-		service.Spec.Overrides,
-		availableGPUs,
-		allowUnoptimized,
-	)
-
-	result.CandidateCount = count
-	result.MatchingResults = convertToTemplateMatchingResults(evaluations)
-
-	if selected == nil {
-		// No template selected - determine why
-		if len(candidates) > 0 && diag.AfterAvailabilityFilter == 0 {
-			result.TemplatesExistButNotReady = true
-			result.SelectionReason = ""
-			result.SelectionMessage = ""
-		} else if diag.AfterUnoptimizedFilter == 0 && diag.UnoptimizedTemplatesWereFiltered {
-			result.SelectionReason = aimv1alpha1.AIMServiceReasonTemplateNotFound
-			result.SelectionMessage = fmt.Sprintf(
-				"No available templates match requirements for model %q: "+
-					"%d unoptimized template(s) filtered out. Set allowUnoptimized to use them.",
-				modelName, diag.AfterAvailabilityFilter)
-		} else {
-			result.SelectionReason = aimv1alpha1.AIMServiceReasonTemplateNotFound
-			result.SelectionMessage = fmt.Sprintf("No available templates match requirements for model %q", modelName)
+	// Get available GPUs in the cluster (not needed for CPU-only services)
+	var availableGPUs []string
+	if service.Spec.Compute != aimv1alpha1.AIMComputeModeCPU {
+		availableGPUs, err = listAvailableGPUs(ctx, c)
+		if err != nil {
+			result.Error = fmt.Errorf("failed to list available GPUs: %w", err)
+			return result
+		}
+	}
+
+	// Free GPUs are needed for the headroom limit and to place GPU preferences on pools with capacity
+	var freeGPUs, headroom *selection.GPUHeadroom
+	percent := maxFreeGPUPercent(runtimeConfig)
+	gpuPreference := service.Spec.Template.GPUPreference
+	if (percent != nil || len(gpuPreference) > 0) && service.Spec.Compute != aimv1alpha1.AIMComputeModeCPU {
+		isvcName, _ := GenerateInferenceServiceName(service.Name, service.Namespace)
+		freeGPUs, err = computeGPUHeadroom(ctx, c, service.Namespace, isvcName, ptr.Deref(percent, 100))
+		if err != nil {
+			result.Error = fmt.Errorf("failed to compute GPU headroom: %w", err)
+			return result
+		}
+		if percent != nil {
+			headroom = freeGPUs
 		}
-		return result
 	}
 
-	if count > 1 {
-		result.SelectionReason = aimv1alpha1.AIMServiceReasonTemplateSelectionAmbiguous
-		result.SelectionMessage = fmt.Sprintf("Multiple templates (%d) satisfy model %q", count, modelName)
+	outcome := selection.Select(candidates, selection.Options{
+		Overrides:                service.Spec.Overrides,
+		Compute:                  service.Spec.Compute,
+		AvailableGPUs:            availableGPUs,
+		AllowUnoptimized:         service.Spec.Template.AllowUnoptimized,
+		AllowUnoptimizedFallback: service.Spec.Template.AllowUnoptimizedFallback,
+		Headroom:                 headroom,
+		GPUPreference:            gpuPreference,
+		FreeGPUs:                 freeGPUs,
+		Goal:                     service.Spec.SelectionGoal,
+		// An external policy may rank the templates that pass all filters
+		Policy: newSelectionPolicy(ctx, service, runtimeConfig),
+	})
+	if outcome.UnoptimizedFallback {
+		logger.V(1).Info("no optimized template fits, falling back to unoptimized templates")
+	}
+
+	result.CandidateCount = outcome.Count
+	result.MatchingResults = selection.MatchingResults(outcome.Evaluations)
+	result.UnoptimizedFallback = outcome.UnoptimizedFallback
+	result.TemplatesExistButNotReady = outcome.NotReady()
+	result.SelectionReason, result.SelectionMessage = outcome.Reason(modelName, service.Namespace)
+	if outcome.Selected == nil || outcome.Count > 1 {
 		return result
 	}
 
 	// Fetch the actual template object
-	if selected.Scope == aimv1alpha1.AIMResolutionScopeNamespace {
+	if outcome.Selected.Scope == aimv1alpha1.AIMResolutionScopeNamespace {
 		template := &aimv1alpha1.AIMServiceTemplate{}
 		err := c.Get(ctx, client.ObjectKey{
-			Namespace: selected.Namespace,
-			Name:      selected.Name,
+			Namespace: outcome.Selected.Namespace,
+			Name:      outcome.Selected.Name,
 		}, template)
 		if err != nil {
 			result.Error = err
@@ -158,7 +152,7 @@ func selectTemplateForModel(
 		result.SelectedTemplate = template
 	} else {
 		template := &aimv1alpha1.AIMClusterServiceTemplate{}
-		err := c.Get(ctx, client.ObjectKey{Name: selected.Name}, template)
+		err := c.Get(ctx, client.ObjectKey{Name: outcome.Selected.Name}, template)
 		if err != nil {
 			result.Error = err
 			return result
@@ -166,7 +160,7 @@ func selectTemplateForModel(
 		result.SelectedClusterTemplate = template
 	}
 
-	logger.V(1).Info("template selected", "template", selected.Name, "scope", selected.Scope)
+	logger.V(1).Info("template selected", "template", outcome.Selected.Name, "scope", outcome.Selected.Scope)
 	return result
 }
 
@@ -176,8 +170,8 @@ func listTemplateCandidatesForModel(
 	c client.Client,
 	namespace string,
 	modelName string,
-) ([]TemplateCandidate, error) {
-	var candidates []TemplateCandidate
+) ([]selection.Candidate, error) {
+	var candidates []selection.Candidate
 
 	// List namespace-scoped templates
 	nsTemplates := &aimv1alpha1.AIMServiceTemplateList{}
@@ -185,15 +179,9 @@ func listTemplateCandidatesForModel(
 		return nil, err
 	}
 
-	for _, t := range nsTemplates.Items {
-		if t.Spec.ModelName == modelName {
-			candidates = append(candidates, TemplateCandidate{
-				Name:      t.Name,
-				Namespace: t.Namespace,
-				Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
-				Spec:      t.Spec.AIMServiceTemplateSpecCommon,
-				Status:    t.Status,
-			})
+	for i := range nsTemplates.Items {
+		if nsTemplates.Items[i].Spec.ModelName == modelName {
+			candidates = append(candidates, selection.NamespaceCandidate(&nsTemplates.Items[i]))
 		}
 	}
 
@@ -203,20 +191,46 @@ func listTemplateCandidatesForModel(
 		return nil, err
 	}
 
+	// Namespace labels are only needed when a template restricts its namespaces
+	var namespaceLabels map[string]string
+	namespaceFetched := false
+
 	for _, t := range clusterTemplates.Items {
-		if t.Spec.ModelName == modelName {
-			candidates = append(candidates, TemplateCandidate{
-				Name:   t.Name,
-				Scope:  aimv1alpha1.AIMResolutionScopeCluster,
-				Spec:   t.Spec.AIMServiceTemplateSpecCommon,
-				Status: t.Status,
-			})
+		if t.Spec.ModelName != modelName {
+			continue
 		}
+		if t.Spec.NamespaceSelector != nil && !namespaceFetched {
+			nsLabels, err := fetchNamespaceLabels(ctx, c, namespace)
+			if err != nil {
+				return nil, err
+			}
+			namespaceLabels = nsLabels
+			namespaceFetched = true
+		}
+		candidates = append(candidates, selection.ClusterCandidate(&t, namespaceLabels))
 	}
 
 	return candidates, nil
 }
 
+// fetchNamespaceLabels returns the labels of the namespace. A missing namespace has no labels.
+func fetchNamespaceLabels(ctx context.Context, c client.Client, namespace string) (map[string]string, error) {
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get namespace %q: %w", namespace, err)
+	}
+	return ns.Labels, nil
+}
+
+// clusterTemplateAllowsNamespace returns true if the namespaceSelector of the cluster template
+// matches the namespace labels. An invalid selector matches no namespace.
+func clusterTemplateAllowsNamespace(template *aimv1alpha1.AIMClusterServiceTemplate, namespaceLabels map[string]string) bool {
+	return template.AllowsNamespace(namespaceLabels)
+}
+
 // listAvailableGPUs returns the list of GPU models available in the cluster.
 // Uses device ID-based extraction for AMD GPUs.
 func listAvailableGPUs(ctx context.Context, c client.Client) ([]string, error) {
@@ -240,469 +254,36 @@ func listAvailableGPUs(ctx context.Context, c client.Client) ([]string, error) {
 	return gpus, nil
 }
 
-// Filter stage identifiers for tracking rejections
-const (
-	stageAvailability = "availability"
-	stageUnoptimized  = "unoptimized"
-	stageOverrides    = "overrides"
-	stageGPU          = "gpu"
-)
-
-// filterByAvailability removes candidates that are not Ready.
-func filterByAvailability(candidates []TemplateCandidate, rejected map[string][]TemplateCandidate) []TemplateCandidate {
-	var result []TemplateCandidate
-	for _, c := range candidates {
-		if c.Status.Status == constants.AIMStatusReady {
-			result = append(result, c)
-		} else {
-			rejected[stageAvailability] = append(rejected[stageAvailability], c)
-		}
-	}
-	return result
-}
-
-// filterByOptimizationStatus removes unoptimized templates if not allowed.
-func filterByOptimizationStatus(candidates []TemplateCandidate, allowUnoptimized bool, rejected map[string][]TemplateCandidate) []TemplateCandidate {
-	var result []TemplateCandidate
-	for _, c := range candidates {
-		// If profile is nil, treat as unoptimized
-		profileType := aimv1alpha1.AIMProfileTypeUnoptimized
-		if c.Status.Profile != nil {
-			profileType = c.Status.Profile.Metadata.Type
-		}
-		if profileType == aimv1alpha1.AIMProfileTypeOptimized || allowUnoptimized {
-			result = append(result, c)
-		} else {
-			rejected[stageUnoptimized] = append(rejected[stageUnoptimized], c)
-		}
-	}
-	return result
-}
-
-// buildFinalEvaluations creates the evaluation list for the final selected candidates.
-func buildFinalEvaluations(filtered []TemplateCandidate, selected *TemplateCandidate, rejected map[string][]TemplateCandidate) []CandidateEvaluation {
-	evaluations := make([]CandidateEvaluation, 0, len(filtered)+len(rejected))
-	appendRejections(&evaluations, rejected)
-
-	for i, c := range filtered {
-		if c.Name == selected.Name {
-			evaluations = append(evaluations, CandidateEvaluation{
-				Candidate: c,
-				Status:    "chosen",
-				Reason:    "BestMatch",
-				Rank:      1,
-			})
-		} else {
-			evaluations = append(evaluations, CandidateEvaluation{
-				Candidate: c,
-				Status:    "rejected",
-				Reason:    "LowerPreferenceRank",
-				Rank:      i + 1,
-			})
-		}
-	}
-	return evaluations
-}
-
-// selectBestTemplate selects the best template from candidates.
-// Selection criteria (in order of priority):
-// 1. Only Available templates (status == Ready)
-// 2. Filter unoptimized if not allowed
-// 3. Filter by service overrides (metric, precision, GPU)
-// 4. Filter by GPU availability in cluster
-// 5. Prefer namespace-scoped over cluster-scoped
-// 6. Prefer by profile type > GPU tier > metric > precision
-func selectBestTemplate(
-	candidates []TemplateCandidate,
-	overrides *aimv1alpha1.AIMServiceOverrides,
-	availableGPUs []string,
-	allowUnoptimized bool,
-) (*TemplateCandidate, int, SelectionDiagnostics, []CandidateEvaluation) {
-	diag := SelectionDiagnostics{TotalCandidates: len(candidates)}
-	rejectedByStage := make(map[string][]TemplateCandidate)
-
-	// Stage 1: Availability filter - only Ready templates can be selected
-	filtered := filterByAvailability(candidates, rejectedByStage)
-	diag.AfterAvailabilityFilter = len(filtered)
-	if len(filtered) == 0 {
-		evals := make([]CandidateEvaluation, 0)
-		appendRejections(&evals, rejectedByStage)
-		return nil, 0, diag, evals
-	}
-
-	// Stage 2: Unoptimized filter - exclude unoptimized unless explicitly allowed
-	filtered = filterByOptimizationStatus(filtered, allowUnoptimized, rejectedByStage)
-	diag.AfterUnoptimizedFilter = len(filtered)
-	diag.UnoptimizedTemplatesWereFiltered = len(rejectedByStage[stageUnoptimized]) > 0
-	if len(filtered) == 0 {
-		evals := make([]CandidateEvaluation, 0)
-		appendRejections(&evals, rejectedByStage)
-		return nil, 0, diag, evals
-	}
-
-	// Stage 3: Overrides filter - match service-specified constraints
-	if overrides != nil {
-		beforeOverrides := filtered
-		filtered = filterTemplatesByOverrides(filtered, overrides)
-		diag.AfterOverridesFilter = len(filtered)
-		if len(filtered) == 0 {
-			rejectedByStage[stageOverrides] = beforeOverrides
-			evals := make([]CandidateEvaluation, 0)
-			appendRejections(&evals, rejectedByStage)
-			return nil, 0, diag, evals
-		}
-	} else {
-		diag.AfterOverridesFilter = len(filtered)
-	}
-
-	// Stage 4: GPU availability filter - only templates for GPUs present in cluster
-	beforeGPU := filtered
-	filtered = filterTemplatesByGPUAvailability(filtered, availableGPUs)
-	diag.AfterGPUAvailabilityFilter = len(filtered)
-	if len(filtered) == 0 {
-		rejectedByStage[stageGPU] = beforeGPU
-		evals := make([]CandidateEvaluation, 0)
-		appendRejections(&evals, rejectedByStage)
-		return nil, 0, diag, evals
-	}
-
-	// Stage 5: Scope preference - namespace templates over cluster templates
-	filtered = preferNamespaceTemplates(filtered)
-
-	// Single candidate remaining - select it
-	if len(filtered) == 1 {
-		evals := buildFinalEvaluations(filtered, &filtered[0], rejectedByStage)
-		return &filtered[0], 1, diag, evals
-	}
-
-	// Stage 6: Preference scoring - rank by profile type, GPU, metric, precision
-	selected, count := choosePreferredTemplate(filtered)
-	evals := buildFinalEvaluations(filtered, selected, rejectedByStage)
-
-	return selected, count, diag, evals
-}
-
-func appendRejections(evals *[]CandidateEvaluation, rejectedByStage map[string][]TemplateCandidate) {
-	for _, c := range rejectedByStage[stageAvailability] {
-		*evals = append(*evals, CandidateEvaluation{
-			Candidate: c,
-			Status:    "rejected",
-			Reason:    getRejectionReasonForStatus(c.Status.Status),
-		})
-	}
-
-	addWithReason := func(stage string, reason string) {
-		for _, c := range rejectedByStage[stage] {
-			*evals = append(*evals, CandidateEvaluation{
-				Candidate: c,
-				Status:    "rejected",
-				Reason:    reason,
-			})
-		}
-	}
-
-	addWithReason(stageUnoptimized, "UnoptimizedTemplateFiltered")
-	addWithReason(stageOverrides, "ServiceOverridesNotMatched")
-	addWithReason(stageGPU, "RequiredGPUNotInCluster")
-}
-
-func getRejectionReasonForStatus(status constants.AIMStatus) string {
-	switch status {
-	case constants.AIMStatusPending:
-		return "TemplatePending"
-	case constants.AIMStatusProgressing:
-		return "TemplateProgressing"
-	case constants.AIMStatusNotAvailable:
-		return "TemplateNotAvailable"
-	case constants.AIMStatusDegraded:
-		return "TemplateDegraded"
-	case constants.AIMStatusFailed:
-		return "TemplateFailed"
-	default:
-		return "TemplateNotReady"
-	}
-}
-
-func filterTemplatesByOverrides(candidates []TemplateCandidate, overrides *aimv1alpha1.AIMServiceOverrides) []TemplateCandidate {
-	if overrides == nil {
-		return candidates
-	}
-
-	result := make([]TemplateCandidate, 0, len(candidates))
-	for _, c := range candidates {
-		templateMetric := candidateMetric(c)
-		templatePrecision := candidatePrecision(c)
-		templateGPUModels := candidateGPUModels(c)
-		templateGPUCount := candidateGPUCount(c)
-
-		if overrides.Metric != nil && !strings.EqualFold(templateMetric, string(*overrides.Metric)) {
-			continue
-		}
-		if overrides.Precision != nil && !strings.EqualFold(templatePrecision, string(*overrides.Precision)) {
-			continue
-		}
-		if overrides.Hardware != nil && overrides.Hardware.GPU != nil {
-			// Check if the override GPU model matches any template GPU model
-			if overrides.Hardware.GPU.Model != "" && len(templateGPUModels) > 0 {
-				if !gpuModelsOverlap([]string{overrides.Hardware.GPU.Model}, templateGPUModels) {
-					continue
-				}
-			}
-			// Filter by GPU count if specified
-			if overrides.Hardware.GPU.Requests > 0 && templateGPUCount > 0 && templateGPUCount != overrides.Hardware.GPU.Requests {
-				continue
-			}
-		}
-		result = append(result, c)
-	}
-	return result
-}
-
-// gpuModelsOverlap returns true if any GPU model from a matches any from b.
-func gpuModelsOverlap(a, b []string) bool {
-	for _, modelA := range a {
-		normalizedA := utils.NormalizeGPUModel(strings.TrimSpace(modelA))
-		for _, modelB := range b {
-			normalizedB := utils.NormalizeGPUModel(strings.TrimSpace(modelB))
-			if strings.EqualFold(normalizedA, normalizedB) {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func filterTemplatesByGPUAvailability(candidates []TemplateCandidate, availableGPUs []string) []TemplateCandidate {
-	gpuMap := make(map[string]struct{}, len(availableGPUs))
-	for _, gpu := range availableGPUs {
-		normalized := utils.NormalizeGPUModel(strings.TrimSpace(gpu))
-		if normalized != "" {
-			gpuMap[normalized] = struct{}{}
-		}
-	}
-
-	result := make([]TemplateCandidate, 0, len(candidates))
-	for _, c := range candidates {
-		models := candidateGPUModels(c)
-		// If no GPU models specified, include the candidate (CPU-only)
-		if len(models) == 0 {
-			result = append(result, c)
-			continue
-		}
-		// Check if ANY of the template's required GPU models are available
-		for _, model := range models {
-			normalized := utils.NormalizeGPUModel(strings.TrimSpace(model))
-			if _, ok := gpuMap[normalized]; ok {
-				result = append(result, c)
-				break
-			}
-		}
-	}
-	return result
-}
-
-func preferNamespaceTemplates(candidates []TemplateCandidate) []TemplateCandidate {
-	hasNamespace := false
-	for _, c := range candidates {
-		if c.Scope == aimv1alpha1.AIMResolutionScopeNamespace {
-			hasNamespace = true
-			break
-		}
-	}
-	if !hasNamespace {
-		return candidates
-	}
-
-	result := make([]TemplateCandidate, 0, len(candidates))
-	for _, c := range candidates {
-		if c.Scope == aimv1alpha1.AIMResolutionScopeNamespace {
-			result = append(result, c)
-		}
-	}
-	return result
-}
-
-// choosePreferredTemplate selects the best template from candidates using a preference hierarchy.
-//
-// Preference hierarchy (highest to lowest priority):
-// 1. Profile Type: optimized > preview > unoptimized
-// 2. GPU Tier: MI325X > MI300X > MI250X > MI210
-// 3. Metric: latency > throughput
-// 4. Precision: smaller bit-width preferred (fp4 > int4 > fp8 > int8 > fp16 > bf16 > fp32)
-//
-// Lower scores indicate higher preference. Unknown values get a high score (len+1000).
-// Returns the best candidate and count of candidates with identical best scores.
-func choosePreferredTemplate(candidates []TemplateCandidate) (*TemplateCandidate, int) {
-	if len(candidates) == 0 {
-		return nil, 0
-	}
-	if len(candidates) == 1 {
-		return &candidates[0], 1
+// setRunningUnoptimizedCondition warns when a service with allowUnoptimizedFallback runs an
+// unoptimized or preview profile. The condition follows the resolved template rather than the
+// selection result, because the template stays resolved after the fallback selection.
+func setRunningUnoptimizedCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	service := obs.service
+	if !service.Spec.Template.AllowUnoptimizedFallback || service.Spec.Template.Name != "" {
+		cm.Delete(aimv1alpha1.AIMServiceConditionRunningUnoptimized)
+		return
 	}
 
-	// Build preference maps: lower index = higher preference
-	gpuPref := makePreferenceMap(gpuPreferenceOrder)
-	metricPref := makePreferenceMap(metricPreferenceOrder)
-	precisionPref := makePreferenceMap(precisionPreferenceOrder)
-	profileTypePref := makePreferenceMap(profileTypePreferenceOrder)
-
-	bestIdx := 0
-	bestGPU := getPreferenceScore(candidateGPUModel(candidates[0]), gpuPref)
-	bestMetric := getPreferenceScore(candidateMetric(candidates[0]), metricPref)
-	bestPrecision := getPreferenceScore(candidatePrecision(candidates[0]), precisionPref)
-	bestProfileType := getPreferenceScore(candidateProfileType(candidates[0]), profileTypePref)
-
-	for i := 1; i < len(candidates); i++ {
-		gpu := getPreferenceScore(candidateGPUModel(candidates[i]), gpuPref)
-		metric := getPreferenceScore(candidateMetric(candidates[i]), metricPref)
-		precision := getPreferenceScore(candidatePrecision(candidates[i]), precisionPref)
-		profileType := getPreferenceScore(candidateProfileType(candidates[i]), profileTypePref)
-
-		// Compare using lexicographic ordering: profile type > GPU > metric > precision
-		// A candidate is better if it has a lower score at the highest-priority dimension
-		// where the candidates differ
-		if profileType < bestProfileType ||
-			(profileType == bestProfileType && gpu < bestGPU) ||
-			(profileType == bestProfileType && gpu == bestGPU && metric < bestMetric) ||
-			(profileType == bestProfileType && gpu == bestGPU && metric == bestMetric && precision < bestPrecision) {
-			bestIdx = i
-			bestGPU = gpu
-			bestMetric = metric
-			bestPrecision = precision
-			bestProfileType = profileType
-		}
+	name, _, _, status := obs.getResolvedTemplate()
+	if name == "" || status == nil || status.Profile == nil {
+		return
 	}
 
-	// Count identical scores (must include all scoring dimensions)
-	identicalCount := 0
-	for i := range candidates {
-		profileType := getPreferenceScore(candidateProfileType(candidates[i]), profileTypePref)
-		gpu := getPreferenceScore(candidateGPUModel(candidates[i]), gpuPref)
-		metric := getPreferenceScore(candidateMetric(candidates[i]), metricPref)
-		precision := getPreferenceScore(candidatePrecision(candidates[i]), precisionPref)
-		if profileType == bestProfileType && gpu == bestGPU && metric == bestMetric && precision == bestPrecision {
-			identicalCount++
-		}
+	profileType := status.Profile.Metadata.Type
+	if profileType == aimv1alpha1.AIMProfileTypeOptimized {
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionRunningUnoptimized, aimv1alpha1.AIMServiceReasonOptimizedProfile,
+			fmt.Sprintf("Template %s uses an optimized profile", name))
+		return
 	}
-
-	return &candidates[bestIdx], identicalCount
+	cm.Set(aimv1alpha1.AIMServiceConditionRunningUnoptimized, metav1.ConditionTrue, aimv1alpha1.AIMServiceReasonUnoptimizedFallback,
+		fmt.Sprintf("No optimized template fits, running template %s with a %s profile; expect lower throughput and higher latency",
+			name, profileType), controllerutils.AsWarning())
 }
 
-func candidateMetric(c TemplateCandidate) string {
-	if c.Status.Profile != nil {
-		if m := c.Status.Profile.Metadata.Metric; m != "" {
-			return string(m)
-		}
-	}
-	if c.Spec.Metric != nil {
-		return string(*c.Spec.Metric)
-	}
-	return ""
-}
-
-func candidatePrecision(c TemplateCandidate) string {
-	if c.Status.Profile != nil {
-		if p := c.Status.Profile.Metadata.Precision; p != "" {
-			return string(p)
-		}
-	}
-	if c.Spec.Precision != nil {
-		return string(*c.Spec.Precision)
-	}
-	return ""
-}
-
-func candidateGPUModel(c TemplateCandidate) string {
-	// Check Hardware.GPU.Model from spec
-	if c.Spec.Hardware != nil && c.Spec.Hardware.GPU != nil && c.Spec.Hardware.GPU.Model != "" {
-		return strings.TrimSpace(c.Spec.Hardware.GPU.Model)
-	}
-	if c.Status.Profile != nil {
-		if gpu := strings.TrimSpace(c.Status.Profile.Metadata.GPU); gpu != "" {
-			return gpu
-		}
-	}
-	return ""
-}
-
-// candidateGPUModels returns GPU model from the candidate spec as a slice.
-// Used for GPU availability filtering where any model match is acceptable.
-func candidateGPUModels(c TemplateCandidate) []string {
-	if c.Spec.Hardware != nil && c.Spec.Hardware.GPU != nil && c.Spec.Hardware.GPU.Model != "" {
-		return []string{c.Spec.Hardware.GPU.Model}
-	}
-	// Fallback to status profile
-	if c.Status.Profile != nil {
-		if gpu := strings.TrimSpace(c.Status.Profile.Metadata.GPU); gpu != "" {
-			return []string{gpu}
-		}
-	}
-	return nil
-}
-
-func candidateGPUCount(c TemplateCandidate) int32 {
-	if c.Spec.Hardware != nil && c.Spec.Hardware.GPU != nil && c.Spec.Hardware.GPU.Requests > 0 {
-		return c.Spec.Hardware.GPU.Requests
-	}
-	if c.Status.Profile != nil && c.Status.Profile.Metadata.GPUCount > 0 {
-		return c.Status.Profile.Metadata.GPUCount
-	}
-	return 0
-}
-
-func candidateProfileType(c TemplateCandidate) string {
-	if c.Status.Profile != nil {
-		return string(c.Status.Profile.Metadata.Type)
-	}
-	return ""
-}
-
-// Preference orders for template selection
-var (
-	gpuPreferenceOrder = []string{
-		"MI325X", "MI300X", "MI250X", "MI210",
-	}
-	metricPreferenceOrder = []string{
-		"latency", "throughput",
-	}
-	// Precision preference: primary ordering by bit-width (smaller preferred for performance).
-	// Secondary ordering by type: fp > bf > int (floating point preferred for accuracy).
-	precisionPreferenceOrder = []string{
-		"fp4", "int4", "fp8", "int8", "fp16", "bf16", "fp32",
-	}
-	profileTypePreferenceOrder = []string{
-		string(aimv1alpha1.AIMProfileTypeOptimized),
-		string(aimv1alpha1.AIMProfileTypePreview),
-		string(aimv1alpha1.AIMProfileTypeUnoptimized),
-	}
-)
-
-func makePreferenceMap(prefs []string) map[string]int {
-	m := make(map[string]int)
-	for i, p := range prefs {
-		m[strings.ToUpper(p)] = i
-	}
-	return m
-}
-
-func getPreferenceScore(value string, prefMap map[string]int) int {
-	if score, ok := prefMap[strings.ToUpper(value)]; ok {
-		return score
-	}
-	return len(prefMap) + 1000
-}
-
-func convertToTemplateMatchingResults(evaluations []CandidateEvaluation) []aimv1alpha1.AIMTemplateCandidateResult {
-	if len(evaluations) == 0 {
-		return []aimv1alpha1.AIMTemplateCandidateResult{}
-	}
-	results := make([]aimv1alpha1.AIMTemplateCandidateResult, len(evaluations))
-	for i, eval := range evaluations {
-		results[i] = aimv1alpha1.AIMTemplateCandidateResult{
-			Name:   eval.Candidate.Name,
-			Status: eval.Status,
-			Reason: eval.Reason,
-		}
+// maxFreeGPUPercent returns the configured GPU headroom percentage, or nil if the check is disabled.
+func maxFreeGPUPercent(runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) *int32 {
+	if runtimeConfig == nil || runtimeConfig.TemplateSelection == nil {
+		return nil
 	}
-	return results
+	return runtimeConfig.TemplateSelection.MaxFreeGPUPercent
 }
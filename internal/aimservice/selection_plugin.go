@@ -0,0 +1,200 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
+)
+
+const (
+	// defaultSelectionPolicyTimeout bounds a plugin call when timeoutSeconds is unset.
+	defaultSelectionPolicyTimeout = 5 * time.Second
+
+	// maxSelectionPolicyResponseBytes caps the plugin response that is read.
+	maxSelectionPolicyResponseBytes = 1 << 20
+)
+
+// selectionPolicyHTTPClient posts requests to HTTP selection policy plugins.
+// Calls are bounded by the context deadline.
+var selectionPolicyHTTPClient = &http.Client{}
+
+// selectionPolicyRequest is the JSON document sent to a selection policy plugin.
+type selectionPolicyRequest struct {
+	Service    selectionPolicyService     `json:"service"`
+	Candidates []selectionPolicyCandidate `json:"candidates"`
+}
+
+// selectionPolicyService describes the service a template is selected for.
+type selectionPolicyService struct {
+	Name      string                     `json:"name"`
+	Namespace string                     `json:"namespace"`
+	Spec      aimv1alpha1.AIMServiceSpec `json:"spec"`
+}
+
+// selectionPolicyCandidate describes a template that passed all selection filters.
+type selectionPolicyCandidate struct {
+	Name      string                                   `json:"name"`
+	Namespace string                                   `json:"namespace,omitempty"`
+	Scope     aimv1alpha1.AIMResolutionScope           `json:"scope"`
+	Spec      aimv1alpha1.AIMServiceTemplateSpecCommon `json:"spec"`
+	Status    aimv1alpha1.AIMServiceTemplateStatus     `json:"status"`
+}
+
+// selectionPolicyResponse is the JSON document returned by a selection policy plugin.
+type selectionPolicyResponse struct {
+	// Ranked lists candidate names, most preferred first
+	Ranked []string `json:"ranked"`
+}
+
+// newSelectionPolicy returns the selection policy plugin configured in the runtime config,
+// or nil if none is configured. Plugin failures are logged and fall back to the built-in scoring.
+func newSelectionPolicy(
+	ctx context.Context,
+	service *aimv1alpha1.AIMService,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) selection.Policy {
+	if runtimeConfig == nil || runtimeConfig.TemplateSelection == nil ||
+		runtimeConfig.TemplateSelection.SelectionPolicyPlugin == nil {
+		return nil
+	}
+	plugin := runtimeConfig.TemplateSelection.SelectionPolicyPlugin
+
+	return func(candidates []selection.Candidate) []selection.Candidate {
+		logger := log.FromContext(ctx)
+		request := selectionPolicyRequest{
+			Service: selectionPolicyService{
+				Name:      service.Name,
+				Namespace: service.Namespace,
+				Spec:      service.Spec,
+			},
+			Candidates: make([]selectionPolicyCandidate, 0, len(candidates)),
+		}
+		for _, c := range candidates {
+			request.Candidates = append(request.Candidates, selectionPolicyCandidate{
+				Name:      c.Name,
+				Namespace: c.Namespace,
+				Scope:     c.Scope,
+				Spec:      c.Spec,
+				Status:    c.Status,
+			})
+		}
+
+		names, err := callSelectionPolicyPlugin(ctx, plugin, request)
+		if err != nil {
+			logger.Error(err, "selection policy plugin failed, using built-in scoring")
+			return nil
+		}
+		ranked := selection.RankByNames(candidates, names)
+		if ranked == nil {
+			logger.Info("selection policy plugin ranked no candidate, using built-in scoring")
+		}
+		return ranked
+	}
+}
+
+// callSelectionPolicyPlugin sends the request to the plugin and returns its ranking.
+func callSelectionPolicyPlugin(
+	ctx context.Context,
+	plugin *aimv1alpha1.AIMSelectionPolicyPlugin,
+	request selectionPolicyRequest,
+) ([]string, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultSelectionPolicyTimeout
+	if seconds := ptr.Deref(plugin.TimeoutSeconds, 0); seconds > 0 {
+		timeout = time.Duration(seconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var output []byte
+	if plugin.Exec != "" {
+		output, err = execSelectionPolicy(ctx, plugin.Exec, body)
+	} else {
+		output, err = postSelectionPolicy(ctx, plugin.URL, body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var response selectionPolicyResponse
+	if err := json.Unmarshal(output, &response); err != nil {
+		return nil, fmt.Errorf("invalid selection policy response: %w", err)
+	}
+	return response.Ranked, nil
+}
+
+// execSelectionPolicy runs the plugin executable with the request on stdin.
+func execSelectionPolicy(ctx context.Context, path string, body []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("selection policy %s failed: %w: %s", path, err, msg)
+		}
+		return nil, fmt.Errorf("selection policy %s failed: %w", path, err)
+	}
+	if stdout.Len() > maxSelectionPolicyResponseBytes {
+		return nil, fmt.Errorf("selection policy %s returned more than %d bytes", path, maxSelectionPolicyResponseBytes)
+	}
+	return stdout.Bytes(), nil
+}
+
+// postSelectionPolicy posts the request to the plugin endpoint and returns the response body.
+func postSelectionPolicy(ctx context.Context, url string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := selectionPolicyHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("selection policy returned status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxSelectionPolicyResponseBytes))
+}
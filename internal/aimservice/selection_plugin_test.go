@@ -0,0 +1,151 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
+)
+
+func TestSelectionPolicyPlugin(t *testing.T) {
+	ctx := testContext()
+	service := NewService("svc").WithModelName(testModelName).Build()
+	candidate := func(name string, precision aimv1alpha1.AIMPrecision) selection.Candidate {
+		return selection.Candidate{
+			Name:      name,
+			Namespace: testNamespace,
+			Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
+			Status: aimv1alpha1.AIMServiceTemplateStatus{
+				Status: constants.AIMStatusReady,
+				Profile: &aimv1alpha1.AIMProfile{Metadata: aimv1alpha1.AIMProfileMetadata{
+					Type: aimv1alpha1.AIMProfileTypeOptimized, GPU: "MI300X", GPUCount: 1, Precision: precision,
+				}},
+			},
+		}
+	}
+	candidates := []selection.Candidate{
+		candidate("fp8", aimv1alpha1.AIMPrecisionFP8),
+		candidate("fp16", aimv1alpha1.AIMPrecisionFP16),
+	}
+	selectWith := func(plugin *aimv1alpha1.AIMSelectionPolicyPlugin) (*selection.Candidate, []selection.Evaluation) {
+		runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{
+			TemplateSelection: &aimv1alpha1.AIMTemplateSelectionConfig{SelectionPolicyPlugin: plugin},
+		}
+		result := selection.Select(candidates, selection.Options{
+			AvailableGPUs: []string{"MI300X"},
+			Policy:        newSelectionPolicy(ctx, service, runtimeConfig),
+		})
+		return result.Selected, result.Evaluations
+	}
+
+	t.Run("http plugin ranking is used", func(t *testing.T) {
+		var request selectionPolicyRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			_, _ = w.Write([]byte(`{"ranked": ["unknown", "fp16"]}`))
+		}))
+		defer server.Close()
+
+		selected, evals := selectWith(&aimv1alpha1.AIMSelectionPolicyPlugin{URL: server.URL})
+		if selected == nil || selected.Name != "fp16" {
+			t.Fatalf("expected fp16 ranked by the plugin, got %+v", selected)
+		}
+		if request.Service.Name != "svc" || len(request.Candidates) != 2 {
+			t.Errorf("unexpected plugin request %+v", request)
+		}
+		for _, eval := range evals {
+			if eval.Candidate.Name == "fp8" && eval.Reason != "NotRankedBySelectionPolicy" {
+				t.Errorf("expected fp8 to be rejected as unranked, got %s", eval.Reason)
+			}
+			if eval.Candidate.Name == "fp16" && eval.Reason != "RankedBySelectionPolicy" {
+				t.Errorf("expected fp16 to be chosen by the policy, got %s", eval.Reason)
+			}
+		}
+	})
+
+	t.Run("exec plugin ranking is used", func(t *testing.T) {
+		script := filepath.Join(t.TempDir(), "policy.sh")
+		content := "#!/bin/sh\ncat > /dev/null\necho '{\"ranked\": [\"fp16\", \"fp8\"]}'\n"
+		if err := os.WriteFile(script, []byte(content), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		selected, _ := selectWith(&aimv1alpha1.AIMSelectionPolicyPlugin{Exec: script})
+		if selected == nil || selected.Name != "fp16" {
+			t.Fatalf("expected fp16 ranked by the plugin, got %+v", selected)
+		}
+	})
+
+	t.Run("failing plugin falls back to built-in scoring", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		selected, _ := selectWith(&aimv1alpha1.AIMSelectionPolicyPlugin{URL: server.URL})
+		if selected == nil || selected.Name != "fp8" {
+			t.Fatalf("expected built-in choice fp8, got %+v", selected)
+		}
+	})
+
+	t.Run("slow plugin times out", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+
+		start := time.Now()
+		selected, _ := selectWith(&aimv1alpha1.AIMSelectionPolicyPlugin{URL: server.URL, TimeoutSeconds: ptr.To(int32(1))})
+		if selected == nil || selected.Name != "fp8" {
+			t.Fatalf("expected built-in choice fp8, got %+v", selected)
+		}
+		if elapsed := time.Since(start); elapsed > 3*time.Second {
+			t.Errorf("expected the plugin call to time out after 1s, took %s", elapsed)
+		}
+	})
+
+	t.Run("empty ranking falls back to built-in scoring", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ranked": []}`))
+		}))
+		defer server.Close()
+
+		selected, _ := selectWith(&aimv1alpha1.AIMSelectionPolicyPlugin{URL: server.URL})
+		if selected == nil || selected.Name != "fp8" {
+			t.Fatalf("expected built-in choice fp8, got %+v", selected)
+		}
+	})
+}
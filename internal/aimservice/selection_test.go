@@ -23,682 +23,347 @@
 package aimservice
 
 import (
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
 )
 
-// ============================================================================
-// STAGE 1: AVAILABILITY FILTER TESTS
-// ============================================================================
-
-func TestFilterByAvailability(t *testing.T) {
+func TestClusterTemplateAllowsNamespace(t *testing.T) {
 	tests := []struct {
-		name             string
-		candidates       []TemplateCandidate
-		expectedCount    int
-		expectedRejected int
+		name     string
+		template *aimv1alpha1.AIMClusterServiceTemplate
+		labels   map[string]string
+		expected bool
 	}{
 		{
-			name:             "empty candidates",
-			candidates:       []TemplateCandidate{},
-			expectedCount:    0,
-			expectedRejected: 0,
+			name:     "no selector allows all namespaces",
+			template: NewClusterTemplate("t").Build(),
+			expected: true,
 		},
 		{
-			name: "all ready",
-			candidates: []TemplateCandidate{
-				NewCandidate("t1").WithStatus(constants.AIMStatusReady).Build(),
-				NewCandidate("t2").WithStatus(constants.AIMStatusReady).Build(),
-			},
-			expectedCount:    2,
-			expectedRejected: 0,
-		},
-		{
-			name: "all not ready",
-			candidates: []TemplateCandidate{
-				NewCandidate("t1").WithStatus(constants.AIMStatusPending).Build(),
-				NewCandidate("t2").WithStatus(constants.AIMStatusProgressing).Build(),
-				NewCandidate("t3").WithStatus(constants.AIMStatusFailed).Build(),
-			},
-			expectedCount:    0,
-			expectedRejected: 3,
+			name:     "matching labels",
+			template: NewClusterTemplate("t").WithNamespaceSelector(map[string]string{"gpu-tier": "large"}).Build(),
+			labels:   map[string]string{"gpu-tier": "large", "team": "ml"},
+			expected: true,
 		},
 		{
-			name: "mixed statuses",
-			candidates: []TemplateCandidate{
-				NewCandidate("ready1").WithStatus(constants.AIMStatusReady).Build(),
-				NewCandidate("pending").WithStatus(constants.AIMStatusPending).Build(),
-				NewCandidate("ready2").WithStatus(constants.AIMStatusReady).Build(),
-				NewCandidate("failed").WithStatus(constants.AIMStatusFailed).Build(),
-			},
-			expectedCount:    2,
-			expectedRejected: 2,
+			name:     "non-matching labels",
+			template: NewClusterTemplate("t").WithNamespaceSelector(map[string]string{"gpu-tier": "large"}).Build(),
+			labels:   map[string]string{"gpu-tier": "small"},
+			expected: false,
 		},
 		{
-			name: "NotAvailable status rejected",
-			candidates: []TemplateCandidate{
-				NewCandidate("ready").WithStatus(constants.AIMStatusReady).Build(),
-				NewCandidate("notavail").WithStatus(constants.AIMStatusNotAvailable).Build(),
-			},
-			expectedCount:    1,
-			expectedRejected: 1,
+			name:     "empty selector allows all namespaces",
+			template: NewClusterTemplate("t").WithNamespaceSelector(nil).Build(),
+			expected: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rejected := make(map[string][]TemplateCandidate)
-			result := filterByAvailability(tt.candidates, rejected)
-
-			if len(result) != tt.expectedCount {
-				t.Errorf("expected %d candidates, got %d", tt.expectedCount, len(result))
-			}
-			if len(rejected[stageAvailability]) != tt.expectedRejected {
-				t.Errorf("expected %d rejected, got %d", tt.expectedRejected, len(rejected[stageAvailability]))
+			if got := clusterTemplateAllowsNamespace(tt.template, tt.labels); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
 			}
 		})
 	}
 }
 
-// ============================================================================
-// STAGE 2: OPTIMIZATION STATUS FILTER TESTS
-// ============================================================================
-
-func TestFilterByOptimizationStatus(t *testing.T) {
-	tests := []struct {
-		name             string
-		candidates       []TemplateCandidate
-		allowUnoptimized bool
-		expectedCount    int
-		expectedRejected int
-	}{
-		{
-			name: "all optimized - no unoptimized allowed",
-			candidates: []TemplateCandidate{
-				NewCandidate("t1").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
-				NewCandidate("t2").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
-			},
-			allowUnoptimized: false,
-			expectedCount:    2,
-			expectedRejected: 0,
-		},
-		{
-			name: "mixed - unoptimized rejected when not allowed",
-			candidates: []TemplateCandidate{
-				NewCandidate("optimized").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
-				NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build(),
-				NewCandidate("preview").WithProfileType(aimv1alpha1.AIMProfileTypePreview).Build(),
-			},
-			allowUnoptimized: false,
-			expectedCount:    1,
-			expectedRejected: 2,
-		},
-		{
-			name: "mixed - all allowed when allowUnoptimized=true",
-			candidates: []TemplateCandidate{
-				NewCandidate("optimized").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
-				NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build(),
-				NewCandidate("preview").WithProfileType(aimv1alpha1.AIMProfileTypePreview).Build(),
-			},
-			allowUnoptimized: true,
-			expectedCount:    3,
-			expectedRejected: 0,
-		},
-		{
-			name: "only unoptimized - all rejected when not allowed",
-			candidates: []TemplateCandidate{
-				NewCandidate("t1").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build(),
-				NewCandidate("t2").WithProfileType(aimv1alpha1.AIMProfileTypePreview).Build(),
-			},
-			allowUnoptimized: false,
-			expectedCount:    0,
-			expectedRejected: 2,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			rejected := make(map[string][]TemplateCandidate)
-			result := filterByOptimizationStatus(tt.candidates, tt.allowUnoptimized, rejected)
-
-			if len(result) != tt.expectedCount {
-				t.Errorf("expected %d candidates, got %d", tt.expectedCount, len(result))
-			}
-			if len(rejected[stageUnoptimized]) != tt.expectedRejected {
-				t.Errorf("expected %d rejected, got %d", tt.expectedRejected, len(rejected[stageUnoptimized]))
-			}
-		})
-	}
+func TestSelectTemplateForModel_NamespaceSelector(t *testing.T) {
+	ctx := testContext()
+	node := NewNode("gpu-node").WithGPUProductID("0x74a1").Build() // MI300X
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   testNamespace,
+		Labels: map[string]string{"gpu-tier": "small"},
+	}}
+	service := NewService("svc").WithModelName(testModelName).Build()
+
+	t.Run("restricted template is not selected", func(t *testing.T) {
+		restricted := NewClusterTemplate("large").WithModelName(testModelName).WithGPU("MI300X", 8).
+			WithNamespaceSelector(map[string]string{"gpu-tier": "large"}).Build()
+		c := newFakeClient(node, namespace, restricted)
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, nil)
+		if result.SelectedClusterTemplate != nil {
+			t.Fatalf("expected no template, got %s", result.SelectedClusterTemplate.Name)
+		}
+		if result.SelectionReason != aimv1alpha1.AIMServiceReasonTemplateNamespaceDenied {
+			t.Errorf("expected reason %s, got %s", aimv1alpha1.AIMServiceReasonTemplateNamespaceDenied, result.SelectionReason)
+		}
+		if len(result.MatchingResults) != 1 || result.MatchingResults[0].Reason != "NamespaceNotAllowed" {
+			t.Errorf("expected NamespaceNotAllowed rejection, got %+v", result.MatchingResults)
+		}
+	})
+
+	t.Run("unrestricted template is selected", func(t *testing.T) {
+		restricted := NewClusterTemplate("large").WithModelName(testModelName).WithGPU("MI300X", 8).
+			WithNamespaceSelector(map[string]string{"gpu-tier": "large"}).Build()
+		open := NewClusterTemplate("small").WithModelName(testModelName).WithGPU("MI300X", 1).Build()
+		c := newFakeClient(node, namespace, restricted, open)
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, nil)
+		if result.SelectedClusterTemplate == nil || result.SelectedClusterTemplate.Name != "small" {
+			t.Fatalf("expected template small, got %+v", result.SelectedClusterTemplate)
+		}
+	})
+
+	t.Run("explicit reference is rejected", func(t *testing.T) {
+		restricted := NewClusterTemplate("large").WithModelName(testModelName).WithGPU("MI300X", 8).
+			WithNamespaceSelector(map[string]string{"gpu-tier": "large"}).Build()
+		c := newFakeClient(node, namespace, restricted)
+		named := NewService("svc").WithTemplateName("large").Build()
+
+		template, clusterTemplate, _ := fetchTemplate(ctx, c, named,
+			controllerutils.FetchResult[*aimv1alpha1.AIMModel]{},
+			controllerutils.FetchResult[*aimv1alpha1.AIMClusterModel]{}, nil)
+		if clusterTemplate.Value != nil {
+			t.Fatal("expected cluster template not to be resolved")
+		}
+		cerr := controllerutils.CategorizeError(template.Error)
+		if cerr.Category() != controllerutils.ErrorCategoryInvalidSpec || cerr.Reason() != aimv1alpha1.AIMServiceReasonTemplateNamespaceDenied {
+			t.Errorf("expected TemplateNamespaceDenied invalid spec error, got %v", template.Error)
+		}
+	})
 }
 
 // ============================================================================
-// STAGE 3: OVERRIDES FILTER TESTS
+// GPU HEADROOM FILTER TESTS
 // ============================================================================
 
-func TestFilterTemplatesByOverrides(t *testing.T) {
-	latency := aimv1alpha1.AIMMetricLatency
-	throughput := aimv1alpha1.AIMMetricThroughput
-	fp16 := aimv1alpha1.AIMPrecisionFP16
-	fp8 := aimv1alpha1.AIMPrecisionFP8
-
-	tests := []struct {
-		name          string
-		candidates    []TemplateCandidate
-		overrides     *aimv1alpha1.AIMServiceOverrides
-		expectedNames []string
-	}{
-		{
-			name: "nil overrides - all pass",
-			candidates: []TemplateCandidate{
-				NewCandidate("t1").WithMetric(latency).Build(),
-				NewCandidate("t2").WithMetric(throughput).Build(),
-			},
-			overrides:     nil,
-			expectedNames: []string{"t1", "t2"},
-		},
-		{
-			name: "filter by metric",
-			candidates: []TemplateCandidate{
-				NewCandidate("latency").WithMetric(latency).Build(),
-				NewCandidate("throughput").WithMetric(throughput).Build(),
-			},
-			overrides:     &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Metric: &latency}},
-			expectedNames: []string{"latency"},
-		},
-		{
-			name: "filter by precision",
-			candidates: []TemplateCandidate{
-				NewCandidate("fp16").WithPrecision(fp16).Build(),
-				NewCandidate("fp8").WithPrecision(fp8).Build(),
-			},
-			overrides:     &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Precision: &fp16}},
-			expectedNames: []string{"fp16"},
-		},
-		{
-			name: "filter by GPU model",
-			candidates: []TemplateCandidate{
-				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
-				NewCandidate("mi325x").WithGPU("MI325X", 8).Build(),
-			},
-			overrides: &aimv1alpha1.AIMServiceOverrides{
-				AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
-					Hardware: &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{Model: "MI300X"}}, // Only model, no count
-				},
-			},
-			expectedNames: []string{"mi300x"},
-		},
-		{
-			name: "filter by GPU count",
-			candidates: []TemplateCandidate{
-				NewCandidate("4gpu").WithGPU("MI300X", 4).Build(),
-				NewCandidate("8gpu").WithGPU("MI300X", 8).Build(),
-			},
-			overrides: &aimv1alpha1.AIMServiceOverrides{
-				AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
-					Hardware: &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 4}},
-				},
-			},
-			expectedNames: []string{"4gpu"},
-		},
-		{
-			name: "filter by multiple overrides",
-			candidates: []TemplateCandidate{
-				NewCandidate("match").WithGPU("MI300X", 4).WithPrecision(fp16).WithMetric(latency).Build(),
-				NewCandidate("wrong-gpu").WithGPU("MI325X", 4).WithPrecision(fp16).WithMetric(latency).Build(),
-				NewCandidate("wrong-precision").WithGPU("MI300X", 4).WithPrecision(fp8).WithMetric(latency).Build(),
-			},
-			overrides: &aimv1alpha1.AIMServiceOverrides{
-				AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
-					Hardware:  &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{Model: "MI300X", Requests: 4}},
-					Precision: &fp16,
-					Metric:    &latency,
-				},
-			},
-			expectedNames: []string{"match"},
-		},
-		{
-			name: "no matches",
-			candidates: []TemplateCandidate{
-				NewCandidate("t1").WithMetric(throughput).Build(),
-				NewCandidate("t2").WithMetric(throughput).Build(),
-			},
-			overrides:     &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Metric: &latency}},
-			expectedNames: []string{},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := filterTemplatesByOverrides(tt.candidates, tt.overrides)
-
-			if len(result) != len(tt.expectedNames) {
-				t.Errorf("expected %d candidates, got %d", len(tt.expectedNames), len(result))
-				return
-			}
-
-			for i, expected := range tt.expectedNames {
-				if result[i].Name != expected {
-					t.Errorf("expected candidate[%d].Name=%s, got %s", i, expected, result[i].Name)
-				}
-			}
-		})
+func gpuPod(namespace, name, node string, gpus int64, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+					corev1.ResourceName(constants.DefaultGPUResourceName): *resource.NewQuantity(gpus, resource.DecimalSI),
+				}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
 	}
 }
 
-// ============================================================================
-// STAGE 4: GPU AVAILABILITY FILTER TESTS
-// ============================================================================
-
-func TestFilterTemplatesByGPUAvailability(t *testing.T) {
-	tests := []struct {
-		name          string
-		candidates    []TemplateCandidate
-		availableGPUs []string
-		expectedNames []string
-	}{
-		{
-			name: "no GPUs available - all rejected",
-			candidates: []TemplateCandidate{
-				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
-				NewCandidate("mi325x").WithGPU("MI325X", 8).Build(),
-			},
-			availableGPUs: []string{},
-			expectedNames: []string{},
-		},
-		{
-			name: "all GPUs available",
-			candidates: []TemplateCandidate{
-				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
-				NewCandidate("mi325x").WithGPU("MI325X", 8).Build(),
-			},
-			availableGPUs: []string{"MI300X", "MI325X"},
-			expectedNames: []string{"mi300x", "mi325x"},
-		},
-		{
-			name: "partial GPU availability",
-			candidates: []TemplateCandidate{
-				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
-				NewCandidate("mi325x").WithGPU("MI325X", 8).Build(),
-				NewCandidate("mi250x").WithGPU("MI250X", 4).Build(),
-			},
-			availableGPUs: []string{"MI300X", "MI250X"},
-			expectedNames: []string{"mi300x", "mi250x"},
-		},
-		{
-			name: "candidate without GPU spec - passes",
-			candidates: []TemplateCandidate{
-				NewCandidate("no-gpu").Build(), // No GPU specified
-				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
-			},
-			availableGPUs: []string{},
-			expectedNames: []string{"no-gpu"},
-		},
-		{
-			name: "case-insensitive GPU matching",
-			candidates: []TemplateCandidate{
-				NewCandidate("mi300x-lower").WithGPU("mi300x", 4).Build(),
-				NewCandidate("MI300X-upper").WithGPU("MI300X", 4).Build(),
-			},
-			availableGPUs: []string{"MI300X"},
-			expectedNames: []string{"mi300x-lower", "MI300X-upper"},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := filterTemplatesByGPUAvailability(tt.candidates, tt.availableGPUs)
-
-			if len(result) != len(tt.expectedNames) {
-				t.Errorf("expected %d candidates, got %d", len(tt.expectedNames), len(result))
-				return
-			}
-
-			for i, expected := range tt.expectedNames {
-				if result[i].Name != expected {
-					t.Errorf("expected candidate[%d].Name=%s, got %s", i, expected, result[i].Name)
-				}
-			}
-		})
+func TestComputeGPUHeadroom(t *testing.T) {
+	ctx := testContext()
+	isvcName, _ := GenerateInferenceServiceName("svc", testNamespace)
+	objects := []client.Object{
+		NewNode("node-a").WithGPUProductID("0x74a1").WithAllocatableGPUs(8).Build(),
+		NewNode("node-b").WithGPUProductID("0x74a1").WithAllocatableGPUs(8).Build(),
+		gpuPod("other", "busy", "node-a", 6, nil),
+		gpuPod(testNamespace, "own", "node-b", 2, map[string]string{constants.LabelKServeInferenceService: isvcName}),
 	}
+	done := gpuPod("other", "done", "node-b", 8, nil)
+	done.Status.Phase = corev1.PodSucceeded
+	objects = append(objects, done)
+
+	t.Run("free GPUs exclude used and own GPUs", func(t *testing.T) {
+		headroom, err := computeGPUHeadroom(ctx, newFakeClient(objects...), testNamespace, isvcName, 50)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		allowed, free := headroom.Allowed("MI300X")
+		if free != 10 || allowed != 5 {
+			t.Errorf("expected 10 free and 5 allowed, got %d free and %d allowed", free, allowed)
+		}
+	})
+
+	t.Run("namespace quota caps free GPUs", func(t *testing.T) {
+		quota := &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpus", Namespace: testNamespace},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: corev1.ResourceList{gpuQuotaResource: resource.MustParse("4")},
+				Used: corev1.ResourceList{gpuQuotaResource: resource.MustParse("1")},
+			},
+		}
+		headroom, err := computeGPUHeadroom(ctx, newFakeClient(append(objects, quota)...), testNamespace, isvcName, 100)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		four := selection.Candidate{Status: aimv1alpha1.AIMServiceTemplateStatus{Profile: &aimv1alpha1.AIMProfile{
+			Metadata: aimv1alpha1.AIMProfileMetadata{GPU: "MI300X", GPUCount: 4},
+		}}}
+		fits, message := headroom.Evaluate(four)
+		if fits {
+			t.Error("expected template to exceed the quota-limited headroom")
+		}
+		if message != "requires 4 MI300X GPU(s); 100% of 3 free allows 3 (limited by namespace quota)" {
+			t.Errorf("unexpected message %q", message)
+		}
+	})
 }
 
-// ============================================================================
-// STAGE 5: SCOPE PREFERENCE TESTS
-// ============================================================================
-
-func TestPreferNamespaceTemplates(t *testing.T) {
-	tests := []struct {
-		name          string
-		candidates    []TemplateCandidate
-		expectedNames []string
-	}{
-		{
-			name: "all namespace-scoped",
-			candidates: []TemplateCandidate{
-				NewCandidate("ns1").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
-				NewCandidate("ns2").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
-			},
-			expectedNames: []string{"ns1", "ns2"},
-		},
-		{
-			name: "all cluster-scoped",
-			candidates: []TemplateCandidate{
-				NewCandidate("cl1").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
-				NewCandidate("cl2").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
-			},
-			expectedNames: []string{"cl1", "cl2"}, // No namespace templates, so cluster ones pass
-		},
-		{
-			name: "mixed - namespace preferred",
-			candidates: []TemplateCandidate{
-				NewCandidate("cluster").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
-				NewCandidate("namespace").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
-			},
-			expectedNames: []string{"namespace"}, // Only namespace template returned
-		},
-		{
-			name: "multiple mixed - all namespace returned",
-			candidates: []TemplateCandidate{
-				NewCandidate("cl1").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
-				NewCandidate("ns1").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
-				NewCandidate("cl2").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
-				NewCandidate("ns2").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
-			},
-			expectedNames: []string{"ns1", "ns2"},
-		},
+func TestSelectTemplateForModel_GPUHeadroom(t *testing.T) {
+	ctx := testContext()
+	node := NewNode("gpu-node").WithGPUProductID("0x74a1").WithAllocatableGPUs(8).Build()
+	busy := gpuPod("other", "busy", "gpu-node", 4, nil)
+	service := NewService("svc").WithModelName(testModelName).Build()
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{
+		TemplateSelection: &aimv1alpha1.AIMTemplateSelectionConfig{MaxFreeGPUPercent: ptr.To(int32(50))},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := preferNamespaceTemplates(tt.candidates)
-
-			if len(result) != len(tt.expectedNames) {
-				t.Errorf("expected %d candidates, got %d", len(tt.expectedNames), len(result))
-				return
-			}
-
-			// Check names (order may vary for namespace templates)
-			resultNames := make(map[string]bool)
-			for _, c := range result {
-				resultNames[c.Name] = true
+	t.Run("template over the headroom is rejected", func(t *testing.T) {
+		small := NewClusterTemplate("small").WithModelName(testModelName).WithGPU("MI300X", 2).Build()
+		large := NewClusterTemplate("large").WithModelName(testModelName).WithGPU("MI300X", 4).Build()
+		c := newFakeClient(node, busy, small, large)
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, runtimeConfig)
+		if result.SelectedClusterTemplate == nil || result.SelectedClusterTemplate.Name != "small" {
+			t.Fatalf("expected template small, got %+v", result.SelectedClusterTemplate)
+		}
+		for _, r := range result.MatchingResults {
+			if r.Name == "large" && (r.Reason != "GPUHeadroomExceeded" ||
+				r.Message != "requires 4 MI300X GPU(s); 50% of 4 free allows 2") {
+				t.Errorf("unexpected evaluation for large: %+v", r)
 			}
-			for _, expected := range tt.expectedNames {
-				if !resultNames[expected] {
-					t.Errorf("expected candidate %s not found in result", expected)
-				}
+			if r.Name == "small" && r.Message == "" {
+				t.Error("expected headroom computation on the chosen template")
 			}
-		})
-	}
+		}
+	})
+
+	t.Run("no template fits", func(t *testing.T) {
+		large := NewClusterTemplate("large").WithModelName(testModelName).WithGPU("MI300X", 4).Build()
+		c := newFakeClient(node, busy, large)
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, runtimeConfig)
+		if result.SelectedClusterTemplate != nil {
+			t.Fatalf("expected no template, got %s", result.SelectedClusterTemplate.Name)
+		}
+		if result.SelectionReason != aimv1alpha1.AIMServiceReasonInsufficientGPUHeadroom {
+			t.Errorf("expected reason %s, got %s", aimv1alpha1.AIMServiceReasonInsufficientGPUHeadroom, result.SelectionReason)
+		}
+		if !strings.Contains(result.SelectionMessage, "large: requires 4 MI300X GPU(s); 50% of 4 free allows 2") {
+			t.Errorf("expected headroom computation in message, got %q", result.SelectionMessage)
+		}
+	})
+
+	t.Run("disabled without runtime config", func(t *testing.T) {
+		large := NewClusterTemplate("large").WithModelName(testModelName).WithGPU("MI300X", 4).Build()
+		c := newFakeClient(node, busy, large)
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, nil)
+		if result.SelectedClusterTemplate == nil {
+			t.Fatal("expected template large to be selected")
+		}
+	})
 }
 
-// ============================================================================
-// STAGE 6: PREFERENCE SCORING TESTS
-// ============================================================================
-
-func TestChoosePreferredTemplate(t *testing.T) {
-	latency := aimv1alpha1.AIMMetricLatency
-	throughput := aimv1alpha1.AIMMetricThroughput
-	fp16 := aimv1alpha1.AIMPrecisionFP16
-	fp8 := aimv1alpha1.AIMPrecisionFP8
-	bf16 := aimv1alpha1.AIMPrecisionBF16
-
-	tests := []struct {
-		name          string
-		candidates    []TemplateCandidate
-		expectedName  string
-		expectedCount int // Number of candidates with identical best scores
-	}{
-		{
-			name:          "empty candidates",
-			candidates:    []TemplateCandidate{},
-			expectedName:  "",
-			expectedCount: 0,
-		},
-		{
-			name: "single candidate",
-			candidates: []TemplateCandidate{
-				NewCandidate("only").Build(),
-			},
-			expectedName:  "only",
-			expectedCount: 1,
-		},
-		{
-			name: "prefer optimized over unoptimized",
-			candidates: []TemplateCandidate{
-				NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build(),
-				NewCandidate("optimized").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
-			},
-			expectedName:  "optimized",
-			expectedCount: 1,
-		},
-		{
-			name: "prefer MI325X over MI300X (GPU tier)",
-			candidates: []TemplateCandidate{
-				NewCandidate("mi300x").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).Build(),
-				NewCandidate("mi325x").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI325X", 4).Build(),
-			},
-			expectedName:  "mi325x",
-			expectedCount: 1,
-		},
-		{
-			name: "prefer latency over throughput (metric)",
-			candidates: []TemplateCandidate{
-				NewCandidate("throughput").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(throughput).Build(),
-				NewCandidate("latency").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).Build(),
-			},
-			expectedName:  "latency",
-			expectedCount: 1,
-		},
-		{
-			name: "prefer fp8 over fp16 (precision)",
-			candidates: []TemplateCandidate{
-				NewCandidate("fp16").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
-				NewCandidate("fp8").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp8).Build(),
-			},
-			expectedName:  "fp8",
-			expectedCount: 1,
-		},
-		{
-			name: "profile type beats GPU tier",
-			candidates: []TemplateCandidate{
-				NewCandidate("unoptimized-mi325x").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).WithGPU("MI325X", 8).Build(),
-				NewCandidate("optimized-mi300x").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).Build(),
-			},
-			expectedName:  "optimized-mi300x",
-			expectedCount: 1,
-		},
-		{
-			name: "GPU tier beats metric",
-			candidates: []TemplateCandidate{
-				NewCandidate("mi300x-latency").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).Build(),
-				NewCandidate("mi325x-throughput").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI325X", 4).WithMetric(throughput).Build(),
-			},
-			expectedName:  "mi325x-throughput",
-			expectedCount: 1,
-		},
-		{
-			name: "identical scores - count > 1",
-			candidates: []TemplateCandidate{
-				NewCandidate("t1").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
-				NewCandidate("t2").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
-			},
-			expectedName:  "t1", // First one wins when identical
-			expectedCount: 2,
-		},
-		{
-			name: "complex scenario with multiple factors",
-			candidates: []TemplateCandidate{
-				NewCandidate("worst").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).WithGPU("MI210", 4).WithMetric(throughput).WithPrecision(bf16).Build(),
-				NewCandidate("mid").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(throughput).WithPrecision(fp16).Build(),
-				NewCandidate("best").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI325X", 8).WithMetric(latency).WithPrecision(fp8).Build(),
-			},
-			expectedName:  "best",
-			expectedCount: 1,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			selected, count := choosePreferredTemplate(tt.candidates)
-
-			if tt.expectedName == "" {
-				if selected != nil {
-					t.Errorf("expected nil selection, got %s", selected.Name)
-				}
-			} else {
-				if selected == nil {
-					t.Errorf("expected selection %s, got nil", tt.expectedName)
-					return
-				}
-				if selected.Name != tt.expectedName {
-					t.Errorf("expected selection %s, got %s", tt.expectedName, selected.Name)
-				}
-			}
-
-			if count != tt.expectedCount {
-				t.Errorf("expected count %d, got %d", tt.expectedCount, count)
-			}
-		})
-	}
+func TestSelectTemplateForModel_UnoptimizedFallback(t *testing.T) {
+	ctx := testContext()
+	node := NewNode("gpu-node").WithGPUProductID("0x74a1").Build() // MI300X
+	// The optimized template needs GPUs that are not in the cluster
+	optimized := NewClusterTemplate("optimized").WithModelName(testModelName).WithGPU("MI325X", 1).Build()
+	unoptimized := NewClusterTemplate("unoptimized").WithModelName(testModelName).WithGPU("MI300X", 1).
+		WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build()
+
+	t.Run("selection fails without consent", func(t *testing.T) {
+		c := newFakeClient(node, optimized, unoptimized)
+		service := NewService("svc").WithModelName(testModelName).Build()
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, nil)
+		if result.SelectedClusterTemplate != nil {
+			t.Fatalf("expected no template, got %s", result.SelectedClusterTemplate.Name)
+		}
+		if result.UnoptimizedFallback {
+			t.Error("expected no fallback")
+		}
+	})
+
+	t.Run("falls back with consent", func(t *testing.T) {
+		c := newFakeClient(node, optimized, unoptimized)
+		service := NewService("svc").WithModelName(testModelName).WithAllowUnoptimizedFallback(true).Build()
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, nil)
+		if result.SelectedClusterTemplate == nil || result.SelectedClusterTemplate.Name != "unoptimized" {
+			t.Fatalf("expected template unoptimized, got %+v", result.SelectedClusterTemplate)
+		}
+		if !result.UnoptimizedFallback {
+			t.Error("expected fallback to be reported")
+		}
+	})
+
+	t.Run("optimized template is preferred with consent", func(t *testing.T) {
+		fits := NewClusterTemplate("fits").WithModelName(testModelName).WithGPU("MI300X", 8).Build()
+		c := newFakeClient(node, optimized, unoptimized, fits)
+		service := NewService("svc").WithModelName(testModelName).WithAllowUnoptimizedFallback(true).Build()
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, nil)
+		if result.SelectedClusterTemplate == nil || result.SelectedClusterTemplate.Name != "fits" {
+			t.Fatalf("expected template fits, got %+v", result.SelectedClusterTemplate)
+		}
+		if result.UnoptimizedFallback {
+			t.Error("expected no fallback")
+		}
+	})
 }
 
-// ============================================================================
-// FULL SELECTION ALGORITHM TESTS
-// ============================================================================
-
-func TestSelectBestTemplate(t *testing.T) {
-	latency := aimv1alpha1.AIMMetricLatency
-	fp16 := aimv1alpha1.AIMPrecisionFP16
+func TestSetRunningUnoptimizedCondition(t *testing.T) {
+	unoptimized := NewClusterTemplate("unoptimized").WithModelName(testModelName).
+		WithProfileType(aimv1alpha1.AIMProfileTypePreview).Build()
+	optimized := NewClusterTemplate("optimized").WithModelName(testModelName).Build()
 
 	tests := []struct {
-		name             string
-		candidates       []TemplateCandidate
-		overrides        *aimv1alpha1.AIMServiceOverrides
-		availableGPUs    []string
-		allowUnoptimized bool
-		expectedName     string
-		expectedCount    int
+		name       string
+		service    *aimv1alpha1.AIMService
+		template   *aimv1alpha1.AIMClusterServiceTemplate
+		wantStatus metav1.ConditionStatus
+		wantReason string
 	}{
 		{
-			name:          "empty candidates",
-			candidates:    []TemplateCandidate{},
-			availableGPUs: []string{"MI300X"},
-			expectedName:  "",
-			expectedCount: 0,
+			name:       "unoptimized profile",
+			service:    NewService("svc").WithAllowUnoptimizedFallback(true).Build(),
+			template:   unoptimized,
+			wantStatus: metav1.ConditionTrue,
+			wantReason: aimv1alpha1.AIMServiceReasonUnoptimizedFallback,
 		},
 		{
-			name: "single matching candidate",
-			candidates: []TemplateCandidate{
-				NewCandidate("only").WithGPU("MI300X", 4).Build(),
-			},
-			availableGPUs: []string{"MI300X"},
-			expectedName:  "only",
-			expectedCount: 1,
-		},
-		{
-			name: "filter by availability first",
-			candidates: []TemplateCandidate{
-				NewCandidate("not-ready").WithStatus(constants.AIMStatusPending).WithGPU("MI325X", 8).Build(),
-				NewCandidate("ready").WithStatus(constants.AIMStatusReady).WithGPU("MI300X", 4).Build(),
-			},
-			availableGPUs: []string{"MI300X", "MI325X"},
-			expectedName:  "ready",
-			expectedCount: 1,
-		},
-		{
-			name: "filter by optimization status",
-			candidates: []TemplateCandidate{
-				NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).WithGPU("MI325X", 8).Build(),
-				NewCandidate("optimized").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).Build(),
-			},
-			availableGPUs:    []string{"MI300X", "MI325X"},
-			allowUnoptimized: false,
-			expectedName:     "optimized",
-			expectedCount:    1,
-		},
-		{
-			name: "filter by overrides",
-			candidates: []TemplateCandidate{
-				NewCandidate("wrong-metric").WithGPU("MI300X", 4).WithMetric(aimv1alpha1.AIMMetricThroughput).Build(),
-				NewCandidate("right-metric").WithGPU("MI300X", 4).WithMetric(latency).Build(),
-			},
-			overrides:     &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Metric: &latency}},
-			availableGPUs: []string{"MI300X"},
-			expectedName:  "right-metric",
-			expectedCount: 1,
-		},
-		{
-			name: "filter by GPU availability",
-			candidates: []TemplateCandidate{
-				NewCandidate("unavailable-gpu").WithGPU("MI325X", 8).Build(),
-				NewCandidate("available-gpu").WithGPU("MI300X", 4).Build(),
-			},
-			availableGPUs: []string{"MI300X"}, // MI325X not available
-			expectedName:  "available-gpu",
-			expectedCount: 1,
+			name:       "optimized profile",
+			service:    NewService("svc").WithAllowUnoptimizedFallback(true).Build(),
+			template:   optimized,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: aimv1alpha1.AIMServiceReasonOptimizedProfile,
 		},
 		{
-			name: "prefer namespace over cluster",
-			candidates: []TemplateCandidate{
-				NewCandidate("cluster").WithScope(aimv1alpha1.AIMResolutionScopeCluster).WithGPU("MI325X", 8).Build(),
-				NewCandidate("namespace").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).WithGPU("MI300X", 4).Build(),
-			},
-			availableGPUs: []string{"MI300X", "MI325X"},
-			expectedName:  "namespace",
-			expectedCount: 1,
-		},
-		{
-			name: "all filters eliminate candidates",
-			candidates: []TemplateCandidate{
-				NewCandidate("not-ready").WithStatus(constants.AIMStatusFailed).Build(),
-				NewCandidate("wrong-gpu").WithGPU("MI325X", 8).Build(),
-			},
-			availableGPUs: []string{"MI300X"}, // MI325X not available
-			expectedName:  "",
-			expectedCount: 0,
-		},
-		{
-			name: "full pipeline with scoring",
-			candidates: []TemplateCandidate{
-				NewCandidate("good").WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
-				NewCandidate("better").WithGPU("MI325X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
-			},
-			availableGPUs: []string{"MI300X", "MI325X"},
-			expectedName:  "better", // MI325X preferred
-			expectedCount: 1,
+			name:     "fallback not enabled",
+			service:  NewService("svc").WithAllowUnoptimized(true).Build(),
+			template: unoptimized,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			selected, count, _, _ := selectBestTemplate(
-				tt.candidates,
-				tt.overrides,
-				tt.availableGPUs,
-				tt.allowUnoptimized,
-			)
-
-			if tt.expectedName == "" {
-				if selected != nil {
-					t.Errorf("expected nil selection, got %s", selected.Name)
-				}
-			} else {
-				if selected == nil {
-					t.Errorf("expected selection %s, got nil", tt.expectedName)
-					return
-				}
-				if selected.Name != tt.expectedName {
-					t.Errorf("expected selection %s, got %s", tt.expectedName, selected.Name)
+			cm := controllerutils.NewConditionManager(nil)
+			obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+				service:         tt.service,
+				clusterTemplate: controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{Value: tt.template},
+			}}
+
+			setRunningUnoptimizedCondition(cm, obs)
+
+			cond := cm.Get(aimv1alpha1.AIMServiceConditionRunningUnoptimized)
+			if tt.wantStatus == "" {
+				if cond != nil {
+					t.Fatalf("expected no condition, got %+v", cond)
 				}
+				return
 			}
-
-			if count != tt.expectedCount {
-				t.Errorf("expected count %d, got %d", tt.expectedCount, count)
+			if cond == nil {
+				t.Fatal("expected condition to be set")
+			}
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("condition = %s/%s, want %s/%s", cond.Status, cond.Reason, tt.wantStatus, tt.wantReason)
 			}
 		})
 	}
@@ -789,7 +454,7 @@ func TestSelectTemplateForModel_Integration(t *testing.T) {
 			}
 
 			c := newFakeClient(objs...)
-			result := selectTemplateForModel(ctx, c, tt.service, testModelName)
+			result := selectTemplateForModel(ctx, c, tt.service, testModelName, nil)
 
 			if tt.expectError {
 				if result.Error == nil {
@@ -0,0 +1,214 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimclustermodelcache"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// sharedModelCache is a cluster model cache that provides one of the template's model sources.
+type sharedModelCache struct {
+	cache *aimv1alpha1.AIMClusterModelCache
+
+	// volume is the PersistentVolume holding the downloaded model
+	volume *corev1.PersistentVolume
+
+	modelSource aimv1alpha1.AIMModelSource
+}
+
+// fetchSharedModelCaches finds the cluster model caches that provide every model source of the
+// template to the service namespace. Shared caches replace the template cache only when all model
+// sources are covered, the service uses the Shared caching mode without encryption, and an existing
+// InferenceService is already mounting them. An empty result means the template cache is used.
+func fetchSharedModelCaches(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+	templateStatus *aimv1alpha1.AIMServiceTemplateStatus,
+	existing *servingv1beta1.InferenceService,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) controllerutils.FetchResult[[]sharedModelCache] {
+	if templateStatus == nil || len(templateStatus.ModelSources) == 0 ||
+		service.Spec.GetCachingMode() != aimv1alpha1.CachingModeShared ||
+		resolveStorageEncryption(service, runtimeConfig) != nil {
+		return controllerutils.FetchResult[[]sharedModelCache]{}
+	}
+
+	var caches aimv1alpha1.AIMClusterModelCacheList
+	if err := c.List(ctx, &caches); err != nil {
+		return controllerutils.FetchResult[[]sharedModelCache]{Error: err}
+	}
+	if len(caches.Items) == 0 {
+		return controllerutils.FetchResult[[]sharedModelCache]{}
+	}
+	var namespace corev1.Namespace
+	if err := c.Get(ctx, client.ObjectKey{Name: service.Namespace}, &namespace); err != nil {
+		return controllerutils.FetchResult[[]sharedModelCache]{Error: err}
+	}
+	// A volume pair created for a terminating namespace would leave the cluster-scoped volume behind
+	if namespace.DeletionTimestamp != nil {
+		return controllerutils.FetchResult[[]sharedModelCache]{}
+	}
+
+	// A running InferenceService keeps a mounted cache while it is re-provisioned;
+	// only new InferenceServices wait for the cache to be ready
+	requireReady := existing == nil
+
+	shared := make([]sharedModelCache, 0, len(templateStatus.ModelSources))
+	for _, source := range templateStatus.ModelSources {
+		cache := findSharedModelCache(caches.Items, source.SourceURI, namespace.Labels, requireReady)
+		if cache == nil {
+			return controllerutils.FetchResult[[]sharedModelCache]{}
+		}
+		var volume corev1.PersistentVolume
+		if err := c.Get(ctx, client.ObjectKey{Name: cache.Status.PersistentVolume}, &volume); err != nil {
+			if apierrors.IsNotFound(err) {
+				return controllerutils.FetchResult[[]sharedModelCache]{}
+			}
+			return controllerutils.FetchResult[[]sharedModelCache]{Error: err}
+		}
+		shared = append(shared, sharedModelCache{cache: cache, volume: &volume, modelSource: source})
+	}
+
+	// A running InferenceService keeps the volumes it was created with
+	if existing != nil && !mountsSharedModelCaches(existing, shared) {
+		return controllerutils.FetchResult[[]sharedModelCache]{}
+	}
+	return controllerutils.FetchResult[[]sharedModelCache]{Value: shared}
+}
+
+// findSharedModelCache returns the first cluster cache (by name) that holds the source URI
+// and allows the namespace, or nil if there is none. With requireReady, only Ready caches match.
+func findSharedModelCache(
+	caches []aimv1alpha1.AIMClusterModelCache,
+	sourceURI string,
+	namespaceLabels map[string]string,
+	requireReady bool,
+) *aimv1alpha1.AIMClusterModelCache {
+	var found *aimv1alpha1.AIMClusterModelCache
+	for i := range caches {
+		cache := &caches[i]
+		if cache.Spec.SourceURI != sourceURI || cache.DeletionTimestamp != nil ||
+			(requireReady && cache.Status.Status != constants.AIMStatusReady) ||
+			cache.Status.PersistentVolume == "" || !cache.AllowsNamespace(namespaceLabels) {
+			continue
+		}
+		if found == nil || cache.Name < found.Name {
+			found = cache
+		}
+	}
+	return found
+}
+
+// mountsSharedModelCaches returns true if the InferenceService mounts the claims of all shared caches.
+func mountsSharedModelCaches(isvc *servingv1beta1.InferenceService, shared []sharedModelCache) bool {
+	for _, s := range shared {
+		claimName := aimclustermodelcache.SharedVolumeClaimName(s.cache)
+		if !slices.ContainsFunc(isvc.Spec.Predictor.Volumes, func(v corev1.Volume) bool {
+			return v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == claimName
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// lostSharedModelCaches returns true if the existing InferenceService mounts cluster model caches
+// that are no longer shared with the namespace, for example after the namespace stopped matching
+// the cache's namespace selector.
+func (obs ServiceObservation) lostSharedModelCaches() bool {
+	if obs.inferenceService.Value == nil || obs.sharedModelCaches.HasError() || len(obs.sharedModelCaches.Value) > 0 {
+		return false
+	}
+	return obs.inferenceService.Value.Annotations[constants.AnnotationClusterModelCaches] != ""
+}
+
+// switchesFromSharedModelCaches returns true once a service that lost its cluster model caches
+// has a ready template cache to rebuild its volumes from.
+func (obs ServiceObservation) switchesFromSharedModelCaches() bool {
+	return obs.lostSharedModelCaches() &&
+		obs.templateCache.Value != nil && obs.templateCache.Value.Status.Status == constants.AIMStatusReady
+}
+
+// addSharedCacheVolumes mounts the namespace claims of the shared caches read-only
+// at the paths the inference container expects the models at.
+func addSharedCacheVolumes(isvc *servingv1beta1.InferenceService, container *corev1.Container, shared []sharedModelCache) {
+	names := make([]string, 0, len(shared))
+	for _, s := range shared {
+		names = append(names, s.cache.Name)
+	}
+	if isvc.Annotations == nil {
+		isvc.Annotations = make(map[string]string)
+	}
+	isvc.Annotations[constants.AnnotationClusterModelCaches] = strings.Join(names, ",")
+
+	for _, s := range shared {
+		claimName := aimclustermodelcache.SharedVolumeClaimName(s.cache)
+		isvc.Spec.Predictor.Volumes = append(isvc.Spec.Predictor.Volumes, corev1.Volume{
+			Name: claimName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+
+		// Sanitize to prevent path traversal, as for template cache volumes
+		safeModelName := strings.ReplaceAll(s.modelSource.ModelID, "..", "")
+		if safeModelName == "" || safeModelName == "." {
+			safeModelName = claimName
+		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      claimName,
+			MountPath: filepath.Join(constants.AIMCacheBasePath, safeModelName),
+			ReadOnly:  true,
+		})
+	}
+}
+
+// sharedModelCacheRefs returns status references to the cluster caches a service mounts.
+func sharedModelCacheRefs(shared []sharedModelCache) []aimv1alpha1.AIMResolvedReference {
+	refs := make([]aimv1alpha1.AIMResolvedReference, 0, len(shared))
+	for _, s := range shared {
+		refs = append(refs, aimv1alpha1.AIMResolvedReference{
+			Name:  s.cache.Name,
+			Scope: aimv1alpha1.AIMResolutionScopeCluster,
+			UID:   s.cache.UID,
+		})
+	}
+	return refs
+}
@@ -0,0 +1,237 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+	"time"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimclustermodelcache"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const testSharedSourceURI = "hf://Qwen/Qwen2-0.5B"
+
+func newSharedCacheObjects(selector *metav1.LabelSelector) (*aimv1alpha1.AIMClusterModelCache, *corev1.PersistentVolume) {
+	cache := &aimv1alpha1.AIMClusterModelCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "qwen", UID: "cache-uid"},
+		Spec: aimv1alpha1.AIMClusterModelCacheSpec{
+			SourceURI:         testSharedSourceURI,
+			NamespaceSelector: selector,
+		},
+		Status: aimv1alpha1.AIMClusterModelCacheStatus{
+			Status:           constants.AIMStatusReady,
+			PersistentVolume: "pvc-1234",
+		},
+	}
+	volume := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1234"},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				NFS: &corev1.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports/pvc-1234"},
+			},
+		},
+	}
+	return cache, volume
+}
+
+func sharedTestTemplateStatus() *aimv1alpha1.AIMServiceTemplateStatus {
+	return &NewTemplate("tmpl").WithModelSources(aimv1alpha1.AIMModelSource{
+		ModelID:   "Qwen/Qwen2-0.5B",
+		SourceURI: testSharedSourceURI,
+	}).Build().Status
+}
+
+func TestFetchSharedModelCaches(t *testing.T) {
+	allowed := &metav1.LabelSelector{MatchLabels: map[string]string{"shared-cache": "true"}}
+	labeledNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: testNamespace, Labels: map[string]string{"shared-cache": "true"},
+	}}
+
+	tests := []struct {
+		name      string
+		selector  *metav1.LabelSelector
+		namespace *corev1.Namespace
+		mode      aimv1alpha1.AIMCachingMode
+		existing  *servingv1beta1.InferenceService
+		wantCount int
+	}{
+		{name: "allowed namespace", selector: allowed, namespace: labeledNamespace, wantCount: 1},
+		{
+			name:      "namespace not selected",
+			selector:  allowed,
+			namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}},
+		},
+		{name: "nil selector", selector: nil, namespace: labeledNamespace},
+		{
+			name:     "terminating namespace",
+			selector: allowed,
+			namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:              testNamespace,
+				Labels:            map[string]string{"shared-cache": "true"},
+				DeletionTimestamp: &metav1.Time{Time: time.Now()},
+				Finalizers:        []string{"kubernetes"},
+			}},
+		},
+		{name: "dedicated caching", selector: allowed, namespace: labeledNamespace, mode: aimv1alpha1.CachingModeDedicated},
+		{
+			name:      "existing InferenceService without shared volumes",
+			selector:  allowed,
+			namespace: labeledNamespace,
+			existing:  &servingv1beta1.InferenceService{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache, volume := newSharedCacheObjects(tt.selector)
+			builder := NewService("svc")
+			if tt.mode != "" {
+				builder = builder.WithCachingMode(tt.mode)
+			}
+			service := builder.Build()
+			c := newFakeClient(cache, volume, tt.namespace)
+
+			result := fetchSharedModelCaches(testContext(), c, service, sharedTestTemplateStatus(), tt.existing, nil)
+			if result.Error != nil {
+				t.Fatalf("unexpected error: %v", result.Error)
+			}
+			if len(result.Value) != tt.wantCount {
+				t.Errorf("expected %d shared caches, got %d", tt.wantCount, len(result.Value))
+			}
+		})
+	}
+}
+
+func TestPlanResources_SharedModelCache(t *testing.T) {
+	cache, volume := newSharedCacheObjects(&metav1.LabelSelector{})
+	service := NewService("svc").WithTemplateName("tmpl").Build()
+	template := NewTemplate("tmpl").WithModelSources(aimv1alpha1.AIMModelSource{
+		ModelID:   "Qwen/Qwen2-0.5B",
+		SourceURI: testSharedSourceURI,
+	}).Build()
+	shared := []sharedModelCache{{cache: cache, volume: volume, modelSource: template.Status.ModelSources[0]}}
+
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service:           service,
+		template:          controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: template},
+		sharedModelCaches: controllerutils.FetchResult[[]sharedModelCache]{Value: shared},
+	}}
+	reconciler := &ServiceReconciler{}
+	plan := reconciler.PlanResources(testContext(),
+		controllerutils.ReconcileContext[*aimv1alpha1.AIMService]{Object: service}, obs)
+
+	claimName := aimclustermodelcache.SharedVolumeClaimName(cache)
+	var foundPV, foundPVC bool
+	for _, obj := range plan.GetToApplyWithoutOwnerRef() {
+		switch o := obj.(type) {
+		case *aimv1alpha1.AIMTemplateCache:
+			t.Error("expected no template cache when a cluster model cache is shared")
+		case *corev1.PersistentVolume:
+			foundPV = o.Spec.ClaimRef != nil && o.Spec.ClaimRef.Namespace == testNamespace
+		case *corev1.PersistentVolumeClaim:
+			foundPVC = o.Name == claimName && o.Namespace == testNamespace
+		}
+	}
+	if !foundPV || !foundPVC {
+		t.Errorf("expected the shared volume pair for %s (pv=%v, pvc=%v)", testNamespace, foundPV, foundPVC)
+	}
+
+	if health := obs.getCacheHealth(); health.State != constants.AIMStatusReady {
+		t.Errorf("expected cache health Ready, got %s", health.State)
+	}
+
+	isvc := buildInferenceService(service, "tmpl", nil, nil, obs)
+	var mounted bool
+	for _, vm := range isvc.Spec.Predictor.Containers[0].VolumeMounts {
+		if vm.Name == claimName {
+			mounted = vm.ReadOnly && vm.MountPath == constants.AIMCacheBasePath+"/Qwen/Qwen2-0.5B"
+		}
+	}
+	if !mounted {
+		t.Error("expected the shared claim to be mounted read-only at the model path")
+	}
+}
+
+func TestSharedModelCache_Revoked(t *testing.T) {
+	cache, _ := newSharedCacheObjects(&metav1.LabelSelector{})
+	service := NewService("svc").WithTemplateName("tmpl").Build()
+	claimName := aimclustermodelcache.SharedVolumeClaimName(cache)
+	existing := &servingv1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{constants.AnnotationClusterModelCaches: cache.Name},
+		},
+		Spec: servingv1beta1.InferenceServiceSpec{Predictor: servingv1beta1.PredictorSpec{
+			PodSpec: servingv1beta1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:         constants.ContainerKServe,
+					VolumeMounts: []corev1.VolumeMount{{Name: claimName, MountPath: "/workspace/cache/Qwen/Qwen2-0.5B"}},
+				}},
+				Volumes: []corev1.Volume{{
+					Name: claimName,
+					VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claimName,
+					}},
+				}},
+			},
+		}},
+	}
+	templateCache := &aimv1alpha1.AIMTemplateCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "tmpl-cache", Namespace: testNamespace},
+		Status:     aimv1alpha1.AIMTemplateCacheStatus{Status: constants.AIMStatusProgressing},
+	}
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service:          service,
+		inferenceService: controllerutils.FetchResult[*servingv1beta1.InferenceService]{Value: existing},
+		templateCache:    controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCache]{Value: templateCache},
+	}}
+
+	health := obs.getCacheHealth()
+	if health.State != constants.AIMStatusDegraded || health.Reason != aimv1alpha1.AIMServiceReasonCacheLost {
+		t.Errorf("expected Degraded %s while the template cache is provisioned, got %s %s",
+			aimv1alpha1.AIMServiceReasonCacheLost, health.State, health.Reason)
+	}
+	isvc := buildInferenceService(service, "tmpl", nil, nil, obs)
+	if isvc.Annotations[constants.AnnotationClusterModelCaches] != cache.Name {
+		t.Error("expected the shared volumes to be kept until the template cache is ready")
+	}
+
+	templateCache.Status.Status = constants.AIMStatusReady
+	isvc = buildInferenceService(service, "tmpl", nil, nil, obs)
+	if _, ok := isvc.Annotations[constants.AnnotationClusterModelCaches]; ok {
+		t.Error("expected the volumes to be rebuilt from the template cache")
+	}
+	for _, v := range isvc.Spec.Predictor.Volumes {
+		if v.Name == claimName {
+			t.Error("expected the revoked shared claim to be removed")
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// SimulationResult is the outcome of running the service reconcile phases without applying anything.
+type SimulationResult struct {
+	// Model is the resolved namespace-scoped model, if any.
+	Model *aimv1alpha1.AIMModel
+	// ClusterModel is the resolved cluster-scoped model, if any.
+	ClusterModel *aimv1alpha1.AIMClusterModel
+	// PendingModelName is set when the service would create a model (image or custom mode).
+	PendingModelName string
+
+	// Template is the resolved namespace-scoped template, if any.
+	Template *aimv1alpha1.AIMServiceTemplate
+	// ClusterTemplate is the resolved cluster-scoped template, if any.
+	ClusterTemplate *aimv1alpha1.AIMClusterServiceTemplate
+	// Selection holds the auto-selection explanation. Nil when the template was referenced explicitly.
+	Selection *TemplateSelectionResult
+
+	// Health is the component health the state engine would evaluate.
+	Health []controllerutils.ComponentHealth
+	// Planned are the objects the reconciler would apply.
+	Planned []client.Object
+}
+
+// Simulate runs the fetch, compose and plan phases for a service against the given client
+// and returns what the reconciler would resolve and apply. It only reads through the client,
+// so it can run offline against a fake client seeded with manifests and a cluster snapshot.
+func Simulate(
+	ctx context.Context,
+	c client.Client,
+	clientset kubernetes.Interface,
+	service *aimv1alpha1.AIMService,
+) SimulationResult {
+	r := &ServiceReconciler{Clientset: clientset, Scheme: c.Scheme()}
+
+	reconcileCtx := controllerutils.ReconcileContext[*aimv1alpha1.AIMService]{Object: service}
+	reconcileCtx.MergedRuntimeConfig, reconcileCtx.RuntimeConfigSources = controllerutils.FetchMergedRuntimeConfig(
+		ctx, c, service.GetRuntimeConfigRef().Name, service.Namespace, service.GetRuntimeConfigOverride())
+
+	fetched := r.FetchRemoteState(ctx, c, reconcileCtx)
+	obs := r.ComposeState(ctx, reconcileCtx, fetched)
+	plan := r.PlanResources(ctx, reconcileCtx, obs)
+
+	result := SimulationResult{
+		Model:           obs.modelResult.Model.Value,
+		ClusterModel:    obs.modelResult.ClusterModel.Value,
+		Template:        obs.template.Value,
+		ClusterTemplate: obs.clusterTemplate.Value,
+		Selection:       obs.templateSelection,
+		Health:          obs.GetComponentHealth(ctx, clientset),
+		Planned:         append(plan.GetToApply(), plan.GetToApplyWithoutOwnerRef()...),
+	}
+	if obs.needsModelCreation {
+		result.PendingModelName = obs.pendingModelName
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func newSimulationClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		aimv1alpha1.AddToScheme, corev1.AddToScheme, autoscalingv2.AddToScheme,
+		kservev1beta1.AddToScheme, gatewayapiv1.Install,
+	} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("failed to build scheme: %v", err)
+		}
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestSimulate_SelectsTemplateAndPlansResources(t *testing.T) {
+	ctx := testContext()
+
+	model := NewClusterModel("llama").Build()
+	template := NewClusterTemplate("llama-mi300x").
+		WithModelName("llama").
+		WithGPU("MI300X", 1).
+		Build()
+	template.Status.ModelSources = []aimv1alpha1.AIMModelSource{NewModelSource("hf://meta/llama", 1<<30)}
+	node := NewNode("gpu-node").WithGPUProductID("0x74a1").Build()
+	service := NewService("svc").WithModelName("llama").Build()
+
+	c := newSimulationClient(t, model, template, node)
+	result := Simulate(ctx, c, kubefake.NewClientset(), service)
+
+	if result.ClusterModel == nil || result.ClusterModel.Name != "llama" {
+		t.Fatalf("expected cluster model llama, got %+v", result.ClusterModel)
+	}
+	if result.ClusterTemplate == nil || result.ClusterTemplate.Name != "llama-mi300x" {
+		t.Fatalf("expected cluster template llama-mi300x, got %+v", result.ClusterTemplate)
+	}
+	if result.Selection == nil || len(result.Selection.MatchingResults) == 0 {
+		t.Error("expected selection explanation")
+	}
+	if len(result.Planned) == 0 {
+		t.Error("expected planned resources")
+	}
+	if len(result.Health) == 0 {
+		t.Error("expected component health")
+	}
+}
+
+func TestSimulate_NoTemplates(t *testing.T) {
+	ctx := testContext()
+
+	model := NewClusterModel("llama").Build()
+	service := NewService("svc").WithModelName("llama").Build()
+
+	c := newSimulationClient(t, model)
+	result := Simulate(ctx, c, kubefake.NewClientset(), service)
+
+	if result.Template != nil || result.ClusterTemplate != nil {
+		t.Error("expected no template to be resolved")
+	}
+	if result.Selection == nil || result.Selection.SelectionReason != aimv1alpha1.AIMServiceReasonTemplateNotFound {
+		t.Errorf("expected %s selection reason, got %+v", aimv1alpha1.AIMServiceReasonTemplateNotFound, result.Selection)
+	}
+	if result.ClusterModel == nil || result.ClusterModel.Status.Status != constants.AIMStatusReady {
+		t.Errorf("expected ready cluster model, got %+v", result.ClusterModel)
+	}
+}
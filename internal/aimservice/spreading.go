@@ -0,0 +1,128 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"sort"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// spreadingConfig returns the spreading settings that apply to the service, or nil when the
+// service runs a single replica or no topology keys are configured.
+func spreadingConfig(service *aimv1alpha1.AIMService, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) *aimv1alpha1.AIMSpreadingConfig {
+	if runtimeConfig == nil || runtimeConfig.Spreading == nil || len(runtimeConfig.Spreading.TopologyKeys) == 0 {
+		return nil
+	}
+	if minReplicas(service) <= 1 {
+		return nil
+	}
+	return runtimeConfig.Spreading
+}
+
+// minReplicas returns the lowest replica count of the service.
+// Precedence: MinReplicas > Replicas > default (1)
+func minReplicas(service *aimv1alpha1.AIMService) int32 {
+	if service.Spec.MinReplicas != nil {
+		return *service.Spec.MinReplicas
+	}
+	if service.Spec.Replicas != nil {
+		return *service.Spec.Replicas
+	}
+	return 1
+}
+
+// applySpreading adds one topology spread constraint per configured topology key, selecting
+// the predictor pods of the InferenceService.
+func applySpreading(isvc *servingv1beta1.InferenceService, spreading *aimv1alpha1.AIMSpreadingConfig) {
+	if spreading == nil {
+		return
+	}
+	whenUnsatisfiable := spreading.WhenUnsatisfiable
+	if whenUnsatisfiable == "" {
+		whenUnsatisfiable = corev1.ScheduleAnyway
+	}
+	for _, key := range spreading.TopologyKeys {
+		isvc.Spec.Predictor.TopologySpreadConstraints = append(isvc.Spec.Predictor.TopologySpreadConstraints,
+			corev1.TopologySpreadConstraint{
+				MaxSkew:           ptr.Deref(spreading.MaxSkew, 1),
+				TopologyKey:       key,
+				WhenUnsatisfiable: whenUnsatisfiable,
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{constants.LabelKServeInferenceService: isvc.Name},
+				},
+			})
+	}
+}
+
+// buildSpreadStatus counts the scheduled predictor pods per domain of each topology key.
+// Pods that are not scheduled yet, or whose node has no value for the key, are not counted.
+func buildSpreadStatus(spreading *aimv1alpha1.AIMSpreadingConfig, pods []corev1.Pod, nodes []corev1.Node) []aimv1alpha1.AIMServiceSpreadStatus {
+	nodeLabels := make(map[string]map[string]string, len(nodes))
+	for i := range nodes {
+		nodeLabels[nodes[i].Name] = nodes[i].Labels
+	}
+
+	statuses := make([]aimv1alpha1.AIMServiceSpreadStatus, 0, len(spreading.TopologyKeys))
+	for _, key := range spreading.TopologyKeys {
+		counts := map[string]int32{}
+		for i := range pods {
+			pod := &pods[i]
+			if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil {
+				continue
+			}
+			if domain := nodeLabels[pod.Spec.NodeName][key]; domain != "" {
+				counts[domain]++
+			}
+		}
+
+		status := aimv1alpha1.AIMServiceSpreadStatus{TopologyKey: key}
+		for name, count := range counts {
+			status.Domains = append(status.Domains, aimv1alpha1.AIMSpreadDomain{Name: name, Pods: count})
+		}
+		sort.Slice(status.Domains, func(i, j int) bool { return status.Domains[i].Name < status.Domains[j].Name })
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// setSpreadStatus reports the current spread of the predictor pods. The previous report is
+// kept when the pods or their nodes could not be observed.
+func setSpreadStatus(status *aimv1alpha1.AIMServiceStatus, obs ServiceObservation) {
+	spreading := spreadingConfig(obs.service, obs.mergedRuntimeConfig.Value)
+	if spreading == nil {
+		status.Spread = nil
+		return
+	}
+	if obs.inferenceServicePods == nil || !obs.inferenceServicePods.OK() || obs.inferenceServicePods.Value == nil ||
+		obs.podNodes.HasError() {
+		return
+	}
+	status.Spread = buildSpreadStatus(spreading, obs.inferenceServicePods.Value.Items, obs.podNodes.Value)
+}
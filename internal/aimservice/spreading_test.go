@@ -0,0 +1,151 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const zoneKey = "topology.kubernetes.io/zone"
+
+func spreadingObservation(service *aimv1alpha1.AIMService, spreading *aimv1alpha1.AIMSpreadingConfig) ServiceObservation {
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{Spreading: spreading}
+	return ServiceObservation{
+		ServiceFetchResult: ServiceFetchResult{
+			service:             service,
+			mergedRuntimeConfig: controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Value: runtimeConfig},
+		},
+	}
+}
+
+func TestBuildInferenceService_Spreading(t *testing.T) {
+	spreading := &aimv1alpha1.AIMSpreadingConfig{
+		TopologyKeys:      []string{zoneKey, "example.com/rack"},
+		MaxSkew:           ptr.To[int32](2),
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+	}
+
+	tests := []struct {
+		name     string
+		replicas *int32
+		min      *int32
+		want     int
+	}{
+		{name: "single replica is not spread"},
+		{name: "fixed replicas are spread", replicas: ptr.To[int32](3), want: 2},
+		{name: "autoscaling with a minimum of one is not spread", min: ptr.To[int32](1)},
+		{name: "autoscaling with a higher minimum is spread", min: ptr.To[int32](2), want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService("svc").Build()
+			service.Spec.Replicas = tt.replicas
+			service.Spec.MinReplicas = tt.min
+
+			isvc := buildInferenceService(service, "tmpl", nil, nil, spreadingObservation(service, spreading))
+			constraints := isvc.Spec.Predictor.TopologySpreadConstraints
+			if len(constraints) != tt.want {
+				t.Fatalf("expected %d constraints, got %d", tt.want, len(constraints))
+			}
+			for _, c := range constraints {
+				if c.MaxSkew != 2 || c.WhenUnsatisfiable != corev1.DoNotSchedule {
+					t.Errorf("unexpected constraint settings: %+v", c)
+				}
+				if c.LabelSelector.MatchLabels[constants.LabelKServeInferenceService] != isvc.Name {
+					t.Errorf("expected constraint to select the predictor pods, got %v", c.LabelSelector.MatchLabels)
+				}
+			}
+			if tt.want > 0 && (constraints[0].TopologyKey != zoneKey || constraints[1].TopologyKey != "example.com/rack") {
+				t.Errorf("unexpected topology keys: %s, %s", constraints[0].TopologyKey, constraints[1].TopologyKey)
+			}
+		})
+	}
+}
+
+func TestApplySpreading_Defaults(t *testing.T) {
+	service := NewService("svc").Build()
+	service.Spec.Replicas = ptr.To[int32](2)
+	spreading := &aimv1alpha1.AIMSpreadingConfig{TopologyKeys: []string{zoneKey}}
+
+	isvc := buildInferenceService(service, "tmpl", nil, nil, spreadingObservation(service, spreading))
+	constraints := isvc.Spec.Predictor.TopologySpreadConstraints
+	if len(constraints) != 1 {
+		t.Fatalf("expected 1 constraint, got %d", len(constraints))
+	}
+	if constraints[0].MaxSkew != 1 || constraints[0].WhenUnsatisfiable != corev1.ScheduleAnyway {
+		t.Errorf("expected skew 1 and ScheduleAnyway, got %+v", constraints[0])
+	}
+}
+
+func TestBuildSpreadStatus(t *testing.T) {
+	spreading := &aimv1alpha1.AIMSpreadingConfig{TopologyKeys: []string{zoneKey}}
+	node := func(name, zone string) corev1.Node {
+		n := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if zone != "" {
+			n.Labels = map[string]string{zoneKey: zone}
+		}
+		return n
+	}
+	pod := func(nodeName string) corev1.Pod {
+		return corev1.Pod{Spec: corev1.PodSpec{NodeName: nodeName}}
+	}
+
+	nodes := []corev1.Node{node("n1", "zone-b"), node("n2", "zone-a"), node("n3", "zone-b"), node("n4", "")}
+	pods := []corev1.Pod{pod("n1"), pod("n2"), pod("n3"), pod("n4"), pod("")}
+
+	statuses := buildSpreadStatus(spreading, pods, nodes)
+	if len(statuses) != 1 || statuses[0].TopologyKey != zoneKey {
+		t.Fatalf("expected one status for %s, got %+v", zoneKey, statuses)
+	}
+	want := []aimv1alpha1.AIMSpreadDomain{{Name: "zone-a", Pods: 1}, {Name: "zone-b", Pods: 2}}
+	got := statuses[0].Domains
+	if len(got) != len(want) {
+		t.Fatalf("expected domains %+v, got %+v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("domain %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSetSpreadStatus_ClearsWhenNotSpread(t *testing.T) {
+	service := NewService("svc").Build()
+	status := &aimv1alpha1.AIMServiceStatus{
+		Spread: []aimv1alpha1.AIMServiceSpreadStatus{{TopologyKey: zoneKey}},
+	}
+
+	setSpreadStatus(status, spreadingObservation(service, &aimv1alpha1.AIMSpreadingConfig{TopologyKeys: []string{zoneKey}}))
+	if status.Spread != nil {
+		t.Errorf("expected spread status to be cleared for a single replica, got %+v", status.Spread)
+	}
+}
@@ -27,14 +27,18 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservicetemplate"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
 	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
 )
 
 // TemplateFetchResult holds the result of fetching/resolving a template for the service.
@@ -53,6 +57,7 @@ func fetchTemplate(
 	service *aimv1alpha1.AIMService,
 	model controllerutils.FetchResult[*aimv1alpha1.AIMModel],
 	clusterModel controllerutils.FetchResult[*aimv1alpha1.AIMClusterModel],
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
 ) (
 	controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate],
 	controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate],
@@ -60,6 +65,11 @@ func fetchTemplate(
 ) {
 	logger := log.FromContext(ctx)
 
+	// Case 0: Template forced via annotation (break-glass), bypasses selection and overrides
+	if forced := service.GetForcedTemplate(); forced != "" {
+		return fetchForcedTemplate(ctx, c, service, forced, resolvedModelName(model, clusterModel))
+	}
+
 	// Try to use previously resolved template if Ready
 	if result, shouldContinue := tryFetchResolvedTemplate(ctx, c, service); !shouldContinue {
 		return result.Template, result.ClusterTemplate, nil
@@ -73,8 +83,20 @@ func fetchTemplate(
 		templateName := strings.TrimSpace(service.Spec.Template.Name)
 		logger.V(1).Info("looking up template by name", "templateName", templateName)
 
+		// Overrides combined with an explicit template are refused under the Reject policy.
+		// Admission enforces this too; the check here covers objects created before the rule existed.
+		if err := selection.ValidateOverrides(&service.Spec); err != nil {
+			templateResult.Error = controllerutils.NewInvalidSpecFieldError(
+				aimv1alpha1.AIMServiceReasonOverridesRejected,
+				"spec.overrides",
+				err.Error(),
+				nil,
+			)
+			return templateResult, clusterTemplateResult, nil
+		}
+
 		// Check for derived template (service has overrides)
-		finalTemplateName := generateDerivedTemplateName(templateName, service.Spec.Overrides)
+		finalTemplateName := explicitTemplateLookupName(service)
 		if finalTemplateName != templateName {
 			logger.V(1).Info("using derived template name", "derivedName", finalTemplateName)
 		}
@@ -94,6 +116,18 @@ func fetchTemplate(
 			return templateResult, clusterTemplateResult, nil
 		}
 
+		// The derived template does not exist yet: resolve the namespace-scoped base
+		// template so the planner can produce the derived one from it.
+		if finalTemplateName != templateName {
+			baseResult := controllerutils.Fetch(ctx, c, client.ObjectKey{
+				Namespace: service.Namespace,
+				Name:      templateName,
+			}, &aimv1alpha1.AIMServiceTemplate{})
+			if baseResult.OK() || !baseResult.IsNotFound() {
+				return baseResult, clusterTemplateResult, nil
+			}
+		}
+
 		// Try cluster-scoped (only base name, derived templates are namespace-scoped)
 		clusterTemplateResult = controllerutils.Fetch(ctx, c, client.ObjectKey{
 			Name: templateName,
@@ -102,6 +136,10 @@ func fetchTemplate(
 		if clusterTemplateResult.OK() {
 			// Clear the namespace-scoped error since we found a cluster template
 			templateResult.Error = nil
+			if err := checkClusterTemplateNamespace(ctx, c, clusterTemplateResult.Value, service.Namespace); err != nil {
+				templateResult.Error = err
+				clusterTemplateResult = controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{}
+			}
 			return templateResult, clusterTemplateResult, nil
 		}
 
@@ -121,22 +159,14 @@ func fetchTemplate(
 	logger.V(1).Info("auto-selecting template")
 
 	// Get model name for template lookup
-	// Use OK() to check if model was actually found (Fetch always sets Value even on error)
-	// Also check Name != "" to ensure the model was actually populated (not an empty struct)
-	var modelName string
-	if model.OK() && model.Value != nil && model.Value.Name != "" {
-		modelName = model.Value.Name
-	} else if clusterModel.OK() && clusterModel.Value != nil && clusterModel.Value.Name != "" {
-		modelName = clusterModel.Value.Name
-	}
-
+	modelName := resolvedModelName(model, clusterModel)
 	if modelName == "" {
 		// Can't auto-select without a model
 		return templateResult, clusterTemplateResult, nil
 	}
 
 	// Perform template auto-selection
-	selection := selectTemplateForModel(ctx, c, service, modelName)
+	selection := selectTemplateForModel(ctx, c, service, modelName, runtimeConfig)
 
 	if selection.Error != nil {
 		templateResult.Error = selection.Error
@@ -152,6 +182,97 @@ func fetchTemplate(
 	return templateResult, clusterTemplateResult, selection
 }
 
+// resolvedModelName returns the name of the model the service resolved to, or "" if none.
+// Uses OK() to check if the model was actually found (Fetch always sets Value even on error),
+// and Name != "" to ensure the model was actually populated (not an empty struct).
+func resolvedModelName(
+	model controllerutils.FetchResult[*aimv1alpha1.AIMModel],
+	clusterModel controllerutils.FetchResult[*aimv1alpha1.AIMClusterModel],
+) string {
+	if model.OK() && model.Value != nil && model.Value.Name != "" {
+		return model.Value.Name
+	}
+	if clusterModel.OK() && clusterModel.Value != nil && clusterModel.Value.Name != "" {
+		return clusterModel.Value.Name
+	}
+	return ""
+}
+
+// fetchForcedTemplate looks up the template pinned with the force-template annotation,
+// namespace-scoped first, then cluster-scoped, and checks that it serves the service's model.
+// Resolution waits until the model is resolved, so the check can always run.
+func fetchForcedTemplate(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+	templateName string,
+	modelName string,
+) (
+	controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate],
+	controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate],
+	*TemplateSelectionResult,
+) {
+	var templateResult controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
+	var clusterTemplateResult controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]
+	if modelName == "" {
+		return templateResult, clusterTemplateResult, nil
+	}
+	log.FromContext(ctx).V(1).Info("using forced template", "templateName", templateName)
+
+	templateResult = controllerutils.Fetch(ctx, c, client.ObjectKey{
+		Namespace: service.Namespace,
+		Name:      templateName,
+	}, &aimv1alpha1.AIMServiceTemplate{})
+	if templateResult.OK() {
+		if err := checkForcedTemplateModel(templateName, templateResult.Value.Spec.ModelName, modelName); err != nil {
+			return controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Error: err}, clusterTemplateResult, nil
+		}
+		return templateResult, clusterTemplateResult, nil
+	}
+	if !templateResult.IsNotFound() {
+		return templateResult, clusterTemplateResult, nil
+	}
+
+	clusterTemplateResult = controllerutils.Fetch(ctx, c, client.ObjectKey{
+		Name: templateName,
+	}, &aimv1alpha1.AIMClusterServiceTemplate{})
+	if clusterTemplateResult.OK() {
+		templateResult.Error = checkClusterTemplateNamespace(ctx, c, clusterTemplateResult.Value, service.Namespace)
+		if templateResult.Error == nil {
+			templateResult.Error = checkForcedTemplateModel(templateName, clusterTemplateResult.Value.Spec.ModelName, modelName)
+		}
+		if templateResult.Error != nil {
+			clusterTemplateResult = controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{}
+		}
+		return templateResult, clusterTemplateResult, nil
+	}
+
+	if clusterTemplateResult.IsNotFound() {
+		templateResult.Error = controllerutils.NewMissingUpstreamDependencyError(
+			aimv1alpha1.AIMServiceReasonTemplateNotFound,
+			fmt.Sprintf("forced template %q not found", templateName),
+			nil,
+		)
+		clusterTemplateResult.Error = nil
+	}
+	return templateResult, clusterTemplateResult, nil
+}
+
+// checkForcedTemplateModel returns an InvalidSpec error if the forced template serves a
+// different model than the service.
+func checkForcedTemplateModel(templateName, templateModel, modelName string) error {
+	if templateModel == modelName {
+		return nil
+	}
+	return controllerutils.NewInvalidSpecFieldError(
+		aimv1alpha1.AIMServiceReasonForcedTemplateMismatch,
+		"spec.template.name",
+		fmt.Sprintf("forced template %q serves model %q, but the service resolves to model %q",
+			templateName, templateModel, modelName),
+		nil,
+	)
+}
+
 // tryFetchResolvedTemplate attempts to fetch a previously resolved template reference.
 // Returns the result and whether to continue with normal resolution.
 func tryFetchResolvedTemplate(
@@ -166,6 +287,13 @@ func tryFetchResolvedTemplate(
 	logger := log.FromContext(ctx)
 	ref := service.Status.ResolvedTemplate
 
+	// While a derived template is pending, the base template is fetched in its place so the
+	// derived one can be planned. Keep looking for the derived template instead of the base.
+	if service.Spec.Template.Name != "" && ref.Name != explicitTemplateLookupName(service) {
+		logger.V(1).Info("resolved template differs from the expected derived template, re-resolving", "name", ref.Name)
+		return result, true
+	}
+
 	switch ref.Scope {
 	case aimv1alpha1.AIMResolutionScopeNamespace:
 		result.Template = controllerutils.Fetch(ctx, c, ref.NamespacedName(), &aimv1alpha1.AIMServiceTemplate{})
@@ -186,6 +314,11 @@ func tryFetchResolvedTemplate(
 	case aimv1alpha1.AIMResolutionScopeCluster:
 		result.ClusterTemplate = controllerutils.Fetch(ctx, c, ref.NamespacedName(), &aimv1alpha1.AIMClusterServiceTemplate{})
 		if result.ClusterTemplate.OK() && result.ClusterTemplate.Value.Status.Status == constants.AIMStatusReady {
+			// A namespaceSelector change may have withdrawn the template from this namespace
+			if err := checkClusterTemplateNamespace(ctx, c, result.ClusterTemplate.Value, service.Namespace); err != nil {
+				logger.V(1).Info("resolved cluster template no longer available in namespace, re-resolving", "name", ref.Name)
+				return TemplateFetchResult{}, true
+			}
 			logger.V(1).Info("using resolved cluster template", "name", ref.Name)
 			return result, false
 		}
@@ -203,6 +336,146 @@ func tryFetchResolvedTemplate(
 	return TemplateFetchResult{}, true
 }
 
+// checkClusterTemplateNamespace returns an InvalidSpec error if the namespaceSelector of the
+// cluster template excludes the namespace.
+func checkClusterTemplateNamespace(
+	ctx context.Context,
+	c client.Client,
+	template *aimv1alpha1.AIMClusterServiceTemplate,
+	namespace string,
+) error {
+	if template.Spec.NamespaceSelector == nil {
+		return nil
+	}
+	namespaceLabels, err := fetchNamespaceLabels(ctx, c, namespace)
+	if err != nil {
+		return err
+	}
+	if clusterTemplateAllowsNamespace(template, namespaceLabels) {
+		return nil
+	}
+	return controllerutils.NewInvalidSpecError(
+		aimv1alpha1.AIMServiceReasonTemplateNamespaceDenied,
+		fmt.Sprintf("cluster template %q is not available in namespace %q", template.Name, namespace),
+		nil,
+	)
+}
+
+// explicitTemplateLookupName returns the namespace-scoped template name to look up for a
+// service with an explicit template name: the derived name under the Derive policy, or
+// the named template itself otherwise.
+func explicitTemplateLookupName(service *aimv1alpha1.AIMService) string {
+	templateName := strings.TrimSpace(service.Spec.Template.Name)
+	if service.Spec.GetOverridesBehavior() != aimv1alpha1.OverridesBehaviorDerive {
+		return templateName
+	}
+	return generateDerivedTemplateName(templateName, service.Spec.Overrides)
+}
+
+// pendingDerivedTemplate returns the name of the derived template the service waits for under
+// the Derive policy while its namespace-scoped base template is resolved in its place.
+// Returns false once the derived template is resolved, or when no derived template applies.
+func (obs ServiceObservation) pendingDerivedTemplate() (string, bool) {
+	service := obs.service
+	if service.Spec.Overrides == nil || service.Spec.Template.Name == "" || service.GetForcedTemplate() != "" {
+		return "", false
+	}
+	if obs.template.Value == nil || obs.template.Value.Name == "" {
+		return "", false
+	}
+	derivedName := explicitTemplateLookupName(service)
+	if derivedName == strings.TrimSpace(service.Spec.Template.Name) || obs.template.Value.Name == derivedName {
+		return "", false
+	}
+	return derivedName, true
+}
+
+// applyOverridesInPlace returns copies of the template spec and status with the service
+// overrides applied, for use when building the InferenceService under the ApplyInPlace
+// policy. The originals are returned unchanged when the policy does not apply.
+func applyOverridesInPlace(
+	service *aimv1alpha1.AIMService,
+	spec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+	status *aimv1alpha1.AIMServiceTemplateStatus,
+) (*aimv1alpha1.AIMServiceTemplateSpecCommon, *aimv1alpha1.AIMServiceTemplateStatus) {
+	overrides := service.Spec.Overrides
+	if overrides == nil || service.Spec.Template.Name == "" || service.GetForcedTemplate() != "" ||
+		service.Spec.GetOverridesBehavior() != aimv1alpha1.OverridesBehaviorApplyInPlace {
+		return spec, status
+	}
+
+	if spec != nil {
+		spec = spec.DeepCopy()
+		if overrides.Metric != nil {
+			spec.Metric = ptr.To(*overrides.Metric)
+		}
+		if overrides.Precision != nil {
+			spec.Precision = ptr.To(*overrides.Precision)
+		}
+		if overrides.Hardware != nil {
+			spec.Hardware = overrides.Hardware.DeepCopy()
+		}
+	}
+
+	if status != nil && overrides.Hardware != nil && overrides.Hardware.GPU != nil {
+		status = status.DeepCopy()
+		if status.ResolvedHardware == nil {
+			status.ResolvedHardware = &aimv1alpha1.AIMHardwareRequirements{}
+		}
+		gpu := status.ResolvedHardware.GPU
+		if gpu == nil {
+			gpu = &aimv1alpha1.AIMGpuRequirements{}
+		}
+		if overrides.Hardware.GPU.Requests > 0 {
+			gpu.Requests = overrides.Hardware.GPU.Requests
+		}
+		if overrides.Hardware.GPU.ResourceName != "" {
+			gpu.ResourceName = overrides.Hardware.GPU.ResourceName
+		}
+		status.ResolvedHardware.GPU = gpu
+	}
+
+	return spec, status
+}
+
+// buildOverridesStatus describes how overrides were combined with an explicit template.
+// Returns nil when the service has no overrides or no explicit template name.
+func buildOverridesStatus(service *aimv1alpha1.AIMService, resolvedTemplateName string) *aimv1alpha1.AIMServiceOverridesStatus {
+	if service.Spec.Overrides == nil || service.Spec.Template.Name == "" || service.GetForcedTemplate() != "" {
+		return nil
+	}
+
+	baseName := strings.TrimSpace(service.Spec.Template.Name)
+	behavior := service.Spec.GetOverridesBehavior()
+	result := &aimv1alpha1.AIMServiceOverridesStatus{
+		Behavior:     behavior,
+		BaseTemplate: baseName,
+	}
+
+	switch behavior {
+	case aimv1alpha1.OverridesBehaviorReject:
+		result.Message = "Overrides are rejected when a template name is specified"
+	case aimv1alpha1.OverridesBehaviorApplyInPlace:
+		result.Message = "Overrides are applied to the InferenceService of template " + baseName
+	default:
+		derivedName := generateDerivedTemplateName(baseName, service.Spec.Overrides)
+		switch {
+		case derivedName == baseName:
+			result.Message = "Overrides match the base template; no derived template is needed"
+		case resolvedTemplateName == derivedName:
+			result.DerivedTemplate = derivedName
+			result.Message = "Using derived template " + derivedName + " with the overrides applied to " + baseName
+		case resolvedTemplateName == baseName:
+			result.DerivedTemplate = derivedName
+			result.Message = "Derived template " + derivedName + " is being created from " + baseName
+		default:
+			result.DerivedTemplate = derivedName
+			result.Message = "Waiting for derived template " + derivedName
+		}
+	}
+	return result
+}
+
 // planDerivedTemplate creates a derived template if the service has overrides.
 func planDerivedTemplate(
 	service *aimv1alpha1.AIMService,
@@ -210,8 +483,13 @@ func planDerivedTemplate(
 	templateSpec *aimv1alpha1.AIMServiceTemplateSpec,
 	obs ServiceObservation,
 ) client.Object {
-	// Only create derived template if service has overrides
-	if service.Spec.Overrides == nil {
+	// Only create derived template if service has overrides, and never for a forced template
+	if service.Spec.Overrides == nil || service.GetForcedTemplate() != "" {
+		return nil
+	}
+
+	// Explicit templates only derive under the Derive policy
+	if service.Spec.Template.Name != "" && service.Spec.GetOverridesBehavior() != aimv1alpha1.OverridesBehaviorDerive {
 		return nil
 	}
 
@@ -219,7 +497,7 @@ func planDerivedTemplate(
 	if obs.template.Value != nil {
 		// Template already exists, check if it's our derived template
 		if val, ok := obs.template.Value.Labels[constants.LabelKeyOrigin]; ok && val == constants.LabelValueOriginDerived {
-			return nil // Already exists
+			return planRegeneratedTemplate(service, obs)
 		}
 	}
 
@@ -234,7 +512,48 @@ func planDerivedTemplate(
 	// Calculate the derived template name
 	derivedName := generateDerivedTemplateName(templateName, service.Spec.Overrides)
 
-	return buildDerivedTemplate(service, derivedName, modelName, templateSpec)
+	template := buildDerivedTemplate(service, derivedName, modelName, templateSpec)
+	setBaseTemplateAnnotations(template, templateName, templateSpec)
+	return template
+}
+
+// planRegeneratedTemplate rebuilds an existing derived template from its base when the base
+// was edited after derivation and the service opted into the Regenerate policy.
+// Returns nil when the derived template should be left as is.
+func planRegeneratedTemplate(service *aimv1alpha1.AIMService, obs ServiceObservation) client.Object {
+	if service.Spec.GetBaseChangePolicy() != aimv1alpha1.BaseTemplateChangePolicyRegenerate {
+		return nil
+	}
+
+	derived := obs.template.Value
+	if !aimservicetemplate.IsDerivedTemplate(derived) || !obs.baseTemplate.OK() || obs.baseTemplate.Value == nil {
+		return nil
+	}
+	base := obs.baseTemplate.Value
+	if !aimservicetemplate.HasBaseTemplateChanged(derived, base) {
+		return nil
+	}
+
+	template := buildDerivedTemplate(service, derived.Name, derived.Spec.ModelName, &base.Spec)
+
+	// Model name and compute mode are immutable, such changes require a new derivation
+	if template.Spec.ModelName != derived.Spec.ModelName || template.Spec.GetCompute() != derived.Spec.GetCompute() {
+		return nil
+	}
+
+	setBaseTemplateAnnotations(template, base.Name, &base.Spec)
+	return template
+}
+
+// setBaseTemplateAnnotations records the base template and the hash of its spec on a derived template.
+func setBaseTemplateAnnotations(template *aimv1alpha1.AIMServiceTemplate, baseName string, baseSpec *aimv1alpha1.AIMServiceTemplateSpec) {
+	if baseSpec == nil {
+		return
+	}
+	template.Annotations = map[string]string{
+		constants.AnnotationBaseTemplate:     baseName,
+		constants.AnnotationBaseTemplateHash: aimservicetemplate.ComputeBaseTemplateHash(*baseSpec),
+	}
 }
 
 // buildDerivedTemplate constructs an AIMServiceTemplate for a service with overrides.
@@ -402,3 +721,25 @@ func normalizeRuntimeConfigName(name string) string {
 	}
 	return name
 }
+
+// setBaseTemplateChangedCondition mirrors the BaseTemplateChanged condition of the resolved derived
+// template onto the service, so that drift of the base is visible where the template is consumed.
+// The condition is removed when the service does not use a derived template.
+func setBaseTemplateChangedCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	if !obs.template.OK() || !aimservicetemplate.IsDerivedTemplate(obs.template.Value) {
+		cm.Delete(aimv1alpha1.AIMServiceConditionBaseTemplateChanged)
+		return
+	}
+
+	cond := meta.FindStatusCondition(obs.template.Value.Status.Conditions, aimv1alpha1.AIMTemplateConditionBaseTemplateChanged)
+	if cond == nil {
+		return
+	}
+
+	var opts []controllerutils.ObservabilityOption
+	if cond.Status == metav1.ConditionTrue {
+		opts = append(opts, controllerutils.AsWarning())
+	}
+	cm.Set(aimv1alpha1.AIMServiceConditionBaseTemplateChanged, cond.Status, cond.Reason,
+		fmt.Sprintf("Template %q: %s", obs.template.Value.Name, cond.Message), opts...)
+}
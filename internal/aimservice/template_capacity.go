@@ -0,0 +1,158 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
+)
+
+// templateCapacityRetryInterval is how often a service waiting for a template at capacity retries.
+const templateCapacityRetryInterval = time.Minute
+
+// isBoundToTemplate reports whether the service already uses the template. Bound services
+// keep their template when it reaches capacity.
+func isBoundToTemplate(service *aimv1alpha1.AIMService, name, namespace string, scope aimv1alpha1.AIMResolutionScope) bool {
+	ref := service.Status.ResolvedTemplate
+	if ref == nil || ref.Name != name || ref.Scope != scope {
+		return false
+	}
+	return scope == aimv1alpha1.AIMResolutionScopeCluster || ref.Namespace == namespace
+}
+
+// countTemplateConsumers counts the services other than the given one that use the template.
+func countTemplateConsumers(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+	name, namespace string,
+	scope aimv1alpha1.AIMResolutionScope,
+) (int32, error) {
+	var result controllerutils.FetchResult[*aimv1alpha1.AIMServiceList]
+	if scope == aimv1alpha1.AIMResolutionScopeCluster {
+		result = controllerutils.FetchClusterScopedConsumers(ctx, c,
+			aimv1alpha1.AIMServiceResolvedTemplateIndexKey, name, controllerutils.ResolvedTemplateRef)
+	} else {
+		result = controllerutils.FetchList(ctx, c, &aimv1alpha1.AIMServiceList{},
+			client.InNamespace(namespace), client.MatchingFields{aimv1alpha1.AIMServiceResolvedTemplateIndexKey: name})
+	}
+	if !result.OK() {
+		return 0, fmt.Errorf("failed to count services using template %q: %w", name, result.Error)
+	}
+
+	var count int32
+	for i := range result.Value.Items {
+		other := &result.Value.Items[i]
+		if other.Namespace == service.Namespace && other.Name == service.Name {
+			continue
+		}
+		if isBoundToTemplate(other, name, namespace, scope) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// templateAtCapacity reports whether binding the service to the template would exceed the
+// template's maxConcurrentServices, and returns the number of services already using it.
+func templateAtCapacity(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+	name, namespace string,
+	scope aimv1alpha1.AIMResolutionScope,
+	spec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+) (bool, int32, error) {
+	if spec.MaxConcurrentServices == nil || isBoundToTemplate(service, name, namespace, scope) {
+		return false, 0, nil
+	}
+	count, err := countTemplateConsumers(ctx, c, service, name, namespace, scope)
+	if err != nil {
+		return false, 0, err
+	}
+	return count >= *spec.MaxConcurrentServices, count, nil
+}
+
+// markCandidatesAtCapacity flags the candidates that cannot take another service.
+func markCandidatesAtCapacity(ctx context.Context, c client.Client, service *aimv1alpha1.AIMService, candidates []selection.Candidate) error {
+	for i := range candidates {
+		candidate := &candidates[i]
+		atCapacity, count, err := templateAtCapacity(ctx, c, service,
+			candidate.Name, candidate.Namespace, candidate.Scope, &candidate.Spec)
+		if err != nil {
+			return err
+		}
+		if atCapacity {
+			candidate.AtCapacity = true
+			candidate.Explain(fmt.Sprintf("%d of %d services", count, *candidate.Spec.MaxConcurrentServices))
+		}
+	}
+	return nil
+}
+
+// checkTemplateCapacity returns a selection result reporting TemplateAtCapacity when the
+// resolved template cannot take the service, or a result with the error when the services
+// using it cannot be counted. It returns nil when the service may use the template.
+func checkTemplateCapacity(
+	ctx context.Context,
+	c client.Client,
+	service *aimv1alpha1.AIMService,
+	template controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate],
+	clusterTemplate controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate],
+) *TemplateSelectionResult {
+	var (
+		kind, name, namespace string
+		scope                 aimv1alpha1.AIMResolutionScope
+		spec                  *aimv1alpha1.AIMServiceTemplateSpecCommon
+	)
+	switch {
+	case template.OK() && template.Value != nil && template.Value.Name != "":
+		kind, name, namespace = "AIMServiceTemplate", template.Value.Name, template.Value.Namespace
+		scope, spec = aimv1alpha1.AIMResolutionScopeNamespace, &template.Value.Spec.AIMServiceTemplateSpecCommon
+	case clusterTemplate.OK() && clusterTemplate.Value != nil && clusterTemplate.Value.Name != "":
+		kind, name = "AIMClusterServiceTemplate", clusterTemplate.Value.Name
+		scope, spec = aimv1alpha1.AIMResolutionScopeCluster, &clusterTemplate.Value.Spec.AIMServiceTemplateSpecCommon
+	default:
+		return nil
+	}
+
+	atCapacity, count, err := templateAtCapacity(ctx, c, service, name, namespace, scope, spec)
+	if err != nil {
+		return &TemplateSelectionResult{Error: err}
+	}
+	if !atCapacity {
+		return nil
+	}
+	return &TemplateSelectionResult{
+		SelectionReason: aimv1alpha1.AIMServiceReasonTemplateAtCapacity,
+		SelectionMessage: fmt.Sprintf("%s %s is at capacity: %d of %d services already use it",
+			kind, name, count, *spec.MaxConcurrentServices),
+	}
+}
@@ -0,0 +1,179 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// newIndexedFakeClient creates a fake client with the resolved template index used to count consumers.
+func newIndexedFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = aimv1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithIndex(&aimv1alpha1.AIMService{}, aimv1alpha1.AIMServiceResolvedTemplateIndexKey, func(obj client.Object) []string {
+			ref := obj.(*aimv1alpha1.AIMService).Status.ResolvedTemplate
+			if ref == nil {
+				return nil
+			}
+			return []string{ref.Name}
+		}).
+		Build()
+}
+
+// boundService returns a service that resolved the given template.
+func boundService(name, namespace, template string, scope aimv1alpha1.AIMResolutionScope) *aimv1alpha1.AIMService {
+	service := NewService(name).WithNamespace(namespace).Build()
+	service.Status.ResolvedTemplate = &aimv1alpha1.AIMResolvedReference{Name: template, Scope: scope}
+	if scope == aimv1alpha1.AIMResolutionScopeNamespace {
+		service.Status.ResolvedTemplate.Namespace = namespace
+	}
+	return service
+}
+
+func TestCheckTemplateCapacity(t *testing.T) {
+	ctx := testContext()
+	template := NewTemplate("tmpl").Build()
+	template.Spec.MaxConcurrentServices = ptr.To(int32(1))
+	clusterTemplate := NewClusterTemplate("shared").Build()
+	clusterTemplate.Spec.MaxConcurrentServices = ptr.To(int32(2))
+	namespaced := controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: template}
+	cluster := controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{Value: clusterTemplate}
+	noTemplate := controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{}
+	noClusterTemplate := controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{}
+
+	t.Run("refuses a new service when full", func(t *testing.T) {
+		existing := boundService("existing", testNamespace, "tmpl", aimv1alpha1.AIMResolutionScopeNamespace)
+		c := newIndexedFakeClient(existing)
+		service := NewService("new").Build()
+
+		result := checkTemplateCapacity(ctx, c, service, namespaced, noClusterTemplate)
+		if result == nil || result.SelectionReason != aimv1alpha1.AIMServiceReasonTemplateAtCapacity {
+			t.Fatalf("expected TemplateAtCapacity, got %+v", result)
+		}
+		if result.SelectionMessage != "AIMServiceTemplate tmpl is at capacity: 1 of 1 services already use it" {
+			t.Errorf("unexpected message %q", result.SelectionMessage)
+		}
+	})
+
+	t.Run("keeps a service that already uses the template", func(t *testing.T) {
+		existing := boundService("existing", testNamespace, "tmpl", aimv1alpha1.AIMResolutionScopeNamespace)
+		other := boundService("other", testNamespace, "tmpl", aimv1alpha1.AIMResolutionScopeNamespace)
+		c := newIndexedFakeClient(existing, other)
+
+		if result := checkTemplateCapacity(ctx, c, existing, namespaced, noClusterTemplate); result != nil {
+			t.Fatalf("expected bound service to keep the template, got %+v", result)
+		}
+	})
+
+	t.Run("ignores services of a same-named template elsewhere", func(t *testing.T) {
+		elsewhere := boundService("elsewhere", "other-ns", "tmpl", aimv1alpha1.AIMResolutionScopeNamespace)
+		clusterScoped := boundService("cluster", testNamespace, "tmpl", aimv1alpha1.AIMResolutionScopeCluster)
+		c := newIndexedFakeClient(elsewhere, clusterScoped)
+
+		if result := checkTemplateCapacity(ctx, c, NewService("new").Build(), namespaced, noClusterTemplate); result != nil {
+			t.Fatalf("expected capacity to be available, got %+v", result)
+		}
+	})
+
+	t.Run("counts cluster template services across namespaces", func(t *testing.T) {
+		a := boundService("a", "ns-a", "shared", aimv1alpha1.AIMResolutionScopeCluster)
+		b := boundService("b", "ns-b", "shared", aimv1alpha1.AIMResolutionScopeCluster)
+		c := newIndexedFakeClient(a, b)
+
+		result := checkTemplateCapacity(ctx, c, NewService("new").Build(), noTemplate, cluster)
+		if result == nil || result.SelectionReason != aimv1alpha1.AIMServiceReasonTemplateAtCapacity {
+			t.Fatalf("expected TemplateAtCapacity, got %+v", result)
+		}
+	})
+
+	t.Run("unlimited without maxConcurrentServices", func(t *testing.T) {
+		unlimited := NewTemplate("tmpl").Build()
+		existing := boundService("existing", testNamespace, "tmpl", aimv1alpha1.AIMResolutionScopeNamespace)
+		// The plain fake client has no index, so any list would fail
+		c := newFakeClient(existing)
+
+		result := checkTemplateCapacity(ctx, c, NewService("new").Build(),
+			controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: unlimited}, noClusterTemplate)
+		if result != nil {
+			t.Fatalf("expected no limit, got %+v", result)
+		}
+	})
+}
+
+func TestSelectTemplateForModel_SkipsTemplatesAtCapacity(t *testing.T) {
+	ctx := testContext()
+	node := NewNode("gpu-node").WithGPUProductID("0x74a1").Build() // MI300X
+	limited := NewClusterTemplate("limited").WithModelName(testModelName).WithGPU("MI300X", 1).Build()
+	limited.Spec.MaxConcurrentServices = ptr.To(int32(1))
+	existing := boundService("existing", "other-ns", "limited", aimv1alpha1.AIMResolutionScopeCluster)
+	service := NewService("svc").WithModelName(testModelName).Build()
+
+	t.Run("selects another template", func(t *testing.T) {
+		fallback := NewClusterTemplate("fallback").WithModelName(testModelName).WithGPU("MI300X", 1).
+			WithMetric(aimv1alpha1.AIMMetricThroughput).Build()
+		c := newIndexedFakeClient(node, limited, fallback, existing)
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, nil)
+		if result.SelectedClusterTemplate == nil || result.SelectedClusterTemplate.Name != "fallback" {
+			t.Fatalf("expected template fallback, got %+v", result.SelectedClusterTemplate)
+		}
+		for _, r := range result.MatchingResults {
+			if r.Name == "limited" && (r.Reason != aimv1alpha1.AIMServiceReasonTemplateAtCapacity || r.Message != "1 of 1 services") {
+				t.Errorf("unexpected evaluation for limited: %+v", r)
+			}
+		}
+	})
+
+	t.Run("pending when every template is full", func(t *testing.T) {
+		c := newIndexedFakeClient(node, limited, existing)
+
+		result := selectTemplateForModel(ctx, c, service, testModelName, nil)
+		if result.SelectedClusterTemplate != nil {
+			t.Fatalf("expected no template, got %s", result.SelectedClusterTemplate.Name)
+		}
+		if result.SelectionReason != aimv1alpha1.AIMServiceReasonTemplateAtCapacity {
+			t.Fatalf("expected reason %s, got %s", aimv1alpha1.AIMServiceReasonTemplateAtCapacity, result.SelectionReason)
+		}
+
+		obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{service: service, templateSelection: result}}
+		health := obs.getTemplateHealth()
+		if health.State != constants.AIMStatusPending || health.Reason != aimv1alpha1.AIMServiceReasonTemplateAtCapacity {
+			t.Errorf("expected Pending TemplateAtCapacity, got %s %s", health.State, health.Reason)
+		}
+	})
+}
@@ -26,11 +26,16 @@ import (
 	"strings"
 	"testing"
 
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservicetemplate"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
 )
 
 // ============================================================================
@@ -565,3 +570,399 @@ func TestNormalizeRuntimeConfigName(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================================
+// OVERRIDES BEHAVIOR TESTS
+// ============================================================================
+
+func TestFetchTemplate_OverridesBehavior(t *testing.T) {
+	base := NewTemplate("base").Build()
+	derivedName := generateDerivedTemplateName("base", &aimv1alpha1.AIMServiceOverrides{
+		AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
+			Hardware: &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{Model: "MI300X", Requests: 2}},
+		},
+	})
+	derived := NewTemplate(derivedName).WithStatus(constants.AIMStatusPending).Build()
+
+	tests := []struct {
+		name         string
+		behavior     aimv1alpha1.AIMOverridesBehavior
+		objects      []client.Object
+		wantTemplate string
+		wantReason   string
+	}{
+		{
+			name:         "derive falls back to base while derived is missing",
+			objects:      []client.Object{base},
+			wantTemplate: "base",
+		},
+		{
+			name:         "derive uses derived template once it exists",
+			objects:      []client.Object{base, derived},
+			wantTemplate: derivedName,
+		},
+		{
+			name:         "apply in place uses the named template",
+			behavior:     aimv1alpha1.OverridesBehaviorApplyInPlace,
+			objects:      []client.Object{base, derived},
+			wantTemplate: "base",
+		},
+		{
+			name:       "reject reports invalid spec",
+			behavior:   aimv1alpha1.OverridesBehaviorReject,
+			objects:    []client.Object{base},
+			wantReason: aimv1alpha1.AIMServiceReasonOverridesRejected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService("svc").WithTemplateName("base").WithOverrideGPU("MI300X", 2).Build()
+			service.Spec.Template.OverridesBehavior = tt.behavior
+
+			templateResult, _, _ := fetchTemplate(testContext(), newFakeClient(tt.objects...), service,
+				controllerutils.FetchResult[*aimv1alpha1.AIMModel]{}, controllerutils.FetchResult[*aimv1alpha1.AIMClusterModel]{}, nil)
+
+			if tt.wantReason != "" {
+				if templateResult.Error == nil {
+					t.Fatal("expected an error")
+				}
+				if reason := controllerutils.CategorizeError(templateResult.Error).Reason(); reason != tt.wantReason {
+					t.Errorf("reason = %s, want %s", reason, tt.wantReason)
+				}
+				return
+			}
+			if !templateResult.OK() {
+				t.Fatalf("unexpected error: %v", templateResult.Error)
+			}
+			if templateResult.Value.Name != tt.wantTemplate {
+				t.Errorf("template = %s, want %s", templateResult.Value.Name, tt.wantTemplate)
+			}
+		})
+	}
+}
+
+func TestFetchTemplate_ForcedTemplate(t *testing.T) {
+	model := controllerutils.FetchResult[*aimv1alpha1.AIMModel]{Value: NewModel(testModelName).Build()}
+	forced := NewTemplate("forced").WithModelName(testModelName).WithStatus(constants.AIMStatusPending).Build()
+	otherModel := NewTemplate("other-model").WithModelName("other").Build()
+	clusterForced := NewClusterTemplate("cluster-forced").WithModelName(testModelName).Build()
+	named := NewTemplate("named").WithModelName(testModelName).Build()
+
+	tests := []struct {
+		name         string
+		forced       string
+		objects      []client.Object
+		wantTemplate string
+		wantCluster  string
+		wantReason   string
+	}{
+		{
+			name:         "namespace template wins over spec.template.name and is not required to be ready",
+			forced:       "forced",
+			objects:      []client.Object{forced, named},
+			wantTemplate: "forced",
+		},
+		{
+			name:        "falls back to cluster template",
+			forced:      "cluster-forced",
+			objects:     []client.Object{clusterForced, named},
+			wantCluster: "cluster-forced",
+		},
+		{
+			name:       "missing template",
+			forced:     "missing",
+			objects:    []client.Object{named},
+			wantReason: aimv1alpha1.AIMServiceReasonTemplateNotFound,
+		},
+		{
+			name:       "template for another model",
+			forced:     "other-model",
+			objects:    []client.Object{otherModel, named},
+			wantReason: aimv1alpha1.AIMServiceReasonForcedTemplateMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService("svc").WithModelName(testModelName).WithTemplateName("named").WithOverrideGPU("MI300X", 2).Build()
+			service.Annotations = map[string]string{constants.AnnotationForceTemplate: tt.forced}
+			if service.GetTemplateSelectionMode() != aimv1alpha1.TemplateSelectionModeForced {
+				t.Fatalf("selection mode = %s, want Forced", service.GetTemplateSelectionMode())
+			}
+
+			templateResult, clusterTemplateResult, _ := fetchTemplate(testContext(), newFakeClient(tt.objects...), service,
+				model, controllerutils.FetchResult[*aimv1alpha1.AIMClusterModel]{}, nil)
+
+			switch {
+			case tt.wantReason != "":
+				if templateResult.Error == nil {
+					t.Fatal("expected an error")
+				}
+				if reason := controllerutils.CategorizeError(templateResult.Error).Reason(); reason != tt.wantReason {
+					t.Errorf("reason = %s, want %s", reason, tt.wantReason)
+				}
+				if clusterTemplateResult.Value != nil {
+					t.Errorf("expected no cluster template, got %s", clusterTemplateResult.Value.Name)
+				}
+			case tt.wantCluster != "":
+				if templateResult.Error != nil || !clusterTemplateResult.OK() || clusterTemplateResult.Value.Name != tt.wantCluster {
+					t.Errorf("expected cluster template %s, got %+v / %+v", tt.wantCluster, templateResult, clusterTemplateResult)
+				}
+			default:
+				if !templateResult.OK() || templateResult.Value.Name != tt.wantTemplate {
+					t.Errorf("expected template %s, got %+v", tt.wantTemplate, templateResult)
+				}
+			}
+		})
+	}
+}
+
+func TestForcedTemplate_BypassesOverrides(t *testing.T) {
+	base := NewTemplate("base").Build()
+	service := NewService("svc").WithTemplateName("base").WithOverrideGPU("MI300X", 2).Build()
+	service.Annotations = map[string]string{constants.AnnotationForceTemplate: "base"}
+	obs := ServiceObservation{}
+	obs.template.Value = base
+
+	if planDerivedTemplate(service, "base", &base.Spec, obs) != nil {
+		t.Error("expected no derived template for a forced template")
+	}
+	if buildOverridesStatus(service, "base") != nil {
+		t.Error("expected no overrides status for a forced template")
+	}
+	service.Spec.Template.OverridesBehavior = aimv1alpha1.OverridesBehaviorApplyInPlace
+	if spec, _ := applyOverridesInPlace(service, &base.Spec.AIMServiceTemplateSpecCommon, nil); spec != &base.Spec.AIMServiceTemplateSpecCommon {
+		t.Error("expected overrides not to be applied in place to a forced template")
+	}
+}
+
+func TestPlanDerivedTemplate_SkippedForApplyInPlace(t *testing.T) {
+	base := NewTemplate("base").Build()
+	service := NewService("svc").WithTemplateName("base").WithOverrideGPU("MI300X", 2).Build()
+	obs := ServiceObservation{}
+	obs.template.Value = base
+
+	if planDerivedTemplate(service, "base", &base.Spec, obs) == nil {
+		t.Fatal("expected a derived template under the default Derive policy")
+	}
+
+	service.Spec.Template.OverridesBehavior = aimv1alpha1.OverridesBehaviorApplyInPlace
+	if planDerivedTemplate(service, "base", &base.Spec, obs) != nil {
+		t.Error("expected no derived template under ApplyInPlace")
+	}
+}
+
+func TestPlanResources_DerivedTemplatePending(t *testing.T) {
+	fetch := refreshFetch(aimv1alpha1.CacheUpdatePolicyIgnore, "rev")
+	fetch.service.Spec.Overrides = NewService("svc").WithOverrideGPU("MI300X", 2).Build().Spec.Overrides
+	derivedName := generateDerivedTemplateName("tmpl", fetch.service.Spec.Overrides)
+	r := &ServiceReconciler{}
+
+	plan := func() ([]client.Object, bool) {
+		obs := ServiceObservation{ServiceFetchResult: fetch}
+		var templates []client.Object
+		isvc := false
+		result := r.PlanResources(testContext(), controllerutils.ReconcileContext[*aimv1alpha1.AIMService]{}, obs)
+		for _, obj := range result.GetToApply() {
+			switch obj.(type) {
+			case *aimv1alpha1.AIMServiceTemplate:
+				templates = append(templates, obj)
+			case *servingv1beta1.InferenceService:
+				isvc = true
+			}
+		}
+		return templates, isvc
+	}
+
+	// Only the derived template is planned while the base stands in for it
+	templates, isvc := plan()
+	if len(templates) != 1 || templates[0].GetName() != derivedName {
+		t.Fatalf("expected derived template %s to be planned, got %v", derivedName, templates)
+	}
+	if isvc {
+		t.Error("expected no InferenceService to be planned from the base template")
+	}
+	health := ServiceObservation{ServiceFetchResult: fetch}.getTemplateHealth()
+	if health.State != constants.AIMStatusProgressing || health.Reason != aimv1alpha1.AIMServiceReasonDerivedTemplatePending ||
+		health.WaitingOn == nil || health.WaitingOn.Name != derivedName {
+		t.Errorf("expected a DerivedTemplatePending health waiting on %s, got %+v", derivedName, health)
+	}
+
+	// The InferenceService is planned once the derived template is Ready
+	derived := templates[0].(*aimv1alpha1.AIMServiceTemplate)
+	derived.Status = fetch.template.Value.Status
+	derived.Status.Status = constants.AIMStatusReady
+	fetch.template.Value = derived
+	if _, isvc := plan(); !isvc {
+		t.Error("expected an InferenceService once the derived template is Ready")
+	}
+	if health := (ServiceObservation{ServiceFetchResult: fetch}).getTemplateHealth(); health.Reason != aimv1alpha1.AIMServiceReasonResolved {
+		t.Errorf("expected the derived template to be resolved, got %+v", health)
+	}
+}
+
+func TestApplyOverridesInPlace(t *testing.T) {
+	spec := &aimv1alpha1.AIMServiceTemplateSpecCommon{}
+	status := &aimv1alpha1.AIMServiceTemplateStatus{
+		ResolvedHardware: &aimv1alpha1.AIMHardwareRequirements{
+			GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 1, ResourceName: "amd.com/gpu"},
+		},
+	}
+
+	service := NewService("svc").WithTemplateName("base").WithOverrideGPU("MI300X", 4).
+		WithOverrideMetric(aimv1alpha1.AIMMetricThroughput).Build()
+
+	// Derive (default) leaves the template untouched
+	gotSpec, gotStatus := applyOverridesInPlace(service, spec, status)
+	if gotSpec != spec || gotStatus != status {
+		t.Fatal("expected originals to be returned under the Derive policy")
+	}
+
+	service.Spec.Template.OverridesBehavior = aimv1alpha1.OverridesBehaviorApplyInPlace
+	gotSpec, gotStatus = applyOverridesInPlace(service, spec, status)
+	if gotSpec.Metric == nil || *gotSpec.Metric != aimv1alpha1.AIMMetricThroughput {
+		t.Errorf("expected metric override, got %v", gotSpec.Metric)
+	}
+	if gotStatus.ResolvedHardware.GPU.Requests != 4 {
+		t.Errorf("expected 4 GPUs, got %d", gotStatus.ResolvedHardware.GPU.Requests)
+	}
+	if gotStatus.ResolvedHardware.GPU.ResourceName != "amd.com/gpu" {
+		t.Errorf("expected resource name to be preserved, got %s", gotStatus.ResolvedHardware.GPU.ResourceName)
+	}
+	if spec.Metric != nil || status.ResolvedHardware.GPU.Requests != 1 {
+		t.Error("expected the original template to be unmodified")
+	}
+}
+
+func TestBuildOverridesStatus(t *testing.T) {
+	service := NewService("svc").WithTemplateName("base").WithOverrideGPU("MI300X", 2).Build()
+	derivedName := generateDerivedTemplateName("base", service.Spec.Overrides)
+
+	if got := buildOverridesStatus(NewService("svc").WithTemplateName("base").Build(), "base"); got != nil {
+		t.Errorf("expected nil without overrides, got %+v", got)
+	}
+
+	pending := buildOverridesStatus(service, "base")
+	if pending.Behavior != aimv1alpha1.OverridesBehaviorDerive || pending.DerivedTemplate != derivedName ||
+		!strings.Contains(pending.Message, "being created") {
+		t.Errorf("unexpected pending status: %+v", pending)
+	}
+
+	active := buildOverridesStatus(service, derivedName)
+	if active.DerivedTemplate != derivedName || !strings.Contains(active.Message, "Using derived template") {
+		t.Errorf("unexpected active status: %+v", active)
+	}
+
+	service.Spec.Template.OverridesBehavior = aimv1alpha1.OverridesBehaviorApplyInPlace
+	inPlace := buildOverridesStatus(service, "base")
+	if inPlace.DerivedTemplate != "" || inPlace.Behavior != aimv1alpha1.OverridesBehaviorApplyInPlace {
+		t.Errorf("unexpected in-place status: %+v", inPlace)
+	}
+}
+
+func TestPlanDerivedTemplate_RecordsBaseTemplate(t *testing.T) {
+	base := NewTemplate("base").WithModelName("model-a").Build()
+	service := NewService("svc").WithTemplateName("base").WithOverrideGPU("MI300X", 2).Build()
+	obs := ServiceObservation{}
+	obs.template.Value = base
+
+	derived, ok := planDerivedTemplate(service, "base", &base.Spec, obs).(*aimv1alpha1.AIMServiceTemplate)
+	if !ok {
+		t.Fatal("expected a derived template")
+	}
+	if derived.Annotations[constants.AnnotationBaseTemplate] != "base" {
+		t.Errorf("base annotation = %q, want base", derived.Annotations[constants.AnnotationBaseTemplate])
+	}
+	if derived.Annotations[constants.AnnotationBaseTemplateHash] != aimservicetemplate.ComputeBaseTemplateHash(base.Spec) {
+		t.Error("expected base hash annotation to match the base spec")
+	}
+}
+
+func TestPlanDerivedTemplate_RegeneratesOnBaseChange(t *testing.T) {
+	base := NewTemplate("base").WithModelName("model-a").Build()
+	service := NewService("svc").WithTemplateName("base").WithOverrideGPU("MI300X", 2).Build()
+
+	derivedName := generateDerivedTemplateName("base", service.Spec.Overrides)
+	derived := buildDerivedTemplate(service, derivedName, "model-a", &base.Spec)
+	setBaseTemplateAnnotations(derived, "base", &base.Spec)
+
+	edited := base.DeepCopy()
+	edited.Spec.Env = []corev1.EnvVar{{Name: "VLLM_FLAG", Value: "1"}}
+
+	renamed := edited.DeepCopy()
+	renamed.Spec.ModelName = "model-b"
+
+	tests := []struct {
+		name   string
+		policy aimv1alpha1.AIMBaseTemplateChangePolicy
+		base   *aimv1alpha1.AIMServiceTemplate
+		want   bool
+	}{
+		{name: "alert policy leaves template alone", policy: aimv1alpha1.BaseTemplateChangePolicyAlert, base: edited},
+		{name: "unchanged base", policy: aimv1alpha1.BaseTemplateChangePolicyRegenerate, base: base},
+		{name: "changed base is regenerated", policy: aimv1alpha1.BaseTemplateChangePolicyRegenerate, base: edited, want: true},
+		{name: "immutable field change is skipped", policy: aimv1alpha1.BaseTemplateChangePolicyRegenerate, base: renamed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service.Spec.Template.BaseChangePolicy = tt.policy
+			obs := ServiceObservation{}
+			obs.template.Value = derived
+			obs.baseTemplate.Value = tt.base
+
+			result := planDerivedTemplate(service, derivedName, &derived.Spec, obs)
+			if !tt.want {
+				if result != nil {
+					t.Fatalf("expected no regeneration, got %v", result)
+				}
+				return
+			}
+
+			regenerated, ok := result.(*aimv1alpha1.AIMServiceTemplate)
+			if !ok {
+				t.Fatal("expected a regenerated template")
+			}
+			if regenerated.Name != derivedName {
+				t.Errorf("name = %s, want %s", regenerated.Name, derivedName)
+			}
+			if len(regenerated.Spec.Env) != 1 || regenerated.Spec.Env[0].Name != "VLLM_FLAG" {
+				t.Errorf("expected env from the edited base, got %v", regenerated.Spec.Env)
+			}
+			if regenerated.Annotations[constants.AnnotationBaseTemplateHash] != aimservicetemplate.ComputeBaseTemplateHash(tt.base.Spec) {
+				t.Error("expected base hash annotation to be refreshed")
+			}
+		})
+	}
+}
+
+func TestSetBaseTemplateChangedCondition(t *testing.T) {
+	base := NewTemplate("base").Build()
+	service := NewService("svc").WithTemplateName("base").WithOverrideGPU("MI300X", 2).Build()
+	derived := buildDerivedTemplate(service, "base-ovr", "", &base.Spec)
+	setBaseTemplateAnnotations(derived, "base", &base.Spec)
+	derived.Status.Conditions = []metav1.Condition{{
+		Type:    aimv1alpha1.AIMTemplateConditionBaseTemplateChanged,
+		Status:  metav1.ConditionTrue,
+		Reason:  aimv1alpha1.AIMTemplateReasonBaseTemplateModified,
+		Message: "Base template \"base\" was modified",
+	}}
+
+	cm := controllerutils.NewConditionManager(nil)
+	obs := ServiceObservation{}
+	obs.service = service
+	obs.template.Value = derived
+	setBaseTemplateChangedCondition(cm, obs)
+
+	cond := cm.Get(aimv1alpha1.AIMServiceConditionBaseTemplateChanged)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != aimv1alpha1.AIMTemplateReasonBaseTemplateModified {
+		t.Fatalf("expected mirrored condition, got %+v", cond)
+	}
+
+	obs.template.Value = base
+	setBaseTemplateChangedCondition(cm, obs)
+	if cm.Get(aimv1alpha1.AIMServiceConditionBaseTemplateChanged) != nil {
+		t.Error("expected condition to be removed for a non-derived template")
+	}
+}
@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"fmt"
+	"path/filepath"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
+)
+
+// tenancyAllows returns true if the name matches one of the allowed patterns.
+// An empty list allows every name; invalid patterns match nothing.
+func tenancyAllows(allowed []string, name string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, pattern := range allowed {
+		if matched, err := filepath.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// clusterTemplateAllowedByTenancy returns true if the runtime config's tenancy policy lets
+// services use the cluster template.
+func clusterTemplateAllowedByTenancy(runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon, name string) bool {
+	if runtimeConfig == nil || runtimeConfig.Tenancy == nil {
+		return true
+	}
+	return tenancyAllows(runtimeConfig.Tenancy.AllowedClusterTemplates, name)
+}
+
+// clusterModelAllowedByTenancy returns true if the runtime config's tenancy policy lets
+// services use the cluster model.
+func clusterModelAllowedByTenancy(runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon, name string) bool {
+	if runtimeConfig == nil || runtimeConfig.Tenancy == nil {
+		return true
+	}
+	return tenancyAllows(runtimeConfig.Tenancy.AllowedClusterModels, name)
+}
+
+// denyCandidatesByTenancy marks cluster template candidates that the tenancy policy forbids
+// as unavailable in the namespace, so auto-selection skips them.
+func denyCandidatesByTenancy(candidates []selection.Candidate, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) {
+	for i := range candidates {
+		c := &candidates[i]
+		if c.Scope == aimv1alpha1.AIMResolutionScopeCluster && !clusterTemplateAllowedByTenancy(runtimeConfig, c.Name) {
+			c.NamespaceDenied = true
+		}
+	}
+}
+
+// checkTenancy refuses a cluster-scoped resource that the tenancy policy does not allow.
+// The refusal is an auth error, which keeps the InferenceService from being created or updated.
+func checkTenancy(component, kind, name, namespace, reason string, allowed bool) (controllerutils.ComponentHealth, bool) {
+	if allowed {
+		return controllerutils.ComponentHealth{}, false
+	}
+	return controllerutils.ComponentHealth{
+		Component:      component,
+		State:          constants.AIMStatusFailed,
+		DependencyType: controllerutils.DependencyTypeUpstream,
+		Errors: []error{controllerutils.NewAuthError(
+			reason,
+			fmt.Sprintf("%s %s is not allowed in namespace %s by the runtime config tenancy policy", kind, name, namespace),
+			nil,
+		)},
+	}, true
+}
@@ -0,0 +1,143 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
+)
+
+func tenancyRuntimeConfig(templates, models []string) controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon] {
+	return controllerutils.FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
+		Value: &aimv1alpha1.AIMRuntimeConfigCommon{
+			Tenancy: &aimv1alpha1.AIMTenancyPolicy{
+				AllowedClusterTemplates: templates,
+				AllowedClusterModels:    models,
+			},
+		},
+	}
+}
+
+func TestTenancyAllows(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		want    bool
+	}{
+		{name: "empty list allows all", allowed: nil, want: true},
+		{name: "exact match", allowed: []string{"llama-latency"}, want: true},
+		{name: "wildcard match", allowed: []string{"qwen-*", "llama-*"}, want: true},
+		{name: "no match", allowed: []string{"qwen-*"}, want: false},
+		{name: "invalid pattern matches nothing", allowed: []string{"["}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tenancyAllows(tt.allowed, "llama-latency"); got != tt.want {
+				t.Errorf("tenancyAllows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTemplateHealth_ClusterTemplateForbidden(t *testing.T) {
+	tests := []struct {
+		name       string
+		allowed    []string
+		wantState  constants.AIMStatus
+		wantDenied bool
+	}{
+		{name: "allowed", allowed: []string{"shared-*"}, wantState: constants.AIMStatusReady},
+		{name: "forbidden", allowed: []string{"team-a-*"}, wantState: constants.AIMStatusFailed, wantDenied: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := NewClusterTemplate("shared-llama").WithStatus(constants.AIMStatusReady).Build()
+			obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+				service:             NewService("svc").Build(),
+				mergedRuntimeConfig: tenancyRuntimeConfig(tt.allowed, nil),
+				clusterTemplate:     controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]{Value: template},
+			}}
+
+			health := obs.getTemplateHealth()
+			if health.State != tt.wantState {
+				t.Fatalf("state = %s, want %s", health.State, tt.wantState)
+			}
+			if !tt.wantDenied {
+				return
+			}
+			if len(health.Errors) != 1 {
+				t.Fatalf("expected one error, got %+v", health)
+			}
+			categorized := controllerutils.CategorizeError(health.Errors[0])
+			if categorized.Category() != controllerutils.ErrorCategoryAuth ||
+				categorized.Reason() != aimv1alpha1.AIMServiceReasonClusterTemplateForbidden {
+				t.Errorf("expected Auth/%s, got %s/%s", aimv1alpha1.AIMServiceReasonClusterTemplateForbidden,
+					categorized.Category(), categorized.Reason())
+			}
+		})
+	}
+}
+
+func TestGetModelHealth_ClusterModelForbidden(t *testing.T) {
+	model := NewClusterModel("shared-model").WithStatus(constants.AIMStatusReady).Build()
+	obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{
+		service:             NewService("svc").Build(),
+		mergedRuntimeConfig: tenancyRuntimeConfig(nil, []string{"team-a-*"}),
+		modelResult: ModelFetchResult{
+			ClusterModel: controllerutils.FetchResult[*aimv1alpha1.AIMClusterModel]{Value: model},
+		},
+	}}
+
+	health := obs.getModelHealth()
+	if health.State != constants.AIMStatusFailed || len(health.Errors) != 1 {
+		t.Fatalf("expected failed model health with one error, got %+v", health)
+	}
+	categorized := controllerutils.CategorizeError(health.Errors[0])
+	if categorized.Category() != controllerutils.ErrorCategoryAuth ||
+		categorized.Reason() != aimv1alpha1.AIMServiceReasonClusterModelForbidden {
+		t.Errorf("expected Auth/%s, got %s/%s", aimv1alpha1.AIMServiceReasonClusterModelForbidden,
+			categorized.Category(), categorized.Reason())
+	}
+}
+
+func TestDenyCandidatesByTenancy(t *testing.T) {
+	candidates := []selection.Candidate{
+		{Name: "team-a-llama", Scope: aimv1alpha1.AIMResolutionScopeCluster},
+		{Name: "shared-llama", Scope: aimv1alpha1.AIMResolutionScopeCluster},
+		{Name: "local-llama", Scope: aimv1alpha1.AIMResolutionScopeNamespace},
+	}
+	denyCandidatesByTenancy(candidates, tenancyRuntimeConfig([]string{"team-a-*"}, nil).Value)
+
+	want := map[string]bool{"team-a-llama": false, "shared-llama": true, "local-llama": false}
+	for _, c := range candidates {
+		if c.NamespaceDenied != want[c.Name] {
+			t.Errorf("%s: NamespaceDenied = %v, want %v", c.Name, c.NamespaceDenied, want[c.Name])
+		}
+	}
+}
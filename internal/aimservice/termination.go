@@ -0,0 +1,111 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"fmt"
+
+	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+const (
+	// DefaultTerminationGracePeriodSeconds is the grace period used when termination is
+	// configured without gracePeriodSeconds. Long generations need more than the
+	// Kubernetes default of 30 seconds.
+	DefaultTerminationGracePeriodSeconds int64 = 120
+
+	// DefaultTerminationDrainSeconds is the preStop wait used when termination is
+	// configured without drainSeconds.
+	DefaultTerminationDrainSeconds int32 = 15
+)
+
+// resolveTermination merges the service termination settings over the runtime config ones, field by field.
+func resolveTermination(
+	service *aimv1alpha1.AIMService,
+	runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon,
+) *aimv1alpha1.AIMTerminationConfig {
+	var resolved *aimv1alpha1.AIMTerminationConfig
+	if runtimeConfig != nil && runtimeConfig.Termination != nil {
+		resolved = runtimeConfig.Termination.DeepCopy()
+	}
+
+	override := service.Spec.Termination
+	if override == nil {
+		return resolved
+	}
+	if resolved == nil {
+		return override.DeepCopy()
+	}
+
+	if override.GracePeriodSeconds != nil {
+		resolved.GracePeriodSeconds = ptr.To(*override.GracePeriodSeconds)
+	}
+	if override.DrainPath != "" {
+		resolved.DrainPath = override.DrainPath
+	}
+	if override.DrainSeconds != nil {
+		resolved.DrainSeconds = ptr.To(*override.DrainSeconds)
+	}
+	return resolved
+}
+
+// applyTermination sets the termination grace period and preStop hook of the serving container.
+// Nothing is changed when no termination settings are configured, keeping the Kubernetes defaults.
+func applyTermination(isvc *servingv1beta1.InferenceService, termination *aimv1alpha1.AIMTerminationConfig) {
+	podSpec := &isvc.Spec.Predictor.PodSpec
+	if termination == nil || len(podSpec.Containers) == 0 {
+		return
+	}
+
+	gracePeriod := ptr.Deref(termination.GracePeriodSeconds, DefaultTerminationGracePeriodSeconds)
+	drainSeconds := int64(ptr.Deref(termination.DrainSeconds, DefaultTerminationDrainSeconds))
+	drainSeconds = min(drainSeconds, gracePeriod)
+	podSpec.TerminationGracePeriodSeconds = ptr.To(gracePeriod)
+
+	var preStop *corev1.LifecycleHandler
+	switch {
+	case termination.DrainPath != "":
+		drain := fmt.Sprintf("curl -sf -X POST http://127.0.0.1:%d%s >/dev/null 2>&1 || true; sleep %d",
+			constants.DefaultHTTPPort, termination.DrainPath, drainSeconds)
+		preStop = &corev1.LifecycleHandler{
+			Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c", drain}},
+		}
+	case drainSeconds > 0:
+		preStop = &corev1.LifecycleHandler{
+			Sleep: &corev1.SleepAction{Seconds: drainSeconds},
+		}
+	default:
+		return
+	}
+
+	container := &podSpec.Containers[0]
+	if container.Lifecycle == nil {
+		container.Lifecycle = &corev1.Lifecycle{}
+	}
+	container.Lifecycle.PreStop = preStop
+}
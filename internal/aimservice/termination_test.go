@@ -0,0 +1,133 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+func TestResolveTermination(t *testing.T) {
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{}
+	runtimeConfig.Termination = &aimv1alpha1.AIMTerminationConfig{
+		GracePeriodSeconds: ptr.To[int64](600),
+		DrainPath:          "/drain",
+	}
+
+	service := NewService("svc").Build()
+	if resolveTermination(service, nil) != nil {
+		t.Error("expected no termination settings when none are configured")
+	}
+
+	resolved := resolveTermination(service, runtimeConfig)
+	if *resolved.GracePeriodSeconds != 600 || resolved.DrainPath != "/drain" {
+		t.Errorf("expected runtime config values, got %+v", resolved)
+	}
+
+	service.Spec.Termination = &aimv1alpha1.AIMTerminationConfig{DrainSeconds: ptr.To[int32](5)}
+	resolved = resolveTermination(service, runtimeConfig)
+	if *resolved.GracePeriodSeconds != 600 || resolved.DrainPath != "/drain" || *resolved.DrainSeconds != 5 {
+		t.Errorf("expected service fields merged over runtime config, got %+v", resolved)
+	}
+	if runtimeConfig.Termination.DrainSeconds != nil {
+		t.Error("runtime config must not be mutated")
+	}
+}
+
+func TestBuildInferenceService_Termination(t *testing.T) {
+	tests := []struct {
+		name        string
+		termination *aimv1alpha1.AIMTerminationConfig
+		wantGrace   *int64
+		wantSleep   int64
+		wantExec    string
+	}{
+		{
+			name: "unset keeps kubernetes defaults",
+		},
+		{
+			name:        "defaults sleep before SIGTERM",
+			termination: &aimv1alpha1.AIMTerminationConfig{},
+			wantGrace:   ptr.To(DefaultTerminationGracePeriodSeconds),
+			wantSleep:   int64(DefaultTerminationDrainSeconds),
+		},
+		{
+			name:        "drain path calls the endpoint then waits",
+			termination: &aimv1alpha1.AIMTerminationConfig{DrainPath: "/drain", DrainSeconds: ptr.To[int32](20)},
+			wantGrace:   ptr.To(DefaultTerminationGracePeriodSeconds),
+			wantExec:    "curl -sf -X POST http://127.0.0.1:8000/drain >/dev/null 2>&1 || true; sleep 20",
+		},
+		{
+			name:        "drain is capped at the grace period",
+			termination: &aimv1alpha1.AIMTerminationConfig{GracePeriodSeconds: ptr.To[int64](10), DrainSeconds: ptr.To[int32](60)},
+			wantGrace:   ptr.To[int64](10),
+			wantSleep:   10,
+		},
+		{
+			name:        "zero drain sets only the grace period",
+			termination: &aimv1alpha1.AIMTerminationConfig{DrainSeconds: ptr.To[int32](0)},
+			wantGrace:   ptr.To(DefaultTerminationGracePeriodSeconds),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService("svc").Build()
+			service.Spec.Termination = tt.termination
+
+			isvc := buildInferenceService(service, "tmpl", nil, nil, ServiceObservation{})
+			podSpec := isvc.Spec.Predictor.PodSpec
+
+			if !ptr.Equal(podSpec.TerminationGracePeriodSeconds, tt.wantGrace) {
+				t.Errorf("terminationGracePeriodSeconds = %v, want %v",
+					ptr.Deref(podSpec.TerminationGracePeriodSeconds, -1), ptr.Deref(tt.wantGrace, -1))
+			}
+
+			lifecycle := podSpec.Containers[0].Lifecycle
+			switch {
+			case tt.wantExec != "":
+				if lifecycle == nil || lifecycle.PreStop == nil || lifecycle.PreStop.Exec == nil {
+					t.Fatal("expected an exec preStop hook")
+				}
+				if cmd := strings.Join(lifecycle.PreStop.Exec.Command, " "); !strings.HasSuffix(cmd, tt.wantExec) {
+					t.Errorf("preStop command = %q, want suffix %q", cmd, tt.wantExec)
+				}
+			case tt.wantSleep > 0:
+				if lifecycle == nil || lifecycle.PreStop == nil || lifecycle.PreStop.Sleep == nil {
+					t.Fatal("expected a sleep preStop hook")
+				}
+				if lifecycle.PreStop.Sleep.Seconds != tt.wantSleep {
+					t.Errorf("preStop sleep = %d, want %d", lifecycle.PreStop.Sleep.Seconds, tt.wantSleep)
+				}
+			default:
+				if lifecycle != nil {
+					t.Errorf("expected no lifecycle, got %+v", lifecycle)
+				}
+			}
+		})
+	}
+}
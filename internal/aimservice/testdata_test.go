@@ -89,16 +89,33 @@ func (b *ServiceBuilder) WithModelImage(image string) *ServiceBuilder {
 	return b
 }
 
+func (b *ServiceBuilder) WithModelAlias(alias string, policy aimv1alpha1.AIMModelVersionPolicy) *ServiceBuilder {
+	b.service.Spec.Model.Alias = ptr.To(alias)
+	b.service.Spec.Model.VersionPolicy = policy
+	return b
+}
+
 func (b *ServiceBuilder) WithTemplateName(name string) *ServiceBuilder {
 	b.service.Spec.Template.Name = name
 	return b
 }
 
+func (b *ServiceBuilder) WithGPUPreference(placement aimv1alpha1.AIMPlacementPolicy, models ...string) *ServiceBuilder {
+	b.service.Spec.Template.GPUPreference = models
+	b.service.Spec.Template.PlacementPolicy = placement
+	return b
+}
+
 func (b *ServiceBuilder) WithAllowUnoptimized(allow bool) *ServiceBuilder {
 	b.service.Spec.Template.AllowUnoptimized = allow
 	return b
 }
 
+func (b *ServiceBuilder) WithAllowUnoptimizedFallback(allow bool) *ServiceBuilder {
+	b.service.Spec.Template.AllowUnoptimizedFallback = allow
+	return b
+}
+
 func (b *ServiceBuilder) WithCachingMode(mode aimv1alpha1.AIMCachingMode) *ServiceBuilder {
 	if b.service.Spec.Caching == nil {
 		b.service.Spec.Caching = &aimv1alpha1.AIMServiceCachingConfig{}
@@ -107,6 +124,14 @@ func (b *ServiceBuilder) WithCachingMode(mode aimv1alpha1.AIMCachingMode) *Servi
 	return b
 }
 
+func (b *ServiceBuilder) WithCacheReadinessGate(gate aimv1alpha1.AIMCacheReadinessGate) *ServiceBuilder {
+	if b.service.Spec.Caching == nil {
+		b.service.Spec.Caching = &aimv1alpha1.AIMServiceCachingConfig{}
+	}
+	b.service.Spec.Caching.ReadinessGate = gate
+	return b
+}
+
 func (b *ServiceBuilder) WithOverrideMetric(metric aimv1alpha1.AIMMetric) *ServiceBuilder {
 	if b.service.Spec.Overrides == nil {
 		b.service.Spec.Overrides = &aimv1alpha1.AIMServiceOverrides{}
@@ -180,6 +205,17 @@ func (b *ModelBuilder) WithImage(image string) *ModelBuilder {
 	return b
 }
 
+func (b *ModelBuilder) WithFamily(family, version string) *ModelBuilder {
+	b.model.Spec.Family = family
+	b.model.Spec.Version = version
+	return b
+}
+
+func (b *ModelBuilder) WithAliases(aliases ...string) *ModelBuilder {
+	b.model.Spec.Aliases = aliases
+	return b
+}
+
 func (b *ModelBuilder) WithStatus(status constants.AIMStatus) *ModelBuilder {
 	b.model.Status.Status = status
 	return b
@@ -223,6 +259,12 @@ func (b *ClusterModelBuilder) WithImage(image string) *ClusterModelBuilder {
 	return b
 }
 
+func (b *ClusterModelBuilder) WithFamily(family, version string) *ClusterModelBuilder {
+	b.model.Spec.Family = family
+	b.model.Spec.Version = version
+	return b
+}
+
 func (b *ClusterModelBuilder) WithStatus(status constants.AIMStatus) *ClusterModelBuilder {
 	b.model.Status.Status = status
 	return b
@@ -361,6 +403,11 @@ func (b *ClusterTemplateBuilder) WithProfileType(profileType aimv1alpha1.AIMProf
 	return b
 }
 
+func (b *ClusterTemplateBuilder) WithNamespaceSelector(matchLabels map[string]string) *ClusterTemplateBuilder {
+	b.template.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: matchLabels}
+	return b
+}
+
 func (b *ClusterTemplateBuilder) WithGPU(model string, count int) *ClusterTemplateBuilder {
 	b.template.Status.Profile.Metadata.GPU = model
 	b.template.Status.Profile.Metadata.GPUCount = int32(count)
@@ -381,74 +428,6 @@ func (b *ClusterTemplateBuilder) Build() *aimv1alpha1.AIMClusterServiceTemplate
 	return b.template.DeepCopy()
 }
 
-// ============================================================================
-// BUILDERS - TemplateCandidate (for selection tests)
-// ============================================================================
-
-// CandidateBuilder provides a fluent API for constructing TemplateCandidate test fixtures.
-type CandidateBuilder struct {
-	candidate TemplateCandidate
-}
-
-// NewCandidate creates a new CandidateBuilder with sensible defaults.
-func NewCandidate(name string) *CandidateBuilder {
-	return &CandidateBuilder{
-		candidate: TemplateCandidate{
-			Name:      name,
-			Namespace: testNamespace,
-			Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
-			Status: aimv1alpha1.AIMServiceTemplateStatus{
-				Status: constants.AIMStatusReady,
-				Profile: &aimv1alpha1.AIMProfile{
-					Metadata: aimv1alpha1.AIMProfileMetadata{
-						Type: aimv1alpha1.AIMProfileTypeOptimized,
-					},
-				},
-			},
-		},
-	}
-}
-
-func (b *CandidateBuilder) WithNamespace(ns string) *CandidateBuilder {
-	b.candidate.Namespace = ns
-	return b
-}
-
-func (b *CandidateBuilder) WithScope(scope aimv1alpha1.AIMResolutionScope) *CandidateBuilder {
-	b.candidate.Scope = scope
-	return b
-}
-
-func (b *CandidateBuilder) WithStatus(status constants.AIMStatus) *CandidateBuilder {
-	b.candidate.Status.Status = status
-	return b
-}
-
-func (b *CandidateBuilder) WithProfileType(profileType aimv1alpha1.AIMProfileType) *CandidateBuilder {
-	b.candidate.Status.Profile.Metadata.Type = profileType
-	return b
-}
-
-func (b *CandidateBuilder) WithGPU(model string, count int) *CandidateBuilder {
-	b.candidate.Status.Profile.Metadata.GPU = model
-	b.candidate.Status.Profile.Metadata.GPUCount = int32(count)
-	return b
-}
-
-func (b *CandidateBuilder) WithMetric(metric aimv1alpha1.AIMMetric) *CandidateBuilder {
-	b.candidate.Status.Profile.Metadata.Metric = metric
-	return b
-}
-
-func (b *CandidateBuilder) WithPrecision(precision aimv1alpha1.AIMPrecision) *CandidateBuilder {
-	b.candidate.Status.Profile.Metadata.Precision = precision
-	return b
-}
-
-func (b *CandidateBuilder) Build() TemplateCandidate {
-	return b.candidate
-}
-
 // ============================================================================
 // BUILDERS - Nodes (for GPU availability tests)
 // ============================================================================
@@ -475,6 +454,13 @@ func (b *NodeBuilder) WithGPUProductID(productID string) *NodeBuilder {
 	return b
 }
 
+func (b *NodeBuilder) WithAllocatableGPUs(count int64) *NodeBuilder {
+	b.node.Status.Allocatable = corev1.ResourceList{
+		corev1.ResourceName(constants.DefaultGPUResourceName): *resource.NewQuantity(count, resource.DecimalSI),
+	}
+	return b
+}
+
 func (b *NodeBuilder) Build() *corev1.Node {
 	return b.node.DeepCopy()
 }
@@ -0,0 +1,70 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// buildDeploymentStrategy converts the service's update strategy into the predictor deployment strategy
+// that KServe applies to the RawDeployment it manages. Returns nil when no strategy is configured,
+// which leaves the KServe default in place.
+func buildDeploymentStrategy(strategy *aimv1alpha1.AIMServiceUpdateStrategy) *appsv1.DeploymentStrategy {
+	if strategy == nil {
+		return nil
+	}
+
+	if strategy.Type == aimv1alpha1.AIMServiceUpdateStrategyRecreate {
+		return &appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}
+	}
+
+	result := &appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+	if strategy.RollingUpdate != nil {
+		result.RollingUpdate = &appsv1.RollingUpdateDeployment{
+			MaxSurge:       strategy.RollingUpdate.MaxSurge,
+			MaxUnavailable: strategy.RollingUpdate.MaxUnavailable,
+		}
+	}
+	return result
+}
+
+// setUpdateStrategyCondition reports whether spec.updateStrategy reaches the predictor. In Serverless
+// mode Knative rolls out revisions itself, so the strategy is not planned and the condition warns.
+func setUpdateStrategyCondition(cm *controllerutils.ConditionManager, obs ServiceObservation) {
+	if obs.service == nil || obs.service.Spec.UpdateStrategy == nil {
+		cm.Delete(aimv1alpha1.AIMServiceConditionUpdateStrategyApplied)
+		return
+	}
+	if obs.deploymentMode == aimv1alpha1.KServeDeploymentModeServerless {
+		cm.MarkFalse(aimv1alpha1.AIMServiceConditionUpdateStrategyApplied,
+			aimv1alpha1.AIMServiceReasonStrategyNotAppliedServerless,
+			"spec.updateStrategy is not applied in Serverless mode; Knative rolls out revisions itself",
+			controllerutils.AsWarning())
+		return
+	}
+	cm.MarkTrue(aimv1alpha1.AIMServiceConditionUpdateStrategyApplied, aimv1alpha1.AIMServiceReasonStrategyApplied,
+		"spec.updateStrategy is the predictor deployment strategy")
+}
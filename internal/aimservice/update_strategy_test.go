@@ -0,0 +1,137 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservice
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func TestBuildDeploymentStrategy(t *testing.T) {
+	if strategy := buildDeploymentStrategy(nil); strategy != nil {
+		t.Fatalf("expected no strategy when unset, got %+v", strategy)
+	}
+
+	recreate := buildDeploymentStrategy(&aimv1alpha1.AIMServiceUpdateStrategy{
+		Type: aimv1alpha1.AIMServiceUpdateStrategyRecreate,
+	})
+	if recreate.Type != appsv1.RecreateDeploymentStrategyType || recreate.RollingUpdate != nil {
+		t.Errorf("unexpected recreate strategy: %+v", recreate)
+	}
+
+	rolling := buildDeploymentStrategy(&aimv1alpha1.AIMServiceUpdateStrategy{
+		Type: aimv1alpha1.AIMServiceUpdateStrategyRollingUpdate,
+		RollingUpdate: &aimv1alpha1.AIMServiceRollingUpdate{
+			MaxSurge:       ptr.To(intstr.FromInt32(0)),
+			MaxUnavailable: ptr.To(intstr.FromString("50%")),
+		},
+	})
+	if rolling.Type != appsv1.RollingUpdateDeploymentStrategyType || rolling.RollingUpdate == nil {
+		t.Fatalf("unexpected rolling strategy: %+v", rolling)
+	}
+	if rolling.RollingUpdate.MaxSurge.IntValue() != 0 || rolling.RollingUpdate.MaxUnavailable.StrVal != "50%" {
+		t.Errorf("unexpected rolling update bounds: %+v", rolling.RollingUpdate)
+	}
+}
+
+func TestBuildInferenceService_UpdateStrategy(t *testing.T) {
+	service := NewService("svc").Build()
+	isvc := buildInferenceService(service, "tmpl", nil, nil, ServiceObservation{})
+	if isvc.Spec.Predictor.DeploymentStrategy != nil {
+		t.Errorf("expected no deployment strategy by default, got %+v", isvc.Spec.Predictor.DeploymentStrategy)
+	}
+
+	service.Spec.UpdateStrategy = &aimv1alpha1.AIMServiceUpdateStrategy{Type: aimv1alpha1.AIMServiceUpdateStrategyRecreate}
+	isvc = buildInferenceService(service, "tmpl", nil, nil, ServiceObservation{})
+	if isvc.Spec.Predictor.DeploymentStrategy == nil ||
+		isvc.Spec.Predictor.DeploymentStrategy.Type != appsv1.RecreateDeploymentStrategyType {
+		t.Errorf("expected Recreate deployment strategy, got %+v", isvc.Spec.Predictor.DeploymentStrategy)
+	}
+}
+
+func TestSetUpdateStrategyCondition(t *testing.T) {
+	recreate := &aimv1alpha1.AIMServiceUpdateStrategy{Type: aimv1alpha1.AIMServiceUpdateStrategyRecreate}
+
+	tests := []struct {
+		name       string
+		strategy   *aimv1alpha1.AIMServiceUpdateStrategy
+		mode       aimv1alpha1.AIMKServeDeploymentMode
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name: "no strategy",
+			mode: aimv1alpha1.KServeDeploymentModeServerless,
+		},
+		{
+			name:       "raw deployment",
+			strategy:   recreate,
+			mode:       aimv1alpha1.KServeDeploymentModeRawDeployment,
+			wantStatus: metav1.ConditionTrue,
+			wantReason: aimv1alpha1.AIMServiceReasonStrategyApplied,
+		},
+		{
+			name:       "serverless",
+			strategy:   recreate,
+			mode:       aimv1alpha1.KServeDeploymentModeServerless,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: aimv1alpha1.AIMServiceReasonStrategyNotAppliedServerless,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := controllerutils.NewConditionManager([]metav1.Condition{{
+				Type:   aimv1alpha1.AIMServiceConditionUpdateStrategyApplied,
+				Status: metav1.ConditionTrue,
+				Reason: aimv1alpha1.AIMServiceReasonStrategyApplied,
+			}})
+			service := NewService("svc").Build()
+			service.Spec.UpdateStrategy = tt.strategy
+			obs := ServiceObservation{ServiceFetchResult: ServiceFetchResult{service: service, deploymentMode: tt.mode}}
+
+			setUpdateStrategyCondition(cm, obs)
+
+			cond := cm.Get(aimv1alpha1.AIMServiceConditionUpdateStrategyApplied)
+			if tt.wantStatus == "" {
+				if cond != nil {
+					t.Fatalf("expected no condition, got %+v", cond)
+				}
+				return
+			}
+			if cond == nil {
+				t.Fatal("expected condition to be set")
+			}
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("condition = %s/%s, want %s/%s", cond.Status, cond.Reason, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// ComputeBaseTemplateHash hashes a template spec. Derived templates record the hash of their
+// base spec at derivation time so that later edits of the base can be detected.
+func ComputeBaseTemplateHash(spec aimv1alpha1.AIMServiceTemplateSpec) string {
+	data, _ := json.Marshal(spec)
+	hash := sha256.Sum256(data)
+	return fmt.Sprintf("%x", hash[:8])
+}
+
+// IsDerivedTemplate returns true if the template was derived from a base template by a service.
+func IsDerivedTemplate(template *aimv1alpha1.AIMServiceTemplate) bool {
+	return template != nil &&
+		template.Labels[constants.LabelKeyOrigin] == constants.LabelValueOriginDerived &&
+		template.Annotations[constants.AnnotationBaseTemplate] != ""
+}
+
+// FetchBaseTemplate fetches the base template a derived template was created from.
+func FetchBaseTemplate(ctx context.Context, c client.Client, derived *aimv1alpha1.AIMServiceTemplate) controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate] {
+	return controllerutils.Fetch(ctx, c, client.ObjectKey{
+		Namespace: derived.Namespace,
+		Name:      derived.Annotations[constants.AnnotationBaseTemplate],
+	}, &aimv1alpha1.AIMServiceTemplate{})
+}
+
+// HasBaseTemplateChanged returns true if the base template spec no longer matches the hash
+// recorded on the derived template.
+func HasBaseTemplateChanged(derived, base *aimv1alpha1.AIMServiceTemplate) bool {
+	return derived.Annotations[constants.AnnotationBaseTemplateHash] != ComputeBaseTemplateHash(base.Spec)
+}
+
+// setBaseTemplateChangedCondition reports whether the base of a derived template was edited after
+// derivation. The condition is removed from templates that were not derived.
+func setBaseTemplateChangedCondition(
+	cm *controllerutils.ConditionManager,
+	template *aimv1alpha1.AIMServiceTemplate,
+	base controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate],
+) {
+	if !IsDerivedTemplate(template) {
+		cm.Delete(aimv1alpha1.AIMTemplateConditionBaseTemplateChanged)
+		return
+	}
+
+	baseName := template.Annotations[constants.AnnotationBaseTemplate]
+	switch {
+	case base.IsNotFound():
+		cm.MarkUnknown(aimv1alpha1.AIMTemplateConditionBaseTemplateChanged, aimv1alpha1.AIMTemplateReasonBaseTemplateNotFound,
+			fmt.Sprintf("Base template %q no longer exists", baseName))
+	case !base.OK() || base.Value == nil:
+		// Keep the last known state on transient errors
+	case HasBaseTemplateChanged(template, base.Value):
+		cm.MarkTrue(aimv1alpha1.AIMTemplateConditionBaseTemplateChanged, aimv1alpha1.AIMTemplateReasonBaseTemplateModified,
+			fmt.Sprintf("Base template %q was modified after this template was derived from it", baseName),
+			controllerutils.AsWarning())
+	default:
+		cm.MarkFalse(aimv1alpha1.AIMTemplateConditionBaseTemplateChanged, aimv1alpha1.AIMTemplateReasonBaseTemplateUnchanged,
+			fmt.Sprintf("Base template %q matches the spec this template was derived from", baseName))
+	}
+}
+
+// setBaseDeletedCondition reports that the base of a derived template was deleted. A base that
+// is still terminating counts as deleted, since its finalizer marks derived templates up front.
+func setBaseDeletedCondition(
+	cm *controllerutils.ConditionManager,
+	template *aimv1alpha1.AIMServiceTemplate,
+	base controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate],
+) {
+	if !IsDerivedTemplate(template) {
+		cm.Delete(aimv1alpha1.AIMTemplateConditionBaseDeleted)
+		return
+	}
+
+	switch {
+	case base.IsNotFound(), base.OK() && base.Value != nil && base.Value.DeletionTimestamp != nil:
+		cm.MarkTrue(aimv1alpha1.AIMTemplateConditionBaseDeleted, aimv1alpha1.AIMTemplateReasonBaseTemplateDeleted,
+			baseDeletedMessage(template.Annotations[constants.AnnotationBaseTemplate]), controllerutils.AsWarning())
+	case base.OK() && base.Value != nil:
+		cm.Delete(aimv1alpha1.AIMTemplateConditionBaseDeleted)
+	}
+}
+
+func baseDeletedMessage(baseName string) string {
+	return fmt.Sprintf("Base template %q was deleted; this template keeps the spec it was derived with", baseName)
+}
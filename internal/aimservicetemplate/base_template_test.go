@@ -0,0 +1,201 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func newBaseTemplate(modelName string) *aimv1alpha1.AIMServiceTemplate {
+	return &aimv1alpha1.AIMServiceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "default"},
+		Spec: aimv1alpha1.AIMServiceTemplateSpec{
+			AIMServiceTemplateSpecCommon: aimv1alpha1.AIMServiceTemplateSpecCommon{ModelName: modelName},
+		},
+	}
+}
+
+func newDerivedTemplate(base *aimv1alpha1.AIMServiceTemplate) *aimv1alpha1.AIMServiceTemplate {
+	return &aimv1alpha1.AIMServiceTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "base-ovr-abcd",
+			Namespace: "default",
+			Labels:    map[string]string{constants.LabelKeyOrigin: constants.LabelValueOriginDerived},
+			Annotations: map[string]string{
+				constants.AnnotationBaseTemplate:     base.Name,
+				constants.AnnotationBaseTemplateHash: ComputeBaseTemplateHash(base.Spec),
+			},
+		},
+		Spec: base.Spec,
+	}
+}
+
+func TestComputeBaseTemplateHash(t *testing.T) {
+	a := newBaseTemplate("model-a")
+	b := newBaseTemplate("model-a")
+	if ComputeBaseTemplateHash(a.Spec) != ComputeBaseTemplateHash(b.Spec) {
+		t.Error("expected equal specs to hash the same")
+	}
+
+	b.Spec.ModelName = "model-b"
+	if ComputeBaseTemplateHash(a.Spec) == ComputeBaseTemplateHash(b.Spec) {
+		t.Error("expected different specs to hash differently")
+	}
+}
+
+func TestIsDerivedTemplate(t *testing.T) {
+	base := newBaseTemplate("model-a")
+	if IsDerivedTemplate(base) {
+		t.Error("expected a template without origin label not to be derived")
+	}
+	if !IsDerivedTemplate(newDerivedTemplate(base)) {
+		t.Error("expected derived template to be detected")
+	}
+
+	// Derived templates created before base tracking carry no base annotation
+	legacy := newDerivedTemplate(base)
+	legacy.Annotations = nil
+	if IsDerivedTemplate(legacy) {
+		t.Error("expected a derived template without base annotation to be ignored")
+	}
+}
+
+func TestSetBaseTemplateChangedCondition(t *testing.T) {
+	base := newBaseTemplate("model-a")
+	edited := newBaseTemplate("model-a")
+	edited.Spec.Env = append(edited.Spec.Env, corev1.EnvVar{Name: "VLLM_FLAG", Value: "1"})
+
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "aimservicetemplates"}, "base")
+
+	tests := []struct {
+		name       string
+		template   *aimv1alpha1.AIMServiceTemplate
+		base       controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:     "not derived removes condition",
+			template: base,
+		},
+		{
+			name:       "unchanged base",
+			template:   newDerivedTemplate(base),
+			base:       controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: base},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: aimv1alpha1.AIMTemplateReasonBaseTemplateUnchanged,
+		},
+		{
+			name:       "modified base",
+			template:   newDerivedTemplate(base),
+			base:       controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: edited},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: aimv1alpha1.AIMTemplateReasonBaseTemplateModified,
+		},
+		{
+			name:       "deleted base",
+			template:   newDerivedTemplate(base),
+			base:       controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Error: notFound},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: aimv1alpha1.AIMTemplateReasonBaseTemplateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := controllerutils.NewConditionManager([]metav1.Condition{{
+				Type:   aimv1alpha1.AIMTemplateConditionBaseTemplateChanged,
+				Status: metav1.ConditionFalse,
+				Reason: "Stale",
+			}})
+
+			setBaseTemplateChangedCondition(cm, tt.template, tt.base)
+
+			cond := cm.Get(aimv1alpha1.AIMTemplateConditionBaseTemplateChanged)
+			if tt.wantReason == "" {
+				if cond != nil {
+					t.Errorf("expected condition to be removed, got %+v", cond)
+				}
+				return
+			}
+			if cond == nil {
+				t.Fatal("expected condition to be set")
+			}
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("condition = %s/%s, want %s/%s", cond.Status, cond.Reason, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestSetBaseDeletedCondition(t *testing.T) {
+	base := newBaseTemplate("model-a")
+	terminating := newBaseTemplate("model-a")
+	terminating.DeletionTimestamp = &metav1.Time{}
+
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "aimservicetemplates"}, "base")
+
+	tests := []struct {
+		name     string
+		template *aimv1alpha1.AIMServiceTemplate
+		base     controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
+		wantSet  bool
+	}{
+		{name: "not derived", template: base},
+		{name: "base exists", template: newDerivedTemplate(base), base: controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: base}},
+		{name: "base terminating", template: newDerivedTemplate(base), base: controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Value: terminating}, wantSet: true},
+		{name: "base deleted", template: newDerivedTemplate(base), base: controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]{Error: notFound}, wantSet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := controllerutils.NewConditionManager([]metav1.Condition{{
+				Type:   aimv1alpha1.AIMTemplateConditionBaseDeleted,
+				Status: metav1.ConditionTrue,
+				Reason: "Stale",
+			}})
+
+			setBaseDeletedCondition(cm, tt.template, tt.base)
+
+			cond := cm.Get(aimv1alpha1.AIMTemplateConditionBaseDeleted)
+			if !tt.wantSet {
+				if cond != nil {
+					t.Errorf("expected condition to be removed, got %+v", cond)
+				}
+				return
+			}
+			if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != aimv1alpha1.AIMTemplateReasonBaseTemplateDeleted {
+				t.Errorf("expected BaseDeleted=True, got %+v", cond)
+			}
+		})
+	}
+}
@@ -29,23 +29,47 @@ import (
 	"k8s.io/utils/ptr"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimtemplatecache"
 	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
 )
 
 // HasExistingTemplateCache checks if a template cache already exists for the given template.
 // It checks owner references to determine if the cache belongs to this template.
 func HasExistingTemplateCache(templateUID types.UID, cachesResult controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCacheList]) bool {
+	return ownedTemplateCache(templateUID, cachesResult) != nil
+}
+
+// ownedTemplateCache returns the template cache owned by the template, nil if there is none.
+func ownedTemplateCache(templateUID types.UID, cachesResult controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCacheList]) *aimv1alpha1.AIMTemplateCache {
 	if !cachesResult.OK() || cachesResult.Value == nil {
-		return false
+		return nil
 	}
-	for _, cache := range cachesResult.Value.Items {
-		for _, owner := range cache.OwnerReferences {
+	for i := range cachesResult.Value.Items {
+		for _, owner := range cachesResult.Value.Items[i].OwnerReferences {
 			if owner.UID == templateUID {
-				return true
+				return &cachesResult.Value.Items[i]
 			}
 		}
 	}
-	return false
+	return nil
+}
+
+// setTemplateCacheReadinessConditions sets CacheProvisioned and CacheWarm from the template
+// cache owned by the template. Both are removed when caching is disabled, and kept unchanged
+// when the caches could not be listed.
+func setTemplateCacheReadinessConditions(
+	cm *controllerutils.ConditionManager,
+	template *aimv1alpha1.AIMServiceTemplate,
+	cachesResult controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCacheList],
+) {
+	if template.Spec.Caching == nil || !template.Spec.Caching.Enabled {
+		aimtemplatecache.SetReadinessConditions(cm, nil)
+		return
+	}
+	if !cachesResult.OK() {
+		return
+	}
+	aimtemplatecache.SetReadinessConditions(cm, ownedTemplateCache(template.UID, cachesResult))
 }
 
 // BuildTemplateCache creates an AIMTemplateCache resource for a namespace-scoped template.
@@ -0,0 +1,99 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// CleanupDiscoveryResources deletes all discovery jobs of a template and their pods.
+// Jobs are deleted with background propagation, and pods are deleted explicitly so that
+// a running discovery pod releases its GPU even if job garbage collection lags behind.
+func CleanupDiscoveryResources(ctx context.Context, c client.Client, namespace, templateName string) error {
+	jobs := FetchDiscoveryJobs(ctx, c, namespace, templateName)
+	if jobs.HasError() {
+		return fmt.Errorf("failed to list discovery jobs: %w", jobs.Error)
+	}
+	for i := range jobs.Value.Items {
+		job := &jobs.Value.Items[i]
+		if err := c.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete discovery job %s: %w", job.Name, err)
+		}
+	}
+
+	pods := FetchDiscoveryPods(ctx, c, namespace, templateName)
+	if pods.HasError() {
+		return fmt.Errorf("failed to list discovery pods: %w", pods.Error)
+	}
+	for i := range pods.Value.Items {
+		pod := &pods.Value.Items[i]
+		if err := c.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete discovery pod %s: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
+
+// MarkDerivedTemplatesBaseDeleted sets the BaseDeleted condition on every template derived
+// from base, so the deletion is visible before the derived templates next reconcile.
+func MarkDerivedTemplatesBaseDeleted(ctx context.Context, c client.Client, base *aimv1alpha1.AIMServiceTemplate) error {
+	var templates aimv1alpha1.AIMServiceTemplateList
+	if err := c.List(ctx, &templates,
+		client.InNamespace(base.Namespace),
+		client.MatchingLabels{constants.LabelKeyOrigin: constants.LabelValueOriginDerived},
+	); err != nil {
+		return fmt.Errorf("failed to list derived templates: %w", err)
+	}
+
+	for i := range templates.Items {
+		derived := &templates.Items[i]
+		if derived.Annotations[constants.AnnotationBaseTemplate] != base.Name {
+			continue
+		}
+		if meta.IsStatusConditionTrue(derived.Status.Conditions, aimv1alpha1.AIMTemplateConditionBaseDeleted) {
+			continue
+		}
+
+		patch := client.MergeFrom(derived.DeepCopy())
+		meta.SetStatusCondition(&derived.Status.Conditions, metav1.Condition{
+			Type:               aimv1alpha1.AIMTemplateConditionBaseDeleted,
+			Status:             metav1.ConditionTrue,
+			Reason:             aimv1alpha1.AIMTemplateReasonBaseTemplateDeleted,
+			Message:            baseDeletedMessage(base.Name),
+			ObservedGeneration: derived.Generation,
+		})
+		if err := c.Status().Patch(ctx, derived, patch); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to mark derived template %s: %w", derived.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func newCleanupClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = aimv1alpha1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&aimv1alpha1.AIMServiceTemplate{}).
+		Build()
+}
+
+func TestCleanupDiscoveryResources(t *testing.T) {
+	discoveryLabels := func(template string) map[string]string {
+		return map[string]string{
+			constants.LabelKeyTemplate:    template,
+			"app.kubernetes.io/component": constants.LabelValueComponentDiscovery,
+		}
+	}
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "disc", Namespace: "default", Labels: discoveryLabels("base")}}
+	otherJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", Labels: discoveryLabels("other")}}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "disc-abc", Namespace: "default",
+		Labels: map[string]string{constants.LabelKeyTemplate: "base", "job-name": "disc"},
+	}}
+
+	c := newCleanupClient(job, otherJob, pod)
+	if err := CleanupDiscoveryResources(context.Background(), c, "default", "base"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var jobs batchv1.JobList
+	if err := c.List(context.Background(), &jobs); err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Items) != 1 || jobs.Items[0].Name != "other" {
+		t.Errorf("expected only the other template's job to remain, got %v", jobs.Items)
+	}
+	var pods corev1.PodList
+	if err := c.List(context.Background(), &pods); err != nil {
+		t.Fatal(err)
+	}
+	if len(pods.Items) != 0 {
+		t.Errorf("expected discovery pod to be deleted, got %d pods", len(pods.Items))
+	}
+}
+
+func TestMarkDerivedTemplatesBaseDeleted(t *testing.T) {
+	base := newBaseTemplate("model-a")
+	derived := newDerivedTemplate(base)
+	unrelated := newDerivedTemplate(newBaseTemplate("model-b"))
+	unrelated.Name = "unrelated"
+	unrelated.Annotations[constants.AnnotationBaseTemplate] = "another-base"
+
+	c := newCleanupClient(base, derived, unrelated)
+	if err := MarkDerivedTemplatesBaseDeleted(context.Background(), c, base); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got aimv1alpha1.AIMServiceTemplate
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(derived), &got); err != nil {
+		t.Fatal(err)
+	}
+	cond := meta.FindStatusCondition(got.Status.Conditions, aimv1alpha1.AIMTemplateConditionBaseDeleted)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != aimv1alpha1.AIMTemplateReasonBaseTemplateDeleted {
+		t.Errorf("expected BaseDeleted=True on derived template, got %+v", cond)
+	}
+
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(unrelated), &got); err != nil {
+		t.Fatal(err)
+	}
+	if meta.FindStatusCondition(got.Status.Conditions, aimv1alpha1.AIMTemplateConditionBaseDeleted) != nil {
+		t.Error("expected template derived from another base to be left untouched")
+	}
+}
@@ -45,28 +45,20 @@ import (
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
 )
 
 const (
-	// Kubernetes name limit
-	kubernetesNameMaxLength = 63
-
-	// Job name components
-	discoveryJobPrefix = "discover-"
-	discoveryJobSuffix = "-"
-
-	// Hash length for job uniqueness (4 bytes = 8 hex chars)
-	discoveryJobHashLength = 4
-	discoveryJobHashHexLen = 8
+	// discoveryJobPrefix is the first part of discovery job names
+	discoveryJobPrefix = "discover"
 
 	// DiscoveryJobBackoffLimit is the number of pod retries before marking the discovery job as failed.
 	// Set to 0 so that pod failure immediately fails the job, allowing the controller to manage
 	// retries with exponential backoff instead of Kubernetes' built-in retry mechanism.
 	DiscoveryJobBackoffLimit = 0
 
-	// DiscoveryJobTTLSeconds defines how long completed discovery jobs persist
-	// before automatic cleanup. This allows time for status inspection and log retrieval.
-	DiscoveryJobTTLSeconds = 60
+	// discoveryContainerName is the name of the container running the dry-run.
+	discoveryContainerName = "discovery"
 )
 
 // DiscoveryJobSpec defines parameters for creating a discovery job.
@@ -79,6 +71,15 @@ type DiscoveryJobSpec struct {
 	ImagePullSecrets []corev1.LocalObjectReference
 	ServiceAccount   string
 	TemplateSpec     aimv1alpha1.AIMServiceTemplateSpecCommon
+	// CPUOnly runs the dry-run in CPU-only mode. Used as a fallback when the
+	// GPU discovery job cannot be scheduled.
+	CPUOnly bool
+	// Resources are the CPU and memory requests and limits of the discovery container.
+	Resources corev1.ResourceRequirements
+	// RequestGPUs requests the GPUs from the template spec unless the job is CPU-only.
+	RequestGPUs bool
+	// Scheduling holds node placement constraints from the runtime config (may be nil).
+	Scheduling *aimv1alpha1.AIMSchedulingConfig
 	// OwnerRef sets the owner reference on the discovery Job for garbage collection.
 	// When the template is deleted, the discovery Job will be automatically cleaned up.
 	OwnerRef metav1.OwnerReference
@@ -114,31 +115,46 @@ func BuildDiscoveryJob(spec DiscoveryJobSpec) *batchv1.Job {
 	if spec.TemplateSpec.ProfileId != "" {
 		hashInput += spec.TemplateSpec.ProfileId
 	}
+	if spec.CPUOnly {
+		hashInput += constants.LabelValueDiscoveryDeviceCPU
+	}
+	if spec.Scheduling != nil {
+		schedulingJSON, _ := json.Marshal(spec.Scheduling)
+		hashInput += string(schedulingJSON)
+	}
+	resources := discoveryContainerResources(spec)
+	if len(resources.Requests) > 0 || len(resources.Limits) > 0 {
+		resourcesJSON, _ := json.Marshal(resources)
+		hashInput += string(resourcesJSON)
+	}
 
-	hash := sha256.Sum256([]byte(hashInput))
-	hashHex := fmt.Sprintf("%x", hash[:discoveryJobHashLength])
+	// Include the builder fingerprint so a controller that builds jobs differently doesn't try to
+	// update the immutable pod template of a job created by an older controller
+	hashInput += DiscoveryBuilderHash()
 
-	// Calculate max template name length to keep total <= 63 chars
-	// Format: "discover-<template>-<hash>"
-	reservedLength := len(discoveryJobPrefix) + len(discoveryJobSuffix) + discoveryJobHashHexLen
-	maxTemplateNameLength := kubernetesNameMaxLength - reservedLength
+	// Format: "discover-<template>-<hash>", truncating the template name to fit
+	jobName, _ := utils.GenerateDerivedName([]string{discoveryJobPrefix, spec.TemplateName},
+		utils.WithHashSource(hashInput))
 
-	// Truncate template name if necessary
-	templateName := spec.TemplateName
-	if len(templateName) > maxTemplateNameLength {
-		templateName = templateName[:maxTemplateNameLength]
+	job := newDiscoveryJob(spec, jobName)
+	job.Annotations = map[string]string{
+		constants.AnnotationDiscoveryControllerVersion: utils.OperatorVersion(),
+		constants.AnnotationDiscoveryBuilderHash:       DiscoveryBuilderHash(),
 	}
+	return job
+}
 
-	jobName := fmt.Sprintf("%s%s%s%s", discoveryJobPrefix, templateName, discoveryJobSuffix, hashHex)
-
+// newDiscoveryJob builds the discovery Job with the given name, without the annotations
+// that record which controller built it.
+func newDiscoveryJob(spec DiscoveryJobSpec, jobName string) *batchv1.Job {
+	resources := discoveryContainerResources(spec)
 	backoffLimit := int32(DiscoveryJobBackoffLimit)
-	ttlSeconds := int32(DiscoveryJobTTLSeconds)
 
 	// Build environment variables
 	env := []corev1.EnvVar{
 		// Silence logging to produce clean JSON output
-		{Name: "AIM_LOG_LEVEL_ROOT", Value: "CRITICAL"},
-		{Name: "AIM_LOG_LEVEL", Value: "CRITICAL"},
+		{Name: constants.EnvAIMLogLevelRoot, Value: string(aimv1alpha1.LogLevelCritical)},
+		{Name: constants.EnvAIMLogLevel, Value: string(aimv1alpha1.LogLevelCritical)},
 	}
 	env = append(env, spec.Env...)
 
@@ -179,6 +195,21 @@ func BuildDiscoveryJob(spec DiscoveryJobSpec) *batchv1.Job {
 		})
 	}
 
+	jobLabels := map[string]string{
+		"app.kubernetes.io/name":       "aim-discovery",
+		"app.kubernetes.io/component":  constants.LabelValueComponentDiscovery,
+		"app.kubernetes.io/managed-by": constants.LabelValueManagedByController,
+		constants.LabelKeyTemplate:     spec.TemplateName,
+		constants.LabelKeyManagedBy:    constants.LabelValueManagedBy,
+	}
+	if spec.CPUOnly {
+		env = append(env, corev1.EnvVar{
+			Name:  constants.EnvAIMDiscoveryDevice,
+			Value: constants.LabelValueDiscoveryDeviceCPU,
+		})
+		jobLabels[constants.LabelKeyDiscoveryDevice] = constants.LabelValueDiscoveryDeviceCPU
+	}
+
 	// Security context for pod security standards compliance
 	allowPrivilegeEscalation := false
 	runAsNonRoot := true
@@ -193,23 +224,18 @@ func BuildDiscoveryJob(spec DiscoveryJobSpec) *batchv1.Job {
 			Kind:       "Job",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      jobName,
-			Namespace: spec.Namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/name":       "aim-discovery",
-				"app.kubernetes.io/component":  constants.LabelValueComponentDiscovery,
-				"app.kubernetes.io/managed-by": constants.LabelValueManagedByController,
-				constants.LabelKeyTemplate:     spec.TemplateName,
-			},
+			Name:            jobName,
+			Namespace:       spec.Namespace,
+			Labels:          jobLabels,
 			OwnerReferences: []metav1.OwnerReference{spec.OwnerRef},
 		},
 		Spec: batchv1.JobSpec{
-			BackoffLimit:            &backoffLimit,
-			TTLSecondsAfterFinished: &ttlSeconds,
+			BackoffLimit: &backoffLimit,
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{
-						constants.LabelKeyTemplate: spec.TemplateName,
+						constants.LabelKeyTemplate:  spec.TemplateName,
+						constants.LabelKeyManagedBy: constants.LabelValueManagedBy,
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -223,13 +249,14 @@ func BuildDiscoveryJob(spec DiscoveryJobSpec) *batchv1.Job {
 					},
 					Containers: []corev1.Container{
 						{
-							Name:  "discovery",
+							Name:  discoveryContainerName,
 							Image: spec.Image,
 							Args: []string{
 								"dry-run",
 								"--format=json",
 							},
-							Env: env,
+							Env:       env,
+							Resources: resources,
 							SecurityContext: &corev1.SecurityContext{
 								AllowPrivilegeEscalation: &allowPrivilegeEscalation,
 								RunAsNonRoot:             &runAsNonRoot,
@@ -244,6 +271,8 @@ func BuildDiscoveryJob(spec DiscoveryJobSpec) *batchv1.Job {
 		},
 	}
 
+	utils.ApplySchedulingToPodSpec(&job.Spec.Template.Spec, spec.Scheduling)
+
 	return job
 }
 
@@ -344,6 +373,15 @@ func GetDiscoveryJobHealth(job *batchv1.Job) controllerutils.ComponentHealth {
 		}
 	}
 
+	// A suspended job is waiting in the GPU job queue
+	if IsJobSuspended(job) && !IsJobComplete(job) {
+		return controllerutils.ComponentHealth{
+			State:   constants.AIMStatusPending,
+			Reason:  "JobQueued",
+			Message: "Discovery job is waiting for a GPU job slot",
+		}
+	}
+
 	// Delegate to shared job health function which properly classifies errors
 	return controllerutils.GetJobHealth(job)
 }
@@ -358,6 +396,14 @@ type discoveryResult struct {
 	Filename string                 `json:"filename"`
 	Profile  discoveryProfileResult `json:"profile"`
 	Models   []discoveryModelResult `json:"models"`
+
+	// Signature is the base64-encoded signature of the profile JSON, when the image signs it
+	Signature string `json:"signature,omitempty"`
+}
+
+// rawDiscoveryResult keeps the profile JSON exactly as emitted, for signature verification.
+type rawDiscoveryResult struct {
+	Profile json.RawMessage `json:"profile"`
 }
 
 // discoveryProfileResult is the raw profile format from discovery job output.
@@ -390,6 +436,21 @@ type discoveryModelResult struct {
 type ParsedDiscovery struct {
 	ModelSources []aimv1alpha1.AIMModelSource
 	Profile      *aimv1alpha1.AIMProfile
+
+	// UnknownFields lists the output fields the controller does not recognize
+	UnknownFields []string
+
+	// ProfileJSON is the profile exactly as emitted by the discovery job
+	ProfileJSON []byte
+
+	// Signature is the signature attached to the profile, empty if unsigned
+	Signature string
+
+	// Verified is true when the profile signature was verified against a trusted key
+	Verified bool
+
+	// VerificationError is set when profile verification rejected the output
+	VerificationError error
 }
 
 // convertToAIMProfile converts the raw discovery profile to AIMProfile API type.
@@ -456,9 +517,11 @@ func findSuccessfulPodForJob(ctx context.Context, c client.Client, job *batchv1.
 }
 
 // streamPodLogs retrieves logs from the specified pod's discovery container.
-func streamPodLogs(ctx context.Context, clientset kubernetes.Interface, pod *corev1.Pod) ([]byte, error) {
+// If tailLines is set, only that many trailing lines are returned.
+func streamPodLogs(ctx context.Context, clientset kubernetes.Interface, pod *corev1.Pod, tailLines *int64) ([]byte, error) {
 	req := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
-		Container: "discovery",
+		Container: discoveryContainerName,
+		TailLines: tailLines,
 	})
 
 	logs, err := req.Stream(ctx)
@@ -512,6 +575,21 @@ func extractLastValidJSONArray(logBytes []byte) ([]byte, error) {
 
 // parseDiscoveryJSON parses discovery results from log bytes, with fallback for mixed output.
 func parseDiscoveryJSON(ctx context.Context, logBytes []byte) ([]discoveryResult, error) {
+	jsonBytes, err := extractDiscoveryJSON(ctx, logBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []discoveryResult
+	if err := json.Unmarshal(jsonBytes, &results); err != nil {
+		return nil, fmt.Errorf("failed to parse extracted JSON array: %w", err)
+	}
+
+	return results, nil
+}
+
+// extractDiscoveryJSON returns the discovery JSON array from log bytes, with fallback for mixed output.
+func extractDiscoveryJSON(ctx context.Context, logBytes []byte) ([]byte, error) {
 	// Check for cancellation before expensive parsing
 	if err := ctx.Err(); err != nil {
 		return nil, fmt.Errorf("context cancelled before parsing JSON: %w", err)
@@ -519,7 +597,7 @@ func parseDiscoveryJSON(ctx context.Context, logBytes []byte) ([]discoveryResult
 
 	var results []discoveryResult
 	if err := json.Unmarshal(logBytes, &results); err == nil {
-		return results, nil
+		return logBytes, nil
 	}
 
 	// Try extracting the last valid JSON array from mixed stdout/stderr
@@ -528,16 +606,19 @@ func parseDiscoveryJSON(ctx context.Context, logBytes []byte) ([]discoveryResult
 		return nil, fmt.Errorf("failed to parse discovery JSON: %w", err)
 	}
 
-	if err := json.Unmarshal(jsonBytes, &results); err != nil {
-		return nil, fmt.Errorf("failed to parse extracted JSON array: %w", err)
-	}
-
-	return results, nil
+	return jsonBytes, nil
 }
 
 // ParseDiscoveryLogs parses the discovery job output to extract model sources and profile.
-// Reads pod logs from the completed job and parses the JSON output.
-func ParseDiscoveryLogs(ctx context.Context, c client.Client, clientset kubernetes.Interface, job *batchv1.Job) (*ParsedDiscovery, error) {
+// Reads pod logs from the completed job and parses the JSON output. Fields of the output
+// that are not recognized are reported in ParsedDiscovery.UnknownFields.
+func ParseDiscoveryLogs(
+	ctx context.Context,
+	c client.Client,
+	clientset kubernetes.Interface,
+	job *batchv1.Job,
+	parsing DiscoveryParsing,
+) (*ParsedDiscovery, error) {
 	// Check for cancellation early
 	if err := ctx.Err(); err != nil {
 		return nil, fmt.Errorf("context cancelled before parsing discovery logs: %w", err)
@@ -554,21 +635,35 @@ func ParseDiscoveryLogs(ctx context.Context, c client.Client, clientset kubernet
 	}
 
 	// Stream pod logs
-	logBytes, err := streamPodLogs(ctx, clientset, successfulPod)
+	logBytes, err := streamPodLogs(ctx, clientset, successfulPod, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	// Parse discovery JSON
-	results, err := parseDiscoveryJSON(ctx, logBytes)
+	jsonBytes, err := extractDiscoveryJSON(ctx, logBytes)
 	if err != nil {
 		return nil, err
 	}
+	var results []discoveryResult
+	if err := json.Unmarshal(jsonBytes, &results); err != nil {
+		return nil, fmt.Errorf("failed to parse extracted JSON array: %w", err)
+	}
 
 	if len(results) == 0 {
 		return nil, fmt.Errorf("discovery output contains empty array")
 	}
 
+	unknownFields, err := findUnknownDiscoveryFields(jsonBytes, parsing.KnownEngineArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawResults []rawDiscoveryResult
+	if err := json.Unmarshal(jsonBytes, &rawResults); err != nil {
+		return nil, fmt.Errorf("failed to parse raw discovery profile: %w", err)
+	}
+
 	// Use the first result
 	result := results[0]
 
@@ -582,8 +677,11 @@ func ParseDiscoveryLogs(ctx context.Context, c client.Client, clientset kubernet
 	modelSources := convertToAIMModelSources(result.Models)
 
 	return &ParsedDiscovery{
-		ModelSources: modelSources,
-		Profile:      profile,
+		ModelSources:  modelSources,
+		Profile:       profile,
+		UnknownFields: unknownFields,
+		ProfileJSON:   rawResults[0].Profile,
+		Signature:     result.Signature,
 	}, nil
 }
 
@@ -0,0 +1,255 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const (
+	// DefaultDiscoveryJobRetention is how long a completed discovery job is kept
+	// after its output has been captured into the template status.
+	DefaultDiscoveryJobRetention = 60 * time.Second
+
+	// DefaultDiscoveryLogTailLines is the number of trailing log lines captured
+	// from a completed discovery job.
+	DefaultDiscoveryLogTailLines = 50
+
+	// discoveryLogTailMaxBytes caps the size of the captured log tail to keep
+	// the template status well below the object size limit.
+	discoveryLogTailMaxBytes = 8 * 1024
+)
+
+// DiscoveryRetention holds the resolved discovery job retention settings.
+type DiscoveryRetention struct {
+	Succeeded    time.Duration
+	Failed       time.Duration
+	LogTailLines int64
+}
+
+// ResolveDiscoveryRetention resolves retention settings from the merged runtime config,
+// falling back to defaults for anything not set.
+func ResolveDiscoveryRetention(config *aimv1alpha1.AIMRuntimeConfigCommon) DiscoveryRetention {
+	retention := DiscoveryRetention{
+		Succeeded:    DefaultDiscoveryJobRetention,
+		Failed:       DefaultDiscoveryJobRetention,
+		LogTailLines: DefaultDiscoveryLogTailLines,
+	}
+	if config == nil || config.Discovery == nil {
+		return retention
+	}
+
+	discovery := config.Discovery
+	if discovery.SuccessfulJobRetention != nil {
+		retention.Succeeded = discovery.SuccessfulJobRetention.Duration
+	}
+	if discovery.FailedJobRetention != nil {
+		retention.Failed = discovery.FailedJobRetention.Duration
+	}
+	if discovery.LogTailLines != nil {
+		retention.LogTailLines = int64(*discovery.LogTailLines)
+	}
+	return retention
+}
+
+// FetchDiscoveryJobs lists all discovery jobs created for a template, including
+// completed and superseded ones awaiting garbage collection.
+func FetchDiscoveryJobs(ctx context.Context, c client.Client, namespace, templateName string) controllerutils.FetchResult[*batchv1.JobList] {
+	return controllerutils.FetchList(ctx, c, &batchv1.JobList{},
+		client.InNamespace(namespace),
+		client.MatchingLabels{
+			constants.LabelKeyTemplate:    templateName,
+			"app.kubernetes.io/component": constants.LabelValueComponentDiscovery,
+		},
+	)
+}
+
+// FetchDiscoveryPods lists the pods of all discovery jobs created for a template.
+func FetchDiscoveryPods(ctx context.Context, c client.Client, namespace, templateName string) controllerutils.FetchResult[*corev1.PodList] {
+	return controllerutils.FetchList(ctx, c, &corev1.PodList{},
+		client.InNamespace(namespace),
+		client.MatchingLabels{constants.LabelKeyTemplate: templateName},
+		client.HasLabels{"job-name"},
+	)
+}
+
+// GetJobFinishedTime returns when the job reached a terminal state.
+// Falls back to the creation timestamp if no terminal condition carries a time.
+func GetJobFinishedTime(job *batchv1.Job) metav1.Time {
+	if job.Status.CompletionTime != nil {
+		return *job.Status.CompletionTime
+	}
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) &&
+			condition.Status == corev1.ConditionTrue && !condition.LastTransitionTime.IsZero() {
+			return condition.LastTransitionTime
+		}
+	}
+	return job.CreationTimestamp
+}
+
+// latestCompletedDiscoveryJob returns the newest completed job, or nil if none have completed.
+func latestCompletedDiscoveryJob(jobs []batchv1.Job) *batchv1.Job {
+	var latest *batchv1.Job
+	for i := range jobs {
+		job := &jobs[i]
+		if !IsJobComplete(job) {
+			continue
+		}
+		if latest == nil || job.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = job
+		}
+	}
+	return latest
+}
+
+// podsForJob returns the pods belonging to the named job, newest first.
+func podsForJob(pods *corev1.PodList, jobName string) []*corev1.Pod {
+	if pods == nil {
+		return nil
+	}
+	var result []*corev1.Pod
+	for i := range pods.Items {
+		if pods.Items[i].Labels["job-name"] == jobName {
+			result = append(result, &pods.Items[i])
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreationTimestamp.After(result[j].CreationTimestamp.Time)
+	})
+	return result
+}
+
+// CaptureDiscoveryOutput returns the output of the newest completed discovery job when it
+// has not been captured yet. Returns nil if there is nothing new to capture.
+// A failure to read logs is recorded in the log tail rather than blocking cleanup forever.
+func CaptureDiscoveryOutput(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	jobs *batchv1.JobList,
+	pods *corev1.PodList,
+	current *aimv1alpha1.AIMDiscoveryOutput,
+	logTailLines int64,
+) *aimv1alpha1.AIMDiscoveryOutput {
+	if jobs == nil {
+		return nil
+	}
+	job := latestCompletedDiscoveryJob(jobs.Items)
+	if job == nil || (current != nil && current.JobName == job.Name) {
+		return nil
+	}
+
+	finishedAt := GetJobFinishedTime(job)
+	output := &aimv1alpha1.AIMDiscoveryOutput{
+		JobName:        job.Name,
+		Succeeded:      IsJobSucceeded(job),
+		CompletionTime: &finishedAt,
+	}
+	if logTailLines <= 0 || clientset == nil {
+		return output
+	}
+
+	jobPods := podsForJob(pods, job.Name)
+	if len(jobPods) == 0 {
+		output.LogTail = "logs unavailable: no pods found for job"
+		return output
+	}
+
+	logBytes, err := streamPodLogs(ctx, clientset, jobPods[0], ptr.To(logTailLines))
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to capture discovery logs", "job", job.Name)
+		output.LogTail = "logs unavailable: " + err.Error()
+		return output
+	}
+	if len(logBytes) > discoveryLogTailMaxBytes {
+		logBytes = logBytes[len(logBytes)-discoveryLogTailMaxBytes:]
+	}
+	output.LogTail = string(logBytes)
+	return output
+}
+
+// PlanDiscoveryJobCleanup schedules deletion of completed discovery jobs and their pods.
+// The newest completed job is only deleted once its output has been recorded in the
+// template status, so the captured data survives a failed status update. Jobs are kept
+// for the configured retention after finishing. Returns the delay until the next
+// retained job becomes eligible for deletion, or zero if none are pending.
+func PlanDiscoveryJobCleanup(
+	planResult *controllerutils.PlanResult,
+	jobs *batchv1.JobList,
+	pods *corev1.PodList,
+	captured *aimv1alpha1.AIMDiscoveryOutput,
+	retention DiscoveryRetention,
+	now time.Time,
+) time.Duration {
+	if jobs == nil {
+		return 0
+	}
+
+	latest := latestCompletedDiscoveryJob(jobs.Items)
+	var requeueAfter time.Duration
+
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if !IsJobComplete(job) {
+			continue
+		}
+
+		// Wait for the output of the newest job to be persisted before cleaning it up
+		if job == latest && (captured == nil || captured.JobName != job.Name) {
+			continue
+		}
+
+		keep := retention.Succeeded
+		if IsJobFailed(job) {
+			keep = retention.Failed
+		}
+		if remaining := GetJobFinishedTime(job).Add(keep).Sub(now); remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+
+		// A plain Job delete orphans its pods, so remove them explicitly
+		planResult.Delete(job)
+		for _, pod := range podsForJob(pods, job.Name) {
+			planResult.Delete(pod)
+		}
+	}
+
+	return requeueAfter
+}
@@ -0,0 +1,212 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func newFinishedDiscoveryJob(name string, created, finished time.Time, succeeded bool) batchv1.Job {
+	conditionType := batchv1.JobFailed
+	if succeeded {
+		conditionType = batchv1.JobComplete
+	}
+	return batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:               conditionType,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(finished),
+			}},
+		},
+	}
+}
+
+func newDiscoveryPod(name, jobName string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": jobName},
+		},
+	}
+}
+
+// ============================================================================
+// RETENTION TESTS
+// ============================================================================
+
+func TestResolveDiscoveryRetention(t *testing.T) {
+	defaults := ResolveDiscoveryRetention(nil)
+	if defaults.Succeeded != DefaultDiscoveryJobRetention || defaults.Failed != DefaultDiscoveryJobRetention {
+		t.Errorf("expected default retention, got %+v", defaults)
+	}
+	if defaults.LogTailLines != DefaultDiscoveryLogTailLines {
+		t.Errorf("expected default log tail lines, got %d", defaults.LogTailLines)
+	}
+
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{
+		Discovery: &aimv1alpha1.AIMDiscoveryConfig{
+			FailedJobRetention: &metav1.Duration{Duration: time.Hour},
+			LogTailLines:       ptrTo(int32(0)),
+		},
+	}
+	got := ResolveDiscoveryRetention(config)
+	if got.Succeeded != DefaultDiscoveryJobRetention {
+		t.Errorf("expected default succeeded retention, got %s", got.Succeeded)
+	}
+	if got.Failed != time.Hour {
+		t.Errorf("expected failed retention 1h, got %s", got.Failed)
+	}
+	if got.LogTailLines != 0 {
+		t.Errorf("expected log capture disabled, got %d", got.LogTailLines)
+	}
+}
+
+// ============================================================================
+// CAPTURE TESTS
+// ============================================================================
+
+func TestCaptureDiscoveryOutput(t *testing.T) {
+	now := time.Now()
+	jobs := &batchv1.JobList{Items: []batchv1.Job{
+		newFinishedDiscoveryJob("discover-old", now.Add(-time.Hour), now.Add(-50*time.Minute), false),
+		newFinishedDiscoveryJob("discover-new", now.Add(-time.Minute), now, false),
+	}}
+	pods := &corev1.PodList{Items: []corev1.Pod{newDiscoveryPod("discover-new-abc", "discover-new")}}
+	clientset := fake.NewSimpleClientset(&pods.Items[0])
+
+	output := CaptureDiscoveryOutput(context.Background(), clientset, jobs, pods, nil, 10)
+	if output == nil {
+		t.Fatal("expected output to be captured")
+	}
+	if output.JobName != "discover-new" {
+		t.Errorf("expected newest job to be captured, got %s", output.JobName)
+	}
+	if output.Succeeded {
+		t.Error("expected failed job to be recorded as not succeeded")
+	}
+	if output.LogTail == "" {
+		t.Error("expected log tail to be captured")
+	}
+
+	// Already captured - nothing new
+	if again := CaptureDiscoveryOutput(context.Background(), clientset, jobs, pods, output, 10); again != nil {
+		t.Errorf("expected no new capture, got %+v", again)
+	}
+}
+
+func TestCaptureDiscoveryOutput_NoPods(t *testing.T) {
+	now := time.Now()
+	jobs := &batchv1.JobList{Items: []batchv1.Job{
+		newFinishedDiscoveryJob("discover-a", now, now, true),
+	}}
+
+	output := CaptureDiscoveryOutput(context.Background(), fake.NewSimpleClientset(), jobs, &corev1.PodList{}, nil, 10)
+	if output == nil || !output.Succeeded {
+		t.Fatalf("expected succeeded output, got %+v", output)
+	}
+	if !strings.HasPrefix(output.LogTail, "logs unavailable") {
+		t.Errorf("expected unavailable marker in log tail, got %q", output.LogTail)
+	}
+}
+
+// ============================================================================
+// CLEANUP TESTS
+// ============================================================================
+
+func TestPlanDiscoveryJobCleanup(t *testing.T) {
+	now := time.Now()
+	retention := DiscoveryRetention{Succeeded: time.Minute, Failed: 10 * time.Minute}
+
+	jobs := &batchv1.JobList{Items: []batchv1.Job{
+		// Superseded and expired: deleted with its pod
+		newFinishedDiscoveryJob("discover-expired", now.Add(-time.Hour), now.Add(-time.Hour), true),
+		// Superseded failed job, still within retention
+		newFinishedDiscoveryJob("discover-retained", now.Add(-30*time.Minute), now.Add(-5*time.Minute), false),
+		// Newest job, expired but output not yet captured
+		newFinishedDiscoveryJob("discover-latest", now.Add(-10*time.Minute), now.Add(-10*time.Minute), true),
+	}}
+	pods := &corev1.PodList{Items: []corev1.Pod{
+		newDiscoveryPod("discover-expired-pod", "discover-expired"),
+		newDiscoveryPod("discover-latest-pod", "discover-latest"),
+	}}
+
+	planResult := controllerutils.PlanResult{}
+	requeue := PlanDiscoveryJobCleanup(&planResult, jobs, pods, nil, retention, now)
+
+	deleted := map[string]bool{}
+	for _, obj := range planResult.GetToDelete() {
+		deleted[obj.GetName()] = true
+	}
+	if len(deleted) != 2 || !deleted["discover-expired"] || !deleted["discover-expired-pod"] {
+		t.Errorf("expected expired job and pod to be deleted, got %v", deleted)
+	}
+	if requeue != 5*time.Minute {
+		t.Errorf("expected requeue after 5m, got %s", requeue)
+	}
+
+	// Once the newest job's output is captured it becomes eligible as well
+	planResult = controllerutils.PlanResult{}
+	captured := &aimv1alpha1.AIMDiscoveryOutput{JobName: "discover-latest"}
+	PlanDiscoveryJobCleanup(&planResult, jobs, pods, captured, retention, now)
+
+	deleted = map[string]bool{}
+	for _, obj := range planResult.GetToDelete() {
+		deleted[obj.GetName()] = true
+	}
+	if !deleted["discover-latest"] || !deleted["discover-latest-pod"] {
+		t.Errorf("expected captured job and pod to be deleted, got %v", deleted)
+	}
+	if deleted["discover-retained"] {
+		t.Error("expected retained job to be kept")
+	}
+}
+
+func TestPlanDiscoveryJobCleanup_SkipsActiveJobs(t *testing.T) {
+	jobs := &batchv1.JobList{Items: []batchv1.Job{{
+		ObjectMeta: metav1.ObjectMeta{Name: "discover-running", Namespace: "default"},
+	}}}
+
+	planResult := controllerutils.PlanResult{}
+	requeue := PlanDiscoveryJobCleanup(&planResult, jobs, &corev1.PodList{}, nil, DiscoveryRetention{}, time.Now())
+	if len(planResult.GetToDelete()) != 0 || requeue != 0 {
+		t.Errorf("expected no action for active job, got %d deletes, requeue %s", len(planResult.GetToDelete()), requeue)
+	}
+}
@@ -28,7 +28,6 @@ import (
 	"os"
 	"time"
 
-	batchv1 "k8s.io/api/batch/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -237,27 +236,6 @@ func (l *DiscoveryLock) isExpired(lease *coordinationv1.Lease) bool {
 	return time.Now().After(expiryTime)
 }
 
-// CountActiveDiscoveryJobs counts the number of active (non-complete) discovery jobs in the cluster.
-func CountActiveDiscoveryJobs(ctx context.Context, c client.Client) (int, error) {
-	var jobList batchv1.JobList
-	if err := c.List(ctx, &jobList, client.MatchingLabels{
-		"app.kubernetes.io/name":       "aim-discovery",
-		"app.kubernetes.io/component":  constants.LabelValueComponentDiscovery,
-		"app.kubernetes.io/managed-by": constants.LabelValueManagedByController,
-	}); err != nil {
-		return 0, err
-	}
-
-	active := 0
-	for i := range jobList.Items {
-		if !IsJobComplete(&jobList.Items[i]) {
-			active++
-		}
-	}
-
-	return active, nil
-}
-
 // NeedsDiscoveryLock returns true if the template might need to create a discovery job,
 // meaning we should acquire the lock before running the pipeline.
 func NeedsDiscoveryLock(status constants.AIMStatus, hasInlineModelSources bool) bool {
@@ -0,0 +1,196 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// maxDiscoveryWarnings bounds the number of unknown fields recorded in status.
+const maxDiscoveryWarnings = 50
+
+// DiscoveryParsing is the resolved configuration for parsing discovery output.
+type DiscoveryParsing struct {
+	// Strict rejects discovery output that contains unknown fields
+	Strict bool
+
+	// KnownEngineArgs are the expected engine_args keys. Empty disables the engine_args check.
+	KnownEngineArgs []string
+
+	// PublicKeys are the PEM-encoded keys trusted to sign profiles. Empty disables verification.
+	PublicKeys []string
+}
+
+// ResolveDiscoveryParsing resolves the discovery parsing configuration from the merged runtime config.
+func ResolveDiscoveryParsing(config *aimv1alpha1.AIMRuntimeConfigCommon) DiscoveryParsing {
+	parsing := DiscoveryParsing{}
+	if config == nil || config.Discovery == nil {
+		return parsing
+	}
+	if config.Discovery.StrictParsing != nil {
+		parsing.Strict = *config.Discovery.StrictParsing
+	}
+	parsing.KnownEngineArgs = config.Discovery.KnownEngineArgs
+	if config.Discovery.ProfileVerification != nil {
+		parsing.PublicKeys = config.Discovery.ProfileVerification.PublicKeys
+	}
+	return parsing
+}
+
+// Accept splits parsed discovery output into the result to use and the result rejected by
+// profile verification or strict parsing. At most one of the returned values is non-nil.
+func (p DiscoveryParsing) Accept(parsed *ParsedDiscovery) (accepted, rejected *ParsedDiscovery) {
+	if parsed == nil {
+		return nil, nil
+	}
+	if len(p.PublicKeys) > 0 {
+		if err := verifyProfileSignature(parsed.ProfileJSON, parsed.Signature, p.PublicKeys); err != nil {
+			parsed.VerificationError = err
+			return nil, parsed
+		}
+		parsed.Verified = true
+	}
+	if p.Strict && len(parsed.UnknownFields) > 0 {
+		return nil, parsed
+	}
+	return parsed, nil
+}
+
+// GetDiscoveryOutputHealth reports discovery output rejected by strict parsing as an invalid spec,
+// which stops the template from becoming ready and from starting new discovery jobs.
+// Returns an empty ComponentHealth when nothing was rejected.
+func GetDiscoveryOutputHealth(rejected *ParsedDiscovery) controllerutils.ComponentHealth {
+	if rejected == nil {
+		return controllerutils.ComponentHealth{}
+	}
+	if rejected.VerificationError != nil {
+		return controllerutils.ComponentHealth{
+			Component: "DiscoveryOutput",
+			Errors:    []error{rejected.VerificationError},
+		}
+	}
+	message := fmt.Sprintf("Discovery output contains fields not recognized by this controller: %s",
+		strings.Join(rejected.UnknownFields, ", "))
+	return controllerutils.ComponentHealth{
+		Component: "DiscoveryOutput",
+		Errors: []error{controllerutils.NewInvalidSpecError(
+			aimv1alpha1.AIMTemplateReasonUnknownDiscoveryFields, message, nil)},
+	}
+}
+
+// discoveryWarnings returns the unknown fields to record in status for this reconcile.
+// ok is false when no discovery output was parsed, so the existing warnings are kept.
+func discoveryWarnings(parsed ...*ParsedDiscovery) (warnings []string, ok bool) {
+	for _, p := range parsed {
+		if p == nil {
+			continue
+		}
+		warnings = p.UnknownFields
+		if len(warnings) > maxDiscoveryWarnings {
+			warnings = warnings[:maxDiscoveryWarnings]
+		}
+		return warnings, true
+	}
+	return nil, false
+}
+
+// findUnknownDiscoveryFields compares the first result of a discovery JSON array with the
+// fields the controller parses, and returns the paths of unrecognized fields, sorted.
+func findUnknownDiscoveryFields(jsonBytes []byte, knownEngineArgs []string) ([]string, error) {
+	var raw []any
+	if err := json.Unmarshal(jsonBytes, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse discovery JSON for field validation: %w", err)
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	unknown := map[string]struct{}{}
+	collectUnknownFields(raw[0], reflect.TypeOf(discoveryResult{}), "", unknown)
+
+	if len(knownEngineArgs) > 0 {
+		known := make(map[string]struct{}, len(knownEngineArgs))
+		for _, key := range knownEngineArgs {
+			known[key] = struct{}{}
+		}
+		if result, isMap := raw[0].(map[string]any); isMap {
+			if profile, isMap := result["profile"].(map[string]any); isMap {
+				if engineArgs, isMap := profile["engine_args"].(map[string]any); isMap {
+					for key := range engineArgs {
+						if _, found := known[key]; !found {
+							unknown["profile.engine_args."+key] = struct{}{}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	fields := make([]string, 0, len(unknown))
+	for field := range unknown {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// collectUnknownFields walks a decoded JSON value alongside the Go type it is parsed into,
+// recording object keys that have no matching json tag. Maps are free-form and not checked.
+func collectUnknownFields(value any, t reflect.Type, path string, unknown map[string]struct{}) {
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, isMap := value.(map[string]any)
+		if !isMap {
+			return
+		}
+		fields := map[string]reflect.Type{}
+		for i := range t.NumField() {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			fields[name] = t.Field(i).Type
+		}
+		for key, child := range obj {
+			fieldType, found := fields[key]
+			if !found {
+				unknown[path+key] = struct{}{}
+				continue
+			}
+			collectUnknownFields(child, fieldType, path+key+".", unknown)
+		}
+	case reflect.Slice:
+		items, isSlice := value.([]any)
+		if !isSlice {
+			return
+		}
+		prefix := strings.TrimSuffix(path, ".") + "[]."
+		for _, item := range items {
+			collectUnknownFields(item, t.Elem(), prefix, unknown)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func TestFindUnknownDiscoveryFields(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           string
+		knownEngineArgs []string
+		want            []string
+	}{
+		{
+			name:  "all fields known",
+			input: `[{"filename":"p.yaml","profile":{"model":"m","quantized_model":"","metadata":{"engine":"vllm","gpu":"MI300X","gpu_count":1,"metric":"latency","precision":"fp8","type":"optimized"},"engine_args":{"tensor_parallel_size":1},"env_vars":{"A":"1"}},"models":[{"name":"m","source":"hf://m","size_gb":1}]}]`,
+			want:  []string{},
+		},
+		{
+			name:  "unknown fields at every level",
+			input: `[{"filename":"p.yaml","schema_version":2,"profile":{"model":"m","max_batch":8,"metadata":{"engine":"vllm","topology":"xgmi"}},"models":[{"name":"a","checksum":"x"},{"name":"b","checksum":"y"}]}]`,
+			want:  []string{"models[].checksum", "profile.max_batch", "profile.metadata.topology", "schema_version"},
+		},
+		{
+			name:            "engine args checked only against known keys",
+			input:           `[{"profile":{"engine_args":{"tensor_parallel_size":1,"speculative_model":"draft"}}}]`,
+			knownEngineArgs: []string{"tensor_parallel_size"},
+			want:            []string{"profile.engine_args.speculative_model"},
+		},
+		{
+			name:  "only the first result is checked",
+			input: `[{"profile":{}},{"unexpected":true}]`,
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findUnknownDiscoveryFields([]byte(tt.input), tt.knownEngineArgs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("unknown fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveDiscoveryParsing(t *testing.T) {
+	if got := ResolveDiscoveryParsing(nil); got.Strict || len(got.KnownEngineArgs) > 0 {
+		t.Errorf("expected lenient parsing by default, got %+v", got)
+	}
+
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{Discovery: &aimv1alpha1.AIMDiscoveryConfig{
+		StrictParsing:   ptr.To(true),
+		KnownEngineArgs: []string{"tensor_parallel_size"},
+	}}
+	got := ResolveDiscoveryParsing(config)
+	if !got.Strict || !reflect.DeepEqual(got.KnownEngineArgs, []string{"tensor_parallel_size"}) {
+		t.Errorf("unexpected parsing config: %+v", got)
+	}
+}
+
+func TestDiscoveryParsingAccept(t *testing.T) {
+	clean := &ParsedDiscovery{}
+	withUnknown := &ParsedDiscovery{UnknownFields: []string{"profile.max_batch"}}
+
+	lenient := DiscoveryParsing{}
+	if accepted, rejected := lenient.Accept(withUnknown); accepted != withUnknown || rejected != nil {
+		t.Error("lenient parsing should accept output with unknown fields")
+	}
+
+	strict := DiscoveryParsing{Strict: true}
+	if accepted, rejected := strict.Accept(clean); accepted != clean || rejected != nil {
+		t.Error("strict parsing should accept output without unknown fields")
+	}
+	accepted, rejected := strict.Accept(withUnknown)
+	if accepted != nil || rejected != withUnknown {
+		t.Fatal("strict parsing should reject output with unknown fields")
+	}
+
+	health := GetDiscoveryOutputHealth(rejected)
+	if health.Component != "DiscoveryOutput" || len(health.Errors) != 1 {
+		t.Fatalf("unexpected health: %+v", health)
+	}
+	if se := controllerutils.CategorizeError(health.Errors[0]); se.Category() != controllerutils.ErrorCategoryInvalidSpec ||
+		se.Reason() != aimv1alpha1.AIMTemplateReasonUnknownDiscoveryFields {
+		t.Errorf("expected an UnknownDiscoveryFields invalid spec error, got %v", health.Errors[0])
+	}
+	if GetDiscoveryOutputHealth(nil).Component != "" {
+		t.Error("expected no health entry without rejected output")
+	}
+}
+
+func TestDiscoveryWarnings(t *testing.T) {
+	if _, ok := discoveryWarnings(nil, nil); ok {
+		t.Error("expected existing warnings to be kept when nothing was parsed")
+	}
+
+	warnings, ok := discoveryWarnings(nil, &ParsedDiscovery{UnknownFields: []string{"a"}})
+	if !ok || !reflect.DeepEqual(warnings, []string{"a"}) {
+		t.Errorf("warnings = %v, %v", warnings, ok)
+	}
+
+	warnings, ok = discoveryWarnings(&ParsedDiscovery{}, nil)
+	if !ok || len(warnings) != 0 {
+		t.Errorf("expected warnings to be cleared, got %v, %v", warnings, ok)
+	}
+}
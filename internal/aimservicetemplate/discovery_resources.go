@@ -0,0 +1,124 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// Default resources of the discovery container. Discovery only inspects the model and
+// plans a profile, so it needs far less than the inference workload, but loading model
+// configs and tokenizers still needs some headroom.
+var (
+	DefaultDiscoveryCPURequest    = resource.MustParse("1")
+	DefaultDiscoveryMemoryRequest = resource.MustParse("2Gi")
+	DefaultDiscoveryMemoryLimit   = resource.MustParse("8Gi")
+)
+
+// DiscoveryResources holds the resolved resource settings for discovery jobs.
+type DiscoveryResources struct {
+	// Resources are the CPU and memory requests and limits of the discovery container.
+	Resources corev1.ResourceRequirements
+	// RequestGPUs requests the template's GPUs for GPU discovery jobs.
+	RequestGPUs bool
+	// PreferCPU runs discovery in CPU-only mode from the start when the image supports it.
+	PreferCPU bool
+}
+
+// ResolveDiscoveryResources resolves discovery resource settings from the merged runtime config.
+// Resource entries from the config are merged over the defaults.
+func ResolveDiscoveryResources(config *aimv1alpha1.AIMRuntimeConfigCommon) DiscoveryResources {
+	resolved := DiscoveryResources{
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    DefaultDiscoveryCPURequest,
+				corev1.ResourceMemory: DefaultDiscoveryMemoryRequest,
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: DefaultDiscoveryMemoryLimit,
+			},
+		},
+		RequestGPUs: true,
+	}
+	if config == nil || config.Discovery == nil {
+		return resolved
+	}
+
+	discovery := config.Discovery
+	if discovery.RequestGPUs != nil {
+		resolved.RequestGPUs = *discovery.RequestGPUs
+	}
+	if discovery.PreferCPU != nil {
+		resolved.PreferCPU = *discovery.PreferCPU
+	}
+	if discovery.Resources != nil {
+		for name, quantity := range discovery.Resources.Requests {
+			resolved.Resources.Requests[name] = quantity
+		}
+		for name, quantity := range discovery.Resources.Limits {
+			resolved.Resources.Limits[name] = quantity
+		}
+	}
+	return resolved
+}
+
+// UseCPUDiscovery returns true if discovery for the template should run in CPU-only mode
+// from the start: either the template itself is CPU-only, or CPU discovery is preferred
+// and the image supports it.
+func (r DiscoveryResources) UseCPUDiscovery(
+	spec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+	metadata *aimv1alpha1.ImageMetadata,
+) bool {
+	return spec.GetCompute() == aimv1alpha1.AIMComputeModeCPU ||
+		(r.PreferCPU && ImageSupportsCPUDiscovery(metadata))
+}
+
+// discoveryContainerResources builds the resources of the discovery container.
+// GPU discovery jobs request the GPUs declared in the template spec (as both requests and
+// limits, which extended resources require); CPU-only jobs never request GPUs.
+func discoveryContainerResources(spec DiscoveryJobSpec) corev1.ResourceRequirements {
+	resources := *spec.Resources.DeepCopy()
+	gpu := spec.TemplateSpec.Hardware
+	if spec.CPUOnly || !spec.RequestGPUs || gpu == nil || gpu.GPU == nil || gpu.GPU.Requests <= 0 {
+		return resources
+	}
+
+	resourceName := corev1.ResourceName(constants.DefaultGPUResourceName)
+	if gpu.GPU.ResourceName != "" {
+		resourceName = corev1.ResourceName(gpu.GPU.ResourceName)
+	}
+	count := *resource.NewQuantity(int64(gpu.GPU.Requests), resource.DecimalSI)
+	if resources.Requests == nil {
+		resources.Requests = corev1.ResourceList{}
+	}
+	if resources.Limits == nil {
+		resources.Limits = corev1.ResourceList{}
+	}
+	resources.Requests[resourceName] = count
+	resources.Limits[resourceName] = count
+	return resources
+}
@@ -0,0 +1,150 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func TestResolveDiscoveryResources(t *testing.T) {
+	defaults := ResolveDiscoveryResources(nil)
+	if !defaults.RequestGPUs || defaults.PreferCPU {
+		t.Errorf("expected GPU requests on and CPU preference off by default, got %+v", defaults)
+	}
+	if got := defaults.Resources.Requests[corev1.ResourceMemory]; got.Cmp(DefaultDiscoveryMemoryRequest) != 0 {
+		t.Errorf("expected default memory request %s, got %s", DefaultDiscoveryMemoryRequest.String(), got.String())
+	}
+
+	resolved := ResolveDiscoveryResources(&aimv1alpha1.AIMRuntimeConfigCommon{
+		Discovery: &aimv1alpha1.AIMDiscoveryConfig{
+			RequestGPUs: ptr.To(false),
+			PreferCPU:   ptr.To(true),
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("16Gi")},
+			},
+		},
+	})
+	if resolved.RequestGPUs || !resolved.PreferCPU {
+		t.Errorf("expected configured flags, got %+v", resolved)
+	}
+	if got := resolved.Resources.Requests[corev1.ResourceMemory]; got.Cmp(resource.MustParse("16Gi")) != 0 {
+		t.Errorf("expected overridden memory request 16Gi, got %s", got.String())
+	}
+	if got := resolved.Resources.Requests[corev1.ResourceCPU]; got.Cmp(DefaultDiscoveryCPURequest) != 0 {
+		t.Errorf("expected default CPU request to be kept, got %s", got.String())
+	}
+
+	// Resolving again must not see the previous override
+	if got := ResolveDiscoveryResources(nil).Resources.Requests[corev1.ResourceMemory]; got.Cmp(DefaultDiscoveryMemoryRequest) != 0 {
+		t.Errorf("expected defaults to be unchanged, got %s", got.String())
+	}
+}
+
+func TestBuildDiscoveryJob_Resources(t *testing.T) {
+	gpuName := corev1.ResourceName(constants.DefaultGPUResourceName)
+	spec := DiscoveryJobSpec{
+		TemplateName: "tmpl",
+		Namespace:    "default",
+		ModelID:      "m",
+		Image:        "img",
+		Resources:    ResolveDiscoveryResources(nil).Resources,
+		RequestGPUs:  true,
+	}
+	spec.TemplateSpec.Hardware = &aimv1alpha1.AIMHardwareRequirements{
+		GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 2},
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(*DiscoveryJobSpec)
+		wantGPUs int64
+	}{
+		{name: "GPU job requests template GPUs", wantGPUs: 2},
+		{name: "CPU-only job requests no GPUs", modify: func(s *DiscoveryJobSpec) { s.CPUOnly = true }},
+		{name: "GPU requests disabled", modify: func(s *DiscoveryJobSpec) { s.RequestGPUs = false }},
+		{name: "template without GPUs", modify: func(s *DiscoveryJobSpec) {
+			s.TemplateSpec = aimv1alpha1.AIMServiceTemplateSpecCommon{}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := spec
+			if tt.modify != nil {
+				tt.modify(&s)
+			}
+			resources := BuildDiscoveryJob(s).Spec.Template.Spec.Containers[0].Resources
+
+			if got := resources.Requests[corev1.ResourceCPU]; got.Cmp(DefaultDiscoveryCPURequest) != 0 {
+				t.Errorf("expected CPU request %s, got %s", DefaultDiscoveryCPURequest.String(), got.String())
+			}
+			gpuRequest := resources.Requests[gpuName]
+			gpuLimit := resources.Limits[gpuName]
+			if gpuRequest.Value() != tt.wantGPUs || gpuLimit.Value() != tt.wantGPUs {
+				t.Errorf("expected %d GPUs requested and limited, got %s/%s", tt.wantGPUs, gpuRequest.String(), gpuLimit.String())
+			}
+		})
+	}
+
+	// The shared defaults must not pick up GPU entries from building a job
+	if _, ok := spec.Resources.Requests[gpuName]; ok {
+		t.Error("expected building the job not to modify the spec resources")
+	}
+}
+
+func TestBuildDiscoveryJob_ResourcesChangeName(t *testing.T) {
+	spec := DiscoveryJobSpec{TemplateName: "tmpl", Namespace: "default", ModelID: "m", Image: "img"}
+	before := BuildDiscoveryJob(spec)
+	spec.Resources = ResolveDiscoveryResources(nil).Resources
+	after := BuildDiscoveryJob(spec)
+
+	if before.Name == after.Name {
+		t.Error("expected a resource change to produce a new job name")
+	}
+}
+
+func TestUseCPUDiscovery(t *testing.T) {
+	gpuSpec := &aimv1alpha1.AIMServiceTemplateSpecCommon{}
+	cpuSpec := &aimv1alpha1.AIMServiceTemplateSpecCommon{Compute: aimv1alpha1.AIMComputeModeCPU}
+	prefer := DiscoveryResources{PreferCPU: true}
+
+	if (DiscoveryResources{}).UseCPUDiscovery(gpuSpec, cpuDiscoveryImageMetadata()) {
+		t.Error("expected GPU discovery without CPU preference")
+	}
+	if !(DiscoveryResources{}).UseCPUDiscovery(cpuSpec, nil) {
+		t.Error("expected CPU discovery for CPU-only templates")
+	}
+	if !prefer.UseCPUDiscovery(gpuSpec, cpuDiscoveryImageMetadata()) {
+		t.Error("expected CPU discovery when preferred and supported by the image")
+	}
+	if prefer.UseCPUDiscovery(gpuSpec, nil) {
+		t.Error("expected GPU discovery when the image does not support CPU discovery")
+	}
+}
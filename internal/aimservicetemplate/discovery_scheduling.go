@@ -0,0 +1,178 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// DefaultDiscoverySchedulingTimeout is how long a discovery pod may stay unschedulable
+// before the CPU-only fallback is attempted.
+const DefaultDiscoverySchedulingTimeout = 5 * time.Minute
+
+// DiscoveryScheduling holds the resolved settings for handling unschedulable discovery jobs.
+type DiscoveryScheduling struct {
+	Timeout     time.Duration
+	CPUFallback bool
+}
+
+// ResolveDiscoveryScheduling resolves scheduling settings from the merged runtime config,
+// falling back to defaults for anything not set.
+func ResolveDiscoveryScheduling(config *aimv1alpha1.AIMRuntimeConfigCommon) DiscoveryScheduling {
+	scheduling := DiscoveryScheduling{Timeout: DefaultDiscoverySchedulingTimeout}
+	if config == nil || config.Discovery == nil {
+		return scheduling
+	}
+	if config.Discovery.SchedulingTimeout != nil {
+		scheduling.Timeout = config.Discovery.SchedulingTimeout.Duration
+	}
+	if config.Discovery.CPUFallback != nil {
+		scheduling.CPUFallback = *config.Discovery.CPUFallback
+	}
+	return scheduling
+}
+
+// DiscoverySchedulingBlock describes a discovery pod the scheduler could not place.
+type DiscoverySchedulingBlock struct {
+	PodName string
+	Since   time.Time
+	Message string
+}
+
+// GetDiscoverySchedulingBlock returns the first pending pod whose PodScheduled condition
+// reports Unschedulable, or nil if no pod is blocked.
+func GetDiscoverySchedulingBlock(pods *corev1.PodList) *DiscoverySchedulingBlock {
+	if pods == nil {
+		return nil
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type != corev1.PodScheduled || condition.Status != corev1.ConditionFalse ||
+				condition.Reason != corev1.PodReasonUnschedulable {
+				continue
+			}
+			since := condition.LastTransitionTime.Time
+			if since.IsZero() {
+				since = pod.CreationTimestamp.Time
+			}
+			return &DiscoverySchedulingBlock{
+				PodName: pod.Name,
+				Since:   since,
+				Message: condition.Message,
+			}
+		}
+	}
+	return nil
+}
+
+// IsCPUOnlyDiscoveryJob returns true if the job runs discovery in CPU-only mode.
+func IsCPUOnlyDiscoveryJob(job *batchv1.Job) bool {
+	return job != nil && job.Labels[constants.LabelKeyDiscoveryDevice] == constants.LabelValueDiscoveryDeviceCPU
+}
+
+// ImageSupportsCPUDiscovery returns true if the image metadata declares CPU-only discovery support.
+func ImageSupportsCPUDiscovery(metadata *aimv1alpha1.ImageMetadata) bool {
+	if metadata == nil {
+		return false
+	}
+	supported, err := strconv.ParseBool(metadata.OriginalLabels[constants.ImageLabelDiscoveryCPU])
+	return err == nil && supported
+}
+
+// PlanDiscoveryCPUFallback replaces an unschedulable discovery job with a CPU-only variant
+// once it has been blocked for longer than the scheduling timeout. The blocked job is deleted
+// so that the replacement does not count twice against the concurrent discovery limit.
+// Returns how long until the fallback becomes due, or 0 if there is nothing to wait for.
+func PlanDiscoveryCPUFallback(
+	planResult *controllerutils.PlanResult,
+	job *batchv1.Job,
+	pods *corev1.PodList,
+	scheduling DiscoveryScheduling,
+	metadata *aimv1alpha1.ImageMetadata,
+	jobSpec DiscoveryJobSpec,
+	now time.Time,
+) time.Duration {
+	if !scheduling.CPUFallback || job == nil || IsCPUOnlyDiscoveryJob(job) || !ImageSupportsCPUDiscovery(metadata) {
+		return 0
+	}
+
+	block := GetDiscoverySchedulingBlock(pods)
+	if block == nil {
+		return 0
+	}
+
+	if remaining := block.Since.Add(scheduling.Timeout).Sub(now); remaining > 0 {
+		return remaining
+	}
+
+	jobSpec.CPUOnly = true
+	planResult.Apply(BuildDiscoveryJob(jobSpec))
+	planResult.Delete(job)
+	return 0
+}
+
+// setDiscoverySchedulingCondition reports whether the active discovery pod is blocked by the scheduler.
+// The condition is removed once there is no discovery pod to report on.
+func setDiscoverySchedulingCondition(
+	cm *controllerutils.ConditionManager,
+	spec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+	discoveryJob controllerutils.FetchResult[*batchv1.Job],
+	discoveryJobPods controllerutils.FetchResult[*corev1.PodList],
+) {
+	if !discoveryJob.OK() || discoveryJob.Value == nil || IsJobComplete(discoveryJob.Value) ||
+		!discoveryJobPods.OK() || discoveryJobPods.Value == nil || len(discoveryJobPods.Value.Items) == 0 {
+		cm.Delete(aimv1alpha1.AIMTemplateDiscoverySchedulingBlockedConditionType)
+		return
+	}
+
+	if block := GetDiscoverySchedulingBlock(discoveryJobPods.Value); block != nil {
+		cm.MarkTrue(aimv1alpha1.AIMTemplateDiscoverySchedulingBlockedConditionType,
+			aimv1alpha1.AIMTemplateReasonDiscoveryUnschedulable,
+			fmt.Sprintf("Discovery pod %s is unschedulable: %s", block.PodName, block.Message))
+		return
+	}
+
+	// CPU-mode templates always run CPU-only discovery; only report a fallback for GPU templates
+	if IsCPUOnlyDiscoveryJob(discoveryJob.Value) && spec.GetCompute() != aimv1alpha1.AIMComputeModeCPU {
+		cm.MarkFalse(aimv1alpha1.AIMTemplateDiscoverySchedulingBlockedConditionType,
+			aimv1alpha1.AIMTemplateReasonDiscoveryCPUFallback,
+			"Discovery is running in CPU-only mode after the GPU discovery job could not be scheduled")
+		return
+	}
+
+	cm.MarkFalse(aimv1alpha1.AIMTemplateDiscoverySchedulingBlockedConditionType,
+		aimv1alpha1.AIMTemplateReasonDiscoveryScheduled, "Discovery pod has been scheduled")
+}
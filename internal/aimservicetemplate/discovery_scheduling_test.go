@@ -0,0 +1,223 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const schedulerMessage = "0/3 nodes are available: 3 Insufficient amd.com/gpu."
+
+func newUnschedulablePod(name string, since time.Time) corev1.Pod {
+	pod := newDiscoveryPod(name, "discover-job")
+	pod.Status = corev1.PodStatus{
+		Phase: corev1.PodPending,
+		Conditions: []corev1.PodCondition{{
+			Type:               corev1.PodScheduled,
+			Status:             corev1.ConditionFalse,
+			Reason:             corev1.PodReasonUnschedulable,
+			Message:            schedulerMessage,
+			LastTransitionTime: metav1.NewTime(since),
+		}},
+	}
+	return pod
+}
+
+func cpuDiscoveryImageMetadata() *aimv1alpha1.ImageMetadata {
+	return &aimv1alpha1.ImageMetadata{
+		OriginalLabels: map[string]string{constants.ImageLabelDiscoveryCPU: "true"},
+	}
+}
+
+func TestResolveDiscoveryScheduling(t *testing.T) {
+	defaults := ResolveDiscoveryScheduling(nil)
+	if defaults.Timeout != DefaultDiscoverySchedulingTimeout || defaults.CPUFallback {
+		t.Errorf("expected defaults, got %+v", defaults)
+	}
+
+	resolved := ResolveDiscoveryScheduling(&aimv1alpha1.AIMRuntimeConfigCommon{
+		Discovery: &aimv1alpha1.AIMDiscoveryConfig{
+			SchedulingTimeout: &metav1.Duration{Duration: time.Minute},
+			CPUFallback:       ptr.To(true),
+		},
+	})
+	if resolved.Timeout != time.Minute || !resolved.CPUFallback {
+		t.Errorf("expected configured values, got %+v", resolved)
+	}
+}
+
+func TestGetDiscoverySchedulingBlock(t *testing.T) {
+	since := time.Now().Add(-time.Minute)
+	running := newDiscoveryPod("running", "discover-job")
+	running.Status.Phase = corev1.PodRunning
+
+	if block := GetDiscoverySchedulingBlock(&corev1.PodList{Items: []corev1.Pod{running}}); block != nil {
+		t.Errorf("expected no block for running pod, got %+v", block)
+	}
+
+	block := GetDiscoverySchedulingBlock(&corev1.PodList{Items: []corev1.Pod{running, newUnschedulablePod("blocked", since)}})
+	if block == nil {
+		t.Fatal("expected unschedulable pod to be detected")
+	}
+	if block.PodName != "blocked" || block.Message != schedulerMessage || !block.Since.Equal(metav1.NewTime(since).Time) {
+		t.Errorf("unexpected block %+v", block)
+	}
+}
+
+func TestBuildDiscoveryJob_CPUOnly(t *testing.T) {
+	spec := DiscoveryJobSpec{TemplateName: "tmpl", Namespace: "default", ModelID: "m", Image: "img"}
+	gpuJob := BuildDiscoveryJob(spec)
+	spec.CPUOnly = true
+	cpuJob := BuildDiscoveryJob(spec)
+
+	if gpuJob.Name == cpuJob.Name {
+		t.Error("expected CPU-only job to have a distinct name")
+	}
+	if IsCPUOnlyDiscoveryJob(gpuJob) || !IsCPUOnlyDiscoveryJob(cpuJob) {
+		t.Error("expected only the CPU-only job to carry the device label")
+	}
+
+	found := false
+	for _, env := range cpuJob.Spec.Template.Spec.Containers[0].Env {
+		if env.Name == constants.EnvAIMDiscoveryDevice && env.Value == constants.LabelValueDiscoveryDeviceCPU {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected %s=cpu in CPU-only job env", constants.EnvAIMDiscoveryDevice)
+	}
+}
+
+func TestPlanDiscoveryCPUFallback(t *testing.T) {
+	now := time.Now()
+	scheduling := DiscoveryScheduling{Timeout: 5 * time.Minute, CPUFallback: true}
+	jobSpec := DiscoveryJobSpec{TemplateName: "tmpl", Namespace: "default", ModelID: "m", Image: "img"}
+	job := BuildDiscoveryJob(jobSpec)
+
+	tests := []struct {
+		name        string
+		scheduling  DiscoveryScheduling
+		job         *batchv1.Job
+		blockedFor  time.Duration
+		metadata    *aimv1alpha1.ImageMetadata
+		wantReplace bool
+		wantWait    bool
+	}{
+		{
+			name:        "blocked past timeout falls back",
+			scheduling:  scheduling,
+			job:         job,
+			blockedFor:  10 * time.Minute,
+			metadata:    cpuDiscoveryImageMetadata(),
+			wantReplace: true,
+		},
+		{
+			name:       "blocked within timeout waits",
+			scheduling: scheduling,
+			job:        job,
+			blockedFor: time.Minute,
+			metadata:   cpuDiscoveryImageMetadata(),
+			wantWait:   true,
+		},
+		{
+			name:       "fallback disabled",
+			scheduling: DiscoveryScheduling{Timeout: 5 * time.Minute},
+			job:        job,
+			blockedFor: 10 * time.Minute,
+			metadata:   cpuDiscoveryImageMetadata(),
+		},
+		{
+			name:       "image without CPU support",
+			scheduling: scheduling,
+			job:        job,
+			blockedFor: 10 * time.Minute,
+		},
+		{
+			name:       "already CPU-only",
+			scheduling: scheduling,
+			job:        BuildDiscoveryJob(DiscoveryJobSpec{TemplateName: "tmpl", Namespace: "default", CPUOnly: true}),
+			blockedFor: 10 * time.Minute,
+			metadata:   cpuDiscoveryImageMetadata(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pods := &corev1.PodList{Items: []corev1.Pod{newUnschedulablePod("blocked", now.Add(-tt.blockedFor))}}
+			planResult := controllerutils.PlanResult{}
+
+			wait := PlanDiscoveryCPUFallback(&planResult, tt.job, pods, tt.scheduling, tt.metadata, jobSpec, now)
+
+			if (wait > 0) != tt.wantWait {
+				t.Errorf("wait = %s, wantWait %v", wait, tt.wantWait)
+			}
+			if !tt.wantReplace {
+				if len(planResult.GetToApply()) != 0 || len(planResult.GetToDelete()) != 0 {
+					t.Errorf("expected no changes, got %d apply / %d delete",
+						len(planResult.GetToApply()), len(planResult.GetToDelete()))
+				}
+				return
+			}
+			if len(planResult.GetToApply()) != 1 || !IsCPUOnlyDiscoveryJob(planResult.GetToApply()[0].(*batchv1.Job)) {
+				t.Errorf("expected a CPU-only job to be applied, got %v", planResult.GetToApply())
+			}
+			if len(planResult.GetToDelete()) != 1 || planResult.GetToDelete()[0].GetName() != job.Name {
+				t.Errorf("expected blocked job to be deleted, got %v", planResult.GetToDelete())
+			}
+		})
+	}
+}
+
+func TestSetDiscoverySchedulingCondition(t *testing.T) {
+	job := controllerutils.FetchResult[*batchv1.Job]{Value: BuildDiscoveryJob(DiscoveryJobSpec{TemplateName: "tmpl"})}
+	blocked := controllerutils.FetchResult[*corev1.PodList]{
+		Value: &corev1.PodList{Items: []corev1.Pod{newUnschedulablePod("blocked", time.Now())}},
+	}
+
+	cm := controllerutils.NewConditionManager(nil)
+	setDiscoverySchedulingCondition(cm, &aimv1alpha1.AIMServiceTemplateSpecCommon{}, job, blocked)
+	cond := cm.Get(aimv1alpha1.AIMTemplateDiscoverySchedulingBlockedConditionType)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != aimv1alpha1.AIMTemplateReasonDiscoveryUnschedulable {
+		t.Fatalf("expected blocked condition, got %+v", cond)
+	}
+	if !strings.Contains(cond.Message, schedulerMessage) {
+		t.Errorf("expected scheduler message in condition, got %q", cond.Message)
+	}
+
+	// Once there are no pods to report on, the condition is removed
+	setDiscoverySchedulingCondition(cm, &aimv1alpha1.AIMServiceTemplateSpecCommon{}, job, controllerutils.FetchResult[*corev1.PodList]{})
+	if cm.Get(aimv1alpha1.AIMTemplateDiscoverySchedulingBlockedConditionType) != nil {
+		t.Error("expected condition to be removed")
+	}
+}
@@ -33,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
 // ============================================================================
@@ -813,6 +814,12 @@ func TestBuildDiscoveryJob(t *testing.T) {
 					t.Errorf("label %q = %q, want %q", key, got, want)
 				}
 			}
+			// The managed-by label lets the manager cache be filtered to operator workloads
+			for _, labels := range []map[string]string{job.Labels, job.Spec.Template.Labels} {
+				if got := labels[constants.LabelKeyManagedBy]; got != constants.LabelValueManagedBy {
+					t.Errorf("label %q = %q, want %q", constants.LabelKeyManagedBy, got, constants.LabelValueManagedBy)
+				}
+			}
 
 			// Check owner references
 			if tt.wantOwnerRef {
@@ -904,6 +911,30 @@ func TestBuildDiscoveryJob_DifferentForDifferentSpecs(t *testing.T) {
 	}
 }
 
+func TestBuildDiscoveryJob_Scheduling(t *testing.T) {
+	spec := DiscoveryJobSpec{
+		TemplateName: "my-template",
+		Namespace:    "default",
+		ModelID:      "test-model",
+		Image:        "ghcr.io/test/image:latest",
+	}
+	unscheduled := BuildDiscoveryJob(spec)
+
+	spec.Scheduling = &aimv1alpha1.AIMSchedulingConfig{
+		NodeSelector: map[string]string{"pool": "gpu"},
+		Tolerations:  []corev1.Toleration{{Key: "amd.com/gpu", Operator: corev1.TolerationOpExists}},
+	}
+	scheduled := BuildDiscoveryJob(spec)
+
+	podSpec := scheduled.Spec.Template.Spec
+	if podSpec.NodeSelector["pool"] != "gpu" || len(podSpec.Tolerations) != 1 {
+		t.Errorf("expected scheduling on discovery pod, got nodeSelector=%v tolerations=%v", podSpec.NodeSelector, podSpec.Tolerations)
+	}
+	if scheduled.Name == unscheduled.Name {
+		t.Error("expected scheduling to change the job name")
+	}
+}
+
 // Helper function for creating pointers
 func ptrTo[T any](v T) *T {
 	return &v
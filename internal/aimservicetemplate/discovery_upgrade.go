@@ -0,0 +1,184 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// discoveryBuilderHash fingerprints the discovery job builder by building a job for a fixed
+// spec that exercises every optional field. Any change in how this controller builds discovery
+// jobs (image arguments, env vars, security context, ...) changes the fingerprint.
+var discoveryBuilderHash = sync.OnceValue(func() string {
+	job := newDiscoveryJob(DiscoveryJobSpec{
+		TemplateName:     "fingerprint",
+		Namespace:        "fingerprint",
+		ModelID:          "fingerprint",
+		Image:            "fingerprint",
+		Env:              []corev1.EnvVar{{Name: "FINGERPRINT", Value: "fingerprint"}},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "fingerprint"}},
+		ServiceAccount:   "fingerprint",
+		TemplateSpec: aimv1alpha1.AIMServiceTemplateSpecCommon{
+			AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
+				Metric:    ptr.To(aimv1alpha1.AIMMetricLatency),
+				Precision: ptr.To(aimv1alpha1.AIMPrecisionFP8),
+				Hardware: &aimv1alpha1.AIMHardwareRequirements{
+					GPU: &aimv1alpha1.AIMGpuRequirements{Model: "fingerprint", Requests: 1},
+				},
+			},
+			ProfileId: "fingerprint",
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+		RequestGPUs: true,
+	}, "fingerprint")
+
+	data, _ := json.Marshal(struct {
+		Labels map[string]string `json:"labels"`
+		Spec   batchv1.JobSpec   `json:"spec"`
+	}{job.Labels, job.Spec})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16]
+})
+
+// DiscoveryBuilderHash returns the fingerprint of the running controller's discovery job builder.
+func DiscoveryBuilderHash() string {
+	return discoveryBuilderHash()
+}
+
+// currentDiscoveryBuilder identifies the running controller as the producer of discovery results.
+func currentDiscoveryBuilder() *aimv1alpha1.AIMDiscoveryBuilder {
+	return &aimv1alpha1.AIMDiscoveryBuilder{
+		ControllerVersion: utils.OperatorVersion(),
+		BuilderHash:       DiscoveryBuilderHash(),
+	}
+}
+
+// discoveryBuilderFromJob returns the controller that built the discovery job, or nil for
+// jobs created before builders were recorded.
+func discoveryBuilderFromJob(job *batchv1.Job) *aimv1alpha1.AIMDiscoveryBuilder {
+	hash := job.Annotations[constants.AnnotationDiscoveryBuilderHash]
+	if hash == "" {
+		return nil
+	}
+	return &aimv1alpha1.AIMDiscoveryBuilder{
+		ControllerVersion: job.Annotations[constants.AnnotationDiscoveryControllerVersion],
+		BuilderHash:       hash,
+	}
+}
+
+// IsCurrentDiscoveryJob returns true if the job was built by the running controller's builder.
+func IsCurrentDiscoveryJob(job *batchv1.Job) bool {
+	return job.Annotations[constants.AnnotationDiscoveryBuilderHash] == DiscoveryBuilderHash()
+}
+
+// IsDiscoverySuperseded returns true if the template is ready with discovery results produced
+// by a different discovery job builder than the running controller's, so discovery must run again.
+// Results recorded without a builder hash predate builder tracking and are not re-run.
+func IsDiscoverySuperseded(status *aimv1alpha1.AIMServiceTemplateStatus) bool {
+	if status.Status != constants.AIMStatusReady || status.DiscoveredBy == nil {
+		return false
+	}
+	return status.DiscoveredBy.BuilderHash != "" && status.DiscoveredBy.BuilderHash != DiscoveryBuilderHash()
+}
+
+// currentDiscoveryJob drops a fetched discovery job built by an older builder, so that
+// re-discovery plans a new job instead of reusing the job that produced the superseded results.
+func currentDiscoveryJob(jobResult controllerutils.FetchResult[*batchv1.Job]) controllerutils.FetchResult[*batchv1.Job] {
+	if jobResult.OK() && jobResult.Value != nil && !IsCurrentDiscoveryJob(jobResult.Value) {
+		return controllerutils.FetchResult[*batchv1.Job]{}
+	}
+	return jobResult
+}
+
+// setDiscoveryBuilderStatus records which controller produced the discovery results and manages
+// the DiscoverySuperseded condition. While superseded, the previous results stay in status and
+// are only replaced once a job from the current builder has succeeded.
+func setDiscoveryBuilderStatus(
+	status *aimv1alpha1.AIMServiceTemplateStatus,
+	cm *controllerutils.ConditionManager,
+	spec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+	discoveryJobResult controllerutils.FetchResult[*batchv1.Job],
+	parsedDiscovery *ParsedDiscovery,
+	gpuResources map[string]utils.GPUResourceInfo,
+) {
+	// Inline model sources are never discovered
+	if len(spec.ModelSources) > 0 {
+		status.DiscoveredBy = nil
+		cm.Delete(aimv1alpha1.AIMTemplateDiscoverySupersededConditionType)
+		return
+	}
+
+	superseded := IsDiscoverySuperseded(status)
+	var job *batchv1.Job
+	if discoveryJobResult.OK() {
+		job = discoveryJobResult.Value
+	}
+
+	if parsedDiscovery != nil && job != nil && (!superseded || IsCurrentDiscoveryJob(job)) {
+		if superseded {
+			// The Discovered condition is already True, so the common decoration keeps the
+			// previous results. Replace them with the results of the re-run.
+			applyParsedDiscovery(status, spec, parsedDiscovery, gpuResources)
+			status.DiscoveryJob = &aimv1alpha1.AIMResolvedReference{Name: job.Name, Namespace: job.Namespace}
+		}
+		status.DiscoveredBy = discoveryBuilderFromJob(job)
+		cm.Delete(aimv1alpha1.AIMTemplateDiscoverySupersededConditionType)
+		return
+	}
+
+	// Adopt results that predate builder tracking instead of re-running discovery for them
+	if status.DiscoveredBy == nil && status.Status == constants.AIMStatusReady {
+		status.DiscoveredBy = currentDiscoveryBuilder()
+	}
+
+	if !superseded {
+		cm.Delete(aimv1alpha1.AIMTemplateDiscoverySupersededConditionType)
+		return
+	}
+
+	previous := status.DiscoveredBy.ControllerVersion
+	if job != nil && IsJobFailed(job) {
+		cm.MarkTrue(aimv1alpha1.AIMTemplateDiscoverySupersededConditionType, aimv1alpha1.AIMTemplateReasonRediscoveryFailed,
+			fmt.Sprintf("Re-running discovery failed (job %s); keeping results from controller %s", job.Name, previous))
+		return
+	}
+	cm.MarkTrue(aimv1alpha1.AIMTemplateDiscoverySupersededConditionType, aimv1alpha1.AIMTemplateReasonDiscoveryBuilderChanged,
+		fmt.Sprintf("Discovery results were produced by controller %s; re-running discovery for controller %s",
+			previous, utils.OperatorVersion()))
+}
@@ -0,0 +1,208 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func newBuilderDiscoveryJob(name, builderHash string, conditions ...batchv1.JobCondition) *batchv1.Job {
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	if builderHash != "" {
+		job.Annotations = map[string]string{
+			constants.AnnotationDiscoveryBuilderHash:       builderHash,
+			constants.AnnotationDiscoveryControllerVersion: "v-job",
+		}
+	}
+	job.Status.Conditions = conditions
+	return job
+}
+
+func TestBuildDiscoveryJob_RecordsBuilder(t *testing.T) {
+	job := BuildDiscoveryJob(DiscoveryJobSpec{TemplateName: "tpl", Namespace: "default", ModelID: "m", Image: "img"})
+
+	if got := job.Annotations[constants.AnnotationDiscoveryBuilderHash]; got != DiscoveryBuilderHash() {
+		t.Errorf("builder hash annotation = %q, want %q", got, DiscoveryBuilderHash())
+	}
+	if job.Annotations[constants.AnnotationDiscoveryControllerVersion] == "" {
+		t.Error("expected controller version annotation")
+	}
+	if !IsCurrentDiscoveryJob(job) {
+		t.Error("expected job to be built by the current builder")
+	}
+	if len(DiscoveryBuilderHash()) != 16 {
+		t.Errorf("unexpected builder hash %q", DiscoveryBuilderHash())
+	}
+}
+
+func TestIsDiscoverySuperseded(t *testing.T) {
+	tests := []struct {
+		name   string
+		status aimv1alpha1.AIMServiceTemplateStatus
+		want   bool
+	}{
+		{
+			name:   "ready with current builder",
+			status: aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady, DiscoveredBy: currentDiscoveryBuilder()},
+			want:   false,
+		},
+		{
+			name: "ready with older builder",
+			status: aimv1alpha1.AIMServiceTemplateStatus{
+				Status:       constants.AIMStatusReady,
+				DiscoveredBy: &aimv1alpha1.AIMDiscoveryBuilder{ControllerVersion: "v0", BuilderHash: "old"},
+			},
+			want: true,
+		},
+		{
+			name:   "ready without recorded builder",
+			status: aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady},
+			want:   false,
+		},
+		{
+			name: "not ready with older builder",
+			status: aimv1alpha1.AIMServiceTemplateStatus{
+				Status:       constants.AIMStatusProgressing,
+				DiscoveredBy: &aimv1alpha1.AIMDiscoveryBuilder{BuilderHash: "old"},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDiscoverySuperseded(&tt.status); got != tt.want {
+				t.Errorf("IsDiscoverySuperseded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrentDiscoveryJob(t *testing.T) {
+	stale := controllerutils.FetchResult[*batchv1.Job]{Value: newBuilderDiscoveryJob("old", "old")}
+	if got := currentDiscoveryJob(stale); got.Value != nil {
+		t.Errorf("expected job from older builder to be dropped, got %s", got.Value.Name)
+	}
+
+	current := controllerutils.FetchResult[*batchv1.Job]{Value: newBuilderDiscoveryJob("new", DiscoveryBuilderHash())}
+	if got := currentDiscoveryJob(current); got.Value == nil || got.Value.Name != "new" {
+		t.Error("expected job from current builder to be kept")
+	}
+}
+
+func TestSetDiscoveryBuilderStatus(t *testing.T) {
+	succeeded := batchv1.JobCondition{Type: batchv1.JobComplete, Status: "True"}
+	failed := batchv1.JobCondition{Type: batchv1.JobFailed, Status: "True"}
+	parsed := &ParsedDiscovery{
+		ModelSources: []aimv1alpha1.AIMModelSource{{ModelID: "new-model"}},
+		Profile:      &aimv1alpha1.AIMProfile{Metadata: aimv1alpha1.AIMProfileMetadata{Engine: "vllm"}},
+	}
+	oldBuilder := &aimv1alpha1.AIMDiscoveryBuilder{ControllerVersion: "v0", BuilderHash: "old"}
+
+	tests := []struct {
+		name            string
+		status          aimv1alpha1.AIMServiceTemplateStatus
+		job             *batchv1.Job
+		parsed          *ParsedDiscovery
+		wantBuilderHash string
+		wantReason      string // empty means the condition is absent
+		wantModel       string
+	}{
+		{
+			name:            "superseded results are kept while re-running",
+			status:          aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady, DiscoveredBy: oldBuilder},
+			wantBuilderHash: "old",
+			wantReason:      aimv1alpha1.AIMTemplateReasonDiscoveryBuilderChanged,
+			wantModel:       "old-model",
+		},
+		{
+			name:            "failed re-run keeps previous results",
+			status:          aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady, DiscoveredBy: oldBuilder},
+			job:             newBuilderDiscoveryJob("new", DiscoveryBuilderHash(), failed),
+			wantBuilderHash: "old",
+			wantReason:      aimv1alpha1.AIMTemplateReasonRediscoveryFailed,
+			wantModel:       "old-model",
+		},
+		{
+			name:            "successful re-run replaces results",
+			status:          aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady, DiscoveredBy: oldBuilder},
+			job:             newBuilderDiscoveryJob("new", DiscoveryBuilderHash(), succeeded),
+			parsed:          parsed,
+			wantBuilderHash: DiscoveryBuilderHash(),
+			wantModel:       "new-model",
+		},
+		{
+			name:            "first discovery records the job's builder",
+			status:          aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusProgressing},
+			job:             newBuilderDiscoveryJob("first", "job-builder", succeeded),
+			parsed:          parsed,
+			wantBuilderHash: "job-builder",
+			wantModel:       "old-model",
+		},
+		{
+			name:            "ready results without builder are adopted",
+			status:          aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady},
+			wantBuilderHash: DiscoveryBuilderHash(),
+			wantModel:       "old-model",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			status.ModelSources = []aimv1alpha1.AIMModelSource{{ModelID: "old-model"}}
+			cm := controllerutils.NewConditionManager(nil)
+			spec := &aimv1alpha1.AIMServiceTemplateSpecCommon{}
+
+			setDiscoveryBuilderStatus(&status, cm, spec,
+				controllerutils.FetchResult[*batchv1.Job]{Value: tt.job}, tt.parsed, nil)
+
+			gotHash := ""
+			if status.DiscoveredBy != nil {
+				gotHash = status.DiscoveredBy.BuilderHash
+			}
+			if gotHash != tt.wantBuilderHash {
+				t.Errorf("builder hash = %q, want %q", gotHash, tt.wantBuilderHash)
+			}
+
+			cond := cm.Get(aimv1alpha1.AIMTemplateDiscoverySupersededConditionType)
+			switch {
+			case tt.wantReason == "" && cond != nil:
+				t.Errorf("expected no superseded condition, got %s", cond.Reason)
+			case tt.wantReason != "" && (cond == nil || cond.Reason != tt.wantReason):
+				t.Errorf("expected superseded condition with reason %s, got %v", tt.wantReason, cond)
+			}
+
+			if len(status.ModelSources) != 1 || status.ModelSources[0].ModelID != tt.wantModel {
+				t.Errorf("model sources = %v, want %s", status.ModelSources, tt.wantModel)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// GPUJobQueueRequeueInterval is how often a template with a queued discovery job re-checks
+// whether a GPU job slot has become available.
+const GPUJobQueueRequeueInterval = 5 * time.Second
+
+// GPUJobLimits holds the resolved concurrency limits for GPU-consuming jobs.
+type GPUJobLimits struct {
+	// Cluster is the maximum number of running GPU jobs across all namespaces.
+	Cluster int32
+	// PerNamespace maps a namespace to its maximum number of running GPU jobs.
+	// Namespaces without an entry are only bound by the cluster limit.
+	PerNamespace map[string]int32
+}
+
+// GPUJobQueue is a snapshot of all AIM GPU jobs in the cluster and the limits that apply to them.
+// Running jobs hold a slot; suspended jobs are queued and admitted in creation order.
+type GPUJobQueue struct {
+	Jobs   []batchv1.Job
+	Limits GPUJobLimits
+}
+
+// IsGPUJob returns true if the job is an AIM discovery job that requests GPUs.
+func IsGPUJob(job *batchv1.Job) bool {
+	return job.Labels[constants.LabelKeyDiscoveryDevice] != constants.LabelValueDiscoveryDeviceCPU
+}
+
+// IsJobSuspended returns true if the job is suspended, i.e. queued for a GPU job slot.
+func IsJobSuspended(job *batchv1.Job) bool {
+	return job != nil && job.Spec.Suspend != nil && *job.Spec.Suspend
+}
+
+// FetchGPUJobQueue lists the active AIM GPU jobs across the cluster and resolves the limits
+// for the namespaces they run in. The namespace of the job being planned is always included,
+// since it may not have any jobs yet.
+func FetchGPUJobQueue(ctx context.Context, c client.Client, namespace string) controllerutils.FetchResult[*GPUJobQueue] {
+	var jobList batchv1.JobList
+	if err := c.List(ctx, &jobList, client.MatchingLabels{
+		"app.kubernetes.io/name":       "aim-discovery",
+		"app.kubernetes.io/component":  constants.LabelValueComponentDiscovery,
+		"app.kubernetes.io/managed-by": constants.LabelValueManagedByController,
+	}); err != nil {
+		return controllerutils.FetchResult[*GPUJobQueue]{Error: err}
+	}
+
+	queue := &GPUJobQueue{}
+	namespaces := map[string]bool{namespace: true}
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if !IsGPUJob(job) || IsJobComplete(job) {
+			continue
+		}
+		queue.Jobs = append(queue.Jobs, *job)
+		namespaces[job.Namespace] = true
+	}
+
+	limits, err := fetchGPUJobLimits(ctx, c, namespaces)
+	if err != nil {
+		return controllerutils.FetchResult[*GPUJobQueue]{Error: err}
+	}
+	queue.Limits = limits
+
+	return controllerutils.FetchResult[*GPUJobQueue]{Value: queue}
+}
+
+// fetchGPUJobLimits reads the GPU job limits from the default cluster runtime config and
+// the default runtime config of each namespace.
+func fetchGPUJobLimits(ctx context.Context, c client.Client, namespaces map[string]bool) (GPUJobLimits, error) {
+	limits := GPUJobLimits{
+		Cluster:      constants.MaxConcurrentDiscoveryJobs,
+		PerNamespace: map[string]int32{},
+	}
+
+	var clusterConfig *aimv1alpha1.AIMGPUJobsConfig
+	var crc aimv1alpha1.AIMClusterRuntimeConfig
+	err := c.Get(ctx, client.ObjectKey{Name: constants.DefaultRuntimeConfigName}, &crc)
+	switch {
+	case err == nil:
+		clusterConfig = crc.Spec.GPUJobs
+	case !apierrors.IsNotFound(err):
+		return limits, err
+	}
+	if clusterConfig != nil && clusterConfig.MaxConcurrent != nil {
+		limits.Cluster = *clusterConfig.MaxConcurrent
+	}
+
+	for namespace := range namespaces {
+		var rc aimv1alpha1.AIMRuntimeConfig
+		err := c.Get(ctx, client.ObjectKey{Name: constants.DefaultRuntimeConfigName, Namespace: namespace}, &rc)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return limits, err
+		}
+		if err == nil && rc.Spec.GPUJobs != nil && rc.Spec.GPUJobs.MaxConcurrent != nil {
+			limits.PerNamespace[namespace] = *rc.Spec.GPUJobs.MaxConcurrent
+		} else if clusterConfig != nil && clusterConfig.MaxConcurrentPerNamespace != nil {
+			limits.PerNamespace[namespace] = *clusterConfig.MaxConcurrentPerNamespace
+		}
+	}
+
+	return limits, nil
+}
+
+// Admit decides whether the given job may run now. A job that is not yet part of the queue
+// (because it has not been created) is treated as the newest queued job.
+//
+// Queued jobs are admitted in creation order while slots are free. A job that is blocked by
+// its namespace limit does not hold up jobs in other namespaces. When the job is not admitted,
+// queuedBehind lists the queued jobs (as namespace/name) that are ahead of it for the slot it
+// is waiting on.
+func (q *GPUJobQueue) Admit(job *batchv1.Job) (admitted bool, queuedBehind []string) {
+	if !IsGPUJob(job) {
+		return true, nil
+	}
+
+	running := 0
+	runningInNamespace := map[string]int{}
+	var queued []*batchv1.Job
+	found := false
+	for i := range q.Jobs {
+		j := &q.Jobs[i]
+		if j.Namespace == job.Namespace && j.Name == job.Name {
+			found = true
+			if !IsJobSuspended(j) {
+				// Already running
+				return true, nil
+			}
+		}
+		if IsJobSuspended(j) {
+			queued = append(queued, j)
+		} else {
+			running++
+			runningInNamespace[j.Namespace]++
+		}
+	}
+	sort.SliceStable(queued, func(a, b int) bool {
+		ta, tb := queued[a].CreationTimestamp, queued[b].CreationTimestamp
+		if !ta.Equal(&tb) {
+			return ta.Before(&tb)
+		}
+		if queued[a].Namespace != queued[b].Namespace {
+			return queued[a].Namespace < queued[b].Namespace
+		}
+		return queued[a].Name < queued[b].Name
+	})
+	if !found {
+		queued = append(queued, job)
+	}
+
+	// Queued jobs ahead of the target, by the slot they compete for
+	var waitingForCluster []string
+	waitingForNamespace := map[string][]string{}
+
+	for _, j := range queued {
+		key := j.Namespace + "/" + j.Name
+		nsLimit, hasNsLimit := q.Limits.PerNamespace[j.Namespace]
+		nsFull := hasNsLimit && runningInNamespace[j.Namespace] >= int(nsLimit)
+		clusterFull := running >= int(q.Limits.Cluster)
+
+		isTarget := j.Namespace == job.Namespace && j.Name == job.Name
+		switch {
+		case nsFull:
+			if isTarget {
+				return false, waitingForNamespace[j.Namespace]
+			}
+			waitingForNamespace[j.Namespace] = append(waitingForNamespace[j.Namespace], key)
+		case clusterFull:
+			if isTarget {
+				return false, waitingForCluster
+			}
+			waitingForCluster = append(waitingForCluster, key)
+			waitingForNamespace[j.Namespace] = append(waitingForNamespace[j.Namespace], key)
+		default:
+			if isTarget {
+				return true, nil
+			}
+			// Admitted, but still queued until its owner resumes it
+			running++
+			runningInNamespace[j.Namespace]++
+			waitingForCluster = append(waitingForCluster, key)
+			waitingForNamespace[j.Namespace] = append(waitingForNamespace[j.Namespace], key)
+		}
+	}
+
+	return false, nil
+}
+
+// FormatQueuedBehind renders the queue position for condition messages.
+func FormatQueuedBehind(queuedBehind []string) string {
+	switch len(queuedBehind) {
+	case 0:
+		return "Discovery job is queued and will start when a GPU job slot frees up"
+	case 1:
+		return fmt.Sprintf("Discovery job is queued behind %s", queuedBehind[0])
+	default:
+		return fmt.Sprintf("Discovery job is queued behind %d GPU jobs, next is %s", len(queuedBehind), queuedBehind[0])
+	}
+}
+
+// PlanDiscoveryJob creates the discovery job, suspended if the GPU job limits do not admit it
+// yet, and resumes an existing suspended job once it is admitted. A queued job whose spec has
+// changed is deleted so that it can be recreated at the back of the queue.
+// Returns how long to wait before checking the queue again, or 0 if nothing is queued.
+func PlanDiscoveryJob(
+	planResult *controllerutils.PlanResult,
+	existing *batchv1.Job,
+	jobSpec DiscoveryJobSpec,
+	queue controllerutils.FetchResult[*GPUJobQueue],
+) time.Duration {
+	job := BuildDiscoveryJob(jobSpec)
+
+	if existing != nil {
+		if !IsJobSuspended(existing) {
+			return 0
+		}
+		if existing.Name != job.Name {
+			planResult.Delete(existing)
+			return GPUJobQueueRequeueInterval
+		}
+	}
+
+	if IsGPUJob(job) {
+		if !queue.OK() || queue.Value == nil {
+			return GPUJobQueueRequeueInterval
+		}
+		if admitted, _ := queue.Value.Admit(job); !admitted {
+			if existing == nil {
+				job.Spec.Suspend = ptr.To(true)
+				planResult.Apply(job)
+			}
+			return GPUJobQueueRequeueInterval
+		}
+	}
+
+	if existing != nil {
+		job.Spec.Suspend = ptr.To(false)
+	}
+	planResult.Apply(job)
+	return 0
+}
+
+// setDiscoveryQueueStatus records the queue position of a suspended discovery job.
+// The position is cleared once the job is running or gone.
+func setDiscoveryQueueStatus(
+	status *aimv1alpha1.AIMServiceTemplateStatus,
+	cm *controllerutils.ConditionManager,
+	discoveryJob controllerutils.FetchResult[*batchv1.Job],
+	queue controllerutils.FetchResult[*GPUJobQueue],
+) {
+	var queuedBehind []string
+	queued := discoveryJob.OK() && IsJobSuspended(discoveryJob.Value) && !IsJobComplete(discoveryJob.Value)
+	if queued && queue.OK() && queue.Value != nil {
+		_, queuedBehind = queue.Value.Admit(discoveryJob.Value)
+	}
+
+	if status.Discovery != nil {
+		status.Discovery.QueuedBehind = queuedBehind
+	} else if len(queuedBehind) > 0 {
+		status.Discovery = &aimv1alpha1.DiscoveryState{QueuedBehind: queuedBehind}
+	}
+
+	if queued {
+		existing := cm.Get(aimv1alpha1.AIMTemplateDiscoveryConditionType)
+		if existing == nil || existing.Status != metav1.ConditionTrue {
+			cm.MarkFalse(aimv1alpha1.AIMTemplateDiscoveryConditionType, aimv1alpha1.AIMTemplateReasonDiscoveryQueued,
+				FormatQueuedBehind(queuedBehind))
+		}
+	}
+}
@@ -0,0 +1,334 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+var queueEpoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newGPUJob(namespace, name string, createdMinutes int, suspended bool) batchv1.Job {
+	job := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(queueEpoch.Add(time.Duration(createdMinutes) * time.Minute)),
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "aim-discovery",
+				"app.kubernetes.io/component":  constants.LabelValueComponentDiscovery,
+				"app.kubernetes.io/managed-by": constants.LabelValueManagedByController,
+			},
+		},
+	}
+	if suspended {
+		job.Spec.Suspend = ptr.To(true)
+	}
+	return job
+}
+
+func TestGPUJobQueueAdmit(t *testing.T) {
+	tests := []struct {
+		name                 string
+		jobs                 []batchv1.Job
+		limits               GPUJobLimits
+		candidate            batchv1.Job
+		expectAdmitted       bool
+		expectedQueuedBehind []string
+	}{
+		{
+			name:           "new job with free slots",
+			jobs:           []batchv1.Job{newGPUJob("a", "running", 0, false)},
+			limits:         GPUJobLimits{Cluster: 2},
+			candidate:      newGPUJob("a", "new", 10, false),
+			expectAdmitted: true,
+		},
+		{
+			name:           "new job with cluster limit reached",
+			jobs:           []batchv1.Job{newGPUJob("a", "running", 0, false)},
+			limits:         GPUJobLimits{Cluster: 1},
+			candidate:      newGPUJob("b", "new", 10, false),
+			expectAdmitted: false,
+		},
+		{
+			name: "new job waits behind older queued jobs",
+			jobs: []batchv1.Job{
+				newGPUJob("a", "running", 0, false),
+				newGPUJob("b", "queued-2", 2, true),
+				newGPUJob("c", "queued-1", 1, true),
+			},
+			limits:               GPUJobLimits{Cluster: 1},
+			candidate:            newGPUJob("d", "new", 10, false),
+			expectAdmitted:       false,
+			expectedQueuedBehind: []string{"c/queued-1", "b/queued-2"},
+		},
+		{
+			name: "oldest queued job is admitted when a slot frees up",
+			jobs: []batchv1.Job{
+				newGPUJob("b", "queued-2", 2, true),
+				newGPUJob("c", "queued-1", 1, true),
+			},
+			limits:         GPUJobLimits{Cluster: 1},
+			candidate:      newGPUJob("c", "queued-1", 1, true),
+			expectAdmitted: true,
+		},
+		{
+			name: "younger queued job is not admitted ahead of older one",
+			jobs: []batchv1.Job{
+				newGPUJob("b", "queued-2", 2, true),
+				newGPUJob("c", "queued-1", 1, true),
+			},
+			limits:               GPUJobLimits{Cluster: 1},
+			candidate:            newGPUJob("b", "queued-2", 2, true),
+			expectAdmitted:       false,
+			expectedQueuedBehind: []string{"c/queued-1"},
+		},
+		{
+			name: "namespace limit reached",
+			jobs: []batchv1.Job{
+				newGPUJob("a", "running", 0, false),
+				newGPUJob("a", "queued", 1, true),
+			},
+			limits:               GPUJobLimits{Cluster: 10, PerNamespace: map[string]int32{"a": 1}},
+			candidate:            newGPUJob("a", "new", 10, false),
+			expectAdmitted:       false,
+			expectedQueuedBehind: []string{"a/queued"},
+		},
+		{
+			name: "job blocked by its namespace limit does not block other namespaces",
+			jobs: []batchv1.Job{
+				newGPUJob("a", "running", 0, false),
+				newGPUJob("a", "queued", 1, true),
+			},
+			limits:         GPUJobLimits{Cluster: 2, PerNamespace: map[string]int32{"a": 1}},
+			candidate:      newGPUJob("b", "new", 10, false),
+			expectAdmitted: true,
+		},
+		{
+			name:           "running job is always admitted",
+			jobs:           []batchv1.Job{newGPUJob("a", "running", 0, false)},
+			limits:         GPUJobLimits{Cluster: 1},
+			candidate:      newGPUJob("a", "running", 0, false),
+			expectAdmitted: true,
+		},
+		{
+			name:   "CPU-only job bypasses the queue",
+			jobs:   []batchv1.Job{newGPUJob("a", "running", 0, false)},
+			limits: GPUJobLimits{Cluster: 1},
+			candidate: func() batchv1.Job {
+				job := newGPUJob("a", "cpu", 10, false)
+				job.Labels[constants.LabelKeyDiscoveryDevice] = constants.LabelValueDiscoveryDeviceCPU
+				return job
+			}(),
+			expectAdmitted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &GPUJobQueue{Jobs: tt.jobs, Limits: tt.limits}
+			admitted, queuedBehind := queue.Admit(&tt.candidate)
+			if admitted != tt.expectAdmitted {
+				t.Errorf("expected admitted=%v, got %v", tt.expectAdmitted, admitted)
+			}
+			if !reflect.DeepEqual(queuedBehind, tt.expectedQueuedBehind) {
+				t.Errorf("expected queuedBehind %v, got %v", tt.expectedQueuedBehind, queuedBehind)
+			}
+		})
+	}
+}
+
+func TestFetchGPUJobQueue(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = aimv1alpha1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+
+	completed := newGPUJob("a", "completed", 0, false)
+	completed.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: "True"}}
+	running := newGPUJob("a", "running", 1, false)
+	other := newGPUJob("b", "queued", 2, true)
+
+	objs := []client.Object{
+		&completed, &running, &other,
+		&aimv1alpha1.AIMClusterRuntimeConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: constants.DefaultRuntimeConfigName},
+			Spec: aimv1alpha1.AIMClusterRuntimeConfigSpec{
+				AIMRuntimeConfigCommon: aimv1alpha1.AIMRuntimeConfigCommon{
+					GPUJobs: &aimv1alpha1.AIMGPUJobsConfig{
+						MaxConcurrent:             ptr.To(int32(4)),
+						MaxConcurrentPerNamespace: ptr.To(int32(2)),
+					},
+				},
+			},
+		},
+		&aimv1alpha1.AIMRuntimeConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: constants.DefaultRuntimeConfigName, Namespace: "b"},
+			Spec: aimv1alpha1.AIMRuntimeConfigSpec{
+				AIMRuntimeConfigCommon: aimv1alpha1.AIMRuntimeConfigCommon{
+					GPUJobs: &aimv1alpha1.AIMGPUJobsConfig{MaxConcurrent: ptr.To(int32(1))},
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	result := FetchGPUJobQueue(context.Background(), c, "c")
+	if !result.OK() {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	queue := result.Value
+	if len(queue.Jobs) != 2 {
+		t.Errorf("expected completed job to be excluded, got %d jobs", len(queue.Jobs))
+	}
+	expected := GPUJobLimits{
+		Cluster:      4,
+		PerNamespace: map[string]int32{"a": 2, "b": 1, "c": 2},
+	}
+	if !reflect.DeepEqual(queue.Limits, expected) {
+		t.Errorf("expected limits %+v, got %+v", expected, queue.Limits)
+	}
+}
+
+func TestPlanDiscoveryJob(t *testing.T) {
+	jobSpec := DiscoveryJobSpec{
+		TemplateName: "tmpl",
+		Namespace:    "a",
+		ModelID:      "model",
+		Image:        "registry.example.com/aim:1.0",
+	}
+	fullQueue := controllerutils.FetchResult[*GPUJobQueue]{Value: &GPUJobQueue{
+		Jobs:   []batchv1.Job{newGPUJob("b", "running", 0, false)},
+		Limits: GPUJobLimits{Cluster: 1},
+	}}
+	emptyQueue := controllerutils.FetchResult[*GPUJobQueue]{Value: &GPUJobQueue{Limits: GPUJobLimits{Cluster: 1}}}
+
+	t.Run("creates running job when admitted", func(t *testing.T) {
+		plan := controllerutils.PlanResult{}
+		if wait := PlanDiscoveryJob(&plan, nil, jobSpec, emptyQueue); wait != 0 {
+			t.Errorf("expected no requeue, got %s", wait)
+		}
+		applied := plan.GetToApply()
+		if len(applied) != 1 || IsJobSuspended(applied[0].(*batchv1.Job)) {
+			t.Fatalf("expected one running job, got %v", applied)
+		}
+	})
+
+	t.Run("creates suspended job when queued", func(t *testing.T) {
+		plan := controllerutils.PlanResult{}
+		if wait := PlanDiscoveryJob(&plan, nil, jobSpec, fullQueue); wait != GPUJobQueueRequeueInterval {
+			t.Errorf("expected requeue, got %s", wait)
+		}
+		applied := plan.GetToApply()
+		if len(applied) != 1 || !IsJobSuspended(applied[0].(*batchv1.Job)) {
+			t.Fatalf("expected one suspended job, got %v", applied)
+		}
+	})
+
+	t.Run("resumes queued job when admitted", func(t *testing.T) {
+		existing := BuildDiscoveryJob(jobSpec)
+		existing.Spec.Suspend = ptr.To(true)
+		queue := controllerutils.FetchResult[*GPUJobQueue]{Value: &GPUJobQueue{
+			Jobs:   []batchv1.Job{*existing},
+			Limits: GPUJobLimits{Cluster: 1},
+		}}
+		plan := controllerutils.PlanResult{}
+		PlanDiscoveryJob(&plan, existing, jobSpec, queue)
+		applied := plan.GetToApply()
+		if len(applied) != 1 {
+			t.Fatalf("expected job to be applied, got %v", applied)
+		}
+		job := applied[0].(*batchv1.Job)
+		if job.Spec.Suspend == nil || *job.Spec.Suspend {
+			t.Errorf("expected suspend=false, got %v", job.Spec.Suspend)
+		}
+	})
+
+	t.Run("leaves queued job alone while not admitted", func(t *testing.T) {
+		existing := BuildDiscoveryJob(jobSpec)
+		existing.Spec.Suspend = ptr.To(true)
+		plan := controllerutils.PlanResult{}
+		if wait := PlanDiscoveryJob(&plan, existing, jobSpec, fullQueue); wait != GPUJobQueueRequeueInterval {
+			t.Errorf("expected requeue, got %s", wait)
+		}
+		if len(plan.GetToApply()) != 0 || len(plan.GetToDelete()) != 0 {
+			t.Errorf("expected no changes")
+		}
+	})
+
+	t.Run("deletes queued job with stale spec", func(t *testing.T) {
+		staleSpec := jobSpec
+		staleSpec.Image = "registry.example.com/aim:0.9"
+		existing := BuildDiscoveryJob(staleSpec)
+		existing.Spec.Suspend = ptr.To(true)
+		plan := controllerutils.PlanResult{}
+		PlanDiscoveryJob(&plan, existing, jobSpec, emptyQueue)
+		if len(plan.GetToDelete()) != 1 || len(plan.GetToApply()) != 0 {
+			t.Errorf("expected stale job to be deleted")
+		}
+	})
+}
+
+func TestSetDiscoveryQueueStatus(t *testing.T) {
+	queued := newGPUJob("a", "queued", 5, true)
+	queue := controllerutils.FetchResult[*GPUJobQueue]{Value: &GPUJobQueue{
+		Jobs: []batchv1.Job{
+			newGPUJob("b", "running", 0, false),
+			newGPUJob("c", "older", 1, true),
+			queued,
+		},
+		Limits: GPUJobLimits{Cluster: 1},
+	}}
+
+	status := &aimv1alpha1.AIMServiceTemplateStatus{}
+	cm := controllerutils.NewConditionManager(nil)
+	setDiscoveryQueueStatus(status, cm, controllerutils.FetchResult[*batchv1.Job]{Value: &queued}, queue)
+
+	if status.Discovery == nil || !reflect.DeepEqual(status.Discovery.QueuedBehind, []string{"c/older"}) {
+		t.Fatalf("expected queuedBehind [c/older], got %+v", status.Discovery)
+	}
+	cond := cm.Get(aimv1alpha1.AIMTemplateDiscoveryConditionType)
+	if cond == nil || cond.Reason != aimv1alpha1.AIMTemplateReasonDiscoveryQueued {
+		t.Errorf("expected DiscoveryQueued condition, got %+v", cond)
+	}
+
+	// Once the job runs, the queue position is cleared
+	running := newGPUJob("a", "queued", 5, false)
+	setDiscoveryQueueStatus(status, cm, controllerutils.FetchResult[*batchv1.Job]{Value: &running}, queue)
+	if len(status.Discovery.QueuedBehind) != 0 {
+		t.Errorf("expected queuedBehind to be cleared, got %v", status.Discovery.QueuedBehind)
+	}
+}
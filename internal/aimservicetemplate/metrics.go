@@ -0,0 +1,137 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// metricsCollectTimeout bounds how long a single scrape may spend reading from the cache.
+const metricsCollectTimeout = 10 * time.Second
+
+// discoveryDurationBuckets are the upper bounds in seconds of the discovery duration histogram.
+// Discovery pulls the image and starts the engine, so runs take from seconds to tens of minutes.
+var discoveryDurationBuckets = []float64{15, 30, 60, 120, 300, 600, 1200, 1800, 3600}
+
+var discoveryDurationDesc = prometheus.NewDesc(
+	"aim_template_discovery_duration_seconds",
+	"Run time of the last successful discovery job of each template, by discovery image.",
+	[]string{"image"}, nil,
+)
+
+// DiscoveryMetricsCollector publishes the discovery durations recorded in template status as a
+// histogram per image, so that a release whose discovery got slower stands out. Values are read
+// from the manager cache at scrape time, so deleted templates drop out without explicit cleanup.
+type DiscoveryMetricsCollector struct {
+	reader client.Reader
+}
+
+// NewDiscoveryMetricsCollector creates a collector reading from the given (typically cached) reader.
+func NewDiscoveryMetricsCollector(reader client.Reader) *DiscoveryMetricsCollector {
+	return &DiscoveryMetricsCollector{reader: reader}
+}
+
+// Describe implements prometheus.Collector.
+func (c *DiscoveryMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- discoveryDurationDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *DiscoveryMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancel()
+	logger := logf.FromContext(ctx).WithName("aimservicetemplate-metrics")
+
+	var templates aimv1alpha1.AIMServiceTemplateList
+	if err := c.reader.List(ctx, &templates); err != nil {
+		logger.Error(err, "failed to list AIMServiceTemplates for metrics")
+		return
+	}
+	var clusterTemplates aimv1alpha1.AIMClusterServiceTemplateList
+	if err := c.reader.List(ctx, &clusterTemplates); err != nil {
+		logger.Error(err, "failed to list AIMClusterServiceTemplates for metrics")
+		return
+	}
+
+	var states []*aimv1alpha1.DiscoveryState
+	for i := range templates.Items {
+		states = append(states, templates.Items[i].Status.Discovery)
+	}
+	for i := range clusterTemplates.Items {
+		states = append(states, clusterTemplates.Items[i].Status.Discovery)
+	}
+
+	for _, h := range discoveryDurationHistograms(states) {
+		ch <- prometheus.MustNewConstHistogram(discoveryDurationDesc, h.count, h.sum, h.buckets, h.image)
+	}
+}
+
+// discoveryHistogram is the cumulative histogram of the discovery durations of one image.
+type discoveryHistogram struct {
+	image   string
+	count   uint64
+	sum     float64
+	buckets map[float64]uint64
+}
+
+// discoveryDurationHistograms groups the recorded discovery durations by image, sorted by image.
+// States without a recorded duration are skipped.
+func discoveryDurationHistograms(states []*aimv1alpha1.DiscoveryState) []discoveryHistogram {
+	byImage := map[string]*discoveryHistogram{}
+	for _, state := range states {
+		if state == nil || state.Duration == nil || state.Image == "" {
+			continue
+		}
+		h, ok := byImage[state.Image]
+		if !ok {
+			h = &discoveryHistogram{image: state.Image, buckets: make(map[float64]uint64, len(discoveryDurationBuckets))}
+			for _, bound := range discoveryDurationBuckets {
+				h.buckets[bound] = 0
+			}
+			byImage[state.Image] = h
+		}
+		seconds := state.Duration.Seconds()
+		h.count++
+		h.sum += seconds
+		for _, bound := range discoveryDurationBuckets {
+			if seconds <= bound {
+				h.buckets[bound]++
+			}
+		}
+	}
+
+	histograms := make([]discoveryHistogram, 0, len(byImage))
+	for _, h := range byImage {
+		histograms = append(histograms, *h)
+	}
+	sort.Slice(histograms, func(i, j int) bool { return histograms[i].image < histograms[j].image })
+	return histograms
+}
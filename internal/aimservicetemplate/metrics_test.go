@@ -0,0 +1,105 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+func succeededDiscoveryJob(image string, start time.Time, duration time.Duration) *batchv1.Job {
+	job := &batchv1.Job{
+		Status: batchv1.JobStatus{
+			StartTime:      &metav1.Time{Time: start},
+			CompletionTime: &metav1.Time{Time: start.Add(duration)},
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+	job.Spec.Template.Spec.Containers = []corev1.Container{{Name: discoveryContainerName, Image: image}}
+	return job
+}
+
+func TestRecordDiscoveryTiming(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	status := &aimv1alpha1.AIMServiceTemplateStatus{}
+
+	recordDiscoveryTiming(status, succeededDiscoveryJob("aim:1.0", start, 90*time.Second))
+	if status.Discovery == nil {
+		t.Fatal("expected discovery state to be recorded")
+	}
+	if status.Discovery.Image != "aim:1.0" {
+		t.Errorf("image = %q, want aim:1.0", status.Discovery.Image)
+	}
+	if !status.Discovery.StartTime.Time.Equal(start) {
+		t.Errorf("start time = %v, want %v", status.Discovery.StartTime, start)
+	}
+	if status.Discovery.Duration.Duration != 90*time.Second {
+		t.Errorf("duration = %s, want 1m30s", status.Discovery.Duration.Duration)
+	}
+
+	failed := succeededDiscoveryJob("aim:2.0", start, time.Minute)
+	failed.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+	recordDiscoveryTiming(status, failed)
+	if status.Discovery.Image != "aim:1.0" {
+		t.Error("a failed job must not replace the recorded timing")
+	}
+}
+
+func TestDiscoveryDurationHistograms(t *testing.T) {
+	state := func(image string, d time.Duration) *aimv1alpha1.DiscoveryState {
+		return &aimv1alpha1.DiscoveryState{Image: image, Duration: &metav1.Duration{Duration: d}}
+	}
+	states := []*aimv1alpha1.DiscoveryState{
+		state("aim:2.0", 10*time.Minute),
+		state("aim:1.0", 20*time.Second),
+		state("aim:1.0", 100*time.Second),
+		{Attempts: 2},
+		nil,
+	}
+
+	histograms := discoveryDurationHistograms(states)
+	if len(histograms) != 2 {
+		t.Fatalf("expected 2 histograms, got %d", len(histograms))
+	}
+
+	first := histograms[0]
+	if first.image != "aim:1.0" || first.count != 2 || first.sum != 120 {
+		t.Errorf("unexpected histogram for aim:1.0: %+v", first)
+	}
+	if first.buckets[15] != 0 || first.buckets[30] != 1 || first.buckets[120] != 2 || first.buckets[3600] != 2 {
+		t.Errorf("unexpected buckets for aim:1.0: %v", first.buckets)
+	}
+
+	second := histograms[1]
+	if second.image != "aim:2.0" || second.count != 1 || second.buckets[300] != 0 || second.buckets[600] != 1 {
+		t.Errorf("unexpected histogram for aim:2.0: %+v", second)
+	}
+}
@@ -266,3 +266,29 @@ func hasNonTemplateFailure(conditions []metav1.Condition, templateComponent stri
 	}
 	return false
 }
+
+// imagePreflightRejectionReasons are the ImageMetadataReady reasons the model controller sets
+// when its image preflight found that the image cannot work as specified.
+var imagePreflightRejectionReasons = map[string]bool{
+	aimv1alpha1.AIMModelReasonInvalidImageReference:    true,
+	aimv1alpha1.AIMModelReasonImageNotFound:            true,
+	aimv1alpha1.AIMModelReasonImagePlatformUnsupported: true,
+	aimv1alpha1.AIMModelReasonInvalidImageMetadata:     true,
+}
+
+// IsModelImageRejected returns true if the model controller's image preflight rejected the
+// model's image, or the image violates the runtime config's security policy. Discovery jobs
+// are not scheduled for rejected images, since they would only end up in ImagePullBackOff,
+// fail on a GPU node, or produce templates that services refuse to serve.
+func IsModelImageRejected(conditions []metav1.Condition) bool {
+	rejected := false
+	for _, c := range conditions {
+		switch c.Type {
+		case "ImageMetadata" + controllerutils.ComponentConditionSuffix:
+			rejected = rejected || (c.Status == metav1.ConditionFalse && imagePreflightRejectionReasons[c.Reason])
+		case aimv1alpha1.AIMModelConditionSecurityPolicyViolated:
+			rejected = rejected || c.Status == metav1.ConditionTrue
+		}
+	}
+	return rejected
+}
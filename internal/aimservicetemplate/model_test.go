@@ -330,3 +330,75 @@ func TestHasNonTemplateFailure(t *testing.T) {
 		})
 	}
 }
+
+func TestIsModelImageRejected(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		expected   bool
+	}{
+		{
+			name:       "no conditions",
+			conditions: nil,
+			expected:   false,
+		},
+		{
+			name: "image metadata ready",
+			conditions: []metav1.Condition{
+				{Type: "ImageMetadataReady", Status: metav1.ConditionTrue},
+			},
+			expected: false,
+		},
+		{
+			name: "image not found",
+			conditions: []metav1.Condition{
+				{Type: "ImageMetadataReady", Status: metav1.ConditionFalse, Reason: aimv1alpha1.AIMModelReasonImageNotFound},
+			},
+			expected: true,
+		},
+		{
+			name: "unsupported platform",
+			conditions: []metav1.Condition{
+				{Type: "ImageMetadataReady", Status: metav1.ConditionFalse, Reason: aimv1alpha1.AIMModelReasonImagePlatformUnsupported},
+			},
+			expected: true,
+		},
+		{
+			name: "registry auth failure does not block discovery",
+			conditions: []metav1.Condition{
+				{Type: "ImageMetadataReady", Status: metav1.ConditionFalse, Reason: aimv1alpha1.AIMModelReasonImageRegistryAuthFailed},
+			},
+			expected: false,
+		},
+		{
+			name: "security policy violated",
+			conditions: []metav1.Condition{
+				{Type: "ImageMetadataReady", Status: metav1.ConditionTrue},
+				{Type: aimv1alpha1.AIMModelConditionSecurityPolicyViolated, Status: metav1.ConditionTrue, Reason: aimv1alpha1.AIMModelReasonCVESeverityExceeded},
+			},
+			expected: true,
+		},
+		{
+			name: "security policy satisfied",
+			conditions: []metav1.Condition{
+				{Type: aimv1alpha1.AIMModelConditionSecurityPolicyViolated, Status: metav1.ConditionFalse, Reason: aimv1alpha1.AIMModelReasonWithinPolicy},
+			},
+			expected: false,
+		},
+		{
+			name: "rejection reason on another condition is ignored",
+			conditions: []metav1.Condition{
+				{Type: "RuntimeConfigReady", Status: metav1.ConditionFalse, Reason: aimv1alpha1.AIMModelReasonImageNotFound},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsModelImageRejected(tc.conditions); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
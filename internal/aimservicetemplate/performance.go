@@ -0,0 +1,219 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/pkg/selection"
+)
+
+// selectionGoals are the goals a template can be recommended for, in the order they are reported.
+var selectionGoals = []aimv1alpha1.AIMSelectionGoal{
+	aimv1alpha1.AIMSelectionGoalLatency,
+	aimv1alpha1.AIMSelectionGoalThroughputPerGPU,
+}
+
+// performanceAnnotation is the accepted content of the performance annotation.
+// TokensPerSecondPerGPU is derived from the resolved hardware and cannot be set directly.
+type performanceAnnotation struct {
+	Source          aimv1alpha1.AIMPerformanceSource `json:"source"`
+	Latency         *metav1.Duration                 `json:"latency,omitempty"`
+	TokensPerSecond *int64                           `json:"tokensPerSecond,omitempty"`
+	MeasuredAt      *metav1.Time                     `json:"measuredAt,omitempty"`
+}
+
+// ParsePerformance parses the performance annotation of a template. Unknown fields are rejected
+// so that a typo does not silently drop a metric. Throughput per GPU is derived from the GPU
+// requests of the resolved hardware.
+func ParsePerformance(value string, hardware *aimv1alpha1.AIMHardwareRequirements) (*aimv1alpha1.AIMProfilePerformance, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+	decoder.DisallowUnknownFields()
+
+	var annotation performanceAnnotation
+	if err := decoder.Decode(&annotation); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", constants.AnnotationPerformance, err)
+	}
+
+	switch annotation.Source {
+	case aimv1alpha1.AIMPerformanceSourceBenchmark, aimv1alpha1.AIMPerformanceSourceLiveTraffic:
+	default:
+		return nil, fmt.Errorf("invalid %s annotation: source must be %s or %s, got %q",
+			constants.AnnotationPerformance,
+			aimv1alpha1.AIMPerformanceSourceBenchmark, aimv1alpha1.AIMPerformanceSourceLiveTraffic,
+			annotation.Source)
+	}
+	if annotation.Latency == nil && annotation.TokensPerSecond == nil {
+		return nil, fmt.Errorf("invalid %s annotation: latency or tokensPerSecond is required",
+			constants.AnnotationPerformance)
+	}
+	if annotation.Latency != nil && annotation.Latency.Duration <= 0 {
+		return nil, fmt.Errorf("invalid %s annotation: latency must be positive, got %s",
+			constants.AnnotationPerformance, annotation.Latency.Duration)
+	}
+	if annotation.TokensPerSecond != nil && *annotation.TokensPerSecond <= 0 {
+		return nil, fmt.Errorf("invalid %s annotation: tokensPerSecond must be positive, got %d",
+			constants.AnnotationPerformance, *annotation.TokensPerSecond)
+	}
+
+	perf := &aimv1alpha1.AIMProfilePerformance{
+		Source:          annotation.Source,
+		Latency:         annotation.Latency,
+		TokensPerSecond: annotation.TokensPerSecond,
+		MeasuredAt:      annotation.MeasuredAt,
+	}
+	if perf.TokensPerSecond != nil && hardware != nil && hardware.GPU != nil && hardware.GPU.Requests > 0 {
+		perf.TokensPerSecondPerGPU = ptr.To(*perf.TokensPerSecond / int64(hardware.GPU.Requests))
+	}
+	return perf, nil
+}
+
+// recommendedGoals returns the goals for which perf scores at least as well as every sibling
+// measurement. Ties recommend all tied templates.
+func recommendedGoals(perf *aimv1alpha1.AIMProfilePerformance, siblings []*aimv1alpha1.AIMProfilePerformance) []aimv1alpha1.AIMSelectionGoal {
+	var goals []aimv1alpha1.AIMSelectionGoal
+	for _, goal := range selectionGoals {
+		score, ok := selection.PerformanceScore(perf, goal)
+		if !ok {
+			continue
+		}
+		best := true
+		for _, sibling := range siblings {
+			if siblingScore, ok := selection.PerformanceScore(sibling, goal); ok && siblingScore < score {
+				best = false
+				break
+			}
+		}
+		if best {
+			goals = append(goals, goal)
+		}
+	}
+	return goals
+}
+
+// FetchSiblingPerformance returns the recorded performance of the other ready templates of the
+// same model in the template's namespace.
+func FetchSiblingPerformance(
+	ctx context.Context,
+	c client.Client,
+	template *aimv1alpha1.AIMServiceTemplate,
+) controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance] {
+	list := controllerutils.FetchList(ctx, c, &aimv1alpha1.AIMServiceTemplateList{},
+		client.InNamespace(template.Namespace),
+		client.MatchingFields{aimv1alpha1.ServiceTemplateModelNameIndexKey: template.Spec.ModelName},
+	)
+	if !list.OK() {
+		return controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance]{Error: list.Error}
+	}
+	var perfs []*aimv1alpha1.AIMProfilePerformance
+	for i := range list.Value.Items {
+		if perf := readyPerformance(template.Name, &list.Value.Items[i]); perf != nil {
+			perfs = append(perfs, perf)
+		}
+	}
+	return controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance]{Value: perfs}
+}
+
+// FetchClusterSiblingPerformance returns the recorded performance of the other ready cluster
+// templates of the same model.
+func FetchClusterSiblingPerformance(
+	ctx context.Context,
+	c client.Client,
+	template *aimv1alpha1.AIMClusterServiceTemplate,
+) controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance] {
+	list := controllerutils.FetchList(ctx, c, &aimv1alpha1.AIMClusterServiceTemplateList{},
+		client.MatchingFields{aimv1alpha1.ServiceTemplateModelNameIndexKey: template.Spec.ModelName},
+	)
+	if !list.OK() {
+		return controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance]{Error: list.Error}
+	}
+	var perfs []*aimv1alpha1.AIMProfilePerformance
+	for i := range list.Value.Items {
+		if perf := readyPerformance(template.Name, &list.Value.Items[i]); perf != nil {
+			perfs = append(perfs, perf)
+		}
+	}
+	return controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance]{Value: perfs}
+}
+
+// readyPerformance returns the recorded performance of a sibling template, or nil if the sibling
+// is the template itself, is not ready, or has no measurement.
+func readyPerformance(self string, sibling interface {
+	GetName() string
+	GetStatus() *aimv1alpha1.AIMServiceTemplateStatus
+}) *aimv1alpha1.AIMProfilePerformance {
+	status := sibling.GetStatus()
+	if sibling.GetName() == self || status.Status != constants.AIMStatusReady {
+		return nil
+	}
+	return status.Performance
+}
+
+// setPerformanceStatus records the performance annotation in status and recommends the template
+// for the goals it measures best at among its ready siblings. Recommendations are kept unchanged
+// when the siblings could not be listed.
+func setPerformanceStatus(
+	status *aimv1alpha1.AIMServiceTemplateStatus,
+	cm *controllerutils.ConditionManager,
+	annotations map[string]string,
+	siblings controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance],
+) {
+	value, ok := annotations[constants.AnnotationPerformance]
+	if !ok {
+		status.Performance = nil
+		status.RecommendedFor = nil
+		cm.Delete(aimv1alpha1.AIMTemplateConditionPerformanceRecorded)
+		return
+	}
+
+	perf, err := ParsePerformance(value, status.ResolvedHardware)
+	if err != nil {
+		status.Performance = nil
+		status.RecommendedFor = nil
+		cm.MarkFalse(aimv1alpha1.AIMTemplateConditionPerformanceRecorded,
+			aimv1alpha1.AIMTemplateReasonInvalidPerformance, err.Error(), controllerutils.AsWarning())
+		return
+	}
+	status.Performance = perf
+	cm.MarkTrue(aimv1alpha1.AIMTemplateConditionPerformanceRecorded,
+		aimv1alpha1.AIMTemplateReasonPerformanceRecorded,
+		fmt.Sprintf("Performance recorded from %s", perf.Source))
+
+	if status.Status != constants.AIMStatusReady {
+		status.RecommendedFor = nil
+		return
+	}
+	if siblings.OK() {
+		status.RecommendedFor = recommendedGoals(perf, siblings.Value)
+	}
+}
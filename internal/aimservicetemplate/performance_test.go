@@ -0,0 +1,228 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+func TestParsePerformance(t *testing.T) {
+	fourGPUs := &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 4, Model: "MI300X"}}
+
+	tests := []struct {
+		name           string
+		value          string
+		hardware       *aimv1alpha1.AIMHardwareRequirements
+		wantErr        bool
+		expectedPerGPU *int64
+	}{
+		{
+			name:           "benchmark with both metrics",
+			value:          `{"source":"Benchmark","latency":"12ms","tokensPerSecond":4200}`,
+			hardware:       fourGPUs,
+			expectedPerGPU: ptr.To[int64](1050),
+		},
+		{
+			name:  "live traffic latency only",
+			value: `{"source":"LiveTraffic","latency":"20ms","measuredAt":"2025-06-01T00:00:00Z"}`,
+		},
+		{
+			name:  "throughput without GPUs has no per-GPU value",
+			value: `{"source":"Benchmark","tokensPerSecond":300}`,
+		},
+		{name: "malformed", value: `{"source":`, wantErr: true},
+		{name: "unknown field", value: `{"source":"Benchmark","latancy":"12ms"}`, wantErr: true},
+		{name: "derived field", value: `{"source":"Benchmark","tokensPerSecondPerGPU":10}`, wantErr: true},
+		{name: "unknown source", value: `{"source":"Guess","latency":"12ms"}`, wantErr: true},
+		{name: "no metrics", value: `{"source":"Benchmark"}`, wantErr: true},
+		{name: "negative latency", value: `{"source":"Benchmark","latency":"-1ms"}`, wantErr: true},
+		{name: "zero throughput", value: `{"source":"Benchmark","tokensPerSecond":0}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perf, err := ParsePerformance(tt.value, tt.hardware)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", perf)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ptr.Deref(perf.TokensPerSecondPerGPU, -1) != ptr.Deref(tt.expectedPerGPU, -1) {
+				t.Errorf("expected tokensPerSecondPerGPU %v, got %v",
+					ptr.Deref(tt.expectedPerGPU, -1), ptr.Deref(perf.TokensPerSecondPerGPU, -1))
+			}
+		})
+	}
+}
+
+func TestRecommendedGoals(t *testing.T) {
+	perf := func(latency time.Duration, perGPU int64) *aimv1alpha1.AIMProfilePerformance {
+		return &aimv1alpha1.AIMProfilePerformance{
+			Source:                aimv1alpha1.AIMPerformanceSourceBenchmark,
+			Latency:               &metav1.Duration{Duration: latency},
+			TokensPerSecondPerGPU: ptr.To(perGPU),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		self     *aimv1alpha1.AIMProfilePerformance
+		siblings []*aimv1alpha1.AIMProfilePerformance
+		expected []aimv1alpha1.AIMSelectionGoal
+	}{
+		{
+			name:     "no siblings recommends every measured goal",
+			self:     perf(10*time.Millisecond, 500),
+			expected: []aimv1alpha1.AIMSelectionGoal{aimv1alpha1.AIMSelectionGoalLatency, aimv1alpha1.AIMSelectionGoalThroughputPerGPU},
+		},
+		{
+			name:     "best latency only",
+			self:     perf(10*time.Millisecond, 500),
+			siblings: []*aimv1alpha1.AIMProfilePerformance{perf(20*time.Millisecond, 900)},
+			expected: []aimv1alpha1.AIMSelectionGoal{aimv1alpha1.AIMSelectionGoalLatency},
+		},
+		{
+			name:     "ties are recommended",
+			self:     perf(10*time.Millisecond, 500),
+			siblings: []*aimv1alpha1.AIMProfilePerformance{perf(10*time.Millisecond, 400)},
+			expected: []aimv1alpha1.AIMSelectionGoal{aimv1alpha1.AIMSelectionGoalLatency, aimv1alpha1.AIMSelectionGoalThroughputPerGPU},
+		},
+		{
+			name: "unmeasured goal is never recommended",
+			self: &aimv1alpha1.AIMProfilePerformance{
+				Source:  aimv1alpha1.AIMPerformanceSourceLiveTraffic,
+				Latency: &metav1.Duration{Duration: 30 * time.Millisecond},
+			},
+			siblings: []*aimv1alpha1.AIMProfilePerformance{perf(40*time.Millisecond, 900)},
+			expected: []aimv1alpha1.AIMSelectionGoal{aimv1alpha1.AIMSelectionGoalLatency},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goals := recommendedGoals(tt.self, tt.siblings)
+			if !slices.Equal(goals, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, goals)
+			}
+		})
+	}
+}
+
+func TestSetPerformanceStatus(t *testing.T) {
+	annotated := map[string]string{constants.AnnotationPerformance: `{"source":"Benchmark","latency":"12ms"}`}
+	slower := &aimv1alpha1.AIMProfilePerformance{
+		Source:  aimv1alpha1.AIMPerformanceSourceBenchmark,
+		Latency: &metav1.Duration{Duration: 20 * time.Millisecond},
+	}
+	siblings := controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance]{
+		Value: []*aimv1alpha1.AIMProfilePerformance{slower},
+	}
+
+	t.Run("ready template is recommended", func(t *testing.T) {
+		status := &aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady}
+		cm := controllerutils.NewConditionManager(nil)
+		setPerformanceStatus(status, cm, annotated, siblings)
+
+		if status.Performance == nil || status.Performance.Latency.Duration != 12*time.Millisecond {
+			t.Fatalf("expected recorded latency, got %+v", status.Performance)
+		}
+		if !slices.Equal(status.RecommendedFor, []aimv1alpha1.AIMSelectionGoal{aimv1alpha1.AIMSelectionGoalLatency}) {
+			t.Errorf("expected latency recommendation, got %v", status.RecommendedFor)
+		}
+		if cond := cm.Get(aimv1alpha1.AIMTemplateConditionPerformanceRecorded); cond == nil || cond.Status != metav1.ConditionTrue {
+			t.Errorf("expected PerformanceRecorded=True, got %+v", cond)
+		}
+	})
+
+	t.Run("template that is not ready is not recommended", func(t *testing.T) {
+		status := &aimv1alpha1.AIMServiceTemplateStatus{
+			Status:         constants.AIMStatusProgressing,
+			RecommendedFor: []aimv1alpha1.AIMSelectionGoal{aimv1alpha1.AIMSelectionGoalLatency},
+		}
+		setPerformanceStatus(status, controllerutils.NewConditionManager(nil), annotated, siblings)
+
+		if status.Performance == nil || status.RecommendedFor != nil {
+			t.Errorf("expected performance without recommendations, got %+v %v", status.Performance, status.RecommendedFor)
+		}
+	})
+
+	t.Run("failed sibling list keeps recommendations", func(t *testing.T) {
+		previous := []aimv1alpha1.AIMSelectionGoal{aimv1alpha1.AIMSelectionGoalLatency}
+		status := &aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady, RecommendedFor: previous}
+		failed := controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance]{Error: errors.New("list failed")}
+		setPerformanceStatus(status, controllerutils.NewConditionManager(nil), annotated, failed)
+
+		if !slices.Equal(status.RecommendedFor, previous) {
+			t.Errorf("expected recommendations to be kept, got %v", status.RecommendedFor)
+		}
+	})
+
+	t.Run("invalid annotation clears performance", func(t *testing.T) {
+		status := &aimv1alpha1.AIMServiceTemplateStatus{
+			Status:         constants.AIMStatusReady,
+			Performance:    slower,
+			RecommendedFor: []aimv1alpha1.AIMSelectionGoal{aimv1alpha1.AIMSelectionGoalLatency},
+		}
+		cm := controllerutils.NewConditionManager(nil)
+		setPerformanceStatus(status, cm, map[string]string{constants.AnnotationPerformance: "{"}, siblings)
+
+		if status.Performance != nil || status.RecommendedFor != nil {
+			t.Errorf("expected performance to be cleared, got %+v %v", status.Performance, status.RecommendedFor)
+		}
+		cond := cm.Get(aimv1alpha1.AIMTemplateConditionPerformanceRecorded)
+		if cond == nil || cond.Reason != aimv1alpha1.AIMTemplateReasonInvalidPerformance {
+			t.Errorf("expected InvalidPerformance condition, got %+v", cond)
+		}
+	})
+
+	t.Run("no annotation removes the condition", func(t *testing.T) {
+		status := &aimv1alpha1.AIMServiceTemplateStatus{Status: constants.AIMStatusReady, Performance: slower}
+		cm := controllerutils.NewConditionManager([]metav1.Condition{{
+			Type:   aimv1alpha1.AIMTemplateConditionPerformanceRecorded,
+			Status: metav1.ConditionTrue,
+			Reason: aimv1alpha1.AIMTemplateReasonPerformanceRecorded,
+		}})
+		setPerformanceStatus(status, cm, nil, siblings)
+
+		if status.Performance != nil {
+			t.Errorf("expected performance to be cleared, got %+v", status.Performance)
+		}
+		if cm.Get(aimv1alpha1.AIMTemplateConditionPerformanceRecorded) != nil {
+			t.Error("expected PerformanceRecorded to be removed")
+		}
+	})
+}
@@ -0,0 +1,121 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// verifyProfileSignature checks the profile signature against the trusted public keys.
+// The signature covers the SHA-256 digest of the profile JSON exactly as emitted, the same
+// scheme cosign uses for blob signatures. Returns an InvalidSpec error describing why the
+// profile was rejected, or nil when any key verifies the signature.
+func verifyProfileSignature(profileJSON []byte, signature string, publicKeys []string) error {
+	if strings.TrimSpace(signature) == "" {
+		return controllerutils.NewInvalidSpecError(
+			aimv1alpha1.AIMTemplateReasonProfileUnsigned,
+			"Discovery profile is not signed, but profile verification is enabled",
+			nil,
+		)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return controllerutils.NewInvalidSpecError(
+			aimv1alpha1.AIMTemplateReasonProfileSignatureInvalid,
+			"Discovery profile signature is not valid base64",
+			err,
+		)
+	}
+
+	digest := sha256.Sum256(profileJSON)
+	var keyErrs []error
+	for i, keyPEM := range publicKeys {
+		key, err := parsePublicKey(keyPEM)
+		if err != nil {
+			keyErrs = append(keyErrs, fmt.Errorf("public key %d: %w", i, err))
+			continue
+		}
+		if verifyDigest(key, profileJSON, digest[:], sig) {
+			return nil
+		}
+	}
+
+	return controllerutils.NewInvalidSpecError(
+		aimv1alpha1.AIMTemplateReasonProfileSignatureInvalid,
+		"Discovery profile signature does not match any trusted public key",
+		errors.Join(keyErrs...),
+	)
+}
+
+// parsePublicKey decodes a PEM-encoded PKIX public key.
+func parsePublicKey(keyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// verifyDigest verifies sig with the given key. Ed25519 signs the message itself,
+// the other key types sign its SHA-256 digest.
+func verifyDigest(key crypto.PublicKey, message, digest, sig []byte) bool {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digest, sig)
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, message, sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest, sig) == nil
+	default:
+		return false
+	}
+}
+
+// setProfilesVerifiedCondition records the outcome of profile verification. The condition is only
+// touched when discovery output was parsed in this reconcile, and removed when verification is disabled.
+func setProfilesVerifiedCondition(cm *controllerutils.ConditionManager, parsed, rejected *ParsedDiscovery) {
+	switch {
+	case rejected != nil && rejected.VerificationError != nil:
+		categorized := controllerutils.CategorizeError(rejected.VerificationError)
+		cm.MarkFalse(aimv1alpha1.AIMTemplateConditionProfilesVerified, categorized.Reason(), categorized.UserMessage())
+	case parsed != nil && parsed.Verified, rejected != nil && rejected.Verified:
+		cm.MarkTrue(aimv1alpha1.AIMTemplateConditionProfilesVerified,
+			aimv1alpha1.AIMTemplateReasonProfileSignatureVerified, "Discovery profile signature verified")
+	case parsed != nil, rejected != nil:
+		cm.Delete(aimv1alpha1.AIMTemplateConditionProfilesVerified)
+	}
+}
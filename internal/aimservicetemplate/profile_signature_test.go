@@ -0,0 +1,201 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimservicetemplate
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const testProfileJSON = `{"model":"m","metadata":{"engine":"vllm","gpu":"MI300X","gpu_count":1}}`
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func newECDSASigner(t *testing.T) (publicKeyPEM string, sign func([]byte) string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return encodePublicKey(t, &key.PublicKey), func(message []byte) string {
+		digest := sha256.Sum256(message)
+		sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+}
+
+func TestVerifyProfileSignature(t *testing.T) {
+	ecdsaKey, ecdsaSign := newECDSASigner(t)
+	otherKey, _ := newECDSASigner(t)
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	edKey := encodePublicKey(t, edPublic)
+	edSig := base64.StdEncoding.EncodeToString(ed25519.Sign(edPrivate, []byte(testProfileJSON)))
+
+	tests := []struct {
+		name       string
+		profile    string
+		signature  string
+		keys       []string
+		wantReason string
+	}{
+		{
+			name:      "ecdsa signature verified",
+			profile:   testProfileJSON,
+			signature: ecdsaSign([]byte(testProfileJSON)),
+			keys:      []string{ecdsaKey},
+		},
+		{
+			name:      "ed25519 signature verified",
+			profile:   testProfileJSON,
+			signature: edSig,
+			keys:      []string{edKey},
+		},
+		{
+			name:      "any trusted key is enough",
+			profile:   testProfileJSON,
+			signature: ecdsaSign([]byte(testProfileJSON)),
+			keys:      []string{"not a key", otherKey, ecdsaKey},
+		},
+		{
+			name:       "unsigned profile",
+			profile:    testProfileJSON,
+			keys:       []string{ecdsaKey},
+			wantReason: aimv1alpha1.AIMTemplateReasonProfileUnsigned,
+		},
+		{
+			name:       "tampered profile",
+			profile:    `{"model":"m","metadata":{"engine":"vllm","gpu":"MI300X","gpu_count":8}}`,
+			signature:  ecdsaSign([]byte(testProfileJSON)),
+			keys:       []string{ecdsaKey},
+			wantReason: aimv1alpha1.AIMTemplateReasonProfileSignatureInvalid,
+		},
+		{
+			name:       "untrusted key",
+			profile:    testProfileJSON,
+			signature:  ecdsaSign([]byte(testProfileJSON)),
+			keys:       []string{otherKey},
+			wantReason: aimv1alpha1.AIMTemplateReasonProfileSignatureInvalid,
+		},
+		{
+			name:       "signature not base64",
+			profile:    testProfileJSON,
+			signature:  "%%%",
+			keys:       []string{ecdsaKey},
+			wantReason: aimv1alpha1.AIMTemplateReasonProfileSignatureInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyProfileSignature([]byte(tt.profile), tt.signature, tt.keys)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("expected signature to verify, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected verification to fail")
+			}
+			se := controllerutils.CategorizeError(err)
+			if se.Category() != controllerutils.ErrorCategoryInvalidSpec || se.Reason() != tt.wantReason {
+				t.Errorf("expected %s invalid spec error, got %v", tt.wantReason, err)
+			}
+		})
+	}
+}
+
+func TestDiscoveryParsingAccept_ProfileVerification(t *testing.T) {
+	key, sign := newECDSASigner(t)
+	parsing := DiscoveryParsing{PublicKeys: []string{key}}
+
+	signed := &ParsedDiscovery{ProfileJSON: []byte(testProfileJSON), Signature: sign([]byte(testProfileJSON))}
+	accepted, rejected := parsing.Accept(signed)
+	if accepted != signed || rejected != nil || !signed.Verified {
+		t.Fatal("expected signed profile to be accepted and marked verified")
+	}
+
+	unsigned := &ParsedDiscovery{ProfileJSON: []byte(testProfileJSON)}
+	accepted, rejected = parsing.Accept(unsigned)
+	if accepted != nil || rejected != unsigned || unsigned.VerificationError == nil {
+		t.Fatal("expected unsigned profile to be rejected")
+	}
+
+	health := GetDiscoveryOutputHealth(rejected)
+	if len(health.Errors) != 1 || controllerutils.CategorizeError(health.Errors[0]).Reason() != aimv1alpha1.AIMTemplateReasonProfileUnsigned {
+		t.Errorf("expected ProfileUnsigned health error, got %+v", health)
+	}
+}
+
+func TestSetProfilesVerifiedCondition(t *testing.T) {
+	cm := controllerutils.NewConditionManager(nil)
+	setProfilesVerifiedCondition(cm, &ParsedDiscovery{Verified: true}, nil)
+	if cond := cm.Get(aimv1alpha1.AIMTemplateConditionProfilesVerified); cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Fatalf("expected ProfilesVerified=True, got %+v", cond)
+	}
+
+	rejected := &ParsedDiscovery{VerificationError: controllerutils.NewInvalidSpecError(
+		aimv1alpha1.AIMTemplateReasonProfileSignatureInvalid, "bad signature", nil)}
+	setProfilesVerifiedCondition(cm, nil, rejected)
+	cond := cm.Get(aimv1alpha1.AIMTemplateConditionProfilesVerified)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != aimv1alpha1.AIMTemplateReasonProfileSignatureInvalid {
+		t.Fatalf("expected ProfilesVerified=False with ProfileSignatureInvalid, got %+v", cond)
+	}
+
+	setProfilesVerifiedCondition(cm, nil, nil)
+	if cm.Get(aimv1alpha1.AIMTemplateConditionProfilesVerified) == nil {
+		t.Error("expected condition to be kept when nothing was parsed")
+	}
+
+	setProfilesVerifiedCondition(cm, &ParsedDiscovery{}, nil)
+	if cm.Get(aimv1alpha1.AIMTemplateConditionProfilesVerified) != nil {
+		t.Error("expected condition to be removed when verification is disabled")
+	}
+}
@@ -73,11 +73,35 @@ type ServiceTemplateFetchResult struct {
 	model               controllerutils.FetchResult[*aimv1alpha1.AIMModel]
 	discoveryJob        controllerutils.FetchResult[*batchv1.Job]
 	discoveryJobPods    controllerutils.FetchResult[*corev1.PodList]
-	templateCaches      controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCacheList]
+
+	// All discovery jobs and pods for the template, used for garbage collection
+	discoveryJobs      controllerutils.FetchResult[*batchv1.JobList]
+	discoveryPods      controllerutils.FetchResult[*corev1.PodList]
+	discoveryRetention DiscoveryRetention
+
+	// How to handle discovery jobs that cannot be scheduled
+	discoveryScheduling DiscoveryScheduling
+	discoveryResources  DiscoveryResources
+
+	// Active GPU jobs across the cluster, for admitting the discovery job
+	gpuJobQueue controllerutils.FetchResult[*GPUJobQueue]
+
+	// Base template, for derived templates only
+	baseTemplate controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
+
+	// Templates of the same model, for performance recommendations
+	siblingPerformance controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance]
+
+	// Output captured from the newest completed discovery job, if not yet in status
+	discoveryOutput *aimv1alpha1.AIMDiscoveryOutput
+	templateCaches  controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCacheList]
 
 	// Parsed discovery results (populated when discovery job has succeeded)
 	parsedDiscovery *ParsedDiscovery
 
+	// Discovery results rejected by strict parsing because of unknown fields
+	rejectedDiscovery *ParsedDiscovery
+
 	// GPU availability state
 	gpuResources map[string]utils.GPUResourceInfo
 	gpuFetchErr  error
@@ -108,14 +132,31 @@ func (r *ServiceTemplateReconciler) FetchRemoteState(
 		&aimv1alpha1.AIMModel{},
 	)
 
+	// Fetch the base template to detect edits made after derivation
+	if IsDerivedTemplate(template) {
+		result.baseTemplate = FetchBaseTemplate(ctx, c, template)
+	}
+
+	// Fetch templates of the same model to compare measured performance
+	if template.Annotations[constants.AnnotationPerformance] != "" {
+		result.siblingPerformance = FetchSiblingPerformance(ctx, c, template)
+	}
+
 	// Fetch GPU resources if GPU is required
 	if TemplateRequiresGPU(template.Spec.AIMServiceTemplateSpecCommon) {
 		result.gpuResources, result.gpuFetchErr = utils.GetClusterGPUResources(ctx, c)
 	}
 
-	// Fetch discovery job if template is not yet ready and has no inline model sources
-	if ShouldCheckDiscoveryJob(template) {
+	// Fetch discovery job if template is not yet ready and has no inline model sources,
+	// or if its discovery results were superseded by a controller upgrade
+	if ShouldCheckDiscoveryJob(template) || IsDiscoverySuperseded(&template.Status) {
 		result.discoveryJob = FetchDiscoveryJob(ctx, c, template.Namespace, template.Name)
+		if IsDiscoverySuperseded(&template.Status) {
+			result.discoveryJob = currentDiscoveryJob(result.discoveryJob)
+		}
+		if template.Spec.GetCompute() != aimv1alpha1.AIMComputeModeCPU {
+			result.gpuJobQueue = FetchGPUJobQueue(ctx, c, template.Namespace)
+		}
 
 		// Fetch discovery job pods for health inspection
 		if result.discoveryJob.OK() && result.discoveryJob.Value != nil {
@@ -128,18 +169,31 @@ func (r *ServiceTemplateReconciler) FetchRemoteState(
 			// Parse discovery logs if job succeeded
 			if IsJobSucceeded(job) {
 				logger := log.FromContext(ctx)
-				discovery, err := ParseDiscoveryLogs(ctx, c, r.Clientset, job)
+				parsing := ResolveDiscoveryParsing(reconcileCtx.MergedRuntimeConfig.Value)
+				discovery, err := ParseDiscoveryLogs(ctx, c, r.Clientset, job, parsing)
 				if err != nil {
 					// Log error but don't fail - status will show job as complete
 					logger.Error(err, "Failed to parse discovery logs", "job", job.Name)
 				} else {
-					result.parsedDiscovery = discovery
+					result.parsedDiscovery, result.rejectedDiscovery = parsing.Accept(discovery)
 				}
 			}
 		}
 
 	}
 
+	// Fetch all discovery jobs for capture and garbage collection, even once the template is ready
+	result.discoveryRetention = ResolveDiscoveryRetention(reconcileCtx.MergedRuntimeConfig.Value)
+	result.discoveryScheduling = ResolveDiscoveryScheduling(reconcileCtx.MergedRuntimeConfig.Value)
+	result.discoveryResources = ResolveDiscoveryResources(reconcileCtx.MergedRuntimeConfig.Value)
+	result.discoveryJobs = FetchDiscoveryJobs(ctx, c, template.Namespace, template.Name)
+	result.discoveryPods = FetchDiscoveryPods(ctx, c, template.Namespace, template.Name)
+	if result.discoveryJobs.OK() && result.discoveryPods.OK() {
+		result.discoveryOutput = CaptureDiscoveryOutput(ctx, r.Clientset,
+			result.discoveryJobs.Value, result.discoveryPods.Value,
+			template.Status.DiscoveryOutput, result.discoveryRetention.LogTailLines)
+	}
+
 	// Fetch template caches if caching is enabled
 	if template.Spec.Caching != nil && template.Spec.Caching.Enabled {
 		result.templateCaches = controllerutils.FetchList(ctx, c, &aimv1alpha1.AIMTemplateCacheList{},
@@ -168,6 +222,11 @@ func (result ServiceTemplateFetchResult) GetComponentHealth(ctx context.Context,
 		}
 	}
 
+	// Discovery output rejected by strict parsing
+	if outputHealth := GetDiscoveryOutputHealth(result.rejectedDiscovery); outputHealth.Component != "" {
+		health = append(health, outputHealth)
+	}
+
 	// GPU availability check
 	gpuHealth := result.getGPUHealth()
 	if gpuHealth.Component != "" {
@@ -199,12 +258,36 @@ type ClusterServiceTemplateFetchResult struct {
 	discoveryJob        controllerutils.FetchResult[*batchv1.Job]
 	discoveryJobPods    controllerutils.FetchResult[*corev1.PodList]
 
+	// All discovery jobs and pods for the template, used for garbage collection
+	discoveryJobs      controllerutils.FetchResult[*batchv1.JobList]
+	discoveryPods      controllerutils.FetchResult[*corev1.PodList]
+	discoveryRetention DiscoveryRetention
+
+	// How to handle discovery jobs that cannot be scheduled
+	discoveryScheduling DiscoveryScheduling
+	discoveryResources  DiscoveryResources
+
+	// Active GPU jobs across the cluster, for admitting the discovery job
+	gpuJobQueue controllerutils.FetchResult[*GPUJobQueue]
+
+	// Output captured from the newest completed discovery job, if not yet in status
+	discoveryOutput *aimv1alpha1.AIMDiscoveryOutput
+
 	// Parsed discovery results (populated when discovery job has succeeded)
 	parsedDiscovery *ParsedDiscovery
 
+	// Discovery results rejected by strict parsing because of unknown fields
+	rejectedDiscovery *ParsedDiscovery
+
 	// GPU availability state
 	gpuResources map[string]utils.GPUResourceInfo
 	gpuFetchErr  error
+
+	// AIMServices across all namespaces that resolved to this template
+	consumers controllerutils.FetchResult[*aimv1alpha1.AIMServiceList]
+
+	// Templates of the same model, for performance recommendations
+	siblingPerformance controllerutils.FetchResult[[]*aimv1alpha1.AIMProfilePerformance]
 }
 
 // FetchRemoteState fetches all required resources for cluster-scoped templates.
@@ -231,16 +314,28 @@ func (r *ClusterServiceTemplateReconciler) FetchRemoteState(
 		&aimv1alpha1.AIMClusterModel{},
 	)
 
+	// Fetch templates of the same model to compare measured performance
+	if template.Annotations[constants.AnnotationPerformance] != "" {
+		result.siblingPerformance = FetchClusterSiblingPerformance(ctx, c, template)
+	}
+
 	// Fetch GPU resources if GPU is required
 	if TemplateRequiresGPU(template.Spec.AIMServiceTemplateSpecCommon) {
 		result.gpuResources, result.gpuFetchErr = utils.GetClusterGPUResources(ctx, c)
 	}
 
-	// Fetch discovery job if template is not yet ready and has no inline model sources
+	// Fetch discovery job if template is not yet ready and has no inline model sources,
+	// or if its discovery results were superseded by a controller upgrade.
 	// Cluster templates run discovery jobs in the operator namespace
 	operatorNamespace := constants.GetOperatorNamespace()
-	if ShouldCheckClusterTemplateDiscoveryJob(template) {
+	if ShouldCheckClusterTemplateDiscoveryJob(template) || IsDiscoverySuperseded(&template.Status) {
 		result.discoveryJob = FetchDiscoveryJob(ctx, c, operatorNamespace, template.Name)
+		if IsDiscoverySuperseded(&template.Status) {
+			result.discoveryJob = currentDiscoveryJob(result.discoveryJob)
+		}
+		if template.Spec.GetCompute() != aimv1alpha1.AIMComputeModeCPU {
+			result.gpuJobQueue = FetchGPUJobQueue(ctx, c, operatorNamespace)
+		}
 
 		// Fetch discovery job pods for health inspection
 		if result.discoveryJob.OK() && result.discoveryJob.Value != nil {
@@ -253,18 +348,35 @@ func (r *ClusterServiceTemplateReconciler) FetchRemoteState(
 			// Parse discovery logs if job succeeded
 			if IsJobSucceeded(job) {
 				logger := log.FromContext(ctx)
-				discovery, err := ParseDiscoveryLogs(ctx, c, r.Clientset, job)
+				parsing := ResolveDiscoveryParsing(reconcileCtx.MergedRuntimeConfig.Value)
+				discovery, err := ParseDiscoveryLogs(ctx, c, r.Clientset, job, parsing)
 				if err != nil {
 					// Log error but don't fail - status will show job as complete
 					logger.Error(err, "Failed to parse discovery logs", "job", job.Name)
 				} else {
-					result.parsedDiscovery = discovery
+					result.parsedDiscovery, result.rejectedDiscovery = parsing.Accept(discovery)
 				}
 			}
 		}
 
 	}
 
+	// Fetch all discovery jobs for capture and garbage collection, even once the template is ready
+	result.discoveryRetention = ResolveDiscoveryRetention(reconcileCtx.MergedRuntimeConfig.Value)
+	result.discoveryScheduling = ResolveDiscoveryScheduling(reconcileCtx.MergedRuntimeConfig.Value)
+	result.discoveryResources = ResolveDiscoveryResources(reconcileCtx.MergedRuntimeConfig.Value)
+	result.discoveryJobs = FetchDiscoveryJobs(ctx, c, operatorNamespace, template.Name)
+	result.discoveryPods = FetchDiscoveryPods(ctx, c, operatorNamespace, template.Name)
+	if result.discoveryJobs.OK() && result.discoveryPods.OK() {
+		result.discoveryOutput = CaptureDiscoveryOutput(ctx, r.Clientset,
+			result.discoveryJobs.Value, result.discoveryPods.Value,
+			template.Status.DiscoveryOutput, result.discoveryRetention.LogTailLines)
+	}
+
+	// Services in any namespace using this template, for the consumers summary
+	result.consumers = controllerutils.FetchClusterScopedConsumers(ctx, c,
+		aimv1alpha1.AIMServiceResolvedTemplateIndexKey, template.Name, controllerutils.ResolvedTemplateRef)
+
 	return result
 }
 
@@ -286,6 +398,11 @@ func (result ClusterServiceTemplateFetchResult) GetComponentHealth(ctx context.C
 		}
 	}
 
+	// Discovery output rejected by strict parsing
+	if outputHealth := GetDiscoveryOutputHealth(result.rejectedDiscovery); outputHealth.Component != "" {
+		health = append(health, outputHealth)
+	}
+
 	// GPU availability check
 	gpuHealth := result.getGPUHealth()
 	if gpuHealth.Component != "" {
@@ -362,6 +479,13 @@ func (r *ServiceTemplateReconciler) PlanResources(
 	template := obs.template
 	planResult := controllerutils.PlanResult{}
 
+	// Garbage collect completed discovery jobs once their output has been captured
+	if obs.discoveryJobs.OK() && obs.discoveryPods.OK() {
+		planResult.RequeueAfter = PlanDiscoveryJobCleanup(&planResult,
+			obs.discoveryJobs.Value, obs.discoveryPods.Value,
+			template.Status.DiscoveryOutput, obs.discoveryRetention, time.Now())
+	}
+
 	// Check if model is available - required for both inline and discovery flows
 	if !obs.model.OK() {
 		logger.V(1).Info("model not found, waiting for model", "modelName", template.Spec.ModelName)
@@ -375,6 +499,12 @@ func (r *ServiceTemplateReconciler) PlanResources(
 		return planResult
 	}
 
+	// Don't schedule discovery for an image the model's preflight already rejected
+	if IsModelImageRejected(model.Status.Conditions) {
+		logger.V(1).Info("model image rejected by preflight, skipping discovery", "modelName", template.Spec.ModelName)
+		return planResult
+	}
+
 	// Check GPU availability first - required for both custom and discovery-based models
 	if !obs.isGPUAvailable() {
 		logger.V(1).Info("required GPU not available, skipping resource planning")
@@ -405,10 +535,14 @@ func (r *ServiceTemplateReconciler) PlanResources(
 			}
 		}
 
-		return planResult
+		// Re-run discovery when the results came from an older discovery job builder
+		if !IsDiscoverySuperseded(&template.Status) {
+			return planResult
+		}
+		logger.V(1).Info("discovery results superseded by discovery job builder change, re-running discovery")
 	}
 
-	// Template not ready - check if we need to create discovery job
+	// Template not ready or superseded - check if we need to create discovery job
 	hasCompletedJob := HasCompletedDiscoveryJob(obs.discoveryJob)
 	hasActiveJob := HasActiveDiscoveryJob(obs.discoveryJob)
 
@@ -417,6 +551,47 @@ func (r *ServiceTemplateReconciler) PlanResources(
 		"hasActiveJob", hasActiveJob,
 		"jobExists", obs.discoveryJob.Value != nil)
 
+	imageMetadata := model.Spec.GetEffectiveImageMetadata(&model.Status)
+	jobSpec := DiscoveryJobSpec{
+		TemplateName:     template.Name,
+		Namespace:        template.Namespace,
+		ModelID:          template.Spec.ModelName,
+		Image:            image,
+		Env:              utils.WithProxyEnv(template.Spec.Env, obs.mergedRuntimeConfig.Value),
+		ImagePullSecrets: model.Spec.ImagePullSecrets,
+		ServiceAccount:   model.Spec.ServiceAccountName,
+		TemplateSpec:     template.Spec.AIMServiceTemplateSpecCommon,
+		CPUOnly:          obs.discoveryResources.UseCPUDiscovery(&template.Spec.AIMServiceTemplateSpecCommon, imageMetadata),
+		Resources:        obs.discoveryResources.Resources,
+		RequestGPUs:      obs.discoveryResources.RequestGPUs,
+		Scheduling:       utils.ResolveScheduling(nil, obs.mergedRuntimeConfig.Value),
+		OwnerRef: metav1.OwnerReference{
+			APIVersion:         aimv1alpha1.GroupVersion.String(),
+			Kind:               "AIMServiceTemplate",
+			Name:               template.Name,
+			UID:                template.UID,
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		},
+	}
+
+	// Fall back to CPU-only discovery once the active job has been unschedulable for too long
+	if hasActiveJob && obs.discoveryJobPods.OK() {
+		wait := PlanDiscoveryCPUFallback(&planResult, obs.discoveryJob.Value, obs.discoveryJobPods.Value,
+			obs.discoveryScheduling, imageMetadata, jobSpec, time.Now())
+		if wait > 0 && (planResult.RequeueAfter == 0 || wait < planResult.RequeueAfter) {
+			planResult.RequeueAfter = wait
+		}
+	}
+
+	// Start a queued discovery job once a GPU job slot is available for it
+	if hasActiveJob && IsJobSuspended(obs.discoveryJob.Value) {
+		wait := PlanDiscoveryJob(&planResult, obs.discoveryJob.Value, jobSpec, obs.gpuJobQueue)
+		if wait > 0 && (planResult.RequeueAfter == 0 || wait < planResult.RequeueAfter) {
+			planResult.RequeueAfter = wait
+		}
+	}
+
 	if !hasCompletedJob && !hasActiveJob {
 		// Compute spec hash for backoff reset detection
 		specHash := ComputeDiscoverySpecHash(template.Spec.AIMServiceTemplateSpecCommon, template.Spec.ModelName, image)
@@ -431,46 +606,12 @@ func (r *ServiceTemplateReconciler) PlanResources(
 			return planResult
 		}
 
-		// Check concurrent job limit - we're protected by the discovery lock at the controller level
-		// so this count + create is atomic across all reconcilers
-		activeJobs, err := CountActiveDiscoveryJobs(ctx, r.Client)
-		if err != nil {
-			logger.Error(err, "failed to count active discovery jobs")
-			return planResult
-		}
-
-		if activeJobs >= constants.MaxConcurrentDiscoveryJobs {
-			logger.V(1).Info("discovery job creation blocked by concurrent limit",
-				"activeJobs", activeJobs,
-				"limit", constants.MaxConcurrentDiscoveryJobs)
-			// Signal controller to requeue after a delay to try again
-			planResult.RequeueAfter = 5 * time.Second
-			return planResult
+		// Create the job, suspended if the GPU job limits don't admit it yet. We're protected by the
+		// discovery lock at the controller level, so the queue snapshot can't change under us.
+		logger.V(1).Info("planning discovery job")
+		if wait := PlanDiscoveryJob(&planResult, nil, jobSpec, obs.gpuJobQueue); wait > 0 {
+			planResult.RequeueAfter = wait
 		}
-
-		logger.V(1).Info("creating discovery job",
-			"activeJobs", activeJobs,
-			"limit", constants.MaxConcurrentDiscoveryJobs)
-
-		job := BuildDiscoveryJob(DiscoveryJobSpec{
-			TemplateName:     template.Name,
-			Namespace:        template.Namespace,
-			ModelID:          template.Spec.ModelName,
-			Image:            image,
-			Env:              template.Spec.Env,
-			ImagePullSecrets: model.Spec.ImagePullSecrets,
-			ServiceAccount:   model.Spec.ServiceAccountName,
-			TemplateSpec:     template.Spec.AIMServiceTemplateSpecCommon,
-			OwnerRef: metav1.OwnerReference{
-				APIVersion:         aimv1alpha1.GroupVersion.String(),
-				Kind:               "AIMServiceTemplate",
-				Name:               template.Name,
-				UID:                template.UID,
-				Controller:         ptr.To(true),
-				BlockOwnerDeletion: ptr.To(true),
-			},
-		})
-		planResult.Apply(job)
 	}
 
 	return planResult
@@ -490,6 +631,13 @@ func (r *ClusterServiceTemplateReconciler) PlanResources(
 	template := obs.template
 	planResult := controllerutils.PlanResult{}
 
+	// Garbage collect completed discovery jobs once their output has been captured
+	if obs.discoveryJobs.OK() && obs.discoveryPods.OK() {
+		planResult.RequeueAfter = PlanDiscoveryJobCleanup(&planResult,
+			obs.discoveryJobs.Value, obs.discoveryPods.Value,
+			template.Status.DiscoveryOutput, obs.discoveryRetention, time.Now())
+	}
+
 	// Check if cluster model is available - required for both inline and discovery flows
 	if !obs.clusterModel.OK() {
 		logger.V(1).Info("cluster model not found, waiting for model", "modelName", template.Spec.ModelName)
@@ -503,6 +651,12 @@ func (r *ClusterServiceTemplateReconciler) PlanResources(
 		return planResult
 	}
 
+	// Don't schedule discovery for an image the cluster model's preflight already rejected
+	if IsModelImageRejected(clusterModel.Status.Conditions) {
+		logger.V(1).Info("cluster model image rejected by preflight, skipping discovery", "modelName", template.Spec.ModelName)
+		return planResult
+	}
+
 	// Check GPU availability first - required for both custom and discovery-based models
 	if !obs.isGPUAvailable() {
 		logger.V(1).Info("required GPU not available, skipping resource planning")
@@ -515,17 +669,62 @@ func (r *ClusterServiceTemplateReconciler) PlanResources(
 		return planResult
 	}
 
-	// If template is Ready, nothing more to plan
+	// If template is Ready, nothing more to plan unless its results came from an older
+	// discovery job builder
 	if template.Status.Status == constants.AIMStatusReady {
-		return planResult
+		if !IsDiscoverySuperseded(&template.Status) {
+			return planResult
+		}
+		logger.V(1).Info("discovery results superseded by discovery job builder change, re-running discovery")
 	}
 
-	// Template not ready - check if we need to create discovery job
+	// Template not ready or superseded - check if we need to create discovery job
 	hasCompletedJob := HasCompletedDiscoveryJob(obs.discoveryJob)
 	hasActiveJob := HasActiveDiscoveryJob(obs.discoveryJob)
 
 	operatorNamespace := constants.GetOperatorNamespace()
 
+	imageMetadata := clusterModel.Spec.GetEffectiveImageMetadata(&clusterModel.Status)
+	jobSpec := DiscoveryJobSpec{
+		TemplateName:     template.Name,
+		Namespace:        operatorNamespace,
+		ModelID:          template.Spec.ModelName,
+		Image:            image,
+		Env:              utils.WithProxyEnv(nil, obs.mergedRuntimeConfig.Value), // Cluster templates don't have env vars
+		ImagePullSecrets: clusterModel.Spec.ImagePullSecrets,
+		ServiceAccount:   clusterModel.Spec.ServiceAccountName,
+		TemplateSpec:     template.Spec.AIMServiceTemplateSpecCommon,
+		CPUOnly:          obs.discoveryResources.UseCPUDiscovery(&template.Spec.AIMServiceTemplateSpecCommon, imageMetadata),
+		Resources:        obs.discoveryResources.Resources,
+		RequestGPUs:      obs.discoveryResources.RequestGPUs,
+		Scheduling:       utils.ResolveScheduling(nil, obs.mergedRuntimeConfig.Value),
+		OwnerRef: metav1.OwnerReference{
+			APIVersion:         aimv1alpha1.GroupVersion.String(),
+			Kind:               "AIMClusterServiceTemplate",
+			Name:               template.Name,
+			UID:                template.UID,
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		},
+	}
+
+	// Fall back to CPU-only discovery once the active job has been unschedulable for too long
+	if hasActiveJob && obs.discoveryJobPods.OK() {
+		wait := PlanDiscoveryCPUFallback(&planResult, obs.discoveryJob.Value, obs.discoveryJobPods.Value,
+			obs.discoveryScheduling, imageMetadata, jobSpec, time.Now())
+		if wait > 0 && (planResult.RequeueAfter == 0 || wait < planResult.RequeueAfter) {
+			planResult.RequeueAfter = wait
+		}
+	}
+
+	// Start a queued discovery job once a GPU job slot is available for it
+	if hasActiveJob && IsJobSuspended(obs.discoveryJob.Value) {
+		wait := PlanDiscoveryJob(&planResult, obs.discoveryJob.Value, jobSpec, obs.gpuJobQueue)
+		if wait > 0 && (planResult.RequeueAfter == 0 || wait < planResult.RequeueAfter) {
+			planResult.RequeueAfter = wait
+		}
+	}
+
 	if !hasCompletedJob && !hasActiveJob {
 		// Compute spec hash for backoff reset detection
 		specHash := ComputeDiscoverySpecHash(template.Spec.AIMServiceTemplateSpecCommon, template.Spec.ModelName, image)
@@ -540,46 +739,12 @@ func (r *ClusterServiceTemplateReconciler) PlanResources(
 			return planResult
 		}
 
-		// Check concurrent job limit - we're protected by the discovery lock at the controller level
-		// so this count + create is atomic across all reconcilers
-		activeJobs, err := CountActiveDiscoveryJobs(ctx, r.Client)
-		if err != nil {
-			logger.Error(err, "failed to count active discovery jobs")
-			return planResult
+		// Create the job, suspended if the GPU job limits don't admit it yet. We're protected by the
+		// discovery lock at the controller level, so the queue snapshot can't change under us.
+		logger.V(1).Info("planning discovery job")
+		if wait := PlanDiscoveryJob(&planResult, nil, jobSpec, obs.gpuJobQueue); wait > 0 {
+			planResult.RequeueAfter = wait
 		}
-
-		if activeJobs >= constants.MaxConcurrentDiscoveryJobs {
-			logger.V(1).Info("discovery job creation blocked by concurrent limit",
-				"activeJobs", activeJobs,
-				"limit", constants.MaxConcurrentDiscoveryJobs)
-			// Signal controller to requeue after a delay to try again
-			planResult.RequeueAfter = 5 * time.Second
-			return planResult
-		}
-
-		logger.V(1).Info("creating discovery job",
-			"activeJobs", activeJobs,
-			"limit", constants.MaxConcurrentDiscoveryJobs)
-
-		job := BuildDiscoveryJob(DiscoveryJobSpec{
-			TemplateName:     template.Name,
-			Namespace:        operatorNamespace,
-			ModelID:          template.Spec.ModelName,
-			Image:            image,
-			Env:              nil, // Cluster templates don't have env vars
-			ImagePullSecrets: clusterModel.Spec.ImagePullSecrets,
-			ServiceAccount:   clusterModel.Spec.ServiceAccountName,
-			TemplateSpec:     template.Spec.AIMServiceTemplateSpecCommon,
-			OwnerRef: metav1.OwnerReference{
-				APIVersion:         aimv1alpha1.GroupVersion.String(),
-				Kind:               "AIMClusterServiceTemplate",
-				Name:               template.Name,
-				UID:                template.UID,
-				Controller:         ptr.To(true),
-				BlockOwnerDeletion: ptr.To(true),
-			},
-		})
-		planResult.Apply(job)
 	}
 
 	return planResult
@@ -601,10 +766,30 @@ func (r *ServiceTemplateReconciler) DecorateStatus(
 		specHash = ComputeDiscoverySpecHash(obs.template.Spec.AIMServiceTemplateSpecCommon, obs.template.Spec.ModelName, obs.model.Value.Spec.Image)
 	}
 
+	setDiscoveryBuilderStatus(status, cm, &obs.template.Spec.AIMServiceTemplateSpecCommon,
+		obs.discoveryJob, obs.parsedDiscovery, obs.gpuResources)
 	decorateTemplateStatusCommon(
 		status, cm, &obs.template.Spec.AIMServiceTemplateSpecCommon, obs.discoveryJob, obs.parsedDiscovery,
 		obs.template.Status.Discovery, specHash, obs.gpuResources,
 	)
+	setDiscoverySchedulingCondition(cm, &obs.template.Spec.AIMServiceTemplateSpecCommon, obs.discoveryJob, obs.discoveryJobPods)
+	setDiscoveryQueueStatus(status, cm, obs.discoveryJob, obs.gpuJobQueue)
+	setBaseTemplateChangedCondition(cm, obs.template, obs.baseTemplate)
+	setBaseDeletedCondition(cm, obs.template, obs.baseTemplate)
+	setTemplateCacheReadinessConditions(cm, obs.template, obs.templateCaches)
+
+	setPerformanceStatus(status, cm, obs.template.Annotations, obs.siblingPerformance)
+
+	// Persist captured discovery output so it outlives the discovery job
+	if obs.discoveryOutput != nil {
+		status.DiscoveryOutput = obs.discoveryOutput
+	}
+
+	// Record unrecognized discovery output fields whenever output was parsed
+	if warnings, ok := discoveryWarnings(obs.parsedDiscovery, obs.rejectedDiscovery); ok {
+		status.DiscoveryWarnings = warnings
+	}
+	setProfilesVerifiedCondition(cm, obs.parsedDiscovery, obs.rejectedDiscovery)
 
 	// Set resolved model reference if available
 	if obs.model.Value != nil {
@@ -627,10 +812,27 @@ func (r *ClusterServiceTemplateReconciler) DecorateStatus(
 		specHash = ComputeDiscoverySpecHash(obs.template.Spec.AIMServiceTemplateSpecCommon, obs.template.Spec.ModelName, obs.clusterModel.Value.Spec.Image)
 	}
 
+	setDiscoveryBuilderStatus(status, cm, &obs.template.Spec.AIMServiceTemplateSpecCommon,
+		obs.discoveryJob, obs.parsedDiscovery, obs.gpuResources)
 	decorateTemplateStatusCommon(
 		status, cm, &obs.template.Spec.AIMServiceTemplateSpecCommon, obs.discoveryJob, obs.parsedDiscovery,
 		obs.template.Status.Discovery, specHash, obs.gpuResources,
 	)
+	setDiscoverySchedulingCondition(cm, &obs.template.Spec.AIMServiceTemplateSpecCommon, obs.discoveryJob, obs.discoveryJobPods)
+	setDiscoveryQueueStatus(status, cm, obs.discoveryJob, obs.gpuJobQueue)
+
+	setPerformanceStatus(status, cm, obs.template.Annotations, obs.siblingPerformance)
+
+	// Persist captured discovery output so it outlives the discovery job
+	if obs.discoveryOutput != nil {
+		status.DiscoveryOutput = obs.discoveryOutput
+	}
+
+	// Record unrecognized discovery output fields whenever output was parsed
+	if warnings, ok := discoveryWarnings(obs.parsedDiscovery, obs.rejectedDiscovery); ok {
+		status.DiscoveryWarnings = warnings
+	}
+	setProfilesVerifiedCondition(cm, obs.parsedDiscovery, obs.rejectedDiscovery)
 
 	// Set resolved model reference if available
 	if obs.clusterModel.Value != nil {
@@ -638,6 +840,11 @@ func (r *ClusterServiceTemplateReconciler) DecorateStatus(
 			Name: obs.clusterModel.Value.Name,
 		}
 	}
+
+	// Summarize consuming services; keep the previous summary if listing failed
+	if obs.consumers.OK() {
+		status.Consumers = controllerutils.BuildConsumersStatus(obs.consumers.Value.Items)
+	}
 }
 
 // decorateTemplateStatusCommon handles shared status decoration for both namespace and cluster-scoped templates.
@@ -706,15 +913,10 @@ func decorateTemplateStatusCommon(
 
 	// Set parsed discovery results if available
 	if parsedDiscovery != nil {
-		status.ModelSources = parsedDiscovery.ModelSources
-		if parsedDiscovery.Profile != nil {
-			status.Profile = parsedDiscovery.Profile
+		applyParsedDiscovery(status, spec, parsedDiscovery, gpuResources)
+		if discoveryJobResult.OK() {
+			recordDiscoveryTiming(status, discoveryJobResult.Value)
 		}
-		// Resolve hardware from discovery + spec fallback
-		status.ResolvedHardware = resolveHardware(parsedDiscovery, spec)
-		status.HardwareSummary = formatHardwareSummary(status.ResolvedHardware)
-		// Compute node affinity from GPU requirements and cluster resources
-		status.ResolvedNodeAffinity = BuildNodeAffinityFromGPURequirements(*spec, gpuResources)
 		cm.MarkTrue("Discovered", "DiscoveryComplete", "Discovery job completed successfully")
 	}
 
@@ -723,6 +925,24 @@ func decorateTemplateStatusCommon(
 		"Waiting for discovery to complete")
 }
 
+// applyParsedDiscovery copies discovery results into the template status.
+func applyParsedDiscovery(
+	status *aimv1alpha1.AIMServiceTemplateStatus,
+	spec *aimv1alpha1.AIMServiceTemplateSpecCommon,
+	parsedDiscovery *ParsedDiscovery,
+	gpuResources map[string]utils.GPUResourceInfo,
+) {
+	status.ModelSources = parsedDiscovery.ModelSources
+	if parsedDiscovery.Profile != nil {
+		status.Profile = parsedDiscovery.Profile
+	}
+	// Resolve hardware from discovery + spec fallback
+	status.ResolvedHardware = resolveHardware(parsedDiscovery, spec)
+	status.HardwareSummary = formatHardwareSummary(status.ResolvedHardware)
+	// Compute node affinity from GPU requirements and cluster resources
+	status.ResolvedNodeAffinity = BuildNodeAffinityFromGPURequirements(*spec, gpuResources)
+}
+
 // buildProfileFromSpec creates an AIMProfile from template spec for custom models.
 // This is used when discovery doesn't run (inline model sources) to populate
 // the status.Profile with GPU count and other metadata from the spec.
@@ -763,12 +983,17 @@ func resolveHardware(discovery *ParsedDiscovery, spec *aimv1alpha1.AIMServiceTem
 	var gpuModel string
 	var resourceName string
 
+	cpuMode := spec.GetCompute() == aimv1alpha1.AIMComputeModeCPU
+
 	// Resource name always comes from spec (discovery doesn't provide this)
 	if spec.Hardware != nil && spec.Hardware.GPU != nil {
 		resourceName = spec.Hardware.GPU.ResourceName
 	}
 
-	if discovery != nil && discovery.Profile != nil {
+	if cpuMode {
+		// CPU mode never requests GPUs, regardless of what discovery reports
+		resourceName = ""
+	} else if discovery != nil && discovery.Profile != nil {
 		// Discovery ran - use discovery values (even if 0)
 		gpuCount = discovery.Profile.Metadata.GPUCount
 		gpuModel = discovery.Profile.Metadata.GPU
@@ -842,6 +1067,28 @@ func formatHardwareSummary(hw *aimv1alpha1.AIMHardwareRequirements) string {
 	return "CPU"
 }
 
+// recordDiscoveryTiming records the image and run time of a successful discovery job.
+// The start time is set by the job controller once the job is unsuspended, so time spent
+// waiting for a GPU job slot is not counted.
+func recordDiscoveryTiming(status *aimv1alpha1.AIMServiceTemplateStatus, job *batchv1.Job) {
+	if job == nil || !IsJobSucceeded(job) || job.Status.StartTime == nil || job.Status.CompletionTime == nil {
+		return
+	}
+	if status.Discovery == nil {
+		status.Discovery = &aimv1alpha1.DiscoveryState{}
+	}
+	for _, container := range job.Spec.Template.Spec.Containers {
+		if container.Name == discoveryContainerName {
+			status.Discovery.Image = container.Image
+		}
+	}
+	status.Discovery.StartTime = job.Status.StartTime.DeepCopy()
+	status.Discovery.CompletionTime = job.Status.CompletionTime.DeepCopy()
+	status.Discovery.Duration = &metav1.Duration{
+		Duration: job.Status.CompletionTime.Sub(job.Status.StartTime.Time),
+	}
+}
+
 // updateDiscoveryStateOnFailure updates the discovery tracking state when a job fails.
 // This increments the attempt counter and records the failure time for backoff calculation.
 // The specHash parameter is stored to enable backoff reset when the spec changes.
@@ -101,6 +101,9 @@ func getMetricShorthand(metric string) string {
 
 // TemplateRequiresGPU returns true if the template spec declares GPU requirements with models.
 func TemplateRequiresGPU(spec aimv1alpha1.AIMServiceTemplateSpecCommon) bool {
+	if spec.GetCompute() == aimv1alpha1.AIMComputeModeCPU {
+		return false
+	}
 	if spec.Hardware == nil || spec.Hardware.GPU == nil {
 		return false
 	}
@@ -270,6 +270,20 @@ func TestTemplateRequiresGPU(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "CPU compute mode ignores GPU hardware",
+			spec: aimv1alpha1.AIMServiceTemplateSpecCommon{
+				ModelName: "test-model",
+				Compute:   aimv1alpha1.AIMComputeModeCPU,
+				AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
+					Hardware: &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{
+						Model:    "mi300x",
+						Requests: 2,
+					}},
+				},
+			},
+			expected: false,
+		},
 		{
 			name: "GPU with model and whitespace padding",
 			spec: aimv1alpha1.AIMServiceTemplateSpecCommon{
@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimtemplatecache
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// metricsCollectTimeout bounds how long a single scrape may spend reading from the cache.
+const metricsCollectTimeout = 10 * time.Second
+
+var templateCacheMetricLabels = []string{"namespace", "template_cache", "template"}
+
+var (
+	templateCacheHitsDesc = prometheus.NewDesc(
+		"aim_template_cache_hits_total",
+		"Number of services that found the template cache warm when they first used it.",
+		templateCacheMetricLabels, nil,
+	)
+	templateCacheMissesDesc = prometheus.NewDesc(
+		"aim_template_cache_misses_total",
+		"Number of services that had to wait for the template cache to be downloaded.",
+		templateCacheMetricLabels, nil,
+	)
+)
+
+// TemplateCacheMetricsCollector publishes the lookup counters recorded in AIMTemplateCache status.
+// Values are read from the manager cache at scrape time, so series for deleted caches disappear
+// without explicit cleanup.
+type TemplateCacheMetricsCollector struct {
+	reader client.Reader
+}
+
+// NewTemplateCacheMetricsCollector creates a collector reading from the given (typically cached) reader.
+func NewTemplateCacheMetricsCollector(reader client.Reader) *TemplateCacheMetricsCollector {
+	return &TemplateCacheMetricsCollector{reader: reader}
+}
+
+// Describe implements prometheus.Collector.
+func (c *TemplateCacheMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- templateCacheHitsDesc
+	ch <- templateCacheMissesDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *TemplateCacheMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancel()
+	logger := logf.FromContext(ctx).WithName("aimtemplatecache-metrics")
+
+	var caches aimv1alpha1.AIMTemplateCacheList
+	if err := c.reader.List(ctx, &caches); err != nil {
+		logger.Error(err, "failed to list AIMTemplateCaches for metrics")
+		return
+	}
+
+	for i := range caches.Items {
+		cache := &caches.Items[i]
+		usage := cache.Status.Usage
+		if usage == nil {
+			continue
+		}
+		labels := []string{cache.Namespace, cache.Name, cache.Spec.TemplateName}
+		ch <- prometheus.MustNewConstMetric(templateCacheHitsDesc, prometheus.CounterValue, float64(usage.Hits), labels...)
+		ch <- prometheus.MustNewConstMetric(templateCacheMissesDesc, prometheus.CounterValue, float64(usage.Misses), labels...)
+	}
+}
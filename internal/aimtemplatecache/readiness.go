@@ -0,0 +1,92 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimtemplatecache
+
+import (
+	"fmt"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// IsProvisioned reports whether every artifact of the cache has a volume claim, so that the
+// cache can be mounted while the artifacts are still downloading.
+func IsProvisioned(cache *aimv1alpha1.AIMTemplateCache) bool {
+	if cache == nil || len(cache.Status.Artifacts) == 0 || cache.Status.Status == constants.AIMStatusFailed {
+		return false
+	}
+	for _, artifact := range cache.Status.Artifacts {
+		if artifact.PersistentVolumeClaim == "" || artifact.Status == constants.AIMStatusFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// IsWarm reports whether all model artifacts of the cache are downloaded.
+func IsWarm(cache *aimv1alpha1.AIMTemplateCache) bool {
+	return cache != nil && cache.Status.Status == constants.AIMStatusReady
+}
+
+// SetReadinessConditions sets the CacheProvisioned and CacheWarm conditions of a service or
+// template that uses the cache. Both conditions are removed when there is no cache.
+func SetReadinessConditions(cm *controllerutils.ConditionManager, cache *aimv1alpha1.AIMTemplateCache) {
+	if cache == nil {
+		cm.Delete(aimv1alpha1.AIMTemplateCacheConditionProvisioned)
+		cm.Delete(aimv1alpha1.AIMTemplateCacheConditionWarm)
+		return
+	}
+
+	if IsProvisioned(cache) || IsWarm(cache) {
+		cm.MarkTrue(aimv1alpha1.AIMTemplateCacheConditionProvisioned, aimv1alpha1.AIMTemplateCacheReasonProvisioned,
+			fmt.Sprintf("Volumes of template cache %q are provisioned", cache.Name))
+	} else {
+		cm.MarkFalse(aimv1alpha1.AIMTemplateCacheConditionProvisioned, aimv1alpha1.AIMTemplateCacheReasonProvisioning,
+			fmt.Sprintf("Waiting for the volumes of template cache %q", cache.Name), controllerutils.AsInfo())
+	}
+
+	switch {
+	case IsWarm(cache):
+		cm.MarkTrue(aimv1alpha1.AIMTemplateCacheConditionWarm, aimv1alpha1.AIMTemplateCacheReasonWarm,
+			fmt.Sprintf("All model artifacts of template cache %q are downloaded", cache.Name))
+	case cache.Status.Status == constants.AIMStatusFailed:
+		cm.MarkFalse(aimv1alpha1.AIMTemplateCacheConditionWarm, aimv1alpha1.AIMTemplateCacheReasonFailed,
+			fmt.Sprintf("Template cache %q failed", cache.Name), controllerutils.AsError())
+	default:
+		cm.MarkFalse(aimv1alpha1.AIMTemplateCacheConditionWarm, aimv1alpha1.AIMTemplateCacheReasonWarming,
+			fmt.Sprintf("%d of %d model artifacts of template cache %q are downloaded",
+				readyArtifacts(cache), len(cache.Status.Artifacts), cache.Name), controllerutils.AsInfo())
+	}
+}
+
+// readyArtifacts returns the number of downloaded artifacts of the cache.
+func readyArtifacts(cache *aimv1alpha1.AIMTemplateCache) int {
+	count := 0
+	for _, artifact := range cache.Status.Artifacts {
+		if artifact.Status == constants.AIMStatusReady {
+			count++
+		}
+	}
+	return count
+}
@@ -24,10 +24,12 @@ package aimtemplatecache
 
 import (
 	"context"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -106,6 +108,9 @@ type TemplateCacheFetchResult struct {
 	serviceTemplate        controllerutils.FetchResult[*aimv1alpha1.AIMServiceTemplate]
 	clusterServiceTemplate *controllerutils.FetchResult[*aimv1alpha1.AIMClusterServiceTemplate]
 	artifacts              controllerutils.FetchResult[*aimv1alpha1.AIMArtifactList]
+
+	// Services in the namespace, for counting their cache lookups
+	services controllerutils.FetchResult[*aimv1alpha1.AIMServiceList]
 }
 
 func (r *TemplateCacheReconciler) FetchRemoteState(
@@ -140,6 +145,7 @@ func (r *TemplateCacheReconciler) FetchRemoteState(
 
 	// Fetch all artifacts in the namespace
 	result.artifacts = controllerutils.FetchList(ctx, c, &aimv1alpha1.AIMArtifactList{}, client.InNamespace(templateCache.Namespace))
+	result.services = controllerutils.FetchList(ctx, c, &aimv1alpha1.AIMServiceList{}, client.InNamespace(templateCache.Namespace))
 
 	return result
 }
@@ -252,6 +258,7 @@ func (r *TemplateCacheReconciler) ComposeState(
 	}
 
 	obs.BestArtifacts = map[string]aimv1alpha1.AIMArtifact{}
+	encryption := utils.ResolveStorageEncryption(tc.Spec.Encryption, fetch.mergedRuntimeConfig.Value)
 
 	logger.V(1).Info("ComposeState: checking artifacts",
 		"templateCache", tc.Name,
@@ -284,9 +291,11 @@ func (r *TemplateCacheReconciler) ComposeState(
 				continue
 			}
 
-			// Artifact is a match if it has the same SourceURI and a StorageClass matching our config
+			// Artifact is a match if it has the same SourceURI, a StorageClass matching our config
+			// and was encrypted with the same key (or both are unencrypted)
 			if cached.Spec.SourceURI == model.SourceURI &&
-				(tc.Spec.StorageClassName == "" || tc.Spec.StorageClassName == cached.Spec.StorageClassName) {
+				(tc.Spec.StorageClassName == "" || tc.Spec.StorageClassName == cached.Spec.StorageClassName) &&
+				encryption.UsesSameKey(cached.Spec.Encryption) {
 				// Select the first matching cache, or replace with a better one
 				// Note: !found is needed because CompareAIMStatus("", "Failed") returns 0 (equal),
 				// since empty string gets priority 0 from the map (same as Failed)
@@ -322,9 +331,10 @@ func (r *TemplateCacheReconciler) PlanResources(
 ) controllerutils.PlanResult {
 	tc := reconcileCtx.Object
 	result := controllerutils.PlanResult{}
+	encryption := utils.ResolveStorageEncryption(tc.Spec.Encryption, obs.mergedRuntimeConfig.Value)
 
 	for idx, cache := range obs.MissingCaches {
-		artifactName, _ := generateArtifactName(tc, cache)
+		artifactName, _ := generateArtifactName(tc, cache, encryption)
 
 		// Sanitize template cache name for label value
 		templateCacheLabelValue, _ := utils.SanitizeLabelValue(tc.Name)
@@ -347,6 +357,7 @@ func (r *TemplateCacheReconciler) PlanResources(
 			},
 			Spec: aimv1alpha1.AIMArtifactSpec{
 				StorageClassName: tc.Spec.StorageClassName,
+				Encryption:       encryption.DeepCopy(),
 				SourceURI:        cache.SourceURI,
 				ModelID:          cache.ModelID,
 				Size:             getSizeOrZero(cache.Size),
@@ -372,7 +383,12 @@ func (r *TemplateCacheReconciler) PlanResources(
 // generateArtifactName returns a deterministic artifact name.
 // Shared caches keep cross-cache reuse behavior by not scoping names to template cache.
 // Dedicated caches scope names to template cache so dedicated/shared artifacts can coexist.
-func generateArtifactName(tc *aimv1alpha1.AIMTemplateCache, modelSource aimv1alpha1.AIMModelSource) (string, error) {
+// Encrypted artifacts are scoped to their key so they never collide with plain copies.
+func generateArtifactName(
+	tc *aimv1alpha1.AIMTemplateCache,
+	modelSource aimv1alpha1.AIMModelSource,
+	encryption *aimv1alpha1.AIMStorageEncryptionConfig,
+) (string, error) {
 	// Replace dots with dashes first to ensure DNS-compliant names (dots cause warnings in Pod names)
 	nameWithoutDots := strings.ReplaceAll(modelSource.SourceURI, ".", "-")
 	hashInputs := []any{
@@ -384,6 +400,9 @@ func generateArtifactName(tc *aimv1alpha1.AIMTemplateCache, modelSource aimv1alp
 	if tc.Spec.Mode == aimv1alpha1.TemplateCacheModeDedicated {
 		hashInputs = append(hashInputs, "dedicated", tc.Name)
 	}
+	if encryption != nil {
+		hashInputs = append(hashInputs, "encrypted", encryption.KMSSecretRef.Name, encryption.KMSSecretRef.Key)
+	}
 
 	return utils.GenerateDerivedName(
 		[]string{nameWithoutDots},
@@ -462,17 +481,84 @@ func (r *TemplateCacheReconciler) DecorateStatus(
 	if len(obs.BestArtifacts) > 0 {
 		status.Artifacts = make(map[string]aimv1alpha1.AIMResolvedArtifact, len(obs.BestArtifacts))
 		for modelName, mc := range obs.BestArtifacts {
-			status.Artifacts[mc.Name] = aimv1alpha1.AIMResolvedArtifact{
+			resolved := aimv1alpha1.AIMResolvedArtifact{
 				UID:                   string(mc.UID),
 				Name:                  mc.Name,
 				Model:                 modelName,
 				Status:                mc.Status.Status,
 				PersistentVolumeClaim: mc.Status.PersistentVolumeClaim,
 			}
+			if provenance := mc.Status.Provenance; provenance != nil {
+				resolved.Revision = provenance.Revision
+				resolved.ManifestDigest = provenance.ManifestDigest
+			}
+			status.Artifacts[mc.Name] = resolved
 		}
 	} else {
 		status.Artifacts = nil
 	}
+
+	setStorageProvisionedCondition(cm, obs.BestArtifacts)
+	setStorageUndersizedCondition(cm, obs.BestArtifacts)
+
+	// Count cache hits and misses of the services using the cache; keep the counts if listing failed
+	if obs.services.OK() {
+		status.Usage = countCacheLookups(status.Usage, obs.templateCache.Name, obs.services.Value.Items)
+	}
+}
+
+// setStorageProvisionedCondition mirrors the artifacts' StorageProvisioned conditions onto the
+// template cache. It is False if any artifact's PVC cannot be provisioned, True once all are
+// provisioned, and left unset while any artifact has not reported yet.
+func setStorageProvisionedCondition(cm *controllerutils.ConditionManager, artifacts map[string]aimv1alpha1.AIMArtifact) {
+	if len(artifacts) == 0 {
+		return
+	}
+
+	// Iterate in a stable order so the reported artifact doesn't flap between reconciles
+	keys := slices.Sorted(maps.Keys(artifacts))
+
+	allProvisioned := true
+	for _, key := range keys {
+		artifact := artifacts[key]
+		cond := meta.FindStatusCondition(artifact.Status.Conditions, aimv1alpha1.ArtifactConditionStorageProvisioned)
+		if cond == nil {
+			allProvisioned = false
+			continue
+		}
+		if cond.Status == metav1.ConditionFalse && cond.Reason != aimv1alpha1.ArtifactReasonStoragePending {
+			cm.MarkFalse(aimv1alpha1.ArtifactConditionStorageProvisioned, cond.Reason,
+				"Artifact "+artifact.Name+": "+cond.Message, controllerutils.AsWarning())
+			return
+		}
+		if cond.Status != metav1.ConditionTrue {
+			allProvisioned = false
+		}
+	}
+
+	if allProvisioned {
+		cm.MarkTrue(aimv1alpha1.ArtifactConditionStorageProvisioned, aimv1alpha1.ArtifactReasonStorageBound, "All artifact PVCs are bound")
+	}
+}
+
+// setStorageUndersizedCondition mirrors the artifacts' StorageUndersized conditions onto the
+// template cache, listing every undersized artifact. It is removed when none are undersized.
+func setStorageUndersizedCondition(cm *controllerutils.ConditionManager, artifacts map[string]aimv1alpha1.AIMArtifact) {
+	var messages []string
+	for _, key := range slices.Sorted(maps.Keys(artifacts)) {
+		artifact := artifacts[key]
+		if meta.IsStatusConditionTrue(artifact.Status.Conditions, aimv1alpha1.ArtifactConditionStorageUndersized) {
+			cond := meta.FindStatusCondition(artifact.Status.Conditions, aimv1alpha1.ArtifactConditionStorageUndersized)
+			messages = append(messages, "Artifact "+artifact.Name+": "+cond.Message)
+		}
+	}
+
+	if len(messages) == 0 {
+		cm.Delete(aimv1alpha1.ArtifactConditionStorageUndersized)
+		return
+	}
+	cm.MarkTrue(aimv1alpha1.ArtifactConditionStorageUndersized, aimv1alpha1.ArtifactReasonInsufficientCapacity,
+		strings.Join(messages, "; "), controllerutils.AsWarning())
 }
 
 // getSizeOrZero returns the size value or zero quantity if nil.
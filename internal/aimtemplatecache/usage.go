@@ -0,0 +1,70 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package aimtemplatecache
+
+import (
+	"slices"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// countCacheLookups adds the lookups of services using the cache that have not been counted
+// yet to the usage counters. Services that no longer use the cache are dropped from the
+// counted list, so it stays bounded by the current consumers while the counters keep growing.
+func countCacheLookups(
+	usage *aimv1alpha1.AIMTemplateCacheUsage,
+	cacheName string,
+	services []aimv1alpha1.AIMService,
+) *aimv1alpha1.AIMTemplateCacheUsage {
+	next := &aimv1alpha1.AIMTemplateCacheUsage{}
+	var counted []string
+	if usage != nil {
+		next.Hits, next.Misses = usage.Hits, usage.Misses
+		counted = usage.CountedServices
+	}
+
+	for i := range services {
+		service := &services[i]
+		if service.Status.Cache == nil || service.Status.Cache.Lookup == nil ||
+			service.Status.Cache.Lookup.TemplateCache != cacheName {
+			continue
+		}
+		uid := string(service.UID)
+		next.CountedServices = append(next.CountedServices, uid)
+		if slices.Contains(counted, uid) {
+			continue
+		}
+		switch service.Status.Cache.Lookup.Result {
+		case aimv1alpha1.CacheLookupHit:
+			next.Hits++
+		case aimv1alpha1.CacheLookupMiss:
+			next.Misses++
+		}
+	}
+	slices.Sort(next.CountedServices)
+
+	if usage == nil && next.Hits == 0 && next.Misses == 0 {
+		return nil
+	}
+	return next
+}
@@ -0,0 +1,368 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package conformance runs the AIM lifecycle against a live cluster to validate that an
+// installation works end to end: create a model, wait for discovery, create a service,
+// verify its endpoint and delete everything again.
+//
+// Each step is reported as a test case, so the results can be written as a JUnit report
+// for partner validation.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimservice"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// DefaultModelImage is a small model image that starts without a GPU.
+const DefaultModelImage = "ghcr.io/silogen/aim-dummy:0.1.10"
+
+// pollInterval is how often the suite checks resource status while waiting.
+const pollInterval = 2 * time.Second
+
+// Config controls a conformance run.
+type Config struct {
+	// Namespace is created for the run if it does not exist, and deleted afterwards if it was.
+	Namespace string
+	// ModelImage is the AIM image to deploy. Defaults to DefaultModelImage.
+	ModelImage string
+	// Name prefixes the model and service names. Defaults to "aim-conformance".
+	Name string
+	// DiscoveryTimeout bounds the wait for the model to become Ready.
+	DiscoveryTimeout time.Duration
+	// ServiceTimeout bounds the wait for the service to become Running.
+	ServiceTimeout time.Duration
+	// DeleteTimeout bounds the wait for deleted resources to disappear.
+	DeleteTimeout time.Duration
+	// KeepResources skips the deletion steps, to inspect a failed run.
+	KeepResources bool
+}
+
+func (cfg *Config) setDefaults() {
+	if cfg.Namespace == "" {
+		cfg.Namespace = "aim-conformance"
+	}
+	if cfg.ModelImage == "" {
+		cfg.ModelImage = DefaultModelImage
+	}
+	if cfg.Name == "" {
+		cfg.Name = "aim-conformance"
+	}
+	if cfg.DiscoveryTimeout == 0 {
+		cfg.DiscoveryTimeout = 10 * time.Minute
+	}
+	if cfg.ServiceTimeout == 0 {
+		cfg.ServiceTimeout = 20 * time.Minute
+	}
+	if cfg.DeleteTimeout == 0 {
+		cfg.DeleteTimeout = 5 * time.Minute
+	}
+}
+
+// Step is one stage of the lifecycle.
+type Step struct {
+	Name string
+	Run  func(ctx context.Context) error
+	// Always runs the step even after an earlier step failed, for cleanup.
+	Always bool
+}
+
+// Result is the outcome of a step. Skipped steps did not run because an earlier step failed.
+type Result struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+	Skipped  bool
+}
+
+// Failed reports whether any step failed.
+func Failed(results []Result) bool {
+	for _, r := range results {
+		if r.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// RunSteps runs the steps in order. After a failure only the steps marked Always run.
+// The optional observer is called with each result as soon as it is known.
+func RunSteps(ctx context.Context, steps []Step, observe func(Result)) []Result {
+	results := make([]Result, 0, len(steps))
+	failed := false
+	for _, step := range steps {
+		result := Result{Name: step.Name}
+		if failed && !step.Always {
+			result.Skipped = true
+		} else {
+			start := time.Now()
+			result.Err = step.Run(ctx)
+			result.Duration = time.Since(start)
+			failed = failed || result.Err != nil
+		}
+		results = append(results, result)
+		if observe != nil {
+			observe(result)
+		}
+	}
+	return results
+}
+
+// Suite runs the lifecycle against a cluster.
+type Suite struct {
+	client    client.Client
+	clientset kubernetes.Interface
+	cfg       Config
+
+	createdNamespace bool
+}
+
+// NewSuite returns a suite for the cluster behind the clients.
+func NewSuite(c client.Client, clientset kubernetes.Interface, cfg Config) *Suite {
+	cfg.setDefaults()
+	return &Suite{client: c, clientset: clientset, cfg: cfg}
+}
+
+// Run executes the lifecycle and returns the result of each step.
+func (s *Suite) Run(ctx context.Context, observe func(Result)) []Result {
+	return RunSteps(ctx, s.Steps(), observe)
+}
+
+// Steps returns the lifecycle steps in order.
+func (s *Suite) Steps() []Step {
+	steps := []Step{
+		{Name: "CreateNamespace", Run: s.createNamespace},
+		{Name: "CreateModel", Run: s.createModel},
+		{Name: "WaitForDiscovery", Run: s.waitForDiscovery},
+		{Name: "CreateService", Run: s.createService},
+		{Name: "WaitForServiceRunning", Run: s.waitForServiceRunning},
+		{Name: "VerifyEndpoint", Run: s.verifyEndpoint},
+	}
+	if s.cfg.KeepResources {
+		return steps
+	}
+	return append(steps,
+		Step{Name: "DeleteService", Run: s.deleteService, Always: true},
+		Step{Name: "DeleteModel", Run: s.deleteModel, Always: true},
+		Step{Name: "DeleteNamespace", Run: s.deleteNamespace, Always: true},
+	)
+}
+
+func (s *Suite) modelName() string   { return s.cfg.Name + "-model" }
+func (s *Suite) serviceName() string { return s.cfg.Name }
+
+func (s *Suite) createNamespace(ctx context.Context) error {
+	err := s.client.Get(ctx, client.ObjectKey{Name: s.cfg.Namespace}, &corev1.Namespace{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get namespace %s: %w", s.cfg.Namespace, err)
+	}
+	if err := s.client.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: s.cfg.Namespace}}); err != nil {
+		return fmt.Errorf("failed to create namespace %s: %w", s.cfg.Namespace, err)
+	}
+	s.createdNamespace = true
+	return nil
+}
+
+func (s *Suite) createModel(ctx context.Context) error {
+	model := &aimv1alpha1.AIMModel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.modelName(),
+			Namespace: s.cfg.Namespace,
+			Labels:    map[string]string{constants.LabelK8sManagedBy: "aim-conformance"},
+		},
+		Spec: aimv1alpha1.AIMModelSpec{Image: s.cfg.ModelImage},
+	}
+	if err := s.client.Create(ctx, model); err != nil {
+		return fmt.Errorf("failed to create model %s: %w", model.Name, err)
+	}
+	return nil
+}
+
+func (s *Suite) waitForDiscovery(ctx context.Context) error {
+	model := &aimv1alpha1.AIMModel{}
+	return s.waitFor(ctx, s.cfg.DiscoveryTimeout, model, s.modelName(), func() (bool, error) {
+		switch model.Status.Status {
+		case constants.AIMStatusReady:
+			return true, nil
+		case constants.AIMStatusFailed:
+			return false, fmt.Errorf("model %s failed: %s", model.Name, conditionMessages(model.Status.Conditions))
+		}
+		return false, nil
+	})
+}
+
+func (s *Suite) createService(ctx context.Context) error {
+	service := &aimv1alpha1.AIMService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.serviceName(),
+			Namespace: s.cfg.Namespace,
+			Labels:    map[string]string{constants.LabelK8sManagedBy: "aim-conformance"},
+		},
+		Spec: aimv1alpha1.AIMServiceSpec{
+			Model: aimv1alpha1.AIMServiceModel{Name: ptr.To(s.modelName())},
+		},
+	}
+	if err := s.client.Create(ctx, service); err != nil {
+		return fmt.Errorf("failed to create service %s: %w", service.Name, err)
+	}
+	return nil
+}
+
+func (s *Suite) waitForServiceRunning(ctx context.Context) error {
+	service := &aimv1alpha1.AIMService{}
+	return s.waitFor(ctx, s.cfg.ServiceTimeout, service, s.serviceName(), func() (bool, error) {
+		switch service.Status.Status {
+		case constants.AIMStatusRunning:
+			return true, nil
+		case constants.AIMStatusFailed:
+			return false, fmt.Errorf("service %s failed: %s", service.Name, conditionMessages(service.Status.Conditions))
+		}
+		return false, nil
+	})
+}
+
+// verifyEndpoint lists the served models through the API server proxy to the predictor,
+// so the check works from outside the cluster without a gateway.
+func (s *Suite) verifyEndpoint(ctx context.Context) error {
+	isvcName, err := aimservice.GenerateInferenceServiceName(s.serviceName(), s.cfg.Namespace)
+	if err != nil {
+		return err
+	}
+	predictor := isvcName + constants.PredictorServiceSuffix
+	body, err := s.clientset.CoreV1().Services(s.cfg.Namespace).
+		ProxyGet("http", predictor, fmt.Sprint(constants.DefaultGatewayPort), "/v1/models", nil).
+		DoRaw(ctx)
+	if err != nil {
+		return fmt.Errorf("GET /v1/models on %s failed: %w", predictor, err)
+	}
+	if len(body) == 0 {
+		return fmt.Errorf("GET /v1/models on %s returned an empty response", predictor)
+	}
+	return nil
+}
+
+func (s *Suite) deleteService(ctx context.Context) error {
+	return s.deleteAndWait(ctx, &aimv1alpha1.AIMService{}, s.serviceName())
+}
+
+func (s *Suite) deleteModel(ctx context.Context) error {
+	return s.deleteAndWait(ctx, &aimv1alpha1.AIMModel{}, s.modelName())
+}
+
+func (s *Suite) deleteNamespace(ctx context.Context) error {
+	if !s.createdNamespace {
+		return nil
+	}
+	if err := s.client.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: s.cfg.Namespace}}); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete namespace %s: %w", s.cfg.Namespace, err)
+	}
+	return nil
+}
+
+// waitFor polls the named object until done returns true or an error, or the timeout expires.
+func (s *Suite) waitFor(ctx context.Context, timeout time.Duration, obj client.Object, name string, done func() (bool, error)) error {
+	key := client.ObjectKey{Namespace: s.cfg.Namespace, Name: name}
+	var lastErr error
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := s.client.Get(ctx, key, obj); err != nil {
+			lastErr = err
+			return false, nil
+		}
+		return done()
+	})
+	if err != nil && wait.Interrupted(err) {
+		if lastErr != nil {
+			return fmt.Errorf("timed out after %s waiting for %s: %w", timeout, name, lastErr)
+		}
+		return fmt.Errorf("timed out after %s waiting for %s: %s", timeout, name, statusSummary(obj))
+	}
+	return err
+}
+
+// deleteAndWait deletes the named object and waits until it is gone.
+func (s *Suite) deleteAndWait(ctx context.Context, obj client.Object, name string) error {
+	obj.SetNamespace(s.cfg.Namespace)
+	obj.SetName(name)
+	if err := s.client.Delete(ctx, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete %s: %w", name, err)
+	}
+	key := client.ObjectKeyFromObject(obj)
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, s.cfg.DeleteTimeout, true, func(ctx context.Context) (bool, error) {
+		err := s.client.Get(ctx, key, obj)
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, client.IgnoreNotFound(err)
+	})
+	if err != nil && wait.Interrupted(err) {
+		return fmt.Errorf("timed out after %s waiting for %s to be deleted", s.cfg.DeleteTimeout, name)
+	}
+	return err
+}
+
+// statusSummary describes the state of an AIM object for timeout messages.
+func statusSummary(obj client.Object) string {
+	switch o := obj.(type) {
+	case *aimv1alpha1.AIMModel:
+		return fmt.Sprintf("status %q: %s", o.Status.Status, conditionMessages(o.Status.Conditions))
+	case *aimv1alpha1.AIMService:
+		return fmt.Sprintf("status %q: %s", o.Status.Status, conditionMessages(o.Status.Conditions))
+	}
+	return "not ready"
+}
+
+// conditionMessages summarizes the conditions that are not True.
+func conditionMessages(conditions []metav1.Condition) string {
+	summary := ""
+	for _, c := range conditions {
+		if c.Status == metav1.ConditionTrue {
+			continue
+		}
+		if summary != "" {
+			summary += "; "
+		}
+		summary += fmt.Sprintf("%s=%s (%s): %s", c.Type, c.Status, c.Reason, c.Message)
+	}
+	if summary == "" {
+		return "no failing conditions"
+	}
+	return summary
+}
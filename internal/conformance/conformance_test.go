@@ -0,0 +1,87 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunStepsSkipsAfterFailure(t *testing.T) {
+	var ran []string
+	step := func(name string, err error, always bool) Step {
+		return Step{Name: name, Always: always, Run: func(context.Context) error {
+			ran = append(ran, name)
+			return err
+		}}
+	}
+
+	var observed int
+	results := RunSteps(context.Background(), []Step{
+		step("create", nil, false),
+		step("wait", errors.New("timed out"), false),
+		step("verify", nil, false),
+		step("delete", nil, true),
+	}, func(Result) { observed++ })
+
+	if got := strings.Join(ran, ","); got != "create,wait,delete" {
+		t.Errorf("ran %s, want create,wait,delete", got)
+	}
+	if observed != 4 {
+		t.Errorf("observed %d results, want 4", observed)
+	}
+	if !results[2].Skipped || results[3].Skipped {
+		t.Errorf("expected only verify to be skipped, got %+v", results)
+	}
+	if !Failed(results) {
+		t.Error("expected the run to fail")
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	results := []Result{
+		{Name: "CreateModel", Duration: 1500 * time.Millisecond},
+		{Name: "WaitForDiscovery", Duration: time.Second, Err: errors.New("model failed")},
+		{Name: "CreateService", Skipped: true},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJUnit(&buf, "aim-conformance", time.Unix(0, 0), results); err != nil {
+		t.Fatal(err)
+	}
+	report := buf.String()
+	for _, want := range []string{
+		`<testsuite name="aim-conformance" tests="3" failures="1" skipped="1" time="2.500" timestamp="1970-01-01T00:00:00Z">`,
+		`<testcase name="CreateModel" classname="aim-conformance" time="1.500"></testcase>`,
+		`<failure message="model failed">model failed</failure>`,
+		`<skipped message="skipped after an earlier step failed"></skipped>`,
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report is missing %s:\n%s", want, report)
+		}
+	}
+}
@@ -35,7 +35,8 @@ const (
 	// DefaultRuntimeConfigName is the name of the default AIM runtime config
 	DefaultRuntimeConfigName = "default"
 
-	// MaxConcurrentDiscoveryJobs is the global limit for concurrent discovery jobs across all namespaces
+	// MaxConcurrentDiscoveryJobs is the default global limit for concurrently running GPU discovery jobs.
+	// It can be changed through gpuJobs.maxConcurrent on the default AIMClusterRuntimeConfig.
 	MaxConcurrentDiscoveryJobs = 10
 
 	// AimLabelDomain is the base domain used for AIM-specific labels.
@@ -187,6 +188,14 @@ const (
 
 	// NodeLabelBetaAMDGPUDeviceID is the legacy/beta node label for AMD GPU device IDs
 	NodeLabelBetaAMDGPUDeviceID = "beta.amd.com/gpu.device-id"
+
+	// NodeLabelAMDGPUHealthPrefix and NodeLabelAMDGPUHealthSuffix enclose the GPU ID in the per-GPU
+	// health labels of the AMD device metrics exporter (e.g., "metricsexporter.amd.com.gpu.0.state")
+	NodeLabelAMDGPUHealthPrefix = "metricsexporter.amd.com.gpu."
+	NodeLabelAMDGPUHealthSuffix = ".state"
+
+	// NodeLabelValueGPUUnhealthy is the health label value of a GPU that failed its health checks
+	NodeLabelValueGPUUnhealthy = "unhealthy"
 )
 
 // Standard Kubernetes label keys
@@ -201,6 +210,10 @@ const (
 const (
 	// ContainerKServe is the name of the main inference container
 	ContainerKServe = "kserve-container"
+	// ContainerRequestLogger is the name of the request logging sidecar
+	ContainerRequestLogger = "request-logger"
+	// RequestLoggerPort is the port the request logging sidecar receives inference logger events on
+	RequestLoggerPort = 9081
 	// VolumeSharedMemory is the name of the shared memory volume
 	VolumeSharedMemory = "dshm"
 	// VolumeModelStorage is the name of the model storage volume
@@ -217,6 +230,14 @@ const (
 	DefaultGPUResourceName = "amd.com/gpu"
 	// AIMCacheBasePath is the base directory for cached models
 	AIMCacheBasePath = "/workspace/cache"
+	// VolumeCacheEncryptionKey is the name of the volume holding the cache encryption key
+	VolumeCacheEncryptionKey = "aim-cache-encryption-key"
+	// MountPathCacheEncryptionKey is the directory the cache encryption key is mounted in
+	MountPathCacheEncryptionKey = "/etc/aim/encryption"
+	// CacheEncryptionKeyFile is the file name of the cache encryption key inside its mount
+	CacheEncryptionKeyFile = "key"
+	// ContainerCacheDecryptPrefix prefixes the init containers that decrypt cache volumes
+	ContainerCacheDecryptPrefix = "decrypt-"
 )
 
 // Component values for resource labels
@@ -239,6 +260,26 @@ const (
 	EnvAIMPrecision = "AIM_PRECISION"
 	// EnvAIMProfileID is the environment variable for the profile ID
 	EnvAIMProfileID = "AIM_PROFILE_ID"
+	// EnvAIMDevice selects the device the inference runtime targets
+	EnvAIMDevice = "AIM_DEVICE"
+	// EnvAIMDiscoveryDevice selects the device the discovery dry-run targets
+	EnvAIMDiscoveryDevice = "AIM_DISCOVERY_DEVICE"
+	// EnvAIMCacheEncryptionKeyFile points to the key file used to encrypt or decrypt cached model weights
+	EnvAIMCacheEncryptionKeyFile = "AIM_CACHE_ENCRYPTION_KEY_FILE"
+	// EnvAIMLogLevel sets the log level of the AIM runtime
+	EnvAIMLogLevel = "AIM_LOG_LEVEL"
+	// EnvAIMLogLevelRoot sets the log level of the libraries used by the AIM runtime
+	EnvAIMLogLevelRoot = "AIM_LOG_LEVEL_ROOT"
+	// EnvAIMLogFormat selects text or JSON log lines
+	EnvAIMLogFormat = "AIM_LOG_FORMAT"
+	// EnvAIMBatchInput is the location of the batch inference input dataset
+	EnvAIMBatchInput = "AIM_BATCH_INPUT"
+	// EnvAIMBatchOutput is the location the batch inference results are written to
+	EnvAIMBatchOutput = "AIM_BATCH_OUTPUT"
+	// EnvAIMBatchShardIndex is the index of the shard a batch inference pod processes
+	EnvAIMBatchShardIndex = "AIM_BATCH_SHARD_INDEX"
+	// EnvAIMBatchShardCount is the number of shards the batch inference input is split into
+	EnvAIMBatchShardCount = "AIM_BATCH_SHARD_COUNT"
 	// EnvVLLMEnableMetrics enables vLLM metrics
 	EnvVLLMEnableMetrics = "VLLM_ENABLE_METRICS"
 
@@ -254,10 +295,15 @@ const (
 	AutoscalerClassNone = "none"
 	// AutoscalerClassKeda enables KEDA-based autoscaling
 	AutoscalerClassKeda = "keda"
+	// AnnotationKServeDeploymentMode is the annotation key that pins the KServe deployment mode of an InferenceService
+	AnnotationKServeDeploymentMode = "serving.kserve.io/deploymentMode"
 	// LabelKServeInferenceService is the label key used by KServe on predictor pods
 	LabelKServeInferenceService = "serving.kserve.io/inferenceservice"
 	// AnnotationOTelSidecarInject is the annotation for OpenTelemetry sidecar injection
 	AnnotationOTelSidecarInject = "sidecar.opentelemetry.io/inject"
+	// AnnotationGPUFraction is the predictor pod annotation with the fraction of a GPU the pod
+	// may use when GPUs are time-sliced between services
+	AnnotationGPUFraction = "amd.com/gpu-fraction"
 	// AnnotationPrometheusPort is the annotation for Prometheus metrics port
 	AnnotationPrometheusPort = "prometheus.kserve.io/port"
 	// DefaultPrometheusPort is the default port for vLLM metrics
@@ -270,6 +316,69 @@ const (
 	// The controller will skip all reconciliation logic and return immediately.
 	// This is useful for testing or debugging purposes.
 	AnnotationReconciliationPaused = AimLabelDomain + "/reconciliation-paused"
+
+	// AnnotationAdoptionPolicy overrides the runtime config's adoption policy for the children of
+	// the annotated resource. One of Adopt, Conflict or Ignore.
+	AnnotationAdoptionPolicy = AimLabelDomain + "/adoption-policy"
+
+	// AnnotationPerformance holds the measured performance of a template's profile as JSON,
+	// written by benchmark or live-traffic analysis tooling, e.g.
+	// {"source":"Benchmark","latency":"12ms","tokensPerSecond":4200}.
+	AnnotationPerformance = AimLabelDomain + "/performance"
+
+	// AnnotationTrace, when set to "true", makes the controller record a compact decision trace
+	// of the latest reconcile into the AnnotationDecisionTrace annotation of the resource.
+	AnnotationTrace = AimLabelDomain + "/trace"
+
+	// AnnotationDecisionTrace holds the JSON decision trace written when AnnotationTrace is enabled.
+	AnnotationDecisionTrace = AimLabelDomain + "/decision-trace"
+
+	// AnnotationFaultFetchDelay delays fetches of the listed kinds while reconciling the resource,
+	// e.g. "AIMServiceTemplate=5s,*=100ms". Only honored by builds with the faultinject tag.
+	AnnotationFaultFetchDelay = AimLabelDomain + "/fault.fetch-delay"
+
+	// AnnotationFaultFetchError fails fetches of the listed kinds with an error of the given
+	// category, e.g. "AIMModel=Infrastructure,InferenceService=NotFound". Only honored by builds
+	// with the faultinject tag.
+	AnnotationFaultFetchError = AimLabelDomain + "/fault.fetch-error"
+
+	// AnnotationFaultApplyError fails applies of the listed kinds with an error of the given
+	// category, e.g. "InferenceService=ResourceExhaustion". Only honored by builds with the
+	// faultinject tag.
+	AnnotationFaultApplyError = AimLabelDomain + "/fault.apply-error"
+
+	// AnnotationBaseTemplate records the name of the template a derived template was created from.
+	AnnotationBaseTemplate = AimLabelDomain + "/base-template"
+
+	// AnnotationBaseTemplateHash records the hash of the base template spec at derivation time.
+	AnnotationBaseTemplateHash = AimLabelDomain + "/base-template-hash"
+
+	// AnnotationForceTemplate pins an AIMService to the named template, bypassing auto-selection,
+	// spec.template.name and overrides. Intended for break-glass debugging.
+	AnnotationForceTemplate = AimLabelDomain + "/force-template"
+
+	// AnnotationArchiveExpiresAt records when a service archive record may be removed (RFC 3339).
+	AnnotationArchiveExpiresAt = AimLabelDomain + "/archive-expires-at"
+
+	// AnnotationClusterModelCaches records the cluster model caches an InferenceService mounts,
+	// comma separated, so a revoked cache can be told apart from a template cache volume.
+	AnnotationClusterModelCaches = AimLabelDomain + "/cluster-model-caches"
+
+	// AnnotationDiscoveryControllerVersion records the version of the controller that launched
+	// a discovery job.
+	AnnotationDiscoveryControllerVersion = AimLabelDomain + "/discovery.controller-version"
+
+	// AnnotationDiscoveryBuilderHash records the fingerprint of the discovery job builder that
+	// produced a discovery job, so results from an older builder can be recognized after upgrades.
+	AnnotationDiscoveryBuilderHash = AimLabelDomain + "/discovery.builder-hash"
+
+	// AnnotationDownloadSourceHash records the source hash of an artifact download job, so a job
+	// whose image, source, model ID or env changed while it was running can be recognized.
+	AnnotationDownloadSourceHash = AimLabelDomain + "/download.source-hash"
+
+	// AnnotationWeightsDigest is the predictor pod annotation with a digest of the cached weights
+	// the pods were started with. Changing it rolls the pods so they load a refreshed cache.
+	AnnotationWeightsDigest = AimLabelDomain + "/weights-digest"
 )
 
 // Template-related constants
@@ -46,6 +46,10 @@ const (
 	// Used on: inference Pods, PVCs
 	LabelKeyService = AimLabelDomain + "/service"
 
+	// LabelKeyBatchJob identifies the owning AIMBatchJob name.
+	// Used on: batch inference Jobs and Pods
+	LabelKeyBatchJob = AimLabelDomain + "/batch-job"
+
 	// ==========================================================================
 	// Origin labels - describe how/why this resource was created
 	// ==========================================================================
@@ -55,6 +59,8 @@ const (
 	LabelKeyOrigin = AimLabelDomain + "/origin"
 
 	// LabelKeyManagedBy indicates what tool/controller manages this resource.
+	// Set to LabelValueManagedBy on the Jobs and Pods the operator creates, which lets the
+	// manager limit its Pod and Job caches to them.
 	LabelKeyManagedBy = AimLabelDomain + "/managed-by"
 
 	// LabelKeyComponent identifies the role of this resource in the architecture.
@@ -65,6 +71,10 @@ const (
 	// Value: "true"
 	LabelKeyCustomModel = AimLabelDomain + "/custom-model"
 
+	// LabelKeyDiscoveryDevice marks discovery jobs that run on a device other than the GPU.
+	// Value: "cpu" for CPU-only fallback jobs.
+	LabelKeyDiscoveryDevice = AimLabelDomain + "/discovery.device"
+
 	// LabelKeyTemplateAlias is the user-provided short-hand alias for a custom template.
 	// Used to find templates by their alias before model prefix and hash are added.
 	LabelKeyTemplateAlias = AimLabelDomain + "/template.alias"
@@ -96,6 +106,24 @@ const (
 	// LabelKeyCacheName identifies the cache resource name.
 	LabelKeyCacheName = AimLabelDomain + "/cache.name"
 
+	// LabelKeyClusterModelCache identifies the AIMClusterModelCache a shared volume belongs to.
+	LabelKeyClusterModelCache = AimLabelDomain + "/cluster-model-cache"
+
+	// LabelKeyPullSecretSource identifies the operator namespace secret a synced image pull
+	// secret was copied from.
+	// Used on: synced pull Secrets
+	LabelKeyPullSecretSource = AimLabelDomain + "/pull-secret.source"
+
+	// LabelKeyVariant identifies the ensemble variant an InferenceService serves.
+	// Used on: variant InferenceServices
+	LabelKeyVariant = AimLabelDomain + "/variant"
+
+	// LabelKeyHealth reflects the health of the resource itself, updated by the state engine
+	// on transitions so resources can be selected and alerted on without parsing status.
+	// Values: ready, degraded, failed. Removed while the resource is pending or progressing.
+	// Used on: all AIM resources reconciled by the state engine. Never propagated to children.
+	LabelKeyHealth = AimLabelDomain + "/health"
+
 	// ==========================================================================
 	// Model source labels - for tracking model origins
 	// ==========================================================================
@@ -123,6 +151,19 @@ const (
 	// LabelValueManagedByController indicates the resource is managed by the AIM controller.
 	LabelValueManagedByController = "aim-controller"
 
+	// ==========================================================================
+	// Health label values
+	// ==========================================================================
+
+	// LabelValueHealthReady indicates the resource is ready or running.
+	LabelValueHealthReady = "ready"
+
+	// LabelValueHealthDegraded indicates the resource works with reduced functionality.
+	LabelValueHealthDegraded = "degraded"
+
+	// LabelValueHealthFailed indicates the resource has failed.
+	LabelValueHealthFailed = "failed"
+
 	// ==========================================================================
 	// Component label values
 	// ==========================================================================
@@ -133,9 +174,21 @@ const (
 	// LabelValueComponentDiscovery indicates a discovery-related resource.
 	LabelValueComponentDiscovery = "discovery"
 
+	// LabelValueComponentBatch indicates a batch inference resource.
+	LabelValueComponentBatch = "batch"
+
+	// LabelValueDiscoveryDeviceCPU marks a CPU-only discovery job.
+	LabelValueDiscoveryDeviceCPU = "cpu"
+
+	// ImageLabelDiscoveryCPU is the image label declaring that the image supports CPU-only discovery.
+	ImageLabelDiscoveryCPU = "com.amd.aim.discovery.cpu"
+
 	// LabelValueComponentCache indicates a cache-related resource.
 	LabelValueComponentCache = "cache"
 
+	// LabelValueComponentServiceArchive indicates an archive record of a deleted service.
+	LabelValueComponentServiceArchive = "service-archive"
+
 	// ==========================================================================
 	// Cache type label values
 	// ==========================================================================
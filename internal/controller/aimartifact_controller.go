@@ -64,6 +64,18 @@ type AIMArtifactReconciler struct {
 	Scheme    *runtime.Scheme
 	Recorder  record.EventRecorder
 
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
 	reconciler controllerutils.DomainReconciler[*aimv1alpha1.AIMArtifact, *aimv1alpha1.AIMArtifactStatus, aimartifact.ArtifactFetchResult, aimartifact.ArtifactObservation]
 	pipeline   controllerutils.Pipeline[*aimv1alpha1.AIMArtifact, *aimv1alpha1.AIMArtifactStatus, aimartifact.ArtifactFetchResult, aimartifact.ArtifactObservation]
 }
@@ -73,7 +85,7 @@ type AIMArtifactReconciler struct {
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimartifacts/finalizers,verbs=update
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
@@ -305,10 +317,13 @@ func (r *AIMArtifactReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		aimartifact.ArtifactFetchResult,
 		aimartifact.ArtifactObservation,
 	]{
-		Client:         mgr.GetClient(),
-		StatusClient:   mgr.GetClient().Status(),
+		Client:         r.Client,
+		StatusClient:   r.Client.Status(),
 		Recorder:       r.Recorder,
 		ControllerName: artifactName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
 		Reconciler:     r.reconciler,
 		Scheme:         r.Scheme,
 		Clientset:      r.Clientset,
@@ -330,6 +345,7 @@ func (r *AIMArtifactReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findArtifactsForRoleBinding),
 			builder.WithPredicates(roleBindingPredicate()),
 		).
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
 		Named(artifactName).
 		Complete(r)
 }
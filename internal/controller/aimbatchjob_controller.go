@@ -0,0 +1,180 @@
+/*
+MIT License
+
+Copyright (c) 2025 Advanced Micro Devices, Inc.
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package controller
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimbatchjob"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const batchJobName = "batch-job"
+
+// AIMBatchJobReconciler reconciles an AIMBatchJob object.
+type AIMBatchJobReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// NamespacedOnly skips watches on cluster-scoped resources, for operators installed
+	// without cluster RBAC.
+	NamespacedOnly bool
+
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
+	// Pipeline and domain reconciler (initialized in SetupWithManager)
+	reconciler controllerutils.DomainReconciler[
+		*aimv1alpha1.AIMBatchJob,
+		*aimv1alpha1.AIMBatchJobStatus,
+		aimbatchjob.BatchJobFetchResult,
+		aimbatchjob.BatchJobObservation,
+	]
+	pipeline controllerutils.Pipeline[
+		*aimv1alpha1.AIMBatchJob,
+		*aimv1alpha1.AIMBatchJobStatus,
+		aimbatchjob.BatchJobFetchResult,
+		aimbatchjob.BatchJobObservation,
+	]
+}
+
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimbatchjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimbatchjobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimbatchjobs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservicetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclusterservicetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimtemplatecaches,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+func (r *AIMBatchJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var batchJob aimv1alpha1.AIMBatchJob
+	if err := r.Get(ctx, req.NamespacedName, &batchJob); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to fetch AIMBatchJob")
+		return ctrl.Result{}, err
+	}
+
+	return r.pipeline.Run(ctx, &batchJob)
+}
+
+// findBatchJobsForTemplate enqueues the batch jobs that wait on a template. Namespace-scoped
+// templates only affect batch jobs in their namespace, cluster-scoped templates all of them.
+func (r *AIMBatchJobReconciler) findBatchJobsForTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	var opts []client.ListOption
+	if obj.GetNamespace() != "" {
+		opts = append(opts, client.InNamespace(obj.GetNamespace()))
+	}
+	return r.findBatchJobsForTemplateName(ctx, obj.GetName(), opts...)
+}
+
+// findBatchJobsForTemplateCache enqueues the batch jobs in the namespace of a template cache
+// that run its template, so they pick up the cache once it becomes Ready.
+func (r *AIMBatchJobReconciler) findBatchJobsForTemplateCache(ctx context.Context, obj client.Object) []reconcile.Request {
+	cache, ok := obj.(*aimv1alpha1.AIMTemplateCache)
+	if !ok {
+		return nil
+	}
+	return r.findBatchJobsForTemplateName(ctx, cache.Spec.TemplateName, client.InNamespace(cache.Namespace))
+}
+
+func (r *AIMBatchJobReconciler) findBatchJobsForTemplateName(ctx context.Context, templateName string, opts ...client.ListOption) []reconcile.Request {
+	var batchJobs aimv1alpha1.AIMBatchJobList
+	if err := r.List(ctx, &batchJobs, opts...); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list AIMBatchJobs for template", "template", templateName)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, batchJob := range batchJobs.Items {
+		// Batch jobs whose Job exists no longer depend on the template
+		if batchJob.Spec.Template != templateName || batchJob.Status.Job != "" {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&batchJob)})
+	}
+	return requests
+}
+
+func (r *AIMBatchJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.reconciler = &aimbatchjob.BatchJobReconciler{
+		Scheme: r.Scheme,
+	}
+	r.pipeline = controllerutils.Pipeline[
+		*aimv1alpha1.AIMBatchJob,
+		*aimv1alpha1.AIMBatchJobStatus,
+		aimbatchjob.BatchJobFetchResult,
+		aimbatchjob.BatchJobObservation,
+	]{
+		Client:         r.Client,
+		StatusClient:   r.Client.Status(),
+		Reconciler:     r.reconciler,
+		Scheme:         r.Scheme,
+		ControllerName: batchJobName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
+	}
+	r.Recorder = mgr.GetEventRecorderFor(r.pipeline.GetFullName())
+	r.pipeline.Recorder = r.Recorder
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&aimv1alpha1.AIMBatchJob{}).
+		Owns(&batchv1.Job{}).
+		Watches(&aimv1alpha1.AIMServiceTemplate{}, handler.EnqueueRequestsFromMapFunc(r.findBatchJobsForTemplate)).
+		Watches(&aimv1alpha1.AIMTemplateCache{}, handler.EnqueueRequestsFromMapFunc(r.findBatchJobsForTemplateCache))
+	if !r.NamespacedOnly {
+		b = b.Watches(&aimv1alpha1.AIMClusterServiceTemplate{}, handler.EnqueueRequestsFromMapFunc(r.findBatchJobsForTemplate))
+	}
+
+	return b.
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
+		Named(batchJobName).
+		Complete(r)
+}
@@ -0,0 +1,130 @@
+/*
+MIT License
+
+Copyright (c) 2025 Advanced Micro Devices, Inc.
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/config"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// watchRecorder records the kinds the controllers request informers for.
+type watchRecorder struct {
+	*informertest.FakeInformers
+
+	mu    sync.Mutex
+	kinds map[string]bool
+}
+
+func (w *watchRecorder) GetInformer(ctx context.Context, obj client.Object, opts ...cache.InformerGetOption) (cache.Informer, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	gvks, _, err := w.Scheme.ObjectKinds(obj)
+	if err != nil {
+		return nil, err
+	}
+	w.kinds[gvks[0].Kind] = true
+	return w.FakeInformers.GetInformer(ctx, obj, opts...)
+}
+
+func (w *watchRecorder) watched(kind string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.kinds[kind]
+}
+
+func TestAIMBatchJobReconciler_SetupWithManager_NamespacedOnly(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := aimv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &watchRecorder{
+		FakeInformers: &informertest.FakeInformers{Scheme: scheme},
+		kinds:         map[string]bool{},
+	}
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "http://127.0.0.1:1"}, ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+		Controller:             config.Controller{SkipNameValidation: ptr.To(true)},
+		NewCache: func(*rest.Config, cache.Options) (cache.Cache, error) {
+			return recorder, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &AIMBatchJobReconciler{
+		Client:         controllerutils.NewNamespacedOnlyClient(mgr.GetClient()),
+		Scheme:         scheme,
+		NamespacedOnly: true,
+	}
+	if err := r.SetupWithManager(mgr); err != nil {
+		t.Fatalf("SetupWithManager failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- mgr.Start(ctx) }()
+	// Sources are started together, so give a skipped watch time to show up once the others did
+	deadline := time.Now().Add(10 * time.Second)
+	for !recorder.watched("AIMTemplateCache") && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("manager failed: %v", err)
+	}
+
+	for _, kind := range []string{"AIMBatchJob", "Job", "AIMServiceTemplate", "AIMTemplateCache"} {
+		if !recorder.watched(kind) {
+			t.Errorf("expected %s to be watched", kind)
+		}
+	}
+	if recorder.watched("AIMClusterServiceTemplate") {
+		t.Error("expected AIMClusterServiceTemplate not to be watched in namespaced-only mode")
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -41,6 +42,7 @@ import (
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
 	"github.com/amd-enterprise-ai/aim-engine/internal/aimmodel"
 	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
 )
 
 const (
@@ -54,6 +56,18 @@ type AIMClusterModelReconciler struct {
 	Recorder  record.EventRecorder
 	Clientset kubernetes.Interface
 
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
 	reconciler controllerutils.DomainReconciler[*aimv1alpha1.AIMClusterModel, *aimv1alpha1.AIMModelStatus, aimmodel.ClusterModelFetchResult, aimmodel.ClusterModelObservation]
 	pipeline   controllerutils.Pipeline[*aimv1alpha1.AIMClusterModel, *aimv1alpha1.AIMModelStatus, aimmodel.ClusterModelFetchResult, aimmodel.ClusterModelObservation]
 }
@@ -65,6 +79,7 @@ type AIMClusterModelReconciler struct {
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclusterruntimeconfigs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclusterservicetemplates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservices,verbs=get;list;watch
 
 func (r *AIMClusterModelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
@@ -99,6 +114,9 @@ func (r *AIMClusterModelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		StatusClient:   mgr.GetClient().Status(),
 		Recorder:       r.Recorder,
 		ControllerName: clusterModelName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
 		Reconciler:     r.reconciler,
 		Scheme:         r.Scheme,
 		Clientset:      r.Clientset,
@@ -150,10 +168,30 @@ func (r *AIMClusterModelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&aimv1alpha1.AIMClusterRuntimeConfig{},
 			handler.EnqueueRequestsFromMapFunc(r.findClusterModelsForClusterRuntimeConfig),
 		).
+		// Watch services so the consumers summary follows model resolution in any namespace
+		Watches(
+			&aimv1alpha1.AIMService{},
+			handler.EnqueueRequestsFromMapFunc(r.findClusterModelForService),
+			builder.WithPredicates(utils.ServiceResolvedRefChangePredicate(controllerutils.ResolvedModelRef)),
+		).
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
 		Named(clusterModelName).
 		Complete(r)
 }
 
+// findClusterModelForService maps an AIMService to the cluster model it resolved to, if any.
+func (r *AIMClusterModelReconciler) findClusterModelForService(_ context.Context, obj client.Object) []reconcile.Request {
+	svc, ok := obj.(*aimv1alpha1.AIMService)
+	if !ok {
+		return nil
+	}
+	ref := svc.Status.ResolvedModel
+	if ref == nil || ref.Name == "" || ref.Scope != aimv1alpha1.AIMResolutionScopeCluster {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: ref.Name}}}
+}
+
 // findClusterModelsForClusterRuntimeConfig returns reconcile requests for all AIMClusterModels
 // that reference the given ClusterRuntimeConfig by name.
 func (r *AIMClusterModelReconciler) findClusterModelsForClusterRuntimeConfig(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -0,0 +1,161 @@
+/*
+MIT License
+
+Copyright (c) 2025 Advanced Micro Devices, Inc.
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimclustermodelcache"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+const clusterModelCacheName = "cluster-model-cache"
+
+// AIMClusterModelCacheReconciler reconciles an AIMClusterModelCache object.
+type AIMClusterModelCacheReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
+	// Pipeline and domain reconciler (initialized in SetupWithManager)
+	reconciler controllerutils.DomainReconciler[
+		*aimv1alpha1.AIMClusterModelCache,
+		*aimv1alpha1.AIMClusterModelCacheStatus,
+		aimclustermodelcache.ClusterModelCacheFetchResult,
+		aimclustermodelcache.ClusterModelCacheObservation,
+	]
+	pipeline controllerutils.Pipeline[
+		*aimv1alpha1.AIMClusterModelCache,
+		*aimv1alpha1.AIMClusterModelCacheStatus,
+		aimclustermodelcache.ClusterModelCacheFetchResult,
+		aimclustermodelcache.ClusterModelCacheObservation,
+	]
+}
+
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclustermodelcaches,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclustermodelcaches/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclustermodelcaches/finalizers,verbs=update
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimartifacts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+func (r *AIMClusterModelCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var cache aimv1alpha1.AIMClusterModelCache
+	if err := r.Get(ctx, req.NamespacedName, &cache); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to fetch AIMClusterModelCache")
+		return ctrl.Result{}, err
+	}
+
+	return r.pipeline.Run(ctx, &cache)
+}
+
+// findClusterModelCachesForNamespace enqueues every cluster cache when a namespace changes,
+// so access is revoked as soon as a namespace stops matching a selector or is deleted.
+func (r *AIMClusterModelCacheReconciler) findClusterModelCachesForNamespace(ctx context.Context, _ client.Object) []reconcile.Request {
+	var caches aimv1alpha1.AIMClusterModelCacheList
+	if err := r.List(ctx, &caches); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list AIMClusterModelCaches for namespace")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(caches.Items))
+	for _, cache := range caches.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{Name: cache.Name}})
+	}
+	return requests
+}
+
+// findClusterModelCacheForSharedVolume enqueues the cluster cache a shared volume was created for.
+func (r *AIMClusterModelCacheReconciler) findClusterModelCacheForSharedVolume(_ context.Context, obj client.Object) []reconcile.Request {
+	name := obj.GetLabels()[constants.LabelKeyClusterModelCache]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: name}}}
+}
+
+func (r *AIMClusterModelCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.reconciler = &aimclustermodelcache.ClusterModelCacheReconciler{
+		Scheme:            r.Scheme,
+		OperatorNamespace: constants.GetOperatorNamespace(),
+	}
+	r.pipeline = controllerutils.Pipeline[
+		*aimv1alpha1.AIMClusterModelCache,
+		*aimv1alpha1.AIMClusterModelCacheStatus,
+		aimclustermodelcache.ClusterModelCacheFetchResult,
+		aimclustermodelcache.ClusterModelCacheObservation,
+	]{
+		Client:         mgr.GetClient(),
+		StatusClient:   mgr.GetClient().Status(),
+		Reconciler:     r.reconciler,
+		Scheme:         r.Scheme,
+		ControllerName: clusterModelCacheName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
+	}
+	r.Recorder = mgr.GetEventRecorderFor(r.pipeline.GetFullName())
+	r.pipeline.Recorder = r.Recorder
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aimv1alpha1.AIMClusterModelCache{}).
+		Owns(&aimv1alpha1.AIMArtifact{}).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.findClusterModelCachesForNamespace)).
+		// Shared volumes are created by the service controller; watch them to keep sharedNamespaces current
+		Watches(&corev1.PersistentVolume{}, handler.EnqueueRequestsFromMapFunc(r.findClusterModelCacheForSharedVolume)).
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
+		Named(clusterModelCacheName).
+		Complete(r)
+}
@@ -50,6 +50,18 @@ type AIMClusterModelSourceReconciler struct {
 	Recorder  record.EventRecorder
 	Clientset kubernetes.Interface
 
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
 	// Pipeline and domain reconciler (initialized in SetupWithManager)
 	reconciler controllerutils.DomainReconciler[
 		*aimv1alpha1.AIMClusterModelSource,
@@ -118,6 +130,9 @@ func (r *AIMClusterModelSourceReconciler) SetupWithManager(mgr ctrl.Manager) err
 		Reconciler:     r.reconciler,
 		Scheme:         r.Scheme,
 		ControllerName: clusterModelSourceName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
 	}
 	r.Recorder = mgr.GetEventRecorderFor(r.pipeline.GetFullName())
 	r.pipeline.Recorder = r.Recorder
@@ -125,6 +140,7 @@ func (r *AIMClusterModelSourceReconciler) SetupWithManager(mgr ctrl.Manager) err
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&aimv1alpha1.AIMClusterModelSource{}).
 		Owns(&aimv1alpha1.AIMClusterModel{}).
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
 		Named(clusterModelSourceName).
 		Complete(r)
 }
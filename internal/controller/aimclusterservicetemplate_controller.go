@@ -63,6 +63,18 @@ type AIMClusterServiceTemplateReconciler struct {
 	Recorder  record.EventRecorder
 	Clientset kubernetes.Interface
 
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
 	reconciler controllerutils.DomainReconciler[
 		*aimv1alpha1.AIMClusterServiceTemplate,
 		*aimv1alpha1.AIMServiceTemplateStatus,
@@ -85,10 +97,11 @@ type AIMClusterServiceTemplateReconciler struct {
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclustermodels,verbs=get;list;watch
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterservingruntimes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservices,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -105,13 +118,19 @@ func (r *AIMClusterServiceTemplateReconciler) Reconcile(ctx context.Context, req
 		return ctrl.Result{}, err
 	}
 
+	if done, result, err := reconcileDiscoveryCleanupFinalizer(ctx, r.Client, r.Recorder, &template, func(ctx context.Context) error {
+		return aimservicetemplate.CleanupDiscoveryResources(ctx, r.Client, constants.GetOperatorNamespace(), template.Name)
+	}); done {
+		return result, err
+	}
+
 	// Check if this template might need to create a discovery job.
 	// If so, we need to acquire a distributed lock to ensure atomicity
 	// of the "count active jobs + create job" operation.
 	needsLock := aimservicetemplate.NeedsDiscoveryLock(
 		template.Status.Status,
 		len(template.Spec.ModelSources) > 0,
-	)
+	) || aimservicetemplate.IsDiscoverySuperseded(&template.Status)
 
 	if needsLock {
 		// Run pipeline under the discovery lock
@@ -157,6 +176,9 @@ func (r *AIMClusterServiceTemplateReconciler) SetupWithManager(mgr ctrl.Manager)
 		StatusClient:   mgr.GetClient().Status(),
 		Recorder:       r.Recorder,
 		ControllerName: clusterServiceTemplateName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
 		Reconciler:     r.reconciler,
 		Scheme:         r.Scheme,
 		Clientset:      r.Clientset,
@@ -267,6 +289,33 @@ func (r *AIMClusterServiceTemplateReconciler) SetupWithManager(mgr ctrl.Manager)
 		return requestsFromClusterServiceTemplates(templates.Items)
 	})
 
+	// Handler for template performance changes - reconcile the measured templates of the same model
+	siblingTemplateHandler := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		changed, ok := obj.(*aimv1alpha1.AIMClusterServiceTemplate)
+		if !ok {
+			return nil
+		}
+
+		var templates aimv1alpha1.AIMClusterServiceTemplateList
+		if err := r.List(ctx, &templates,
+			client.MatchingFields{aimv1alpha1.ServiceTemplateModelNameIndexKey: changed.Spec.ModelName},
+		); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list sibling AIMClusterServiceTemplates",
+				"template", changed.Name)
+			return nil
+		}
+
+		// Filter to templates with a performance measurement
+		filtered := make([]aimv1alpha1.AIMClusterServiceTemplate, 0, len(templates.Items))
+		for i := range templates.Items {
+			if templates.Items[i].Annotations[constants.AnnotationPerformance] != "" {
+				filtered = append(filtered, templates.Items[i])
+			}
+		}
+
+		return requestsFromClusterServiceTemplates(filtered)
+	})
+
 	// Handler for discovery Pod changes - reconcile cluster template when pod status changes
 	// Cluster-scoped templates run discovery jobs in the operator namespace
 	discoveryPodHandler := handler.EnqueueRequestsFromMapFunc(r.findClusterTemplateForDiscoveryPod)
@@ -278,11 +327,32 @@ func (r *AIMClusterServiceTemplateReconciler) SetupWithManager(mgr ctrl.Manager)
 		Watches(&aimv1alpha1.AIMRuntimeConfig{}, runtimeConfigHandler).
 		Watches(&corev1.Node{}, nodeHandler, builder.WithPredicates(utils.NodeGPUChangePredicate())).
 		Watches(&aimv1alpha1.AIMClusterModel{}, clusterModelHandler).
+		Watches(&aimv1alpha1.AIMClusterServiceTemplate{}, siblingTemplateHandler, builder.WithPredicates(utils.TemplatePerformanceChangePredicate())).
 		Watches(&corev1.Pod{}, discoveryPodHandler, builder.WithPredicates(clusterDiscoveryPodPredicate())).
+		// Watch services so the consumers summary follows template resolution in any namespace
+		Watches(
+			&aimv1alpha1.AIMService{},
+			handler.EnqueueRequestsFromMapFunc(r.findClusterTemplateForService),
+			builder.WithPredicates(utils.ServiceResolvedRefChangePredicate(controllerutils.ResolvedTemplateRef)),
+		).
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
 		Named(clusterServiceTemplateName).
 		Complete(r)
 }
 
+// findClusterTemplateForService maps an AIMService to the cluster template it resolved to, if any.
+func (r *AIMClusterServiceTemplateReconciler) findClusterTemplateForService(_ context.Context, obj client.Object) []reconcile.Request {
+	svc, ok := obj.(*aimv1alpha1.AIMService)
+	if !ok {
+		return nil
+	}
+	ref := svc.Status.ResolvedTemplate
+	if ref == nil || ref.Name == "" || ref.Scope != aimv1alpha1.AIMResolutionScopeCluster {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: ref.Name}}}
+}
+
 // findClusterTemplateForDiscoveryPod maps a discovery Pod to its owning AIMClusterServiceTemplate using the template label.
 func (r *AIMClusterServiceTemplateReconciler) findClusterTemplateForDiscoveryPod(ctx context.Context, pod client.Object) []reconcile.Request {
 	templateName, ok := pod.GetLabels()[constants.LabelKeyTemplate]
@@ -387,6 +457,16 @@ func hasClusterDiscoveryPodIssue(pod *corev1.Pod) bool {
 		}
 	}
 
+	// Check for scheduling issues (e.g. no node with free GPUs)
+	if pod.Status.Phase == corev1.PodPending {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse &&
+				condition.Reason == corev1.PodReasonUnschedulable {
+				return true
+			}
+		}
+	}
+
 	return false
 }
 
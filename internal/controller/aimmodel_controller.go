@@ -54,6 +54,22 @@ type AIMModelReconciler struct {
 	Recorder  record.EventRecorder
 	Clientset kubernetes.Interface
 
+	// NamespacedOnly skips watches on cluster-scoped resources, for operators installed
+	// without cluster RBAC.
+	NamespacedOnly bool
+
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
 	reconciler controllerutils.DomainReconciler[*aimv1alpha1.AIMModel, *aimv1alpha1.AIMModelStatus, aimmodel.ModelFetchResult, aimmodel.ModelObservation]
 	pipeline   controllerutils.Pipeline[*aimv1alpha1.AIMModel, *aimv1alpha1.AIMModelStatus, aimmodel.ModelFetchResult, aimmodel.ModelObservation]
 }
@@ -95,10 +111,13 @@ func (r *AIMModelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		aimmodel.ModelFetchResult,
 		aimmodel.ModelObservation,
 	]{
-		Client:         mgr.GetClient(),
-		StatusClient:   mgr.GetClient().Status(),
+		Client:         r.Client,
+		StatusClient:   r.Client.Status(),
 		Recorder:       r.Recorder,
 		ControllerName: modelName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
 		Reconciler:     r.reconciler,
 		Scheme:         r.Scheme,
 		Clientset:      r.Clientset,
@@ -142,7 +161,7 @@ func (r *AIMModelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&aimv1alpha1.AIMModel{}).
 		Owns(&aimv1alpha1.AIMServiceTemplate{}).
 		// Watch all ServiceTemplates (including externally-created) that reference this model
@@ -154,12 +173,17 @@ func (r *AIMModelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(
 			&aimv1alpha1.AIMRuntimeConfig{},
 			handler.EnqueueRequestsFromMapFunc(r.findModelsForRuntimeConfig),
-		).
+		)
+	if !r.NamespacedOnly {
 		// Watch cluster-scoped RuntimeConfigs and enqueue models that reference them
-		Watches(
+		b = b.Watches(
 			&aimv1alpha1.AIMClusterRuntimeConfig{},
 			handler.EnqueueRequestsFromMapFunc(r.findModelsForClusterRuntimeConfig),
-		).
+		)
+	}
+
+	return b.
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
 		Named(modelName).
 		Complete(r)
 }
@@ -0,0 +1,176 @@
+/*
+MIT License
+
+Copyright (c) 2025 Advanced Micro Devices, Inc.
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/config"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	controllerutils "github.com/amd-enterprise-ai/aim-engine/internal/controller/utils"
+)
+
+// clusterReadRecorder fails and records every read of a cluster-scoped kind, like a cache
+// that only has informers for namespaced kinds.
+type clusterReadRecorder struct {
+	mapper meta.RESTMapper
+
+	mu    sync.Mutex
+	kinds []string
+}
+
+func (c *clusterReadRecorder) check(cl client.WithWatch, obj runtime.Object) error {
+	gvk, err := cl.GroupVersionKindFor(obj)
+	if err != nil {
+		return err
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil || mapping.Scope.Name() != meta.RESTScopeNameRoot {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.kinds = append(c.kinds, gvk.Kind)
+	return apierrors.NewForbidden(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, "", nil)
+}
+
+func (c *clusterReadRecorder) read() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.kinds...)
+}
+
+func TestAIMModelReconciler_NamespacedOnly_PipelineSkipsClusterKinds(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := aimv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for gvk := range scheme.AllKnownTypes() {
+		if strings.HasSuffix(gvk.Kind, "List") || strings.HasSuffix(gvk.Kind, "Options") {
+			continue
+		}
+		scope := meta.RESTScopeNamespace
+		if strings.HasPrefix(gvk.Kind, "AIMCluster") || gvk.Kind == "Namespace" || gvk.Kind == "Node" {
+			scope = meta.RESTScopeRoot
+		}
+		mapper.Add(gvk, scope)
+	}
+
+	model := &aimv1alpha1.AIMModel{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "team"},
+		Spec: aimv1alpha1.AIMModelSpec{
+			Image:     "registry.example.com/llama:1.0",
+			Discovery: &aimv1alpha1.AIMModelDiscoveryConfig{ExtractMetadata: false},
+		},
+	}
+	recorder := &clusterReadRecorder{mapper: mapper}
+	underlying := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(mapper).
+		WithObjects(model, &aimv1alpha1.AIMClusterRuntimeConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}}).
+		WithStatusSubresource(&aimv1alpha1.AIMModel{}).
+		WithIndex(&aimv1alpha1.AIMServiceTemplate{}, aimv1alpha1.ServiceTemplateModelNameIndexKey, func(obj client.Object) []string {
+			return []string{obj.(*aimv1alpha1.AIMServiceTemplate).Spec.ModelName}
+		}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if err := recorder.check(cl, obj); err != nil {
+					return err
+				}
+				return cl.Get(ctx, key, obj, opts...)
+			},
+			List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if err := recorder.check(cl, list); err != nil {
+					return err
+				}
+				return cl.List(ctx, list, opts...)
+			},
+		}).
+		Build()
+
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "http://127.0.0.1:1"}, ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+		Controller:             config.Controller{SkipNameValidation: ptr.To(true)},
+		NewCache: func(*rest.Config, cache.Options) (cache.Cache, error) {
+			return &informertest.FakeInformers{Scheme: scheme}, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &AIMModelReconciler{
+		Client:         controllerutils.NewNamespacedOnlyClient(underlying),
+		Scheme:         scheme,
+		NamespacedOnly: true,
+	}
+	if err := r.SetupWithManager(mgr); err != nil {
+		t.Fatalf("SetupWithManager failed: %v", err)
+	}
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "team", Name: "llama"}}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if kinds := recorder.read(); len(kinds) != 0 {
+		t.Errorf("expected no cluster-scoped reads in namespaced-only mode, got %v", kinds)
+	}
+
+	var updated aimv1alpha1.AIMModel
+	if err := underlying.Get(context.Background(), req.NamespacedName, &updated); err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Status.Conditions) == 0 {
+		t.Error("expected the pipeline to write status conditions")
+	}
+}
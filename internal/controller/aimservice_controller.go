@@ -25,11 +25,14 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	servingv1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
@@ -68,6 +71,34 @@ type AIMServiceReconciler struct {
 	Recorder  record.EventRecorder
 	Clientset kubernetes.Interface
 
+	// APIReader reads directly from the API server, bypassing the cache.
+	// Used for critical checks such as deciding which template caches to delete.
+	// Falls back to the cached client if nil.
+	APIReader client.Reader
+
+	// NamespacedOnly skips watches on cluster-scoped resources, for operators installed
+	// without cluster RBAC.
+	NamespacedOnly bool
+
+	// KServeNamespace is the namespace KServe is installed into, where its deployment mode is read.
+	KServeNamespace string
+
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
+	// StatusUpdateDebounce collapses status-only updates of InferenceServices, their pods and
+	// events into one reconcile per service within this window. Zero reconciles on every update.
+	StatusUpdateDebounce time.Duration
+
 	reconciler controllerutils.DomainReconciler[*aimv1alpha1.AIMService, *aimv1alpha1.AIMServiceStatus, aimservice.ServiceFetchResult, aimservice.ServiceObservation]
 	pipeline   controllerutils.Pipeline[*aimv1alpha1.AIMService, *aimv1alpha1.AIMServiceStatus, aimservice.ServiceFetchResult, aimservice.ServiceObservation]
 }
@@ -75,22 +106,31 @@ type AIMServiceReconciler struct {
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservices,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservices/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservices/finalizers,verbs=update
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimendpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimendpoints/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimmodels,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclustermodels,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservicetemplates,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclusterservicetemplates,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimtemplatecaches,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimartifacts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclustermodelcaches,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimruntimeconfigs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclusterruntimeconfigs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;create;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -126,10 +166,20 @@ func (r *AIMServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			}
 
 			if namespaceTerminating {
+				// Services are torn down first; this only reports when the last AIM resource goes
+				if _, err := awaitNamespaceTeardown(ctx, r.Client, r.Recorder, &service, "AIMService", teardownTierService); err != nil {
+					logger.Error(err, "Failed to check namespace teardown", "namespace", service.Namespace)
+					return ctrl.Result{}, err
+				}
 				logger.Info("Namespace is terminating, skipping template cache cleanup before finalizer removal",
 					"namespace", service.Namespace,
 					"service", service.Name)
 			} else {
+				if err := r.archiveService(ctx, &service); err != nil {
+					logger.Error(err, "Failed to archive service")
+					return ctrl.Result{}, err
+				}
+
 				// Run cleanup logic
 				if err := r.cleanupTemplateCaches(ctx, &service); err != nil {
 					logger.Error(err, "Failed to cleanup template caches")
@@ -185,8 +235,11 @@ func (r *AIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	ctx := context.Background()
 
 	r.reconciler = &aimservice.ServiceReconciler{
-		Clientset: r.Clientset,
-		Scheme:    r.Scheme,
+		Clientset:       r.Clientset,
+		Scheme:          r.Scheme,
+		MetricsReader:   r.APIReader,
+		KServeNamespace: r.KServeNamespace,
+		WeightsReloader: aimservice.ReloadWeights,
 	}
 	r.pipeline = controllerutils.Pipeline[
 		*aimv1alpha1.AIMService,
@@ -194,10 +247,13 @@ func (r *AIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		aimservice.ServiceFetchResult,
 		aimservice.ServiceObservation,
 	]{
-		Client:         mgr.GetClient(),
-		StatusClient:   mgr.GetClient().Status(),
+		Client:         r.Client,
+		StatusClient:   r.Client.Status(),
 		Recorder:       r.Recorder,
 		ControllerName: serviceName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
 		Reconciler:     r.reconciler,
 		Scheme:         r.Scheme,
 		Clientset:      r.Clientset,
@@ -211,10 +267,17 @@ func (r *AIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		if !ok {
 			return nil
 		}
-		if svc.Spec.Template.Name == "" {
-			return nil
+		var names []string
+		if svc.Spec.Template.Name != "" {
+			names = append(names, svc.Spec.Template.Name)
 		}
-		return []string{svc.Spec.Template.Name}
+		// Ensemble variants reference their own templates
+		if svc.Spec.Ensemble != nil {
+			for _, variant := range svc.Spec.Ensemble.Variants {
+				names = append(names, variant.Template)
+			}
+		}
+		return names
 	}); err != nil {
 		return err
 	}
@@ -233,6 +296,20 @@ func (r *AIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	// Index AIMService by resolved model name so cluster models can summarize their consumers
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &aimv1alpha1.AIMService{}, aimv1alpha1.AIMServiceResolvedModelIndexKey, func(obj client.Object) []string {
+		svc, ok := obj.(*aimv1alpha1.AIMService)
+		if !ok {
+			return nil
+		}
+		if svc.Status.ResolvedModel == nil || svc.Status.ResolvedModel.Name == "" {
+			return nil
+		}
+		return []string{svc.Status.ResolvedModel.Name}
+	}); err != nil {
+		return err
+	}
+
 	// Index Events by involvedObject.name for efficient lookup when fetching InferenceService events
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &corev1.Event{}, "involvedObject.name", func(obj client.Object) []string {
 		event, ok := obj.(*corev1.Event)
@@ -244,41 +321,35 @@ func (r *AIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&aimv1alpha1.AIMService{}).
-		Owns(&servingv1beta1.InferenceService{}).
+		// InferenceService status changes often while predictors roll out, so bursts are debounced
+		Watches(
+			&servingv1beta1.InferenceService{},
+			controllerutils.DebounceStatusUpdates(
+				handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &aimv1alpha1.AIMService{}, handler.OnlyControllerOwner()),
+				r.StatusUpdateDebounce,
+			),
+		).
 		Owns(&gatewayapiv1.HTTPRoute{}).
+		Owns(&networkingv1.NetworkPolicy{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&aimv1alpha1.AIMEndpoint{}).
 		// Watch namespace-scoped templates and enqueue services that reference them
 		Watches(
 			&aimv1alpha1.AIMServiceTemplate{},
 			handler.EnqueueRequestsFromMapFunc(r.findServicesForTemplate),
 		).
-		// Watch cluster-scoped templates and enqueue services that reference them
-		Watches(
-			&aimv1alpha1.AIMClusterServiceTemplate{},
-			handler.EnqueueRequestsFromMapFunc(r.findServicesForClusterTemplate),
-		).
 		// Watch namespace-scoped models and enqueue services using them
 		Watches(
 			&aimv1alpha1.AIMModel{},
 			handler.EnqueueRequestsFromMapFunc(r.findServicesForModel),
 		).
-		// Watch cluster-scoped models and enqueue services using them
-		Watches(
-			&aimv1alpha1.AIMClusterModel{},
-			handler.EnqueueRequestsFromMapFunc(r.findServicesForClusterModel),
-		).
 		// Watch namespace-scoped RuntimeConfigs and enqueue services that reference them
 		Watches(
 			&aimv1alpha1.AIMRuntimeConfig{},
 			handler.EnqueueRequestsFromMapFunc(r.findServicesForRuntimeConfig),
 		).
-		// Watch cluster-scoped RuntimeConfigs and enqueue services that reference them
-		Watches(
-			&aimv1alpha1.AIMClusterRuntimeConfig{},
-			handler.EnqueueRequestsFromMapFunc(r.findServicesForClusterRuntimeConfig),
-		).
 		// Watch template caches and enqueue services that use them
 		// artifact status is resolved through TemplateCache.Status.Artifacts
 		Watches(
@@ -288,12 +359,28 @@ func (r *AIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		// Watch events for InferenceServices to detect configuration errors like ServerlessModeRejected
 		Watches(
 			&corev1.Event{},
-			handler.EnqueueRequestsFromMapFunc(r.findServicesForInferenceServiceEvent),
+			controllerutils.DebounceStatusUpdates(
+				handler.EnqueueRequestsFromMapFunc(r.findServicesForInferenceServiceEvent), r.StatusUpdateDebounce),
 		).
 		// Watch pods for InferenceServices to detect ImagePull errors, pending states, etc.
+		// Pod status updates are debounced to avoid reconcile storms when many predictor pods flap
 		Watches(
 			&corev1.Pod{},
-			handler.EnqueueRequestsFromMapFunc(r.findServicesForInferenceServicePod),
+			controllerutils.DebounceStatusUpdates(
+				handler.EnqueueRequestsFromMapFunc(r.findServicesForInferenceServicePod), r.StatusUpdateDebounce),
+		).
+		// Watch node health and enqueue services with predictor pods on the node, to detect unhealthy GPUs
+		Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.findServicesForNode),
+			builder.WithPredicates(utils.NodeHealthChangePredicate()),
+		).
+		// Watch secret metadata and enqueue services that reference or failed on the secret.
+		// Secret data is read uncached during validation, so only metadata is cached here.
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findServicesForSecret),
+			builder.OnlyMetadata,
 		).
 		// Watch HPAs to update replica status when KEDA creates/updates them
 		// Use predicate to only trigger on replica changes, not every metrics update
@@ -301,7 +388,34 @@ func (r *AIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&autoscalingv2.HorizontalPodAutoscaler{},
 			handler.EnqueueRequestsFromMapFunc(r.findServicesForHPA),
 			builder.WithPredicates(hpaReplicaChangePredicate()),
-		).
+		)
+
+	if !r.NamespacedOnly {
+		b = b.
+			// Watch cluster-scoped templates and enqueue services that reference them
+			Watches(
+				&aimv1alpha1.AIMClusterServiceTemplate{},
+				handler.EnqueueRequestsFromMapFunc(r.findServicesForClusterTemplate),
+			).
+			// Watch cluster-scoped models and enqueue services using them
+			Watches(
+				&aimv1alpha1.AIMClusterModel{},
+				handler.EnqueueRequestsFromMapFunc(r.findServicesForClusterModel),
+			).
+			// Watch cluster-scoped RuntimeConfigs and enqueue services that reference them
+			Watches(
+				&aimv1alpha1.AIMClusterRuntimeConfig{},
+				handler.EnqueueRequestsFromMapFunc(r.findServicesForClusterRuntimeConfig),
+			).
+			// Watch cluster model caches so services switch to them once they become ready
+			Watches(
+				&aimv1alpha1.AIMClusterModelCache{},
+				handler.EnqueueRequestsFromMapFunc(r.findServicesForClusterModelCache),
+			)
+	}
+
+	return b.
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
 		Named(serviceName).
 		Complete(r)
 }
@@ -366,7 +480,7 @@ func (r *AIMServiceReconciler) findServicesForClusterTemplate(ctx context.Contex
 }
 
 // findServicesForModel returns reconcile requests for all AIMServices
-// that reference the given model by name, by image, or that own the model (custom models).
+// that reference the given model by name, by image, by alias, or that own the model (custom models).
 func (r *AIMServiceReconciler) findServicesForModel(ctx context.Context, obj client.Object) []reconcile.Request {
 	model, ok := obj.(*aimv1alpha1.AIMModel)
 	if !ok {
@@ -412,13 +526,23 @@ func (r *AIMServiceReconciler) findServicesForModel(ctx context.Context, obj cli
 					Namespace: svc.Namespace,
 				},
 			})
+			continue
+		}
+		// Check if service references this model by family or alias
+		if svc.Spec.Model.Alias != nil && model.Spec.MatchesAlias(*svc.Spec.Model.Alias) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      svc.Name,
+					Namespace: svc.Namespace,
+				},
+			})
 		}
 	}
 	return requests
 }
 
 // findServicesForClusterModel returns reconcile requests for all AIMServices
-// that reference the given cluster model by name or by image.
+// that reference the given cluster model by name, by image, or by alias.
 func (r *AIMServiceReconciler) findServicesForClusterModel(ctx context.Context, obj client.Object) []reconcile.Request {
 	model, ok := obj.(*aimv1alpha1.AIMClusterModel)
 	if !ok {
@@ -452,6 +576,16 @@ func (r *AIMServiceReconciler) findServicesForClusterModel(ctx context.Context,
 					Namespace: svc.Namespace,
 				},
 			})
+			continue
+		}
+		// Check if service references this model by family or alias
+		if svc.Spec.Model.Alias != nil && model.Spec.MatchesAlias(*svc.Spec.Model.Alias) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      svc.Name,
+					Namespace: svc.Namespace,
+				},
+			})
 		}
 	}
 	return requests
@@ -517,6 +651,103 @@ func (r *AIMServiceReconciler) findServicesForClusterRuntimeConfig(ctx context.C
 	return requests
 }
 
+// findServicesForClusterModelCache returns reconcile requests for all AIMServices
+// using the Shared caching mode, the only mode that mounts cluster model caches.
+func (r *AIMServiceReconciler) findServicesForClusterModelCache(ctx context.Context, obj client.Object) []reconcile.Request {
+	var services aimv1alpha1.AIMServiceList
+	if err := r.List(ctx, &services); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list AIMServices for ClusterModelCache", "cache", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, svc := range services.Items {
+		if svc.Spec.GetCachingMode() == aimv1alpha1.CachingModeShared {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      svc.Name,
+					Namespace: svc.Namespace,
+				},
+			})
+		}
+	}
+	return requests
+}
+
+// findServicesForSecret returns reconcile requests for AIMServices in the secret's namespace
+// that reference it directly, or whose status reports a secret validation failure.
+// Synced pull secrets enqueue their service, and their operator namespace source enqueues
+// every service holding a copy so the copies follow source changes.
+func (r *AIMServiceReconciler) findServicesForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	if _, ok := obj.GetLabels()[constants.LabelKeyPullSecretSource]; ok {
+		if svc := obj.GetLabels()[constants.LabelKeyService]; svc != "" {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: svc, Namespace: obj.GetNamespace()},
+			})
+		}
+	}
+	if obj.GetNamespace() == constants.GetOperatorNamespace() {
+		copies := &metav1.PartialObjectMetadataList{}
+		copies.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("SecretList"))
+		if err := r.List(ctx, copies, client.MatchingLabels{constants.LabelKeyPullSecretSource: obj.GetName()}); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list synced pull secrets for Secret", "secret", obj.GetName())
+		}
+		for _, c := range copies.Items {
+			if svc := c.Labels[constants.LabelKeyService]; svc != "" {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: svc, Namespace: c.Namespace},
+				})
+			}
+		}
+	}
+
+	var services aimv1alpha1.AIMServiceList
+	if err := r.List(ctx, &services, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list AIMServices for Secret", "secret", obj.GetName())
+		return requests
+	}
+
+	for _, svc := range services.Items {
+		if serviceReferencesSecret(&svc, obj.GetName()) || hasSecretFailure(&svc) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      svc.Name,
+					Namespace: svc.Namespace,
+				},
+			})
+		}
+	}
+	return requests
+}
+
+// serviceReferencesSecret reports whether the service spec references the named secret
+// as an image pull secret or through an env secretKeyRef.
+func serviceReferencesSecret(svc *aimv1alpha1.AIMService, name string) bool {
+	for _, ref := range svc.Spec.ImagePullSecrets {
+		if ref.Name == name {
+			return true
+		}
+	}
+	for _, env := range svc.Spec.Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSecretFailure reports whether a condition of the service carries a secret validation reason.
+// This covers secrets referenced from templates and runtime configs.
+func hasSecretFailure(svc *aimv1alpha1.AIMService) bool {
+	for _, cond := range svc.Status.Conditions {
+		if cond.Reason == aimv1alpha1.AIMServiceReasonSecretNotFound || cond.Reason == aimv1alpha1.AIMServiceReasonInvalidSecret {
+			return true
+		}
+	}
+	return false
+}
+
 // findServicesForTemplateCache returns reconcile requests for all AIMServices
 // that use the same template as the given template cache.
 // Template caches are not owned by services (to allow sharing), so we find services
@@ -591,6 +822,37 @@ func (r *AIMServiceReconciler) findServicesForInferenceServicePod(ctx context.Co
 	return nil
 }
 
+// findServicesForNode returns reconcile requests for the AIMServices whose predictor pods
+// run on the given node.
+func (r *AIMServiceReconciler) findServicesForNode(ctx context.Context, obj client.Object) []reconcile.Request {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return nil
+	}
+
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods, client.HasLabels{constants.LabelKServeInferenceService}); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list predictor pods for node", "node", node.Name)
+		return nil
+	}
+
+	seen := map[types.NamespacedName]struct{}{}
+	var requests []reconcile.Request
+	for i := range pods.Items {
+		if pods.Items[i].Spec.NodeName != node.Name {
+			continue
+		}
+		for _, req := range r.findServicesForInferenceServicePod(ctx, &pods.Items[i]) {
+			if _, dup := seen[req.NamespacedName]; dup {
+				continue
+			}
+			seen[req.NamespacedName] = struct{}{}
+			requests = append(requests, req)
+		}
+	}
+	return requests
+}
+
 // findServicesForInferenceServiceEvent returns reconcile requests for AIMServices
 // when an event is created for an InferenceService they own.
 // This enables detection of configuration errors like ServerlessModeRejected.
@@ -710,6 +972,38 @@ func (r *AIMServiceReconciler) findServicesForHPA(ctx context.Context, obj clien
 	}
 }
 
+// archiveService records a compact archive of the service before it is removed, when the
+// runtime config enables service archiving. A runtime config that cannot be resolved
+// does not block deletion.
+func (r *AIMServiceReconciler) archiveService(ctx context.Context, service *aimv1alpha1.AIMService) error {
+	logger := log.FromContext(ctx)
+
+	config, _ := controllerutils.FetchMergedRuntimeConfig(ctx, r.Client,
+		service.GetRuntimeConfigRef().Name, service.Namespace, service.GetRuntimeConfigOverride())
+	if config.HasError() {
+		logger.Info("Skipping service archive, runtime config could not be resolved",
+			"service", service.Name, "error", config.Error.Error())
+		return nil
+	}
+	retention, enabled := aimservice.ResolveServiceArchiveRetention(config.Value)
+	if !enabled {
+		return nil
+	}
+
+	var reader client.Reader = r.Client
+	if r.APIReader != nil {
+		reader = r.APIReader
+	}
+	if err := aimservice.ArchiveService(ctx, r.Client, reader, service, retention, time.Now()); err != nil {
+		if apierrors.IsForbidden(err) {
+			logger.Info("Skipping service archive, namespace may be terminating", "service", service.Name)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
 // cleanupTemplateCaches deletes AIMTemplateCaches created by this service that are not Available.
 // Template caches that are stuck in Failed/Pending states cannot be re-created while they exist,
 // blocking any future service that would use the same template. Deleting non-Available caches
@@ -723,14 +1017,21 @@ func (r *AIMServiceReconciler) cleanupTemplateCaches(ctx context.Context, servic
 		return fmt.Errorf("failed to sanitize service name for label: %w", err)
 	}
 
-	// List all AIMTemplateCaches created by this AIMService
-	var templateCaches aimv1alpha1.AIMTemplateCacheList
-	if err := r.List(ctx, &templateCaches,
+	// List all AIMTemplateCaches created by this AIMService. Read live so a cache that just
+	// became Ready is not deleted based on a stale cached status.
+	listOpts := []client.ListOption{
 		client.InNamespace(service.Namespace),
 		client.MatchingLabels{
 			constants.LabelService: serviceLabelValue,
 		},
-	); err != nil {
+	}
+	var cachesResult controllerutils.FetchResult[*aimv1alpha1.AIMTemplateCacheList]
+	if r.APIReader != nil {
+		cachesResult = controllerutils.FetchListLive(ctx, r.APIReader, &aimv1alpha1.AIMTemplateCacheList{}, listOpts...)
+	} else {
+		cachesResult = controllerutils.FetchList(ctx, r.Client, &aimv1alpha1.AIMTemplateCacheList{}, listOpts...)
+	}
+	if err := cachesResult.Error; err != nil {
 		// If the namespace is being deleted, skip cleanup
 		if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
 			logger.Info("Skipping cleanup, namespace may be terminating", "service", service.Name)
@@ -741,14 +1042,22 @@ func (r *AIMServiceReconciler) cleanupTemplateCaches(ctx context.Context, servic
 
 	// Delete only the ones that are not Available
 	var errs []error
-	for i := range templateCaches.Items {
-		tc := &templateCaches.Items[i]
+	for i := range cachesResult.Value.Items {
+		tc := &cachesResult.Value.Items[i]
 		if tc.Status.Status != constants.AIMStatusReady {
-			if deleteErr := r.Delete(ctx, tc); deleteErr != nil && !apierrors.IsNotFound(deleteErr) {
+			// Only delete the version we observed; if the cache changed since, leave it alone
+			rv := tc.ResourceVersion
+			if deleteErr := r.Delete(ctx, tc, client.Preconditions{ResourceVersion: &rv}); deleteErr != nil && !apierrors.IsNotFound(deleteErr) {
 				// If namespace is terminating, continue
 				if apierrors.IsForbidden(deleteErr) {
 					continue
 				}
+				if apierrors.IsConflict(deleteErr) {
+					logger.Info("Template cache changed since it was read, skipping deletion",
+						"templateCache", tc.Name,
+						"service", service.Name)
+					continue
+				}
 				errs = append(errs, fmt.Errorf("failed to delete template cache %s: %w", tc.Name, deleteErr))
 			} else {
 				logger.Info("Deleted non-available template cache during service cleanup",
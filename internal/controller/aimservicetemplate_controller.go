@@ -63,6 +63,22 @@ type AIMServiceTemplateReconciler struct {
 	Recorder  record.EventRecorder
 	Clientset kubernetes.Interface
 
+	// NamespacedOnly skips watches on cluster-scoped resources, for operators installed
+	// without cluster RBAC.
+	NamespacedOnly bool
+
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
 	reconciler controllerutils.DomainReconciler[
 		*aimv1alpha1.AIMServiceTemplate,
 		*aimv1alpha1.AIMServiceTemplateStatus,
@@ -87,7 +103,7 @@ type AIMServiceTemplateReconciler struct {
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
@@ -107,13 +123,22 @@ func (r *AIMServiceTemplateReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, err
 	}
 
+	if done, result, err := reconcileDiscoveryCleanupFinalizer(ctx, r.Client, r.Recorder, &template, func(ctx context.Context) error {
+		if err := aimservicetemplate.CleanupDiscoveryResources(ctx, r.Client, template.Namespace, template.Name); err != nil {
+			return err
+		}
+		return aimservicetemplate.MarkDerivedTemplatesBaseDeleted(ctx, r.Client, &template)
+	}); done {
+		return result, err
+	}
+
 	// Check if this template might need to create a discovery job.
 	// If so, we need to acquire a distributed lock to ensure atomicity
 	// of the "count active jobs + create job" operation.
 	needsLock := aimservicetemplate.NeedsDiscoveryLock(
 		template.Status.Status,
 		len(template.Spec.ModelSources) > 0,
-	)
+	) || aimservicetemplate.IsDiscoverySuperseded(&template.Status)
 
 	if needsLock {
 		// Run pipeline under the discovery lock
@@ -143,7 +168,7 @@ func (r *AIMServiceTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error
 
 	// Initialize the domain reconciler
 	r.reconciler = &aimservicetemplate.ServiceTemplateReconciler{
-		Client:    mgr.GetClient(),
+		Client:    r.Client,
 		Clientset: r.Clientset,
 		Scheme:    r.Scheme,
 	}
@@ -155,10 +180,13 @@ func (r *AIMServiceTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error
 		aimservicetemplate.ServiceTemplateFetchResult,
 		aimservicetemplate.ServiceTemplateObservation,
 	]{
-		Client:         mgr.GetClient(),
-		StatusClient:   mgr.GetClient().Status(),
+		Client:         r.Client,
+		StatusClient:   r.Client.Status(),
 		Recorder:       r.Recorder,
 		ControllerName: serviceTemplateName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
 		Reconciler:     r.reconciler,
 		Scheme:         r.Scheme,
 		Clientset:      r.Clientset,
@@ -265,18 +293,81 @@ func (r *AIMServiceTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error
 		return requestsFromServiceTemplates(templates.Items)
 	})
 
+	// Handler for base template changes - reconcile the templates derived from it
+	baseTemplateHandler := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		base, ok := obj.(*aimv1alpha1.AIMServiceTemplate)
+		if !ok {
+			return nil
+		}
+
+		var templates aimv1alpha1.AIMServiceTemplateList
+		if err := r.List(ctx, &templates,
+			client.InNamespace(base.Namespace),
+			client.MatchingLabels{constants.LabelKeyOrigin: constants.LabelValueOriginDerived},
+		); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list derived AIMServiceTemplates",
+				"template", base.Name, "namespace", base.Namespace)
+			return nil
+		}
+
+		// Filter to templates derived from this one
+		filtered := make([]aimv1alpha1.AIMServiceTemplate, 0, len(templates.Items))
+		for i := range templates.Items {
+			if templates.Items[i].Annotations[constants.AnnotationBaseTemplate] == base.Name {
+				filtered = append(filtered, templates.Items[i])
+			}
+		}
+
+		return requestsFromServiceTemplates(filtered)
+	})
+
+	// Handler for template performance changes - reconcile the measured templates of the same model
+	siblingTemplateHandler := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		changed, ok := obj.(*aimv1alpha1.AIMServiceTemplate)
+		if !ok {
+			return nil
+		}
+
+		var templates aimv1alpha1.AIMServiceTemplateList
+		if err := r.List(ctx, &templates,
+			client.InNamespace(changed.Namespace),
+			client.MatchingFields{aimv1alpha1.ServiceTemplateModelNameIndexKey: changed.Spec.ModelName},
+		); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list sibling AIMServiceTemplates",
+				"template", changed.Name, "namespace", changed.Namespace)
+			return nil
+		}
+
+		// Filter to templates with a performance measurement
+		filtered := make([]aimv1alpha1.AIMServiceTemplate, 0, len(templates.Items))
+		for i := range templates.Items {
+			if templates.Items[i].Annotations[constants.AnnotationPerformance] != "" {
+				filtered = append(filtered, templates.Items[i])
+			}
+		}
+
+		return requestsFromServiceTemplates(filtered)
+	})
+
 	// Handler for discovery Pod changes - reconcile template when pod status changes
 	discoveryPodHandler := handler.EnqueueRequestsFromMapFunc(r.findTemplateForDiscoveryPod)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&aimv1alpha1.AIMServiceTemplate{}).
 		Owns(&batchv1.Job{}).
 		Owns(&aimv1alpha1.AIMTemplateCache{}).
 		Watches(&aimv1alpha1.AIMRuntimeConfig{}, runtimeConfigHandler).
-		Watches(&aimv1alpha1.AIMClusterRuntimeConfig{}, clusterRuntimeConfigHandler).
 		Watches(&corev1.Node{}, nodeHandler, builder.WithPredicates(utils.NodeGPUChangePredicate())).
 		Watches(&aimv1alpha1.AIMModel{}, modelHandler).
-		Watches(&corev1.Pod{}, discoveryPodHandler, builder.WithPredicates(discoveryPodPredicate())).
+		Watches(&aimv1alpha1.AIMServiceTemplate{}, baseTemplateHandler, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&aimv1alpha1.AIMServiceTemplate{}, siblingTemplateHandler, builder.WithPredicates(utils.TemplatePerformanceChangePredicate())).
+		Watches(&corev1.Pod{}, discoveryPodHandler, builder.WithPredicates(discoveryPodPredicate()))
+	if !r.NamespacedOnly {
+		b = b.Watches(&aimv1alpha1.AIMClusterRuntimeConfig{}, clusterRuntimeConfigHandler)
+	}
+
+	return b.
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
 		Named(serviceTemplateName).
 		Complete(r)
 }
@@ -372,6 +463,16 @@ func hasDiscoveryPodIssue(pod *corev1.Pod) bool {
 		}
 	}
 
+	// Check for scheduling issues (e.g. no node with free GPUs)
+	if pod.Status.Phase == corev1.PodPending {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse &&
+				condition.Reason == corev1.PodReasonUnschedulable {
+				return true
+			}
+		}
+	}
+
 	return false
 }
 
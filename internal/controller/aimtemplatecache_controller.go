@@ -62,6 +62,22 @@ type AIMTemplateCacheReconciler struct {
 	Scheme    *runtime.Scheme
 	Recorder  record.EventRecorder
 
+	// NamespacedOnly skips watches on cluster-scoped resources, for operators installed
+	// without cluster RBAC.
+	NamespacedOnly bool
+
+	// WorkqueueMonitor records the workqueue depth and age for the SLO. Optional.
+	WorkqueueMonitor *controllerutils.WorkqueueMonitor
+
+	// PauseSwitch pauses apply and delete of the controller cluster-wide. Optional.
+	PauseSwitch *controllerutils.PauseSwitch
+
+	// StatusBatcher writes status asynchronously in batches. Optional.
+	StatusBatcher *controllerutils.StatusBatcher
+
+	// CircuitBreaker backs off sharply from resources that keep failing. Optional.
+	CircuitBreaker *controllerutils.CircuitBreaker
+
 	reconciler controllerutils.DomainReconciler[
 		*aimv1alpha1.AIMTemplateCache,
 		*aimv1alpha1.AIMTemplateCacheStatus,
@@ -82,6 +98,7 @@ type AIMTemplateCacheReconciler struct {
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservicetemplates,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimclusterservicetemplates,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimartifacts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aim.eai.amd.com,resources=aimservices,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -118,6 +135,15 @@ func (r *AIMTemplateCacheReconciler) Reconcile(ctx context.Context, req ctrl.Req
 			}
 
 			if namespaceTerminating {
+				// Template caches are torn down after the services of the namespace
+				wait, err := awaitNamespaceTeardown(ctx, r.Client, r.Recorder, &templateCache, "AIMTemplateCache", teardownTierTemplateCache)
+				if err != nil {
+					logger.Error(err, "Failed to check namespace teardown", "namespace", templateCache.Namespace)
+					return ctrl.Result{}, err
+				}
+				if wait {
+					return ctrl.Result{RequeueAfter: namespaceTeardownRequeueInterval}, nil
+				}
 				logger.Info("Namespace is terminating, skipping artifact cleanup before finalizer removal",
 					"namespace", templateCache.Namespace,
 					"templateCache", templateCache.Name)
@@ -212,10 +238,13 @@ func (r *AIMTemplateCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		aimtemplatecache.TemplateCacheFetchResult,
 		aimtemplatecache.TemplateCacheObservation,
 	]{
-		Client:         mgr.GetClient(),
-		StatusClient:   mgr.GetClient().Status(),
+		Client:         r.Client,
+		StatusClient:   r.Client.Status(),
 		Recorder:       r.Recorder,
 		ControllerName: templateCacheName,
+		PauseSwitch:    r.PauseSwitch,
+		StatusBatcher:  r.StatusBatcher,
+		CircuitBreaker: r.CircuitBreaker,
 		Reconciler:     r.reconciler,
 		Scheme:         r.Scheme,
 		Clientset:      r.Clientset,
@@ -252,7 +281,7 @@ func (r *AIMTemplateCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		},
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&aimv1alpha1.AIMTemplateCache{}).
 		// Watch artifacts and enqueue template caches that created them (via label)
 		// artifacts are shared resources without owner references
@@ -265,11 +294,22 @@ func (r *AIMTemplateCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findTemplateCachesForServiceTemplate),
 			builder.WithPredicates(templateStatusPredicate),
 		).
+		// Watch services so their cache lookups are counted in the template cache usage
 		Watches(
+			&aimv1alpha1.AIMService{},
+			handler.EnqueueRequestsFromMapFunc(r.findTemplateCacheForServiceLookup),
+			builder.WithPredicates(serviceCacheLookupPredicate),
+		)
+	if !r.NamespacedOnly {
+		b = b.Watches(
 			&aimv1alpha1.AIMClusterServiceTemplate{},
 			handler.EnqueueRequestsFromMapFunc(r.findTemplateCachesForClusterServiceTemplate),
 			builder.WithPredicates(templateStatusPredicate),
-		).
+		)
+	}
+
+	return b.
+		WithOptions(r.WorkqueueMonitor.ControllerOptions()).
 		Named(templateCacheName).
 		Complete(r)
 }
@@ -361,6 +401,52 @@ func (r *AIMTemplateCacheReconciler) findTemplateCachesForArtifact(ctx context.C
 	}
 }
 
+// findTemplateCacheForServiceLookup returns the template cache a service recorded a lookup against.
+func (r *AIMTemplateCacheReconciler) findTemplateCacheForServiceLookup(ctx context.Context, obj client.Object) []ctrl.Request {
+	lookup := getServiceCacheLookup(obj)
+	if lookup == nil || lookup.TemplateCache == "" {
+		return nil
+	}
+	return []ctrl.Request{
+		{
+			NamespacedName: client.ObjectKey{
+				Name:      lookup.TemplateCache,
+				Namespace: obj.GetNamespace(),
+			},
+		},
+	}
+}
+
+// serviceCacheLookupPredicate passes service events that add, change or remove a cache lookup.
+var serviceCacheLookupPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return getServiceCacheLookup(e.Object) != nil
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldLookup := getServiceCacheLookup(e.ObjectOld)
+		newLookup := getServiceCacheLookup(e.ObjectNew)
+		if oldLookup == nil || newLookup == nil {
+			return oldLookup != newLookup
+		}
+		return *oldLookup != *newLookup
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return getServiceCacheLookup(e.Object) != nil
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// getServiceCacheLookup extracts the cache lookup from a service status, if any
+func getServiceCacheLookup(obj client.Object) *aimv1alpha1.AIMServiceCacheLookup {
+	service, ok := obj.(*aimv1alpha1.AIMService)
+	if !ok || service.Status.Cache == nil {
+		return nil
+	}
+	return service.Status.Cache.Lookup
+}
+
 // getTemplateStatus extracts the status from a template object (works for both namespace and cluster scoped)
 func getTemplateStatus(obj client.Object) constants.AIMStatus {
 	switch t := obj.(type) {
@@ -24,12 +24,50 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+const (
+	// namespaceTeardownRequeueInterval is how often a resource of a terminating namespace checks
+	// whether the resources torn down before it are gone.
+	namespaceTeardownRequeueInterval = 2 * time.Second
+
+	// eventReasonTeardownWaiting is emitted on a terminating namespace while a resource waits
+	// for the resources torn down before it.
+	eventReasonTeardownWaiting = "AIMTeardownWaiting"
+
+	// eventReasonTeardownComplete is emitted on a terminating namespace when its last AIM
+	// resource releases its finalizer.
+	eventReasonTeardownComplete = "AIMTeardownComplete"
 )
 
+// teardownTier is the position of a resource kind in the teardown order of a terminating
+// namespace. Services go first, then template caches, then templates, so that a template cache
+// or template is never removed while a service still depends on it.
+type teardownTier int
+
+const (
+	teardownTierService teardownTier = iota
+	teardownTierTemplateCache
+	teardownTierTemplate
+)
+
+// namespaceTeardown counts the AIM resources left in a terminating namespace.
+type namespaceTeardown struct {
+	services       int
+	templateCaches int
+	templates      int
+}
+
 // isNamespaceTerminating returns true when the namespace is deleting or already deleted.
 func isNamespaceTerminating(ctx context.Context, c client.Client, namespace string) (bool, error) {
 	if namespace == "" {
@@ -46,3 +84,79 @@ func isNamespaceTerminating(ctx context.Context, c client.Client, namespace stri
 
 	return ns.DeletionTimestamp != nil, nil
 }
+
+// fetchNamespaceTeardown counts the AIM resources left in the namespace.
+func fetchNamespaceTeardown(ctx context.Context, c client.Client, namespace string) (namespaceTeardown, error) {
+	var services aimv1alpha1.AIMServiceList
+	if err := c.List(ctx, &services, client.InNamespace(namespace)); err != nil {
+		return namespaceTeardown{}, fmt.Errorf("failed to list AIMServices: %w", err)
+	}
+	var templateCaches aimv1alpha1.AIMTemplateCacheList
+	if err := c.List(ctx, &templateCaches, client.InNamespace(namespace)); err != nil {
+		return namespaceTeardown{}, fmt.Errorf("failed to list AIMTemplateCaches: %w", err)
+	}
+	var templates aimv1alpha1.AIMServiceTemplateList
+	if err := c.List(ctx, &templates, client.InNamespace(namespace)); err != nil {
+		return namespaceTeardown{}, fmt.Errorf("failed to list AIMServiceTemplates: %w", err)
+	}
+	return namespaceTeardown{
+		services:       len(services.Items),
+		templateCaches: len(templateCaches.Items),
+		templates:      len(templates.Items),
+	}, nil
+}
+
+// pendingBefore describes the resources that must be deleted before resources of the tier,
+// or returns an empty string if there are none.
+func (t namespaceTeardown) pendingBefore(tier teardownTier) string {
+	var pending []string
+	if tier > teardownTierService && t.services > 0 {
+		pending = append(pending, fmt.Sprintf("%d AIMService(s)", t.services))
+	}
+	if tier > teardownTierTemplateCache && t.templateCaches > 0 {
+		pending = append(pending, fmt.Sprintf("%d AIMTemplateCache(s)", t.templateCaches))
+	}
+	return strings.Join(pending, " and ")
+}
+
+// awaitNamespaceTeardown returns true while obj must keep its finalizer because resources torn
+// down before it still exist in its terminating namespace. Waiting, and the release of the last
+// AIM resource of the namespace, are reported as events on the namespace.
+func awaitNamespaceTeardown(
+	ctx context.Context,
+	c client.Client,
+	recorder record.EventRecorder,
+	obj client.Object,
+	kind string,
+	tier teardownTier,
+) (bool, error) {
+	teardown, err := fetchNamespaceTeardown(ctx, c, obj.GetNamespace())
+	if err != nil {
+		return false, err
+	}
+
+	if pending := teardown.pendingBefore(tier); pending != "" {
+		recordNamespaceEvent(ctx, c, recorder, obj.GetNamespace(), eventReasonTeardownWaiting,
+			fmt.Sprintf("%s %s waits for %s to be deleted", kind, obj.GetName(), pending))
+		return true, nil
+	}
+
+	if teardown.services+teardown.templateCaches+teardown.templates <= 1 {
+		recordNamespaceEvent(ctx, c, recorder, obj.GetNamespace(), eventReasonTeardownComplete,
+			"Released all AIM resources in order: services, template caches, templates")
+	}
+	return false, nil
+}
+
+// recordNamespaceEvent emits a normal event on the namespace. Nothing is emitted once the
+// namespace is gone.
+func recordNamespaceEvent(ctx context.Context, c client.Client, recorder record.EventRecorder, namespace, reason, message string) {
+	if recorder == nil {
+		return
+	}
+	var ns corev1.Namespace
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		return
+	}
+	recorder.Event(&ns, corev1.EventTypeNormal, reason, message)
+}
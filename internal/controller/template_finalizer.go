@@ -0,0 +1,110 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controller
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// finalizerDiscoveryCleanup removes a template's discovery jobs and pods before the
+	// template is deleted, so no discovery job keeps holding GPUs after its template is gone.
+	finalizerDiscoveryCleanup = "aim.eai.amd.com/discovery-cleanup"
+)
+
+// reconcileDiscoveryCleanupFinalizer adds the discovery cleanup finalizer to live templates and
+// runs cleanup before removing it from deleted ones. In a terminating namespace, the finalizer is
+// kept until the services and template caches of the namespace are gone. It returns done=true
+// when the caller should return the given result instead of running the pipeline.
+func reconcileDiscoveryCleanupFinalizer(
+	ctx context.Context,
+	c client.Client,
+	recorder record.EventRecorder,
+	template client.Object,
+	cleanup func(ctx context.Context) error,
+) (done bool, result ctrl.Result, err error) {
+	logger := log.FromContext(ctx)
+
+	if template.GetDeletionTimestamp() == nil {
+		if controllerutil.ContainsFinalizer(template, finalizerDiscoveryCleanup) {
+			return false, ctrl.Result{}, nil
+		}
+		controllerutil.AddFinalizer(template, finalizerDiscoveryCleanup)
+		if err := c.Update(ctx, template); err != nil {
+			if apierrors.IsConflict(err) {
+				return true, ctrl.Result{Requeue: true}, nil
+			}
+			return true, ctrl.Result{}, err
+		}
+		return true, ctrl.Result{Requeue: true}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(template, finalizerDiscoveryCleanup) {
+		return true, ctrl.Result{}, nil
+	}
+
+	namespaceTerminating, err := isNamespaceTerminating(ctx, c, template.GetNamespace())
+	if err != nil && !apierrors.IsForbidden(err) {
+		logger.Error(err, "Failed to check namespace termination", "namespace", template.GetNamespace())
+		return true, ctrl.Result{}, err
+	}
+	if apierrors.IsForbidden(err) {
+		namespaceTerminating = true
+	}
+
+	if namespaceTerminating {
+		wait, err := awaitNamespaceTeardown(ctx, c, recorder, template, "AIMServiceTemplate", teardownTierTemplate)
+		if err != nil {
+			logger.Error(err, "Failed to check namespace teardown", "namespace", template.GetNamespace())
+			return true, ctrl.Result{}, err
+		}
+		if wait {
+			return true, ctrl.Result{RequeueAfter: namespaceTeardownRequeueInterval}, nil
+		}
+		logger.Info("Namespace is terminating, skipping discovery cleanup before finalizer removal",
+			"namespace", template.GetNamespace(), "template", template.GetName())
+	} else if err := cleanup(ctx); err != nil {
+		logger.Error(err, "Failed to clean up discovery resources", "template", template.GetName())
+		return true, ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(template, finalizerDiscoveryCleanup)
+	if err := c.Update(ctx, template); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, ctrl.Result{}, nil
+		}
+		if apierrors.IsConflict(err) {
+			return true, ctrl.Result{Requeue: true}, nil
+		}
+		return true, ctrl.Result{}, err
+	}
+	logger.Info("Removed discovery cleanup finalizer", "template", template.GetName(), "namespace", template.GetNamespace())
+	return true, ctrl.Result{}, nil
+}
@@ -0,0 +1,144 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+const (
+	// ConditionTypeResourceConflict is True while objects the operator would create already exist
+	// without being managed by it, and the adoption policy is Conflict.
+	ConditionTypeResourceConflict = "ResourceConflict"
+
+	// ReasonObjectNotManaged is the ResourceConflict reason, and the matching Ready reason
+	ReasonObjectNotManaged = "ObjectNotManaged"
+
+	// resourceConflictRecheckInterval is how often a conflict is re-checked. Deleting or
+	// annotating the conflicting object does not trigger a watch event on the owner.
+	resourceConflictRecheckInterval = time.Minute
+)
+
+// ResolveAdoptionPolicy returns the adoption policy for the children of obj. The annotation on
+// obj takes precedence over the runtime config. Returns an empty policy when neither sets a
+// valid one, in which case objects are applied without checking who manages them.
+func ResolveAdoptionPolicy(obj client.Object, config *aimv1alpha1.AIMRuntimeConfigCommon) aimv1alpha1.AIMAdoptionPolicy {
+	policy := aimv1alpha1.AIMAdoptionPolicy(obj.GetAnnotations()[constants.AnnotationAdoptionPolicy])
+	if policy == "" && config != nil {
+		policy = config.AdoptionPolicy
+	}
+	switch policy {
+	case aimv1alpha1.AIMAdoptionPolicyAdopt, aimv1alpha1.AIMAdoptionPolicyConflict, aimv1alpha1.AIMAdoptionPolicyIgnore:
+		return policy
+	default:
+		return ""
+	}
+}
+
+// isOperatorManaged reports whether an object carries the labels of one of the operator's controllers.
+func isOperatorManaged(obj client.Object) bool {
+	labels := obj.GetLabels()
+	if labels[constants.LabelKeyManagedBy] == constants.LabelValueManagedBy {
+		return true
+	}
+	managedBy := labels[constants.LabelK8sManagedBy]
+	return strings.HasPrefix(managedBy, "aim-") && strings.HasSuffix(managedBy, "-controller")
+}
+
+// findUnmanagedObjects returns the objects that already exist without owner references and
+// without the operator's labels. Read errors other than NotFound do not count, so the apply
+// reports them.
+func findUnmanagedObjects(ctx context.Context, c client.Client, scheme *runtime.Scheme, objs []client.Object) []client.Object {
+	var unmanaged []client.Object
+	for _, obj := range objs {
+		current, ok := obj.DeepCopyObject().(client.Object)
+		if !ok {
+			continue
+		}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil ||
+			len(current.GetOwnerReferences()) > 0 || isOperatorManaged(current) {
+			continue
+		}
+		// The kind names the object in the ResourceConflict condition
+		_ = stampGVK(obj, scheme)
+		unmanaged = append(unmanaged, obj)
+	}
+	return unmanaged
+}
+
+// applyAdoptionPolicy checks the owned objects of the plan for unmanaged pre-existing objects.
+// Under Adopt they are marked to be applied with forced field ownership, otherwise they are
+// removed from the plan. Returns the unmanaged objects.
+func applyAdoptionPolicy(
+	ctx context.Context,
+	c client.Client,
+	scheme *runtime.Scheme,
+	planResult *PlanResult,
+	policy aimv1alpha1.AIMAdoptionPolicy,
+) []client.Object {
+	if policy == "" {
+		return nil
+	}
+	unmanaged := findUnmanagedObjects(ctx, c, scheme, planResult.toApply)
+	if len(unmanaged) == 0 {
+		return nil
+	}
+	if policy == aimv1alpha1.AIMAdoptionPolicyAdopt {
+		if planResult.adopt == nil {
+			planResult.adopt = make(map[client.Object]bool, len(unmanaged))
+		}
+		for _, obj := range unmanaged {
+			planResult.adopt[obj] = true
+		}
+		return unmanaged
+	}
+	// Filter into a new slice, the plan may share its backing array with the caller
+	toApply := make([]client.Object, 0, len(planResult.toApply))
+	for _, obj := range planResult.toApply {
+		if !slices.Contains(unmanaged, obj) {
+			toApply = append(toApply, obj)
+		}
+	}
+	planResult.toApply = toApply
+	return unmanaged
+}
+
+// resourceConflictMessage names the conflicting objects for the ResourceConflict condition.
+func resourceConflictMessage(objs []client.Object) string {
+	names := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		names = append(names, fmt.Sprintf("%s %s", obj.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(obj)))
+	}
+	return fmt.Sprintf("Not managed by the operator: %s. Delete them or set the %s annotation to Adopt",
+		strings.Join(names, ", "), constants.AnnotationAdoptionPolicy)
+}
@@ -0,0 +1,209 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// forceRecordingClient records which applied objects forced field ownership
+type forceRecordingClient struct {
+	client.Client
+	forced []string
+}
+
+func (c *forceRecordingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch.Type() == types.ApplyPatchType {
+		patchOpts := &client.PatchOptions{}
+		patchOpts.ApplyOptions(opts)
+		if patchOpts.Force != nil && *patchOpts.Force {
+			c.forced = append(c.forced, obj.GetName())
+		}
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func newAdoptionOwner(policy aimv1alpha1.AIMAdoptionPolicy) *testObject {
+	obj := &testObject{
+		TypeMeta: metav1.TypeMeta{APIVersion: "test.k8s.io/v1", Kind: "TestObject"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-obj",
+			Namespace:         "default",
+			CreationTimestamp: metav1.Now(),
+		},
+	}
+	if policy != "" {
+		obj.Annotations = map[string]string{constants.AnnotationAdoptionPolicy: string(policy)}
+	}
+	return obj
+}
+
+func TestResolveAdoptionPolicy(t *testing.T) {
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{AdoptionPolicy: aimv1alpha1.AIMAdoptionPolicyIgnore}
+
+	tests := []struct {
+		name   string
+		obj    *testObject
+		config *aimv1alpha1.AIMRuntimeConfigCommon
+		want   aimv1alpha1.AIMAdoptionPolicy
+	}{
+		{name: "unset", obj: newAdoptionOwner(""), want: ""},
+		{name: "config", obj: newAdoptionOwner(""), config: config, want: aimv1alpha1.AIMAdoptionPolicyIgnore},
+		{
+			name:   "annotation wins over config",
+			obj:    newAdoptionOwner(aimv1alpha1.AIMAdoptionPolicyConflict),
+			config: config,
+			want:   aimv1alpha1.AIMAdoptionPolicyConflict,
+		},
+		{name: "unknown value", obj: newAdoptionOwner("Takeover"), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveAdoptionPolicy(tt.obj, tt.config); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFindUnmanagedObjects(t *testing.T) {
+	owned := newPlanOrderChild("owned")
+	owned.OwnerReferences = []metav1.OwnerReference{{APIVersion: "test.k8s.io/v1", Kind: "TestObject", Name: "test-obj", UID: "uid"}}
+	labelled := newPlanOrderChild("labelled")
+	labelled.Labels = map[string]string{constants.LabelK8sManagedBy: "aim-test-controller"}
+	foreign := newPlanOrderChild("foreign")
+	scheme := newPlanOrderScheme()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(owned, labelled, foreign).Build()
+
+	desired := []client.Object{
+		newPlanOrderChild("owned"),
+		newPlanOrderChild("labelled"),
+		newPlanOrderChild("foreign"),
+		newPlanOrderChild("missing"),
+	}
+	unmanaged := findUnmanagedObjects(context.Background(), c, scheme, desired)
+	if len(unmanaged) != 1 || unmanaged[0].GetName() != "foreign" {
+		t.Fatalf("expected only the foreign object, got %v", unmanaged)
+	}
+	if msg := resourceConflictMessage(unmanaged); msg != "Not managed by the operator: testObject default/foreign. "+
+		"Delete them or set the aim.eai.amd.com/adoption-policy annotation to Adopt" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+}
+
+func TestPipeline_Run_AdoptionPolicyConflict(t *testing.T) {
+	scheme := newPlanOrderScheme()
+	obj := newAdoptionOwner(aimv1alpha1.AIMAdoptionPolicyConflict)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(obj, newPlanOrderChild("isvc")).WithStatusSubresource(obj).Build()
+
+	var plan PlanResult
+	plan.Apply(newPlanOrderChild("isvc"))
+	plan.Apply(newPlanOrderChild("config"))
+	reconciler := &testReconcilerWithPlan{fetchResult: testFetch{ModelReady: true}, planResult: plan}
+
+	applyClient := &selectiveFailingApplyClient{Client: fakeClient}
+	p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+		Client:         applyClient,
+		StatusClient:   fakeClient.Status(),
+		Recorder:       record.NewFakeRecorder(10),
+		Reconciler:     reconciler,
+		Scheme:         scheme,
+		ControllerName: "test",
+	}
+
+	result, err := p.Run(context.Background(), obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applyClient.applied) != 1 || applyClient.applied[0] != "config" {
+		t.Errorf("expected only the managed object to be applied, got %v", applyClient.applied)
+	}
+	conflict := findCondition(obj.Status.Conditions, ConditionTypeResourceConflict)
+	if conflict == nil || conflict.Status != metav1.ConditionTrue || conflict.Reason != ReasonObjectNotManaged {
+		t.Fatalf("expected a ResourceConflict condition, got %+v", conflict)
+	}
+	if obj.Status.Status != string(constants.AIMStatusFailed) {
+		t.Errorf("expected status Failed, got %s", obj.Status.Status)
+	}
+	if result.RequeueAfter != resourceConflictRecheckInterval {
+		t.Errorf("expected a requeue after %v, got %v", resourceConflictRecheckInterval, result.RequeueAfter)
+	}
+
+	// Removing the conflicting object clears the condition
+	if err := fakeClient.Delete(context.Background(), newPlanOrderChild("isvc")); err != nil {
+		t.Fatalf("failed to delete isvc: %v", err)
+	}
+	applyClient.applied = nil
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applyClient.applied) != 2 {
+		t.Errorf("expected both objects to be applied, got %v", applyClient.applied)
+	}
+	if cond := findCondition(obj.Status.Conditions, ConditionTypeResourceConflict); cond != nil {
+		t.Errorf("expected the ResourceConflict condition to be removed, got %+v", cond)
+	}
+}
+
+func TestPipeline_Run_AdoptionPolicyAdopt(t *testing.T) {
+	scheme := newPlanOrderScheme()
+	obj := newAdoptionOwner(aimv1alpha1.AIMAdoptionPolicyAdopt)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(obj, newPlanOrderChild("isvc")).WithStatusSubresource(obj).Build()
+
+	var plan PlanResult
+	plan.Apply(newPlanOrderChild("isvc"))
+	plan.Apply(newPlanOrderChild("config"))
+	reconciler := &testReconcilerWithPlan{fetchResult: testFetch{ModelReady: true}, planResult: plan}
+
+	applyClient := &forceRecordingClient{Client: &selectiveFailingApplyClient{Client: fakeClient}}
+	p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+		Client:         applyClient,
+		StatusClient:   fakeClient.Status(),
+		Recorder:       record.NewFakeRecorder(10),
+		Reconciler:     reconciler,
+		Scheme:         scheme,
+		ControllerName: "test",
+	}
+
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applyClient.forced) != 1 || applyClient.forced[0] != "isvc" {
+		t.Errorf("expected only the unmanaged object to force ownership, got %v", applyClient.forced)
+	}
+	if cond := findCondition(obj.Status.Conditions, ConditionTypeResourceConflict); cond != nil {
+		t.Errorf("expected no ResourceConflict condition, got %+v", cond)
+	}
+}
@@ -23,15 +23,21 @@
 package controllerutils
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
+	"text/template"
 
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,9 +45,46 @@ import (
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
+// ObjectApplyError is the failure to apply a single object.
+type ObjectApplyError struct {
+	GVK schema.GroupVersionKind
+	Key client.ObjectKey
+	Err error
+}
+
+func (e *ObjectApplyError) Error() string {
+	return fmt.Sprintf("failed to apply %s %s: %v", e.GVK.Kind, e.Key.Name, e.Err)
+}
+
+func (e *ObjectApplyError) Unwrap() error {
+	return e.Err
+}
+
+// ObjectApplyErrors returns every per-object failure contained in err, in apply order.
+func ObjectApplyErrors(err error) []*ObjectApplyError {
+	if err == nil {
+		return nil
+	}
+	if objErr, ok := err.(*ObjectApplyError); ok {
+		return []*ObjectApplyError{objErr}
+	}
+	var result []*ObjectApplyError
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			result = append(result, ObjectApplyErrors(inner)...)
+		}
+	case interface{ Unwrap() error }:
+		result = ObjectApplyErrors(e.Unwrap())
+	}
+	return result
+}
+
 // ApplyDesiredState applies the desired set of objects via Server-Side Apply (SSA).
 // Objects are applied in deterministic order: by GVK, then namespace, then name.
 // If owner is provided, owner references will be set on all objects before applying.
+// A failing object does not stop the remaining ones from being applied; the returned
+// error joins one ObjectApplyError per failed object.
 func ApplyDesiredState(
 	ctx context.Context,
 	k8sClient client.Client,
@@ -49,6 +92,7 @@ func ApplyDesiredState(
 	scheme *runtime.Scheme,
 	desired []client.Object,
 	owner client.Object,
+	patchOpts ...client.PatchOption,
 ) error {
 	if len(desired) == 0 {
 		return nil
@@ -79,6 +123,7 @@ func ApplyDesiredState(
 	sorted := sortObjects(desired)
 
 	// Apply each object via SSA
+	var errs []error
 	for _, obj := range sorted {
 		gvk := obj.GetObjectKind().GroupVersionKind()
 		key := client.ObjectKeyFromObject(obj)
@@ -88,17 +133,21 @@ func ApplyDesiredState(
 		// SSA will automatically handle conflicts - if another manager has changed fields,
 		// this apply will only update fields owned by this controller's field manager.
 		// This allows proper cooperation with kubectl and other controllers.
+		if err := injectApplyFault(ctx, obj); err != nil {
+			errs = append(errs, &ObjectApplyError{GVK: gvk, Key: key, Err: err})
+			continue
+		}
 		if err := k8sClient.Patch(
 			ctx,
 			obj,
 			client.Apply,
-			client.FieldOwner(fieldOwner),
+			append([]client.PatchOption{client.FieldOwner(fieldOwner)}, patchOpts...)...,
 		); err != nil {
-			return fmt.Errorf("failed to apply %s %s: %w", gvk.Kind, key.Name, err)
+			errs = append(errs, &ObjectApplyError{GVK: gvk, Key: key, Err: err})
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // stampGVK ensures the object has its GVK set from the scheme
@@ -188,6 +237,7 @@ func sortObjects(objects []client.Object) []client.Object {
 	return sorted
 }
 
+// PropagateLabelsForResult propagates labels and annotations from the parent to all resources in the PlanResult.
 func PropagateLabelsForResult(parent client.Object, planResult *PlanResult, config *aimv1alpha1.AIMRuntimeConfigCommon) {
 	for _, obj := range planResult.toApply {
 		PropagateLabels(parent, obj, config)
@@ -197,6 +247,18 @@ func PropagateLabelsForResult(parent client.Object, planResult *PlanResult, conf
 	}
 }
 
+// ApplyMetadataToResult labels all resources in the PlanResult before they are applied.
+// Controller labels are set first so that labels propagated from the parent can never override them.
+func ApplyMetadataToResult(
+	parent client.Object,
+	planResult *PlanResult,
+	config *aimv1alpha1.AIMRuntimeConfigCommon,
+	controllerLabels map[string]string,
+) {
+	ApplyControllerLabelsToResult(planResult, controllerLabels)
+	PropagateLabelsForResult(parent, planResult, config)
+}
+
 // ApplyControllerLabelsToResult adds controller-specific labels to all resources in the PlanResult.
 // These labels are added to both the resource metadata and to Job pod templates (if applicable).
 // Labels are merged with existing labels - existing labels are not overwritten.
@@ -252,9 +314,9 @@ func applyControllerLabels(obj client.Object, labels map[string]string) {
 	}
 }
 
-// PropagateLabels propagates labels from a parent resource to a child resource.
+// PropagateLabels propagates labels and annotations from a parent resource to a child resource.
 // AIM system labels (aim.eai.amd.com/*) are always propagated to maintain traceability
-// across the resource hierarchy. User-defined labels are propagated based on the
+// across the resource hierarchy. User-defined labels and annotations are propagated based on the
 // runtime config's label propagation settings.
 //
 // Parameters:
@@ -262,66 +324,146 @@ func applyControllerLabels(obj client.Object, labels map[string]string) {
 //   - child: The target resource that will receive the propagated labels
 //   - config: The runtime config common spec containing label propagation settings
 //
-// The function does nothing if the parent has no labels.
-// The child's existing labels are preserved and only new labels are added.
+// The child's existing labels and annotations are preserved and only new keys are added.
+// Controller-owned keys (see isControllerOwnedKey) are never propagated from user settings.
 //
 // Special handling for Jobs: Labels are also propagated to the PodTemplateSpec.
 func PropagateLabels(parent, child client.Object, config *aimv1alpha1.AIMRuntimeConfigCommon) {
-	parentLabels := parent.GetLabels()
-	if len(parentLabels) == 0 {
-		return
-	}
-
-	// Initialize child labels if nil
-	childLabels := child.GetLabels()
-	if childLabels == nil {
-		childLabels = make(map[string]string)
+	var policy *aimv1alpha1.AIMRuntimeConfigLabelPropagationSpec
+	if config != nil && config.LabelPropagation != nil && config.LabelPropagation.Enabled {
+		policy = config.LabelPropagation
 	}
 
 	// Collect labels to propagate
 	labelsToPropagate := make(map[string]string)
-
-	// Check if user-defined label propagation is enabled
-	userPropagationEnabled := config != nil &&
-		config.LabelPropagation != nil &&
-		config.LabelPropagation.Enabled &&
-		len(config.LabelPropagation.Match) > 0
-
-	// Iterate through parent labels and collect ones to propagate
-	for key, value := range parentLabels {
-		// Skip if child already has this label
-		if _, exists := childLabels[key]; exists {
+	for key, value := range parent.GetLabels() {
+		// The health label describes the parent itself, so it is never copied
+		if key == constants.LabelKeyHealth {
 			continue
 		}
 
 		// Always propagate AIM system labels for traceability across the resource hierarchy
 		if strings.HasPrefix(key, constants.AimLabelDomain+"/") {
-			childLabels[key] = value
 			labelsToPropagate[key] = value
 			continue
 		}
 
 		// Propagate labels matching user-defined patterns if enabled
-		if userPropagationEnabled && matchesAnyPattern(key, config.LabelPropagation.Match) {
-			childLabels[key] = value
+		if policy != nil && !isControllerOwnedKey(key) && matchesFilter(key, policy.Match, policy.Exclude) {
+			labelsToPropagate[key] = value
+		}
+	}
+	if policy != nil {
+		for key, value := range renderLabelTemplates(parent, policy.Templates) {
 			labelsToPropagate[key] = value
 		}
 	}
 
-	child.SetLabels(childLabels)
+	if len(labelsToPropagate) > 0 {
+		child.SetLabels(mergeMissing(child.GetLabels(), labelsToPropagate))
 
-	// Special handling for Jobs: also propagate to PodTemplateSpec
-	if job, ok := child.(*batchv1.Job); ok && len(labelsToPropagate) > 0 {
-		if job.Spec.Template.Labels == nil {
-			job.Spec.Template.Labels = make(map[string]string)
+		// Special handling for Jobs: also propagate to PodTemplateSpec
+		if job, ok := child.(*batchv1.Job); ok {
+			job.Spec.Template.Labels = mergeMissing(job.Spec.Template.Labels, labelsToPropagate)
 		}
-		for key, value := range labelsToPropagate {
-			// Only add if not already present in pod template
-			if _, exists := job.Spec.Template.Labels[key]; !exists {
-				job.Spec.Template.Labels[key] = value
+	}
+
+	if policy != nil && policy.Annotations != nil {
+		annotationsToPropagate := make(map[string]string)
+		for key, value := range parent.GetAnnotations() {
+			if isReservedAnnotationKey(key) {
+				continue
 			}
+			if matchesFilter(key, policy.Annotations.Match, policy.Annotations.Exclude) {
+				annotationsToPropagate[key] = value
+			}
+		}
+		if len(annotationsToPropagate) > 0 {
+			child.SetAnnotations(mergeMissing(child.GetAnnotations(), annotationsToPropagate))
+		}
+	}
+}
+
+// mergeMissing adds the entries of src that are not yet present in dst, initializing dst if needed.
+func mergeMissing(dst, src map[string]string) map[string]string {
+	if dst == nil {
+		dst = make(map[string]string, len(src))
+	}
+	for key, value := range src {
+		if _, exists := dst[key]; !exists {
+			dst[key] = value
+		}
+	}
+	return dst
+}
+
+// isControllerOwnedKey returns true for label keys that only controllers may set.
+func isControllerOwnedKey(key string) bool {
+	return key == constants.LabelK8sManagedBy || strings.HasPrefix(key, constants.AimLabelDomain+"/")
+}
+
+// isReservedAnnotationKey returns true for annotation keys that must not be copied between resources.
+func isReservedAnnotationKey(key string) bool {
+	return strings.HasPrefix(key, constants.AimLabelDomain+"/") || strings.HasPrefix(key, "kubectl.kubernetes.io/")
+}
+
+// matchesFilter returns true if the key matches any of the match patterns and none of the exclude patterns.
+func matchesFilter(key string, match, exclude []string) bool {
+	return matchesAnyPattern(key, match) && !matchesAnyPattern(key, exclude)
+}
+
+// labelTemplateData is the data available to label templates.
+type labelTemplateData struct {
+	Name        string
+	Namespace   string
+	Kind        string
+	ModelName   string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// renderLabelTemplates renders templated labels against the parent resource.
+// Templates that fail to render, render to an invalid label value, or target a
+// controller-owned or invalid key are skipped.
+func renderLabelTemplates(parent client.Object, templates map[string]string) map[string]string {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	data := labelTemplateData{
+		Name:        parent.GetName(),
+		Namespace:   parent.GetNamespace(),
+		Kind:        parent.GetObjectKind().GroupVersionKind().Kind,
+		Labels:      parent.GetLabels(),
+		Annotations: parent.GetAnnotations(),
+	}
+	if data.Kind == "" {
+		data.Kind = reflect.Indirect(reflect.ValueOf(parent)).Type().Name()
+	}
+	if named, ok := parent.(interface{ GetModelName() string }); ok {
+		data.ModelName = named.GetModelName()
+	}
+
+	rendered := make(map[string]string, len(templates))
+	for key, text := range templates {
+		if isControllerOwnedKey(key) || len(validation.IsQualifiedName(key)) > 0 {
+			continue
+		}
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(text)
+		if err != nil {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			continue
+		}
+		value := buf.String()
+		if len(validation.IsValidLabelValue(value)) > 0 {
+			continue
 		}
+		rendered[key] = value
 	}
+	return rendered
 }
 
 // matchesAnyPattern checks if a label key matches any of the provided patterns.
@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
 const testLabelValueAlpha = "alpha"
@@ -320,6 +321,16 @@ func TestPropagateLabels(t *testing.T) {
 			config:         nil,
 			expectedLabels: nil,
 		},
+		{
+			name: "health label is never propagated",
+			parentLabels: map[string]string{
+				constants.LabelKeyHealth:        constants.LabelValueHealthFailed,
+				constants.LabelKeyTemplateAlias: "fast",
+			},
+			childLabels:    nil,
+			config:         nil,
+			expectedLabels: map[string]string{constants.LabelKeyTemplateAlias: "fast"},
+		},
 		{
 			name:         "disabled propagation does nothing",
 			parentLabels: map[string]string{"team": testLabelValueAlpha},
@@ -404,6 +415,64 @@ func TestPropagateLabels(t *testing.T) {
 			},
 			expectedLabels: map[string]string{"team": testLabelValueAlpha, "org": "mycompany"},
 		},
+		{
+			name:         "exclude wins over match",
+			parentLabels: map[string]string{"team-alpha": "1", "team-secret": "2"},
+			childLabels:  nil,
+			config: &aimv1alpha1.AIMRuntimeConfigCommon{
+				LabelPropagation: &aimv1alpha1.AIMRuntimeConfigLabelPropagationSpec{
+					Enabled: true,
+					Match:   []string{"team-*"},
+					Exclude: []string{"*-secret"},
+				},
+			},
+			expectedLabels: map[string]string{"team-alpha": "1"},
+		},
+		{
+			name:         "managed-by is never propagated by user patterns",
+			parentLabels: map[string]string{"app.kubernetes.io/managed-by": "someone-else", "team": testLabelValueAlpha},
+			childLabels:  nil,
+			config: &aimv1alpha1.AIMRuntimeConfigCommon{
+				LabelPropagation: &aimv1alpha1.AIMRuntimeConfigLabelPropagationSpec{
+					Enabled: true,
+					Match:   []string{"*", "*/*"},
+				},
+			},
+			expectedLabels: map[string]string{"team": testLabelValueAlpha},
+		},
+		{
+			name:         "renders label templates",
+			parentLabels: map[string]string{"team": testLabelValueAlpha},
+			childLabels:  nil,
+			config: &aimv1alpha1.AIMRuntimeConfigCommon{
+				LabelPropagation: &aimv1alpha1.AIMRuntimeConfigLabelPropagationSpec{
+					Enabled: true,
+					Templates: map[string]string{
+						"org.example/parent":  "{{ .Name }}",
+						"org.example/team":    `{{ index .Labels "team" }}`,
+						"org.example/missing": `{{ .Labels.owner }}`,
+						"org.example/invalid": "{{ .Name }} with spaces",
+						"org.example/broken":  "{{ .Name",
+					},
+				},
+			},
+			expectedLabels: map[string]string{"org.example/parent": "parent", "org.example/team": testLabelValueAlpha},
+		},
+		{
+			name:         "label templates cannot set controller-owned keys",
+			parentLabels: nil,
+			childLabels:  nil,
+			config: &aimv1alpha1.AIMRuntimeConfigCommon{
+				LabelPropagation: &aimv1alpha1.AIMRuntimeConfigLabelPropagationSpec{
+					Enabled: true,
+					Templates: map[string]string{
+						"app.kubernetes.io/managed-by":    "{{ .Name }}",
+						"aim.eai.amd.com/aimservice.name": "{{ .Name }}",
+					},
+				},
+			},
+			expectedLabels: nil,
+		},
 		{
 			name:         "empty parent labels does nothing",
 			parentLabels: map[string]string{},
@@ -548,3 +617,115 @@ func TestPropagateLabelsFoResult(t *testing.T) {
 		}
 	}
 }
+
+func TestPropagateLabels_ModelNameTemplate(t *testing.T) {
+	parent := &aimv1alpha1.AIMServiceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "tmpl", Namespace: "ns"},
+		Spec: aimv1alpha1.AIMServiceTemplateSpec{
+			AIMServiceTemplateSpecCommon: aimv1alpha1.AIMServiceTemplateSpecCommon{ModelName: "qwen3-32b"},
+		},
+	}
+	child := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "child"}}
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{
+		LabelPropagation: &aimv1alpha1.AIMRuntimeConfigLabelPropagationSpec{
+			Enabled: true,
+			Templates: map[string]string{
+				"org.example/model":  "{{ .ModelName }}",
+				"org.example/source": "{{ .Kind }}.{{ .Namespace }}",
+			},
+		},
+	}
+
+	PropagateLabels(parent, child, config)
+
+	if got := child.Labels["org.example/model"]; got != "qwen3-32b" {
+		t.Errorf("model label = %q, want qwen3-32b", got)
+	}
+	if got := child.Labels["org.example/source"]; got != "AIMServiceTemplate.ns" {
+		t.Errorf("source label = %q, want AIMServiceTemplate.ns", got)
+	}
+}
+
+func TestPropagateLabels_Annotations(t *testing.T) {
+	parent := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "parent",
+			Annotations: map[string]string{
+				"org.example/owner":                                "team-a",
+				"org.example/secret":                               "s3cr3t",
+				"aim.eai.amd.com/base-template":                    "base",
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+	}
+	child := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "child",
+			Annotations: map[string]string{"org.example/owner": "keep"},
+		},
+	}
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{
+		LabelPropagation: &aimv1alpha1.AIMRuntimeConfigLabelPropagationSpec{
+			Enabled: true,
+			Annotations: &aimv1alpha1.AIMPropagationKeyFilter{
+				Match:   []string{"*/*"},
+				Exclude: []string{"org.example/secret"},
+			},
+		},
+	}
+
+	PropagateLabels(parent, child, config)
+
+	want := map[string]string{"org.example/owner": "keep"}
+	if len(child.Annotations) != len(want) || child.Annotations["org.example/owner"] != "keep" {
+		t.Errorf("annotations = %v, want %v", child.Annotations, want)
+	}
+
+	child.Annotations = nil
+	PropagateLabels(parent, child, config)
+	if child.Annotations["org.example/owner"] != "team-a" || len(child.Annotations) != 1 {
+		t.Errorf("annotations = %v, want only org.example/owner=team-a", child.Annotations)
+	}
+}
+
+func TestApplyMetadataToResult_ControllerLabelsWin(t *testing.T) {
+	parent := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "parent",
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by":    "someone-else",
+				"aim.eai.amd.com/aimservice.name": "spoofed",
+				"team":                            testLabelValueAlpha,
+			},
+		},
+	}
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{
+		LabelPropagation: &aimv1alpha1.AIMRuntimeConfigLabelPropagationSpec{
+			Enabled: true,
+			Match:   []string{"*", "*/*"},
+		},
+	}
+	controllerLabels := map[string]string{
+		"app.kubernetes.io/managed-by":    "aim-service-controller",
+		"aim.eai.amd.com/aimservice.name": "parent",
+	}
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "job"}}
+	planResult := &PlanResult{toApply: []client.Object{job}}
+
+	ApplyMetadataToResult(parent, planResult, config, controllerLabels)
+
+	for key, want := range controllerLabels {
+		if got := job.Labels[key]; got != want {
+			t.Errorf("label %s = %q, want %q", key, got, want)
+		}
+	}
+	if job.Labels["team"] != testLabelValueAlpha {
+		t.Errorf("expected user label to be propagated, got %v", job.Labels)
+	}
+	if _, exists := job.Spec.Template.Labels["app.kubernetes.io/managed-by"]; exists {
+		t.Errorf("expected no managed-by label on the pod template, got %v", job.Spec.Template.Labels)
+	}
+	if got := job.Spec.Template.Labels["aim.eai.amd.com/aimservice.name"]; got != "parent" {
+		t.Errorf("pod template label = %q, want parent", got)
+	}
+}
@@ -0,0 +1,161 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// ConditionTypeReconcileThrottled is True while the circuit breaker holds back reconciles of
+	// the resource after repeated failures. The message carries the time of the next retry.
+	ConditionTypeReconcileThrottled = "ReconcileThrottled"
+
+	// ReasonCircuitOpen is the ReconcileThrottled reason
+	ReasonCircuitOpen = "CircuitOpen"
+)
+
+// CircuitBreaker limits how often a resource that keeps failing is reconciled, so that a
+// crash-retry loop does not exhaust the API server quota. Once a resource failed threshold
+// times within the window, the circuit opens and reconciles are skipped for the backoff.
+// The first reconcile after the backoff runs normally: a success closes the circuit, another
+// failure opens it again. A spec change closes the circuit immediately. Failures are tracked
+// in memory, so they are reset when the operator restarts. A nil breaker never opens.
+type CircuitBreaker struct {
+	threshold int
+	window    time.Duration
+	backoff   time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	objects map[string]*circuitState
+}
+
+type circuitState struct {
+	failures   []time.Time
+	openUntil  time.Time
+	generation int64
+}
+
+// NewCircuitBreaker creates a breaker that opens after threshold failures within the window.
+// Returns nil when threshold is not positive, which disables the breaker.
+func NewCircuitBreaker(threshold int, window, backoff time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	return &CircuitBreaker{
+		threshold: threshold,
+		window:    window,
+		backoff:   backoff,
+		now:       time.Now,
+		objects:   map[string]*circuitState{},
+	}
+}
+
+// OpenUntil returns when the circuit of the resource closes, and false if it is not open.
+// A resource whose generation changed since the circuit opened is let through, so that a fix
+// to the spec is picked up right away.
+func (b *CircuitBreaker) OpenUntil(key string, generation int64) (time.Time, bool) {
+	if b == nil {
+		return time.Time{}, false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state, ok := b.objects[key]
+	if !ok || !b.now().Before(state.openUntil) {
+		return time.Time{}, false
+	}
+	if state.generation != generation {
+		delete(b.objects, key)
+		return time.Time{}, false
+	}
+	return state.openUntil, true
+}
+
+// RecordFailure records a failed reconcile. It returns when the circuit closes again, the number
+// of failures within the window, and true if the failure opened the circuit. Failures older than
+// the window are forgotten. The failure of the first reconcile after the backoff always reopens it.
+func (b *CircuitBreaker) RecordFailure(key string, generation int64) (time.Time, int, bool) {
+	if b == nil {
+		return time.Time{}, 0, false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	state, ok := b.objects[key]
+	if !ok {
+		state = &circuitState{}
+		b.objects[key] = state
+	}
+	state.failures = append(recentFailures(state.failures, now.Add(-b.window)), now)
+	if state.openUntil.IsZero() && len(state.failures) < b.threshold {
+		return time.Time{}, len(state.failures), false
+	}
+	state.openUntil = now.Add(b.backoff)
+	state.generation = generation
+	b.prune(now)
+	return state.openUntil, len(state.failures), true
+}
+
+// RecordSuccess closes the circuit of the resource and forgets its failures.
+func (b *CircuitBreaker) RecordSuccess(key string) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.objects, key)
+}
+
+// Window returns how far back failures are counted.
+func (b *CircuitBreaker) Window() time.Duration {
+	if b == nil {
+		return 0
+	}
+	return b.window
+}
+
+// prune forgets resources whose circuit is closed and whose failures left the window, e.g.
+// resources that were deleted while failing. Called with the lock held.
+func (b *CircuitBreaker) prune(now time.Time) {
+	since := now.Add(-b.window)
+	for key, state := range b.objects {
+		if now.Before(state.openUntil) {
+			continue
+		}
+		if state.failures = recentFailures(state.failures, since); len(state.failures) == 0 {
+			delete(b.objects, key)
+		}
+	}
+}
+
+// recentFailures drops the failures before since. Failures are ordered by time.
+func recentFailures(failures []time.Time, since time.Time) []time.Time {
+	for i, failure := range failures {
+		if failure.After(since) {
+			return failures[i:]
+		}
+	}
+	return failures[:0]
+}
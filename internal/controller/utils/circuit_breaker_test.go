@@ -0,0 +1,178 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestCircuitBreaker(now *time.Time) *CircuitBreaker {
+	b := NewCircuitBreaker(3, time.Minute, 10*time.Minute)
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func TestCircuitBreaker_Nil(t *testing.T) {
+	var b *CircuitBreaker
+	if NewCircuitBreaker(0, time.Minute, time.Minute) != nil {
+		t.Error("expected a zero threshold to disable the breaker")
+	}
+	if _, _, opened := b.RecordFailure("key", 1); opened {
+		t.Error("expected a nil breaker never to open")
+	}
+	if _, open := b.OpenUntil("key", 1); open {
+		t.Error("expected a nil breaker never to be open")
+	}
+	b.RecordSuccess("key")
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newTestCircuitBreaker(&now)
+
+	for i := range 2 {
+		if _, failures, opened := b.RecordFailure("key", 1); opened || failures != i+1 {
+			t.Fatalf("failure %d: expected the circuit to stay closed, got failures=%d opened=%v", i+1, failures, opened)
+		}
+		now = now.Add(10 * time.Second)
+	}
+	until, failures, opened := b.RecordFailure("key", 1)
+	if !opened || failures != 3 || !until.Equal(now.Add(10*time.Minute)) {
+		t.Fatalf("expected the third failure to open the circuit, got until=%v failures=%d opened=%v", until, failures, opened)
+	}
+	if _, open := b.OpenUntil("key", 1); !open {
+		t.Error("expected the circuit to be open")
+	}
+	if _, open := b.OpenUntil("other", 1); open {
+		t.Error("expected other resources not to be affected")
+	}
+
+	// The first reconcile after the backoff runs, and another failure reopens the circuit
+	now = until
+	if _, open := b.OpenUntil("key", 1); open {
+		t.Fatal("expected the circuit to let a retry through after the backoff")
+	}
+	if _, _, opened := b.RecordFailure("key", 1); !opened {
+		t.Error("expected a failed retry to reopen the circuit")
+	}
+
+	b.RecordSuccess("key")
+	if _, open := b.OpenUntil("key", 1); open {
+		t.Error("expected a success to close the circuit")
+	}
+}
+
+func TestCircuitBreaker_ForgetsOldFailures(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newTestCircuitBreaker(&now)
+
+	b.RecordFailure("key", 1)
+	b.RecordFailure("key", 1)
+	now = now.Add(2 * time.Minute)
+	if _, failures, opened := b.RecordFailure("key", 1); opened || failures != 1 {
+		t.Errorf("expected failures outside the window to be forgotten, got failures=%d opened=%v", failures, opened)
+	}
+}
+
+func TestCircuitBreaker_SpecChangeCloses(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newTestCircuitBreaker(&now)
+	for range 3 {
+		b.RecordFailure("key", 1)
+	}
+	if _, open := b.OpenUntil("key", 2); open {
+		t.Error("expected a new generation to close the circuit")
+	}
+	if _, _, opened := b.RecordFailure("key", 2); opened {
+		t.Error("expected failures to be counted afresh after a spec change")
+	}
+}
+
+func TestPipeline_Run_CircuitBreaker(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	scheme.AddKnownTypes(metav1.SchemeGroupVersion, &testObject{})
+	obj := &testObject{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "meta.k8s.io/v1", Kind: "testObject"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-obj", Namespace: "default"},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj).WithStatusSubresource(obj).Build()
+	recorder := record.NewFakeRecorder(100)
+
+	pipeline := &Pipeline[*testObject, *testStatus, testFetch, testObservationWithError]{
+		Client:         cl,
+		StatusClient:   cl.Status(),
+		Recorder:       recorder,
+		ControllerName: "test",
+		Reconciler: &testReconcilerWithError{
+			infraError: NewInfrastructureError("NetworkTimeout", "Network timeout", errors.New("timeout")),
+		},
+		Scheme:         scheme,
+		CircuitBreaker: NewCircuitBreaker(2, time.Minute, 10*time.Minute),
+	}
+
+	if _, err := pipeline.Run(context.Background(), obj); err == nil {
+		t.Fatal("expected the first failure to be returned")
+	}
+	result, err := pipeline.Run(context.Background(), obj)
+	if err != nil {
+		t.Fatalf("expected an open circuit to replace the error, got %v", err)
+	}
+	if result.RequeueAfter <= 9*time.Minute {
+		t.Errorf("expected a requeue after the backoff, got %v", result.RequeueAfter)
+	}
+	cond := findCondition(obj.Status.Conditions, ConditionTypeReconcileThrottled)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != ReasonCircuitOpen ||
+		!strings.Contains(cond.Message, "Next retry at") {
+		t.Fatalf("expected ReconcileThrottled=True with the retry time, got %+v", cond)
+	}
+
+	// Reconciles while the circuit is open are skipped without touching status
+	conditions := len(obj.Status.Conditions)
+	result, err = pipeline.Run(context.Background(), obj)
+	if err != nil || result.RequeueAfter <= 9*time.Minute {
+		t.Errorf("expected the reconcile to be skipped, got result=%+v err=%v", result, err)
+	}
+	if len(obj.Status.Conditions) != conditions {
+		t.Error("expected status to be unchanged while the circuit is open")
+	}
+
+	throttledEvents := 0
+	for len(recorder.Events) > 0 {
+		if strings.Contains(<-recorder.Events, ReasonCircuitOpen) {
+			throttledEvents++
+		}
+	}
+	if throttledEvents != 1 {
+		t.Errorf("expected one summarizing event, got %d", throttledEvents)
+	}
+}
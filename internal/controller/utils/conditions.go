@@ -27,6 +27,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
@@ -41,6 +42,8 @@ type ConfiguredCondition struct {
 type ConditionManager struct {
 	now        func() time.Time
 	conditions []ConfiguredCondition
+	// initial holds the conditions the manager was created with, for transition tracking
+	initial []metav1.Condition
 }
 
 func NewConditionManager(existing []metav1.Condition) *ConditionManager {
@@ -51,6 +54,7 @@ func NewConditionManager(existing []metav1.Condition) *ConditionManager {
 	return &ConditionManager{
 		now:        time.Now,
 		conditions: conditions,
+		initial:    append([]metav1.Condition(nil), existing...),
 	}
 }
 
@@ -186,6 +190,39 @@ func (m *ConditionManager) ConfigFor(condType string) ObservabilityConfig {
 	return m.conditions[idx].Config
 }
 
+// AppendTransition appends the transition of the given condition since the manager was created
+// to history, keeping at most limit entries (oldest are dropped). The history is returned unchanged
+// if the condition's status and reason did not change or the condition was removed.
+func (m *ConditionManager) AppendTransition(
+	history []aimv1alpha1.AIMConditionTransition,
+	condType string,
+	limit int,
+) []aimv1alpha1.AIMConditionTransition {
+	current := m.Get(condType)
+	if current == nil {
+		return history
+	}
+	for i := range m.initial {
+		if m.initial[i].Type == condType {
+			if m.initial[i].Status == current.Status && m.initial[i].Reason == current.Reason {
+				return history
+			}
+			break
+		}
+	}
+
+	history = append(history, aimv1alpha1.AIMConditionTransition{
+		Status:  current.Status,
+		Reason:  current.Reason,
+		Message: current.Message,
+		Time:    current.LastTransitionTime,
+	})
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+	return history
+}
+
 func indexOfCondition(conditions []ConfiguredCondition, condType string) int {
 	for i := range conditions {
 		if conditions[i].Type == condType {
@@ -27,6 +27,8 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
 )
 
 func TestNewConditionManager(t *testing.T) {
@@ -567,3 +569,49 @@ func TestConditionManager_Set(t *testing.T) {
 		t.Errorf("expected eventLevel Warning, got %v", cfg.eventLevel)
 	}
 }
+
+func TestConditionManager_AppendTransition(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	existing := []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Progressing", LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+	}
+
+	// Message-only change is not a transition
+	cm := NewConditionManager(existing)
+	cm.now = func() time.Time { return now }
+	cm.MarkFalse("Ready", "Progressing", "still waiting")
+	if history := cm.AppendTransition(nil, "Ready", 3); len(history) != 0 {
+		t.Errorf("expected no transition, got %v", history)
+	}
+
+	// Status change is recorded with its transition time
+	cm.MarkTrue("Ready", "AllReady", "all components ready")
+	history := cm.AppendTransition(nil, "Ready", 3)
+	if len(history) != 1 {
+		t.Fatalf("expected 1 transition, got %d", len(history))
+	}
+	if history[0].Status != metav1.ConditionTrue || history[0].Reason != "AllReady" ||
+		history[0].Message != "all components ready" || !history[0].Time.Time.Equal(now) {
+		t.Errorf("unexpected transition %+v", history[0])
+	}
+
+	// A newly added condition counts as a transition
+	cm = NewConditionManager(nil)
+	cm.MarkFalse("Ready", "Starting", "")
+	if history := cm.AppendTransition(nil, "Ready", 3); len(history) != 1 {
+		t.Errorf("expected transition for new condition, got %v", history)
+	}
+
+	// History is bounded, dropping the oldest entries
+	full := []aimv1alpha1.AIMConditionTransition{{Reason: "a"}, {Reason: "b"}, {Reason: "c"}}
+	history = cm.AppendTransition(full, "Ready", 3)
+	if len(history) != 3 || history[0].Reason != "b" || history[2].Reason != "Starting" {
+		t.Errorf("expected bounded history [b c Starting], got %+v", history)
+	}
+
+	// Removed conditions leave the history unchanged
+	cm.Delete("Ready")
+	if history := cm.AppendTransition(full, "Ready", 3); len(history) != 3 || history[2].Reason != "c" {
+		t.Errorf("expected history unchanged, got %+v", history)
+	}
+}
@@ -38,15 +38,22 @@ const (
 	DefaultRuntimeConfigName = "default"
 )
 
-// FetchMergedRuntimeConfig fetches and merges namespace and cluster-scoped runtime configs.
-// Returns a FetchResult containing the merged config.
+// FetchMergedRuntimeConfig fetches and merges namespace and cluster-scoped runtime configs,
+// then merges the optional inline override on top. Returns a FetchResult containing the merged
+// config and the sources that contributed to it, lowest precedence first.
 //
 // Behavior:
 //   - If both namespace and cluster configs exist, they are merged (namespace takes precedence)
 //   - If only one exists, it is returned
 //   - If neither exists and name is "default", returns nil config with no error (OK)
 //   - If neither exists and name is not "default", returns NotFound error
-func FetchMergedRuntimeConfig(ctx context.Context, c client.Client, name, namespace string) FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon] {
+//   - A non-nil override is merged last and takes precedence over both configs
+func FetchMergedRuntimeConfig(
+	ctx context.Context,
+	c client.Client,
+	name, namespace string,
+	override *aimv1alpha1.AIMRuntimeConfigCommon,
+) (FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon], []aimv1alpha1.AIMResolvedReference) {
 	if name == "" {
 		name = DefaultRuntimeConfigName
 	}
@@ -56,7 +63,7 @@ func FetchMergedRuntimeConfig(ctx context.Context, c client.Client, name, namesp
 	if namespace != "" {
 		nsResult := Fetch(ctx, c, client.ObjectKey{Name: name, Namespace: namespace}, &aimv1alpha1.AIMRuntimeConfig{})
 		if nsResult.HasError() && !nsResult.IsNotFound() {
-			return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Error: nsResult.Error}
+			return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Error: nsResult.Error}, nil
 		}
 		if nsResult.OK() {
 			nsConfig = nsResult.Value
@@ -67,50 +74,69 @@ func FetchMergedRuntimeConfig(ctx context.Context, c client.Client, name, namesp
 	var clusterConfig *aimv1alpha1.AIMClusterRuntimeConfig
 	clusterResult := Fetch(ctx, c, client.ObjectKey{Name: name}, &aimv1alpha1.AIMClusterRuntimeConfig{})
 	if clusterResult.HasError() && !clusterResult.IsNotFound() {
-		return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Error: clusterResult.Error}
+		return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Error: clusterResult.Error}, nil
 	}
 	if clusterResult.OK() {
 		clusterConfig = clusterResult.Value
 	}
 
 	// Both not found
-	if nsConfig == nil && clusterConfig == nil {
-		if name != DefaultRuntimeConfigName {
-			// Non-default config not found - this is a user configuration error
-			// (they referenced a config that doesn't exist), not a transient dependency
-			return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
-				Error: NewMissingUpstreamDependencyError(
-					"ConfigNotFound",
-					"RuntimeConfig "+name+" not found",
-					apierrors.NewNotFound(
-						schema.GroupResource{
-							Group:    aimv1alpha1.GroupVersion.Group,
-							Resource: "aimruntimeconfigs",
-						},
-						name,
-					),
+	if nsConfig == nil && clusterConfig == nil && name != DefaultRuntimeConfigName {
+		// Non-default config not found - this is a user configuration error
+		// (they referenced a config that doesn't exist), not a transient dependency
+		return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{
+			Error: NewMissingUpstreamDependencyError(
+				"ConfigNotFound",
+				"RuntimeConfig "+name+" not found",
+				apierrors.NewNotFound(
+					schema.GroupResource{
+						Group:    aimv1alpha1.GroupVersion.Group,
+						Resource: "aimruntimeconfigs",
+					},
+					name,
 				),
-			}
-		}
-		// Default config not found is OK - return nil config
-		return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{}
+			),
+		}, nil
 	}
 
 	// Extract and migrate configs
+	var sources []aimv1alpha1.AIMResolvedReference
 	var clusterCommon, nsCommon *aimv1alpha1.AIMRuntimeConfigCommon
 	if clusterConfig != nil {
 		clusterCommon = &clusterConfig.Spec.AIMRuntimeConfigCommon
 		migrateDeprecatedStorageFields(clusterCommon)
+		dropNamespaceOnlyFields(clusterCommon)
+		sources = append(sources, aimv1alpha1.AIMResolvedReference{
+			Name:  clusterConfig.Name,
+			Scope: aimv1alpha1.AIMResolutionScopeCluster,
+			Kind:  "AIMClusterRuntimeConfig",
+			UID:   clusterConfig.UID,
+		})
 	}
 	if nsConfig != nil {
 		nsCommon = &nsConfig.Spec.AIMRuntimeConfigCommon
 		migrateDeprecatedStorageFields(nsCommon)
+		dropClusterOnlyFields(nsCommon)
+		sources = append(sources, aimv1alpha1.AIMResolvedReference{
+			Name:      nsConfig.Name,
+			Namespace: nsConfig.Namespace,
+			Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
+			Kind:      "AIMRuntimeConfig",
+			UID:       nsConfig.UID,
+		})
 	}
 
-	// Merge configs (namespace takes precedence over cluster)
+	// Merge configs (namespace takes precedence over cluster, the override over both)
 	merged := MergeRuntimeConfigs(nsCommon, clusterCommon)
+	if override != nil {
+		merged = MergeRuntimeConfigs(override, merged)
+		sources = append(sources, aimv1alpha1.AIMResolvedReference{
+			Name:  "inline",
+			Scope: aimv1alpha1.AIMResolutionScopeInline,
+		})
+	}
 
-	return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Value: merged}
+	return FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]{Value: merged}, sources
 }
 
 // migrateDeprecatedStorageFields migrates deprecated top-level storage fields to the new Storage struct.
@@ -137,6 +163,22 @@ func migrateDeprecatedStorageFields(config *aimv1alpha1.AIMRuntimeConfigCommon)
 	}
 }
 
+// dropClusterOnlyFields clears the fields that are only honored on the AIMClusterRuntimeConfig.
+// The selection policy plugin runs an executable or calls an endpoint from the operator,
+// which namespace users must not be able to configure.
+func dropClusterOnlyFields(config *aimv1alpha1.AIMRuntimeConfigCommon) {
+	if config.TemplateSelection != nil {
+		config.TemplateSelection.SelectionPolicyPlugin = nil
+	}
+}
+
+// dropNamespaceOnlyFields clears the fields that are only honored on the namespace-scoped AIMRuntimeConfig.
+// GPU sharing degrades the performance of every service in a namespace, so each namespace must opt in
+// itself rather than inherit it from the cluster.
+func dropNamespaceOnlyFields(config *aimv1alpha1.AIMRuntimeConfigCommon) {
+	config.GPUSharing = nil
+}
+
 // MergeRuntimeConfigs merges two AIMRuntimeConfigCommon structs, with the priority config
 // taking precedence over the base config. Uses key-based merging for env vars.
 //
@@ -170,3 +212,9 @@ func MergeRuntimeConfigs(priority, base *aimv1alpha1.AIMRuntimeConfigCommon) *ai
 type RuntimeConfigRefProvider interface {
 	GetRuntimeConfigRef() aimv1alpha1.RuntimeConfigRef
 }
+
+// RuntimeConfigOverrideProvider is implemented by resources that can carry an inline
+// runtime config override, merged on top of the referenced runtime configs.
+type RuntimeConfigOverrideProvider interface {
+	GetRuntimeConfigOverride() *aimv1alpha1.AIMRuntimeConfigCommon
+}
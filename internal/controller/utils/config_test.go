@@ -0,0 +1,220 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+func newRuntimeConfigTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := aimv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestFetchMergedRuntimeConfig_OverrideMergedLast(t *testing.T) {
+	clusterConfig := &aimv1alpha1.AIMClusterRuntimeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultRuntimeConfigName},
+		Spec: aimv1alpha1.AIMClusterRuntimeConfigSpec{
+			AIMRuntimeConfigCommon: aimv1alpha1.AIMRuntimeConfigCommon{
+				AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+					Env: []corev1.EnvVar{{Name: "A", Value: "cluster"}, {Name: "B", Value: "cluster"}},
+				},
+			},
+		},
+	}
+	nsConfig := &aimv1alpha1.AIMRuntimeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultRuntimeConfigName, Namespace: "test-ns"},
+		Spec: aimv1alpha1.AIMRuntimeConfigSpec{
+			AIMRuntimeConfigCommon: aimv1alpha1.AIMRuntimeConfigCommon{
+				AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+					Env: []corev1.EnvVar{{Name: "A", Value: "namespace"}},
+				},
+			},
+		},
+	}
+	override := &aimv1alpha1.AIMRuntimeConfigCommon{
+		AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+			Env: []corev1.EnvVar{{Name: "B", Value: "inline"}},
+		},
+	}
+
+	c := newRuntimeConfigTestClient(t, clusterConfig, nsConfig)
+	result, sources := FetchMergedRuntimeConfig(context.Background(), c, "", "test-ns", override)
+	if !result.OK() || result.Value == nil {
+		t.Fatalf("expected merged config, got error %v", result.Error)
+	}
+
+	env := map[string]string{}
+	for _, e := range result.Value.Env {
+		env[e.Name] = e.Value
+	}
+	if env["A"] != "namespace" {
+		t.Errorf("expected A from namespace config, got %q", env["A"])
+	}
+	if env["B"] != "inline" {
+		t.Errorf("expected B from inline override, got %q", env["B"])
+	}
+
+	wantScopes := []aimv1alpha1.AIMResolutionScope{
+		aimv1alpha1.AIMResolutionScopeCluster,
+		aimv1alpha1.AIMResolutionScopeNamespace,
+		aimv1alpha1.AIMResolutionScopeInline,
+	}
+	if len(sources) != len(wantScopes) {
+		t.Fatalf("expected %d sources, got %+v", len(wantScopes), sources)
+	}
+	for i, scope := range wantScopes {
+		if sources[i].Scope != scope {
+			t.Errorf("source %d: expected scope %s, got %s", i, scope, sources[i].Scope)
+		}
+	}
+	if sources[2].Name != "inline" {
+		t.Errorf("expected inline source name, got %q", sources[2].Name)
+	}
+}
+
+func TestFetchMergedRuntimeConfig_OverrideWithoutConfigs(t *testing.T) {
+	override := &aimv1alpha1.AIMRuntimeConfigCommon{
+		AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+			Env: []corev1.EnvVar{{Name: "A", Value: "inline"}},
+		},
+	}
+
+	c := newRuntimeConfigTestClient(t)
+	result, sources := FetchMergedRuntimeConfig(context.Background(), c, "", "test-ns", override)
+	if !result.OK() || result.Value == nil {
+		t.Fatalf("expected override as config, got error %v", result.Error)
+	}
+	if len(result.Value.Env) != 1 || result.Value.Env[0].Value != "inline" {
+		t.Errorf("expected override env, got %+v", result.Value.Env)
+	}
+	if len(sources) != 1 || sources[0].Scope != aimv1alpha1.AIMResolutionScopeInline {
+		t.Errorf("expected only the inline source, got %+v", sources)
+	}
+}
+
+func TestFetchMergedRuntimeConfig_MissingNamedConfigIgnoresOverride(t *testing.T) {
+	override := &aimv1alpha1.AIMRuntimeConfigCommon{}
+
+	c := newRuntimeConfigTestClient(t)
+	result, sources := FetchMergedRuntimeConfig(context.Background(), c, "missing", "test-ns", override)
+	if result.OK() {
+		t.Fatal("expected error for missing named runtime config")
+	}
+	if sources != nil {
+		t.Errorf("expected no sources on error, got %+v", sources)
+	}
+}
+
+func TestFetchMergedRuntimeConfig_SelectionPolicyPluginClusterOnly(t *testing.T) {
+	clusterConfig := &aimv1alpha1.AIMClusterRuntimeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultRuntimeConfigName},
+		Spec: aimv1alpha1.AIMClusterRuntimeConfigSpec{
+			AIMRuntimeConfigCommon: aimv1alpha1.AIMRuntimeConfigCommon{
+				TemplateSelection: &aimv1alpha1.AIMTemplateSelectionConfig{
+					SelectionPolicyPlugin: &aimv1alpha1.AIMSelectionPolicyPlugin{URL: "http://policy.aim-system"},
+				},
+			},
+		},
+	}
+	nsConfig := &aimv1alpha1.AIMRuntimeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultRuntimeConfigName, Namespace: "test-ns"},
+		Spec: aimv1alpha1.AIMRuntimeConfigSpec{
+			AIMRuntimeConfigCommon: aimv1alpha1.AIMRuntimeConfigCommon{
+				TemplateSelection: &aimv1alpha1.AIMTemplateSelectionConfig{
+					SelectionPolicyPlugin: &aimv1alpha1.AIMSelectionPolicyPlugin{Exec: "/bin/sh"},
+				},
+			},
+		},
+	}
+
+	// The namespace plugin is dropped, so the cluster plugin applies
+	c := newRuntimeConfigTestClient(t, clusterConfig, nsConfig)
+	result, _ := FetchMergedRuntimeConfig(context.Background(), c, "", "test-ns", nil)
+	if !result.OK() || result.Value == nil || result.Value.TemplateSelection == nil {
+		t.Fatalf("expected merged templateSelection, got error %v", result.Error)
+	}
+	plugin := result.Value.TemplateSelection.SelectionPolicyPlugin
+	if plugin == nil || plugin.URL != "http://policy.aim-system" || plugin.Exec != "" {
+		t.Errorf("expected the cluster plugin, got %+v", plugin)
+	}
+
+	// Without a cluster config, the namespace plugin is not honored
+	c = newRuntimeConfigTestClient(t, nsConfig)
+	result, _ = FetchMergedRuntimeConfig(context.Background(), c, "", "test-ns", nil)
+	if !result.OK() || result.Value == nil {
+		t.Fatalf("expected namespace config, got error %v", result.Error)
+	}
+	if result.Value.TemplateSelection != nil && result.Value.TemplateSelection.SelectionPolicyPlugin != nil {
+		t.Errorf("expected the namespace plugin to be dropped, got %+v", result.Value.TemplateSelection.SelectionPolicyPlugin)
+	}
+}
+
+func TestFetchMergedRuntimeConfig_GPUSharingNamespaceOnly(t *testing.T) {
+	clusterConfig := &aimv1alpha1.AIMClusterRuntimeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultRuntimeConfigName},
+		Spec: aimv1alpha1.AIMClusterRuntimeConfigSpec{
+			AIMRuntimeConfigCommon: aimv1alpha1.AIMRuntimeConfigCommon{
+				GPUSharing: &aimv1alpha1.AIMGPUSharingConfig{Enabled: true},
+			},
+		},
+	}
+
+	// The cluster config cannot turn on sharing for a namespace
+	c := newRuntimeConfigTestClient(t, clusterConfig)
+	result, _ := FetchMergedRuntimeConfig(context.Background(), c, "", "test-ns", nil)
+	if !result.OK() || result.Value == nil {
+		t.Fatalf("expected cluster config, got error %v", result.Error)
+	}
+	if result.Value.GPUSharing != nil {
+		t.Errorf("expected cluster gpuSharing to be dropped, got %+v", result.Value.GPUSharing)
+	}
+
+	// A namespace config opts its namespace in
+	nsConfig := &aimv1alpha1.AIMRuntimeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultRuntimeConfigName, Namespace: "test-ns"},
+		Spec: aimv1alpha1.AIMRuntimeConfigSpec{
+			AIMRuntimeConfigCommon: aimv1alpha1.AIMRuntimeConfigCommon{
+				GPUSharing: &aimv1alpha1.AIMGPUSharingConfig{Enabled: true},
+			},
+		},
+	}
+	c = newRuntimeConfigTestClient(t, clusterConfig, nsConfig)
+	result, _ = FetchMergedRuntimeConfig(context.Background(), c, "", "test-ns", nil)
+	if !result.OK() || result.Value == nil || result.Value.GPUSharing == nil || !result.Value.GPUSharing.Enabled {
+		t.Errorf("expected namespace gpuSharing to apply, got %+v", result.Value)
+	}
+}
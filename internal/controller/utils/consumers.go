@@ -0,0 +1,105 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// FetchClusterScopedConsumers lists the AIMServices whose resolved reference (selected by the
+// given field index key) names a cluster-scoped resource. The index matches by name only,
+// so services that resolved a namespace-scoped resource with the same name are filtered out.
+func FetchClusterScopedConsumers(
+	ctx context.Context,
+	c client.Client,
+	indexKey string,
+	name string,
+	resolvedRef func(*aimv1alpha1.AIMService) *aimv1alpha1.AIMResolvedReference,
+) FetchResult[*aimv1alpha1.AIMServiceList] {
+	result := FetchList(ctx, c, &aimv1alpha1.AIMServiceList{}, client.MatchingFields{indexKey: name})
+	if !result.OK() {
+		return result
+	}
+
+	filtered := result.Value.Items[:0]
+	for i := range result.Value.Items {
+		ref := resolvedRef(&result.Value.Items[i])
+		if ref != nil && ref.Name == name && ref.Scope == aimv1alpha1.AIMResolutionScopeCluster {
+			filtered = append(filtered, result.Value.Items[i])
+		}
+	}
+	result.Value.Items = filtered
+	return result
+}
+
+// BuildConsumersStatus aggregates services into a consumers summary with a bounded
+// namespace list and sample.
+func BuildConsumersStatus(services []aimv1alpha1.AIMService) *aimv1alpha1.AIMConsumersStatus {
+	refs := make([]aimv1alpha1.AIMConsumerReference, 0, len(services))
+	namespaceSet := make(map[string]struct{})
+	for i := range services {
+		refs = append(refs, aimv1alpha1.AIMConsumerReference{
+			Kind:      "AIMService",
+			Namespace: services[i].Namespace,
+			Name:      services[i].Name,
+		})
+		namespaceSet[services[i].Namespace] = struct{}{}
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Namespace != refs[j].Namespace {
+			return refs[i].Namespace < refs[j].Namespace
+		}
+		return refs[i].Name < refs[j].Name
+	})
+
+	namespaces := make([]string, 0, len(namespaceSet))
+	for ns := range namespaceSet {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	status := &aimv1alpha1.AIMConsumersStatus{Count: int32(len(refs))}
+	if len(namespaces) > 0 {
+		status.Namespaces = namespaces[:min(len(namespaces), aimv1alpha1.MaxConsumerNamespaces)]
+	}
+	if len(refs) > 0 {
+		status.Sample = refs[:min(len(refs), aimv1alpha1.MaxConsumerSampleSize)]
+	}
+	return status
+}
+
+// ResolvedTemplateRef returns the service's resolved template reference.
+func ResolvedTemplateRef(service *aimv1alpha1.AIMService) *aimv1alpha1.AIMResolvedReference {
+	return service.Status.ResolvedTemplate
+}
+
+// ResolvedModelRef returns the service's resolved model reference.
+func ResolvedModelRef(service *aimv1alpha1.AIMService) *aimv1alpha1.AIMResolvedReference {
+	return service.Status.ResolvedModel
+}
@@ -0,0 +1,109 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+func consumerService(namespace, name, template string, scope aimv1alpha1.AIMResolutionScope) *aimv1alpha1.AIMService {
+	return &aimv1alpha1.AIMService{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Status: aimv1alpha1.AIMServiceStatus{
+			ResolvedTemplate: &aimv1alpha1.AIMResolvedReference{Name: template, Scope: scope},
+		},
+	}
+}
+
+func TestFetchClusterScopedConsumers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = aimv1alpha1.AddToScheme(scheme)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			consumerService("team-a", "svc1", "shared", aimv1alpha1.AIMResolutionScopeCluster),
+			consumerService("team-b", "svc2", "shared", aimv1alpha1.AIMResolutionScopeCluster),
+			// Same name, but a namespace-scoped template: not a consumer of the cluster template
+			consumerService("team-c", "svc3", "shared", aimv1alpha1.AIMResolutionScopeNamespace),
+			consumerService("team-a", "svc4", "other", aimv1alpha1.AIMResolutionScopeCluster),
+		).
+		WithIndex(&aimv1alpha1.AIMService{}, aimv1alpha1.AIMServiceResolvedTemplateIndexKey, func(obj client.Object) []string {
+			ref := obj.(*aimv1alpha1.AIMService).Status.ResolvedTemplate
+			if ref == nil {
+				return nil
+			}
+			return []string{ref.Name}
+		}).
+		Build()
+
+	result := FetchClusterScopedConsumers(context.Background(), c,
+		aimv1alpha1.AIMServiceResolvedTemplateIndexKey, "shared", ResolvedTemplateRef)
+	if !result.OK() {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.Value.Items) != 2 {
+		t.Fatalf("expected 2 consumers, got %d", len(result.Value.Items))
+	}
+	for _, svc := range result.Value.Items {
+		if svc.Namespace == "team-c" {
+			t.Errorf("namespace-scoped resolution should be filtered out: %s/%s", svc.Namespace, svc.Name)
+		}
+	}
+}
+
+func TestBuildConsumersStatus(t *testing.T) {
+	empty := BuildConsumersStatus(nil)
+	if empty.Count != 0 || empty.Namespaces != nil || empty.Sample != nil {
+		t.Errorf("unexpected empty status: %+v", empty)
+	}
+
+	var services []aimv1alpha1.AIMService
+	for i := 0; i < 15; i++ {
+		services = append(services, *consumerService(fmt.Sprintf("ns-%d", i%3), fmt.Sprintf("svc-%02d", 14-i), "t", aimv1alpha1.AIMResolutionScopeCluster))
+	}
+
+	status := BuildConsumersStatus(services)
+	if status.Count != 15 {
+		t.Errorf("count = %d, want 15", status.Count)
+	}
+	if len(status.Namespaces) != 3 || status.Namespaces[0] != "ns-0" || status.Namespaces[2] != "ns-2" {
+		t.Errorf("unexpected namespaces: %v", status.Namespaces)
+	}
+	if len(status.Sample) != aimv1alpha1.MaxConsumerSampleSize {
+		t.Fatalf("sample size = %d, want %d", len(status.Sample), aimv1alpha1.MaxConsumerSampleSize)
+	}
+	first := status.Sample[0]
+	if first.Kind != "AIMService" || first.Namespace != "ns-0" || first.Name != "svc-02" {
+		t.Errorf("unexpected first sample entry: %+v", first)
+	}
+}
@@ -0,0 +1,239 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CRDSchemaCheckMode controls what happens when the installed CRDs do not match the compiled API types.
+type CRDSchemaCheckMode string
+
+const (
+	// CRDSchemaCheckEnforce refuses to start the operator when fields are missing.
+	CRDSchemaCheckEnforce CRDSchemaCheckMode = "enforce"
+
+	// CRDSchemaCheckWarn logs missing fields and starts anyway.
+	CRDSchemaCheckWarn CRDSchemaCheckMode = "warn"
+
+	// CRDSchemaCheckOff skips the check.
+	CRDSchemaCheckOff CRDSchemaCheckMode = "off"
+)
+
+// CRDSchemaMissingFields reports the number of compiled API fields each installed CRD does not declare.
+// The API server prunes undeclared fields, so a non-zero value means writes would silently drop data.
+var CRDSchemaMissingFields = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "aim_crd_schema_missing_fields",
+	Help: "Number of fields of the operator's API types that the installed CRD schema does not declare.",
+}, []string{"crd"})
+
+// CRDSchemaGap describes a mismatch between an installed CRD and the compiled API types.
+type CRDSchemaGap struct {
+	// CRD is the name of the CustomResourceDefinition.
+	CRD string
+
+	// Path is the JSON path of the missing field, or empty when the CRD or version itself is missing.
+	Path string
+
+	// Reason explains the mismatch.
+	Reason string
+}
+
+func (g CRDSchemaGap) String() string {
+	if g.Path == "" {
+		return fmt.Sprintf("%s: %s", g.CRD, g.Reason)
+	}
+	return fmt.Sprintf("%s: %s %s", g.CRD, g.Path, g.Reason)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
+
+// CheckCRDSchemas compares the installed CRDs of a group version against the Go types registered
+// for it in the scheme. It returns a gap for every CRD that is missing or does not serve the version,
+// and for every spec or status field the CRD schema does not declare. Fields below a schema that
+// preserves unknown fields are not checked. Kinds for which skip returns true are not checked.
+// The reader must be able to read CustomResourceDefinitions.
+func CheckCRDSchemas(
+	ctx context.Context,
+	reader client.Reader,
+	scheme *runtime.Scheme,
+	gv schema.GroupVersion,
+	skip func(kind string) bool,
+) ([]CRDSchemaGap, error) {
+	kinds := make([]string, 0)
+	for kind := range scheme.KnownTypes(gv) {
+		if strings.HasSuffix(kind, "List") || !strings.HasPrefix(kind, "AIM") || (skip != nil && skip(kind)) {
+			continue
+		}
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	var gaps []CRDSchemaGap
+	for _, kind := range kinds {
+		// Kubebuilder names CRDs after the lowercase plural of the kind
+		crdName := strings.ToLower(kind) + "s." + gv.Group
+		var crd apiextensionsv1.CustomResourceDefinition
+		if err := reader.Get(ctx, client.ObjectKey{Name: crdName}, &crd); err != nil {
+			if apierrors.IsNotFound(err) {
+				gaps = append(gaps, CRDSchemaGap{CRD: crdName, Reason: "is not installed"})
+				continue
+			}
+			return nil, fmt.Errorf("failed to get CRD %s: %w", crdName, err)
+		}
+		gaps = append(gaps, crdSchemaGaps(&crd, gv.Version, scheme.KnownTypes(gv)[kind])...)
+	}
+	return gaps, nil
+}
+
+// crdSchemaGaps checks one CRD version against the Go type of its kind.
+func crdSchemaGaps(crd *apiextensionsv1.CustomResourceDefinition, version string, t reflect.Type) []CRDSchemaGap {
+	var crdVersion *apiextensionsv1.CustomResourceDefinitionVersion
+	for i := range crd.Spec.Versions {
+		if crd.Spec.Versions[i].Name == version {
+			crdVersion = &crd.Spec.Versions[i]
+		}
+	}
+	if crdVersion == nil || !crdVersion.Served {
+		return []CRDSchemaGap{{CRD: crd.Name, Reason: fmt.Sprintf("does not serve version %s", version)}}
+	}
+	if crdVersion.Schema == nil || crdVersion.Schema.OpenAPIV3Schema == nil {
+		return []CRDSchemaGap{{CRD: crd.Name, Reason: "has no schema"}}
+	}
+
+	var gaps []CRDSchemaGap
+	root := crdVersion.Schema.OpenAPIV3Schema
+	for _, top := range []string{"spec", "status"} {
+		field, ok := jsonField(t, top)
+		if !ok {
+			continue
+		}
+		for _, path := range missingSchemaFields(field.Type, root.Properties[top], top, t.PkgPath(), nil) {
+			gaps = append(gaps, CRDSchemaGap{CRD: crd.Name, Path: path, Reason: "is not declared"})
+		}
+	}
+	return gaps
+}
+
+// jsonField returns the struct field serialized under the given JSON name.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == name {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// jsonName returns the JSON name of a struct field, "" for inlined fields and "-" for skipped ones.
+func jsonName(f reflect.StructField) string {
+	tag, ok := f.Tag.Lookup("json")
+	if !ok {
+		if f.Anonymous {
+			return ""
+		}
+		return f.Name
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if strings.Contains(tag, ",inline") {
+		return ""
+	}
+	return name
+}
+
+// missingSchemaFields returns the JSON paths of fields of t that the schema does not declare.
+// Only types from the API package (pkgPath) are descended into; other types, such as core
+// Kubernetes types, are generated from their own definitions and only checked for presence.
+func missingSchemaFields(
+	t reflect.Type,
+	s apiextensionsv1.JSONSchemaProps,
+	path, pkgPath string,
+	visiting []reflect.Type,
+) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if s.XPreserveUnknownFields != nil && *s.XPreserveUnknownFields {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 || s.Items == nil || s.Items.Schema == nil {
+			return nil
+		}
+		return missingSchemaFields(t.Elem(), *s.Items.Schema, path+"[]", pkgPath, visiting)
+	case reflect.Map:
+		if s.AdditionalProperties == nil || s.AdditionalProperties.Schema == nil {
+			return nil
+		}
+		return missingSchemaFields(t.Elem(), *s.AdditionalProperties.Schema, path+"{}", pkgPath, visiting)
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	if t.PkgPath() != pkgPath {
+		return nil
+	}
+	for _, v := range visiting {
+		if v == t {
+			return nil
+		}
+	}
+	visiting = append(visiting, t)
+
+	var missing []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := jsonName(f)
+		switch name {
+		case "-":
+			continue
+		case "":
+			missing = append(missing, missingSchemaFields(f.Type, s, path, pkgPath, visiting)...)
+			continue
+		}
+		fieldSchema, ok := s.Properties[name]
+		if !ok {
+			missing = append(missing, path+"."+name)
+			continue
+		}
+		missing = append(missing, missingSchemaFields(f.Type, fieldSchema, path+"."+name, pkgPath, visiting)...)
+	}
+	return missing
+}
@@ -0,0 +1,153 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// loadGeneratedCRDs reads the CRDs generated from the API types.
+func loadGeneratedCRDs(t *testing.T) []*apiextensionsv1.CustomResourceDefinition {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "config", "crd", "bases", "*.yaml"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to find generated CRDs: %v", err)
+	}
+	crds := make([]*apiextensionsv1.CustomResourceDefinition, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(data, crd); err != nil {
+			t.Fatalf("failed to parse %s: %v", file, err)
+		}
+		crds = append(crds, crd)
+	}
+	return crds
+}
+
+func crdTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = aimv1alpha1.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	return scheme
+}
+
+func TestCheckCRDSchemas_GeneratedCRDsMatch(t *testing.T) {
+	scheme := crdTestScheme()
+	objs := make([]client.Object, 0)
+	for _, crd := range loadGeneratedCRDs(t) {
+		objs = append(objs, crd)
+	}
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	gaps, err := CheckCRDSchemas(context.Background(), reader, scheme, aimv1alpha1.GroupVersion, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, gap := range gaps {
+		t.Errorf("unexpected gap (regenerate the CRDs?): %s", gap)
+	}
+}
+
+func TestCheckCRDSchemas_ReportsGaps(t *testing.T) {
+	scheme := crdTestScheme()
+	var objs []client.Object
+	for _, crd := range loadGeneratedCRDs(t) {
+		switch crd.Name {
+		case "aimservices.aim.eai.amd.com":
+			// Simulate a CRD from an older release that lacks a newer field
+			spec := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"]
+			delete(spec.Properties, "replicas")
+			crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"] = spec
+		case "aimmodels.aim.eai.amd.com":
+			// Leave out a CRD entirely
+			continue
+		}
+		objs = append(objs, crd)
+	}
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	gaps, err := CheckCRDSchemas(context.Background(), reader, scheme, aimv1alpha1.GroupVersion, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]bool{
+		"aimservices.aim.eai.amd.com: spec.replicas is not declared": false,
+		"aimmodels.aim.eai.amd.com: is not installed":                false,
+	}
+	for _, gap := range gaps {
+		if _, ok := want[gap.String()]; !ok {
+			t.Errorf("unexpected gap: %s", gap)
+			continue
+		}
+		want[gap.String()] = true
+	}
+	for gap, found := range want {
+		if !found {
+			t.Errorf("expected gap %q", gap)
+		}
+	}
+}
+
+func TestMissingSchemaFields_NestedAndPreserved(t *testing.T) {
+	type inner struct {
+		Kept    string `json:"kept"`
+		Dropped string `json:"dropped,omitempty"`
+	}
+	type outer struct {
+		Items []inner          `json:"items"`
+		Free  map[string]inner `json:"free"`
+		Skip  string           `json:"-"`
+	}
+	preserve := true
+	s := apiextensionsv1.JSONSchemaProps{Properties: map[string]apiextensionsv1.JSONSchemaProps{
+		"items": {Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{
+			Properties: map[string]apiextensionsv1.JSONSchemaProps{"kept": {}},
+		}}},
+		"free": {XPreserveUnknownFields: &preserve},
+	}}
+
+	got := missingSchemaFields(typeOf[outer](), s, "spec", typeOf[outer]().PkgPath(), nil)
+	if len(got) != 1 || got[0] != "spec.items[].dropped" {
+		t.Errorf("expected [spec.items[].dropped], got %v", got)
+	}
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
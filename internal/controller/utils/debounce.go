@@ -0,0 +1,110 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DebounceStatusUpdates wraps a child resource event handler so that status-only updates enqueue
+// their requests after window instead of immediately. The workqueue keeps a single entry per
+// request, so a burst of status updates within the window (e.g. dozens of predictor pods
+// flapping) collapses into one reconcile of the parent. Creates, deletes and updates that change
+// the spec, labels, annotations, owners or deletion state are enqueued immediately.
+// A window of zero returns the handler unchanged.
+func DebounceStatusUpdates(inner handler.EventHandler, window time.Duration) handler.EventHandler {
+	if window <= 0 {
+		return inner
+	}
+	return &debounceHandler{inner: inner, window: window}
+}
+
+type debounceHandler struct {
+	inner  handler.EventHandler
+	window time.Duration
+}
+
+func (h *debounceHandler) Create(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.inner.Create(ctx, e, q)
+}
+
+func (h *debounceHandler) Update(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if IsStatusOnlyUpdate(e.ObjectOld, e.ObjectNew) {
+		q = &delayedQueue{TypedRateLimitingInterface: q, delay: h.window}
+	}
+	h.inner.Update(ctx, e, q)
+}
+
+func (h *debounceHandler) Delete(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.inner.Delete(ctx, e, q)
+}
+
+func (h *debounceHandler) Generic(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.inner.Generic(ctx, e, q)
+}
+
+// delayedQueue turns immediate adds into delayed adds. The delaying queue keeps the earliest
+// ready time of duplicate items, so repeated adds within the delay do not postpone the reconcile.
+type delayedQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	delay time.Duration
+}
+
+func (q *delayedQueue) Add(item reconcile.Request) {
+	q.AddAfter(item, q.delay)
+}
+
+// IsStatusOnlyUpdate reports whether an update left everything but the status unchanged.
+// Objects without a generation (such as pods on older clusters) count as status-only when
+// their labels, annotations, owners and deletion state are unchanged.
+func IsStatusOnlyUpdate(oldObj, newObj client.Object) bool {
+	if oldObj == nil || newObj == nil {
+		return false
+	}
+	return oldObj.GetGeneration() == newObj.GetGeneration() &&
+		maps.Equal(oldObj.GetLabels(), newObj.GetLabels()) &&
+		maps.Equal(oldObj.GetAnnotations(), newObj.GetAnnotations()) &&
+		(oldObj.GetDeletionTimestamp() == nil) == (newObj.GetDeletionTimestamp() == nil) &&
+		ownerUIDsEqual(oldObj, newObj)
+}
+
+func ownerUIDsEqual(oldObj, newObj client.Object) bool {
+	oldRefs, newRefs := oldObj.GetOwnerReferences(), newObj.GetOwnerReferences()
+	if len(oldRefs) != len(newRefs) {
+		return false
+	}
+	for i := range oldRefs {
+		if oldRefs[i].UID != newRefs[i].UID {
+			return false
+		}
+	}
+	return true
+}
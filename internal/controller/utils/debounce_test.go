@@ -0,0 +1,111 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestDebounceStatusUpdates(t *testing.T) {
+	parent := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "svc"}}
+	inner := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{parent}
+	})
+	window := 50 * time.Millisecond
+	h := DebounceStatusUpdates(inner, window)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Labels: map[string]string{"app": "svc"}}}
+	flapped := pod.DeepCopy()
+	flapped.Status.Phase = corev1.PodRunning
+	relabeled := pod.DeepCopy()
+	relabeled.Labels["app"] = "other"
+
+	t.Run("status-only updates are collapsed", func(t *testing.T) {
+		q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		defer q.ShutDown()
+		for range 10 {
+			h.Update(context.Background(), event.UpdateEvent{ObjectOld: pod, ObjectNew: flapped}, q)
+		}
+		if q.Len() != 0 {
+			t.Fatalf("expected no immediate requests, got %d", q.Len())
+		}
+		time.Sleep(2 * window)
+		if q.Len() != 1 {
+			t.Errorf("expected one request after the window, got %d", q.Len())
+		}
+	})
+
+	t.Run("metadata updates are immediate", func(t *testing.T) {
+		q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		defer q.ShutDown()
+		h.Update(context.Background(), event.UpdateEvent{ObjectOld: pod, ObjectNew: relabeled}, q)
+		if q.Len() != 1 {
+			t.Errorf("expected an immediate request, got %d", q.Len())
+		}
+	})
+
+	t.Run("zero window disables debouncing", func(t *testing.T) {
+		if DebounceStatusUpdates(inner, 0) != inner {
+			t.Error("expected the inner handler to be returned")
+		}
+	})
+}
+
+func TestIsStatusOnlyUpdate(t *testing.T) {
+	base := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Generation: 1}}
+
+	status := base.DeepCopy()
+	status.Status.Phase = corev1.PodFailed
+	if !IsStatusOnlyUpdate(base, status) {
+		t.Error("expected a status change to be status-only")
+	}
+
+	spec := base.DeepCopy()
+	spec.Generation = 2
+	if IsStatusOnlyUpdate(base, spec) {
+		t.Error("expected a generation change not to be status-only")
+	}
+
+	deleting := base.DeepCopy()
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	if IsStatusOnlyUpdate(base, deleting) {
+		t.Error("expected a deletion not to be status-only")
+	}
+
+	annotated := base.DeepCopy()
+	annotated.Annotations = map[string]string{"a": "b"}
+	if IsStatusOnlyUpdate(base, annotated) {
+		t.Error("expected an annotation change not to be status-only")
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"syscall"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 )
 
 // ErrorCategory classifies high-level error semantics for the state engine.
@@ -49,14 +50,17 @@ type StateEngineError interface {
 	Category() ErrorCategory
 	Reason() string
 	UserMessage() string
+	// FieldPath returns the spec path the error refers to, empty if it is not tied to a field.
+	FieldPath() string
 }
 
 // stateEngineError is the concrete implementation of StateEngineError.
 type stateEngineError struct {
-	err     error
-	cat     ErrorCategory
-	reason  string
-	message string
+	err       error
+	cat       ErrorCategory
+	reason    string
+	message   string
+	fieldPath string
 }
 
 // Error returns a formatted error message combining the reason and user message.
@@ -93,6 +97,10 @@ func (e *stateEngineError) UserMessage() string {
 	return e.message
 }
 
+func (e *stateEngineError) FieldPath() string {
+	return e.fieldPath
+}
+
 // String returns the human-readable name of the error category.
 func (c ErrorCategory) String() string {
 	switch c {
@@ -201,6 +209,25 @@ func NewInvalidSpecError(reason, message string, cause error) StateEngineError {
 	}
 }
 
+// NewInvalidSpecFieldError creates an InvalidSpec error tied to a single spec field.
+// Field errors are listed in status.validationErrors so that clients can point the
+// user at the field to fix.
+//
+// Parameters:
+//   - reason: Machine-readable reason code (e.g., "InvalidRequestLogging")
+//   - fieldPath: Path of the offending field (e.g., "spec.routing.gatewayRef")
+//   - message: Human-readable description for users
+//   - cause: Underlying error that caused this issue (may be nil)
+func NewInvalidSpecFieldError(reason, fieldPath, message string, cause error) StateEngineError {
+	return &stateEngineError{
+		err:       cause,
+		cat:       ErrorCategoryInvalidSpec,
+		reason:    reason,
+		message:   message,
+		fieldPath: fieldPath,
+	}
+}
+
 // NewResourceExhaustionError creates a resource exhaustion error.
 // Use this for errors related to resource limits being hit:
 // - Disk full / no space left on device
@@ -241,6 +268,15 @@ func CategorizeError(err error) StateEngineError {
 		return se
 	}
 
+	// Kind not served by the API server (CRD missing or version skew) - retrying will not help
+	if meta.IsNoMatchError(err) {
+		return NewMissingUpstreamDependencyError(
+			ReasonRuntimeDependencyMissing,
+			"Resource kind is not served by the API server",
+			err,
+		)
+	}
+
 	// Kubernetes API errors
 	if statusErr := apierrors.APIStatus(nil); errors.As(err, &statusErr) {
 		status := statusErr.Status()
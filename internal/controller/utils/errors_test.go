@@ -30,7 +30,9 @@ import (
 	"testing"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestErrorCategoryString(t *testing.T) {
@@ -384,6 +386,21 @@ func TestCategorizeError_KubernetesAPIErrors(t *testing.T) {
 	}
 }
 
+func TestCategorizeError_NoKindMatch(t *testing.T) {
+	err := fmt.Errorf("failed to get InferenceService: %w", &meta.NoKindMatchError{
+		GroupKind:        schema.GroupKind{Group: "serving.kserve.io", Kind: "InferenceService"},
+		SearchedVersions: []string{"v1beta1"},
+	})
+
+	categorized := CategorizeError(err)
+	if categorized.Category() != ErrorCategoryMissingUpstreamDependency {
+		t.Errorf("expected category %v, got %v", ErrorCategoryMissingUpstreamDependency, categorized.Category())
+	}
+	if categorized.Reason() != ReasonRuntimeDependencyMissing {
+		t.Errorf("expected reason %s, got %s", ReasonRuntimeDependencyMissing, categorized.Reason())
+	}
+}
+
 func TestCategorizeError_HTTPStatusCodes(t *testing.T) {
 	tests := []struct {
 		name             string
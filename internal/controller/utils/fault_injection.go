@@ -0,0 +1,234 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// ReasonInjectedFault is the reason of errors produced by fault injection.
+const ReasonInjectedFault = "InjectedFault"
+
+// faultWildcard matches every kind in a fault annotation.
+const faultWildcard = "*"
+
+// faultNotFound is the fetch error category that simulates a missing object.
+const faultNotFound = "NotFound"
+
+// injectedFaults are the faults requested by the annotations of the reconciled object,
+// keyed by kind.
+type injectedFaults struct {
+	fetchDelay map[string]time.Duration
+	fetchError map[string]string
+	applyError map[string]string
+}
+
+type injectedFaultsKey struct{}
+
+// withInjectedFaults attaches the faults requested by the object's annotations to the context.
+// It is a no-op unless the binary was built with the faultinject tag.
+func withInjectedFaults(ctx context.Context, obj client.Object) context.Context {
+	if !faultInjectionEnabled {
+		return ctx
+	}
+	faults, err := parseInjectedFaults(obj.GetAnnotations())
+	if err != nil {
+		log.FromContext(ctx).Error(err, "ignoring invalid fault injection annotations")
+		return ctx
+	}
+	if faults == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, injectedFaultsKey{}, faults)
+}
+
+// parseInjectedFaults reads the fault annotations. Returns nil when none are set.
+func parseInjectedFaults(annotations map[string]string) (*injectedFaults, error) {
+	delays, err := parseFaultList(annotations[constants.AnnotationFaultFetchDelay])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.AnnotationFaultFetchDelay, err)
+	}
+	fetchErrors, err := parseFaultList(annotations[constants.AnnotationFaultFetchError])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.AnnotationFaultFetchError, err)
+	}
+	applyErrors, err := parseFaultList(annotations[constants.AnnotationFaultApplyError])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.AnnotationFaultApplyError, err)
+	}
+	if len(delays) == 0 && len(fetchErrors) == 0 && len(applyErrors) == 0 {
+		return nil, nil
+	}
+
+	faults := &injectedFaults{
+		fetchDelay: map[string]time.Duration{},
+		fetchError: fetchErrors,
+		applyError: applyErrors,
+	}
+	for kind, value := range delays {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid delay for %s: %w", constants.AnnotationFaultFetchDelay, kind, err)
+		}
+		faults.fetchDelay[kind] = d
+	}
+	for kind, category := range fetchErrors {
+		if category != faultNotFound && !isFaultCategory(category) {
+			return nil, fmt.Errorf("%s: unknown category %q for %s", constants.AnnotationFaultFetchError, category, kind)
+		}
+	}
+	for kind, category := range applyErrors {
+		if !isFaultCategory(category) {
+			return nil, fmt.Errorf("%s: unknown category %q for %s", constants.AnnotationFaultApplyError, category, kind)
+		}
+	}
+	return faults, nil
+}
+
+// parseFaultList parses "Kind=value,Kind=value".
+func parseFaultList(value string) (map[string]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	entries := map[string]string{}
+	for _, entry := range strings.Split(value, ",") {
+		kind, v, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || kind == "" || v == "" {
+			return nil, fmt.Errorf("invalid entry %q, expected Kind=value", entry)
+		}
+		entries[strings.TrimSpace(kind)] = strings.TrimSpace(v)
+	}
+	return entries, nil
+}
+
+// isFaultCategory reports whether name is the String() of a known error category.
+func isFaultCategory(name string) bool {
+	_, ok := faultCategoryError(name, "")
+	return ok
+}
+
+// faultCategoryError builds an error of the named category.
+func faultCategoryError(category, kind string) (error, bool) {
+	message := fmt.Sprintf("injected %s fault for %s", category, kind)
+	switch category {
+	case ErrorCategoryInfrastructure.String():
+		return NewInfrastructureError(ReasonInjectedFault, message, nil), true
+	case ErrorCategoryAuth.String():
+		return NewAuthError(ReasonInjectedFault, message, nil), true
+	case ErrorCategoryMissingDownstreamDependency.String():
+		return NewMissingDownstreamDependencyError(ReasonInjectedFault, message, nil), true
+	case ErrorCategoryMissingUpstreamDependency.String():
+		return NewMissingUpstreamDependencyError(ReasonInjectedFault, message, nil), true
+	case ErrorCategoryInvalidSpec.String():
+		return NewInvalidSpecError(ReasonInjectedFault, message, nil), true
+	case ErrorCategoryResourceExhaustion.String():
+		return NewResourceExhaustionError(ReasonInjectedFault, message, nil), true
+	}
+	return nil, false
+}
+
+// lookupFault returns the entry for kind, falling back to the wildcard.
+func lookupFault[V any](entries map[string]V, kind string) (V, bool) {
+	if v, ok := entries[kind]; ok {
+		return v, true
+	}
+	v, ok := entries[faultWildcard]
+	return v, ok
+}
+
+// injectFetchFault delays or fails a fetch of obj when the context requests it.
+func injectFetchFault(ctx context.Context, obj any) error {
+	if !faultInjectionEnabled {
+		return nil
+	}
+	faults, _ := ctx.Value(injectedFaultsKey{}).(*injectedFaults)
+	if faults == nil {
+		return nil
+	}
+	kind := faultKind(obj)
+	if delay, ok := lookupFault(faults.fetchDelay, kind); ok && delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	category, ok := lookupFault(faults.fetchError, kind)
+	if !ok {
+		return nil
+	}
+	if category == faultNotFound {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: strings.ToLower(kind)}, "injected")
+	}
+	err, _ := faultCategoryError(category, kind)
+	return err
+}
+
+// injectApplyFault fails the apply of obj when the context requests it.
+func injectApplyFault(ctx context.Context, obj client.Object) error {
+	if !faultInjectionEnabled {
+		return nil
+	}
+	faults, _ := ctx.Value(injectedFaultsKey{}).(*injectedFaults)
+	if faults == nil {
+		return nil
+	}
+	kind := faultKind(obj)
+	category, ok := lookupFault(faults.applyError, kind)
+	if !ok {
+		return nil
+	}
+	err, _ := faultCategoryError(category, kind)
+	return err
+}
+
+// faultKind returns the kind of obj, taken from its Go type for typed objects without a GVK.
+// List types map to the kind of their items.
+func faultKind(obj any) string {
+	if o, ok := obj.(interface {
+		GetObjectKind() schema.ObjectKind
+	}); ok {
+		if kind := o.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+			return strings.TrimSuffix(kind, "List")
+		}
+	}
+	t := reflect.TypeOf(obj)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return ""
+	}
+	return strings.TrimSuffix(t.Name(), "List")
+}
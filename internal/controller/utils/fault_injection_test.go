@@ -0,0 +1,114 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func TestParseInjectedFaults(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantNil     bool
+		wantErr     bool
+	}{
+		{name: "none", annotations: map[string]string{"other": "x"}, wantNil: true},
+		{name: "valid", annotations: map[string]string{
+			constants.AnnotationFaultFetchDelay: "AIMServiceTemplate=5s, *=100ms",
+			constants.AnnotationFaultFetchError: "AIMModel=Infrastructure,InferenceService=NotFound",
+			constants.AnnotationFaultApplyError: "InferenceService=ResourceExhaustion",
+		}},
+		{name: "invalid delay", annotations: map[string]string{constants.AnnotationFaultFetchDelay: "AIMModel=soon"}, wantErr: true},
+		{name: "missing value", annotations: map[string]string{constants.AnnotationFaultFetchError: "AIMModel"}, wantErr: true},
+		{name: "unknown category", annotations: map[string]string{constants.AnnotationFaultApplyError: "AIMModel=Flaky"}, wantErr: true},
+		{name: "not found is fetch only", annotations: map[string]string{constants.AnnotationFaultApplyError: "AIMModel=NotFound"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			faults, err := parseInjectedFaults(tt.annotations)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (faults == nil) != tt.wantNil {
+				t.Errorf("faults = %+v, wantNil %v", faults, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestFaultKind(t *testing.T) {
+	typed := &aimv1alpha1.AIMServiceTemplate{}
+	stamped := &corev1.Pod{}
+	stamped.Kind = "Pod"
+	for obj, want := range map[any]string{
+		typed:                               "AIMServiceTemplate",
+		&aimv1alpha1.AIMModelList{}:         "AIMModel",
+		stamped:                             "Pod",
+		&corev1.PersistentVolumeClaimList{}: "PersistentVolumeClaim",
+	} {
+		if got := faultKind(obj); got != want {
+			t.Errorf("faultKind(%T) = %q, want %q", obj, got, want)
+		}
+	}
+}
+
+func TestInjectFetchFault(t *testing.T) {
+	faults, err := parseInjectedFaults(map[string]string{
+		constants.AnnotationFaultFetchDelay: "AIMModel=20ms",
+		constants.AnnotationFaultFetchError: "AIMModel=Infrastructure,*=NotFound",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), injectedFaultsKey{}, faults)
+
+	start := time.Now()
+	err = injectFetchFault(ctx, &aimv1alpha1.AIMModel{})
+	if !faultInjectionEnabled {
+		if err != nil {
+			t.Fatalf("expected no fault without the faultinject tag, got %v", err)
+		}
+		return
+	}
+	if time.Since(start) < 20*time.Millisecond {
+		t.Error("expected the fetch to be delayed")
+	}
+	if CategorizeError(err).Category() != ErrorCategoryInfrastructure {
+		t.Errorf("expected an infrastructure error, got %v", err)
+	}
+	if err := injectFetchFault(ctx, &aimv1alpha1.AIMServiceTemplate{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the wildcard to inject NotFound, got %v", err)
+	}
+	if err := injectApplyFault(ctx, &aimv1alpha1.AIMModel{}); err != nil {
+		t.Errorf("expected no apply fault, got %v", err)
+	}
+}
@@ -24,10 +24,14 @@ package controllerutils
 
 import (
 	"context"
+	"reflect"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
 // FetchResult wraps a fetched value and its error, simplifying fetch result handling.
@@ -35,6 +39,84 @@ import (
 type FetchResult[T any] struct {
 	Value T
 	Error error
+
+	// ResourceVersion is the resourceVersion of the fetched object or list.
+	// Empty if the fetch failed or the result was not produced by a fetch helper.
+	ResourceVersion string
+
+	// FetchedAt is when the read completed. Zero if the result was not produced by a fetch helper.
+	FetchedAt time.Time
+
+	// Source indicates whether the value was read from the informer cache, live from the API server,
+	// or through the manager's API reader.
+	Source FetchSource
+
+	// ref identifies the object that was fetched. It is set by the single-object fetch helpers,
+	// also when the object was not found, and fills in ComponentHealth.WaitingOn.
+	ref *ObjectRef
+}
+
+// FetchSource identifies where a FetchResult was read from.
+type FetchSource string
+
+const (
+	// FetchSourceCache indicates a read through the manager's cache-backed client.
+	// Cached reads may lag behind the API server.
+	FetchSourceCache FetchSource = "Cache"
+
+	// FetchSourceLive indicates a read directly from the API server through an uncached client.
+	FetchSourceLive FetchSource = "Live"
+
+	// FetchSourceAPIReader indicates a read through the manager's API reader, which always
+	// goes to the API server. See NewAPIReader.
+	FetchSourceAPIReader FetchSource = "APIReader"
+)
+
+// apiReader marks a reader as the manager's API reader, so fetches through it record
+// FetchSourceAPIReader whichever fetch helper is used.
+type apiReader struct {
+	client.Reader
+}
+
+// NewAPIReader wraps the manager's API reader (mgr.GetAPIReader()) so that fetch results
+// read through it are labeled with FetchSourceAPIReader.
+func NewAPIReader(r client.Reader) client.Reader {
+	if _, ok := r.(apiReader); ok {
+		return r
+	}
+	return apiReader{Reader: r}
+}
+
+// readSource returns the source of a read through r, given the source the fetch helper expects.
+func readSource(r client.Reader, expected FetchSource) FetchSource {
+	if _, ok := r.(apiReader); ok {
+		return FetchSourceAPIReader
+	}
+	return expected
+}
+
+// IsLive returns true if the result was read directly from the API server,
+// through an uncached client or the API reader.
+func (fr FetchResult[T]) IsLive() bool {
+	return fr.Source == FetchSourceLive || fr.Source == FetchSourceAPIReader
+}
+
+// Age returns how long ago the result was fetched, or zero if the fetch time is unknown.
+func (fr FetchResult[T]) Age() time.Duration {
+	if fr.FetchedAt.IsZero() {
+		return 0
+	}
+	return time.Since(fr.FetchedAt)
+}
+
+// IsStale returns true if the result is older than maxAge, or if its fetch time is unknown.
+// Use this to decide whether to force a live read (see FetchLive) before acting on the
+// result, e.g. before deleting a resource based on its observed status.
+func (fr FetchResult[T]) IsStale(maxAge time.Duration) bool {
+	if fr.FetchedAt.IsZero() {
+		return true
+	}
+	return time.Since(fr.FetchedAt) > maxAge
 }
 
 // IsNotFound returns true if the error is a NotFound error.
@@ -69,19 +151,67 @@ func (fr FetchResult[T]) HasError() bool {
 //	    }, nil
 //	}
 func Fetch[T client.Object](ctx context.Context, c client.Client, key client.ObjectKey, obj T) FetchResult[T] {
-	err := c.Get(ctx, key, obj)
+	return fetchFrom(ctx, c, key, obj, FetchSourceCache)
+}
+
+// FetchLive retrieves a single object directly from the API server, bypassing the informer cache.
+// The reader is typically the manager's API reader (mgr.GetAPIReader()). Use this for critical
+// checks where acting on a stale cached value would be harmful.
+func FetchLive[T client.Object](ctx context.Context, r client.Reader, key client.ObjectKey, obj T) FetchResult[T] {
+	return fetchFrom(ctx, r, key, obj, FetchSourceLive)
+}
+
+func fetchFrom[T client.Object](ctx context.Context, r client.Reader, key client.ObjectKey, obj T, source FetchSource) FetchResult[T] {
+	source = readSource(r, source)
+	err := injectFetchFault(ctx, obj)
+	if err == nil {
+		err = r.Get(ctx, key, obj)
+	}
 	if err != nil {
 		// Return nil Value on error to prevent callers from using uninitialized objects
 		var zero T
 		return FetchResult[T]{
-			Value: zero,
-			Error: err,
+			Value:     zero,
+			Error:     err,
+			FetchedAt: time.Now(),
+			Source:    source,
+			ref:       &ObjectRef{Kind: objectKind(obj), Namespace: key.Namespace, Name: key.Name},
 		}
 	}
 	return FetchResult[T]{
-		Value: obj,
-		Error: nil,
+		Value:           obj,
+		Error:           nil,
+		ResourceVersion: obj.GetResourceVersion(),
+		FetchedAt:       time.Now(),
+		Source:          source,
+		ref:             &ObjectRef{Kind: objectKind(obj), Namespace: key.Namespace, Name: key.Name},
+	}
+}
+
+// objectKind returns the kind of a typed object, falling back to its Go type name
+// since objects read through the typed client usually have an empty TypeMeta.
+func objectKind(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+	return reflect.TypeOf(obj).Elem().Name()
+}
+
+// withWaitingOn fills in the fetched object as what a not-ready component is waiting on,
+// unless the inspector already named one. Fetch errors other than NotFound are not waits.
+func (fr FetchResult[T]) withWaitingOn(health ComponentHealth) ComponentHealth {
+	if health.WaitingOn != nil || fr.ref == nil {
+		return health
+	}
+	if fr.HasError() && !fr.IsNotFound() {
+		return health
+	}
+	if health.GetState() == constants.AIMStatusReady {
+		return health
 	}
+	ref := *fr.ref
+	health.WaitingOn = &ref
+	return health
 }
 
 // FetchList retrieves a list of objects from the Kubernetes API and wraps the result.
@@ -102,10 +232,30 @@ func Fetch[T client.Object](ctx context.Context, c client.Client, key client.Obj
 //	// Access in ComposeState:
 //	for _, pod := range fetch.Pods.Value.Items { ... }
 func FetchList[T client.ObjectList](ctx context.Context, c client.Client, list T, opts ...client.ListOption) FetchResult[T] {
-	return FetchResult[T]{
-		Value: list,
-		Error: c.List(ctx, list, opts...),
+	return fetchListFrom(ctx, c, list, FetchSourceCache, opts...)
+}
+
+// FetchListLive retrieves a list of objects directly from the API server, bypassing the informer cache.
+func FetchListLive[T client.ObjectList](ctx context.Context, r client.Reader, list T, opts ...client.ListOption) FetchResult[T] {
+	return fetchListFrom(ctx, r, list, FetchSourceLive, opts...)
+}
+
+func fetchListFrom[T client.ObjectList](ctx context.Context, r client.Reader, list T, source FetchSource, opts ...client.ListOption) FetchResult[T] {
+	source = readSource(r, source)
+	err := injectFetchFault(ctx, list)
+	if err == nil {
+		err = r.List(ctx, list, opts...)
+	}
+	result := FetchResult[T]{
+		Value:     list,
+		Error:     err,
+		FetchedAt: time.Now(),
+		Source:    source,
 	}
+	if err == nil {
+		result.ResourceVersion = list.GetResourceVersion()
+	}
+	return result
 }
 
 // ToComponentHealth converts a FetchResult into ComponentHealth with automatic error handling.
@@ -114,10 +264,10 @@ func FetchList[T client.ObjectList](ctx context.Context, c client.Client, list T
 func (fr FetchResult[T]) ToComponentHealth(component string, inspector func(T) ComponentHealth) ComponentHealth {
 	// Handle fetch errors - pass raw error for later categorization
 	if fr.HasError() {
-		return ComponentHealth{
+		return fr.withWaitingOn(ComponentHealth{
 			Component: component,
 			Errors:    []error{fr.Error},
-		}
+		})
 	}
 
 	// No fetch errors - inspect the value for semantic state
@@ -126,7 +276,7 @@ func (fr FetchResult[T]) ToComponentHealth(component string, inspector func(T) C
 	// Override the component name
 	health.Component = component
 
-	return health
+	return fr.withWaitingOn(health)
 }
 
 // ToComponentHealthWithContext converts a FetchResult into ComponentHealth with automatic error handling.
@@ -140,10 +290,10 @@ func (fr FetchResult[T]) ToComponentHealthWithContext(
 ) ComponentHealth {
 	// Handle fetch errors - pass raw error for later categorization
 	if fr.HasError() {
-		return ComponentHealth{
+		return fr.withWaitingOn(ComponentHealth{
 			Component: component,
 			Errors:    []error{fr.Error},
-		}
+		})
 	}
 
 	// No fetch errors - inspect the value for semantic state
@@ -152,7 +302,7 @@ func (fr FetchResult[T]) ToComponentHealthWithContext(
 	// Override the component name
 	health.Component = component
 
-	return health
+	return fr.withWaitingOn(health)
 }
 
 // ToUpstreamComponentHealth converts a FetchResult for an upstream dependency into ComponentHealth.
@@ -175,11 +325,11 @@ func (fr FetchResult[T]) ToUpstreamComponentHealth(component string, inspector f
 			wrappedErr = fr.Error
 		}
 
-		return ComponentHealth{
+		return fr.withWaitingOn(ComponentHealth{
 			Component:      component,
 			Errors:         []error{wrappedErr},
 			DependencyType: DependencyTypeUpstream,
-		}
+		})
 	}
 
 	// No fetch errors - inspect the value for semantic state
@@ -189,7 +339,7 @@ func (fr FetchResult[T]) ToUpstreamComponentHealth(component string, inspector f
 	health.Component = component
 	health.DependencyType = DependencyTypeUpstream
 
-	return health
+	return fr.withWaitingOn(health)
 }
 
 // ToDownstreamComponentHealth converts a FetchResult for a downstream dependency into ComponentHealth.
@@ -211,11 +361,11 @@ func (fr FetchResult[T]) ToDownstreamComponentHealth(component string, inspector
 			wrappedErr = fr.Error
 		}
 
-		return ComponentHealth{
+		return fr.withWaitingOn(ComponentHealth{
 			Component:      component,
 			Errors:         []error{wrappedErr},
 			DependencyType: DependencyTypeDownstream,
-		}
+		})
 	}
 
 	// No fetch errors - inspect the value for semantic state
@@ -225,5 +375,5 @@ func (fr FetchResult[T]) ToDownstreamComponentHealth(component string, inspector
 	health.Component = component
 	health.DependencyType = DependencyTypeDownstream
 
-	return health
+	return fr.withWaitingOn(health)
 }
@@ -0,0 +1,116 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultFetchConcurrency bounds the number of concurrent API calls issued by a FetchGroup.
+const DefaultFetchConcurrency = 8
+
+// FetchGroup runs independent fetches concurrently with bounded concurrency.
+// It has errgroup-style semantics (Go / Wait) tailored to the fetch phase:
+//   - Fetch errors are captured in each FetchResult and never abort sibling fetches.
+//   - Fetches scheduled after the parent context is cancelled are not executed;
+//     their FetchResult records the context error instead.
+//   - A panic in a fetch is re-raised from Wait on the calling goroutine, so the
+//     controller's panic recovery still applies.
+//
+// Each fetch must write to its own destination; a FetchGroup provides no locking
+// for shared state. Do not reuse a FetchGroup after Wait returns.
+//
+// Example:
+//
+//	g := NewFetchGroup(ctx, DefaultFetchConcurrency)
+//	GoFetch(g, c, key, &aimv1alpha1.AIMModel{}, &result.model)
+//	GoFetchList(g, c, &corev1.PodList{}, &result.pods, client.InNamespace(ns))
+//	g.Wait()
+type FetchGroup struct {
+	ctx   context.Context
+	group *errgroup.Group
+
+	panicOnce  sync.Once
+	panicValue any
+}
+
+// NewFetchGroup creates a FetchGroup bound to ctx. A limit <= 0 means no limit.
+func NewFetchGroup(ctx context.Context, limit int) *FetchGroup {
+	group, groupCtx := errgroup.WithContext(ctx)
+	if limit > 0 {
+		group.SetLimit(limit)
+	}
+	return &FetchGroup{ctx: groupCtx, group: group}
+}
+
+// Go schedules fn to run in the group. fn receives the group context, which is
+// cancelled if the parent context is cancelled or another fetch panics.
+// Go blocks while the concurrency limit is reached.
+func (g *FetchGroup) Go(fn func(ctx context.Context)) {
+	g.group.Go(func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				g.panicOnce.Do(func() { g.panicValue = r })
+				err = fmt.Errorf("fetch panicked: %v", r)
+			}
+		}()
+		fn(g.ctx)
+		return nil
+	})
+}
+
+// Wait blocks until all scheduled fetches have finished.
+// If any fetch panicked, Wait re-panics with the first panic value.
+func (g *FetchGroup) Wait() {
+	_ = g.group.Wait()
+	if g.panicValue != nil {
+		panic(g.panicValue)
+	}
+}
+
+// GoFetch schedules a Fetch of a single object and stores the result in dest.
+func GoFetch[T client.Object](g *FetchGroup, c client.Client, key client.ObjectKey, obj T, dest *FetchResult[T]) {
+	g.Go(func(ctx context.Context) {
+		if err := ctx.Err(); err != nil {
+			*dest = FetchResult[T]{Error: err}
+			return
+		}
+		*dest = Fetch(ctx, c, key, obj)
+	})
+}
+
+// GoFetchList schedules a FetchList and stores the result in dest.
+func GoFetchList[T client.ObjectList](g *FetchGroup, c client.Client, list T, dest *FetchResult[T], opts ...client.ListOption) {
+	g.Go(func(ctx context.Context) {
+		if err := ctx.Err(); err != nil {
+			*dest = FetchResult[T]{Error: err}
+			return
+		}
+		*dest = FetchList(ctx, c, list, opts...)
+	})
+}
@@ -0,0 +1,119 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestFetchGroup_FetchesConcurrently(t *testing.T) {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "present", Namespace: "default"}}
+	cl := fake.NewClientBuilder().WithObjects(cm).Build()
+
+	var (
+		found    FetchResult[*corev1.ConfigMap]
+		missing  FetchResult[*corev1.ConfigMap]
+		listed   FetchResult[*corev1.ConfigMapList]
+		executed atomic.Int32
+	)
+
+	g := NewFetchGroup(context.Background(), 2)
+	GoFetch(g, cl, client.ObjectKey{Name: "present", Namespace: "default"}, &corev1.ConfigMap{}, &found)
+	GoFetch(g, cl, client.ObjectKey{Name: "absent", Namespace: "default"}, &corev1.ConfigMap{}, &missing)
+	GoFetchList(g, cl, &corev1.ConfigMapList{}, &listed, client.InNamespace("default"))
+	g.Go(func(ctx context.Context) { executed.Add(1) })
+	g.Wait()
+
+	if !found.OK() || found.Value.Name != "present" {
+		t.Errorf("expected present ConfigMap, got %+v", found)
+	}
+	if !missing.IsNotFound() {
+		t.Errorf("expected NotFound for absent ConfigMap, got %v", missing.Error)
+	}
+	if !listed.OK() || len(listed.Value.Items) != 1 {
+		t.Errorf("expected 1 listed ConfigMap, got %+v", listed)
+	}
+	if executed.Load() != 1 {
+		t.Error("expected custom fetch to run")
+	}
+}
+
+func TestFetchGroup_RespectsLimit(t *testing.T) {
+	var active, peak atomic.Int32
+
+	g := NewFetchGroup(context.Background(), 2)
+	for range 6 {
+		g.Go(func(ctx context.Context) {
+			n := active.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			active.Add(-1)
+		})
+	}
+	g.Wait()
+
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 concurrent fetches, got %d", peak.Load())
+	}
+}
+
+func TestFetchGroup_CancelledContext(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var result FetchResult[*corev1.ConfigMap]
+	g := NewFetchGroup(ctx, DefaultFetchConcurrency)
+	GoFetch(g, cl, client.ObjectKey{Name: "any", Namespace: "default"}, &corev1.ConfigMap{}, &result)
+	g.Wait()
+
+	if result.Error != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", result.Error)
+	}
+}
+
+func TestFetchGroup_PanicIsReraisedFromWait(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("expected panic value 'boom', got %v", r)
+		}
+	}()
+
+	g := NewFetchGroup(context.Background(), 0)
+	g.Go(func(ctx context.Context) { panic("boom") })
+	g.Wait()
+	t.Error("expected Wait to panic")
+}
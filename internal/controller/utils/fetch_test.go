@@ -23,11 +23,17 @@
 package controllerutils
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
@@ -146,6 +152,107 @@ func TestFetchResult_HasError(t *testing.T) {
 	}
 }
 
+func TestFetchResult_IsStale(t *testing.T) {
+	tests := []struct {
+		name     string
+		fr       FetchResult[any]
+		maxAge   time.Duration
+		expected bool
+	}{
+		{
+			name:     "unknown fetch time is stale",
+			fr:       FetchResult[any]{},
+			maxAge:   time.Hour,
+			expected: true,
+		},
+		{
+			name:     "recent fetch is fresh",
+			fr:       FetchResult[any]{FetchedAt: time.Now()},
+			maxAge:   time.Minute,
+			expected: false,
+		},
+		{
+			name:     "old fetch is stale",
+			fr:       FetchResult[any]{FetchedAt: time.Now().Add(-2 * time.Minute)},
+			maxAge:   time.Minute,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fr.IsStale(tt.maxAge); got != tt.expected {
+				t.Errorf("IsStale() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFetch_RecordsMetadata(t *testing.T) {
+	ctx := context.Background()
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cfg", Namespace: "default"}}
+	cl := fake.NewClientBuilder().WithObjects(cm).Build()
+	key := client.ObjectKey{Name: "cfg", Namespace: "default"}
+
+	cached := Fetch(ctx, cl, key, &corev1.ConfigMap{})
+	if !cached.OK() || cached.Source != FetchSourceCache || cached.IsLive() {
+		t.Errorf("expected successful cache read, got %+v", cached)
+	}
+	if cached.ResourceVersion == "" || cached.ResourceVersion != cached.Value.ResourceVersion {
+		t.Errorf("expected resourceVersion to be recorded, got %q", cached.ResourceVersion)
+	}
+	if cached.FetchedAt.IsZero() {
+		t.Error("expected fetch time to be recorded")
+	}
+
+	live := FetchLive(ctx, cl, key, &corev1.ConfigMap{})
+	if !live.IsLive() {
+		t.Errorf("expected live read, got source %q", live.Source)
+	}
+
+	missing := FetchLive(ctx, cl, client.ObjectKey{Name: "absent", Namespace: "default"}, &corev1.ConfigMap{})
+	if !missing.IsNotFound() || missing.ResourceVersion != "" {
+		t.Errorf("expected NotFound without resourceVersion, got %+v", missing)
+	}
+
+	list := FetchListLive(ctx, cl, &corev1.ConfigMapList{}, client.InNamespace("default"))
+	if !list.OK() || !list.IsLive() || len(list.Value.Items) != 1 {
+		t.Errorf("expected live list with 1 item, got %+v", list)
+	}
+}
+
+func TestFetch_RecordsAPIReaderSource(t *testing.T) {
+	ctx := context.Background()
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cfg", Namespace: "default"}}
+	reader := NewAPIReader(fake.NewClientBuilder().WithObjects(cm).Build())
+	key := client.ObjectKey{Name: "cfg", Namespace: "default"}
+
+	tests := []struct {
+		name   string
+		source FetchSource
+	}{
+		{name: "FetchLive", source: FetchLive(ctx, reader, key, &corev1.ConfigMap{}).Source},
+		{name: "FetchListLive", source: FetchListLive(ctx, reader, &corev1.ConfigMapList{}).Source},
+		{
+			name:   "FetchLive not found",
+			source: FetchLive(ctx, reader, client.ObjectKey{Name: "absent", Namespace: "default"}, &corev1.ConfigMap{}).Source,
+		},
+		{name: "rewrapped", source: FetchLive(ctx, NewAPIReader(reader), key, &corev1.ConfigMap{}).Source},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.source != FetchSourceAPIReader {
+				t.Errorf("Source = %q, want %q", tt.source, FetchSourceAPIReader)
+			}
+		})
+	}
+
+	if fr := (FetchResult[*corev1.ConfigMap]{Source: FetchSourceAPIReader}); !fr.IsLive() {
+		t.Error("expected an API reader result to be live")
+	}
+}
+
 func TestFetchResult_ToComponentHealth_Success(t *testing.T) {
 	// Test case: successful fetch with inspector function
 	type testModel struct {
@@ -340,3 +447,31 @@ func TestFetchResult_ToComponentHealth_InfrastructureError(t *testing.T) {
 		t.Errorf("GetMessage() = %v, want 'network timeout' (derived from error)", message)
 	}
 }
+
+func TestFetchResult_WaitingOn(t *testing.T) {
+	ctx := context.Background()
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "default"}}
+	c := fake.NewClientBuilder().WithObjects(pod).Build()
+
+	notReady := func(*corev1.Pod) ComponentHealth {
+		return ComponentHealth{State: constants.AIMStatusProgressing}
+	}
+	ready := func(*corev1.Pod) ComponentHealth {
+		return ComponentHealth{State: constants.AIMStatusReady}
+	}
+
+	found := Fetch(ctx, c, client.ObjectKey{Namespace: "default", Name: "worker"}, &corev1.Pod{})
+	health := found.ToDownstreamComponentHealth("Workload", notReady)
+	if health.WaitingOn == nil || health.WaitingOn.String() != "Pod default/worker" {
+		t.Errorf("WaitingOn = %v, want Pod default/worker", health.WaitingOn)
+	}
+	if health := found.ToDownstreamComponentHealth("Workload", ready); health.WaitingOn != nil {
+		t.Errorf("WaitingOn = %v, want nil for a ready component", health.WaitingOn)
+	}
+
+	missing := Fetch(ctx, c, client.ObjectKey{Namespace: "default", Name: "missing"}, &corev1.Pod{})
+	health = missing.ToUpstreamComponentHealth("Workload", ready)
+	if health.WaitingOn == nil || health.WaitingOn.Name != "missing" {
+		t.Errorf("WaitingOn = %v, want the missing pod", health.WaitingOn)
+	}
+}
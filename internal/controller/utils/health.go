@@ -23,6 +23,8 @@
 package controllerutils
 
 import (
+	"fmt"
+
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
@@ -82,6 +84,13 @@ type ComponentHealth struct {
 	// When set, this ComponentHealth represents a specific pod/deployment/etc.
 	// When nil, this represents an aggregated component view.
 	ChildRef *ChildRef
+
+	// WaitingOn optionally identifies the object this component is waiting on.
+	// When set on a component that is not ready, the state engine renders it into the condition
+	// message ("Waiting on AIMArtifact default/llama-8b") unless Message is set. Without errors
+	// or an explicit State, the component is Pending (upstream) or Progressing (downstream).
+	// Fetch results fill it in from the fetched object when the inspector leaves it empty.
+	WaitingOn *ObjectRef
 }
 
 // ChildRef identifies a child resource (e.g., Pod, Deployment, Service).
@@ -91,6 +100,22 @@ type ChildRef struct {
 	Name      string
 }
 
+// ObjectRef identifies an object by kind, namespace and name.
+// Namespace is empty for cluster-scoped objects.
+type ObjectRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// String renders the reference as "Kind namespace/name", or "Kind name" for cluster-scoped objects.
+func (r ObjectRef) String() string {
+	if r.Namespace == "" {
+		return r.Kind + " " + r.Name
+	}
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+}
+
 // ComponentHealthProvider is implemented by observation types that surface per-component health.
 type ComponentHealthProvider interface {
 	GetComponentHealth() []ComponentHealth
@@ -101,6 +126,9 @@ func (ch ComponentHealth) GetState() constants.AIMStatus {
 	if ch.State != "" {
 		return ch.State
 	}
+	if len(ch.Errors) == 0 && ch.WaitingOn != nil {
+		return deriveStatusFromDependencyType(ch.DependencyType)
+	}
 	return DeriveStateFromErrors(ch.Errors)
 }
 
@@ -115,6 +143,9 @@ func (ch ComponentHealth) GetReason() string {
 		categorized := CategorizeError(ch.Errors[0])
 		return categorized.Reason()
 	}
+	if ch.WaitingOn != nil {
+		return "WaitingFor" + ch.WaitingOn.Kind
+	}
 	return string(constants.AIMStatusReady)
 }
 
@@ -124,11 +155,18 @@ func (ch ComponentHealth) GetMessage() string {
 	if ch.Message != "" {
 		return ch.Message
 	}
+	waiting := ch.WaitingOn != nil && ch.GetState() != constants.AIMStatusReady
 	if len(ch.Errors) > 0 {
 		// Categorize the first error to extract message
 		categorized := CategorizeError(ch.Errors[0])
+		if waiting {
+			return categorized.UserMessage() + ": waiting on " + ch.WaitingOn.String()
+		}
 		return categorized.UserMessage()
 	}
+	if waiting {
+		return "Waiting on " + ch.WaitingOn.String()
+	}
 	return ""
 }
 
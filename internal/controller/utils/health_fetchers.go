@@ -695,6 +695,58 @@ func GetPvcHealth(pvc *corev1.PersistentVolumeClaim) ComponentHealth {
 	}
 }
 
+// pvcProvisioningEventReasons are the PVC warning event reasons that explain why a claim is stuck Pending.
+var pvcProvisioningEventReasons = []string{
+	"ProvisioningFailed", // Dynamic provisioner failed (missing StorageClass, no capacity, provisioner error)
+	"FailedBinding",      // No matching PersistentVolume and no StorageClass to provision one
+}
+
+// GetPvcHealthWithEvents evaluates the health of a PVC like GetPvcHealth, and additionally
+// inspects the PVC's events when it is Pending to surface provisioning problems.
+// Problems the user must fix (StorageClass not found, no default StorageClass) are
+// classified as MissingUpstreamDependency; everything else (capacity, provisioner errors)
+// is classified as Infrastructure.
+func GetPvcHealthWithEvents(pvc *corev1.PersistentVolumeClaim, events []corev1.Event) ComponentHealth {
+	health := GetPvcHealth(pvc)
+	if pvc == nil || pvc.Status.Phase != corev1.ClaimPending || len(health.Errors) > 0 {
+		return health
+	}
+
+	event := findLatestEventByReasons(events, pvcProvisioningEventReasons)
+	if event == nil || event.Type != corev1.EventTypeWarning {
+		return health
+	}
+
+	return ComponentHealth{
+		Errors: []error{classifyPvcProvisioningEvent(event)},
+	}
+}
+
+// classifyPvcProvisioningEvent converts a PVC provisioning warning event into a categorized error.
+func classifyPvcProvisioningEvent(event *corev1.Event) error {
+	message := strings.ToLower(event.Message)
+	switch {
+	case strings.Contains(message, "storageclass") && strings.Contains(message, "not found"):
+		return NewMissingUpstreamDependencyError(
+			"StorageClassNotFound",
+			fmt.Sprintf("PVC storage class not found: %s", event.Message),
+			nil,
+		)
+	case strings.Contains(message, "no storage class is set"):
+		return NewMissingUpstreamDependencyError(
+			"NoStorageClass",
+			fmt.Sprintf("PVC has no storage class and no default storage class is configured: %s", event.Message),
+			nil,
+		)
+	default:
+		return NewInfrastructureError(
+			"PvcProvisioningFailed",
+			fmt.Sprintf("PVC provisioning failed: %s", event.Message),
+			nil,
+		)
+	}
+}
+
 // GetHTTPRouteHealth evaluates the health of an HTTPRoute.
 // An HTTPRoute is ready when at least one parent has accepted it.
 func GetHTTPRouteHealth(route *gatewayapiv1.HTTPRoute) ComponentHealth {
@@ -0,0 +1,122 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func TestGetPvcHealthWithEvents(t *testing.T) {
+	pendingPvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+	}
+	warning := func(reason, message string) corev1.Event {
+		return corev1.Event{Type: corev1.EventTypeWarning, Reason: reason, Message: message}
+	}
+
+	tests := []struct {
+		name             string
+		pvc              *corev1.PersistentVolumeClaim
+		events           []corev1.Event
+		expectedCategory ErrorCategory
+		expectedReason   string
+		expectedState    constants.AIMStatus
+	}{
+		{
+			name:           "bound PVC is healthy",
+			pvc:            &corev1.PersistentVolumeClaim{Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound}},
+			events:         []corev1.Event{warning("ProvisioningFailed", "old failure")},
+			expectedState:  constants.AIMStatusReady,
+			expectedReason: string(constants.AIMStatusReady),
+		},
+		{
+			name:           "pending PVC without events is progressing",
+			pvc:            pendingPvc,
+			expectedState:  constants.AIMStatusProgressing,
+			expectedReason: "PvcPending",
+		},
+		{
+			name: "normal wait event is ignored",
+			pvc:  pendingPvc,
+			events: []corev1.Event{{
+				Type: corev1.EventTypeNormal, Reason: "WaitForFirstConsumer", Message: "waiting for first consumer",
+			}},
+			expectedState:  constants.AIMStatusProgressing,
+			expectedReason: "PvcPending",
+		},
+		{
+			name:             "missing storage class is an upstream dependency",
+			pvc:              pendingPvc,
+			events:           []corev1.Event{warning("ProvisioningFailed", `storageclass.storage.k8s.io "fast" not found`)},
+			expectedCategory: ErrorCategoryMissingUpstreamDependency,
+			expectedReason:   "StorageClassNotFound",
+		},
+		{
+			name: "no default storage class is an upstream dependency",
+			pvc:  pendingPvc,
+			events: []corev1.Event{warning("FailedBinding",
+				"no persistent volumes available for this claim and no storage class is set")},
+			expectedCategory: ErrorCategoryMissingUpstreamDependency,
+			expectedReason:   "NoStorageClass",
+		},
+		{
+			name:             "capacity failure is infrastructure",
+			pvc:              pendingPvc,
+			events:           []corev1.Event{warning("ProvisioningFailed", "failed to provision volume: insufficient capacity")},
+			expectedCategory: ErrorCategoryInfrastructure,
+			expectedReason:   "PvcProvisioningFailed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := GetPvcHealthWithEvents(tt.pvc, tt.events)
+
+			if tt.expectedCategory == ErrorCategoryUnknown && tt.expectedState != "" {
+				if len(health.Errors) != 0 {
+					t.Fatalf("expected no errors, got %v", health.Errors)
+				}
+				if health.GetState() != tt.expectedState {
+					t.Errorf("expected state %s, got %s", tt.expectedState, health.GetState())
+				}
+			} else {
+				if len(health.Errors) != 1 {
+					t.Fatalf("expected one error, got %v", health.Errors)
+				}
+				categorized := CategorizeError(health.Errors[0])
+				if categorized.Category() != tt.expectedCategory {
+					t.Errorf("expected category %s, got %s", tt.expectedCategory, categorized.Category())
+				}
+			}
+			if health.GetReason() != tt.expectedReason {
+				t.Errorf("expected reason %s, got %s", tt.expectedReason, health.GetReason())
+			}
+		})
+	}
+}
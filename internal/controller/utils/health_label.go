@@ -0,0 +1,74 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// HealthLabelValue maps an AIM status to the value of the LabelKeyHealth label.
+// Returns an empty string for states that do not carry a health label, such as
+// Pending and Progressing.
+func HealthLabelValue(status constants.AIMStatus) string {
+	switch status {
+	case constants.AIMStatusReady, constants.AIMStatusRunning:
+		return constants.LabelValueHealthReady
+	case constants.AIMStatusDegraded:
+		return constants.LabelValueHealthDegraded
+	case constants.AIMStatusFailed:
+		return constants.LabelValueHealthFailed
+	default:
+		return ""
+	}
+}
+
+// RecordHealthLabel sets the health label of the object to match its status, and removes it
+// for states without a health label. The object is only patched when the label changes, so
+// steady-state reconciles do not write to the API server.
+func RecordHealthLabel(ctx context.Context, c client.Client, obj client.Object, status constants.AIMStatus) error {
+	value := HealthLabelValue(status)
+	current, hasLabel := obj.GetLabels()[constants.LabelKeyHealth]
+	if (value == "" && !hasLabel) || (value != "" && current == value) {
+		return nil
+	}
+
+	var labelValue any
+	if value != "" {
+		labelValue = value
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{constants.LabelKeyHealth: labelValue},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch))
+}
@@ -0,0 +1,84 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func TestHealthLabelValue(t *testing.T) {
+	tests := map[constants.AIMStatus]string{
+		constants.AIMStatusReady:       constants.LabelValueHealthReady,
+		constants.AIMStatusRunning:     constants.LabelValueHealthReady,
+		constants.AIMStatusDegraded:    constants.LabelValueHealthDegraded,
+		constants.AIMStatusFailed:      constants.LabelValueHealthFailed,
+		constants.AIMStatusPending:     "",
+		constants.AIMStatusProgressing: "",
+	}
+	for status, want := range tests {
+		if got := HealthLabelValue(status); got != want {
+			t.Errorf("HealthLabelValue(%s) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestRecordHealthLabel(t *testing.T) {
+	obj := newTraceTestObject(nil)
+	cl := newTraceTestClient(obj)
+	ctx := context.Background()
+
+	if err := RecordHealthLabel(ctx, cl, obj, constants.AIMStatusFailed); err != nil {
+		t.Fatalf("RecordHealthLabel() error = %v", err)
+	}
+	stored := &testObject{}
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(obj), stored); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got := stored.GetLabels()[constants.LabelKeyHealth]; got != constants.LabelValueHealthFailed {
+		t.Errorf("health label = %q, want %q", got, constants.LabelValueHealthFailed)
+	}
+
+	if err := RecordHealthLabel(ctx, cl, obj, constants.AIMStatusProgressing); err != nil {
+		t.Fatalf("RecordHealthLabel() error = %v", err)
+	}
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(obj), stored); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if _, ok := stored.GetLabels()[constants.LabelKeyHealth]; ok {
+		t.Error("health label should be removed while the resource is progressing")
+	}
+}
+
+func TestRecordHealthLabel_Unchanged(t *testing.T) {
+	obj := newTraceTestObject(nil)
+	obj.Labels = map[string]string{constants.LabelKeyHealth: constants.LabelValueHealthReady}
+	// No client: an unchanged label must not be patched
+	if err := RecordHealthLabel(context.Background(), nil, obj, constants.AIMStatusRunning); err != nil {
+		t.Fatalf("RecordHealthLabel() error = %v", err)
+	}
+}
@@ -386,3 +386,46 @@ func TestComponentHealth_ChildRef(t *testing.T) {
 		t.Errorf("ChildRef.Name = %v, want foo-123", ch.ChildRef.Name)
 	}
 }
+
+func TestComponentHealth_WaitingOn(t *testing.T) {
+	ref := &ObjectRef{Kind: "AIMArtifact", Namespace: "default", Name: "llama-8b"}
+
+	upstream := ComponentHealth{Component: "Model", DependencyType: DependencyTypeUpstream, WaitingOn: ref}
+	if got := upstream.GetState(); got != constants.AIMStatusPending {
+		t.Errorf("GetState() = %v, want Pending for an upstream wait", got)
+	}
+	if got := upstream.GetReason(); got != "WaitingForAIMArtifact" {
+		t.Errorf("GetReason() = %v, want WaitingForAIMArtifact", got)
+	}
+	if got := upstream.GetMessage(); got != "Waiting on AIMArtifact default/llama-8b" {
+		t.Errorf("GetMessage() = %q, want 'Waiting on AIMArtifact default/llama-8b'", got)
+	}
+
+	downstream := ComponentHealth{Component: "Cache", DependencyType: DependencyTypeDownstream, WaitingOn: ref}
+	if got := downstream.GetState(); got != constants.AIMStatusProgressing {
+		t.Errorf("GetState() = %v, want Progressing for a downstream wait", got)
+	}
+
+	withError := ComponentHealth{
+		Component: "Cache",
+		WaitingOn: ref,
+		Errors:    []error{NewMissingDownstreamDependencyError("NotFound", "Cache not found", nil)},
+	}
+	if got := withError.GetMessage(); got != "Cache not found: waiting on AIMArtifact default/llama-8b" {
+		t.Errorf("GetMessage() = %q, want the error message with the waited-on object", got)
+	}
+
+	explicit := ComponentHealth{Component: "Cache", State: constants.AIMStatusProgressing, Message: "Downloading", WaitingOn: ref}
+	if got := explicit.GetMessage(); got != "Downloading" {
+		t.Errorf("GetMessage() = %q, want the explicit message", got)
+	}
+
+	ready := ComponentHealth{Component: "Cache", State: constants.AIMStatusReady, WaitingOn: ref}
+	if got := ready.GetMessage(); got != "" {
+		t.Errorf("GetMessage() = %q, want no waiting message for a ready component", got)
+	}
+
+	if got := (ObjectRef{Kind: "AIMClusterModel", Name: "llama"}).String(); got != "AIMClusterModel llama" {
+		t.Errorf("String() = %q, want 'AIMClusterModel llama' for a cluster-scoped object", got)
+	}
+}
@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// namespacedOnlyClient hides cluster-scoped resources from controllers when the operator runs
+// in namespaced-only mode, where it has no cluster RBAC to read them:
+//   - Get of a cluster-scoped object returns NotFound, List returns an empty list
+//   - Get of a Namespace returns an active namespace without labels
+//   - Nodes are passed through, since GPU-aware scheduling needs them
+//
+// Callers already treat missing cluster configs, models and templates as absent.
+type namespacedOnlyClient struct {
+	client.Client
+}
+
+// NewNamespacedOnlyClient wraps c so that cluster-scoped resources other than Nodes are never
+// read from the API server.
+func NewNamespacedOnlyClient(c client.Client) client.Client {
+	return &namespacedOnlyClient{Client: c}
+}
+
+func (c *namespacedOnlyClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if !c.hidden(obj) {
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+	if ns, ok := obj.(*corev1.Namespace); ok {
+		*ns = corev1.Namespace{}
+		ns.Name = key.Name
+		ns.Status.Phase = corev1.NamespaceActive
+		return nil
+	}
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return err
+	}
+	return apierrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, key.Name)
+}
+
+func (c *namespacedOnlyClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if !c.hidden(list) {
+		return c.Client.List(ctx, list, opts...)
+	}
+	return meta.SetList(list, nil)
+}
+
+// hidden returns true for cluster-scoped kinds other than Nodes. Objects whose scope cannot be
+// determined are passed through, so errors surface from the API server as usual.
+func (c *namespacedOnlyClient) hidden(obj runtime.Object) bool {
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return false
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	if gvk.Group == "" && gvk.Kind == "Node" {
+		return false
+	}
+	mapping, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false
+	}
+	return mapping.Scope.Name() == meta.RESTScopeNameRoot
+}
@@ -0,0 +1,100 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+func newNamespacedOnlyTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	if err := aimv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, obj := range []client.Object{&corev1.Node{}, &corev1.Namespace{}, &aimv1alpha1.AIMClusterRuntimeConfig{}} {
+		gvks, _, _ := scheme.ObjectKinds(obj)
+		mapper.Add(gvks[0], meta.RESTScopeRoot)
+	}
+	for _, obj := range []client.Object{&corev1.ConfigMap{}, &aimv1alpha1.AIMRuntimeConfig{}} {
+		gvks, _, _ := scheme.ObjectKinds(obj)
+		mapper.Add(gvks[0], meta.RESTScopeNamespace)
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).WithObjects(objs...).Build()
+	return NewNamespacedOnlyClient(c)
+}
+
+func TestNamespacedOnlyClient(t *testing.T) {
+	ctx := context.Background()
+	c := newNamespacedOnlyTestClient(t,
+		&aimv1alpha1.AIMClusterRuntimeConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&aimv1alpha1.AIMRuntimeConfig{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "team"}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "gpu-node"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team", Labels: map[string]string{"a": "b"}}},
+	)
+
+	err := c.Get(ctx, client.ObjectKey{Name: "default"}, &aimv1alpha1.AIMClusterRuntimeConfig{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected cluster runtime config to be hidden, got %v", err)
+	}
+
+	var clusterConfigs aimv1alpha1.AIMClusterRuntimeConfigList
+	if err := c.List(ctx, &clusterConfigs); err != nil || len(clusterConfigs.Items) != 0 {
+		t.Errorf("expected empty cluster runtime config list, got %d items (err %v)", len(clusterConfigs.Items), err)
+	}
+
+	if err := c.Get(ctx, client.ObjectKey{Name: "default", Namespace: "team"}, &aimv1alpha1.AIMRuntimeConfig{}); err != nil {
+		t.Errorf("expected namespaced runtime config to be readable, got %v", err)
+	}
+
+	var nodes corev1.NodeList
+	if err := c.List(ctx, &nodes); err != nil || len(nodes.Items) != 1 {
+		t.Errorf("expected nodes to pass through, got %d items (err %v)", len(nodes.Items), err)
+	}
+
+	var ns corev1.Namespace
+	if err := c.Get(ctx, client.ObjectKey{Name: "team"}, &ns); err != nil {
+		t.Fatalf("expected synthesized namespace, got %v", err)
+	}
+	if ns.Name != "team" || ns.Status.Phase != corev1.NamespaceActive || len(ns.Labels) != 0 {
+		t.Errorf("expected an active namespace without labels, got %+v", ns)
+	}
+}
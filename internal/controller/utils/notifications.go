@@ -0,0 +1,235 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// notificationTimeout bounds a webhook delivery, including its retries. Deliveries run in
+// the background, so a slow receiver never delays reconciliation.
+const notificationTimeout = 10 * time.Second
+
+// Notification is the JSON payload posted to notification webhooks.
+type Notification struct {
+	Event     aimv1alpha1.AIMNotificationEvent `json:"event"`
+	Kind      string                           `json:"kind"`
+	Namespace string                           `json:"namespace,omitempty"`
+	Name      string                           `json:"name"`
+	Reason    string                           `json:"reason"`
+	Message   string                           `json:"message"`
+	Timestamp metav1.Time                      `json:"timestamp"`
+
+	// FailedConditions lists the False component conditions that explain the transition
+	FailedConditions []NotificationCondition `json:"failedConditions,omitempty"`
+
+	// Text is a one-line summary, the field Slack incoming webhooks display
+	Text string `json:"text"`
+}
+
+// NotificationCondition is a condition summary included in notifications.
+type NotificationCondition struct {
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+	Message string `json:"message,omitempty"`
+}
+
+// Notifier delivers notifications to a webhook URL.
+type Notifier interface {
+	Notify(ctx context.Context, url string, notification Notification) error
+}
+
+// WebhookNotifier posts notifications as JSON over HTTP.
+type WebhookNotifier struct {
+	Client *http.Client
+}
+
+// Notify posts the notification and fails on non-2xx responses.
+// Transient failures are retried within the deadline of ctx.
+func (n WebhookNotifier) Notify(ctx context.Context, url string, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	httpClient := n.Client
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return utils.Retry(ctx, utils.DefaultRetryPolicy, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return &utils.HTTPStatusError{
+				StatusCode: resp.StatusCode,
+				Message:    fmt.Sprintf("webhook returned status %d", resp.StatusCode),
+			}
+		}
+		return nil
+	})
+}
+
+// defaultNotifier is used by pipelines that do not set a Notifier.
+var defaultNotifier Notifier = WebhookNotifier{Client: &http.Client{Timeout: notificationTimeout}}
+
+// BuildNotifications derives the notifications for the condition transitions of one reconcile.
+// Only transitions of Ready to False produce notifications: auth and invalid spec failures for
+// all kinds, and failures of cache resources (AIMTemplateCache, AIMArtifact).
+func BuildNotifications(
+	kind string,
+	obj client.Object,
+	transitions []ConditionTransition,
+	conditions []metav1.Condition,
+	status constants.AIMStatus,
+	now time.Time,
+) []Notification {
+	var ready *ConditionTransition
+	for i := range transitions {
+		if transitions[i].New != nil && transitions[i].New.Type == ConditionTypeReady {
+			ready = &transitions[i]
+			break
+		}
+	}
+	if ready == nil || ready.New.Status != metav1.ConditionFalse {
+		return nil
+	}
+
+	var event aimv1alpha1.AIMNotificationEvent
+	switch {
+	case ready.New.Reason == ReasonAuthError:
+		event = aimv1alpha1.NotificationEventAuthError
+	case ready.New.Reason == ReasonInvalidSpec:
+		event = aimv1alpha1.NotificationEventInvalidSpec
+	case status == constants.AIMStatusFailed && (kind == "AIMTemplateCache" || kind == "AIMArtifact"):
+		event = aimv1alpha1.NotificationEventCacheFailed
+	default:
+		return nil
+	}
+
+	notification := Notification{
+		Event:     event,
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Reason:    ready.New.Reason,
+		Message:   ready.New.Message,
+		Timestamp: metav1.NewTime(now),
+	}
+	for _, cond := range conditions {
+		if cond.Status != metav1.ConditionFalse || isFrameworkCondition(cond.Type) {
+			continue
+		}
+		notification.FailedConditions = append(notification.FailedConditions, NotificationCondition{
+			Type:    cond.Type,
+			Reason:  cond.Reason,
+			Message: cond.Message,
+		})
+	}
+
+	name := obj.GetName()
+	if obj.GetNamespace() != "" {
+		name = obj.GetNamespace() + "/" + name
+	}
+	notification.Text = fmt.Sprintf("[%s] %s %s: %s", event, kind, name, ready.New.Message)
+	if len(notification.FailedConditions) > 0 {
+		first := notification.FailedConditions[0]
+		notification.Text = fmt.Sprintf("[%s] %s %s: %s (%s)", event, kind, name, first.Message, first.Reason)
+	}
+	return []Notification{notification}
+}
+
+// isFrameworkCondition returns true for the conditions set by the state engine itself.
+func isFrameworkCondition(condType string) bool {
+	switch condType {
+	case ConditionTypeReady, ConditionTypeAuthValid, ConditionTypeConfigValid, ConditionTypeDependenciesReachable:
+		return true
+	}
+	return false
+}
+
+// webhookMatches returns true if the webhook subscribes to the notification.
+func webhookMatches(webhook aimv1alpha1.AIMNotificationWebhook, notification Notification) bool {
+	if len(webhook.Events) > 0 && !slices.Contains(webhook.Events, notification.Event) {
+		return false
+	}
+	if len(webhook.Kinds) > 0 && !slices.Contains(webhook.Kinds, notification.Kind) {
+		return false
+	}
+	return true
+}
+
+// SendNotifications delivers the notifications to all matching webhooks in the background.
+func SendNotifications(
+	ctx context.Context,
+	notifier Notifier,
+	config *aimv1alpha1.AIMRuntimeConfigCommon,
+	notifications []Notification,
+) {
+	if config == nil || config.Notifications == nil || len(notifications) == 0 {
+		return
+	}
+	if notifier == nil {
+		notifier = defaultNotifier
+	}
+	logger := log.FromContext(ctx)
+
+	for _, webhook := range config.Notifications.Webhooks {
+		for _, notification := range notifications {
+			if !webhookMatches(webhook, notification) {
+				continue
+			}
+			go func(name, url string, notification Notification) {
+				sendCtx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+				defer cancel()
+				if err := notifier.Notify(sendCtx, url, notification); err != nil {
+					logger.Error(err, "failed to send notification", "webhook", name, "event", notification.Event)
+					return
+				}
+				logger.V(1).Info("sent notification", "webhook", name, "event", notification.Event)
+			}(webhook.Name, webhook.URL, notification)
+		}
+	}
+}
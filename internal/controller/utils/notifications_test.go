@@ -0,0 +1,239 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func readyTransition(old *metav1.Condition, reason string) ConditionTransition {
+	return ConditionTransition{
+		Old: old,
+		New: &metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: reason, Message: "not ready"},
+	}
+}
+
+func TestBuildNotifications(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	wasReady := &metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionTrue}
+	obj := &aimv1alpha1.AIMService{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "ns"}}
+	conditions := []metav1.Condition{
+		{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: ReasonInvalidSpec},
+		{Type: ConditionTypeConfigValid, Status: metav1.ConditionFalse, Reason: ReasonInvalidSpec},
+		{Type: "TemplateReady", Status: metav1.ConditionFalse, Reason: "TemplateNamespaceDenied", Message: "denied"},
+		{Type: "ModelReady", Status: metav1.ConditionTrue, Reason: "Resolved"},
+	}
+
+	tests := []struct {
+		name        string
+		kind        string
+		transitions []ConditionTransition
+		status      constants.AIMStatus
+		expected    aimv1alpha1.AIMNotificationEvent
+	}{
+		{
+			name:        "invalid spec",
+			kind:        "AIMService",
+			transitions: []ConditionTransition{readyTransition(wasReady, ReasonInvalidSpec)},
+			status:      constants.AIMStatusFailed,
+			expected:    aimv1alpha1.NotificationEventInvalidSpec,
+		},
+		{
+			name:        "auth error on new object",
+			kind:        "AIMService",
+			transitions: []ConditionTransition{readyTransition(nil, ReasonAuthError)},
+			status:      constants.AIMStatusFailed,
+			expected:    aimv1alpha1.NotificationEventAuthError,
+		},
+		{
+			name:        "cache failed",
+			kind:        "AIMTemplateCache",
+			transitions: []ConditionTransition{readyTransition(wasReady, "ArtifactFailed")},
+			status:      constants.AIMStatusFailed,
+			expected:    aimv1alpha1.NotificationEventCacheFailed,
+		},
+		{
+			name:        "failed service is not a cache failure",
+			kind:        "AIMService",
+			transitions: []ConditionTransition{readyTransition(wasReady, "InferenceServiceFailed")},
+			status:      constants.AIMStatusFailed,
+		},
+		{
+			name:        "progressing cache",
+			kind:        "AIMTemplateCache",
+			transitions: []ConditionTransition{readyTransition(wasReady, ReasonProgressing)},
+			status:      constants.AIMStatusProgressing,
+		},
+		{
+			name: "ready became true",
+			kind: "AIMService",
+			transitions: []ConditionTransition{{
+				New: &metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: ReasonAllComponentsReady},
+			}},
+			status: constants.AIMStatusReady,
+		},
+		{
+			name: "no ready transition",
+			kind: "AIMService",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications := BuildNotifications(tt.kind, obj, tt.transitions, conditions, tt.status, now)
+			if tt.expected == "" {
+				if len(notifications) != 0 {
+					t.Fatalf("expected no notifications, got %+v", notifications)
+				}
+				return
+			}
+			if len(notifications) != 1 {
+				t.Fatalf("expected 1 notification, got %d", len(notifications))
+			}
+			n := notifications[0]
+			if n.Event != tt.expected || n.Kind != tt.kind || n.Name != "svc" || n.Namespace != "ns" {
+				t.Errorf("unexpected notification %+v", n)
+			}
+			if len(n.FailedConditions) != 1 || n.FailedConditions[0].Type != "TemplateReady" {
+				t.Errorf("expected only TemplateReady as failed condition, got %+v", n.FailedConditions)
+			}
+			if n.Text == "" {
+				t.Error("expected summary text")
+			}
+		})
+	}
+}
+
+func TestWebhookMatches(t *testing.T) {
+	notification := Notification{Event: aimv1alpha1.NotificationEventCacheFailed, Kind: "AIMArtifact"}
+
+	tests := []struct {
+		name     string
+		webhook  aimv1alpha1.AIMNotificationWebhook
+		expected bool
+	}{
+		{name: "no filters", webhook: aimv1alpha1.AIMNotificationWebhook{}, expected: true},
+		{
+			name:     "matching event",
+			webhook:  aimv1alpha1.AIMNotificationWebhook{Events: []aimv1alpha1.AIMNotificationEvent{aimv1alpha1.NotificationEventCacheFailed}},
+			expected: true,
+		},
+		{
+			name:    "other event",
+			webhook: aimv1alpha1.AIMNotificationWebhook{Events: []aimv1alpha1.AIMNotificationEvent{aimv1alpha1.NotificationEventAuthError}},
+		},
+		{
+			name:    "other kind",
+			webhook: aimv1alpha1.AIMNotificationWebhook{Kinds: []string{"AIMService"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := webhookMatches(tt.webhook, notification); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	received := make(chan Notification, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		var n Notification
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- n
+	}))
+	defer server.Close()
+
+	notifier := WebhookNotifier{Client: server.Client()}
+	err := notifier.Notify(context.Background(), server.URL, Notification{Event: aimv1alpha1.NotificationEventInvalidSpec, Text: "hello"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := <-received; n.Event != aimv1alpha1.NotificationEventInvalidSpec || n.Text != "hello" {
+		t.Errorf("unexpected payload %+v", n)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	if err := notifier.Notify(context.Background(), failing.URL, Notification{}); err == nil {
+		t.Error("expected error for non-2xx response")
+	}
+}
+
+type recordingNotifier struct {
+	urls chan string
+}
+
+func (r recordingNotifier) Notify(_ context.Context, url string, _ Notification) error {
+	r.urls <- url
+	return nil
+}
+
+func TestSendNotifications(t *testing.T) {
+	notifier := recordingNotifier{urls: make(chan string, 2)}
+	config := &aimv1alpha1.AIMRuntimeConfigCommon{
+		Notifications: &aimv1alpha1.AIMNotificationsConfig{
+			Webhooks: []aimv1alpha1.AIMNotificationWebhook{
+				{Name: "all", URL: "https://hooks.example.com/all"},
+				{Name: "auth", URL: "https://hooks.example.com/auth", Events: []aimv1alpha1.AIMNotificationEvent{aimv1alpha1.NotificationEventAuthError}},
+			},
+		},
+	}
+
+	SendNotifications(context.Background(), notifier, config, []Notification{{Event: aimv1alpha1.NotificationEventInvalidSpec}})
+
+	select {
+	case url := <-notifier.urls:
+		if url != "https://hooks.example.com/all" {
+			t.Errorf("unexpected webhook %s", url)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification was not sent")
+	}
+	select {
+	case url := <-notifier.urls:
+		t.Errorf("unexpected second notification to %s", url)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,154 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+const (
+	// ReconcilePauseConfigMapName is the ConfigMap in the operator namespace that pauses
+	// controllers cluster-wide. Each data key names a controller, e.g. "service: true".
+	ReconcilePauseConfigMapName = "aim-reconcile-pause"
+
+	// PauseAllControllers is the ConfigMap key that pauses every controller.
+	PauseAllControllers = "all"
+
+	// ConditionTypeReconcilePaused is True while the controller of the resource is paused
+	// cluster-wide. Status is still updated, but nothing is applied or deleted.
+	ConditionTypeReconcilePaused = "ReconcilePaused"
+
+	// ReasonControllerPaused is the ReconcilePaused reason
+	ReasonControllerPaused = "ControllerPaused"
+
+	// pausedRecheckInterval is how often a paused resource is re-checked. Removing the pause
+	// does not trigger a watch event on the resources, so they pick up where they left off.
+	pausedRecheckInterval = time.Minute
+)
+
+// PauseSwitch is a kill switch for incident response. It watches the ReconcilePauseConfigMapName
+// ConfigMap and reports which controllers must not apply or delete anything. It is added to the
+// manager as a runnable and passed to the pipelines.
+type PauseSwitch struct {
+	clientset kubernetes.Interface
+	namespace string
+
+	mu     sync.RWMutex
+	paused map[string]bool
+}
+
+// NewPauseSwitch creates a switch that watches the pause ConfigMap in the given namespace.
+func NewPauseSwitch(clientset kubernetes.Interface, namespace string) *PauseSwitch {
+	return &PauseSwitch{clientset: clientset, namespace: namespace}
+}
+
+// IsPaused reports whether the named controller is paused. A nil switch pauses nothing.
+func (s *PauseSwitch) IsPaused(controllerName string) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.paused[controllerName] || s.paused[PauseAllControllers]
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica follows the
+// switch, so a newly elected leader does not resume reconciling.
+func (s *PauseSwitch) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable. Only the pause ConfigMap is watched, not all ConfigMaps.
+func (s *PauseSwitch) Start(ctx context.Context) error {
+	factory := informers.NewSharedInformerFactoryWithOptions(s.clientset, 0,
+		informers.WithNamespace(s.namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", ReconcilePauseConfigMapName).String()
+		}))
+	informer := factory.Core().V1().ConfigMaps().Informer()
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj any) { s.update(ctx, obj) },
+		UpdateFunc: func(_, obj any) { s.update(ctx, obj) },
+		DeleteFunc: func(any) { s.set(ctx, nil) },
+	}); err != nil {
+		return fmt.Errorf("failed to watch the %s ConfigMap: %w", ReconcilePauseConfigMapName, err)
+	}
+	factory.Start(ctx.Done())
+	<-ctx.Done()
+	factory.Shutdown()
+	return nil
+}
+
+func (s *PauseSwitch) update(ctx context.Context, obj any) {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok || configMap.Name != ReconcilePauseConfigMapName {
+		return
+	}
+	s.set(ctx, parsePausedControllers(configMap.Data))
+}
+
+// set replaces the paused controllers and logs when they change.
+func (s *PauseSwitch) set(ctx context.Context, paused map[string]bool) {
+	s.mu.Lock()
+	changed := !maps.Equal(s.paused, paused)
+	s.paused = paused
+	s.mu.Unlock()
+	if changed {
+		logf.FromContext(ctx).WithName("pause-switch").Info("Paused controllers changed",
+			"configMap", s.namespace+"/"+ReconcilePauseConfigMapName,
+			"controllers", slices.Sorted(maps.Keys(paused)))
+	}
+}
+
+// parsePausedControllers returns the controllers whose key is set to a true value.
+// Keys with other values, e.g. "false", leave the controller running.
+func parsePausedControllers(data map[string]string) map[string]bool {
+	paused := map[string]bool{}
+	for name, value := range data {
+		if enabled, err := strconv.ParseBool(value); err == nil && enabled {
+			paused[name] = true
+		}
+	}
+	return paused
+}
+
+// pausedMessage describes the pause for the ReconcilePaused condition.
+func (s *PauseSwitch) pausedMessage() string {
+	return fmt.Sprintf("Apply and delete are paused by the ConfigMap %s/%s", s.namespace, ReconcilePauseConfigMapName)
+}
@@ -0,0 +1,148 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParsePausedControllers(t *testing.T) {
+	paused := parsePausedControllers(map[string]string{
+		"service": "true",
+		"model":   "false",
+		"cache":   "yes",
+		"all":     "1",
+	})
+	if len(paused) != 2 || !paused["service"] || !paused["all"] {
+		t.Errorf("expected service and all to be paused, got %v", paused)
+	}
+}
+
+func TestPauseSwitch_IsPaused(t *testing.T) {
+	var nilSwitch *PauseSwitch
+	if nilSwitch.IsPaused("service") {
+		t.Error("expected a nil switch to pause nothing")
+	}
+
+	s := NewPauseSwitch(nil, "aim-system")
+	s.set(context.Background(), map[string]bool{"service": true})
+	if !s.IsPaused("service") || s.IsPaused("model") {
+		t.Error("expected only the service controller to be paused")
+	}
+	s.set(context.Background(), map[string]bool{PauseAllControllers: true})
+	if !s.IsPaused("model") {
+		t.Error("expected all controllers to be paused")
+	}
+}
+
+func TestPauseSwitch_WatchesConfigMap(t *testing.T) {
+	clientset := kubefake.NewClientset()
+	s := NewPauseSwitch(clientset, "aim-system")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = s.Start(ctx) }()
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ReconcilePauseConfigMapName, Namespace: "aim-system"},
+		Data:       map[string]string{"service": "true"},
+	}
+	if _, err := clientset.CoreV1().ConfigMaps("aim-system").Create(ctx, configMap, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create ConfigMap: %v", err)
+	}
+	waitForPause(t, s, "service", true)
+
+	if err := clientset.CoreV1().ConfigMaps("aim-system").Delete(ctx, ReconcilePauseConfigMapName, metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("failed to delete ConfigMap: %v", err)
+	}
+	waitForPause(t, s, "service", false)
+}
+
+func waitForPause(t *testing.T, s *PauseSwitch, controllerName string, want bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for s.IsPaused(controllerName) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected IsPaused(%q) to become %v", controllerName, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPipeline_Run_ControllerPaused(t *testing.T) {
+	scheme := newPlanOrderScheme()
+	obj := newAdoptionOwner("")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(obj, newPlanOrderChild("old")).WithStatusSubresource(obj).Build()
+
+	var plan PlanResult
+	plan.Apply(newPlanOrderChild("isvc"))
+	plan.Delete(newPlanOrderChild("old"))
+	reconciler := &testReconcilerWithPlan{fetchResult: testFetch{ModelReady: true}, planResult: plan}
+
+	pauseSwitch := NewPauseSwitch(nil, "aim-system")
+	pauseSwitch.set(context.Background(), map[string]bool{"test": true})
+	applyClient := &selectiveFailingApplyClient{Client: fakeClient}
+	p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+		Client:         applyClient,
+		StatusClient:   fakeClient.Status(),
+		Recorder:       record.NewFakeRecorder(10),
+		Reconciler:     reconciler,
+		Scheme:         scheme,
+		ControllerName: "test",
+		PauseSwitch:    pauseSwitch,
+	}
+
+	result, err := p.Run(context.Background(), obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applyClient.applied) != 0 || !planOrderChildExists(t, fakeClient, "old") {
+		t.Errorf("expected nothing to be applied or deleted, applied %v", applyClient.applied)
+	}
+	paused := findCondition(obj.Status.Conditions, ConditionTypeReconcilePaused)
+	if paused == nil || paused.Status != metav1.ConditionTrue || paused.Reason != ReasonControllerPaused {
+		t.Fatalf("expected a ReconcilePaused condition, got %+v", paused)
+	}
+	if result.RequeueAfter != pausedRecheckInterval {
+		t.Errorf("expected a requeue after %v, got %v", pausedRecheckInterval, result.RequeueAfter)
+	}
+
+	pauseSwitch.set(context.Background(), nil)
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applyClient.applied) != 1 || planOrderChildExists(t, fakeClient, "old") {
+		t.Errorf("expected the plan to run once resumed, applied %v", applyClient.applied)
+	}
+	if cond := findCondition(obj.Status.Conditions, ConditionTypeReconcilePaused); cond != nil {
+		t.Errorf("expected the ReconcilePaused condition to be removed, got %+v", cond)
+	}
+}
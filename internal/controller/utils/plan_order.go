@@ -0,0 +1,185 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// deletionBarrierRecheckInterval is how soon a reconcile is retried while a later group of the
+// plan waits for deleted objects to disappear, e.g. while their finalizers run.
+const deletionBarrierRecheckInterval = 5 * time.Second
+
+// orderedDeleteResult summarizes a deletion phase.
+type orderedDeleteResult struct {
+	// deleted is the number of delete calls that succeeded
+	deleted int
+
+	// errs are the failed deletions of the last group that was handled
+	errs []error
+
+	// pending is true when a group's objects still exist, so later groups were not deleted
+	pending bool
+}
+
+// weightsOf returns the distinct weights of the objects in ascending order.
+func (pr *PlanResult) weightsOf(lists ...[]client.Object) []int {
+	var weights []int
+	for _, objs := range lists {
+		for _, obj := range objs {
+			if w := pr.weights[obj]; !slices.Contains(weights, w) {
+				weights = append(weights, w)
+			}
+		}
+	}
+	slices.Sort(weights)
+	return weights
+}
+
+// withWeight returns the objects with the given weight, keeping their planned order.
+func (pr *PlanResult) withWeight(objs []client.Object, weight int) []client.Object {
+	var group []client.Object
+	for _, obj := range objs {
+		if pr.weights[obj] == weight {
+			group = append(group, obj)
+		}
+	}
+	return group
+}
+
+// deleteInOrder deletes the objects in groups of ascending weight. A group is only deleted once
+// the previous groups were deleted without errors and their objects are gone.
+func deleteInOrder(ctx context.Context, c client.Client, pr *PlanResult, objs []client.Object) orderedDeleteResult {
+	var result orderedDeleteResult
+	weights := pr.weightsOf(objs)
+	for i, weight := range weights {
+		group := pr.withWeight(objs, weight)
+		for _, obj := range group {
+			if err := c.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				gvk := obj.GetObjectKind().GroupVersionKind()
+				key := client.ObjectKeyFromObject(obj)
+				result.errs = append(result.errs, fmt.Errorf("delete failed for %s %s/%s: %w", gvk.Kind, key.Namespace, key.Name, err))
+				continue
+			}
+			result.deleted++
+		}
+		if len(result.errs) > 0 {
+			return result
+		}
+		// The last group does not hold anything back, so it is not waited on
+		if i < len(weights)-1 && anyExists(ctx, c, group) {
+			result.pending = true
+			return result
+		}
+	}
+	return result
+}
+
+// anyExists reports whether any of the objects can still be read. Read errors other than
+// NotFound count as existing, so a barrier is never passed on uncertain state.
+func anyExists(ctx context.Context, c client.Client, objs []client.Object) bool {
+	for _, obj := range objs {
+		current, ok := obj.DeepCopyObject().(client.Object)
+		if !ok {
+			continue
+		}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); !apierrors.IsNotFound(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyInOrder applies owned and unowned objects in groups of ascending weight. All objects of
+// a group are applied even if some fail, but later groups are skipped once a group failed.
+// Returns the number of objects whose apply was attempted.
+func applyInOrder(
+	ctx context.Context,
+	c client.Client,
+	fieldOwner string,
+	scheme *runtime.Scheme,
+	pr *PlanResult,
+	owner client.Object,
+) (int, error) {
+	attempted := 0
+	for _, weight := range pr.weightsOf(pr.toApply, pr.toApplyWithoutOwnerRef) {
+		owned := pr.withWeight(pr.toApply, weight)
+		unowned := pr.withWeight(pr.toApplyWithoutOwnerRef, weight)
+		attempted += len(owned) + len(unowned)
+
+		// Apply owned resources (with owner references). Adopted objects take over the fields
+		// other managers set, e.g. kubectl for a manually created object.
+		var ownedErr, unownedErr error
+		adopted := slices.DeleteFunc(slices.Clone(owned), func(obj client.Object) bool { return !pr.adopt[obj] })
+		owned = slices.DeleteFunc(owned, func(obj client.Object) bool { return pr.adopt[obj] })
+		if len(owned) > 0 || len(adopted) > 0 {
+			ownedErr = errors.Join(
+				ApplyDesiredState(ctx, c, fieldOwner, scheme, owned, owner),
+				ApplyDesiredState(ctx, c, fieldOwner, scheme, adopted, owner, client.ForceOwnership),
+			)
+			if ownedErr != nil {
+				ownedErr = fmt.Errorf("failed to apply owned resources: %w", ownedErr)
+			}
+		}
+
+		// Apply unowned resources (without owner references), even if some owned ones failed
+		if len(unowned) > 0 {
+			unownedErr = ApplyDesiredState(ctx, c, fieldOwner, scheme, unowned, nil)
+			if unownedErr != nil {
+				unownedErr = fmt.Errorf("failed to apply unowned resources: %w", unownedErr)
+			}
+		}
+		if err := errors.Join(ownedErr, unownedErr); err != nil {
+			return attempted, err
+		}
+	}
+	return attempted, nil
+}
+
+// statusPatch is a planned patch of the status subresource of an object.
+type statusPatch struct {
+	obj   client.Object
+	patch client.Patch
+}
+
+// patchStatuses sends the status patches. Objects that no longer exist are skipped, e.g. a pod
+// that was deleted since it was fetched.
+func patchStatuses(ctx context.Context, c client.Client, patches []statusPatch) []error {
+	var errs []error
+	for _, p := range patches {
+		if err := c.Status().Patch(ctx, p.obj, p.patch); client.IgnoreNotFound(err) != nil {
+			gvk := p.obj.GetObjectKind().GroupVersionKind()
+			key := client.ObjectKeyFromObject(p.obj)
+			errs = append(errs, fmt.Errorf("status patch failed for %s %s/%s: %w", gvk.Kind, key.Namespace, key.Name, err))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,254 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newPlanOrderScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	scheme.AddKnownTypes(schema.GroupVersion{Group: "test.k8s.io", Version: "v1"}, &testObject{})
+	return scheme
+}
+
+func newPlanOrderChild(name string, finalizers ...string) *testObject {
+	return &testObject{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "test.k8s.io/v1", Kind: "TestObject"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Finalizers: finalizers},
+	}
+}
+
+func planOrderChildExists(t *testing.T, c client.Client, name string) bool {
+	t.Helper()
+	err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: name}, &testObject{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		t.Fatalf("failed to get %s: %v", name, err)
+	}
+	return err == nil
+}
+
+func TestDeleteInOrder_WaitsForEarlierGroups(t *testing.T) {
+	isvc := newPlanOrderChild("isvc", "serving.kserve.io/inferenceservice")
+	pvc := newPlanOrderChild("pvc")
+	c := fake.NewClientBuilder().WithScheme(newPlanOrderScheme()).WithObjects(isvc, pvc).Build()
+
+	var plan PlanResult
+	plan.Delete(newPlanOrderChild("pvc"), WithWeight(1))
+	plan.Delete(newPlanOrderChild("isvc"))
+
+	result := deleteInOrder(context.Background(), c, &plan, plan.GetToDelete())
+	if !result.pending || result.deleted != 1 || len(result.errs) != 0 {
+		t.Fatalf("expected the first group to hold back the second, got %+v", result)
+	}
+	if !planOrderChildExists(t, c, "pvc") {
+		t.Error("expected the claim to be kept while the InferenceService finalizes")
+	}
+
+	// Once the finalizer is removed the InferenceService is gone and the claim follows
+	current := &testObject{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(isvc), current); err != nil {
+		t.Fatalf("failed to get isvc: %v", err)
+	}
+	current.Finalizers = nil
+	if err := c.Update(context.Background(), current); err != nil {
+		t.Fatalf("failed to remove finalizer: %v", err)
+	}
+
+	result = deleteInOrder(context.Background(), c, &plan, plan.GetToDelete())
+	if result.pending || len(result.errs) != 0 {
+		t.Fatalf("expected all groups to be deleted, got %+v", result)
+	}
+	if planOrderChildExists(t, c, "pvc") {
+		t.Error("expected the claim to be deleted after the InferenceService")
+	}
+}
+
+func TestApplyInOrder_StopsAfterFailedGroup(t *testing.T) {
+	scheme := newPlanOrderScheme()
+	owner := newPlanOrderChild("owner")
+	c := &selectiveFailingApplyClient{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).Build(),
+		failName: "a-bad",
+	}
+
+	var plan PlanResult
+	plan.Apply(newPlanOrderChild("c-later"), WithWeight(1))
+	plan.Apply(newPlanOrderChild("a-bad"))
+	plan.ApplyWithoutOwnerRef(newPlanOrderChild("b-good"))
+
+	attempted, err := applyInOrder(context.Background(), c, "test", scheme, &plan, owner)
+	if err == nil {
+		t.Fatal("expected the failed apply to be reported")
+	}
+	if attempted != 2 {
+		t.Errorf("expected only the first group to be attempted, got %d", attempted)
+	}
+	if len(c.applied) != 1 || c.applied[0] != "b-good" {
+		t.Errorf("expected the rest of the failed group to be applied, got %v", c.applied)
+	}
+
+	c.failName = ""
+	c.applied = nil
+	if _, err := applyInOrder(context.Background(), c, "test", scheme, &plan, owner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(c.applied) != 3 || c.applied[2] != "c-later" {
+		t.Errorf("expected the later group to be applied last, got %v", c.applied)
+	}
+}
+
+func TestPipeline_Run_DeleteAfterApply(t *testing.T) {
+	scheme := newPlanOrderScheme()
+	obj := &testObject{
+		TypeMeta: metav1.TypeMeta{APIVersion: "test.k8s.io/v1", Kind: "TestObject"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-obj",
+			Namespace:         "default",
+			CreationTimestamp: metav1.Now(),
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(obj, newPlanOrderChild("old-cache")).WithStatusSubresource(obj).Build()
+
+	var plan PlanResult
+	plan.Apply(newPlanOrderChild("new-cache"))
+	plan.DeleteAfterApply(newPlanOrderChild("old-cache"))
+	reconciler := &testReconcilerWithPlan{fetchResult: testFetch{ModelReady: true}, planResult: plan}
+
+	applyClient := &selectiveFailingApplyClient{Client: fakeClient, failName: "new-cache"}
+	p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+		Client:         applyClient,
+		StatusClient:   fakeClient.Status(),
+		Recorder:       record.NewFakeRecorder(10),
+		Reconciler:     reconciler,
+		Scheme:         scheme,
+		ControllerName: "test",
+	}
+
+	if _, err := p.Run(context.Background(), obj); err == nil {
+		t.Fatal("expected the apply failure to be returned")
+	}
+	if !planOrderChildExists(t, fakeClient, "old-cache") {
+		t.Error("expected the old cache to be kept while the new one fails to apply")
+	}
+
+	applyClient.failName = ""
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if planOrderChildExists(t, fakeClient, "old-cache") {
+		t.Error("expected the old cache to be deleted once the new one applied")
+	}
+}
+
+func TestPatchStatuses_SkipsMissingObjects(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p-0", Namespace: "default"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).WithStatusSubresource(pod).Build()
+
+	var plan PlanResult
+	for _, name := range []string{"p-0", "gone"} {
+		original := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+		updated := original.DeepCopy()
+		updated.Status.Conditions = []corev1.PodCondition{{Type: "example.com/Gate", Status: corev1.ConditionTrue}}
+		plan.PatchStatus(updated, client.StrategicMergeFrom(original))
+	}
+
+	if errs := patchStatuses(context.Background(), c, plan.toPatchStatus); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	current := &corev1.Pod{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(pod), current); err != nil {
+		t.Fatalf("failed to get pod: %v", err)
+	}
+	if len(current.Status.Conditions) != 1 || current.Status.Conditions[0].Type != "example.com/Gate" {
+		t.Errorf("expected the condition to be patched, got %+v", current.Status.Conditions)
+	}
+}
+
+func TestPipeline_Run_ActionsRunAfterApply(t *testing.T) {
+	scheme := newPlanOrderScheme()
+	obj := newAdoptionOwner("")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj).WithStatusSubresource(obj).Build()
+
+	runs := 0
+	var plan PlanResult
+	plan.Apply(newPlanOrderChild("isvc"))
+	plan.Run(func(ctx context.Context, cm *ConditionManager) {
+		runs++
+		cm.MarkTrue("Reloaded", "ActionRan", "The action ran")
+	})
+	reconciler := &testReconcilerWithPlan{fetchResult: testFetch{ModelReady: true}, planResult: plan}
+
+	pauseSwitch := NewPauseSwitch(nil, "aim-system")
+	pauseSwitch.set(context.Background(), map[string]bool{"test": true})
+	applyClient := &selectiveFailingApplyClient{Client: fakeClient, failName: "isvc"}
+	p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+		Client:         applyClient,
+		StatusClient:   fakeClient.Status(),
+		Recorder:       record.NewFakeRecorder(10),
+		Reconciler:     reconciler,
+		Scheme:         scheme,
+		ControllerName: "test",
+		PauseSwitch:    pauseSwitch,
+	}
+
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if runs != 0 {
+		t.Error("expected the action to be skipped while the controller is paused")
+	}
+
+	pauseSwitch.set(context.Background(), nil)
+	if _, err := p.Run(context.Background(), obj); err == nil {
+		t.Fatal("expected the apply failure to be returned")
+	}
+	if runs != 0 {
+		t.Error("expected the action to be skipped when the apply failed")
+	}
+
+	applyClient.failName = ""
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if runs != 1 {
+		t.Fatalf("expected the action to run once the plan applied, ran %d times", runs)
+	}
+	if cond := findCondition(obj.Status.Conditions, "Reloaded"); cond == nil || cond.Reason != "ActionRan" {
+		t.Errorf("expected the condition set by the action in status, got %+v", cond)
+	}
+}
@@ -0,0 +1,234 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// adaptiveMinQPSFraction is the lowest fraction of the configured QPS that adaptive
+	// throttling backs off to.
+	adaptiveMinQPSFraction = 0.1
+
+	// adaptiveRecoveryInterval is how long the API server must not throttle before the client
+	// rate is raised again.
+	adaptiveRecoveryInterval = 10 * time.Second
+
+	// adaptiveRecoveryStep is the fraction of the configured QPS added back per recovery interval.
+	adaptiveRecoveryStep = 0.1
+)
+
+var (
+	// ClientQPSLimit is the current QPS limit of the API client under adaptive throttling.
+	ClientQPSLimit = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "aim_client_qps_limit",
+		Help: "Current QPS limit of the API client, lowered while the API server throttles requests.",
+	})
+
+	// ClientThrottledRequests counts the requests the API server rejected with 429 Too Many Requests.
+	ClientThrottledRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "aim_client_throttled_requests_total",
+		Help: "Number of API requests rejected with 429 Too Many Requests.",
+	})
+)
+
+// WorkqueueBucket is the overall token bucket of a controller workqueue.
+type WorkqueueBucket struct {
+	// QPS is the sustained rate of requeues.
+	QPS float64
+	// Burst is the number of requeues allowed at once.
+	Burst int
+}
+
+// WorkqueueRateLimits configures the rate limiter of the controller workqueues. A requeued
+// request waits for the longer of its per-item exponential backoff and the token bucket.
+type WorkqueueRateLimits struct {
+	// BaseDelay is the backoff after the first failure of a request.
+	BaseDelay time.Duration
+	// MaxDelay caps the per-item backoff.
+	MaxDelay time.Duration
+	// Bucket is the token bucket shared by all requests of a controller.
+	Bucket WorkqueueBucket
+	// Overrides replaces the token bucket of individual controllers, by controller name.
+	Overrides map[string]WorkqueueBucket
+}
+
+// DefaultWorkqueueRateLimits returns the limits of the controller-runtime default rate limiter.
+func DefaultWorkqueueRateLimits() WorkqueueRateLimits {
+	return WorkqueueRateLimits{
+		BaseDelay: 5 * time.Millisecond,
+		MaxDelay:  1000 * time.Second,
+		Bucket:    WorkqueueBucket{QPS: 10, Burst: 100},
+	}
+}
+
+// RateLimiter returns the rate limiter for the workqueue of the named controller.
+func (l WorkqueueRateLimits) RateLimiter(controller string) workqueue.TypedRateLimiter[reconcile.Request] {
+	bucket := l.Bucket
+	if override, ok := l.Overrides[controller]; ok {
+		bucket = override
+	}
+	return workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](l.BaseDelay, l.MaxDelay),
+		&workqueue.TypedBucketRateLimiter[reconcile.Request]{
+			Limiter: rate.NewLimiter(rate.Limit(bucket.QPS), bucket.Burst),
+		},
+	)
+}
+
+// ParseWorkqueueBucketOverrides parses comma-separated controller=qps:burst pairs,
+// for example "service=20:200,artifact=5:50".
+func ParseWorkqueueBucketOverrides(value string) (map[string]WorkqueueBucket, error) {
+	overrides := map[string]WorkqueueBucket{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		controller, limits, ok := strings.Cut(entry, "=")
+		if !ok || controller == "" {
+			return nil, fmt.Errorf("invalid rate limit %q: expected controller=qps:burst", entry)
+		}
+		qpsValue, burstValue, ok := strings.Cut(limits, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: expected controller=qps:burst", entry)
+		}
+		qps, err := strconv.ParseFloat(qpsValue, 64)
+		if err != nil || qps <= 0 {
+			return nil, fmt.Errorf("invalid rate limit %q: qps must be a positive number", entry)
+		}
+		burst, err := strconv.Atoi(burstValue)
+		if err != nil || burst <= 0 {
+			return nil, fmt.Errorf("invalid rate limit %q: burst must be a positive integer", entry)
+		}
+		overrides[controller] = WorkqueueBucket{QPS: qps, Burst: burst}
+	}
+	return overrides, nil
+}
+
+// AdaptiveRateLimiter is a client-side rate limiter for API requests that backs off when the API
+// server answers 429 Too Many Requests. Each throttled response halves the rate, down to a tenth
+// of the configured QPS. After a quiet recovery interval, the rate is raised again in steps of a
+// tenth until it is back at the configured QPS.
+type AdaptiveRateLimiter struct {
+	limiter *rate.Limiter
+	maxQPS  float64
+	minQPS  float64
+	now     func() time.Time
+
+	mu         sync.Mutex
+	lastChange time.Time
+}
+
+// NewAdaptiveRateLimiter creates a limiter starting at the configured QPS and burst.
+func NewAdaptiveRateLimiter(qps float32, burst int) *AdaptiveRateLimiter {
+	l := &AdaptiveRateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(qps), burst),
+		maxQPS:  float64(qps),
+		minQPS:  float64(qps) * adaptiveMinQPSFraction,
+		now:     time.Now,
+	}
+	ClientQPSLimit.Set(l.maxQPS)
+	return l
+}
+
+// Configure makes the REST config use the limiter for all its clients and report responses to it.
+func (l *AdaptiveRateLimiter) Configure(cfg *rest.Config) {
+	cfg.RateLimiter = l
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &adaptiveRoundTripper{next: rt, limiter: l}
+	})
+}
+
+// TryAccept implements flowcontrol.RateLimiter.
+func (l *AdaptiveRateLimiter) TryAccept() bool {
+	return l.limiter.Allow()
+}
+
+// Accept implements flowcontrol.RateLimiter.
+func (l *AdaptiveRateLimiter) Accept() {
+	_ = l.limiter.Wait(context.Background())
+}
+
+// Stop implements flowcontrol.RateLimiter.
+func (l *AdaptiveRateLimiter) Stop() {}
+
+// QPS implements flowcontrol.RateLimiter.
+func (l *AdaptiveRateLimiter) QPS() float32 {
+	return float32(l.limiter.Limit())
+}
+
+// Wait implements flowcontrol.RateLimiter.
+func (l *AdaptiveRateLimiter) Wait(ctx context.Context) error {
+	return l.limiter.Wait(ctx)
+}
+
+// observe adjusts the rate to the status code of a response.
+func (l *AdaptiveRateLimiter) observe(statusCode int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	current := float64(l.limiter.Limit())
+
+	if statusCode == http.StatusTooManyRequests {
+		ClientThrottledRequests.Inc()
+		l.setLimit(max(current/2, l.minQPS), now)
+		return
+	}
+	if current < l.maxQPS && now.Sub(l.lastChange) >= adaptiveRecoveryInterval {
+		l.setLimit(min(current+l.maxQPS*adaptiveRecoveryStep, l.maxQPS), now)
+	}
+}
+
+func (l *AdaptiveRateLimiter) setLimit(qps float64, now time.Time) {
+	l.limiter.SetLimitAt(now, rate.Limit(qps))
+	l.lastChange = now
+	ClientQPSLimit.Set(qps)
+}
+
+// adaptiveRoundTripper reports the status code of every response to the limiter.
+type adaptiveRoundTripper struct {
+	next    http.RoundTripper
+	limiter *AdaptiveRateLimiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *adaptiveRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err == nil {
+		rt.limiter.observe(resp.StatusCode)
+	}
+	return resp, err
+}
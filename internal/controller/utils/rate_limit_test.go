@@ -0,0 +1,104 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package controllerutils
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestParseWorkqueueBucketOverrides(t *testing.T) {
+	overrides, err := ParseWorkqueueBucketOverrides(" service=20:200, artifact=0.5:5 ,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(overrides) != 2 ||
+		overrides["service"] != (WorkqueueBucket{QPS: 20, Burst: 200}) ||
+		overrides["artifact"] != (WorkqueueBucket{QPS: 0.5, Burst: 5}) {
+		t.Errorf("unexpected overrides %+v", overrides)
+	}
+
+	for _, value := range []string{"service", "service=20", "=20:200", "service=x:200", "service=20:0", "service=-1:10"} {
+		if _, err := ParseWorkqueueBucketOverrides(value); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
+
+func TestWorkqueueRateLimits_Override(t *testing.T) {
+	limits := DefaultWorkqueueRateLimits()
+	limits.BaseDelay = time.Millisecond
+	limits.Bucket = WorkqueueBucket{QPS: 1000, Burst: 1000}
+	limits.Overrides = map[string]WorkqueueBucket{"service": {QPS: 1, Burst: 1}}
+
+	// The default bucket has room, so the per-item backoff decides
+	limiter := limits.RateLimiter("artifact")
+	if delay := limiter.When(request("a")); delay != time.Millisecond {
+		t.Errorf("expected the base delay, got %s", delay)
+	}
+
+	// The overridden bucket is exhausted after one request, so it delays the next one
+	limiter = limits.RateLimiter("service")
+	limiter.When(request("a"))
+	if delay := limiter.When(request("b")); delay < 500*time.Millisecond {
+		t.Errorf("expected the token bucket to delay beyond the base delay, got %s", delay)
+	}
+}
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewAdaptiveRateLimiter(20, 30)
+	l.now = func() time.Time { return now }
+
+	l.observe(http.StatusTooManyRequests)
+	if qps := l.QPS(); qps != 10 {
+		t.Errorf("expected the rate to halve to 10, got %v", qps)
+	}
+	for range 5 {
+		l.observe(http.StatusTooManyRequests)
+	}
+	if qps := l.QPS(); qps != 2 {
+		t.Errorf("expected the rate to stop at a tenth of the configured QPS, got %v", qps)
+	}
+
+	// Successes only raise the rate once the recovery interval has passed
+	l.observe(http.StatusOK)
+	if qps := l.QPS(); qps != 2 {
+		t.Errorf("expected no recovery within the interval, got %v", qps)
+	}
+	now = now.Add(adaptiveRecoveryInterval)
+	l.observe(http.StatusOK)
+	if qps := l.QPS(); qps != 4 {
+		t.Errorf("expected the rate to recover by a tenth of the configured QPS, got %v", qps)
+	}
+
+	for range 20 {
+		now = now.Add(adaptiveRecoveryInterval)
+		l.observe(http.StatusOK)
+	}
+	if limit := l.limiter.Limit(); limit != rate.Limit(20) {
+		t.Errorf("expected the rate to recover to the configured QPS, got %v", limit)
+	}
+}
@@ -0,0 +1,398 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// rbacAuditRetryInterval is how often the audit runs again while permissions are missing,
+// so that readiness recovers once the RBAC is fixed.
+const rbacAuditRetryInterval = 5 * time.Minute
+
+// rbacCheckerMaxListed bounds the missing permissions listed in the readiness check message.
+const rbacCheckerMaxListed = 5
+
+// RBACMissingPermissions reports the permissions the audit found missing, 1 per verb and resource.
+// Optional permissions are reported too, so that disabled features are visible on dashboards.
+var RBACMissingPermissions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "aim_rbac_missing_permissions",
+	Help: "Permissions the operator needs but is not granted, by API group, resource and verb.",
+}, []string{"group", "resource", "verb", "optional"})
+
+// RBACScope determines where a permission is checked.
+type RBACScope int
+
+const (
+	// RBACScopeNamespaced permissions are checked in every watched namespace, or cluster-wide
+	// when the operator watches all namespaces.
+	RBACScopeNamespaced RBACScope = iota
+
+	// RBACScopeCluster permissions are checked cluster-wide and not needed in namespaced-only mode.
+	RBACScopeCluster
+
+	// RBACScopeClusterAlways permissions are checked cluster-wide, also in namespaced-only mode.
+	RBACScopeClusterAlways
+
+	// RBACScopeOperatorNamespace permissions are only needed in the operator namespace.
+	RBACScopeOperatorNamespace
+)
+
+// RBACPermission is a set of verbs the operator needs on some resources.
+type RBACPermission struct {
+	Group string
+	// Resources may name a subresource, e.g. "pods/log".
+	Resources []string
+	// Name restricts the permission to a single object.
+	Name  string
+	Verbs []string
+	Scope RBACScope
+	// Optional permissions only disable a feature when missing; they do not fail readiness.
+	Optional bool
+}
+
+var (
+	verbsReadOnly = []string{"get", "list", "watch"}
+	verbsManage   = []string{"create", "delete", "get", "list", "patch", "update", "watch"}
+)
+
+// RequiredRBACPermissions mirrors the manager ClusterRole generated from the kubebuilder markers.
+// Keep it in sync when a marker changes; a test compares it with config/rbac/role.yaml.
+var RequiredRBACPermissions = []RBACPermission{
+	{Resources: []string{"configmaps"}, Verbs: []string{"create", "delete", "get", "list", "watch"}},
+	{Resources: []string{"events"}, Verbs: []string{"create", "get", "list", "patch", "watch"}},
+	{Resources: []string{"namespaces"}, Verbs: verbsReadOnly, Scope: RBACScopeCluster},
+	{Resources: []string{"nodes"}, Verbs: verbsReadOnly, Scope: RBACScopeClusterAlways},
+	{Resources: []string{"resourcequotas"}, Verbs: verbsReadOnly},
+	{Resources: []string{"persistentvolumeclaims", "secrets"}, Verbs: verbsManage},
+	{Resources: []string{"persistentvolumes"}, Verbs: verbsManage, Scope: RBACScopeCluster},
+	{Resources: []string{"pods"}, Verbs: []string{"delete", "get", "list", "watch"}},
+	{Resources: []string{"pods/log"}, Verbs: []string{"get"}},
+	{Resources: []string{"pods/status"}, Verbs: []string{"patch"}, Scope: RBACScopeOperatorNamespace, Optional: true},
+	{
+		Group: "aim.eai.amd.com",
+		Resources: []string{
+			"aimartifacts", "aimbatchjobs", "aimendpoints", "aimmodels", "aimruntimeconfigs",
+			"aimservices", "aimservicetemplates", "aimtemplatecaches",
+		},
+		Verbs: verbsManage,
+	},
+	{
+		Group: "aim.eai.amd.com",
+		Resources: []string{
+			"aimclustermodelcaches", "aimclustermodels", "aimclustermodelsources",
+			"aimclusterruntimeconfigs", "aimclusterservicetemplates",
+		},
+		Verbs: verbsManage,
+		Scope: RBACScopeCluster,
+	},
+	{
+		Group: "aim.eai.amd.com",
+		Resources: []string{
+			"aimartifacts/finalizers", "aimbatchjobs/finalizers", "aimmodels/finalizers",
+			"aimruntimeconfigs/finalizers", "aimservices/finalizers", "aimservicetemplates/finalizers",
+			"aimtemplatecaches/finalizers",
+		},
+		Verbs: []string{"update"},
+	},
+	{
+		Group: "aim.eai.amd.com",
+		Resources: []string{
+			"aimclustermodelcaches/finalizers", "aimclustermodels/finalizers", "aimclustermodelsources/finalizers",
+			"aimclusterruntimeconfigs/finalizers", "aimclusterservicetemplates/finalizers",
+		},
+		Verbs: []string{"update"},
+		Scope: RBACScopeCluster,
+	},
+	{
+		Group: "aim.eai.amd.com",
+		Resources: []string{
+			"aimartifacts/status", "aimbatchjobs/status", "aimendpoints/status", "aimmodels/status",
+			"aimruntimeconfigs/status", "aimservices/status", "aimservicetemplates/status", "aimtemplatecaches/status",
+		},
+		Verbs: []string{"get", "patch", "update"},
+	},
+	{
+		Group: "aim.eai.amd.com",
+		Resources: []string{
+			"aimclustermodelcaches/status", "aimclustermodels/status", "aimclustermodelsources/status",
+			"aimclusterruntimeconfigs/status", "aimclusterservicetemplates/status",
+		},
+		Verbs: []string{"get", "patch", "update"},
+		Scope: RBACScopeCluster,
+	},
+	{
+		Group:     "apiextensions.k8s.io",
+		Resources: []string{"customresourcedefinitions"},
+		Verbs:     []string{"get"},
+		Scope:     RBACScopeCluster,
+		Optional:  true,
+	},
+	{Group: "apps", Resources: []string{"deployments"}, Verbs: []string{"get"}, Optional: true},
+	{Group: "autoscaling", Resources: []string{"horizontalpodautoscalers"}, Verbs: verbsReadOnly},
+	{Group: "batch", Resources: []string{"jobs"}, Verbs: verbsManage},
+	// Leader election fails on its own without leases, and is not always enabled
+	{
+		Group:     "coordination.k8s.io",
+		Resources: []string{"leases"},
+		Verbs:     verbsManage,
+		Scope:     RBACScopeOperatorNamespace,
+		Optional:  true,
+	},
+	{Group: "gateway.networking.k8s.io", Resources: []string{"httproutes"}, Verbs: verbsManage},
+	{Group: "metrics.k8s.io", Resources: []string{"pods"}, Verbs: []string{"get", "list"}, Optional: true},
+	{Group: "networking.k8s.io", Resources: []string{"networkpolicies"}, Verbs: verbsManage},
+	{
+		Group:     "rbac.authorization.k8s.io",
+		Resources: []string{"clusterroles"},
+		Name:      "artifact-status-updater",
+		Verbs:     []string{"bind"},
+		Scope:     RBACScopeCluster,
+	},
+	{Group: "rbac.authorization.k8s.io", Resources: []string{"rolebindings"}, Verbs: []string{"create", "get", "list", "patch", "update", "watch"}},
+	{Group: "serving.kserve.io", Resources: []string{"inferenceservices", "servingruntimes"}, Verbs: verbsManage},
+	{Group: "serving.kserve.io", Resources: []string{"clusterservingruntimes"}, Verbs: verbsManage, Scope: RBACScopeCluster},
+	{Group: "storage.k8s.io", Resources: []string{"storageclasses"}, Verbs: verbsReadOnly, Scope: RBACScopeCluster},
+}
+
+// RBACCheck is a single verb on a resource, checked with one SelfSubjectAccessReview.
+type RBACCheck struct {
+	Group     string
+	Resource  string
+	Name      string
+	Verb      string
+	Namespace string
+	Optional  bool
+}
+
+func (c RBACCheck) String() string {
+	resource := c.Resource
+	if c.Group != "" {
+		resource += "." + c.Group
+	}
+	if c.Name != "" {
+		resource += "/" + c.Name
+	}
+	if c.Namespace == "" {
+		return fmt.Sprintf("%s %s (cluster-wide)", c.Verb, resource)
+	}
+	return fmt.Sprintf("%s %s in namespace %s", c.Verb, resource, c.Namespace)
+}
+
+// RBACAudit checks at startup, with a SelfSubjectAccessReview per verb and resource, that the
+// operator holds the permissions it needs. Missing permissions are logged, exported as the
+// aim_rbac_missing_permissions gauge and fail the readiness check, instead of surfacing as
+// Forbidden errors in the middle of a reconcile. While permissions are missing the audit is
+// repeated, so the operator becomes ready once its RBAC is fixed.
+type RBACAudit struct {
+	clientset         kubernetes.Interface
+	permissions       []RBACPermission
+	namespaces        []string
+	operatorNamespace string
+	retryInterval     time.Duration
+
+	mu      sync.RWMutex
+	missing []RBACCheck
+}
+
+// NewRBACAudit creates an audit of the required permissions. With namespaces, the operator runs in
+// namespaced-only mode and namespaced permissions are checked in each of them.
+func NewRBACAudit(clientset kubernetes.Interface, namespaces []string, operatorNamespace string) *RBACAudit {
+	return &RBACAudit{
+		clientset:         clientset,
+		permissions:       RequiredRBACPermissions,
+		namespaces:        namespaces,
+		operatorNamespace: operatorNamespace,
+		retryInterval:     rbacAuditRetryInterval,
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica reports its own readiness.
+func (a *RBACAudit) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable. It audits the permissions and repeats the audit until
+// nothing is missing or the manager stops.
+func (a *RBACAudit) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("rbac-audit")
+	ticker := time.NewTicker(a.retryInterval)
+	defer ticker.Stop()
+
+	for {
+		denials, err := a.Audit(ctx)
+		switch {
+		case err != nil:
+			logger.Error(err, "RBAC audit incomplete, retrying", "retryInterval", a.retryInterval)
+		case len(denials) == 0:
+			logger.Info("RBAC audit passed, all required permissions are granted")
+			return nil
+		default:
+			for _, d := range denials {
+				logger.Error(nil, "missing RBAC permission", "group", d.Group, "resource", d.Resource,
+					"name", d.Name, "verb", d.Verb, "namespace", d.Namespace, "optional", d.Optional)
+			}
+			logger.Error(nil, "RBAC audit found missing permissions, update the manager role; retrying",
+				"missing", len(denials), "retryInterval", a.retryInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Audit checks every permission and returns the denied checks. The denials are recorded for the
+// readiness check and the metric. Reviews that fail are returned as an error and do not count as denials.
+func (a *RBACAudit) Audit(ctx context.Context) ([]RBACCheck, error) {
+	checks := a.checks()
+	allowed := make([]bool, len(checks))
+	errs := make([]error, len(checks))
+
+	g := NewFetchGroup(ctx, DefaultFetchConcurrency)
+	for i := range checks {
+		g.Go(func(ctx context.Context) {
+			allowed[i], errs[i] = a.review(ctx, checks[i])
+		})
+	}
+	g.Wait()
+
+	var denials []RBACCheck
+	var failed error
+	for i, check := range checks {
+		if errs[i] != nil {
+			if failed == nil {
+				failed = fmt.Errorf("failed to review %s: %w", check, errs[i])
+			}
+			continue
+		}
+		if !allowed[i] {
+			denials = append(denials, check)
+		}
+	}
+
+	RBACMissingPermissions.Reset()
+	var missing []RBACCheck
+	for _, d := range denials {
+		RBACMissingPermissions.WithLabelValues(d.Group, d.Resource, d.Verb, fmt.Sprint(d.Optional)).Set(1)
+		if !d.Optional {
+			missing = append(missing, d)
+		}
+	}
+	a.mu.Lock()
+	a.missing = missing
+	a.mu.Unlock()
+	return denials, failed
+}
+
+// checks expands the permissions into one review per verb, resource and namespace.
+func (a *RBACAudit) checks() []RBACCheck {
+	var checks []RBACCheck
+	for _, p := range a.permissions {
+		for _, namespace := range a.namespacesFor(p.Scope) {
+			for _, resource := range p.Resources {
+				for _, verb := range p.Verbs {
+					checks = append(checks, RBACCheck{
+						Group: p.Group, Resource: resource, Name: p.Name, Verb: verb,
+						Namespace: namespace, Optional: p.Optional,
+					})
+				}
+			}
+		}
+	}
+	return checks
+}
+
+// namespacesFor returns the namespaces a permission of the scope is checked in, "" meaning cluster-wide.
+func (a *RBACAudit) namespacesFor(scope RBACScope) []string {
+	switch scope {
+	case RBACScopeCluster:
+		if len(a.namespaces) > 0 {
+			return nil
+		}
+		return []string{""}
+	case RBACScopeClusterAlways:
+		return []string{""}
+	case RBACScopeOperatorNamespace:
+		return []string{a.operatorNamespace}
+	default:
+		if len(a.namespaces) > 0 {
+			return a.namespaces
+		}
+		return []string{""}
+	}
+}
+
+// review asks the API server whether the operator may perform the check.
+func (a *RBACAudit) review(ctx context.Context, check RBACCheck) (bool, error) {
+	resource, subresource, _ := strings.Cut(check.Resource, "/")
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   check.Namespace,
+				Verb:        check.Verb,
+				Group:       check.Group,
+				Resource:    resource,
+				Subresource: subresource,
+				Name:        check.Name,
+			},
+		},
+	}
+	result, err := a.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return result.Status.Allowed, nil
+}
+
+// Checker implements healthz.Checker. It fails while required permissions are missing.
+// The check passes before the first audit completes, so that readiness is not delayed.
+func (a *RBACAudit) Checker(_ *http.Request) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if len(a.missing) == 0 {
+		return nil
+	}
+	descriptions := make([]string, 0, rbacCheckerMaxListed+1)
+	for i, d := range a.missing {
+		if i == rbacCheckerMaxListed {
+			descriptions = append(descriptions, fmt.Sprintf("and %d more", len(a.missing)-i))
+			break
+		}
+		descriptions = append(descriptions, d.String())
+	}
+	return fmt.Errorf("missing RBAC permissions: %s", strings.Join(descriptions, "; "))
+}
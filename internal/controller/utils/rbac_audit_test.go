@@ -0,0 +1,162 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+)
+
+// flattenPermissions returns one key per group, resource, name and verb.
+func flattenPermissions(permissions []RBACPermission) map[string]bool {
+	keys := map[string]bool{}
+	for _, p := range permissions {
+		for _, resource := range p.Resources {
+			for _, verb := range p.Verbs {
+				keys[strings.Join([]string{p.Group, resource, p.Name, verb}, "|")] = true
+			}
+		}
+	}
+	return keys
+}
+
+func TestRequiredRBACPermissions_MatchManagerRole(t *testing.T) {
+	data, err := os.ReadFile("../../../config/rbac/role.yaml")
+	if err != nil {
+		t.Fatalf("failed to read the manager role: %v", err)
+	}
+	var role rbacv1.ClusterRole
+	if err := yaml.Unmarshal(data, &role); err != nil {
+		t.Fatalf("failed to parse the manager role: %v", err)
+	}
+
+	var fromRole []RBACPermission
+	for _, rule := range role.Rules {
+		for _, group := range rule.APIGroups {
+			names := rule.ResourceNames
+			if len(names) == 0 {
+				names = []string{""}
+			}
+			for _, name := range names {
+				fromRole = append(fromRole, RBACPermission{Group: group, Resources: rule.Resources, Name: name, Verbs: rule.Verbs})
+			}
+		}
+	}
+
+	want, got := flattenPermissions(fromRole), flattenPermissions(RequiredRBACPermissions)
+	for key := range want {
+		if !got[key] {
+			t.Errorf("permission %s of the manager role is not audited", key)
+		}
+	}
+	for key := range got {
+		if !want[key] {
+			t.Errorf("audited permission %s is not in the manager role", key)
+		}
+	}
+}
+
+// reviewingClientset answers SelfSubjectAccessReviews with allowed unless denied returns true.
+func reviewingClientset(denied func(attrs *authorizationv1.ResourceAttributes) bool) *kubefake.Clientset {
+	clientset := kubefake.NewClientset()
+	clientset.PrependReactor("create", "selfsubjectaccessreviews",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+			review.Status.Allowed = !denied(review.Spec.ResourceAttributes)
+			return true, review, nil
+		})
+	return clientset
+}
+
+func TestRBACAudit(t *testing.T) {
+	clientset := reviewingClientset(func(attrs *authorizationv1.ResourceAttributes) bool {
+		return (attrs.Group == "batch" && attrs.Verb == "create") || attrs.Group == "metrics.k8s.io"
+	})
+	audit := NewRBACAudit(clientset, nil, "aim-system")
+
+	if err := audit.Checker(nil); err != nil {
+		t.Errorf("expected readiness to pass before the first audit, got %v", err)
+	}
+
+	denials, err := audit.Audit(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(denials) != 3 {
+		t.Fatalf("expected 3 denials, got %v", denials)
+	}
+
+	err = audit.Checker(nil)
+	if err == nil || !strings.Contains(err.Error(), "create jobs.batch (cluster-wide)") {
+		t.Errorf("expected readiness to fail on the missing job permission, got %v", err)
+	}
+	if strings.Contains(err.Error(), "metrics.k8s.io") {
+		t.Errorf("expected optional permissions not to fail readiness, got %v", err)
+	}
+	if got := testutil.ToFloat64(RBACMissingPermissions.WithLabelValues("metrics.k8s.io", "pods", "list", "true")); got != 1 {
+		t.Errorf("expected the missing optional permission to be exported, got %v", got)
+	}
+
+	// Readiness recovers once the permissions are granted
+	audit.clientset = reviewingClientset(func(*authorizationv1.ResourceAttributes) bool { return false })
+	if denials, err := audit.Audit(context.Background()); err != nil || len(denials) != 0 {
+		t.Fatalf("expected no denials, got %v (err %v)", denials, err)
+	}
+	if err := audit.Checker(nil); err != nil {
+		t.Errorf("expected readiness to pass, got %v", err)
+	}
+}
+
+func TestRBACAudit_NamespacedOnly(t *testing.T) {
+	audit := NewRBACAudit(kubefake.NewClientset(), []string{"team-a", "team-b"}, "aim-system")
+
+	namespaces := map[string]map[string]bool{}
+	for _, check := range audit.checks() {
+		if namespaces[check.Resource] == nil {
+			namespaces[check.Resource] = map[string]bool{}
+		}
+		namespaces[check.Resource][check.Namespace] = true
+	}
+	if !namespaces["aimservices"]["team-a"] || !namespaces["aimservices"]["team-b"] || namespaces["aimservices"][""] {
+		t.Errorf("expected namespaced permissions to be checked in each watched namespace, got %v", namespaces["aimservices"])
+	}
+	if !namespaces["nodes"][""] {
+		t.Error("expected nodes to be checked cluster-wide in namespaced-only mode")
+	}
+	if len(namespaces["aimclustermodels"]) > 0 || len(namespaces["storageclasses"]) > 0 {
+		t.Error("expected cluster-scoped permissions not to be checked in namespaced-only mode")
+	}
+	if !namespaces["leases"]["aim-system"] || len(namespaces["leases"]) != 1 {
+		t.Errorf("expected leases to be checked in the operator namespace, got %v", namespaces["leases"])
+	}
+}
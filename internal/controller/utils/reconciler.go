@@ -26,17 +26,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
@@ -56,6 +61,10 @@ const (
 	ConditionTypeConfigValid           = "ConfigValid"
 	ConditionTypeReady                 = "Ready"
 
+	// ConditionTypeRuntimeDependencyMissing is True while a managed resource kind
+	// (e.g. an InferenceService version) is not served by the API server.
+	ConditionTypeRuntimeDependencyMissing = "RuntimeDependencyMissing"
+
 	// Component condition suffix (e.g., "ModelReady", "TemplateReady")
 	ComponentConditionSuffix = "Ready"
 
@@ -79,6 +88,14 @@ const (
 	MessageMissingRef  = "Referenced resource not found"
 	MessageConfigValid = "Configuration is valid"
 
+	// RuntimeDependencyMissing condition reason, and the matching Ready reason
+	ReasonKindNotServed            = "KindNotServed"
+	ReasonRuntimeDependencyMissing = "RuntimeDependencyMissing"
+
+	// runtimeDependencyRecheckInterval is how often a resource blocked on a missing
+	// CRD is re-checked. Installing the CRD does not trigger a watch event on the owner.
+	runtimeDependencyRecheckInterval = 5 * time.Minute
+
 	// Ready condition reasons
 	ReasonAllComponentsReady  = "AllComponentsReady"
 	ReasonComponentsNotReady  = "ComponentsNotReady"
@@ -87,9 +104,18 @@ const (
 	MessageComponentsNotReady = "Some components are not ready"
 	MessageProgressing        = "Waiting for components to become ready"
 	MessageInfraError         = "Infrastructure error - waiting for retry"
+
+	// ReasonApplyFailed is set on a component condition when its child object failed to apply
+	ReasonApplyFailed = "ApplyFailed"
 )
 
 // PlanResult contains the desired state changes from the PlanResources phase.
+//
+// The pipeline deletes the objects planned with Delete, then applies, then deletes the objects
+// planned with DeleteAfterApply. Within each phase, objects are handled in groups of ascending
+// weight (see WithWeight), and each group is a barrier: a later group is only handled once the
+// earlier ones succeeded, and for deletions once the deleted objects are gone. Objects planned
+// without a weight share weight 0, so plans that do not use weights keep their behavior.
 type PlanResult struct {
 	// toApply are objects to create or update via Server-Side Apply with owner references.
 	// This is the default and most common case - owned resources will be garbage collected
@@ -100,30 +126,97 @@ type PlanResult struct {
 	// Use this for shared resources or resources that should outlive the owner.
 	toApplyWithoutOwnerRef []client.Object
 
-	// toDelete are objects to delete
+	// toDelete are objects to delete before anything is applied
 	toDelete []client.Object
 
+	// toDeleteAfterApply are objects to delete once all objects were applied successfully
+	toDeleteAfterApply []client.Object
+
+	// toPatchStatus are status patches of objects the operator does not apply, e.g. pod conditions
+	toPatchStatus []statusPatch
+
+	// actions are side effects run once everything was applied, e.g. calls to a workload's API
+	actions []Action
+
+	// weights orders objects within their phase; objects without an entry have weight 0
+	weights map[client.Object]int
+
+	// adopt marks owned objects that exist without being managed by the operator and are taken
+	// over under the Adopt policy; they are applied with forced field ownership
+	adopt map[client.Object]bool
+
 	// RequeueAfter signals to the controller that reconciliation should be retried
 	// after the specified duration. Use this when the reconciler cannot proceed
 	// (e.g., blocked by a rate limit) but should retry later.
 	RequeueAfter time.Duration
 }
 
+// PlanOption adjusts how a planned object is ordered within its phase.
+type PlanOption func(pr *PlanResult, obj client.Object)
+
+// WithWeight orders an object within its phase. Lower weights are handled first, and a group
+// is only handled once all groups with lower weights succeeded. For example, deleting an
+// InferenceService with weight 0 and its PVC with weight 1 keeps the claim until the
+// InferenceService is gone.
+func WithWeight(weight int) PlanOption {
+	return func(pr *PlanResult, obj client.Object) {
+		if pr.weights == nil {
+			pr.weights = make(map[client.Object]int)
+		}
+		pr.weights[obj] = weight
+	}
+}
+
 // Apply adds an object to be applied with an owner reference (default behavior).
 // The object will be garbage collected when the owner is deleted.
-func (pr *PlanResult) Apply(obj client.Object) {
+func (pr *PlanResult) Apply(obj client.Object, opts ...PlanOption) {
 	pr.toApply = append(pr.toApply, obj)
+	pr.applyOptions(obj, opts)
 }
 
 // ApplyWithoutOwnerRef adds an object to be applied without an owner reference.
 // Use this for shared resources or resources that should outlive the owner.
-func (pr *PlanResult) ApplyWithoutOwnerRef(obj client.Object) {
+func (pr *PlanResult) ApplyWithoutOwnerRef(obj client.Object, opts ...PlanOption) {
 	pr.toApplyWithoutOwnerRef = append(pr.toApplyWithoutOwnerRef, obj)
+	pr.applyOptions(obj, opts)
 }
 
-// Delete adds an object to be deleted
-func (pr *PlanResult) Delete(obj client.Object) {
+// Delete adds an object to be deleted before anything is applied
+func (pr *PlanResult) Delete(obj client.Object, opts ...PlanOption) {
 	pr.toDelete = append(pr.toDelete, obj)
+	pr.applyOptions(obj, opts)
+}
+
+// DeleteAfterApply adds an object to be deleted once all planned objects were applied
+// successfully, e.g. an old cache that is replaced by a new one in the same plan.
+func (pr *PlanResult) DeleteAfterApply(obj client.Object, opts ...PlanOption) {
+	pr.toDeleteAfterApply = append(pr.toDeleteAfterApply, obj)
+	pr.applyOptions(obj, opts)
+}
+
+// PatchStatus adds a patch of the status subresource of an object that is not applied by the
+// operator, such as a condition on a pod created by KServe. Status patches are sent after the
+// apply phase; objects that no longer exist are skipped.
+func (pr *PlanResult) PatchStatus(obj client.Object, patch client.Patch) {
+	pr.toPatchStatus = append(pr.toPatchStatus, statusPatch{obj: obj, patch: patch})
+}
+
+// Action is a side effect that is neither an apply nor a delete, such as a call to the API of a
+// workload the operator runs. Actions run after the apply phase and before the status of the
+// reconciled object is written, so they report their outcome through its status and through cm.
+type Action func(ctx context.Context, cm *ConditionManager)
+
+// Run adds an action that runs once all planned objects were deleted and applied successfully.
+// Like the apply phase, actions are skipped while the controller is paused or the state engine
+// holds back changes.
+func (pr *PlanResult) Run(action Action) {
+	pr.actions = append(pr.actions, action)
+}
+
+func (pr *PlanResult) applyOptions(obj client.Object, opts []PlanOption) {
+	for _, opt := range opts {
+		opt(pr, obj)
+	}
 }
 
 // GetToApply returns the objects to be applied with owner references (for testing)
@@ -136,11 +229,35 @@ func (pr *PlanResult) GetToApplyWithoutOwnerRef() []client.Object {
 	return pr.toApplyWithoutOwnerRef
 }
 
-// GetToDelete returns the objects to be deleted (for testing)
+// GetToDelete returns the objects to be deleted before applying (for testing)
 func (pr *PlanResult) GetToDelete() []client.Object {
 	return pr.toDelete
 }
 
+// GetToDeleteAfterApply returns the objects to be deleted after applying (for testing)
+func (pr *PlanResult) GetToDeleteAfterApply() []client.Object {
+	return pr.toDeleteAfterApply
+}
+
+// GetToPatchStatus returns the objects whose status is patched (for testing)
+func (pr *PlanResult) GetToPatchStatus() []client.Object {
+	objs := make([]client.Object, 0, len(pr.toPatchStatus))
+	for _, p := range pr.toPatchStatus {
+		objs = append(objs, p.obj)
+	}
+	return objs
+}
+
+// GetActions returns the planned actions (for testing)
+func (pr *PlanResult) GetActions() []Action {
+	return pr.actions
+}
+
+// GetWeight returns the weight of a planned object (for testing)
+func (pr *PlanResult) GetWeight(obj client.Object) int {
+	return pr.weights[obj]
+}
+
 // StateEngineDecision contains the state engine's analysis and reconciliation directives.
 type StateEngineDecision struct {
 	// ShouldApply is false if ConfigValid/AuthValid/DependenciesReachable is False
@@ -151,6 +268,18 @@ type StateEngineDecision struct {
 
 	// RequeueError is the error to return for controller-runtime requeue
 	RequeueError error
+
+	// ErrorCategories lists the error categories found in component health (for decision traces)
+	ErrorCategories []ErrorCategory
+
+	// WithinGracePeriod is true if infrastructure errors are still within the degradation grace period
+	WithinGracePeriod bool
+
+	// WaitingOn lists the objects that not-ready components are waiting on (for decision traces)
+	WaitingOn []ObjectRef
+
+	// Status is the derived root status. Empty for reconcilers that set status manually.
+	Status constants.AIMStatus
 }
 
 // InfrastructureError represents retriable infrastructure failures (network, API server, etc.).
@@ -206,6 +335,13 @@ type StatusDecorator[T ObjectWithStatus[S], S StatusWithConditions, Obs any] int
 	DecorateStatus(status S, cm *ConditionManager, obs Obs)
 }
 
+// ReadinessGateProvider names conditions that must be True for Ready although they are not
+// component Ready conditions, e.g. a condition set in DecorateStatus.
+type ReadinessGateProvider interface {
+	// ReadinessGates returns the condition types that gate the Ready condition while they are set.
+	ReadinessGates() []string
+}
+
 // ManualStatusController takes full ownership of status & conditions.
 // When implemented, the StateEngine is NOT called.
 type ManualStatusController[T ObjectWithStatus[S], S StatusWithConditions, Obs any] interface {
@@ -223,6 +359,10 @@ type Pipeline[T ObjectWithStatus[S], S StatusWithConditions, F any, Obs any] str
 	Scheme         *runtime.Scheme
 	ControllerName string
 	Clientset      kubernetes.Interface // Optional: for health inspectors that need additional K8s API access
+	Notifier       Notifier             // Optional: delivers runtime config notifications, defaults to HTTP webhooks
+	PauseSwitch    *PauseSwitch         // Optional: pauses apply and delete of this controller cluster-wide
+	StatusBatcher  *StatusBatcher       // Optional: writes status asynchronously in batches instead of StatusClient
+	CircuitBreaker *CircuitBreaker      // Optional: backs off sharply from resources that keep failing
 }
 
 // GetKubernetesName returns the Kubernetes controller name (used in SetupWithManager's .Named()).
@@ -240,6 +380,10 @@ func (p *Pipeline[T, S, F, Obs]) GetFullName() string {
 type ReconcileContext[T client.Object] struct {
 	Object              T
 	MergedRuntimeConfig FetchResult[*aimv1alpha1.AIMRuntimeConfigCommon]
+
+	// RuntimeConfigSources lists the runtime configs merged into MergedRuntimeConfig,
+	// lowest precedence first.
+	RuntimeConfigSources []aimv1alpha1.AIMResolvedReference
 }
 
 // Run executes the standard Fetch → Compose → Plan → StateEngine → Apply → Events → Status flow.
@@ -257,6 +401,21 @@ func (p *Pipeline[T, S, F, Obs]) Run(ctx context.Context, obj T) (ctrl.Result, e
 		return ctrl.Result{}, nil
 	}
 
+	// === Pre-check: Skip reconciliation while the circuit breaker is open ===
+	circuitKey := p.ControllerName + "/" + client.ObjectKeyFromObject(obj).String()
+	if until, open := p.CircuitBreaker.OpenUntil(circuitKey, obj.GetGeneration()); open {
+		logger.V(1).Info("Reconciliation throttled after repeated failures, skipping", "retryAt", until)
+		return ctrl.Result{RequeueAfter: time.Until(until)}, nil
+	}
+
+	// Honor fault injection annotations in test builds
+	ctx = withInjectedFaults(ctx, obj)
+
+	// A status write still pending in the batcher is the current status of this object version
+	if pending, ok := p.StatusBatcher.Pending(obj).(T); ok {
+		reflect.ValueOf(obj.GetStatus()).Elem().Set(reflect.ValueOf(pending.GetStatus()).Elem())
+	}
+
 	// 1) Get current status pointer (will be mutated)
 	status := obj.GetStatus() // S, e.g. *AIMServiceStatus
 
@@ -270,7 +429,12 @@ func (p *Pipeline[T, S, F, Obs]) Run(ctx context.Context, obj T) (ctrl.Result, e
 			name = ref.Name
 		}
 	}
-	reconcileCtx.MergedRuntimeConfig = FetchMergedRuntimeConfig(ctx, p.Client, name, obj.GetNamespace())
+	var override *aimv1alpha1.AIMRuntimeConfigCommon
+	if o, ok := any(obj).(RuntimeConfigOverrideProvider); ok {
+		override = o.GetRuntimeConfigOverride()
+	}
+	reconcileCtx.MergedRuntimeConfig, reconcileCtx.RuntimeConfigSources = FetchMergedRuntimeConfig(
+		ctx, p.Client, name, obj.GetNamespace(), override)
 
 	// 2) Deep copy the entire object to capture old status for comparison
 	oldObj, ok := obj.DeepCopyObject().(T)
@@ -304,66 +468,168 @@ func (p *Pipeline[T, S, F, Obs]) Run(ctx context.Context, obj T) (ctrl.Result, e
 		return ctrl.Result{}, fmt.Errorf("state engine failed: %w", stateErr)
 	}
 
+	// === Phase 4a: Controller Pause ===
+	// A controller paused cluster-wide keeps updating status, but neither deletes nor applies.
+	controllerPaused := p.PauseSwitch.IsPaused(p.ControllerName)
+	if controllerPaused {
+		decision.ShouldApply = false
+	}
+
 	// === Phase 5: Delete ===
 	// Delete objects before applying new state (only if decision allows apply).
+	// Groups of ascending weight are deleted in order; a group waits until the previous ones are gone.
 	// Aggregate errors to avoid silent failures.
-	var deleteErrs []error
+	var deleteResult orderedDeleteResult
 	if decision.ShouldApply && len(planResult.toDelete) > 0 {
-		for _, objToDelete := range planResult.toDelete {
-			if err := p.Client.Delete(ctx, objToDelete); client.IgnoreNotFound(err) != nil {
-				gvk := objToDelete.GetObjectKind().GroupVersionKind()
-				key := client.ObjectKeyFromObject(objToDelete)
-				deleteErrs = append(deleteErrs, fmt.Errorf("delete failed for %s %s/%s: %w", gvk.Kind, key.Namespace, key.Name, err))
-			}
-		}
+		deleteResult = deleteInOrder(ctx, p.Client, &planResult, planResult.toDelete)
 	}
+	deleteErrs := deleteResult.errs
 
 	// === Phase 6: Apply ===
 	// Use Server-Side Apply to create/update desired objects (only if decision allows).
+	// Groups of ascending weight are applied in order; a failed group stops later groups.
 	var applyErr error
-	if decision.ShouldApply && len(deleteErrs) == 0 {
-		// Propagate labels from the parent to the children
-		PropagateLabelsForResult(reconcileCtx.Object, &planResult, reconcileCtx.MergedRuntimeConfig.Value)
-
-		// Add standard controller labels to all resources
+	var applyAttempted int
+	var adoptionPolicy aimv1alpha1.AIMAdoptionPolicy
+	var unmanaged []client.Object
+	var patchErrs []error
+	applyRan := decision.ShouldApply && len(deleteErrs) == 0 && !deleteResult.pending
+	if applyRan {
+		// Add standard controller labels to all resources, then propagate labels from the parent
 		controllerLabels := map[string]string{
 			"app.kubernetes.io/managed-by":                                        p.GetFullName(),
 			fmt.Sprintf("%s/%s.name", constants.AimLabelDomain, p.ControllerName): obj.GetName(),
 		}
-		ApplyControllerLabelsToResult(&planResult, controllerLabels)
+		ApplyMetadataToResult(reconcileCtx.Object, &planResult, reconcileCtx.MergedRuntimeConfig.Value, controllerLabels)
 
-		// Apply owned resources (with owner references)
-		if len(planResult.toApply) > 0 {
-			applyErr = ApplyDesiredState(ctx, p.Client, p.GetFullName(), p.Scheme, planResult.toApply, obj)
-			if applyErr != nil {
-				applyErr = fmt.Errorf("failed to apply owned resources: %w", applyErr)
-			}
+		// Objects that exist without being managed by the operator are handled per adoption policy
+		adoptionPolicy = ResolveAdoptionPolicy(obj, reconcileCtx.MergedRuntimeConfig.Value)
+		unmanaged = applyAdoptionPolicy(ctx, p.Client, p.Scheme, &planResult, adoptionPolicy)
+
+		applyAttempted, applyErr = applyInOrder(ctx, p.Client, p.GetFullName(), p.Scheme, &planResult, obj)
+
+		// === Phase 6a: Delete After Apply ===
+		// Objects replaced by the applied ones are only deleted once everything applied.
+		if applyErr == nil && len(planResult.toDeleteAfterApply) > 0 {
+			afterApply := deleteInOrder(ctx, p.Client, &planResult, planResult.toDeleteAfterApply)
+			deleteResult.deleted += afterApply.deleted
+			deleteResult.pending = afterApply.pending
+			deleteErrs = afterApply.errs
+		}
+
+		// === Phase 6b: Patch Status ===
+		// Conditions on objects created by other controllers, e.g. pod readiness gates.
+		if len(planResult.toPatchStatus) > 0 {
+			patchErrs = patchStatuses(ctx, p.Client, planResult.toPatchStatus)
 		}
 
-		// Apply unowned resources (without owner references)
-		if applyErr == nil && len(planResult.toApplyWithoutOwnerRef) > 0 {
-			applyErr = ApplyDesiredState(ctx, p.Client, p.GetFullName(), p.Scheme, planResult.toApplyWithoutOwnerRef, nil)
-			if applyErr != nil {
-				applyErr = fmt.Errorf("failed to apply unowned resources: %w", applyErr)
+		// === Phase 6c: Actions ===
+		// Side effects beyond the API server, only once the planned state was reached.
+		if applyErr == nil && len(deleteErrs) == 0 && !deleteResult.pending {
+			for _, action := range planResult.actions {
+				action(ctx, cm)
 			}
 		}
 	}
+	applyFailures := ObjectApplyErrors(applyErr)
 
 	// === Phase 7: Handle Apply/Delete Errors ===
 	// Apply/delete failures are treated as infrastructure errors (retriable).
 	// Set DependenciesReachable=False to indicate the operator cannot reach the API server
 	// or lacks permissions to perform the operation.
+	// A kind the API server does not serve (CRD missing or version skew) will not heal on
+	// retry, so it is surfaced as a persistent condition and re-checked periodically
+	// instead of being requeued with backoff.
 	var phaseErr error
-	if len(deleteErrs) > 0 {
+	var requeueAfter time.Duration
+	if deleteResult.pending {
+		requeueAfter = deletionBarrierRecheckInterval
+	}
+	if msg, missing := runtimeDependencyMessage(append(deleteErrs, applyErr)...); missing {
+		cm.Set(ConditionTypeRuntimeDependencyMissing, metav1.ConditionTrue, ReasonKindNotServed, msg, AsError())
+		cm.Set(ConditionTypeReady, metav1.ConditionFalse, ReasonRuntimeDependencyMissing, msg, AsError())
+		status.SetStatus(string(constants.AIMStatusFailed))
+		requeueAfter = runtimeDependencyRecheckInterval
+	} else if len(deleteErrs) > 0 {
 		phaseErr = InfrastructureError{Count: len(deleteErrs), Errors: deleteErrs}
 		cm.Set(ConditionTypeDependenciesReachable, metav1.ConditionFalse, ReasonDependenciesNotReachable, fmt.Sprintf("Failed to delete resources: %v", deleteErrs[0]), AsError())
 	} else if applyErr != nil {
-		phaseErr = InfrastructureError{Count: 1, Errors: []error{applyErr}}
+		errs := []error{applyErr}
+		if len(applyFailures) > 0 {
+			errs = make([]error, 0, len(applyFailures))
+			for _, failure := range applyFailures {
+				errs = append(errs, failure)
+			}
+		}
+		phaseErr = InfrastructureError{Count: len(errs), Errors: errs}
 		cm.Set(ConditionTypeDependenciesReachable, metav1.ConditionFalse, ReasonDependenciesNotReachable, fmt.Sprintf("Failed to apply resources: %v", applyErr), AsError())
+		if markComponentApplyFailures(cm, applyFailures) {
+			if ready := cm.Get(ConditionTypeReady); ready != nil && ready.Status == metav1.ConditionTrue {
+				cm.Set(ConditionTypeReady, metav1.ConditionFalse, ReasonComponentsNotReady, MessageComponentsNotReady, AsError())
+			}
+		}
+	} else if len(patchErrs) > 0 {
+		phaseErr = InfrastructureError{Count: len(patchErrs), Errors: patchErrs}
+		cm.Set(ConditionTypeDependenciesReachable, metav1.ConditionFalse, ReasonDependenciesNotReachable, fmt.Sprintf("Failed to patch resource status: %v", patchErrs[0]), AsError())
+	} else if decision.ShouldApply {
+		cm.Delete(ConditionTypeRuntimeDependencyMissing)
+	}
+
+	// === Phase 7a: Ownership Conflicts ===
+	if applyRan && adoptionPolicy == aimv1alpha1.AIMAdoptionPolicyConflict && len(unmanaged) > 0 {
+		msg := resourceConflictMessage(unmanaged)
+		cm.Set(ConditionTypeResourceConflict, metav1.ConditionTrue, ReasonObjectNotManaged, msg, AsError())
+		cm.Set(ConditionTypeReady, metav1.ConditionFalse, ReasonObjectNotManaged, msg, AsError())
+		status.SetStatus(string(constants.AIMStatusFailed))
+		if requeueAfter == 0 || resourceConflictRecheckInterval < requeueAfter {
+			requeueAfter = resourceConflictRecheckInterval
+		}
+	} else if applyRan {
+		cm.Delete(ConditionTypeResourceConflict)
+	}
+
+	// === Phase 7b: Controller Pause ===
+	if controllerPaused {
+		cm.Set(ConditionTypeReconcilePaused, metav1.ConditionTrue, ReasonControllerPaused, p.PauseSwitch.pausedMessage(), AsWarning())
+		if requeueAfter == 0 || pausedRecheckInterval < requeueAfter {
+			requeueAfter = pausedRecheckInterval
+		}
+	} else {
+		cm.Delete(ConditionTypeReconcilePaused)
+	}
+
+	// === Phase 7c: Circuit Breaker ===
+	// A resource that failed too often within the window is retried after a sharp backoff
+	// instead of the workqueue's exponential one. The condition carries the retry time.
+	reconcileErr := phaseErr
+	if decision.ShouldRequeue {
+		reconcileErr = decision.RequeueError
+	}
+	var circuitOpenUntil time.Time
+	if reconcileErr != nil {
+		if until, failures, opened := p.CircuitBreaker.RecordFailure(circuitKey, obj.GetGeneration()); opened {
+			circuitOpenUntil = until
+			cm.Set(ConditionTypeReconcileThrottled, metav1.ConditionTrue, ReasonCircuitOpen,
+				fmt.Sprintf("Reconciliation failed %d time(s) within %s, last error: %v. Next retry at %s",
+					failures, p.CircuitBreaker.Window(), reconcileErr, until.UTC().Format(time.RFC3339)),
+				AsWarning())
+		} else {
+			cm.Delete(ConditionTypeReconcileThrottled)
+		}
+	} else {
+		p.CircuitBreaker.RecordSuccess(circuitKey)
+		cm.Delete(ConditionTypeReconcileThrottled)
+	}
+	if withFailures, ok := any(status).(StatusWithApplyFailures); ok && applyRan {
+		withFailures.SetApplyFailures(toAPIApplyFailures(applyFailures))
 	}
 
 	// === Phase 8: Update Conditions ===
 	status.SetConditions(cm.Conditions())
+	if withHistory, ok := any(status).(StatusWithTransitionHistory); ok {
+		withHistory.SetTransitionHistory(cm.AppendTransition(
+			withHistory.GetTransitionHistory(), ConditionTypeReady, aimv1alpha1.MaxTransitionHistory))
+	}
 
 	// === Phase 9: Emit Events and Logs ===
 	transitions := DiffConditionTransitions(oldConditions, status.GetConditions())
@@ -373,8 +639,10 @@ func (p *Pipeline[T, S, F, Obs]) Run(ctx context.Context, obj T) (ctrl.Result, e
 	EmitRecurringLogs(ctx, cm)
 
 	// === Phase 10: Update Status ===
-	// ALWAYS update status (even on errors) so users can see what went wrong
-	if !equality.Semantic.DeepEqual(oldStatus, status) {
+	// ALWAYS update status (even on errors) so users can see what went wrong.
+	// With a status batcher, the status is enqueued in Phase 10d instead.
+	statusChanged := !equality.Semantic.DeepEqual(oldStatus, status)
+	if statusChanged && p.StatusBatcher == nil {
 		if err := p.StatusClient.Update(ctx, obj); err != nil {
 			// Conflict errors are expected during concurrent updates (e.g., when child resources
 			// are being reconciled simultaneously). Log at debug level and return nil - the
@@ -387,7 +655,59 @@ func (p *Pipeline[T, S, F, Obs]) Run(ctx context.Context, obj T) (ctrl.Result, e
 		}
 	}
 
+	// === Phase 10a: Send Notifications ===
+	// Only after the status update succeeded, so a conflict retry does not notify twice.
+	// A batched status write sends them once it is written.
+	var notify func(ctx context.Context)
+	if reconcileCtx.MergedRuntimeConfig.Value != nil && reconcileCtx.MergedRuntimeConfig.Value.Notifications != nil {
+		notifications := BuildNotifications(p.kindOf(obj), obj, transitions, status.GetConditions(), decision.Status, time.Now())
+		notify = func(ctx context.Context) {
+			SendNotifications(ctx, p.Notifier, reconcileCtx.MergedRuntimeConfig.Value, notifications)
+		}
+	}
+	// The metadata patches below overwrite the object with the stored status
+	var pendingStatus client.Object
+	if statusChanged && p.StatusBatcher != nil {
+		pendingStatus, _ = obj.DeepCopyObject().(client.Object)
+	} else if notify != nil {
+		notify(ctx)
+	}
+
+	// === Phase 10b: Record Health Label ===
+	// Lets users select resources by health without parsing status; failures are logged only.
+	healthStatus := decision.Status
+	if provider, ok := any(status).(constants.StatusProvider); ok {
+		healthStatus = provider.GetAIMStatus()
+	}
+	if err := RecordHealthLabel(ctx, p.Client, obj, healthStatus); err != nil {
+		logger.V(1).Info("failed to record health label", "error", err)
+	}
+
+	// === Phase 10c: Record Decision Trace ===
+	// Opt-in per object; failures are logged but never fail the reconcile.
+	var applied int
+	if decision.ShouldApply && (applyErr == nil || len(applyFailures) > 0) {
+		applied = applyAttempted - len(applyFailures)
+	}
+	deleted := deleteResult.deleted
+	trace := NewDecisionTrace(obj.GetGeneration(), decision, applied, deleted)
+	if err := RecordDecisionTrace(ctx, p.Client, obj, trace); err != nil {
+		logger.V(1).Info("failed to record decision trace", "error", err)
+	}
+
+	// === Phase 10d: Enqueue Batched Status ===
+	// After the metadata patches above, so that the write carries their resource version.
+	if pendingStatus != nil {
+		pendingStatus.SetResourceVersion(obj.GetResourceVersion())
+		p.StatusBatcher.Enqueue(pendingStatus, notify)
+	}
+
 	// === Phase 11: Return Decision ===
+	// An open circuit replaces the error, so the workqueue does not retry on its own backoff
+	if !circuitOpenUntil.IsZero() {
+		return ctrl.Result{RequeueAfter: time.Until(circuitOpenUntil)}, nil
+	}
+
 	// Return requeue error if infrastructure issues detected (triggers exponential backoff)
 	if decision.ShouldRequeue {
 		return ctrl.Result{}, decision.RequeueError
@@ -400,13 +720,94 @@ func (p *Pipeline[T, S, F, Obs]) Run(ctx context.Context, obj T) (ctrl.Result, e
 
 	// === Phase 12: Return PlanResult RequeueAfter ===
 	// If the reconciler requested a requeue (e.g., blocked by rate limit), honor it
-	if planResult.RequeueAfter > 0 {
-		return ctrl.Result{RequeueAfter: planResult.RequeueAfter}, nil
+	if planResult.RequeueAfter > 0 && (requeueAfter == 0 || planResult.RequeueAfter < requeueAfter) {
+		requeueAfter = planResult.RequeueAfter
+	}
+	if requeueAfter > 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// markComponentApplyFailures attaches per-object apply failures to the component
+// conditions reported this reconcile. Components are matched by the failed object's
+// kind, following the convention that a child's component is named after its kind
+// (e.g. "InferenceService" reports InferenceServiceReady). Failures without a matching
+// component are only listed in status.applyFailures. Reports whether any condition was marked.
+func markComponentApplyFailures(cm *ConditionManager, failures []*ObjectApplyError) bool {
+	marked := map[string]bool{}
+	for _, failure := range failures {
+		conditionType := failure.GVK.Kind + ComponentConditionSuffix
+		if marked[conditionType] || cm.Get(conditionType) == nil {
+			continue
+		}
+		marked[conditionType] = true
+		cm.Set(conditionType, metav1.ConditionFalse, ReasonApplyFailed, failure.Error(), AsError())
+	}
+	return len(marked) > 0
+}
+
+// toAPIApplyFailures converts per-object apply failures into their status form,
+// capped at MaxApplyFailures.
+func toAPIApplyFailures(failures []*ObjectApplyError) []aimv1alpha1.AIMApplyFailure {
+	if len(failures) == 0 {
+		return nil
+	}
+	result := make([]aimv1alpha1.AIMApplyFailure, 0, min(len(failures), aimv1alpha1.MaxApplyFailures))
+	for _, failure := range failures {
+		if len(result) == aimv1alpha1.MaxApplyFailures {
+			break
+		}
+		result = append(result, aimv1alpha1.AIMApplyFailure{
+			APIVersion: failure.GVK.GroupVersion().String(),
+			Kind:       failure.GVK.Kind,
+			Namespace:  failure.Key.Namespace,
+			Name:       failure.Key.Name,
+			Message:    failure.Err.Error(),
+		})
+	}
+	return result
+}
+
+// runtimeDependencyMessage reports whether any of errs means the API server does not
+// serve a requested kind, and describes the missing group, kind and versions.
+func runtimeDependencyMessage(errs ...error) (string, bool) {
+	for _, err := range errs {
+		if err == nil || !meta.IsNoMatchError(err) {
+			continue
+		}
+		var kindErr *meta.NoKindMatchError
+		if errors.As(err, &kindErr) {
+			gk := kindErr.GroupKind
+			if gk.Group == "" {
+				gk.Group = "core"
+			}
+			return fmt.Sprintf("%s.%s (versions %s) is not served by the API server; install or upgrade the CRD that provides it",
+				gk.Kind, gk.Group, strings.Join(kindErr.SearchedVersions, ", ")), true
+		}
+		var resourceErr *meta.NoResourceMatchError
+		if errors.As(err, &resourceErr) {
+			gvr := resourceErr.PartialResource
+			return fmt.Sprintf("%s is not served by the API server; install or upgrade the CRD that provides it",
+				gvr.String()), true
+		}
+		return fmt.Sprintf("Managed resource kind is not served by the API server: %v", err), true
+	}
+	return "", false
+}
+
+// kindOf returns the kind of the object, resolved from the scheme since typed objects
+// usually have empty TypeMeta.
+func (p *Pipeline[T, S, F, Obs]) kindOf(obj T) string {
+	if p.Scheme != nil {
+		if gvk, err := apiutil.GVKForObject(obj, p.Scheme); err == nil {
+			return gvk.Kind
+		}
+	}
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
+
 // errorCategories holds the results of error categorization from component health.
 type errorCategories struct {
 	hasInfra                bool
@@ -415,6 +816,28 @@ type errorCategories struct {
 	hasMissingUpstreamDep   bool // Missing dependencies that will not self-heal (e.g., required secret or config)
 	hasInvalidSpec          bool
 	infraErrors             []error
+	fieldErrors             []StateEngineError // InvalidSpec errors tied to a spec field
+}
+
+// categories returns the error categories that were found, in a stable order.
+func (c errorCategories) categories() []ErrorCategory {
+	var result []ErrorCategory
+	if c.hasInfra {
+		result = append(result, ErrorCategoryInfrastructure)
+	}
+	if c.hasAuth {
+		result = append(result, ErrorCategoryAuth)
+	}
+	if c.hasMissingDownstreamDep {
+		result = append(result, ErrorCategoryMissingDownstreamDependency)
+	}
+	if c.hasMissingUpstreamDep {
+		result = append(result, ErrorCategoryMissingUpstreamDependency)
+	}
+	if c.hasInvalidSpec {
+		result = append(result, ErrorCategoryInvalidSpec)
+	}
+	return result
 }
 
 // categorizeComponentErrors collects and categorizes all errors from component health.
@@ -440,6 +863,9 @@ func categorizeComponentErrors(componentHealth []ComponentHealth) errorCategorie
 				result.hasMissingUpstreamDep = true
 			case ErrorCategoryInvalidSpec:
 				result.hasInvalidSpec = true
+				if categorized.FieldPath() != "" {
+					result.fieldErrors = append(result.fieldErrors, categorized)
+				}
 			case ErrorCategoryUnknown:
 				// Unknown errors are treated as infrastructure errors (retriable)
 				result.hasInfra = true
@@ -537,7 +963,7 @@ func setErrorCategoryConditions(cm *ConditionManager, cats errorCategories) {
 	}
 
 	if cats.hasInvalidSpec {
-		cm.Set(ConditionTypeConfigValid, metav1.ConditionFalse, ReasonInvalidSpec, MessageInvalidSpec, AsError())
+		cm.Set(ConditionTypeConfigValid, metav1.ConditionFalse, ReasonInvalidSpec, invalidSpecMessage(cats.fieldErrors), AsError())
 	} else if cats.hasMissingUpstreamDep {
 		cm.Set(ConditionTypeConfigValid, metav1.ConditionFalse, ReasonMissingRef, MessageMissingRef, AsError())
 	} else if oldConfigValid := cm.Get(ConditionTypeConfigValid); oldConfigValid != nil && oldConfigValid.Status == metav1.ConditionFalse {
@@ -545,12 +971,58 @@ func setErrorCategoryConditions(cm *ConditionManager, cats errorCategories) {
 	}
 }
 
+// invalidSpecMessage summarizes the invalid fields for the ConfigValid condition.
+// The individual messages are listed in status.validationErrors.
+func invalidSpecMessage(fieldErrors []StateEngineError) string {
+	paths := validationErrorPaths(fieldErrors)
+	if len(paths) == 0 {
+		return MessageInvalidSpec
+	}
+	return fmt.Sprintf("%s: %d invalid field(s): %s", MessageInvalidSpec, len(paths), strings.Join(paths, ", "))
+}
+
+// validationErrorPaths returns the distinct field paths of the errors, sorted.
+func validationErrorPaths(fieldErrors []StateEngineError) []string {
+	var paths []string
+	for _, err := range fieldErrors {
+		if !slices.Contains(paths, err.FieldPath()) {
+			paths = append(paths, err.FieldPath())
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// toAPIValidationErrors converts InvalidSpec field errors into their status form, sorted by
+// field path with duplicates removed and capped at MaxValidationErrors.
+func toAPIValidationErrors(fieldErrors []StateEngineError) []aimv1alpha1.AIMValidationError {
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+	result := make([]aimv1alpha1.AIMValidationError, 0, len(fieldErrors))
+	for _, err := range fieldErrors {
+		entry := aimv1alpha1.AIMValidationError{
+			FieldPath: err.FieldPath(),
+			Reason:    err.Reason(),
+			Message:   err.UserMessage(),
+		}
+		if !slices.Contains(result, entry) {
+			result = append(result, entry)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].FieldPath < result[j].FieldPath })
+	if len(result) > aimv1alpha1.MaxValidationErrors {
+		result = result[:aimv1alpha1.MaxValidationErrors]
+	}
+	return result
+}
+
 // deriveStatusAndSetReadyCondition analyzes all component conditions, derives the root status,
 // and sets the Ready condition. This should be called after DecorateStatus to ensure all
 // conditions (including domain-specific ones) are considered.
 //
 // Returns the derived root status.
-func deriveStatusAndSetReadyCondition(cm *ConditionManager, cats errorCategories, componentHealth []ComponentHealth) constants.AIMStatus {
+func deriveStatusAndSetReadyCondition(cm *ConditionManager, cats errorCategories, componentHealth []ComponentHealth, readinessGates []string) constants.AIMStatus {
 	// Check for error category conditions first (highest priority)
 	if status, handled := handleErrorCategories(cm, cats); handled {
 		return status
@@ -560,7 +1032,7 @@ func deriveStatusAndSetReadyCondition(cm *ConditionManager, cats errorCategories
 	componentStates, componentDepTypes := buildComponentMaps(componentHealth)
 
 	// Scan all component conditions and aggregate results
-	scanResult := scanComponentConditions(cm, componentStates, componentDepTypes)
+	scanResult := scanComponentConditions(cm, componentStates, componentDepTypes, readinessGates)
 
 	// Set Ready condition based on scan results
 	setReadyConditionFromScan(cm, scanResult, cats)
@@ -613,8 +1085,8 @@ type componentScanResult struct {
 	firstErrorMessage   string
 }
 
-// scanComponentConditions scans all component Ready conditions and aggregates their status.
-func scanComponentConditions(cm *ConditionManager, componentStates map[string]constants.AIMStatus, componentDepTypes map[string]DependencyType) componentScanResult {
+// scanComponentConditions scans all component Ready conditions and readiness gates and aggregates their status.
+func scanComponentConditions(cm *ConditionManager, componentStates map[string]constants.AIMStatus, componentDepTypes map[string]DependencyType, readinessGates []string) componentScanResult {
 	result := componentScanResult{
 		allReady:    true,
 		worstStatus: constants.AIMStatusReady,
@@ -624,11 +1096,14 @@ func scanComponentConditions(cm *ConditionManager, componentStates map[string]co
 	// since the number of conditions is typically small (< 10) and this is called once per reconcile.
 	allConditions := cm.Conditions()
 	for _, cond := range allConditions {
-		if !isComponentCondition(cond.Type) {
+		componentName := cond.Type
+		switch {
+		case slices.Contains(readinessGates, cond.Type):
+		case isComponentCondition(cond.Type):
+			componentName = strings.TrimSuffix(cond.Type, ComponentConditionSuffix)
+		default:
 			continue
 		}
-
-		componentName := strings.TrimSuffix(cond.Type, ComponentConditionSuffix)
 		componentStatus := deriveComponentStatus(cond.Status, componentName, componentStates, componentDepTypes)
 
 		// Process the component status
@@ -751,14 +1226,19 @@ func (p *Pipeline[T, S, F, Obs]) processStateEngine(
 
 	// Categorize errors
 	cats := categorizeComponentErrors(componentHealth)
+	waitingOn := collectWaitingOn(componentHealth)
+	if withValidation, ok := any(status).(StatusWithValidationErrors); ok {
+		withValidation.SetValidationErrors(toAPIValidationErrors(cats.fieldErrors))
+	}
 
 	// Manual mode: reconciler owns status & conditions
 	if manual, ok := any(p.Reconciler).(ManualStatusController[T, S, Obs]); ok {
 		manual.SetStatus(status, cm, obs)
 		if cats.hasInfra {
-			return StateEngineDecision{ShouldApply: false, ShouldRequeue: true, RequeueError: errors.Join(cats.infraErrors...)}, nil
+			return StateEngineDecision{ShouldApply: false, ShouldRequeue: true, RequeueError: errors.Join(cats.infraErrors...),
+				ErrorCategories: cats.categories(), WaitingOn: waitingOn}, nil
 		}
-		return StateEngineDecision{ShouldApply: true, ShouldRequeue: false}, nil
+		return StateEngineDecision{ShouldApply: true, ShouldRequeue: false, ErrorCategories: cats.categories(), WaitingOn: waitingOn}, nil
 	}
 
 	// Set DependenciesReachable condition
@@ -781,17 +1261,34 @@ func (p *Pipeline[T, S, F, Obs]) processStateEngine(
 
 	// Derive root status and set Ready condition after DecorateStatus has had a chance to add conditions.
 	// This ensures all conditions (including domain-specific ones) are considered.
-	derivedStatus := deriveStatusAndSetReadyCondition(cm, cats, componentHealth)
+	var readinessGates []string
+	if gates, ok := any(p.Reconciler).(ReadinessGateProvider); ok {
+		readinessGates = gates.ReadinessGates()
+	}
+	derivedStatus := deriveStatusAndSetReadyCondition(cm, cats, componentHealth, readinessGates)
 	status.SetStatus(string(derivedStatus))
 
 	// Determine behavior
 	if cats.hasInfra {
 		infraErr := InfrastructureError{Count: len(cats.infraErrors), Errors: cats.infraErrors}
-		return StateEngineDecision{ShouldApply: false, ShouldRequeue: true, RequeueError: infraErr}, nil
+		return StateEngineDecision{ShouldApply: false, ShouldRequeue: true, RequeueError: infraErr,
+			ErrorCategories: cats.categories(), WithinGracePeriod: withinGracePeriod, WaitingOn: waitingOn, Status: derivedStatus}, nil
 	}
 	// Block apply if auth, invalid spec, or missing upstream dependencies
 	shouldApply := !cats.hasAuth && !cats.hasInvalidSpec && !cats.hasMissingUpstreamDep
-	return StateEngineDecision{ShouldApply: shouldApply, ShouldRequeue: false}, nil
+	return StateEngineDecision{ShouldApply: shouldApply, ShouldRequeue: false,
+		ErrorCategories: cats.categories(), WithinGracePeriod: withinGracePeriod, WaitingOn: waitingOn, Status: derivedStatus}, nil
+}
+
+// collectWaitingOn returns the objects that not-ready components are waiting on, in component order.
+func collectWaitingOn(componentHealth []ComponentHealth) []ObjectRef {
+	var refs []ObjectRef
+	for _, h := range componentHealth {
+		if h.WaitingOn != nil && h.GetState() != constants.AIMStatusReady {
+			refs = append(refs, *h.WaitingOn)
+		}
+	}
+	return refs
 }
 
 // deriveStatusFromDependencyType derives the status for a not-ready component based on its dependency type.
@@ -854,6 +1351,27 @@ type StatusWithConditions interface {
 	SetStatus(string)
 }
 
+// StatusWithTransitionHistory is implemented by status types that keep a bounded
+// history of Ready condition transitions.
+type StatusWithTransitionHistory interface {
+	GetTransitionHistory() []aimv1alpha1.AIMConditionTransition
+	SetTransitionHistory([]aimv1alpha1.AIMConditionTransition)
+}
+
+// StatusWithApplyFailures is implemented by status types that list the child objects
+// that failed to apply in the last reconcile.
+type StatusWithApplyFailures interface {
+	GetApplyFailures() []aimv1alpha1.AIMApplyFailure
+	SetApplyFailures([]aimv1alpha1.AIMApplyFailure)
+}
+
+// StatusWithValidationErrors is implemented by status types that list the spec fields
+// that failed validation in the last reconcile.
+type StatusWithValidationErrors interface {
+	GetValidationErrors() []aimv1alpha1.AIMValidationError
+	SetValidationErrors([]aimv1alpha1.AIMValidationError)
+}
+
 // ObjectWithStatus is a constraint for objects that have a Status field with conditions.
 type ObjectWithStatus[S StatusWithConditions] interface {
 	runtime.Object
@@ -26,18 +26,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
 	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
@@ -61,6 +65,7 @@ func (t *testObject) DeepCopyObject() runtime.Object {
 	out := &testObject{}
 	*out = *t
 	out.Status.Conditions = append([]metav1.Condition(nil), t.Status.Conditions...)
+	out.Status.ApplyFailures = append([]aimv1alpha1.AIMApplyFailure(nil), t.Status.ApplyFailures...)
 	return out
 }
 
@@ -69,8 +74,9 @@ func (t *testObject) GetStatus() *testStatus {
 }
 
 type testStatus struct {
-	Status     string             `json:"status"`
-	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	Status        string                        `json:"status"`
+	Conditions    []metav1.Condition            `json:"conditions,omitempty"`
+	ApplyFailures []aimv1alpha1.AIMApplyFailure `json:"applyFailures,omitempty"`
 }
 
 func (t *testStatus) GetConditions() []metav1.Condition {
@@ -85,6 +91,14 @@ func (t *testStatus) SetStatus(status string) {
 	t.Status = status
 }
 
+func (t *testStatus) GetApplyFailures() []aimv1alpha1.AIMApplyFailure {
+	return t.ApplyFailures
+}
+
+func (t *testStatus) SetApplyFailures(failures []aimv1alpha1.AIMApplyFailure) {
+	t.ApplyFailures = failures
+}
+
 type testFetch struct {
 	ModelReady bool
 }
@@ -357,6 +371,64 @@ func TestPipeline_processStateEngine_Success(t *testing.T) {
 	}
 }
 
+// testReconcilerGated decorates the status with a running pod whose model is still loading,
+// and gates Ready on the model condition.
+type testReconcilerGated struct {
+	testReconciler
+	modelLoaded metav1.ConditionStatus
+}
+
+func (r *testReconcilerGated) DecorateStatus(status *testStatus, cm *ConditionManager, obs testObservation) {
+	cm.Set("PodReady", metav1.ConditionTrue, "PodsRunning", "1 of 1 pods are running")
+	cm.Set("ModelLoaded", r.modelLoaded, "ModelLoading", "Pods are running but model weights are still loading")
+}
+
+func (r *testReconcilerGated) ReadinessGates() []string {
+	return []string{"ModelLoaded"}
+}
+
+func TestPipeline_processStateEngine_ReadinessGates(t *testing.T) {
+	tests := []struct {
+		name        string
+		modelLoaded metav1.ConditionStatus
+		wantReady   metav1.ConditionStatus
+		wantStatus  constants.AIMStatus
+	}{
+		{
+			name:        "pods ready, model not loaded",
+			modelLoaded: metav1.ConditionFalse,
+			wantReady:   metav1.ConditionFalse,
+			wantStatus:  constants.AIMStatusProgressing,
+		},
+		{
+			name:        "pods ready, model loaded",
+			modelLoaded: metav1.ConditionTrue,
+			wantReady:   metav1.ConditionTrue,
+			wantStatus:  constants.AIMStatusReady,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := NewConditionManager(nil)
+			p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+				Reconciler: &testReconcilerGated{modelLoaded: tt.modelLoaded},
+			}
+
+			decision, err := p.processStateEngine(context.Background(), testObservation{modelReady: true}, cm, &testStatus{})
+			if err != nil {
+				t.Fatalf("processStateEngine returned error: %v", err)
+			}
+			if ready := cm.Get(ConditionTypeReady); ready == nil || ready.Status != tt.wantReady {
+				t.Errorf("Ready = %+v, want %s", ready, tt.wantReady)
+			}
+			if decision.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", decision.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
 func TestPipeline_processStateEngine_InfrastructureError(t *testing.T) {
 	// Test processStateEngine with infrastructure error
 	infraErr := NewInfrastructureError("NetworkTimeout", "Network timeout", errors.New("timeout"))
@@ -1811,6 +1883,257 @@ func TestPipeline_Run_ApplyError_SetsDependenciesReachable(t *testing.T) {
 	}
 }
 
+func TestPipeline_Run_NoKindMatch_SetsRuntimeDependencyMissing(t *testing.T) {
+	// Test that apply errors for kinds the API server does not serve set a persistent
+	// RuntimeDependencyMissing condition instead of returning an infrastructure error
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	scheme.AddKnownTypes(schema.GroupVersion{Group: "test.k8s.io", Version: "v1"}, &testObject{})
+
+	obj := &testObject{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "test.k8s.io/v1",
+			Kind:       "TestObject",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-obj",
+			Namespace:         "default",
+			CreationTimestamp: metav1.Now(),
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj).WithStatusSubresource(obj).Build()
+
+	reconciler := &testReconcilerWithPlan{
+		fetchResult: testFetch{ModelReady: true},
+		planResult: PlanResult{
+			toApply: []client.Object{
+				&testObject{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "test.k8s.io/v1",
+						Kind:       "TestObject",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "child-resource",
+						Namespace: "default",
+					},
+				},
+			},
+		},
+	}
+
+	p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+		Client:         &noKindMatchClient{Client: fakeClient},
+		StatusClient:   fakeClient.Status(),
+		Recorder:       record.NewFakeRecorder(10),
+		Reconciler:     reconciler,
+		Scheme:         scheme,
+		ControllerName: "test",
+	}
+
+	result, err := p.Run(context.Background(), obj)
+	if err != nil {
+		t.Fatalf("Expected no error for missing kind, got %v", err)
+	}
+	if result.RequeueAfter != runtimeDependencyRecheckInterval {
+		t.Errorf("Expected RequeueAfter %v, got %v", runtimeDependencyRecheckInterval, result.RequeueAfter)
+	}
+
+	missing := findCondition(obj.Status.Conditions, ConditionTypeRuntimeDependencyMissing)
+	if missing == nil || missing.Status != metav1.ConditionTrue {
+		t.Fatalf("RuntimeDependencyMissing should be True, got %+v", missing)
+	}
+	if !strings.Contains(missing.Message, "InferenceService.serving.kserve.io") || !strings.Contains(missing.Message, "v1beta1") {
+		t.Errorf("RuntimeDependencyMissing message should name the missing kind, got: %s", missing.Message)
+	}
+	ready := findCondition(obj.Status.Conditions, ConditionTypeReady)
+	if ready == nil || ready.Status != metav1.ConditionFalse || ready.Reason != ReasonRuntimeDependencyMissing {
+		t.Errorf("Ready should be False with reason %s, got %+v", ReasonRuntimeDependencyMissing, ready)
+	}
+	if obj.Status.Status != string(constants.AIMStatusFailed) {
+		t.Errorf("Expected status Failed, got %s", obj.Status.Status)
+	}
+
+	// Once the kind is served again, the condition is cleared
+	p.Client = fakeClient
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("Unexpected error after kind became available: %v", err)
+	}
+	if cond := findCondition(obj.Status.Conditions, ConditionTypeRuntimeDependencyMissing); cond != nil {
+		t.Errorf("RuntimeDependencyMissing should be cleared, got %+v", cond)
+	}
+}
+
+func TestPipeline_Run_PartialApply_ReportsFailures(t *testing.T) {
+	// Test that one failing object does not stop the others from being applied,
+	// and that the failure is listed in status.applyFailures until it heals
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	scheme.AddKnownTypes(schema.GroupVersion{Group: "test.k8s.io", Version: "v1"}, &testObject{})
+
+	obj := &testObject{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "test.k8s.io/v1",
+			Kind:       "TestObject",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-obj",
+			Namespace:         "default",
+			CreationTimestamp: metav1.Now(),
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj).WithStatusSubresource(obj).Build()
+
+	child := func(name string) client.Object {
+		return &testObject{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "test.k8s.io/v1", Kind: "TestObject"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		}
+	}
+	reconciler := &testReconcilerWithPlan{
+		fetchResult: testFetch{ModelReady: true},
+		planResult: PlanResult{
+			toApply:                []client.Object{child("a-bad"), child("b-good")},
+			toApplyWithoutOwnerRef: []client.Object{child("c-unowned")},
+		},
+	}
+
+	recordingClient := &selectiveFailingApplyClient{Client: fakeClient, failName: "a-bad"}
+	p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+		Client:         recordingClient,
+		StatusClient:   fakeClient.Status(),
+		Recorder:       record.NewFakeRecorder(10),
+		Reconciler:     reconciler,
+		Scheme:         scheme,
+		ControllerName: "test",
+	}
+
+	_, err := p.Run(context.Background(), obj)
+	var infraErr InfrastructureError
+	if !errors.As(err, &infraErr) || infraErr.Count != 1 {
+		t.Fatalf("Expected InfrastructureError with one failure, got %v", err)
+	}
+
+	if len(recordingClient.applied) != 2 {
+		t.Errorf("Expected the two healthy objects to be applied, got %v", recordingClient.applied)
+	}
+	if len(obj.Status.ApplyFailures) != 1 {
+		t.Fatalf("Expected one apply failure in status, got %+v", obj.Status.ApplyFailures)
+	}
+	failure := obj.Status.ApplyFailures[0]
+	if failure.Name != "a-bad" || failure.Namespace != "default" || failure.APIVersion != "test.k8s.io/v1" {
+		t.Errorf("Unexpected apply failure entry: %+v", failure)
+	}
+
+	// Once the object applies, the failures are cleared
+	recordingClient.failName = ""
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("Unexpected error after failure healed: %v", err)
+	}
+	if len(obj.Status.ApplyFailures) != 0 {
+		t.Errorf("Expected apply failures to be cleared, got %+v", obj.Status.ApplyFailures)
+	}
+}
+
+func TestMarkComponentApplyFailures(t *testing.T) {
+	cm := NewConditionManager(nil)
+	cm.MarkTrue("InferenceServiceReady", "Ready", "InferenceService is ready")
+
+	failures := []*ObjectApplyError{
+		{
+			GVK: schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"},
+			Key: client.ObjectKey{Namespace: "default", Name: "isvc"},
+			Err: errors.New("admission webhook denied the request"),
+		},
+		{
+			GVK: schema.GroupVersionKind{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
+			Key: client.ObjectKey{Namespace: "default", Name: "hpa"},
+			Err: errors.New("denied"),
+		},
+	}
+
+	if !markComponentApplyFailures(cm, failures) {
+		t.Fatal("Expected a component condition to be marked")
+	}
+	cond := cm.Get("InferenceServiceReady")
+	if cond.Status != metav1.ConditionFalse || cond.Reason != ReasonApplyFailed {
+		t.Errorf("Expected InferenceServiceReady=False/%s, got %s/%s", ReasonApplyFailed, cond.Status, cond.Reason)
+	}
+	if !strings.Contains(cond.Message, "admission webhook denied") {
+		t.Errorf("Expected message to carry the apply error, got %s", cond.Message)
+	}
+	if cm.Get("HorizontalPodAutoscalerReady") != nil {
+		t.Error("Failures without a reported component should not create conditions")
+	}
+}
+
+func TestCategorizeComponentErrors_CollectsFieldErrors(t *testing.T) {
+	health := []ComponentHealth{
+		{Component: "Routing", Errors: []error{
+			NewInvalidSpecFieldError("GatewayNotConfigured", "spec.routing.gatewayRef", "no gateway", nil),
+		}},
+		{Component: "RequestLogging", Errors: []error{
+			NewInvalidSpecFieldError("InvalidRequestLogging", "spec.observability.requestLogging.sampleRate", "bad rate", nil),
+			NewInvalidSpecError("PodFailed", "pod failed", nil),
+		}},
+	}
+
+	cats := categorizeComponentErrors(health)
+	if !cats.hasInvalidSpec {
+		t.Fatal("Expected invalid spec category")
+	}
+	if len(cats.fieldErrors) != 2 {
+		t.Fatalf("Expected 2 field errors, got %d", len(cats.fieldErrors))
+	}
+
+	got := toAPIValidationErrors(cats.fieldErrors)
+	want := []aimv1alpha1.AIMValidationError{
+		{FieldPath: "spec.observability.requestLogging.sampleRate", Reason: "InvalidRequestLogging", Message: "bad rate"},
+		{FieldPath: "spec.routing.gatewayRef", Reason: "GatewayNotConfigured", Message: "no gateway"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	cm := NewConditionManager(nil)
+	setErrorCategoryConditions(cm, cats)
+	cond := cm.Get(ConditionTypeConfigValid)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != ReasonInvalidSpec {
+		t.Fatalf("Expected ConfigValid=False/%s, got %v", ReasonInvalidSpec, cond)
+	}
+	wantMessage := MessageInvalidSpec + ": 2 invalid field(s): spec.observability.requestLogging.sampleRate, spec.routing.gatewayRef"
+	if cond.Message != wantMessage {
+		t.Errorf("Expected message %q, got %q", wantMessage, cond.Message)
+	}
+}
+
+func TestToAPIValidationErrors_DeduplicatesAndCaps(t *testing.T) {
+	if got := toAPIValidationErrors(nil); got != nil {
+		t.Errorf("Expected nil without field errors, got %v", got)
+	}
+
+	var fieldErrors []StateEngineError
+	for i := 0; i < aimv1alpha1.MaxValidationErrors+5; i++ {
+		fieldErrors = append(fieldErrors, NewInvalidSpecFieldError("Invalid", fmt.Sprintf("spec.field%02d", i), "invalid", nil))
+	}
+	fieldErrors = append(fieldErrors, NewInvalidSpecFieldError("Invalid", "spec.field00", "invalid", nil))
+
+	got := toAPIValidationErrors(fieldErrors)
+	if len(got) != aimv1alpha1.MaxValidationErrors {
+		t.Fatalf("Expected %d entries, got %d", aimv1alpha1.MaxValidationErrors, len(got))
+	}
+	if got[0].FieldPath != "spec.field00" || got[1].FieldPath != "spec.field01" {
+		t.Errorf("Expected sorted, deduplicated entries, got %v", got[:2])
+	}
+}
+
+func TestInvalidSpecMessage_WithoutFieldErrors(t *testing.T) {
+	if got := invalidSpecMessage(nil); got != MessageInvalidSpec {
+		t.Errorf("Expected %q, got %q", MessageInvalidSpec, got)
+	}
+}
+
 func TestPipeline_Run_DeleteError_SetsDependenciesReachable(t *testing.T) {
 	// Test that delete errors set DependenciesReachable=False and return InfrastructureError
 	scheme := runtime.NewScheme()
@@ -1963,6 +2286,37 @@ func (c *failingApplyClient) Patch(ctx context.Context, obj client.Object, patch
 	return errors.New("simulated apply failure: insufficient permissions")
 }
 
+// Helper type for testing partial apply: fails only the object named failName
+type selectiveFailingApplyClient struct {
+	client.Client
+	failName string
+	applied  []string
+}
+
+func (c *selectiveFailingApplyClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch.Type() != types.ApplyPatchType {
+		// Metadata patches of the reconciled object itself, e.g. the health label
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	}
+	if obj.GetName() == c.failName {
+		return errors.New("simulated apply failure: admission webhook denied the request")
+	}
+	c.applied = append(c.applied, obj.GetName())
+	return nil
+}
+
+// Helper type for testing apply of kinds the API server does not serve
+type noKindMatchClient struct {
+	client.Client
+}
+
+func (c *noKindMatchClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return &meta.NoKindMatchError{
+		GroupKind:        schema.GroupKind{Group: "serving.kserve.io", Kind: "InferenceService"},
+		SearchedVersions: []string{"v1beta1"},
+	}
+}
+
 // Helper type for testing delete failures
 type failingDeleteClient struct {
 	client.Client
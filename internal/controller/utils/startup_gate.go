@@ -0,0 +1,189 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get
+
+// startupGatePollInterval is how often the startup gate checks its dependencies.
+const startupGatePollInterval = 5 * time.Second
+
+// StartupDependency is a deployment that must be available before the controllers start
+// processing their workqueues, e.g. the KServe controller or the AMD GPU operator.
+type StartupDependency struct {
+	// Name identifies the dependency in logs.
+	Name string
+	// Namespace and Deployment locate the deployment.
+	Namespace  string
+	Deployment string
+}
+
+// ParseStartupDependency parses a "namespace/deployment" reference. An empty value returns
+// false, so that a dependency can be disabled by clearing its flag.
+func ParseStartupDependency(name, value string) (StartupDependency, bool, error) {
+	if value == "" {
+		return StartupDependency{}, false, nil
+	}
+	namespace, deployment, ok := strings.Cut(value, "/")
+	if !ok || namespace == "" || deployment == "" || strings.Contains(deployment, "/") {
+		return StartupDependency{}, false, fmt.Errorf("invalid %s deployment %q, expected namespace/name", name, value)
+	}
+	return StartupDependency{Name: name, Namespace: namespace, Deployment: deployment}, true, nil
+}
+
+// StartupGate holds back the controller workqueues until its dependencies are available,
+// so that a cold cluster boot does not produce a burst of infrastructure errors while the
+// KServe controller or the GPU operator are still starting. The gate opens once all
+// dependencies are available, when the timeout expires, or when the manager stops.
+// Events are still queued while the gate is closed. A nil gate is always open.
+type StartupGate struct {
+	clientset    kubernetes.Interface
+	dependencies []StartupDependency
+	timeout      time.Duration
+	pollInterval time.Duration
+
+	once   sync.Once
+	opened chan struct{}
+}
+
+// NewStartupGate creates a gate that waits up to timeout for the dependencies.
+func NewStartupGate(clientset kubernetes.Interface, timeout time.Duration, dependencies ...StartupDependency) *StartupGate {
+	return &StartupGate{
+		clientset:    clientset,
+		dependencies: dependencies,
+		timeout:      timeout,
+		pollInterval: startupGatePollInterval,
+		opened:       make(chan struct{}),
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica gates its own queues.
+func (g *StartupGate) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable. It polls the dependencies until they are available or the
+// timeout expires, then opens the gate and returns.
+func (g *StartupGate) Start(ctx context.Context) error {
+	defer g.open()
+	logger := logf.FromContext(ctx).WithName("startup-gate")
+	deadline := time.NewTimer(g.timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(g.pollInterval)
+	defer ticker.Stop()
+
+	var lastWaiting string
+	for {
+		waiting := g.unavailable(ctx)
+		if len(waiting) == 0 {
+			logger.Info("startup dependencies are available, starting controllers")
+			return nil
+		}
+		if summary := strings.Join(waiting, "; "); summary != lastWaiting {
+			logger.Info("waiting for startup dependencies", "waiting", waiting, "timeout", g.timeout)
+			lastWaiting = summary
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-deadline.C:
+			logger.Error(nil, "startup dependencies are not available, starting controllers anyway",
+				"waiting", waiting, "timeout", g.timeout)
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// unavailable returns a description of every dependency that is not available yet.
+func (g *StartupGate) unavailable(ctx context.Context) []string {
+	var waiting []string
+	for _, dep := range g.dependencies {
+		if reason := g.check(ctx, dep); reason != "" {
+			waiting = append(waiting, fmt.Sprintf("%s (%s/%s): %s", dep.Name, dep.Namespace, dep.Deployment, reason))
+		}
+	}
+	return waiting
+}
+
+// check returns why the dependency is not available, or an empty string if it is.
+func (g *StartupGate) check(ctx context.Context, dep StartupDependency) string {
+	deployment, err := g.clientset.AppsV1().Deployments(dep.Namespace).Get(ctx, dep.Deployment, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "deployment not found"
+	}
+	if err != nil {
+		return err.Error()
+	}
+	for _, cond := range deployment.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			if cond.Status == corev1.ConditionTrue && deployment.Status.AvailableReplicas > 0 {
+				return ""
+			}
+			if cond.Message != "" {
+				return cond.Message
+			}
+		}
+	}
+	return "deployment has no available replicas"
+}
+
+func (g *StartupGate) open() {
+	g.once.Do(func() { close(g.opened) })
+}
+
+// Wait blocks until the gate is open.
+func (g *StartupGate) Wait() {
+	if g == nil {
+		return
+	}
+	<-g.opened
+}
+
+// IsOpen reports whether the gate is open.
+func (g *StartupGate) IsOpen() bool {
+	if g == nil {
+		return true
+	}
+	select {
+	case <-g.opened:
+		return true
+	default:
+		return false
+	}
+}
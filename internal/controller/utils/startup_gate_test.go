@@ -0,0 +1,142 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseStartupDependency(t *testing.T) {
+	dep, ok, err := ParseStartupDependency("kserve", "kserve/kserve-controller-manager")
+	if err != nil || !ok {
+		t.Fatalf("expected a dependency, got ok=%v err=%v", ok, err)
+	}
+	if dep.Namespace != "kserve" || dep.Deployment != "kserve-controller-manager" || dep.Name != "kserve" {
+		t.Errorf("unexpected dependency %+v", dep)
+	}
+
+	if _, ok, err := ParseStartupDependency("kserve", ""); ok || err != nil {
+		t.Errorf("expected an empty value to disable the dependency, got ok=%v err=%v", ok, err)
+	}
+	for _, value := range []string{"kserve", "/name", "kserve/", "a/b/c"} {
+		if _, _, err := ParseStartupDependency("kserve", value); err == nil {
+			t.Errorf("expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestStartupGate_Nil(t *testing.T) {
+	var gate *StartupGate
+	if !gate.IsOpen() {
+		t.Error("expected a nil gate to be open")
+	}
+	gate.Wait()
+}
+
+func availableDeployment(namespace, name string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Status: appsv1.DeploymentStatus{
+			AvailableReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func TestStartupGate_OpensWhenAvailable(t *testing.T) {
+	clientset := kubefake.NewSimpleClientset(availableDeployment("kserve", "kserve-controller-manager"))
+	gate := NewStartupGate(clientset, time.Hour, StartupDependency{
+		Name: "kserve", Namespace: "kserve", Deployment: "kserve-controller-manager",
+	})
+	if gate.IsOpen() {
+		t.Fatal("expected the gate to be closed before it starts")
+	}
+	if err := gate.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gate.IsOpen() {
+		t.Error("expected the gate to open once the dependency is available")
+	}
+}
+
+func TestStartupGate_OpensOnTimeout(t *testing.T) {
+	clientset := kubefake.NewSimpleClientset()
+	gate := NewStartupGate(clientset, 20*time.Millisecond, StartupDependency{
+		Name: "gpu-operator", Namespace: "kube-amd-gpu", Deployment: "operator",
+	})
+	gate.pollInterval = 5 * time.Millisecond
+	if got := gate.check(context.Background(), gate.dependencies[0]); got != "deployment not found" {
+		t.Errorf("expected a missing deployment, got %q", got)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		gate.Wait()
+		close(done)
+	}()
+	if err := gate.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Wait to return once the timeout expired")
+	}
+}
+
+func TestStartupGate_OpensOnShutdown(t *testing.T) {
+	gate := NewStartupGate(kubefake.NewSimpleClientset(), time.Hour, StartupDependency{
+		Name: "kserve", Namespace: "kserve", Deployment: "missing",
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := gate.Start(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gate.IsOpen() {
+		t.Error("expected the gate to open when the manager stops")
+	}
+}
+
+func TestStartupGate_UnavailableDeployment(t *testing.T) {
+	deployment := availableDeployment("kserve", "kserve-controller-manager")
+	deployment.Status.AvailableReplicas = 0
+	deployment.Status.Conditions[0].Status = corev1.ConditionFalse
+	deployment.Status.Conditions[0].Message = "Deployment does not have minimum availability."
+	gate := NewStartupGate(kubefake.NewSimpleClientset(deployment), time.Hour)
+	got := gate.check(context.Background(), StartupDependency{
+		Name: "kserve", Namespace: "kserve", Deployment: "kserve-controller-manager",
+	})
+	if got != "Deployment does not have minimum availability." {
+		t.Errorf("expected the condition message, got %q", got)
+	}
+}
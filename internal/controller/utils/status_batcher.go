@@ -0,0 +1,214 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// maxStatusWriteAttempts is how often a failing status write is retried before it is dropped.
+	// The next reconcile of the object enqueues the status again.
+	maxStatusWriteAttempts = 3
+
+	// statusBatcherShutdownTimeout bounds the final flush when the manager stops.
+	statusBatcherShutdownTimeout = 10 * time.Second
+)
+
+var (
+	// StatusWrites counts the status writes of the batcher by result.
+	StatusWrites = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aim_status_writes_total",
+		Help: "Status writes of the status batcher by result (written, conflict, failed).",
+	}, []string{"result"})
+
+	// StatusWritesCoalesced counts the status updates that replaced a pending write of the same object.
+	StatusWritesCoalesced = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "aim_status_writes_coalesced_total",
+		Help: "Status updates that replaced a pending write of the same object instead of being written.",
+	})
+)
+
+// StatusBatcher moves status writes off the reconcile critical path. Reconciles enqueue the
+// computed status; a pending write is replaced by later updates of the same object and written
+// once, window after it was first enqueued. Objects in a steady-state error loop are then written
+// at most once per window, however often they are reconciled. It is added to the manager as a
+// runnable and passed to the pipelines.
+type StatusBatcher struct {
+	client client.StatusWriter
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[statusKey]*pendingStatus
+}
+
+// statusKey identifies an object across kinds
+type statusKey struct {
+	kind string
+	key  client.ObjectKey
+}
+
+type pendingStatus struct {
+	obj       client.Object
+	onWritten func(ctx context.Context)
+	due       time.Time
+	attempts  int
+}
+
+// NewStatusBatcher creates a batcher that writes through c, window after the first update of an object.
+func NewStatusBatcher(c client.StatusWriter, window time.Duration) *StatusBatcher {
+	return &StatusBatcher{
+		client:  c,
+		window:  window,
+		pending: map[statusKey]*pendingStatus{},
+	}
+}
+
+func statusKeyOf(obj client.Object) statusKey {
+	return statusKey{kind: fmt.Sprintf("%T", obj), key: client.ObjectKeyFromObject(obj)}
+}
+
+// Enqueue schedules a status write of obj, replacing a pending write of the same object.
+// onWritten is called after the write succeeded; a replaced write does not call its callback.
+func (b *StatusBatcher) Enqueue(obj client.Object, onWritten func(ctx context.Context)) {
+	snapshot, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return
+	}
+	key := statusKeyOf(obj)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if existing, ok := b.pending[key]; ok {
+		existing.obj = snapshot
+		existing.onWritten = onWritten
+		existing.attempts = 0
+		StatusWritesCoalesced.Inc()
+		return
+	}
+	b.pending[key] = &pendingStatus{obj: snapshot, onWritten: onWritten, due: time.Now().Add(b.window)}
+}
+
+// Pending returns a copy of the pending write of obj, if it was computed from the same
+// resource version. Reconciles before the write use it as the current status, so that they
+// do not report the same transitions again. A nil batcher has no pending writes.
+func (b *StatusBatcher) Pending(obj client.Object) client.Object {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p, ok := b.pending[statusKeyOf(obj)]
+	if !ok || p.obj.GetResourceVersion() != obj.GetResourceVersion() {
+		return nil
+	}
+	snapshot, _ := p.obj.DeepCopyObject().(client.Object)
+	return snapshot
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader reconciles,
+// so only the leader has status to write.
+func (b *StatusBatcher) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable. Pending writes are flushed when the manager stops.
+func (b *StatusBatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(max(b.window/2, 10*time.Millisecond))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), statusBatcherShutdownTimeout)
+			b.flush(flushCtx, time.Time{})
+			cancel()
+			return nil
+		case now := <-ticker.C:
+			b.flush(ctx, now)
+		}
+	}
+}
+
+// flush writes the pending statuses that are due at now. A zero now writes all of them.
+func (b *StatusBatcher) flush(ctx context.Context, now time.Time) {
+	b.mu.Lock()
+	var due []*pendingStatus
+	for key, p := range b.pending {
+		if now.IsZero() || !p.due.After(now) {
+			due = append(due, p)
+			delete(b.pending, key)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, p := range due {
+		b.write(ctx, p)
+	}
+}
+
+func (b *StatusBatcher) write(ctx context.Context, p *pendingStatus) {
+	logger := logf.FromContext(ctx).WithName("status-batcher").WithValues(
+		"type", fmt.Sprintf("%T", p.obj), "namespace", p.obj.GetNamespace(), "name", p.obj.GetName())
+
+	err := b.client.Update(ctx, p.obj)
+	switch {
+	case err == nil:
+		StatusWrites.WithLabelValues("written").Inc()
+		if p.onWritten != nil {
+			p.onWritten(ctx)
+		}
+	case apierrors.IsConflict(err) || apierrors.IsNotFound(err):
+		// The object changed or is gone; its next reconcile computes the status again
+		StatusWrites.WithLabelValues("conflict").Inc()
+		logger.V(1).Info("status write superseded, dropping it", "error", err.Error())
+	default:
+		StatusWrites.WithLabelValues("failed").Inc()
+		p.attempts++
+		if p.attempts >= maxStatusWriteAttempts {
+			logger.Error(err, "status write failed, dropping it", "attempts", p.attempts)
+			return
+		}
+		logger.V(1).Info("status write failed, retrying", "error", err.Error(), "attempts", p.attempts)
+		b.retry(p)
+	}
+}
+
+// retry re-schedules a failed write, unless a newer status of the object was enqueued meanwhile.
+func (b *StatusBatcher) retry(p *pendingStatus) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	key := statusKeyOf(p.obj)
+	if _, ok := b.pending[key]; ok {
+		return
+	}
+	p.due = time.Now().Add(b.window)
+	b.pending[key] = p
+}
@@ -0,0 +1,191 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// failingStatusWriter fails the next status updates with the queued errors
+type failingStatusWriter struct {
+	client.SubResourceWriter
+	errs    []error
+	updates int
+}
+
+func (w *failingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	w.updates++
+	if len(w.errs) > 0 {
+		err := w.errs[0]
+		w.errs = w.errs[1:]
+		return err
+	}
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+func newBatcherTestObject(t *testing.T) (*testObject, client.Client) {
+	t.Helper()
+	obj := newAdoptionOwner("")
+	c := fake.NewClientBuilder().WithScheme(newPlanOrderScheme()).WithObjects(obj).WithStatusSubresource(obj).Build()
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(obj), obj); err != nil {
+		t.Fatalf("failed to get object: %v", err)
+	}
+	return obj, c
+}
+
+func storedStatus(t *testing.T, c client.Client, obj *testObject) string {
+	t.Helper()
+	stored := &testObject{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(obj), stored); err != nil {
+		t.Fatalf("failed to get object: %v", err)
+	}
+	return stored.Status.Status
+}
+
+func TestStatusBatcher_CoalescesUpdates(t *testing.T) {
+	obj, c := newBatcherTestObject(t)
+	b := NewStatusBatcher(c.Status(), time.Second)
+
+	var written []string
+	for _, status := range []string{"Progressing", "Failed", "Ready"} {
+		obj.Status.Status = status
+		b.Enqueue(obj, func(context.Context) { written = append(written, status) })
+	}
+	if pending, ok := b.Pending(obj).(*testObject); !ok || pending.Status.Status != "Ready" {
+		t.Fatalf("expected the latest status to be pending, got %+v", pending)
+	}
+
+	// Not due yet
+	b.flush(context.Background(), time.Now())
+	if got := storedStatus(t, c, obj); got != "" {
+		t.Fatalf("expected no write before the window, got %q", got)
+	}
+
+	b.flush(context.Background(), time.Now().Add(time.Second))
+	if got := storedStatus(t, c, obj); got != "Ready" {
+		t.Errorf("expected the latest status to be written, got %q", got)
+	}
+	if len(written) != 1 || written[0] != "Ready" {
+		t.Errorf("expected only the written status to be notified, got %v", written)
+	}
+	if b.Pending(obj) != nil {
+		t.Error("expected no pending write after the flush")
+	}
+}
+
+func TestStatusBatcher_PendingRequiresSameVersion(t *testing.T) {
+	obj, c := newBatcherTestObject(t)
+	b := NewStatusBatcher(c.Status(), time.Second)
+	b.Enqueue(obj, nil)
+
+	newer, _ := obj.DeepCopyObject().(*testObject)
+	newer.ResourceVersion = obj.ResourceVersion + "1"
+	if b.Pending(newer) != nil {
+		t.Error("expected a pending write of an older version to be ignored")
+	}
+	var nilBatcher *StatusBatcher
+	if nilBatcher.Pending(obj) != nil {
+		t.Error("expected a nil batcher to have no pending writes")
+	}
+}
+
+func TestStatusBatcher_RetriesFailedWrites(t *testing.T) {
+	obj, c := newBatcherTestObject(t)
+	writer := &failingStatusWriter{
+		SubResourceWriter: c.Status(),
+		errs:              []error{errors.New("connection refused")},
+	}
+	b := NewStatusBatcher(writer, time.Second)
+
+	obj.Status.Status = "Ready"
+	b.Enqueue(obj, nil)
+	b.flush(context.Background(), time.Time{})
+	if b.Pending(obj) == nil {
+		t.Fatal("expected the failed write to be retried")
+	}
+	b.flush(context.Background(), time.Time{})
+	if got := storedStatus(t, c, obj); got != "Ready" || writer.updates != 2 {
+		t.Errorf("expected the retry to write the status, got %q after %d updates", got, writer.updates)
+	}
+
+	// Conflicts are dropped, the next reconcile computes the status again
+	writer.errs = []error{apierrors.NewConflict(schema.GroupResource{Resource: "testobjects"}, obj.Name, errors.New("changed"))}
+	b.Enqueue(obj, nil)
+	b.flush(context.Background(), time.Time{})
+	if b.Pending(obj) != nil {
+		t.Error("expected a conflicting write to be dropped")
+	}
+}
+
+func TestPipeline_Run_StatusBatcher(t *testing.T) {
+	scheme := newPlanOrderScheme()
+	obj, fakeClient := newBatcherTestObject(t)
+	batcher := NewStatusBatcher(fakeClient.Status(), time.Second)
+	recorder := record.NewFakeRecorder(10)
+	p := &Pipeline[*testObject, *testStatus, testFetch, testObservation]{
+		Client:         fakeClient,
+		StatusClient:   fakeClient.Status(),
+		Recorder:       recorder,
+		Reconciler:     &testReconcilerWithPlan{fetchResult: testFetch{ModelReady: true}},
+		Scheme:         scheme,
+		ControllerName: "test",
+		StatusBatcher:  batcher,
+	}
+
+	if _, err := p.Run(context.Background(), obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := storedStatus(t, fakeClient, obj); got != "" {
+		t.Fatalf("expected the status write to be deferred, got %q", got)
+	}
+	events := len(recorder.Events)
+
+	// A second reconcile of the same version starts from the pending status
+	again := &testObject{}
+	if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(obj), again); err != nil {
+		t.Fatalf("failed to get object: %v", err)
+	}
+	if _, err := p.Run(context.Background(), again); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recorder.Events) != events {
+		t.Errorf("expected no repeated transition events, got %d more", len(recorder.Events)-events)
+	}
+	if again.Status.Status == "" {
+		t.Error("expected the pending status to be used as the current status")
+	}
+
+	batcher.flush(context.Background(), time.Time{})
+	if got := storedStatus(t, fakeClient, obj); got == "" {
+		t.Error("expected the status to be written by the flush")
+	}
+}
@@ -0,0 +1,124 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// DecisionTrace is a compact record of the decisions taken during a single reconcile.
+// It is written to the AnnotationDecisionTrace annotation of objects that opt in via AnnotationTrace.
+// It intentionally contains no timestamps so that repeated identical reconciles do not rewrite it.
+type DecisionTrace struct {
+	// Generation is the object generation that was reconciled
+	Generation int64 `json:"generation"`
+
+	// ErrorCategories lists the error categories found in component health
+	ErrorCategories []string `json:"errorCategories,omitempty"`
+
+	// WithinGracePeriod is true if infrastructure errors were masked by the degradation grace period
+	WithinGracePeriod bool `json:"withinGracePeriod"`
+
+	// WaitingOn lists the objects that not-ready components were waiting on, as "Kind namespace/name"
+	WaitingOn []string `json:"waitingOn,omitempty"`
+
+	// ShouldApply and ShouldRequeue are the state engine's directives
+	ShouldApply   bool `json:"shouldApply"`
+	ShouldRequeue bool `json:"shouldRequeue"`
+
+	// Applied and Deleted are the number of objects applied and deleted
+	Applied int `json:"applied"`
+	Deleted int `json:"deleted"`
+}
+
+// NewDecisionTrace builds a decision trace from the state engine decision and apply/delete outcome.
+func NewDecisionTrace(generation int64, decision StateEngineDecision, applied, deleted int) DecisionTrace {
+	trace := DecisionTrace{
+		Generation:        generation,
+		WithinGracePeriod: decision.WithinGracePeriod,
+		ShouldApply:       decision.ShouldApply,
+		ShouldRequeue:     decision.ShouldRequeue,
+		Applied:           applied,
+		Deleted:           deleted,
+	}
+	for _, category := range decision.ErrorCategories {
+		trace.ErrorCategories = append(trace.ErrorCategories, category.String())
+	}
+	for _, ref := range decision.WaitingOn {
+		trace.WaitingOn = append(trace.WaitingOn, ref.String())
+	}
+	return trace
+}
+
+// IsTraceEnabled returns true if the object has the trace annotation set to "true".
+func IsTraceEnabled(obj client.Object) bool {
+	return obj.GetAnnotations()[constants.AnnotationTrace] == "true"
+}
+
+// RecordDecisionTrace writes the trace to the object's decision trace annotation if tracing is enabled,
+// and removes a stale trace once tracing has been turned off. The object is only patched when the
+// annotation actually changes.
+func RecordDecisionTrace(ctx context.Context, c client.Client, obj client.Object, trace DecisionTrace) error {
+	current, hasTrace := obj.GetAnnotations()[constants.AnnotationDecisionTrace]
+
+	if !IsTraceEnabled(obj) {
+		if !hasTrace {
+			return nil
+		}
+		return patchDecisionTrace(ctx, c, obj, nil)
+	}
+
+	encoded, err := json.Marshal(trace)
+	if err != nil {
+		return fmt.Errorf("failed to encode decision trace: %w", err)
+	}
+	value := string(encoded)
+	if hasTrace && current == value {
+		return nil
+	}
+	return patchDecisionTrace(ctx, c, obj, &value)
+}
+
+// patchDecisionTrace sets (or, for a nil value, removes) the decision trace annotation with a merge patch.
+func patchDecisionTrace(ctx context.Context, c client.Client, obj client.Object, value *string) error {
+	var annotationValue any
+	if value != nil {
+		annotationValue = *value
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{constants.AnnotationDecisionTrace: annotationValue},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch))
+}
@@ -0,0 +1,147 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func newTraceTestClient(obj *testObject) client.Client {
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	scheme.AddKnownTypes(metav1.SchemeGroupVersion, &testObject{})
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj).Build()
+}
+
+func newTraceTestObject(annotations map[string]string) *testObject {
+	return &testObject{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "meta.k8s.io/v1",
+			Kind:       "testObject",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-obj",
+			Namespace:   "default",
+			Generation:  3,
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestNewDecisionTrace(t *testing.T) {
+	decision := StateEngineDecision{
+		ShouldApply:       false,
+		ShouldRequeue:     true,
+		ErrorCategories:   []ErrorCategory{ErrorCategoryInfrastructure, ErrorCategoryAuth},
+		WithinGracePeriod: true,
+	}
+
+	trace := NewDecisionTrace(3, decision, 0, 1)
+
+	if trace.Generation != 3 || trace.Applied != 0 || trace.Deleted != 1 {
+		t.Errorf("unexpected counters: %+v", trace)
+	}
+	if !trace.ShouldRequeue || trace.ShouldApply || !trace.WithinGracePeriod {
+		t.Errorf("unexpected directives: %+v", trace)
+	}
+	if len(trace.ErrorCategories) != 2 ||
+		trace.ErrorCategories[0] != ErrorCategoryInfrastructure.String() ||
+		trace.ErrorCategories[1] != ErrorCategoryAuth.String() {
+		t.Errorf("unexpected error categories: %v", trace.ErrorCategories)
+	}
+}
+
+func TestRecordDecisionTrace_Disabled(t *testing.T) {
+	obj := newTraceTestObject(nil)
+	cl := newTraceTestClient(obj)
+
+	if err := RecordDecisionTrace(context.Background(), cl, obj, DecisionTrace{Generation: 3}); err != nil {
+		t.Fatalf("RecordDecisionTrace() error = %v", err)
+	}
+
+	if _, ok := obj.GetAnnotations()[constants.AnnotationDecisionTrace]; ok {
+		t.Error("decision trace should not be written when tracing is disabled")
+	}
+}
+
+func TestRecordDecisionTrace_Enabled(t *testing.T) {
+	obj := newTraceTestObject(map[string]string{constants.AnnotationTrace: "true"})
+	cl := newTraceTestClient(obj)
+	trace := DecisionTrace{Generation: 3, ShouldApply: true, Applied: 2}
+
+	if err := RecordDecisionTrace(context.Background(), cl, obj, trace); err != nil {
+		t.Fatalf("RecordDecisionTrace() error = %v", err)
+	}
+
+	stored := &testObject{}
+	if err := cl.Get(context.Background(), client.ObjectKeyFromObject(obj), stored); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	value, ok := stored.GetAnnotations()[constants.AnnotationDecisionTrace]
+	if !ok {
+		t.Fatal("decision trace annotation should be written")
+	}
+
+	var decoded DecisionTrace
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		t.Fatalf("decision trace is not valid JSON: %v", err)
+	}
+	if decoded.Applied != 2 || !decoded.ShouldApply || decoded.Generation != 3 {
+		t.Errorf("unexpected decoded trace: %+v", decoded)
+	}
+
+	// Recording the same trace again must not change the object
+	resourceVersion := stored.GetResourceVersion()
+	if err := RecordDecisionTrace(context.Background(), cl, stored, trace); err != nil {
+		t.Fatalf("RecordDecisionTrace() error = %v", err)
+	}
+	if stored.GetResourceVersion() != resourceVersion {
+		t.Error("identical trace should not patch the object")
+	}
+}
+
+func TestRecordDecisionTrace_RemovesStaleTrace(t *testing.T) {
+	obj := newTraceTestObject(map[string]string{constants.AnnotationDecisionTrace: `{"generation":1}`})
+	cl := newTraceTestClient(obj)
+
+	if err := RecordDecisionTrace(context.Background(), cl, obj, DecisionTrace{Generation: 3}); err != nil {
+		t.Fatalf("RecordDecisionTrace() error = %v", err)
+	}
+
+	stored := &testObject{}
+	if err := cl.Get(context.Background(), client.ObjectKeyFromObject(obj), stored); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if _, ok := stored.GetAnnotations()[constants.AnnotationDecisionTrace]; ok {
+		t.Error("stale decision trace should be removed once tracing is disabled")
+	}
+}
@@ -0,0 +1,381 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get
+// +kubebuilder:rbac:groups="",resources=pods/status,verbs=patch
+
+// workqueueSampleInterval is how often queue depth and age are sampled.
+const workqueueSampleInterval = 10 * time.Second
+
+// ConditionTypeWorkqueueSLO is the condition set on the operator pod. It is True while every
+// controller's oldest queued item is within the SLO, and False during a sustained breach.
+const ConditionTypeWorkqueueSLO corev1.PodConditionType = constants.AimLabelDomain + "/WorkqueueWithinSLO"
+
+var (
+	// WorkqueueDepth is the number of requests waiting in each controller's workqueue.
+	WorkqueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aim_workqueue_depth",
+		Help: "Number of requests waiting in the controller workqueue.",
+	}, []string{"controller"})
+
+	// WorkqueueOldestItemAge is how long the oldest request has been waiting in each controller's workqueue.
+	WorkqueueOldestItemAge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aim_workqueue_oldest_item_age_seconds",
+		Help: "Seconds the oldest request has been waiting in the controller workqueue.",
+	}, []string{"controller"})
+
+	// WorkqueueSLOBreached is 1 while a controller is in a sustained SLO breach, 0 otherwise.
+	WorkqueueSLOBreached = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aim_workqueue_slo_breached",
+		Help: "Whether the controller workqueue is in a sustained SLO breach (1) or not (0).",
+	}, []string{"controller"})
+)
+
+// WorkqueueSLO defines when a controller workqueue is considered unhealthy.
+type WorkqueueSLO struct {
+	// MaxOldestItemAge is the longest a request may wait in the queue. Zero disables the SLO.
+	MaxOldestItemAge time.Duration
+	// BreachDuration is how long MaxOldestItemAge must be exceeded before the breach is reported.
+	BreachDuration time.Duration
+}
+
+// WorkqueueSLOReporter publishes the controllers that are in a sustained SLO breach.
+type WorkqueueSLOReporter interface {
+	ReportWorkqueueSLO(ctx context.Context, breached []string) error
+}
+
+// WorkqueueMonitor records depth and oldest item age of the controller workqueues it creates,
+// and reports sustained breaches of the SLO. It is added to the manager as a runnable, and
+// its queues are passed to the controllers through ControllerOptions.
+type WorkqueueMonitor struct {
+	slo        WorkqueueSLO
+	rateLimits *WorkqueueRateLimits
+	reporter   WorkqueueSLOReporter
+	gate       *StartupGate
+
+	mu          sync.Mutex
+	queues      map[string]*trackedQueue
+	breachSince map[string]time.Time
+	breached    []string
+	reported    bool
+}
+
+// NewWorkqueueMonitor creates a monitor for the given SLO. The queues it creates use the given
+// rate limits, or the controller-runtime default rate limiter if they are nil. The reporter may be nil.
+func NewWorkqueueMonitor(slo WorkqueueSLO, rateLimits *WorkqueueRateLimits, reporter WorkqueueSLOReporter) *WorkqueueMonitor {
+	return &WorkqueueMonitor{
+		slo:         slo,
+		rateLimits:  rateLimits,
+		reporter:    reporter,
+		queues:      map[string]*trackedQueue{},
+		breachSince: map[string]time.Time{},
+	}
+}
+
+// SetStartupGate holds back the queues the monitor creates until the gate is open. It must be
+// called before the manager starts. Queues are not reported as breaching their SLO while gated.
+func (m *WorkqueueMonitor) SetStartupGate(gate *StartupGate) {
+	m.gate = gate
+}
+
+// ControllerOptions returns controller options whose workqueue is tracked by the monitor.
+// A nil monitor returns the default options.
+func (m *WorkqueueMonitor) ControllerOptions() controller.Options {
+	if m == nil {
+		return controller.Options{}
+	}
+	return controller.Options{NewQueue: m.newQueue}
+}
+
+func (m *WorkqueueMonitor) newQueue(
+	name string,
+	rateLimiter workqueue.TypedRateLimiter[reconcile.Request],
+) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	if m.rateLimits != nil {
+		rateLimiter = m.rateLimits.RateLimiter(name)
+	}
+	q := &trackedQueue{
+		TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter,
+			workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{Name: name}),
+		rateLimiter: rateLimiter,
+		gate:        m.gate,
+		readySince:  map[reconcile.Request]time.Time{},
+		now:         time.Now,
+	}
+	m.mu.Lock()
+	m.queues[name] = q
+	m.mu.Unlock()
+	return q
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Queues of a non-leader stay
+// empty, so every replica can sample its own.
+func (m *WorkqueueMonitor) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable.
+func (m *WorkqueueMonitor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(workqueueSampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.sample(ctx, time.Now())
+		}
+	}
+}
+
+// sample updates the metrics, logs breach transitions and reports the breached controllers
+// when the set changes.
+func (m *WorkqueueMonitor) sample(ctx context.Context, now time.Time) {
+	breached, changed := m.evaluate(ctx, now)
+	if !changed || m.reporter == nil {
+		return
+	}
+	if err := m.reporter.ReportWorkqueueSLO(ctx, breached); err != nil {
+		logf.FromContext(ctx).WithName("workqueue-slo").Error(err, "failed to report workqueue SLO")
+		m.mu.Lock()
+		m.reported = false
+		m.mu.Unlock()
+	}
+}
+
+// evaluate samples every queue and returns the controllers in a sustained breach, and whether
+// that set differs from the last reported one.
+func (m *WorkqueueMonitor) evaluate(ctx context.Context, now time.Time) ([]string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	logger := logf.FromContext(ctx).WithName("workqueue-slo")
+
+	var breached []string
+	for name, q := range m.queues {
+		depth, oldest := q.snapshot(now)
+		WorkqueueDepth.WithLabelValues(name).Set(float64(depth))
+		WorkqueueOldestItemAge.WithLabelValues(name).Set(oldest.Seconds())
+
+		if m.slo.MaxOldestItemAge <= 0 || oldest <= m.slo.MaxOldestItemAge || !m.gate.IsOpen() {
+			delete(m.breachSince, name)
+			WorkqueueSLOBreached.WithLabelValues(name).Set(0)
+			continue
+		}
+		since, ok := m.breachSince[name]
+		if !ok {
+			since = now
+			m.breachSince[name] = now
+		}
+		if now.Sub(since) < m.slo.BreachDuration {
+			WorkqueueSLOBreached.WithLabelValues(name).Set(0)
+			continue
+		}
+		WorkqueueSLOBreached.WithLabelValues(name).Set(1)
+		breached = append(breached, name)
+		if !slices.Contains(m.breached, name) {
+			logger.Error(nil, "controller workqueue is breaching its SLO",
+				"controller", name, "depth", depth, "oldestItemAge", oldest.String(),
+				"maxOldestItemAge", m.slo.MaxOldestItemAge.String(), "breachingSince", since)
+		}
+	}
+	slices.Sort(breached)
+
+	for _, name := range m.breached {
+		if !slices.Contains(breached, name) {
+			logger.Info("controller workqueue is back within its SLO", "controller", name)
+		}
+	}
+
+	changed := !m.reported || !slices.Equal(breached, m.breached)
+	m.breached = breached
+	m.reported = true
+	return breached, changed
+}
+
+// trackedQueue records when each waiting request became ready to be processed.
+// Requests added with a delay are tracked from the moment the delay elapses.
+type trackedQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	rateLimiter workqueue.TypedRateLimiter[reconcile.Request]
+	gate        *StartupGate
+
+	mu         sync.Mutex
+	readySince map[reconcile.Request]time.Time
+	now        func() time.Time
+}
+
+func (q *trackedQueue) track(item reconcile.Request, at time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if existing, ok := q.readySince[item]; !ok || at.Before(existing) {
+		q.readySince[item] = at
+	}
+}
+
+// Add implements workqueue.TypedInterface.
+func (q *trackedQueue) Add(item reconcile.Request) {
+	q.track(item, q.now())
+	q.TypedRateLimitingInterface.Add(item)
+}
+
+// AddAfter implements workqueue.TypedDelayingInterface.
+func (q *trackedQueue) AddAfter(item reconcile.Request, duration time.Duration) {
+	q.track(item, q.now().Add(duration))
+	q.TypedRateLimitingInterface.AddAfter(item, duration)
+}
+
+// AddRateLimited implements workqueue.TypedRateLimitingInterface. It asks the rate limiter for
+// the delay itself, exactly as the wrapped queue would, so the request can be tracked.
+func (q *trackedQueue) AddRateLimited(item reconcile.Request) {
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+// Get implements workqueue.TypedInterface. It blocks until the startup gate is open.
+func (q *trackedQueue) Get() (reconcile.Request, bool) {
+	q.gate.Wait()
+	item, shutdown := q.TypedRateLimitingInterface.Get()
+	q.mu.Lock()
+	delete(q.readySince, item)
+	q.mu.Unlock()
+	return item, shutdown
+}
+
+// snapshot returns the queue depth and how long the oldest ready request has been waiting.
+func (q *trackedQueue) snapshot(now time.Time) (int, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var oldest time.Duration
+	for _, at := range q.readySince {
+		if age := now.Sub(at); age > oldest {
+			oldest = age
+		}
+	}
+	return q.Len(), oldest
+}
+
+// PodConditionReporter reports the workqueue SLO as a condition on the operator's own pod.
+type PodConditionReporter struct {
+	reader    client.Reader
+	writer    client.StatusClient
+	namespace string
+	name      string
+}
+
+// NewPodConditionReporter creates a reporter for the operator pod, identified by the POD_NAME
+// environment variable or the hostname. The reader should bypass the cache, since the operator
+// pod is usually not part of it.
+func NewPodConditionReporter(reader client.Reader, writer client.StatusClient) *PodConditionReporter {
+	name := os.Getenv("POD_NAME")
+	if name == "" {
+		name, _ = os.Hostname()
+	}
+	return &PodConditionReporter{
+		reader:    reader,
+		writer:    writer,
+		namespace: constants.GetOperatorNamespace(),
+		name:      name,
+	}
+}
+
+// ReportWorkqueueSLO implements WorkqueueSLOReporter.
+func (r *PodConditionReporter) ReportWorkqueueSLO(ctx context.Context, breached []string) error {
+	if r.name == "" || r.namespace == "" {
+		return nil
+	}
+	var pod corev1.Pod
+	if err := r.reader.Get(ctx, client.ObjectKey{Namespace: r.namespace, Name: r.name}, &pod); err != nil {
+		return fmt.Errorf("failed to get operator pod: %w", err)
+	}
+	original := pod.DeepCopy()
+	if !SetPodCondition(&pod, workqueueSLOCondition(breached)) {
+		return nil
+	}
+	if err := r.writer.Status().Patch(ctx, &pod, client.StrategicMergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch operator pod status: %w", err)
+	}
+	return nil
+}
+
+func workqueueSLOCondition(breached []string) corev1.PodCondition {
+	if len(breached) == 0 {
+		return corev1.PodCondition{
+			Type:    ConditionTypeWorkqueueSLO,
+			Status:  corev1.ConditionTrue,
+			Reason:  "WithinSLO",
+			Message: "All controller workqueues are within their SLO",
+		}
+	}
+	return corev1.PodCondition{
+		Type:    ConditionTypeWorkqueueSLO,
+		Status:  corev1.ConditionFalse,
+		Reason:  "SLOBreached",
+		Message: fmt.Sprintf("Workqueues breaching their SLO: %s", strings.Join(breached, ", ")),
+	}
+}
+
+// SetPodCondition sets the condition on the pod, keeping the transition time when the status
+// is unchanged. It returns false when the pod already has the same condition.
+func SetPodCondition(pod *corev1.Pod, condition corev1.PodCondition) bool {
+	now := metav1.Now()
+	for i := range pod.Status.Conditions {
+		existing := &pod.Status.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status && existing.Reason == condition.Reason &&
+			existing.Message == condition.Message {
+			return false
+		}
+		condition.LastTransitionTime = existing.LastTransitionTime
+		if existing.Status != condition.Status {
+			condition.LastTransitionTime = now
+		}
+		*existing = condition
+		return true
+	}
+	condition.LastTransitionTime = now
+	pod.Status.Conditions = append(pod.Status.Conditions, condition)
+	return true
+}
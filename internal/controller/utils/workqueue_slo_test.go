@@ -0,0 +1,186 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package controllerutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newTestTrackedQueue(t *testing.T, m *WorkqueueMonitor, name string, now *time.Time) *trackedQueue {
+	t.Helper()
+	q := m.newQueue(name, workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()).(*trackedQueue)
+	q.now = func() time.Time { return *now }
+	t.Cleanup(q.ShutDown)
+	return q
+}
+
+func request(name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: name}}
+}
+
+func TestTrackedQueue_OldestItemAge(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	q := newTestTrackedQueue(t, NewWorkqueueMonitor(WorkqueueSLO{}, nil, nil), "test", &now)
+
+	q.Add(request("a"))
+	now = now.Add(time.Minute)
+	q.Add(request("b"))
+	q.Add(request("a")) // re-adding keeps the original time
+	q.AddAfter(request("c"), time.Hour)
+	now = now.Add(time.Minute)
+
+	depth, oldest := q.snapshot(now)
+	if depth != 2 {
+		t.Errorf("expected depth 2, got %d", depth)
+	}
+	if oldest != 2*time.Minute {
+		t.Errorf("expected oldest age 2m, got %s", oldest)
+	}
+
+	item, _ := q.Get()
+	if item != request("a") {
+		t.Fatalf("expected request a first, got %v", item)
+	}
+	q.Done(item)
+	if _, oldest = q.snapshot(now); oldest != time.Minute {
+		t.Errorf("expected oldest age 1m after taking a, got %s", oldest)
+	}
+}
+
+type recordingReporter struct {
+	reports [][]string
+}
+
+func (r *recordingReporter) ReportWorkqueueSLO(_ context.Context, breached []string) error {
+	r.reports = append(r.reports, breached)
+	return nil
+}
+
+func TestWorkqueueMonitor_SustainedBreach(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	reporter := &recordingReporter{}
+	m := NewWorkqueueMonitor(WorkqueueSLO{MaxOldestItemAge: time.Minute, BreachDuration: 5 * time.Minute}, nil, reporter)
+	slow := newTestTrackedQueue(t, m, "slow", &now)
+	newTestTrackedQueue(t, m, "idle", &now)
+	ctx := context.Background()
+
+	m.sample(ctx, now)
+	if len(reporter.reports) != 1 || len(reporter.reports[0]) != 0 {
+		t.Fatalf("expected an initial report without breaches, got %v", reporter.reports)
+	}
+
+	slow.Add(request("a"))
+	now = now.Add(2 * time.Minute) // over the threshold, but not for long enough
+	m.sample(ctx, now)
+	if len(reporter.reports) != 1 {
+		t.Fatalf("expected no report before the breach is sustained, got %v", reporter.reports)
+	}
+
+	now = now.Add(5 * time.Minute)
+	m.sample(ctx, now)
+	if len(reporter.reports) != 2 || len(reporter.reports[1]) != 1 || reporter.reports[1][0] != "slow" {
+		t.Fatalf("expected the slow controller to be reported, got %v", reporter.reports)
+	}
+
+	m.sample(ctx, now.Add(time.Minute))
+	if len(reporter.reports) != 2 {
+		t.Fatalf("expected no report while the breach is unchanged, got %v", reporter.reports)
+	}
+
+	item, _ := slow.Get()
+	slow.Done(item)
+	m.sample(ctx, now.Add(2*time.Minute))
+	if len(reporter.reports) != 3 || len(reporter.reports[2]) != 0 {
+		t.Fatalf("expected recovery to be reported, got %v", reporter.reports)
+	}
+}
+
+func TestWorkqueueMonitor_DisabledSLO(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewWorkqueueMonitor(WorkqueueSLO{}, nil, nil)
+	q := newTestTrackedQueue(t, m, "test", &now)
+	q.Add(request("a"))
+
+	breached, _ := m.evaluate(context.Background(), now.Add(time.Hour))
+	if len(breached) != 0 {
+		t.Errorf("expected no breaches with the SLO disabled, got %v", breached)
+	}
+}
+
+func TestNilWorkqueueMonitor_ControllerOptions(t *testing.T) {
+	var m *WorkqueueMonitor
+	if m.ControllerOptions().NewQueue != nil {
+		t.Error("expected default queue for a nil monitor")
+	}
+}
+
+func TestPodConditionReporter(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "aim-system", Name: "operator"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).WithStatusSubresource(pod).Build()
+	reporter := &PodConditionReporter{reader: c, writer: c, namespace: "aim-system", name: "operator"}
+	ctx := context.Background()
+
+	condition := func() *corev1.PodCondition {
+		var got corev1.Pod
+		if err := c.Get(ctx, client.ObjectKeyFromObject(pod), &got); err != nil {
+			t.Fatalf("failed to get pod: %v", err)
+		}
+		for i := range got.Status.Conditions {
+			if got.Status.Conditions[i].Type == ConditionTypeWorkqueueSLO {
+				return &got.Status.Conditions[i]
+			}
+		}
+		return nil
+	}
+
+	if err := reporter.ReportWorkqueueSLO(ctx, []string{"service", "model"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cond := condition()
+	if cond == nil || cond.Status != corev1.ConditionFalse || cond.Reason != "SLOBreached" {
+		t.Fatalf("expected a False SLOBreached condition, got %+v", cond)
+	}
+	if cond.Message != "Workqueues breaching their SLO: service, model" {
+		t.Errorf("unexpected message %q", cond.Message)
+	}
+
+	if err := reporter.ReportWorkqueueSLO(ctx, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cond = condition(); cond == nil || cond.Status != corev1.ConditionTrue {
+		t.Fatalf("expected a True condition after recovery, got %+v", cond)
+	}
+}
@@ -0,0 +1,301 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package snapshot exports AIM custom resources into a portable bundle and imports
+// them into another cluster, for disaster recovery and cluster migrations.
+//
+// A bundle is a v1 List, so its specs can also be applied with kubectl. Server-populated
+// metadata is stripped on export. Objects controlled by another AIM resource (for example
+// templates created by model discovery or caches created for services) are skipped by
+// default, since the controllers recreate them.
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// AnnotationExportedAt records when a bundle was exported.
+const AnnotationExportedAt = constants.AimLabelDomain + "/snapshot-exported-at"
+
+// Kinds lists the exported kinds in import order, so that referenced objects exist first.
+var Kinds = []string{
+	"AIMClusterRuntimeConfig",
+	"AIMRuntimeConfig",
+	"AIMClusterModelSource",
+	"AIMClusterModel",
+	"AIMModel",
+	"AIMClusterServiceTemplate",
+	"AIMServiceTemplate",
+	"AIMTemplateCache",
+	"AIMArtifact",
+	"AIMService",
+}
+
+// ExportOptions controls which objects are exported.
+type ExportOptions struct {
+	// Namespaces limits namespaced objects to these namespaces. Empty exports all namespaces.
+	Namespaces []string
+	// IncludeStatus keeps the status of each object, e.g. to avoid re-running template discovery.
+	IncludeStatus bool
+	// IncludeOwned exports objects controlled by another AIM resource. Their owner
+	// references are dropped, since the owner UIDs differ in the target cluster.
+	IncludeOwned bool
+}
+
+// Export lists all AIM objects and returns them as a bundle.
+func Export(ctx context.Context, c client.Reader, opts ExportOptions, now time.Time) (*unstructured.UnstructuredList, error) {
+	bundle := &unstructured.UnstructuredList{}
+	bundle.SetAPIVersion("v1")
+	bundle.SetKind("List")
+
+	for _, kind := range Kinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(aimv1alpha1.GroupVersion.WithKind(kind + "List"))
+		if err := c.List(ctx, list); err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", kind, err)
+		}
+
+		items := list.Items
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].GetNamespace() != items[j].GetNamespace() {
+				return items[i].GetNamespace() < items[j].GetNamespace()
+			}
+			return items[i].GetName() < items[j].GetName()
+		})
+		for i := range items {
+			item := &items[i]
+			if !namespaceIncluded(item.GetNamespace(), opts.Namespaces) {
+				continue
+			}
+			if !opts.IncludeOwned && isControlledByAIM(item) {
+				continue
+			}
+			item.SetGroupVersionKind(aimv1alpha1.GroupVersion.WithKind(kind))
+			bundle.Items = append(bundle.Items, sanitize(item, opts.IncludeStatus, now))
+		}
+	}
+	return bundle, nil
+}
+
+func namespaceIncluded(namespace string, namespaces []string) bool {
+	if namespace == "" || len(namespaces) == 0 {
+		return true
+	}
+	for _, ns := range namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// isControlledByAIM returns true if the object has a controller owner in the AIM API group.
+func isControlledByAIM(obj *unstructured.Unstructured) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err == nil && gv.Group == aimv1alpha1.GroupVersion.Group && ref.Controller != nil && *ref.Controller {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitize returns a copy of the object without server-populated metadata.
+func sanitize(obj *unstructured.Unstructured, includeStatus bool, now time.Time) unstructured.Unstructured {
+	out := obj.DeepCopy()
+	out.SetUID("")
+	out.SetResourceVersion("")
+	out.SetGeneration(0)
+	out.SetCreationTimestamp(metav1.Time{})
+	out.SetManagedFields(nil)
+	out.SetOwnerReferences(nil)
+	out.SetFinalizers(nil)
+	out.SetDeletionTimestamp(nil)
+	out.SetDeletionGracePeriodSeconds(nil)
+	unstructured.RemoveNestedField(out.Object, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+	if len(out.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(out.Object, "metadata", "annotations")
+	}
+	if !includeStatus {
+		unstructured.RemoveNestedField(out.Object, "status")
+	}
+
+	annotations := out.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationExportedAt] = now.UTC().Format(time.RFC3339)
+	out.SetAnnotations(annotations)
+	return *out
+}
+
+// ImportOptions controls how a bundle is applied.
+type ImportOptions struct {
+	// NamespaceMap renames namespaces, from source to target. Namespaces not in the map are kept.
+	NamespaceMap map[string]string
+	// RestoreStatus writes the exported status of each object through the status subresource.
+	RestoreStatus bool
+	// DryRun reports what would change without writing.
+	DryRun bool
+}
+
+// ImportResult records the outcome for one object.
+type ImportResult struct {
+	Object string
+	Action string // "created", "updated" or "failed"
+	Error  error
+}
+
+// Import creates or updates the bundle objects in import order. Failures are reported
+// per object and do not stop the import.
+func Import(ctx context.Context, c client.Client, bundle *unstructured.UnstructuredList, opts ImportOptions) []ImportResult {
+	items := make([]unstructured.Unstructured, len(bundle.Items))
+	copy(items, bundle.Items)
+	sort.SliceStable(items, func(i, j int) bool {
+		return kindOrder(items[i].GetKind()) < kindOrder(items[j].GetKind())
+	})
+
+	var results []ImportResult
+	for i := range items {
+		obj := items[i].DeepCopy()
+		remapNamespace(obj, opts.NamespaceMap)
+		result := ImportResult{Object: objectRef(obj)}
+
+		action, err := importObject(ctx, c, obj, opts)
+		result.Action = action
+		if err != nil {
+			result.Action = "failed"
+			result.Error = err
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func importObject(ctx context.Context, c client.Client, obj *unstructured.Unstructured, opts ImportOptions) (string, error) {
+	status, hasStatus, _ := unstructured.NestedFieldCopy(obj.Object, "status")
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	var createOpts []client.CreateOption
+	var updateOpts []client.UpdateOption
+	if opts.DryRun {
+		createOpts = append(createOpts, client.DryRunAll)
+		updateOpts = append(updateOpts, client.DryRunAll)
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+	action := "updated"
+	switch {
+	case apierrors.IsNotFound(err):
+		action = "created"
+		if err := c.Create(ctx, obj, createOpts...); err != nil {
+			return action, err
+		}
+	case err != nil:
+		return action, err
+	default:
+		obj.SetResourceVersion(existing.GetResourceVersion())
+		if err := c.Update(ctx, obj, updateOpts...); err != nil {
+			return action, err
+		}
+	}
+
+	if !opts.RestoreStatus || !hasStatus || opts.DryRun {
+		return action, nil
+	}
+	if err := unstructured.SetNestedField(obj.Object, status, "status"); err != nil {
+		return action, err
+	}
+	if err := c.Status().Update(ctx, obj); err != nil {
+		return action, fmt.Errorf("failed to restore status: %w", err)
+	}
+	return action, nil
+}
+
+// remapNamespace renames the object namespace and namespace references in its status,
+// such as resolved template and model references.
+func remapNamespace(obj *unstructured.Unstructured, namespaceMap map[string]string) {
+	source := obj.GetNamespace()
+	target, ok := namespaceMap[source]
+	if source == "" || !ok {
+		return
+	}
+	obj.SetNamespace(target)
+	if status, found := obj.Object["status"]; found {
+		obj.Object["status"] = remapNamespaceFields(status, source, target)
+	}
+}
+
+func remapNamespaceFields(value any, source, target string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if key == "namespace" && field == source {
+				v[key] = target
+				continue
+			}
+			v[key] = remapNamespaceFields(field, source, target)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = remapNamespaceFields(v[i], source, target)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+func kindOrder(kind string) int {
+	for i, k := range Kinds {
+		if k == kind {
+			return i
+		}
+	}
+	return len(Kinds)
+}
+
+func objectRef(obj *unstructured.Unstructured) string {
+	parts := []string{obj.GetKind()}
+	if obj.GetNamespace() != "" {
+		parts = append(parts, obj.GetNamespace()+"/"+obj.GetName())
+	} else {
+		parts = append(parts, obj.GetName())
+	}
+	return strings.Join(parts, " ")
+}
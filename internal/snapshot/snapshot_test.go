@@ -0,0 +1,226 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+var testNow = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(aimv1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+func newService(namespace, name string) *aimv1alpha1.AIMService {
+	return &aimv1alpha1.AIMService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			UID:             "service-uid",
+			ResourceVersion: "42",
+			Finalizers:      []string{"aim.eai.amd.com/cleanup"},
+		},
+		Spec: aimv1alpha1.AIMServiceSpec{
+			Model: aimv1alpha1.AIMServiceModel{Name: ptr.To("llama")},
+		},
+		Status: aimv1alpha1.AIMServiceStatus{
+			Status: constants.AIMStatusReady,
+			ResolvedTemplate: &aimv1alpha1.AIMResolvedReference{
+				Name:      "llama-template",
+				Namespace: namespace,
+			},
+		},
+	}
+}
+
+func newFakeClient(objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().
+		WithScheme(newScheme()).
+		WithObjects(objs...).
+		WithStatusSubresource(&aimv1alpha1.AIMService{}).
+		Build()
+}
+
+func TestExport(t *testing.T) {
+	owned := &aimv1alpha1.AIMServiceTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "discovered",
+			Namespace: "team-a",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: aimv1alpha1.GroupVersion.String(),
+				Kind:       "AIMModel",
+				Name:       "llama",
+				UID:        "model-uid",
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+	c := newFakeClient(
+		newService("team-a", "svc"),
+		newService("team-b", "other"),
+		owned,
+		&aimv1alpha1.AIMClusterModel{ObjectMeta: metav1.ObjectMeta{Name: "cluster-model"}},
+	)
+
+	tests := []struct {
+		name string
+		opts ExportOptions
+		want []string
+	}{
+		{
+			name: "all namespaces",
+			want: []string{"AIMClusterModel cluster-model", "AIMService team-a/svc", "AIMService team-b/other"},
+		},
+		{
+			name: "namespace filter keeps cluster resources",
+			opts: ExportOptions{Namespaces: []string{"team-a"}},
+			want: []string{"AIMClusterModel cluster-model", "AIMService team-a/svc"},
+		},
+		{
+			name: "include owned",
+			opts: ExportOptions{Namespaces: []string{"team-a"}, IncludeOwned: true},
+			want: []string{"AIMClusterModel cluster-model", "AIMServiceTemplate team-a/discovered", "AIMService team-a/svc"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundle, err := Export(context.Background(), c, tt.opts, testNow)
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+			var got []string
+			for i := range bundle.Items {
+				got = append(got, objectRef(&bundle.Items[i]))
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Export() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Export()[%d] = %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestExport_Sanitizes(t *testing.T) {
+	c := newFakeClient(newService("team-a", "svc"))
+
+	bundle, err := Export(context.Background(), c, ExportOptions{}, testNow)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if bundle.GetKind() != "List" || len(bundle.Items) != 1 {
+		t.Fatalf("unexpected bundle: kind=%s items=%d", bundle.GetKind(), len(bundle.Items))
+	}
+
+	item := bundle.Items[0]
+	if item.GetUID() != "" || item.GetResourceVersion() != "" || len(item.GetFinalizers()) != 0 {
+		t.Errorf("server metadata not stripped: %v", item.Object["metadata"])
+	}
+	if _, found := item.Object["status"]; found {
+		t.Error("status exported without IncludeStatus")
+	}
+	if got := item.GetAnnotations()[AnnotationExportedAt]; got != "2026-01-02T03:04:05Z" {
+		t.Errorf("exported-at annotation = %q", got)
+	}
+	if item.GetAPIVersion() != aimv1alpha1.GroupVersion.String() || item.GetKind() != "AIMService" {
+		t.Errorf("unexpected type %s %s", item.GetAPIVersion(), item.GetKind())
+	}
+}
+
+func TestImport_RemapsNamespace(t *testing.T) {
+	source := newFakeClient(newService("team-a", "svc"))
+	bundle, err := Export(context.Background(), source, ExportOptions{IncludeStatus: true}, testNow)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	target := newFakeClient()
+	results := Import(context.Background(), target, bundle, ImportOptions{
+		NamespaceMap:  map[string]string{"team-a": "team-z"},
+		RestoreStatus: true,
+	})
+	if len(results) != 1 || results[0].Error != nil || results[0].Action != "created" {
+		t.Fatalf("Import() = %+v", results)
+	}
+
+	svc := &aimv1alpha1.AIMService{}
+	if err := target.Get(context.Background(), client.ObjectKey{Namespace: "team-z", Name: "svc"}, svc); err != nil {
+		t.Fatalf("imported service not found: %v", err)
+	}
+	if svc.Spec.Model.Name == nil || *svc.Spec.Model.Name != "llama" {
+		t.Errorf("spec not restored: %+v", svc.Spec.Model)
+	}
+	if svc.Status.Status != constants.AIMStatusReady {
+		t.Errorf("status = %q, want Ready", svc.Status.Status)
+	}
+	if svc.Status.ResolvedTemplate == nil || svc.Status.ResolvedTemplate.Namespace != "team-z" {
+		t.Errorf("status namespace not remapped: %+v", svc.Status.ResolvedTemplate)
+	}
+
+	// A second import updates the existing object
+	results = Import(context.Background(), target, bundle, ImportOptions{NamespaceMap: map[string]string{"team-a": "team-z"}})
+	if len(results) != 1 || results[0].Action != "updated" {
+		t.Errorf("re-import = %+v, want updated", results)
+	}
+}
+
+func TestImport_Order(t *testing.T) {
+	bundle := &unstructured.UnstructuredList{}
+	for _, kind := range []string{"AIMService", "AIMClusterRuntimeConfig", "AIMModel"} {
+		obj := unstructured.Unstructured{}
+		obj.SetGroupVersionKind(aimv1alpha1.GroupVersion.WithKind(kind))
+		obj.SetName("x")
+		if kind != "AIMClusterRuntimeConfig" {
+			obj.SetNamespace("default")
+		}
+		bundle.Items = append(bundle.Items, obj)
+	}
+
+	results := Import(context.Background(), newFakeClient(), bundle, ImportOptions{DryRun: true})
+	want := []string{"AIMClusterRuntimeConfig x", "AIMModel default/x", "AIMService default/x"}
+	for i, result := range results {
+		if result.Object != want[i] {
+			t.Errorf("Import()[%d] = %q, want %q", i, result.Object, want[i])
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronScheduleErrors(t *testing.T) {
+	for _, expr := range []string{
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * FOO *",
+	} {
+		if _, err := ParseCronSchedule(expr); err == nil {
+			t.Errorf("expected %q to be rejected", expr)
+		}
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	// 2026-01-01 is a Thursday
+	base := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 1, 1, 10, 31, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, 1, 2, 2, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"0 22 * * SAT,SUN", time.Date(2026, 1, 3, 22, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"0 3 1 MAR *", time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC)},
+		{"0 1-3/2 * * *", time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC)},
+		// Either day field matches when both are restricted
+		{"0 0 15 * MON", time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		s, err := ParseCronSchedule(tt.expr)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.expr, err)
+		}
+		if got := s.Next(base); !got.Equal(tt.want) {
+			t.Errorf("%q: Next = %s, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestCronScheduleNextInLocation(t *testing.T) {
+	kolkata := time.FixedZone("IST", 5*3600+1800)
+	s, err := ParseCronSchedule("0 2 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := s.Next(time.Date(2026, 1, 1, 10, 0, 0, 0, kolkata))
+	if want := time.Date(2026, 1, 2, 2, 0, 0, 0, kolkata); !got.Equal(want) {
+		t.Errorf("Next = %s, want %s", got, want)
+	}
+}
+
+func TestCronScheduleLastWithin(t *testing.T) {
+	s, err := ParseCronSchedule("0 2 * * SAT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2026-01-03 is a Saturday
+	open := time.Date(2026, 1, 3, 5, 59, 0, 0, time.UTC)
+	if start, ok := s.LastWithin(open, 4*time.Hour); !ok || !start.Equal(time.Date(2026, 1, 3, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the window to be open since 02:00, got %s %v", start, ok)
+	}
+	if _, ok := s.LastWithin(open.Add(time.Minute), 4*time.Hour); ok {
+		t.Error("expected the window to be closed after 4 hours")
+	}
+	if _, ok := s.LastWithin(time.Date(2026, 1, 3, 1, 59, 0, 0, time.UTC), 4*time.Hour); ok {
+		t.Error("expected the window to be closed before it opens")
+	}
+}
@@ -0,0 +1,75 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// envVarReference matches $(NAME) references in env var values, and the $$ escape that
+// Kubernetes turns into a literal $.
+var envVarReference = regexp.MustCompile(`\$\$|\$\(([A-Za-z_][A-Za-z0-9_]*)\)`)
+
+// ExpandEnvVarTemplates substitutes $(NAME) references in env var values with the given variables.
+// References to env vars of the same list are left for Kubernetes to expand, and take precedence
+// over variables of the same name. Escaped references ($$(NAME)) are left as they are.
+// Any other reference is an error, and is left unexpanded in the returned copy.
+func ExpandEnvVarTemplates(env []corev1.EnvVar, variables map[string]string) ([]corev1.EnvVar, error) {
+	defined := make(map[string]bool, len(env))
+	for _, e := range env {
+		defined[e.Name] = true
+	}
+
+	expanded := CopyEnvVars(env)
+	var errs []error
+	for i := range expanded {
+		var unknown []string
+		expanded[i].Value = envVarReference.ReplaceAllStringFunc(expanded[i].Value, func(ref string) string {
+			if ref == "$$" {
+				return ref
+			}
+			name := ref[2 : len(ref)-1]
+			if defined[name] {
+				return ref
+			}
+			if value, ok := variables[name]; ok {
+				return value
+			}
+			if !slices.Contains(unknown, name) {
+				unknown = append(unknown, name)
+			}
+			return ref
+		})
+		if len(unknown) > 0 {
+			errs = append(errs, fmt.Errorf("env var %s references unknown variables: %s",
+				expanded[i].Name, strings.Join(unknown, ", ")))
+		}
+	}
+	return expanded, errors.Join(errs...)
+}
@@ -0,0 +1,71 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestExpandEnvVarTemplates(t *testing.T) {
+	variables := map[string]string{"NAMESPACE": "team-a", "GPU_COUNT": "4", "POD_IP": "unused"}
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr string
+	}{
+		{name: "plain value", value: "foo", want: "foo"},
+		{name: "variables", value: "/cache/$(NAMESPACE)/tp$(GPU_COUNT)", want: "/cache/team-a/tp4"},
+		{name: "env var reference left for Kubernetes", value: "http://$(POD_IP):8000", want: "http://$(POD_IP):8000"},
+		{name: "escaped reference", value: "$$(NAMESPACE)", want: "$$(NAMESPACE)"},
+		{name: "not a reference", value: "$NAMESPACE and $(", want: "$NAMESPACE and $("},
+		{
+			name:    "unknown variable",
+			value:   "$(NAMESPACE)-$(CLUSTER)-$(CLUSTER)",
+			want:    "team-a-$(CLUSTER)-$(CLUSTER)",
+			wantErr: "env var VALUE references unknown variables: CLUSTER",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := []corev1.EnvVar{{Name: "VALUE", Value: tt.value}, {Name: "POD_IP"}}
+			expanded, err := ExpandEnvVarTemplates(env, variables)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+			if expanded[0].Value != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, expanded[0].Value)
+			}
+			if env[0].Value != tt.value {
+				t.Error("expected the input to be left unchanged")
+			}
+		})
+	}
+}
@@ -29,6 +29,9 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -179,6 +182,43 @@ func GenerateDerivedName(nameParts []string, opts ...NameOption) (string, error)
 	return result, nil
 }
 
+// NameCollisionError reports that a generated name is already taken by an object
+// that is not controlled by the expected owner.
+type NameCollisionError struct {
+	Kind      string
+	Namespace string
+	Name      string
+	// Controller describes the current controller of the object, empty if it has none.
+	Controller string
+}
+
+func (e *NameCollisionError) Error() string {
+	holder := "an object without a controller"
+	if e.Controller != "" {
+		holder = e.Controller
+	}
+	return fmt.Sprintf("%s %s/%s already exists and is controlled by %s", e.Kind, e.Namespace, e.Name, holder)
+}
+
+// CheckNameCollision verifies that an existing object with a generated name is controlled by
+// ownerUID. Generated names are deterministic, so an object created by someone else (or by
+// another owner whose name hashes the same) would otherwise be silently adopted by the next
+// apply. Returns a *NameCollisionError if the object has a different or no controller.
+func CheckNameCollision(existing metav1.Object, kind string, ownerUID types.UID) error {
+	if existing == nil || existing.GetName() == "" {
+		return nil
+	}
+	ref := metav1.GetControllerOf(existing)
+	if ref != nil && ref.UID == ownerUID {
+		return nil
+	}
+	err := &NameCollisionError{Kind: kind, Namespace: existing.GetNamespace(), Name: existing.GetName()}
+	if ref != nil {
+		err.Controller = ref.Kind + " " + ref.Name
+	}
+	return err
+}
+
 // computeHash creates a deterministic hash from input values of any type.
 // Arrays, slices, and maps are sorted recursively to ensure determinism.
 func computeHash(inputs ...any) string {
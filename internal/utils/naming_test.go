@@ -0,0 +1,111 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestCheckNameCollision(t *testing.T) {
+	owned := func(uid string) *metav1.ObjectMeta {
+		return &metav1.ObjectMeta{
+			Name:      "svc-1a2b3c4d",
+			Namespace: "ns",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "AIMService", Name: "svc", UID: k8stypes.UID("uid-" + uid), Controller: ptr.To(true)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		obj     *metav1.ObjectMeta
+		wantErr string
+	}{
+		{
+			name: "controlled by owner",
+			obj:  owned("a"),
+		},
+		{
+			name:    "controlled by another owner",
+			obj:     owned("b"),
+			wantErr: "InferenceService ns/svc-1a2b3c4d already exists and is controlled by AIMService svc",
+		},
+		{
+			name:    "no controller",
+			obj:     &metav1.ObjectMeta{Name: "svc-1a2b3c4d", Namespace: "ns"},
+			wantErr: "InferenceService ns/svc-1a2b3c4d already exists and is controlled by an object without a controller",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckNameCollision(tt.obj, "InferenceService", "uid-a")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var collision *NameCollisionError
+			if !errors.As(err, &collision) {
+				t.Fatalf("expected NameCollisionError, got %v", err)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("error = %q, want %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateDerivedName(t *testing.T) {
+	t.Run("deterministic with hash", func(t *testing.T) {
+		a, _ := GenerateDerivedName([]string{"discover", "my-template"}, WithHashSource("spec"))
+		b, _ := GenerateDerivedName([]string{"discover", "my-template"}, WithHashSource("spec"))
+		if a != b {
+			t.Errorf("names differ: %q vs %q", a, b)
+		}
+		if len(a) != len("discover-my-template-")+DefaultHashLength {
+			t.Errorf("unexpected name %q", a)
+		}
+	})
+
+	t.Run("truncates the longest part", func(t *testing.T) {
+		long := "a-very-long-template-name-that-does-not-fit-into-a-kubernetes-name"
+		name, err := GenerateDerivedName([]string{"discover", long}, WithHashSource("spec"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(name) > MaxKubernetesNameLength {
+			t.Errorf("name %q is %d characters", name, len(name))
+		}
+		if name[:len("discover-a-very")] != "discover-a-very" {
+			t.Errorf("expected the prefix to be kept, got %q", name)
+		}
+	})
+}
@@ -23,11 +23,16 @@
 package utils
 
 import (
+	"maps"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
 // NodeGPUChangePredicate returns a predicate that triggers reconciles when GPU-related node attributes change.
@@ -128,3 +133,94 @@ func isGPUKey(key string) bool {
 	return strings.HasPrefix(key, "amd.com/") ||
 		strings.HasPrefix(key, "beta.amd.com/")
 }
+
+// NodeHealthChangePredicate returns a predicate that passes node updates where a condition changed
+// status or a GPU health label of the AMD device metrics exporter changed. Heartbeat-only status
+// updates are filtered out.
+func NodeHealthChangePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, okOld := e.ObjectOld.(*corev1.Node)
+			newNode, okNew := e.ObjectNew.(*corev1.Node)
+			if !okOld || !okNew {
+				return false
+			}
+			return nodeConditionStatusesChanged(oldNode, newNode) ||
+				!maps.Equal(gpuHealthLabels(oldNode.Labels), gpuHealthLabels(newNode.Labels))
+		},
+	}
+}
+
+func nodeConditionStatusesChanged(oldNode, newNode *corev1.Node) bool {
+	if len(oldNode.Status.Conditions) != len(newNode.Status.Conditions) {
+		return true
+	}
+	oldStatuses := make(map[corev1.NodeConditionType]corev1.ConditionStatus, len(oldNode.Status.Conditions))
+	for _, cond := range oldNode.Status.Conditions {
+		oldStatuses[cond.Type] = cond.Status
+	}
+	for _, cond := range newNode.Status.Conditions {
+		if status, ok := oldStatuses[cond.Type]; !ok || status != cond.Status {
+			return true
+		}
+	}
+	return false
+}
+
+func gpuHealthLabels(labels map[string]string) map[string]string {
+	health := map[string]string{}
+	for key, value := range labels {
+		if strings.HasPrefix(key, constants.NodeLabelAMDGPUHealthPrefix) &&
+			strings.HasSuffix(key, constants.NodeLabelAMDGPUHealthSuffix) {
+			health[key] = value
+		}
+	}
+	return health
+}
+
+// ServiceResolvedRefChangePredicate returns a predicate that passes AIMService creates and deletes,
+// and updates where the resolved reference returned by ref changed name or scope.
+func ServiceResolvedRefChangePredicate(ref func(*aimv1alpha1.AIMService) *aimv1alpha1.AIMResolvedReference) predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSvc, okOld := e.ObjectOld.(*aimv1alpha1.AIMService)
+			newSvc, okNew := e.ObjectNew.(*aimv1alpha1.AIMService)
+			if !okOld || !okNew {
+				return false
+			}
+			oldRef, newRef := ref(oldSvc), ref(newSvc)
+			if oldRef == nil || newRef == nil {
+				return oldRef != newRef
+			}
+			return oldRef.Name != newRef.Name || oldRef.Scope != newRef.Scope
+		},
+	}
+}
+
+// templateWithStatus is implemented by both AIMServiceTemplate and AIMClusterServiceTemplate.
+type templateWithStatus interface {
+	GetAnnotations() map[string]string
+	GetStatus() *aimv1alpha1.AIMServiceTemplateStatus
+}
+
+// TemplatePerformanceChangePredicate returns a predicate that passes template creates and deletes,
+// and updates that change the performance annotation, the recorded performance or the template status.
+// Sibling templates of the same model compare their measurements on these changes.
+func TemplatePerformanceChangePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldTpl, okOld := e.ObjectOld.(templateWithStatus)
+			newTpl, okNew := e.ObjectNew.(templateWithStatus)
+			if !okOld || !okNew {
+				return false
+			}
+			oldStatus, newStatus := oldTpl.GetStatus(), newTpl.GetStatus()
+			return oldTpl.GetAnnotations()[constants.AnnotationPerformance] != newTpl.GetAnnotations()[constants.AnnotationPerformance] ||
+				oldStatus.Status != newStatus.Status ||
+				!equality.Semantic.DeepEqual(oldStatus.Performance, newStatus.Performance)
+		},
+	}
+}
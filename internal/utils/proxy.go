@@ -0,0 +1,65 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// ProxyEnvVars returns the proxy environment variables for the runtime config's proxy settings,
+// or nil when no proxy is configured. Both the upper- and lowercase variants are set.
+func ProxyEnvVars(runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) []corev1.EnvVar {
+	if runtimeConfig == nil || runtimeConfig.Proxy == nil {
+		return nil
+	}
+	proxy := runtimeConfig.Proxy
+
+	var env []corev1.EnvVar
+	add := func(name, value string) {
+		if value == "" {
+			return
+		}
+		env = append(env,
+			corev1.EnvVar{Name: name, Value: value},
+			corev1.EnvVar{Name: strings.ToLower(name), Value: value},
+		)
+	}
+	add("HTTP_PROXY", proxy.HTTPProxy)
+	add("HTTPS_PROXY", proxy.HTTPSProxy)
+	add("NO_PROXY", strings.Join(proxy.NoProxy, ","))
+	return env
+}
+
+// WithProxyEnv returns env with the runtime config's proxy variables added for any that env
+// does not set itself. env is returned unchanged when no proxy is configured.
+func WithProxyEnv(env []corev1.EnvVar, runtimeConfig *aimv1alpha1.AIMRuntimeConfigCommon) []corev1.EnvVar {
+	proxyEnv := ProxyEnvVars(runtimeConfig)
+	if len(proxyEnv) == 0 {
+		return env
+	}
+	return MergeEnvVars(proxyEnv, env)
+}
@@ -0,0 +1,192 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// RetryPolicy configures how external calls (registries, webhooks) are retried.
+type RetryPolicy struct {
+	// Attempts is the total number of attempts, including the first one.
+	Attempts int
+	// InitialBackoff is the wait before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts.
+	MaxBackoff time.Duration
+	// Factor multiplies the wait after each attempt.
+	Factor float64
+	// Jitter randomizes each wait by up to this fraction in either direction,
+	// so that controllers hitting the same registry do not retry in lockstep.
+	Jitter float64
+	// AttemptTimeout bounds each attempt. Zero means attempts are only bounded by the context.
+	AttemptTimeout time.Duration
+}
+
+// DefaultRetryPolicy is used for registry and other external API calls made during reconciliation.
+// It is kept short, since the reconcile loop retries on its own once these attempts are exhausted.
+var DefaultRetryPolicy = RetryPolicy{
+	Attempts:       3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Factor:         2,
+	Jitter:         0.2,
+	AttemptTimeout: 30 * time.Second,
+}
+
+// HTTPStatusError reports an unexpected HTTP response status.
+type HTTPStatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return e.Message
+}
+
+// NewHTTPStatusError builds an HTTPStatusError for a response from the given URL.
+func NewHTTPStatusError(statusCode int, url, body string) *HTTPStatusError {
+	msg := fmt.Sprintf("unexpected status %d from %s", statusCode, url)
+	if body != "" {
+		msg += ": " + body
+	}
+	return &HTTPStatusError{StatusCode: statusCode, Message: msg}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks an error as terminal, so that Retry returns it without further attempts.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsRetriableHTTPStatus returns true for HTTP statuses that may succeed on a later attempt:
+// request timeouts, rate limiting, and server errors other than 501 Not Implemented.
+func IsRetriableHTTPStatus(statusCode int) bool {
+	switch {
+	case statusCode == http.StatusRequestTimeout, statusCode == http.StatusTooEarly,
+		statusCode == http.StatusTooManyRequests:
+		return true
+	case statusCode == http.StatusNotImplemented:
+		return false
+	default:
+		return statusCode >= 500
+	}
+}
+
+// IsRetriableError classifies an error from an external call as retriable or terminal.
+// Network errors, timeouts and retriable HTTP statuses are retried. Client errors such as
+// 401, 403 and 404, cancellation, and errors the caller marked Permanent are terminal,
+// as are errors that cannot be classified (e.g. malformed responses).
+func IsRetriableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var permanent *permanentError
+	if errors.As(err, &permanent) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return IsRetriableHTTPStatus(statusErr.StatusCode)
+	}
+	var transportErr *transport.Error
+	if errors.As(err, &transportErr) {
+		return transportErr.Temporary() || IsRetriableHTTPStatus(transportErr.StatusCode)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// Retry calls fn until it succeeds, returns a terminal error, or the attempts are exhausted,
+// waiting with exponential backoff and jitter between attempts. It never waits past the
+// context deadline, and returns the last error from fn.
+func Retry(ctx context.Context, policy RetryPolicy, fn func(ctx context.Context) error) error {
+	attempts := max(policy.Attempts, 1)
+	backoff := policy.InitialBackoff
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = callAttempt(ctx, policy.AttemptTimeout, fn)
+		if err == nil || attempt >= attempts || !IsRetriableError(err) || ctx.Err() != nil {
+			return err
+		}
+
+		wait := jitterDuration(backoff, policy.Jitter)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return err
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		backoff = time.Duration(float64(backoff) * max(policy.Factor, 1))
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
+
+func callAttempt(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return fn(attemptCtx)
+}
+
+// jitterDuration randomizes d by up to the given fraction in either direction.
+func jitterDuration(d time.Duration, jitter float64) time.Duration {
+	if jitter <= 0 || d <= 0 {
+		return d
+	}
+	return time.Duration(float64(d) * (1 + jitter*(2*rand.Float64()-1)))
+}
@@ -0,0 +1,182 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+var fastRetryPolicy = RetryPolicy{
+	Attempts:       3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     2 * time.Millisecond,
+	Factor:         2,
+	Jitter:         0.5,
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsRetriableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "server error", err: NewHTTPStatusError(http.StatusBadGateway, "url", ""), want: true},
+		{name: "rate limited", err: NewHTTPStatusError(http.StatusTooManyRequests, "url", ""), want: true},
+		{name: "not implemented", err: NewHTTPStatusError(http.StatusNotImplemented, "url", ""), want: false},
+		{name: "not found", err: NewHTTPStatusError(http.StatusNotFound, "url", ""), want: false},
+		{name: "wrapped unauthorized", err: fmt.Errorf("fetch: %w", NewHTTPStatusError(http.StatusUnauthorized, "url", "")), want: false},
+		{name: "registry unavailable", err: &transport.Error{StatusCode: http.StatusServiceUnavailable}, want: true},
+		{name: "registry forbidden", err: &transport.Error{StatusCode: http.StatusForbidden}, want: false},
+		{name: "registry error wrapping transport error", err: &ImageRegistryError{
+			Type: ImagePullErrorGeneric, Cause: &transport.Error{StatusCode: http.StatusInternalServerError},
+		}, want: true},
+		{name: "network timeout", err: fmt.Errorf("dial: %w", timeoutError{}), want: true},
+		{name: "attempt deadline", err: context.DeadlineExceeded, want: true},
+		{name: "canceled", err: context.Canceled, want: false},
+		{name: "permanent server error", err: Permanent(NewHTTPStatusError(http.StatusBadGateway, "url", "")), want: false},
+		{name: "unclassified", err: errors.New("failed to decode JSON response"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetriableError(tt.err); got != tt.want {
+				t.Errorf("IsRetriableError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		errs         []error
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "succeeds first time", errs: []error{nil}, wantAttempts: 1},
+		{
+			name:         "succeeds after transient failures",
+			errs:         []error{NewHTTPStatusError(http.StatusServiceUnavailable, "url", ""), timeoutError{}, nil},
+			wantAttempts: 3,
+		},
+		{
+			name:         "stops on terminal error",
+			errs:         []error{NewHTTPStatusError(http.StatusNotFound, "url", ""), nil},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name: "gives up after all attempts",
+			errs: []error{
+				NewHTTPStatusError(http.StatusBadGateway, "url", ""),
+				NewHTTPStatusError(http.StatusBadGateway, "url", ""),
+				NewHTTPStatusError(http.StatusBadGateway, "url", ""),
+				nil,
+			},
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			err := Retry(context.Background(), fastRetryPolicy, func(context.Context) error {
+				err := tt.errs[attempts]
+				attempts++
+				return err
+			})
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error result: %v", err)
+			}
+		})
+	}
+}
+
+func TestRetry_RespectsContextDeadline(t *testing.T) {
+	policy := fastRetryPolicy
+	policy.InitialBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	attempts := 0
+	start := time.Now()
+	err := Retry(ctx, policy, func(context.Context) error {
+		attempts++
+		return NewHTTPStatusError(http.StatusServiceUnavailable, "url", "")
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("expected a single failed attempt, got %d attempts and error %v", attempts, err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Error("expected Retry not to wait past the context deadline")
+	}
+}
+
+func TestRetry_AttemptTimeout(t *testing.T) {
+	policy := fastRetryPolicy
+	policy.AttemptTimeout = 10 * time.Millisecond
+
+	attempts := 0
+	err := Retry(context.Background(), policy, func(ctx context.Context) error {
+		attempts++
+		if attempts < 2 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	})
+	if err != nil || attempts != 2 {
+		t.Errorf("expected the timed out attempt to be retried, got %d attempts and error %v", attempts, err)
+	}
+}
+
+func TestJitterDuration(t *testing.T) {
+	for range 100 {
+		d := jitterDuration(time.Second, 0.2)
+		if d < 800*time.Millisecond || d > 1200*time.Millisecond {
+			t.Fatalf("jittered duration %s out of bounds", d)
+		}
+	}
+	if d := jitterDuration(time.Second, 0); d != time.Second {
+		t.Errorf("expected no jitter, got %s", d)
+	}
+}
@@ -0,0 +1,246 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// ResolveScheduling determines the effective node placement constraints for a pod.
+// The service-level config (may be nil) is merged over the runtime config's scheduling:
+//   - nodeSelector keys from the service take precedence
+//   - tolerations from the service are added to the runtime config tolerations
+//   - each affinity kind set on the service replaces the runtime config value of that kind
+//
+// Returns nil if neither source sets any scheduling constraints.
+func ResolveScheduling(serviceScheduling *aimv1alpha1.AIMSchedulingConfig, runtimeConfigSpec *aimv1alpha1.AIMRuntimeConfigCommon) *aimv1alpha1.AIMSchedulingConfig {
+	var base *aimv1alpha1.AIMSchedulingConfig
+	if runtimeConfigSpec != nil {
+		base = runtimeConfigSpec.Scheduling
+	}
+	if base == nil && serviceScheduling == nil {
+		return nil
+	}
+	if base == nil {
+		return serviceScheduling.DeepCopy()
+	}
+	if serviceScheduling == nil {
+		return base.DeepCopy()
+	}
+
+	return &aimv1alpha1.AIMSchedulingConfig{
+		NodeSelector: MergeNodeSelector(base.NodeSelector, serviceScheduling.NodeSelector),
+		Tolerations:  MergeTolerations(base.Tolerations, serviceScheduling.Tolerations),
+		Affinity:     MergeAffinity(base.Affinity, serviceScheduling.Affinity),
+	}
+}
+
+// MergeNodeSelector returns the union of two node selectors, with override keys taking precedence.
+func MergeNodeSelector(base, override map[string]string) map[string]string {
+	if len(base) == 0 && len(override) == 0 {
+		return nil
+	}
+	merged := make(map[string]string, len(base)+len(override))
+	maps.Copy(merged, base)
+	maps.Copy(merged, override)
+	return merged
+}
+
+// MergeTolerations returns base followed by any tolerations from extra that are not already present.
+func MergeTolerations(base, extra []corev1.Toleration) []corev1.Toleration {
+	if len(base) == 0 && len(extra) == 0 {
+		return nil
+	}
+	merged := make([]corev1.Toleration, 0, len(base)+len(extra))
+	for _, toleration := range append(append([]corev1.Toleration(nil), base...), extra...) {
+		if !containsToleration(merged, toleration) {
+			merged = append(merged, *toleration.DeepCopy())
+		}
+	}
+	return merged
+}
+
+func containsToleration(tolerations []corev1.Toleration, toleration corev1.Toleration) bool {
+	for i := range tolerations {
+		if equality.Semantic.DeepEqual(tolerations[i], toleration) {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeAffinity merges two affinities per kind: each of nodeAffinity, podAffinity and
+// podAntiAffinity set on override replaces the corresponding value from base.
+func MergeAffinity(base, override *corev1.Affinity) *corev1.Affinity {
+	if base == nil && override == nil {
+		return nil
+	}
+	if override == nil {
+		return base.DeepCopy()
+	}
+	if base == nil {
+		return override.DeepCopy()
+	}
+
+	merged := base.DeepCopy()
+	if override.NodeAffinity != nil {
+		merged.NodeAffinity = override.NodeAffinity.DeepCopy()
+	}
+	if override.PodAffinity != nil {
+		merged.PodAffinity = override.PodAffinity.DeepCopy()
+	}
+	if override.PodAntiAffinity != nil {
+		merged.PodAntiAffinity = override.PodAntiAffinity.DeepCopy()
+	}
+	return merged
+}
+
+// CombineNodeAffinity returns a node affinity that requires both a and b to be satisfied.
+// Required node selector terms are ORed by Kubernetes, so the result contains the
+// cross product of the terms of a and b with their requirements concatenated.
+// Preferred scheduling terms are concatenated.
+func CombineNodeAffinity(a, b *corev1.NodeAffinity) *corev1.NodeAffinity {
+	if a == nil && b == nil {
+		return nil
+	}
+	if b == nil {
+		return a.DeepCopy()
+	}
+	if a == nil {
+		return b.DeepCopy()
+	}
+
+	combined := &corev1.NodeAffinity{}
+
+	aRequired := a.RequiredDuringSchedulingIgnoredDuringExecution
+	bRequired := b.RequiredDuringSchedulingIgnoredDuringExecution
+	switch {
+	case aRequired == nil || len(aRequired.NodeSelectorTerms) == 0:
+		combined.RequiredDuringSchedulingIgnoredDuringExecution = bRequired.DeepCopy()
+	case bRequired == nil || len(bRequired.NodeSelectorTerms) == 0:
+		combined.RequiredDuringSchedulingIgnoredDuringExecution = aRequired.DeepCopy()
+	default:
+		selector := &corev1.NodeSelector{}
+		for _, aTerm := range aRequired.NodeSelectorTerms {
+			for _, bTerm := range bRequired.NodeSelectorTerms {
+				term := corev1.NodeSelectorTerm{}
+				for _, expr := range append(append([]corev1.NodeSelectorRequirement(nil), aTerm.MatchExpressions...), bTerm.MatchExpressions...) {
+					term.MatchExpressions = append(term.MatchExpressions, *expr.DeepCopy())
+				}
+				for _, field := range append(append([]corev1.NodeSelectorRequirement(nil), aTerm.MatchFields...), bTerm.MatchFields...) {
+					term.MatchFields = append(term.MatchFields, *field.DeepCopy())
+				}
+				selector.NodeSelectorTerms = append(selector.NodeSelectorTerms, term)
+			}
+		}
+		combined.RequiredDuringSchedulingIgnoredDuringExecution = selector
+	}
+
+	for _, term := range append(append([]corev1.PreferredSchedulingTerm(nil),
+		a.PreferredDuringSchedulingIgnoredDuringExecution...), b.PreferredDuringSchedulingIgnoredDuringExecution...) {
+		combined.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			combined.PreferredDuringSchedulingIgnoredDuringExecution, *term.DeepCopy())
+	}
+
+	return combined
+}
+
+// ApplySchedulingToPodSpec merges the scheduling constraints into a pod spec.
+// Existing node selector keys are overridden, tolerations are added, node affinity is
+// combined so both the existing and the configured node affinity must be satisfied,
+// and pod (anti-)affinity is set when configured.
+func ApplySchedulingToPodSpec(podSpec *corev1.PodSpec, scheduling *aimv1alpha1.AIMSchedulingConfig) {
+	if scheduling == nil {
+		return
+	}
+	podSpec.NodeSelector = MergeNodeSelector(podSpec.NodeSelector, scheduling.NodeSelector)
+	podSpec.Tolerations = MergeTolerations(podSpec.Tolerations, scheduling.Tolerations)
+	podSpec.Affinity = ApplyAffinity(podSpec.Affinity, scheduling.Affinity)
+}
+
+// ApplyAffinity merges a configured affinity into an existing pod affinity.
+// Node affinity is combined with CombineNodeAffinity; pod affinity and pod anti-affinity
+// from the configured affinity replace the existing values when set.
+func ApplyAffinity(existing, configured *corev1.Affinity) *corev1.Affinity {
+	if configured == nil {
+		return existing
+	}
+	result := existing.DeepCopy()
+	if result == nil {
+		result = &corev1.Affinity{}
+	}
+	result.NodeAffinity = CombineNodeAffinity(result.NodeAffinity, configured.NodeAffinity)
+	if configured.PodAffinity != nil {
+		result.PodAffinity = configured.PodAffinity.DeepCopy()
+	}
+	if configured.PodAntiAffinity != nil {
+		result.PodAntiAffinity = configured.PodAntiAffinity.DeepCopy()
+	}
+	return result
+}
+
+// PinnedNodeLabels returns the node labels the scheduling constraints pin to a single value.
+// A label is pinned by the node selector, or by the required node affinity when every node
+// selector term requires it to be exactly the same value (terms are ORed by Kubernetes).
+// Node selector values take precedence over node affinity.
+func PinnedNodeLabels(scheduling *aimv1alpha1.AIMSchedulingConfig) map[string]string {
+	if scheduling == nil {
+		return nil
+	}
+	pinned := map[string]string{}
+	if affinity := scheduling.Affinity; affinity != nil && affinity.NodeAffinity != nil &&
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		for i, term := range terms {
+			termPinned := pinnedByTerm(term)
+			if i == 0 {
+				maps.Copy(pinned, termPinned)
+				continue
+			}
+			maps.DeleteFunc(pinned, func(key, value string) bool {
+				return termPinned[key] != value
+			})
+		}
+	}
+	maps.Copy(pinned, scheduling.NodeSelector)
+	if len(pinned) == 0 {
+		return nil
+	}
+	return pinned
+}
+
+// pinnedByTerm returns the labels a node selector term requires to have exactly one value.
+func pinnedByTerm(term corev1.NodeSelectorTerm) map[string]string {
+	pinned := map[string]string{}
+	for _, expr := range term.MatchExpressions {
+		if expr.Operator == corev1.NodeSelectorOpIn && len(expr.Values) == 1 {
+			pinned[expr.Key] = expr.Values[0]
+		}
+	}
+	return pinned
+}
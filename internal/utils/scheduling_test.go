@@ -0,0 +1,183 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package utils
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+func nodeAffinityRequiring(key string, values ...string) *corev1.NodeAffinity {
+	return &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{
+					Key:      key,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   values,
+				}},
+			}},
+		},
+	}
+}
+
+func TestResolveScheduling(t *testing.T) {
+	gpuToleration := corev1.Toleration{Key: "amd.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	poolToleration := corev1.Toleration{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "inference", Effect: corev1.TaintEffectNoSchedule}
+
+	if got := ResolveScheduling(nil, nil); got != nil {
+		t.Fatalf("expected nil scheduling, got %+v", got)
+	}
+
+	runtimeConfig := &aimv1alpha1.AIMRuntimeConfigCommon{
+		AIMServiceRuntimeConfig: aimv1alpha1.AIMServiceRuntimeConfig{
+			Scheduling: &aimv1alpha1.AIMSchedulingConfig{
+				NodeSelector: map[string]string{"pool": "gpu", "zone": "a"},
+				Tolerations:  []corev1.Toleration{gpuToleration},
+				Affinity:     &corev1.Affinity{NodeAffinity: nodeAffinityRequiring("rack", "r1")},
+			},
+		},
+	}
+
+	fromRuntimeConfig := ResolveScheduling(nil, runtimeConfig)
+	if fromRuntimeConfig.NodeSelector["pool"] != "gpu" || len(fromRuntimeConfig.Tolerations) != 1 {
+		t.Errorf("expected runtime config scheduling, got %+v", fromRuntimeConfig)
+	}
+
+	merged := ResolveScheduling(&aimv1alpha1.AIMSchedulingConfig{
+		NodeSelector: map[string]string{"pool": "inference"},
+		Tolerations:  []corev1.Toleration{gpuToleration, poolToleration},
+		Affinity:     &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{}},
+	}, runtimeConfig)
+
+	if merged.NodeSelector["pool"] != "inference" || merged.NodeSelector["zone"] != "a" {
+		t.Errorf("expected service node selector keys to take precedence, got %v", merged.NodeSelector)
+	}
+	if len(merged.Tolerations) != 2 {
+		t.Errorf("expected deduplicated tolerations, got %v", merged.Tolerations)
+	}
+	if merged.Affinity.NodeAffinity == nil || merged.Affinity.PodAntiAffinity == nil {
+		t.Errorf("expected runtime config node affinity and service pod anti-affinity, got %+v", merged.Affinity)
+	}
+
+	// The runtime config must not be mutated by merging
+	if runtimeConfig.Scheduling.NodeSelector["pool"] != "gpu" {
+		t.Error("runtime config node selector was mutated")
+	}
+}
+
+func TestCombineNodeAffinity(t *testing.T) {
+	gpu := &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gpu", Operator: corev1.NodeSelectorOpIn, Values: []string{"MI300X"}}}},
+				{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gpu", Operator: corev1.NodeSelectorOpIn, Values: []string{"MI325X"}}}},
+			},
+		},
+	}
+	pool := nodeAffinityRequiring("pool", "inference")
+
+	combined := CombineNodeAffinity(gpu, pool)
+	terms := combined.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) != 2 {
+		t.Fatalf("expected 2 combined terms, got %d", len(terms))
+	}
+	for _, term := range terms {
+		if len(term.MatchExpressions) != 2 || term.MatchExpressions[1].Key != "pool" {
+			t.Errorf("expected each term to require both gpu and pool, got %+v", term.MatchExpressions)
+		}
+	}
+
+	if got := CombineNodeAffinity(nil, pool); got.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Key != "pool" {
+		t.Errorf("expected pool affinity when gpu affinity is nil, got %+v", got)
+	}
+	if got := CombineNodeAffinity(nil, nil); got != nil {
+		t.Errorf("expected nil, got %+v", got)
+	}
+}
+
+func TestApplySchedulingToPodSpec(t *testing.T) {
+	podSpec := &corev1.PodSpec{
+		NodeSelector: map[string]string{"existing": "true"},
+	}
+
+	ApplySchedulingToPodSpec(podSpec, &aimv1alpha1.AIMSchedulingConfig{
+		NodeSelector: map[string]string{"pool": "gpu"},
+		Tolerations:  []corev1.Toleration{{Key: "amd.com/gpu", Operator: corev1.TolerationOpExists}},
+		Affinity:     &corev1.Affinity{NodeAffinity: nodeAffinityRequiring("pool", "gpu")},
+	})
+
+	if podSpec.NodeSelector["existing"] != "true" || podSpec.NodeSelector["pool"] != "gpu" {
+		t.Errorf("unexpected node selector: %v", podSpec.NodeSelector)
+	}
+	if len(podSpec.Tolerations) != 1 {
+		t.Errorf("expected 1 toleration, got %v", podSpec.Tolerations)
+	}
+	if podSpec.Affinity == nil || podSpec.Affinity.NodeAffinity == nil {
+		t.Error("expected node affinity to be set")
+	}
+
+	unchanged := &corev1.PodSpec{}
+	ApplySchedulingToPodSpec(unchanged, nil)
+	if unchanged.NodeSelector != nil || unchanged.Tolerations != nil || unchanged.Affinity != nil {
+		t.Errorf("expected pod spec to be unchanged, got %+v", unchanged)
+	}
+}
+
+func TestPinnedNodeLabels(t *testing.T) {
+	const zoneKey = "topology.kubernetes.io/zone"
+
+	if got := PinnedNodeLabels(nil); got != nil {
+		t.Errorf("expected nil for nil scheduling, got %v", got)
+	}
+
+	got := PinnedNodeLabels(&aimv1alpha1.AIMSchedulingConfig{
+		NodeSelector: map[string]string{"pool": "mi300x"},
+		Affinity:     &corev1.Affinity{NodeAffinity: nodeAffinityRequiring(zoneKey, "zone-a")},
+	})
+	if got["pool"] != "mi300x" || got[zoneKey] != "zone-a" {
+		t.Errorf("expected pool and zone to be pinned, got %v", got)
+	}
+
+	if got := PinnedNodeLabels(&aimv1alpha1.AIMSchedulingConfig{
+		Affinity: &corev1.Affinity{NodeAffinity: nodeAffinityRequiring(zoneKey, "zone-a", "zone-b")},
+	}); got != nil {
+		t.Errorf("expected a multi-value requirement not to pin the zone, got %v", got)
+	}
+
+	// Terms are ORed, so a label is only pinned when every term requires the same value
+	sameZone := nodeAffinityRequiring(zoneKey, "zone-a")
+	otherZone := nodeAffinityRequiring(zoneKey, "zone-b")
+	twoTerms := &corev1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+		NodeSelectorTerms: append(sameZone.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms,
+			otherZone.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms...),
+	}}
+	if got := PinnedNodeLabels(&aimv1alpha1.AIMSchedulingConfig{
+		Affinity: &corev1.Affinity{NodeAffinity: twoTerms},
+	}); got != nil {
+		t.Errorf("expected terms with different zones not to pin the zone, got %v", got)
+	}
+}
@@ -23,9 +23,15 @@
 package utils
 
 import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
 )
 
 const (
@@ -117,3 +123,72 @@ func GetPVCHeadroomPercent(spec *aimv1alpha1.AIMRuntimeConfigCommon) int32 {
 	}
 	return DefaultPVCHeadroomPercent
 }
+
+// ResolveStorageEncryption determines the effective cache encryption. An explicit config on the
+// consuming resource takes precedence over the runtime config's Storage.Encryption.
+// Returns nil when caches are not encrypted.
+func ResolveStorageEncryption(
+	explicit *aimv1alpha1.AIMStorageEncryptionConfig,
+	runtimeConfigSpec *aimv1alpha1.AIMRuntimeConfigCommon,
+) *aimv1alpha1.AIMStorageEncryptionConfig {
+	if explicit != nil {
+		return explicit
+	}
+	if runtimeConfigSpec != nil && runtimeConfigSpec.Storage != nil {
+		return runtimeConfigSpec.Storage.Encryption
+	}
+	return nil
+}
+
+// CacheEncryptionKeyVolume returns a volume exposing the referenced encryption key as a single file.
+func CacheEncryptionKeyVolume(encryption *aimv1alpha1.AIMStorageEncryptionConfig) corev1.Volume {
+	return corev1.Volume{
+		Name: constants.VolumeCacheEncryptionKey,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: encryption.KMSSecretRef.Name,
+				Items: []corev1.KeyToPath{{
+					Key:  encryption.KMSSecretRef.Key,
+					Path: constants.CacheEncryptionKeyFile,
+				}},
+			},
+		},
+	}
+}
+
+// CacheEncryptionKeyVolumeMount returns the read-only mount for CacheEncryptionKeyVolume.
+func CacheEncryptionKeyVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      constants.VolumeCacheEncryptionKey,
+		MountPath: constants.MountPathCacheEncryptionKey,
+		ReadOnly:  true,
+	}
+}
+
+// CacheEncryptionKeyEnvVar returns the environment variable pointing at the mounted encryption key.
+func CacheEncryptionKeyEnvVar() corev1.EnvVar {
+	return corev1.EnvVar{
+		Name:  constants.EnvAIMCacheEncryptionKeyFile,
+		Value: path.Join(constants.MountPathCacheEncryptionKey, constants.CacheEncryptionKeyFile),
+	}
+}
+
+// ArtifactVolumeName returns the pod volume name for a resolved artifact.
+func ArtifactVolumeName(artifact aimv1alpha1.AIMResolvedArtifact) string {
+	return strings.ReplaceAll(MakeRFC1123Compliant(artifact.Name), ".", "-")
+}
+
+// ArtifactMountPath returns where a resolved artifact is mounted: its mount point when set,
+// otherwise the model ID (e.g. "Qwen/Qwen2-0.5B") below the cache base path.
+func ArtifactMountPath(artifact aimv1alpha1.AIMResolvedArtifact) string {
+	// TODO: Consider removing MountPoint field if it's never used
+	if artifact.MountPoint != "" {
+		return artifact.MountPoint
+	}
+	// Sanitize to prevent path traversal (remove ".." sequences)
+	safeModelName := strings.ReplaceAll(artifact.Model, "..", "")
+	if safeModelName == "" || safeModelName == "." {
+		safeModelName = ArtifactVolumeName(artifact) // Fall back to volume name if model name is invalid
+	}
+	return filepath.Join(constants.AIMCacheBasePath, safeModelName)
+}
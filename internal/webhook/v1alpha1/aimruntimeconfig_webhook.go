@@ -0,0 +1,107 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/aimruntimeconfig"
+)
+
+var runtimeConfigLog = logf.Log.WithName("aimruntimeconfig-webhook")
+
+// SetupAIMRuntimeConfigWebhookWithManager registers the validating webhooks of
+// AIMRuntimeConfig and AIMClusterRuntimeConfig with the manager.
+func SetupAIMRuntimeConfigWebhookWithManager(mgr ctrl.Manager) error {
+	validator := &RuntimeConfigCustomValidator{Client: mgr.GetClient()}
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&aimv1alpha1.AIMRuntimeConfig{}).
+		WithValidator(validator).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&aimv1alpha1.AIMClusterRuntimeConfig{}).
+		WithValidator(validator).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aim-eai-amd-com-v1alpha1-aimruntimeconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=aim.eai.amd.com,resources=aimruntimeconfigs,verbs=create;update,versions=v1alpha1,name=vaimruntimeconfig-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-aim-eai-amd-com-v1alpha1-aimclusterruntimeconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=aim.eai.amd.com,resources=aimclusterruntimeconfigs,verbs=create;update,versions=v1alpha1,name=vaimclusterruntimeconfig-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// RuntimeConfigCustomValidator validates AIMRuntimeConfig and AIMClusterRuntimeConfig objects
+// on create and update. Deletes are always allowed.
+type RuntimeConfigCustomValidator struct {
+	// Client looks up the storage classes referenced by the config.
+	Client client.Reader
+}
+
+var _ admission.CustomValidator = &RuntimeConfigCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *RuntimeConfigCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *RuntimeConfigCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *RuntimeConfigCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *RuntimeConfigCustomValidator) validate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	var (
+		config        *aimv1alpha1.AIMRuntimeConfigCommon
+		name          string
+		kind          string
+		clusterScoped bool
+	)
+	switch o := obj.(type) {
+	case *aimv1alpha1.AIMRuntimeConfig:
+		config, name, kind = &o.Spec.AIMRuntimeConfigCommon, o.Name, "AIMRuntimeConfig"
+	case *aimv1alpha1.AIMClusterRuntimeConfig:
+		config, name, kind, clusterScoped = &o.Spec.AIMRuntimeConfigCommon, o.Name, "AIMClusterRuntimeConfig", true
+	default:
+		return nil, fmt.Errorf("expected a runtime config object but got %T", obj)
+	}
+	runtimeConfigLog.V(1).Info("validating runtime config", "kind", kind, "name", name)
+
+	warnings, errs := aimruntimeconfig.Validate(ctx, v.Client, config, clusterScoped)
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(aimv1alpha1.GroupVersion.WithKind(kind).GroupKind(), name, errs)
+	}
+	return warnings, nil
+}
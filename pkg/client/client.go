@@ -0,0 +1,136 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package client is a typed Go client for the AIM custom resources. It wraps a controller-runtime
+// client with one accessor per kind, so that platform teams can manage AIM resources from their
+// own services without registering the API types themselves:
+//
+//	c, err := client.New(ctrl.GetConfigOrDie())
+//	service, err := c.AIMServices("team-a").Get(ctx, "llama")
+//
+// For informers and listers, pass Scheme to a controller-runtime cache or manager; the cached
+// client it returns can be wrapped with NewForClient.
+package client
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// Scheme contains the Kubernetes built-in types and the AIM API types.
+var Scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(Scheme))
+	utilruntime.Must(aimv1alpha1.AddToScheme(Scheme))
+}
+
+// Client is a typed client for the AIM custom resources. The embedded controller-runtime client
+// remains available for other kinds.
+type Client struct {
+	ctrlclient.Client
+}
+
+// New creates a client for the cluster of the given REST config.
+func New(config *rest.Config) (*Client, error) {
+	c, err := ctrlclient.New(config, ctrlclient.Options{Scheme: Scheme})
+	if err != nil {
+		return nil, err
+	}
+	return NewForClient(c), nil
+}
+
+// NewForClient wraps an existing controller-runtime client, e.g. the cached client of a manager.
+// Its scheme must contain the AIM API types.
+func NewForClient(c ctrlclient.Client) *Client {
+	return &Client{Client: c}
+}
+
+// AIMServices returns a client for the AIMServices in the namespace.
+func (c *Client) AIMServices(namespace string) Resource[*aimv1alpha1.AIMService, *aimv1alpha1.AIMServiceList] {
+	return newResource[aimv1alpha1.AIMService, aimv1alpha1.AIMServiceList](c.Client, namespace)
+}
+
+// AIMModels returns a client for the AIMModels in the namespace.
+func (c *Client) AIMModels(namespace string) Resource[*aimv1alpha1.AIMModel, *aimv1alpha1.AIMModelList] {
+	return newResource[aimv1alpha1.AIMModel, aimv1alpha1.AIMModelList](c.Client, namespace)
+}
+
+// AIMClusterModels returns a client for the AIMClusterModels.
+func (c *Client) AIMClusterModels() Resource[*aimv1alpha1.AIMClusterModel, *aimv1alpha1.AIMClusterModelList] {
+	return newResource[aimv1alpha1.AIMClusterModel, aimv1alpha1.AIMClusterModelList](c.Client, "")
+}
+
+// AIMServiceTemplates returns a client for the AIMServiceTemplates in the namespace.
+func (c *Client) AIMServiceTemplates(namespace string) Resource[*aimv1alpha1.AIMServiceTemplate, *aimv1alpha1.AIMServiceTemplateList] {
+	return newResource[aimv1alpha1.AIMServiceTemplate, aimv1alpha1.AIMServiceTemplateList](c.Client, namespace)
+}
+
+// AIMClusterServiceTemplates returns a client for the AIMClusterServiceTemplates.
+func (c *Client) AIMClusterServiceTemplates() Resource[*aimv1alpha1.AIMClusterServiceTemplate, *aimv1alpha1.AIMClusterServiceTemplateList] {
+	return newResource[aimv1alpha1.AIMClusterServiceTemplate, aimv1alpha1.AIMClusterServiceTemplateList](c.Client, "")
+}
+
+// AIMTemplateCaches returns a client for the AIMTemplateCaches in the namespace.
+func (c *Client) AIMTemplateCaches(namespace string) Resource[*aimv1alpha1.AIMTemplateCache, *aimv1alpha1.AIMTemplateCacheList] {
+	return newResource[aimv1alpha1.AIMTemplateCache, aimv1alpha1.AIMTemplateCacheList](c.Client, namespace)
+}
+
+// AIMArtifacts returns a client for the AIMArtifacts in the namespace.
+func (c *Client) AIMArtifacts(namespace string) Resource[*aimv1alpha1.AIMArtifact, *aimv1alpha1.AIMArtifactList] {
+	return newResource[aimv1alpha1.AIMArtifact, aimv1alpha1.AIMArtifactList](c.Client, namespace)
+}
+
+// AIMClusterModelCaches returns a client for the AIMClusterModelCaches.
+func (c *Client) AIMClusterModelCaches() Resource[*aimv1alpha1.AIMClusterModelCache, *aimv1alpha1.AIMClusterModelCacheList] {
+	return newResource[aimv1alpha1.AIMClusterModelCache, aimv1alpha1.AIMClusterModelCacheList](c.Client, "")
+}
+
+// AIMClusterModelSources returns a client for the AIMClusterModelSources.
+func (c *Client) AIMClusterModelSources() Resource[*aimv1alpha1.AIMClusterModelSource, *aimv1alpha1.AIMClusterModelSourceList] {
+	return newResource[aimv1alpha1.AIMClusterModelSource, aimv1alpha1.AIMClusterModelSourceList](c.Client, "")
+}
+
+// AIMRuntimeConfigs returns a client for the AIMRuntimeConfigs in the namespace.
+func (c *Client) AIMRuntimeConfigs(namespace string) Resource[*aimv1alpha1.AIMRuntimeConfig, *aimv1alpha1.AIMRuntimeConfigList] {
+	return newResource[aimv1alpha1.AIMRuntimeConfig, aimv1alpha1.AIMRuntimeConfigList](c.Client, namespace)
+}
+
+// AIMClusterRuntimeConfigs returns a client for the AIMClusterRuntimeConfigs.
+func (c *Client) AIMClusterRuntimeConfigs() Resource[*aimv1alpha1.AIMClusterRuntimeConfig, *aimv1alpha1.AIMClusterRuntimeConfigList] {
+	return newResource[aimv1alpha1.AIMClusterRuntimeConfig, aimv1alpha1.AIMClusterRuntimeConfigList](c.Client, "")
+}
+
+// AIMBatchJobs returns a client for the AIMBatchJobs in the namespace.
+func (c *Client) AIMBatchJobs(namespace string) Resource[*aimv1alpha1.AIMBatchJob, *aimv1alpha1.AIMBatchJobList] {
+	return newResource[aimv1alpha1.AIMBatchJob, aimv1alpha1.AIMBatchJobList](c.Client, namespace)
+}
+
+// AIMEndpoints returns a client for the AIMEndpoints in the namespace.
+func (c *Client) AIMEndpoints(namespace string) Resource[*aimv1alpha1.AIMEndpoint, *aimv1alpha1.AIMEndpointList] {
+	return newResource[aimv1alpha1.AIMEndpoint, aimv1alpha1.AIMEndpointList](c.Client, namespace)
+}
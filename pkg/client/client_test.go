@@ -0,0 +1,148 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+func newTestClient(objs ...*aimv1alpha1.AIMService) *Client {
+	builder := fake.NewClientBuilder().WithScheme(Scheme)
+	for _, obj := range objs {
+		builder = builder.WithObjects(obj)
+	}
+	return NewForClient(builder.Build())
+}
+
+func TestResource_CRUD(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient()
+	services := c.AIMServices("team-a")
+
+	service := &aimv1alpha1.AIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama"},
+		Spec:       aimv1alpha1.AIMServiceSpec{Model: aimv1alpha1.AIMServiceModel{Name: ptr.To("llama-3")}},
+	}
+	if err := services.Create(ctx, service); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if service.Namespace != "team-a" {
+		t.Errorf("expected the namespace to default to the client's, got %q", service.Namespace)
+	}
+
+	got, err := services.Get(ctx, "llama")
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if ptr.Deref(got.Spec.Model.Name, "") != "llama-3" {
+		t.Errorf("unexpected model %v", got.Spec.Model.Name)
+	}
+
+	list, err := services.List(ctx)
+	if err != nil || len(list.Items) != 1 {
+		t.Fatalf("expected one service, got %v (err %v)", list, err)
+	}
+	if other, err := c.AIMServices("team-b").List(ctx); err != nil || len(other.Items) != 0 {
+		t.Errorf("expected no services in another namespace, got %v (err %v)", other, err)
+	}
+
+	if err := services.Delete(ctx, "llama"); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := services.Get(ctx, "llama"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the service to be deleted, got %v", err)
+	}
+}
+
+func TestResource_ClusterScoped(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient()
+	model := &aimv1alpha1.AIMClusterModel{ObjectMeta: metav1.ObjectMeta{Name: "llama-3"}}
+	if err := c.AIMClusterModels().Create(ctx, model); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if _, err := c.AIMClusterModels().Get(ctx, "llama-3"); err != nil {
+		t.Errorf("get failed: %v", err)
+	}
+}
+
+func serviceWithStatus(status constants.AIMStatus, conditions ...metav1.Condition) *aimv1alpha1.AIMService {
+	return &aimv1alpha1.AIMService{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "team-a"},
+		Status:     aimv1alpha1.AIMServiceStatus{Status: status, Conditions: conditions},
+	}
+}
+
+func TestWaitForServiceRunning(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	c := newTestClient(serviceWithStatus(constants.AIMStatusRunning))
+	service, err := c.waitForServiceRunning(ctx, "team-a", "llama", time.Millisecond)
+	if err != nil || service == nil || service.Status.Status != constants.AIMStatusRunning {
+		t.Fatalf("expected the running service, got %v (err %v)", service, err)
+	}
+}
+
+func TestWaitForServiceRunning_Failed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	c := newTestClient(serviceWithStatus(constants.AIMStatusFailed, metav1.Condition{
+		Type: "ModelReady", Status: metav1.ConditionFalse, Reason: "ModelNotFound", Message: "model llama-3 not found",
+	}))
+	_, err := c.waitForServiceRunning(ctx, "team-a", "llama", time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "model llama-3 not found") {
+		t.Fatalf("expected the failing condition in the error, got %v", err)
+	}
+}
+
+func TestWaitForServiceRunning_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	c := newTestClient(serviceWithStatus(constants.AIMStatusStarting))
+	service, err := c.waitForServiceRunning(ctx, "team-a", "llama", time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), `status "Starting"`) {
+		t.Fatalf("expected a timeout with the current status, got %v", err)
+	}
+	if service == nil {
+		t.Error("expected the last observed service to be returned")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := newTestClient().waitForServiceRunning(ctx, "team-a", "llama", time.Millisecond); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the read error to be wrapped, got %v", err)
+	}
+}
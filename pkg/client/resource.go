@@ -0,0 +1,113 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package client
+
+import (
+	"context"
+
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// object constrains a pointer to an API type, so that Resource can allocate new objects.
+type object[O any] interface {
+	*O
+	ctrlclient.Object
+}
+
+// objectList constrains a pointer to an API list type.
+type objectList[L any] interface {
+	*L
+	ctrlclient.ObjectList
+}
+
+// Resource is a typed client for one kind of AIM resource in one namespace. Cluster-scoped kinds
+// have an empty namespace.
+type Resource[T ctrlclient.Object, L ctrlclient.ObjectList] struct {
+	client    ctrlclient.Client
+	namespace string
+	newObject func() T
+	newList   func() L
+}
+
+func newResource[O, LO any, T object[O], L objectList[LO]](c ctrlclient.Client, namespace string) Resource[T, L] {
+	return Resource[T, L]{
+		client:    c,
+		namespace: namespace,
+		newObject: func() T { return T(new(O)) },
+		newList:   func() L { return L(new(LO)) },
+	}
+}
+
+// Get returns the named resource.
+func (r Resource[T, L]) Get(ctx context.Context, name string) (T, error) {
+	obj := r.newObject()
+	if err := r.client.Get(ctx, ctrlclient.ObjectKey{Namespace: r.namespace, Name: name}, obj); err != nil {
+		var zero T
+		return zero, err
+	}
+	return obj, nil
+}
+
+// List returns the resources in the namespace, or all of a cluster-scoped kind.
+func (r Resource[T, L]) List(ctx context.Context, opts ...ctrlclient.ListOption) (L, error) {
+	list := r.newList()
+	if r.namespace != "" {
+		opts = append([]ctrlclient.ListOption{ctrlclient.InNamespace(r.namespace)}, opts...)
+	}
+	if err := r.client.List(ctx, list, opts...); err != nil {
+		var zero L
+		return zero, err
+	}
+	return list, nil
+}
+
+// Create creates the resource. Its namespace defaults to the namespace of the client.
+func (r Resource[T, L]) Create(ctx context.Context, obj T, opts ...ctrlclient.CreateOption) error {
+	r.defaultNamespace(obj)
+	return r.client.Create(ctx, obj, opts...)
+}
+
+// Update replaces the spec and metadata of the resource.
+func (r Resource[T, L]) Update(ctx context.Context, obj T, opts ...ctrlclient.UpdateOption) error {
+	r.defaultNamespace(obj)
+	return r.client.Update(ctx, obj, opts...)
+}
+
+// Patch patches the resource, e.g. with ctrlclient.MergeFrom(original).
+func (r Resource[T, L]) Patch(ctx context.Context, obj T, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error {
+	r.defaultNamespace(obj)
+	return r.client.Patch(ctx, obj, patch, opts...)
+}
+
+// Delete deletes the named resource.
+func (r Resource[T, L]) Delete(ctx context.Context, name string, opts ...ctrlclient.DeleteOption) error {
+	obj := r.newObject()
+	obj.SetNamespace(r.namespace)
+	obj.SetName(name)
+	return r.client.Delete(ctx, obj, opts...)
+}
+
+func (r Resource[T, L]) defaultNamespace(obj T) {
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(r.namespace)
+	}
+}
@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// DefaultPollInterval is how often WaitForServiceRunning reads the service.
+const DefaultPollInterval = 5 * time.Second
+
+// WaitForServiceRunning polls the named AIMService until it is Running and returns it. It fails
+// as soon as the service is Failed, and when ctx is done; use context.WithTimeout to bound the
+// wait. Read errors are retried until ctx is done.
+func (c *Client) WaitForServiceRunning(ctx context.Context, namespace, name string) (*aimv1alpha1.AIMService, error) {
+	return c.waitForServiceRunning(ctx, namespace, name, DefaultPollInterval)
+}
+
+func (c *Client) waitForServiceRunning(
+	ctx context.Context,
+	namespace, name string,
+	interval time.Duration,
+) (*aimv1alpha1.AIMService, error) {
+	services := c.AIMServices(namespace)
+	var service *aimv1alpha1.AIMService
+	var lastErr error
+	err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+		current, err := services.Get(ctx, name)
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		service, lastErr = current, nil
+		switch service.Status.Status {
+		case constants.AIMStatusRunning:
+			return true, nil
+		case constants.AIMStatusFailed:
+			return false, fmt.Errorf("service %s/%s failed: %s", namespace, name, failingConditions(service.Status.Conditions))
+		}
+		return false, nil
+	})
+	if err != nil && wait.Interrupted(err) {
+		if lastErr != nil {
+			return service, fmt.Errorf("waiting for service %s/%s: %w", namespace, name, lastErr)
+		}
+		if service != nil {
+			return service, fmt.Errorf("service %s/%s is not running, status %q: %s",
+				namespace, name, service.Status.Status, failingConditions(service.Status.Conditions))
+		}
+	}
+	return service, err
+}
+
+// failingConditions summarizes the conditions that are not True.
+func failingConditions(conditions []metav1.Condition) string {
+	var failing []string
+	for _, c := range conditions {
+		if c.Status != metav1.ConditionTrue {
+			failing = append(failing, fmt.Sprintf("%s=%s (%s): %s", c.Type, c.Status, c.Reason, c.Message))
+		}
+	}
+	if len(failing) == 0 {
+		return "no failing conditions"
+	}
+	return strings.Join(failing, "; ")
+}
@@ -0,0 +1,331 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package selection
+
+import (
+	"fmt"
+	"strings"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// Stage identifies the filter stage that rejected a candidate.
+type Stage string
+
+// Filter stage identifiers for tracking rejections
+const (
+	StageNamespace    Stage = "namespace"
+	StageAvailability Stage = "availability"
+	StageCapacity     Stage = "capacity"
+	StageUnoptimized  Stage = "unoptimized"
+	StageOverrides    Stage = "overrides"
+	StageCompute      Stage = "compute"
+	StageGPU          Stage = "gpu"
+	StageHeadroom     Stage = "headroom"
+	StagePreference   Stage = "preference"
+	StageGPURank      Stage = "gpuRank"
+	StageGoal         Stage = "goal"
+)
+
+// Rejections collects the candidates rejected by each filter stage.
+type Rejections map[Stage][]Candidate
+
+// FilterByNamespace removes cluster templates that are not available in the service namespace.
+func FilterByNamespace(candidates []Candidate, rejected Rejections) []Candidate {
+	var result []Candidate
+	for _, c := range candidates {
+		if c.NamespaceDenied {
+			rejected[StageNamespace] = append(rejected[StageNamespace], c)
+		} else {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// FilterByAvailability removes candidates that are not Ready.
+func FilterByAvailability(candidates []Candidate, rejected Rejections) []Candidate {
+	var result []Candidate
+	for _, c := range candidates {
+		if c.Status.Status == constants.AIMStatusReady {
+			result = append(result, c)
+		} else {
+			rejected[StageAvailability] = append(rejected[StageAvailability], c)
+		}
+	}
+	return result
+}
+
+// FilterByCapacity removes candidates that have reached their maxConcurrentServices.
+func FilterByCapacity(candidates []Candidate, rejected Rejections) []Candidate {
+	var result []Candidate
+	for _, c := range candidates {
+		if c.AtCapacity {
+			rejected[StageCapacity] = append(rejected[StageCapacity], c)
+		} else {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// FilterByOptimizationStatus removes unoptimized templates if not allowed.
+func FilterByOptimizationStatus(candidates []Candidate, allowUnoptimized bool, rejected Rejections) []Candidate {
+	var result []Candidate
+	for _, c := range candidates {
+		// If profile is nil, treat as unoptimized
+		profileType := aimv1alpha1.AIMProfileTypeUnoptimized
+		if c.Status.Profile != nil {
+			profileType = c.Status.Profile.Metadata.Type
+		}
+		if profileType == aimv1alpha1.AIMProfileTypeOptimized || allowUnoptimized {
+			result = append(result, c)
+		} else {
+			rejected[StageUnoptimized] = append(rejected[StageUnoptimized], c)
+		}
+	}
+	return result
+}
+
+// FilterByComputeMode removes candidates whose compute mode differs from the requested one.
+func FilterByComputeMode(candidates []Candidate, compute aimv1alpha1.AIMComputeMode, rejected Rejections) []Candidate {
+	var result []Candidate
+	for _, c := range candidates {
+		if c.Spec.GetCompute() == compute {
+			result = append(result, c)
+		} else {
+			rejected[StageCompute] = append(rejected[StageCompute], c)
+		}
+	}
+	return result
+}
+
+// FilterByGPUAvailability keeps the candidates that require any of the available GPU models.
+// Candidates without a GPU model are kept.
+func FilterByGPUAvailability(candidates []Candidate, availableGPUs []string) []Candidate {
+	gpuMap := make(map[string]struct{}, len(availableGPUs))
+	for _, gpu := range availableGPUs {
+		normalized := utils.NormalizeGPUModel(strings.TrimSpace(gpu))
+		if normalized != "" {
+			gpuMap[normalized] = struct{}{}
+		}
+	}
+
+	result := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		models := c.GPUModels()
+		// If no GPU models specified, include the candidate (CPU-only)
+		if len(models) == 0 {
+			result = append(result, c)
+			continue
+		}
+		// Check if ANY of the template's required GPU models are available
+		for _, model := range models {
+			normalized := utils.NormalizeGPUModel(strings.TrimSpace(model))
+			if _, ok := gpuMap[normalized]; ok {
+				result = append(result, c)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// FilterByGPUHeadroom removes candidates that need more GPUs than the headroom allows,
+// recording the computation on each evaluated candidate.
+func FilterByGPUHeadroom(candidates []Candidate, headroom *GPUHeadroom, rejected Rejections) []Candidate {
+	var result []Candidate
+	for _, c := range candidates {
+		fits, explanation := headroom.Evaluate(c)
+		c.Explain(explanation)
+		if fits {
+			result = append(result, c)
+		} else {
+			rejected[StageHeadroom] = append(rejected[StageHeadroom], c)
+		}
+	}
+	return result
+}
+
+// FilterByGPUPreference keeps the candidates for the most preferred GPU model that has enough
+// free GPUs. If no preferred model has capacity, the most preferred model with a candidate is kept,
+// so the service still deploys and waits for capacity. freeGPUs may be nil, which skips the
+// capacity check.
+func FilterByGPUPreference(
+	candidates []Candidate,
+	preference []string,
+	freeGPUs *GPUHeadroom,
+	rejected Rejections,
+) []Candidate {
+	bestRank, bestFitRank := -1, -1
+	ranked := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		rank := GPUPreferenceRank(c, preference, nil)
+		if rank < 0 {
+			rejected[StagePreference] = append(rejected[StagePreference], c)
+			continue
+		}
+		ranked = append(ranked, c)
+		if bestRank < 0 || rank < bestRank {
+			bestRank = rank
+		}
+		if fitRank := GPUPreferenceRank(c, preference, freeGPUs); fitRank >= 0 && (bestFitRank < 0 || fitRank < bestFitRank) {
+			bestFitRank = fitRank
+		}
+	}
+	if len(ranked) == 0 {
+		return nil
+	}
+
+	target := bestRank
+	if bestFitRank >= 0 {
+		target = bestFitRank
+	}
+	targetModel := preference[target]
+
+	var result []Candidate
+	for _, c := range ranked {
+		if gpuModelsOverlap([]string{targetModel}, c.GPUModels()) {
+			c.Explain(fmt.Sprintf("%s is GPU preference %d of %d", targetModel, target+1, len(preference)))
+			result = append(result, c)
+		} else {
+			c.Explain(fmt.Sprintf("%s (GPU preference %d) is preferred", targetModel, target+1))
+			rejected[StageGPURank] = append(rejected[StageGPURank], c)
+		}
+	}
+	return result
+}
+
+// GPUPreferenceRank returns the position in the preference of the most preferred GPU model of
+// the candidate, or -1 if none of its models are preferred. With freeGPUs set, only models with
+// enough free GPUs for the candidate are considered.
+func GPUPreferenceRank(c Candidate, preference []string, freeGPUs *GPUHeadroom) int {
+	models := c.GPUModels()
+	count := int64(c.GPUCount())
+	for rank, preferred := range preference {
+		if !gpuModelsOverlap([]string{preferred}, models) {
+			continue
+		}
+		if freeGPUs != nil {
+			if allowed, _ := freeGPUs.Allowed(preferred); allowed < count {
+				continue
+			}
+		}
+		return rank
+	}
+	return -1
+}
+
+// PreferNamespaceTemplates keeps only the namespace-scoped candidates if there are any.
+func PreferNamespaceTemplates(candidates []Candidate) []Candidate {
+	hasNamespace := false
+	for _, c := range candidates {
+		if c.Scope == aimv1alpha1.AIMResolutionScopeNamespace {
+			hasNamespace = true
+			break
+		}
+	}
+	if !hasNamespace {
+		return candidates
+	}
+
+	result := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Scope == aimv1alpha1.AIMResolutionScopeNamespace {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// FilterBySelectionGoal keeps the candidates with the best measured score for the goal.
+// Candidates without a measurement for the goal are rejected when any candidate has one;
+// if none has, all candidates are kept and the goal has no effect.
+func FilterBySelectionGoal(candidates []Candidate, goal aimv1alpha1.AIMSelectionGoal, rejected Rejections) []Candidate {
+	var best int64
+	measured := false
+	for _, c := range candidates {
+		if score, ok := PerformanceScore(c.Status.Performance, goal); ok && (!measured || score < best) {
+			best, measured = score, true
+		}
+	}
+	if !measured {
+		return candidates
+	}
+
+	var result []Candidate
+	for _, c := range candidates {
+		score, ok := PerformanceScore(c.Status.Performance, goal)
+		switch {
+		case !ok:
+			c.Explain(fmt.Sprintf("no %s measurement", goal))
+			rejected[StageGoal] = append(rejected[StageGoal], c)
+		case score > best:
+			c.Explain(fmt.Sprintf("another template measures better for %s", goal))
+			rejected[StageGoal] = append(rejected[StageGoal], c)
+		default:
+			c.Explain(fmt.Sprintf("best measured template for %s", goal))
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// PerformanceScore returns the score of a measurement for a selection goal. Lower is better.
+// Returns false if the measurement does not cover the goal.
+func PerformanceScore(perf *aimv1alpha1.AIMProfilePerformance, goal aimv1alpha1.AIMSelectionGoal) (int64, bool) {
+	if perf == nil {
+		return 0, false
+	}
+	switch goal {
+	case aimv1alpha1.AIMSelectionGoalLatency:
+		if perf.Latency != nil {
+			return int64(perf.Latency.Duration), true
+		}
+	case aimv1alpha1.AIMSelectionGoalThroughputPerGPU:
+		if perf.TokensPerSecondPerGPU != nil {
+			return -*perf.TokensPerSecondPerGPU, true
+		}
+	}
+	return 0, false
+}
+
+// RankByNames orders the candidates by the names a selection policy ranked. Unknown and
+// repeated names are ignored, and candidates that were not ranked are dropped.
+// Returns nil if no candidate was ranked.
+func RankByNames(candidates []Candidate, names []string) []Candidate {
+	var ranked []Candidate
+	used := make(map[int]bool, len(candidates))
+	for _, name := range names {
+		for i, c := range candidates {
+			if c.Name == name && !used[i] {
+				used[i] = true
+				ranked = append(ranked, c)
+				break
+			}
+		}
+	}
+	return ranked
+}
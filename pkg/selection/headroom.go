@@ -0,0 +1,89 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package selection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// reasonGPUHeadroomExceeded is the evaluation reason of candidates rejected by the headroom.
+const reasonGPUHeadroomExceeded = "GPUHeadroomExceeded"
+
+// GPUHeadroom limits templates to a percentage of the currently free GPUs.
+type GPUHeadroom struct {
+	Percent int32
+	// Free is the number of free GPUs per normalized GPU model.
+	Free map[string]int64
+	// QuotaRemaining is the remaining GPU quota of the namespace, nil without a GPU quota.
+	QuotaRemaining *int64
+}
+
+// Allowed returns how many GPUs of the model a template may use, and the free GPUs it was computed from.
+func (h *GPUHeadroom) Allowed(model string) (allowed int64, free int64) {
+	free = h.Free[utils.NormalizeGPUModel(strings.TrimSpace(model))]
+	if h.QuotaRemaining != nil && *h.QuotaRemaining < free {
+		free = *h.QuotaRemaining
+	}
+	return free * int64(h.Percent) / 100, free
+}
+
+// Evaluate checks the candidate against the headroom and returns whether it fits
+// along with an explanation of the computation. When the template supports several
+// GPU models, the model with the most free GPUs is used.
+func (h *GPUHeadroom) Evaluate(c Candidate) (bool, string) {
+	count := int64(c.GPUCount())
+	models := c.GPUModels()
+	if count == 0 || len(models) == 0 {
+		return true, ""
+	}
+
+	bestModel := ""
+	var bestAllowed, bestFree int64 = -1, 0
+	for _, model := range models {
+		allowed, free := h.Allowed(model)
+		if allowed > bestAllowed {
+			bestModel, bestAllowed, bestFree = model, allowed, free
+		}
+	}
+
+	message := fmt.Sprintf("requires %d %s GPU(s); %d%% of %d free allows %d",
+		count, bestModel, h.Percent, bestFree, bestAllowed)
+	if h.QuotaRemaining != nil && *h.QuotaRemaining == bestFree {
+		message += " (limited by namespace quota)"
+	}
+	return count <= bestAllowed, message
+}
+
+// headroomExplanations joins the headroom computations of the rejected candidates.
+func headroomExplanations(evaluations []Evaluation) string {
+	var parts []string
+	for _, eval := range evaluations {
+		if eval.Reason == reasonGPUHeadroomExceeded {
+			parts = append(parts, fmt.Sprintf("%s: %s", eval.Candidate.Name, eval.Message))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,102 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package selection
+
+import (
+	"errors"
+	"strings"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/utils"
+)
+
+// ErrOverridesRejected is returned by ValidateOverrides for services that combine overrides
+// with an explicit template under the Reject overrides behavior.
+var ErrOverridesRejected = errors.New("overrides cannot be combined with template.name when template.overridesBehavior is Reject")
+
+// ValidateOverrides checks that the overrides of the service spec may be combined with its
+// template. Admission enforces the same rule.
+func ValidateOverrides(spec *aimv1alpha1.AIMServiceSpec) error {
+	if spec.Overrides != nil && spec.Template.Name != "" && spec.GetOverridesBehavior() == aimv1alpha1.OverridesBehaviorReject {
+		return ErrOverridesRejected
+	}
+	return nil
+}
+
+// FilterByOverrides keeps the candidates that match the metric, precision and GPU of the
+// service overrides. Constraints a candidate does not specify are not checked.
+func FilterByOverrides(candidates []Candidate, overrides *aimv1alpha1.AIMServiceOverrides) []Candidate {
+	if overrides == nil {
+		return candidates
+	}
+
+	result := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if MatchesOverrides(c, overrides) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// MatchesOverrides reports whether the candidate matches the service overrides.
+func MatchesOverrides(c Candidate, overrides *aimv1alpha1.AIMServiceOverrides) bool {
+	if overrides == nil {
+		return true
+	}
+	if overrides.Metric != nil && !strings.EqualFold(c.Metric(), string(*overrides.Metric)) {
+		return false
+	}
+	if overrides.Precision != nil && !strings.EqualFold(c.Precision(), string(*overrides.Precision)) {
+		return false
+	}
+	if overrides.Hardware != nil && overrides.Hardware.GPU != nil {
+		// Check if the override GPU model matches any template GPU model
+		models := c.GPUModels()
+		if overrides.Hardware.GPU.Model != "" && len(models) > 0 {
+			if !gpuModelsOverlap([]string{overrides.Hardware.GPU.Model}, models) {
+				return false
+			}
+		}
+		// Filter by GPU count if specified
+		count := c.GPUCount()
+		if overrides.Hardware.GPU.Requests > 0 && count > 0 && count != overrides.Hardware.GPU.Requests {
+			return false
+		}
+	}
+	return true
+}
+
+// gpuModelsOverlap returns true if any GPU model from a matches any from b.
+func gpuModelsOverlap(a, b []string) bool {
+	for _, modelA := range a {
+		normalizedA := utils.NormalizeGPUModel(strings.TrimSpace(modelA))
+		for _, modelB := range b {
+			normalizedB := utils.NormalizeGPUModel(strings.TrimSpace(modelB))
+			if strings.EqualFold(normalizedA, normalizedB) {
+				return true
+			}
+		}
+	}
+	return false
+}
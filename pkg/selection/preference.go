@@ -0,0 +1,203 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package selection
+
+import (
+	"strings"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+)
+
+// Preference orders for template selection
+var (
+	gpuPreferenceOrder = []string{
+		"MI325X", "MI300X", "MI250X", "MI210",
+	}
+	metricPreferenceOrder = []string{
+		"latency", "throughput",
+	}
+	// Precision preference: primary ordering by bit-width (smaller preferred for performance).
+	// Secondary ordering by type: fp > bf > int (floating point preferred for accuracy).
+	precisionPreferenceOrder = []string{
+		"fp4", "int4", "fp8", "int8", "fp16", "bf16", "fp32",
+	}
+	profileTypePreferenceOrder = []string{
+		string(aimv1alpha1.AIMProfileTypeOptimized),
+		string(aimv1alpha1.AIMProfileTypePreview),
+		string(aimv1alpha1.AIMProfileTypeUnoptimized),
+	}
+)
+
+// ChoosePreferred selects the best template from candidates using a preference hierarchy.
+//
+// Preference hierarchy (highest to lowest priority):
+// 1. Profile Type: optimized > preview > unoptimized
+// 2. GPU Tier: MI325X > MI300X > MI250X > MI210
+// 3. Metric: latency > throughput
+// 4. Precision: smaller bit-width preferred (fp4 > int4 > fp8 > int8 > fp16 > bf16 > fp32)
+//
+// Lower scores indicate higher preference. Unknown values get a high score (len+1000).
+// Returns the best candidate and count of candidates with identical best scores.
+func ChoosePreferred(candidates []Candidate) (*Candidate, int) {
+	if len(candidates) == 0 {
+		return nil, 0
+	}
+	if len(candidates) == 1 {
+		return &candidates[0], 1
+	}
+
+	// Build preference maps: lower index = higher preference
+	gpuPref := makePreferenceMap(gpuPreferenceOrder)
+	metricPref := makePreferenceMap(metricPreferenceOrder)
+	precisionPref := makePreferenceMap(precisionPreferenceOrder)
+	profileTypePref := makePreferenceMap(profileTypePreferenceOrder)
+
+	bestIdx := 0
+	bestGPU := getPreferenceScore(candidates[0].GPUModel(), gpuPref)
+	bestMetric := getPreferenceScore(candidates[0].Metric(), metricPref)
+	bestPrecision := getPreferenceScore(candidates[0].Precision(), precisionPref)
+	bestProfileType := getPreferenceScore(candidates[0].ProfileType(), profileTypePref)
+
+	for i := 1; i < len(candidates); i++ {
+		gpu := getPreferenceScore(candidates[i].GPUModel(), gpuPref)
+		metric := getPreferenceScore(candidates[i].Metric(), metricPref)
+		precision := getPreferenceScore(candidates[i].Precision(), precisionPref)
+		profileType := getPreferenceScore(candidates[i].ProfileType(), profileTypePref)
+
+		// Compare using lexicographic ordering: profile type > GPU > metric > precision
+		// A candidate is better if it has a lower score at the highest-priority dimension
+		// where the candidates differ
+		if profileType < bestProfileType ||
+			(profileType == bestProfileType && gpu < bestGPU) ||
+			(profileType == bestProfileType && gpu == bestGPU && metric < bestMetric) ||
+			(profileType == bestProfileType && gpu == bestGPU && metric == bestMetric && precision < bestPrecision) {
+			bestIdx = i
+			bestGPU = gpu
+			bestMetric = metric
+			bestPrecision = precision
+			bestProfileType = profileType
+		}
+	}
+
+	// Count identical scores (must include all scoring dimensions)
+	identicalCount := 0
+	for i := range candidates {
+		profileType := getPreferenceScore(candidates[i].ProfileType(), profileTypePref)
+		gpu := getPreferenceScore(candidates[i].GPUModel(), gpuPref)
+		metric := getPreferenceScore(candidates[i].Metric(), metricPref)
+		precision := getPreferenceScore(candidates[i].Precision(), precisionPref)
+		if profileType == bestProfileType && gpu == bestGPU && metric == bestMetric && precision == bestPrecision {
+			identicalCount++
+		}
+	}
+
+	return &candidates[bestIdx], identicalCount
+}
+
+// Metric returns the metric of the candidate's profile, falling back to the spec.
+func (c *Candidate) Metric() string {
+	if c.Status.Profile != nil {
+		if m := c.Status.Profile.Metadata.Metric; m != "" {
+			return string(m)
+		}
+	}
+	if c.Spec.Metric != nil {
+		return string(*c.Spec.Metric)
+	}
+	return ""
+}
+
+// Precision returns the precision of the candidate's profile, falling back to the spec.
+func (c *Candidate) Precision() string {
+	if c.Status.Profile != nil {
+		if p := c.Status.Profile.Metadata.Precision; p != "" {
+			return string(p)
+		}
+	}
+	if c.Spec.Precision != nil {
+		return string(*c.Spec.Precision)
+	}
+	return ""
+}
+
+// GPUModel returns the GPU model of the candidate's spec, falling back to its profile.
+func (c *Candidate) GPUModel() string {
+	if c.Spec.Hardware != nil && c.Spec.Hardware.GPU != nil && c.Spec.Hardware.GPU.Model != "" {
+		return strings.TrimSpace(c.Spec.Hardware.GPU.Model)
+	}
+	if c.Status.Profile != nil {
+		if gpu := strings.TrimSpace(c.Status.Profile.Metadata.GPU); gpu != "" {
+			return gpu
+		}
+	}
+	return ""
+}
+
+// GPUModels returns GPU model from the candidate spec as a slice.
+// Used for GPU availability filtering where any model match is acceptable.
+func (c *Candidate) GPUModels() []string {
+	if c.Spec.Hardware != nil && c.Spec.Hardware.GPU != nil && c.Spec.Hardware.GPU.Model != "" {
+		return []string{c.Spec.Hardware.GPU.Model}
+	}
+	// Fallback to status profile
+	if c.Status.Profile != nil {
+		if gpu := strings.TrimSpace(c.Status.Profile.Metadata.GPU); gpu != "" {
+			return []string{gpu}
+		}
+	}
+	return nil
+}
+
+// GPUCount returns the GPUs the candidate requests, 0 if unknown.
+func (c *Candidate) GPUCount() int32 {
+	if c.Spec.Hardware != nil && c.Spec.Hardware.GPU != nil && c.Spec.Hardware.GPU.Requests > 0 {
+		return c.Spec.Hardware.GPU.Requests
+	}
+	if c.Status.Profile != nil && c.Status.Profile.Metadata.GPUCount > 0 {
+		return c.Status.Profile.Metadata.GPUCount
+	}
+	return 0
+}
+
+// ProfileType returns the type of the candidate's profile, empty without a profile.
+func (c *Candidate) ProfileType() string {
+	if c.Status.Profile != nil {
+		return string(c.Status.Profile.Metadata.Type)
+	}
+	return ""
+}
+
+func makePreferenceMap(prefs []string) map[string]int {
+	m := make(map[string]int)
+	for i, p := range prefs {
+		m[strings.ToUpper(p)] = i
+	}
+	return m
+}
+
+func getPreferenceScore(value string, prefMap map[string]int) int {
+	if score, ok := prefMap[strings.ToUpper(value)]; ok {
+		return score
+	}
+	return len(prefMap) + 1000
+}
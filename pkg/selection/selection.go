@@ -0,0 +1,500 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package selection implements the template selection of AIMServices: the filter stages that
+// narrow the templates of a model down to the ones a service may use, and the ranking that
+// picks one of them. It works on plain candidate slices and performs no API calls, so that a
+// UI or backend can show the same results as the controller:
+//
+//	candidates := []selection.Candidate{selection.NamespaceCandidate(&template)}
+//	result := selection.Select(candidates, selection.Options{AvailableGPUs: []string{"MI300X"}})
+//	reason, message := result.Reason("llama-3-8b", "team-a")
+//
+// Cluster state the controller reads before selecting, such as the GPU models in the cluster,
+// the free GPUs and the services already using a template, is passed in through Candidate and
+// Options.
+package selection
+
+import (
+	"fmt"
+	"strings"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+// Evaluation statuses of a candidate.
+const (
+	StatusChosen   = "chosen"
+	StatusRejected = "rejected"
+)
+
+// Candidate captures the information needed to evaluate a template during selection.
+type Candidate struct {
+	Name      string
+	Namespace string
+	Scope     aimv1alpha1.AIMResolutionScope
+	Spec      aimv1alpha1.AIMServiceTemplateSpecCommon
+	Status    aimv1alpha1.AIMServiceTemplateStatus
+
+	// NamespaceDenied is set for cluster templates whose namespaceSelector excludes the service namespace,
+	// or that the namespace's tenancy policy does not allow.
+	NamespaceDenied bool
+
+	// AtCapacity is set for templates whose maxConcurrentServices is reached by other services.
+	AtCapacity bool
+
+	// Explanation details how a filter evaluated the candidate, such as the GPU headroom computation.
+	Explanation string
+}
+
+// NamespaceCandidate returns the candidate for a namespace-scoped template.
+func NamespaceCandidate(template *aimv1alpha1.AIMServiceTemplate) Candidate {
+	return Candidate{
+		Name:      template.Name,
+		Namespace: template.Namespace,
+		Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
+		Spec:      template.Spec.AIMServiceTemplateSpecCommon,
+		Status:    template.Status,
+	}
+}
+
+// ClusterCandidate returns the candidate for a cluster-scoped template. namespaceLabels are the
+// labels of the service namespace, checked against the template's namespaceSelector.
+func ClusterCandidate(template *aimv1alpha1.AIMClusterServiceTemplate, namespaceLabels map[string]string) Candidate {
+	return Candidate{
+		Name:            template.Name,
+		Scope:           aimv1alpha1.AIMResolutionScopeCluster,
+		Spec:            template.Spec.AIMServiceTemplateSpecCommon,
+		Status:          template.Status,
+		NamespaceDenied: !template.AllowsNamespace(namespaceLabels),
+	}
+}
+
+// Explain appends an explanation of a filter outcome to the candidate.
+func (c *Candidate) Explain(explanation string) {
+	switch {
+	case explanation == "":
+	case c.Explanation == "":
+		c.Explanation = explanation
+	default:
+		c.Explanation += "; " + explanation
+	}
+}
+
+// Evaluation captures why a specific candidate was chosen or rejected.
+type Evaluation struct {
+	Candidate Candidate
+	Status    string // StatusChosen or StatusRejected
+	Reason    string // CamelCase reason
+	Rank      int    // For candidates that passed all filters
+	Message   string // Optional explanation of the evaluation
+}
+
+// Diagnostics counts the candidates left after each filter stage.
+type Diagnostics struct {
+	TotalCandidates                  int
+	AfterNamespaceFilter             int
+	AfterAvailabilityFilter          int
+	AfterCapacityFilter              int
+	AfterUnoptimizedFilter           int
+	AfterOverridesFilter             int
+	AfterComputeFilter               int
+	AfterGPUAvailabilityFilter       int
+	AfterGPUHeadroomFilter           int
+	AfterGPUPreferenceFilter         int
+	UnoptimizedTemplatesWereFiltered bool
+}
+
+// Policy ranks the candidates that passed all filters, most preferred first.
+// It returns nil to fall back to the built-in scoring.
+type Policy func(candidates []Candidate) []Candidate
+
+// Options are the service settings and cluster state a selection is made for.
+type Options struct {
+	// Overrides are the service overrides candidates must match.
+	Overrides *aimv1alpha1.AIMServiceOverrides
+	// Compute is the compute mode requested by the service, empty for any.
+	Compute aimv1alpha1.AIMComputeMode
+	// AvailableGPUs are the GPU models present in the cluster. Ignored in CPU mode.
+	AvailableGPUs []string
+	// AllowUnoptimized admits unoptimized and preview templates.
+	AllowUnoptimized bool
+	// AllowUnoptimizedFallback repeats the selection with unoptimized templates allowed
+	// when no optimized template fits.
+	AllowUnoptimizedFallback bool
+	// Headroom limits templates to a share of the free GPUs, nil to disable the limit.
+	Headroom *GPUHeadroom
+	// GPUPreference lists GPU models, most preferred first.
+	GPUPreference []string
+	// FreeGPUs places the GPU preference on models with free capacity, nil to ignore capacity.
+	FreeGPUs *GPUHeadroom
+	// Goal keeps the best measured templates for the selection goal.
+	Goal aimv1alpha1.AIMSelectionGoal
+	// Policy ranks the remaining candidates before the built-in scoring, if set.
+	Policy Policy
+}
+
+// Result is the outcome of a template selection.
+type Result struct {
+	// Selected is the chosen candidate, nil if no candidate passed the filters.
+	Selected *Candidate
+	// Count is the number of candidates that rank equally best. More than one is ambiguous.
+	Count       int
+	Diagnostics Diagnostics
+	Evaluations []Evaluation
+	// UnoptimizedFallback is true when no optimized template could be selected and the
+	// selection was repeated with unoptimized templates allowed.
+	UnoptimizedFallback bool
+
+	opts Options
+}
+
+// Select selects the best template from candidates. Selection criteria (in order of priority):
+// 0. Only templates available in the service namespace
+// 1. Only Available templates (status == Ready)
+// 1b. Only templates below their maxConcurrentServices
+// 2. Filter unoptimized if not allowed
+// 3. Filter by service overrides (metric, precision, GPU)
+// 4. Filter by compute mode, if the service requests one
+// 5. Filter by GPU availability in cluster (skipped in CPU mode)
+// 6. Filter by GPU headroom, if configured
+// 7. Keep the most preferred GPU model with capacity, if the service has a GPU preference
+// 8. Prefer namespace-scoped over cluster-scoped
+// 8b. Keep the best measured templates for the selection goal, if the service sets one
+// 9. Rank by the selection policy, if configured and successful
+// 10. Prefer by profile type > GPU tier > metric > precision
+//
+// With AllowUnoptimizedFallback, the selection is repeated with unoptimized templates allowed
+// when ready templates exist but none is selected.
+func Select(candidates []Candidate, opts Options) Result {
+	result := selectBest(candidates, opts)
+	if result.Selected == nil && !opts.AllowUnoptimized && opts.AllowUnoptimizedFallback &&
+		result.Diagnostics.AfterAvailabilityFilter > 0 {
+		fallbackOpts := opts
+		fallbackOpts.AllowUnoptimized = true
+		if fallback := selectBest(candidates, fallbackOpts); fallback.Selected != nil {
+			fallback.UnoptimizedFallback = true
+			fallback.opts = opts
+			return fallback
+		}
+	}
+	return result
+}
+
+// selectBest runs the filter stages and ranking once.
+func selectBest(candidates []Candidate, opts Options) Result {
+	result := Result{Diagnostics: Diagnostics{TotalCandidates: len(candidates)}, opts: opts}
+	diag := &result.Diagnostics
+	rejected := make(Rejections)
+	reject := func() Result {
+		result.Evaluations = make([]Evaluation, 0)
+		appendRejections(&result.Evaluations, rejected)
+		return result
+	}
+
+	// Stage 0: Namespace filter - cluster templates restricted to other namespaces
+	filtered := FilterByNamespace(candidates, rejected)
+	diag.AfterNamespaceFilter = len(filtered)
+	if len(filtered) == 0 {
+		return reject()
+	}
+
+	// Stage 1: Availability filter - only Ready templates can be selected
+	filtered = FilterByAvailability(filtered, rejected)
+	diag.AfterAvailabilityFilter = len(filtered)
+	if len(filtered) == 0 {
+		return reject()
+	}
+
+	// Stage 1b: Capacity filter - templates at maxConcurrentServices take no more services
+	filtered = FilterByCapacity(filtered, rejected)
+	diag.AfterCapacityFilter = len(filtered)
+	if len(filtered) == 0 {
+		return reject()
+	}
+
+	// Stage 2: Unoptimized filter - exclude unoptimized unless explicitly allowed
+	filtered = FilterByOptimizationStatus(filtered, opts.AllowUnoptimized, rejected)
+	diag.AfterUnoptimizedFilter = len(filtered)
+	diag.UnoptimizedTemplatesWereFiltered = len(rejected[StageUnoptimized]) > 0
+	if len(filtered) == 0 {
+		return reject()
+	}
+
+	// Stage 3: Overrides filter - match service-specified constraints
+	if opts.Overrides != nil {
+		beforeOverrides := filtered
+		filtered = FilterByOverrides(filtered, opts.Overrides)
+		diag.AfterOverridesFilter = len(filtered)
+		if len(filtered) == 0 {
+			rejected[StageOverrides] = beforeOverrides
+			return reject()
+		}
+	} else {
+		diag.AfterOverridesFilter = len(filtered)
+	}
+
+	// Stage 4: Compute mode filter - match the service's requested compute mode
+	if opts.Compute != "" {
+		filtered = FilterByComputeMode(filtered, opts.Compute, rejected)
+	}
+	diag.AfterComputeFilter = len(filtered)
+	if len(filtered) == 0 {
+		return reject()
+	}
+
+	// Stage 5: GPU availability filter - only templates for GPUs present in cluster
+	if opts.Compute != aimv1alpha1.AIMComputeModeCPU {
+		beforeGPU := filtered
+		filtered = FilterByGPUAvailability(filtered, opts.AvailableGPUs)
+		if len(filtered) == 0 {
+			rejected[StageGPU] = beforeGPU
+			diag.AfterGPUAvailabilityFilter = 0
+			return reject()
+		}
+	}
+	diag.AfterGPUAvailabilityFilter = len(filtered)
+
+	// Stage 6: GPU headroom filter - templates may only use a share of the free GPUs
+	if opts.Headroom != nil {
+		filtered = FilterByGPUHeadroom(filtered, opts.Headroom, rejected)
+	}
+	diag.AfterGPUHeadroomFilter = len(filtered)
+	if len(filtered) == 0 {
+		return reject()
+	}
+
+	// Stage 7: GPU preference - the most preferred GPU model with free capacity
+	if len(opts.GPUPreference) > 0 && opts.Compute != aimv1alpha1.AIMComputeModeCPU {
+		filtered = FilterByGPUPreference(filtered, opts.GPUPreference, opts.FreeGPUs, rejected)
+	}
+	diag.AfterGPUPreferenceFilter = len(filtered)
+	if len(filtered) == 0 {
+		return reject()
+	}
+
+	// Stage 8: Scope preference - namespace templates over cluster templates
+	filtered = PreferNamespaceTemplates(filtered)
+
+	// Stage 8b: Selection goal - the best measured templates for the goal
+	if opts.Goal != "" {
+		filtered = FilterBySelectionGoal(filtered, opts.Goal, rejected)
+	}
+
+	// Single candidate remaining - select it
+	if len(filtered) == 1 {
+		result.Selected, result.Count = &filtered[0], 1
+		result.Evaluations = buildFinalEvaluations(filtered, result.Selected, rejected)
+		return result
+	}
+
+	// Stage 9: Selection policy - an external plugin ranks the remaining candidates
+	if opts.Policy != nil {
+		if ranked := opts.Policy(filtered); len(ranked) > 0 {
+			result.Selected, result.Count = &ranked[0], 1
+			result.Evaluations = buildPolicyEvaluations(filtered, ranked, rejected)
+			return result
+		}
+	}
+
+	// Stage 10: Preference scoring - rank by profile type, GPU, metric, precision
+	result.Selected, result.Count = ChoosePreferred(filtered)
+	result.Evaluations = buildFinalEvaluations(filtered, result.Selected, rejected)
+	return result
+}
+
+// Reason returns the reason and message that explain the result for a model in the service
+// namespace, as reported on the AIMService. Both are empty when a single template was selected,
+// and when templates exist but none is Ready yet (see NotReady).
+func (r Result) Reason(modelName, namespace string) (reason, message string) {
+	diag := r.Diagnostics
+	if r.Selected != nil {
+		if r.Count > 1 {
+			return aimv1alpha1.AIMServiceReasonTemplateSelectionAmbiguous,
+				fmt.Sprintf("Multiple templates (%d) satisfy model %q", r.Count, modelName)
+		}
+		return "", ""
+	}
+
+	switch {
+	case diag.TotalCandidates == 0:
+		return aimv1alpha1.AIMServiceReasonTemplateNotFound,
+			fmt.Sprintf("No templates found for model %q", modelName)
+	case diag.AfterNamespaceFilter == 0:
+		return aimv1alpha1.AIMServiceReasonTemplateNamespaceDenied, fmt.Sprintf(
+			"No templates for model %q are available in namespace %q: "+
+				"%d cluster template(s) restricted by namespaceSelector or tenancy policy",
+			modelName, namespace, diag.TotalCandidates)
+	case diag.AfterAvailabilityFilter == 0:
+		return "", ""
+	case diag.AfterCapacityFilter == 0:
+		return aimv1alpha1.AIMServiceReasonTemplateAtCapacity, fmt.Sprintf(
+			"All %d available template(s) for model %q are at their maxConcurrentServices limit",
+			diag.AfterAvailabilityFilter, modelName)
+	case diag.AfterUnoptimizedFilter == 0 && diag.UnoptimizedTemplatesWereFiltered:
+		return aimv1alpha1.AIMServiceReasonTemplateNotFound, fmt.Sprintf(
+			"No available templates match requirements for model %q: "+
+				"%d unoptimized template(s) filtered out. Set allowUnoptimized or allowUnoptimizedFallback to use them.",
+			modelName, diag.AfterAvailabilityFilter)
+	case diag.AfterGPUAvailabilityFilter > 0 && diag.AfterGPUHeadroomFilter == 0:
+		return aimv1alpha1.AIMServiceReasonInsufficientGPUHeadroom, fmt.Sprintf(
+			"No templates for model %q fit the GPU headroom: %d template(s) need more than %d%% of the free GPUs (%s)",
+			modelName, diag.AfterGPUAvailabilityFilter, r.opts.Headroom.Percent, headroomExplanations(r.Evaluations))
+	case diag.AfterGPUHeadroomFilter > 0 && diag.AfterGPUPreferenceFilter == 0:
+		return aimv1alpha1.AIMServiceReasonTemplateNotFound, fmt.Sprintf(
+			"No available templates for model %q match the GPU preference %s",
+			modelName, strings.Join(r.opts.GPUPreference, ", "))
+	default:
+		return aimv1alpha1.AIMServiceReasonTemplateNotFound,
+			fmt.Sprintf("No available templates match requirements for model %q", modelName)
+	}
+}
+
+// NotReady reports whether templates for the model exist in the namespace but none is Ready.
+func (r Result) NotReady() bool {
+	return r.Selected == nil && r.Diagnostics.AfterNamespaceFilter > 0 && r.Diagnostics.AfterAvailabilityFilter == 0
+}
+
+// MatchingResults converts the evaluations to the API candidate results.
+func MatchingResults(evaluations []Evaluation) []aimv1alpha1.AIMTemplateCandidateResult {
+	results := make([]aimv1alpha1.AIMTemplateCandidateResult, len(evaluations))
+	for i, eval := range evaluations {
+		results[i] = aimv1alpha1.AIMTemplateCandidateResult{
+			Name:    eval.Candidate.Name,
+			Status:  eval.Status,
+			Reason:  eval.Reason,
+			Message: eval.Message,
+		}
+	}
+	return results
+}
+
+// buildFinalEvaluations creates the evaluation list for the final selected candidates.
+func buildFinalEvaluations(filtered []Candidate, selected *Candidate, rejected Rejections) []Evaluation {
+	evaluations := make([]Evaluation, 0, len(filtered)+len(rejected))
+	appendRejections(&evaluations, rejected)
+
+	for i, c := range filtered {
+		if c.Name == selected.Name {
+			evaluations = append(evaluations, Evaluation{
+				Candidate: c,
+				Status:    StatusChosen,
+				Reason:    "BestMatch",
+				Rank:      1,
+				Message:   c.Explanation,
+			})
+		} else {
+			evaluations = append(evaluations, Evaluation{
+				Candidate: c,
+				Status:    StatusRejected,
+				Reason:    "LowerPreferenceRank",
+				Rank:      i + 1,
+				Message:   c.Explanation,
+			})
+		}
+	}
+	return evaluations
+}
+
+// buildPolicyEvaluations creates the evaluation list for candidates ranked by a selection policy.
+// The first ranked candidate is chosen; candidates the policy did not rank are rejected.
+func buildPolicyEvaluations(filtered []Candidate, ranked []Candidate, rejected Rejections) []Evaluation {
+	evaluations := make([]Evaluation, 0, len(filtered)+len(rejected))
+	appendRejections(&evaluations, rejected)
+	rankedNames := make(map[string]bool, len(ranked))
+	for i, c := range ranked {
+		rankedNames[c.Name] = true
+		eval := Evaluation{
+			Candidate: c,
+			Status:    StatusRejected,
+			Reason:    "LowerPolicyRank",
+			Rank:      i + 1,
+			Message:   c.Explanation,
+		}
+		if i == 0 {
+			eval.Status = StatusChosen
+			eval.Reason = "RankedBySelectionPolicy"
+		}
+		evaluations = append(evaluations, eval)
+	}
+	for _, c := range filtered {
+		if !rankedNames[c.Name] {
+			evaluations = append(evaluations, Evaluation{
+				Candidate: c,
+				Status:    StatusRejected,
+				Reason:    "NotRankedBySelectionPolicy",
+				Message:   c.Explanation,
+			})
+		}
+	}
+	return evaluations
+}
+
+func appendRejections(evals *[]Evaluation, rejected Rejections) {
+	for _, c := range rejected[StageAvailability] {
+		*evals = append(*evals, Evaluation{
+			Candidate: c,
+			Status:    StatusRejected,
+			Reason:    rejectionReasonForStatus(c.Status.Status),
+		})
+	}
+
+	addWithReason := func(stage Stage, reason string) {
+		for _, c := range rejected[stage] {
+			*evals = append(*evals, Evaluation{
+				Candidate: c,
+				Status:    StatusRejected,
+				Reason:    reason,
+				Message:   c.Explanation,
+			})
+		}
+	}
+
+	addWithReason(StageNamespace, "NamespaceNotAllowed")
+	addWithReason(StageCapacity, aimv1alpha1.AIMServiceReasonTemplateAtCapacity)
+	addWithReason(StageUnoptimized, "UnoptimizedTemplateFiltered")
+	addWithReason(StageOverrides, "ServiceOverridesNotMatched")
+	addWithReason(StageCompute, "ComputeModeNotMatched")
+	addWithReason(StageGPU, "RequiredGPUNotInCluster")
+	addWithReason(StageHeadroom, reasonGPUHeadroomExceeded)
+	addWithReason(StagePreference, "GPUModelNotPreferred")
+	addWithReason(StageGPURank, "LowerGPUPreference")
+	addWithReason(StageGoal, "NotRecommendedForGoal")
+}
+
+func rejectionReasonForStatus(status constants.AIMStatus) string {
+	switch status {
+	case constants.AIMStatusPending:
+		return "TemplatePending"
+	case constants.AIMStatusProgressing:
+		return "TemplateProgressing"
+	case constants.AIMStatusNotAvailable:
+		return "TemplateNotAvailable"
+	case constants.AIMStatusDegraded:
+		return "TemplateDegraded"
+	case constants.AIMStatusFailed:
+		return "TemplateFailed"
+	default:
+		return "TemplateNotReady"
+	}
+}
@@ -0,0 +1,1072 @@
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package selection
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/constants"
+)
+
+const testNamespace = "test-ns"
+
+// ============================================================================
+// BUILDERS
+// ============================================================================
+
+// CandidateBuilder provides a fluent API for constructing Candidate test fixtures.
+type CandidateBuilder struct {
+	candidate Candidate
+}
+
+// NewCandidate creates a new CandidateBuilder with sensible defaults.
+func NewCandidate(name string) *CandidateBuilder {
+	return &CandidateBuilder{
+		candidate: Candidate{
+			Name:      name,
+			Namespace: testNamespace,
+			Scope:     aimv1alpha1.AIMResolutionScopeNamespace,
+			Status: aimv1alpha1.AIMServiceTemplateStatus{
+				Status: constants.AIMStatusReady,
+				Profile: &aimv1alpha1.AIMProfile{
+					Metadata: aimv1alpha1.AIMProfileMetadata{
+						Type: aimv1alpha1.AIMProfileTypeOptimized,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *CandidateBuilder) WithNamespace(ns string) *CandidateBuilder {
+	b.candidate.Namespace = ns
+	return b
+}
+
+func (b *CandidateBuilder) WithScope(scope aimv1alpha1.AIMResolutionScope) *CandidateBuilder {
+	b.candidate.Scope = scope
+	return b
+}
+
+func (b *CandidateBuilder) WithStatus(status constants.AIMStatus) *CandidateBuilder {
+	b.candidate.Status.Status = status
+	return b
+}
+
+func (b *CandidateBuilder) WithProfileType(profileType aimv1alpha1.AIMProfileType) *CandidateBuilder {
+	b.candidate.Status.Profile.Metadata.Type = profileType
+	return b
+}
+
+func (b *CandidateBuilder) WithGPU(model string, count int) *CandidateBuilder {
+	b.candidate.Status.Profile.Metadata.GPU = model
+	b.candidate.Status.Profile.Metadata.GPUCount = int32(count)
+	return b
+}
+
+func (b *CandidateBuilder) WithMetric(metric aimv1alpha1.AIMMetric) *CandidateBuilder {
+	b.candidate.Status.Profile.Metadata.Metric = metric
+	return b
+}
+
+func (b *CandidateBuilder) WithPrecision(precision aimv1alpha1.AIMPrecision) *CandidateBuilder {
+	b.candidate.Status.Profile.Metadata.Precision = precision
+	return b
+}
+
+func (b *CandidateBuilder) WithCompute(compute aimv1alpha1.AIMComputeMode) *CandidateBuilder {
+	b.candidate.Spec.Compute = compute
+	return b
+}
+
+func (b *CandidateBuilder) Build() Candidate {
+	return b.candidate
+}
+
+// ============================================================================
+// STAGE 0: NAMESPACE FILTER TESTS
+// ============================================================================
+
+func TestFilterByNamespace(t *testing.T) {
+	denied := NewCandidate("denied").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build()
+	denied.NamespaceDenied = true
+	candidates := []Candidate{
+		NewCandidate("allowed").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
+		denied,
+	}
+
+	rejected := make(Rejections)
+	result := FilterByNamespace(candidates, rejected)
+
+	if len(result) != 1 || result[0].Name != "allowed" {
+		t.Errorf("expected only allowed candidate, got %v", result)
+	}
+	if len(rejected[StageNamespace]) != 1 {
+		t.Errorf("expected 1 rejected, got %d", len(rejected[StageNamespace]))
+	}
+}
+
+// ============================================================================
+// STAGE 1: AVAILABILITY FILTER TESTS
+// ============================================================================
+
+func TestFilterByAvailability(t *testing.T) {
+	tests := []struct {
+		name             string
+		candidates       []Candidate
+		expectedCount    int
+		expectedRejected int
+	}{
+		{
+			name:             "empty candidates",
+			candidates:       []Candidate{},
+			expectedCount:    0,
+			expectedRejected: 0,
+		},
+		{
+			name: "all ready",
+			candidates: []Candidate{
+				NewCandidate("t1").WithStatus(constants.AIMStatusReady).Build(),
+				NewCandidate("t2").WithStatus(constants.AIMStatusReady).Build(),
+			},
+			expectedCount:    2,
+			expectedRejected: 0,
+		},
+		{
+			name: "all not ready",
+			candidates: []Candidate{
+				NewCandidate("t1").WithStatus(constants.AIMStatusPending).Build(),
+				NewCandidate("t2").WithStatus(constants.AIMStatusProgressing).Build(),
+				NewCandidate("t3").WithStatus(constants.AIMStatusFailed).Build(),
+			},
+			expectedCount:    0,
+			expectedRejected: 3,
+		},
+		{
+			name: "mixed statuses",
+			candidates: []Candidate{
+				NewCandidate("ready1").WithStatus(constants.AIMStatusReady).Build(),
+				NewCandidate("pending").WithStatus(constants.AIMStatusPending).Build(),
+				NewCandidate("ready2").WithStatus(constants.AIMStatusReady).Build(),
+				NewCandidate("failed").WithStatus(constants.AIMStatusFailed).Build(),
+			},
+			expectedCount:    2,
+			expectedRejected: 2,
+		},
+		{
+			name: "NotAvailable status rejected",
+			candidates: []Candidate{
+				NewCandidate("ready").WithStatus(constants.AIMStatusReady).Build(),
+				NewCandidate("notavail").WithStatus(constants.AIMStatusNotAvailable).Build(),
+			},
+			expectedCount:    1,
+			expectedRejected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rejected := make(Rejections)
+			result := FilterByAvailability(tt.candidates, rejected)
+
+			if len(result) != tt.expectedCount {
+				t.Errorf("expected %d candidates, got %d", tt.expectedCount, len(result))
+			}
+			if len(rejected[StageAvailability]) != tt.expectedRejected {
+				t.Errorf("expected %d rejected, got %d", tt.expectedRejected, len(rejected[StageAvailability]))
+			}
+		})
+	}
+}
+
+// ============================================================================
+// STAGE 2: OPTIMIZATION STATUS FILTER TESTS
+// ============================================================================
+
+func TestFilterByOptimizationStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		candidates       []Candidate
+		allowUnoptimized bool
+		expectedCount    int
+		expectedRejected int
+	}{
+		{
+			name: "all optimized - no unoptimized allowed",
+			candidates: []Candidate{
+				NewCandidate("t1").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
+				NewCandidate("t2").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
+			},
+			allowUnoptimized: false,
+			expectedCount:    2,
+			expectedRejected: 0,
+		},
+		{
+			name: "mixed - unoptimized rejected when not allowed",
+			candidates: []Candidate{
+				NewCandidate("optimized").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
+				NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build(),
+				NewCandidate("preview").WithProfileType(aimv1alpha1.AIMProfileTypePreview).Build(),
+			},
+			allowUnoptimized: false,
+			expectedCount:    1,
+			expectedRejected: 2,
+		},
+		{
+			name: "mixed - all allowed when allowUnoptimized=true",
+			candidates: []Candidate{
+				NewCandidate("optimized").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
+				NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build(),
+				NewCandidate("preview").WithProfileType(aimv1alpha1.AIMProfileTypePreview).Build(),
+			},
+			allowUnoptimized: true,
+			expectedCount:    3,
+			expectedRejected: 0,
+		},
+		{
+			name: "only unoptimized - all rejected when not allowed",
+			candidates: []Candidate{
+				NewCandidate("t1").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build(),
+				NewCandidate("t2").WithProfileType(aimv1alpha1.AIMProfileTypePreview).Build(),
+			},
+			allowUnoptimized: false,
+			expectedCount:    0,
+			expectedRejected: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rejected := make(Rejections)
+			result := FilterByOptimizationStatus(tt.candidates, tt.allowUnoptimized, rejected)
+
+			if len(result) != tt.expectedCount {
+				t.Errorf("expected %d candidates, got %d", tt.expectedCount, len(result))
+			}
+			if len(rejected[StageUnoptimized]) != tt.expectedRejected {
+				t.Errorf("expected %d rejected, got %d", tt.expectedRejected, len(rejected[StageUnoptimized]))
+			}
+		})
+	}
+}
+
+// ============================================================================
+// STAGE 3: OVERRIDES FILTER TESTS
+// ============================================================================
+
+func TestFilterByOverrides(t *testing.T) {
+	latency := aimv1alpha1.AIMMetricLatency
+	throughput := aimv1alpha1.AIMMetricThroughput
+	fp16 := aimv1alpha1.AIMPrecisionFP16
+	fp8 := aimv1alpha1.AIMPrecisionFP8
+
+	tests := []struct {
+		name          string
+		candidates    []Candidate
+		overrides     *aimv1alpha1.AIMServiceOverrides
+		expectedNames []string
+	}{
+		{
+			name: "nil overrides - all pass",
+			candidates: []Candidate{
+				NewCandidate("t1").WithMetric(latency).Build(),
+				NewCandidate("t2").WithMetric(throughput).Build(),
+			},
+			overrides:     nil,
+			expectedNames: []string{"t1", "t2"},
+		},
+		{
+			name: "filter by metric",
+			candidates: []Candidate{
+				NewCandidate("latency").WithMetric(latency).Build(),
+				NewCandidate("throughput").WithMetric(throughput).Build(),
+			},
+			overrides:     &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Metric: &latency}},
+			expectedNames: []string{"latency"},
+		},
+		{
+			name: "filter by precision",
+			candidates: []Candidate{
+				NewCandidate("fp16").WithPrecision(fp16).Build(),
+				NewCandidate("fp8").WithPrecision(fp8).Build(),
+			},
+			overrides:     &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Precision: &fp16}},
+			expectedNames: []string{"fp16"},
+		},
+		{
+			name: "filter by GPU model",
+			candidates: []Candidate{
+				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
+				NewCandidate("mi325x").WithGPU("MI325X", 8).Build(),
+			},
+			overrides: &aimv1alpha1.AIMServiceOverrides{
+				AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
+					Hardware: &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{Model: "MI300X"}}, // Only model, no count
+				},
+			},
+			expectedNames: []string{"mi300x"},
+		},
+		{
+			name: "filter by GPU count",
+			candidates: []Candidate{
+				NewCandidate("4gpu").WithGPU("MI300X", 4).Build(),
+				NewCandidate("8gpu").WithGPU("MI300X", 8).Build(),
+			},
+			overrides: &aimv1alpha1.AIMServiceOverrides{
+				AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
+					Hardware: &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{Requests: 4}},
+				},
+			},
+			expectedNames: []string{"4gpu"},
+		},
+		{
+			name: "filter by multiple overrides",
+			candidates: []Candidate{
+				NewCandidate("match").WithGPU("MI300X", 4).WithPrecision(fp16).WithMetric(latency).Build(),
+				NewCandidate("wrong-gpu").WithGPU("MI325X", 4).WithPrecision(fp16).WithMetric(latency).Build(),
+				NewCandidate("wrong-precision").WithGPU("MI300X", 4).WithPrecision(fp8).WithMetric(latency).Build(),
+			},
+			overrides: &aimv1alpha1.AIMServiceOverrides{
+				AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{
+					Hardware:  &aimv1alpha1.AIMHardwareRequirements{GPU: &aimv1alpha1.AIMGpuRequirements{Model: "MI300X", Requests: 4}},
+					Precision: &fp16,
+					Metric:    &latency,
+				},
+			},
+			expectedNames: []string{"match"},
+		},
+		{
+			name: "no matches",
+			candidates: []Candidate{
+				NewCandidate("t1").WithMetric(throughput).Build(),
+				NewCandidate("t2").WithMetric(throughput).Build(),
+			},
+			overrides:     &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Metric: &latency}},
+			expectedNames: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FilterByOverrides(tt.candidates, tt.overrides)
+
+			if len(result) != len(tt.expectedNames) {
+				t.Errorf("expected %d candidates, got %d", len(tt.expectedNames), len(result))
+				return
+			}
+
+			for i, expected := range tt.expectedNames {
+				if result[i].Name != expected {
+					t.Errorf("expected candidate[%d].Name=%s, got %s", i, expected, result[i].Name)
+				}
+			}
+		})
+	}
+}
+
+// ============================================================================
+// STAGE 4: GPU AVAILABILITY FILTER TESTS
+// ============================================================================
+
+func TestFilterByGPUAvailability(t *testing.T) {
+	tests := []struct {
+		name          string
+		candidates    []Candidate
+		availableGPUs []string
+		expectedNames []string
+	}{
+		{
+			name: "no GPUs available - all rejected",
+			candidates: []Candidate{
+				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
+				NewCandidate("mi325x").WithGPU("MI325X", 8).Build(),
+			},
+			availableGPUs: []string{},
+			expectedNames: []string{},
+		},
+		{
+			name: "all GPUs available",
+			candidates: []Candidate{
+				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
+				NewCandidate("mi325x").WithGPU("MI325X", 8).Build(),
+			},
+			availableGPUs: []string{"MI300X", "MI325X"},
+			expectedNames: []string{"mi300x", "mi325x"},
+		},
+		{
+			name: "partial GPU availability",
+			candidates: []Candidate{
+				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
+				NewCandidate("mi325x").WithGPU("MI325X", 8).Build(),
+				NewCandidate("mi250x").WithGPU("MI250X", 4).Build(),
+			},
+			availableGPUs: []string{"MI300X", "MI250X"},
+			expectedNames: []string{"mi300x", "mi250x"},
+		},
+		{
+			name: "candidate without GPU spec - passes",
+			candidates: []Candidate{
+				NewCandidate("no-gpu").Build(), // No GPU specified
+				NewCandidate("mi300x").WithGPU("MI300X", 4).Build(),
+			},
+			availableGPUs: []string{},
+			expectedNames: []string{"no-gpu"},
+		},
+		{
+			name: "case-insensitive GPU matching",
+			candidates: []Candidate{
+				NewCandidate("mi300x-lower").WithGPU("mi300x", 4).Build(),
+				NewCandidate("MI300X-upper").WithGPU("MI300X", 4).Build(),
+			},
+			availableGPUs: []string{"MI300X"},
+			expectedNames: []string{"mi300x-lower", "MI300X-upper"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FilterByGPUAvailability(tt.candidates, tt.availableGPUs)
+
+			if len(result) != len(tt.expectedNames) {
+				t.Errorf("expected %d candidates, got %d", len(tt.expectedNames), len(result))
+				return
+			}
+
+			for i, expected := range tt.expectedNames {
+				if result[i].Name != expected {
+					t.Errorf("expected candidate[%d].Name=%s, got %s", i, expected, result[i].Name)
+				}
+			}
+		})
+	}
+}
+
+// ============================================================================
+// GPU PREFERENCE FILTER TESTS
+// ============================================================================
+
+func TestFilterByGPUPreference(t *testing.T) {
+	mi325 := NewCandidate("mi325").WithGPU("MI325X", 4).Build()
+	mi300 := NewCandidate("mi300").WithGPU("MI300X", 4).Build()
+	mi210 := NewCandidate("mi210").WithGPU("MI210", 4).Build()
+	preference := []string{"MI325X", "MI300X"}
+
+	tests := []struct {
+		name     string
+		free     map[string]int64
+		expected string
+	}{
+		{
+			name:     "most preferred model with capacity",
+			free:     map[string]int64{"MI325X": 8, "MI300X": 8},
+			expected: "mi325",
+		},
+		{
+			name:     "falls back to next model with capacity",
+			free:     map[string]int64{"MI325X": 2, "MI300X": 8},
+			expected: "mi300",
+		},
+		{
+			name:     "most preferred model when none has capacity",
+			free:     map[string]int64{},
+			expected: "mi325",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rejected := make(Rejections)
+			freeGPUs := &GPUHeadroom{Percent: 100, Free: tt.free}
+			result := FilterByGPUPreference([]Candidate{mi210, mi300, mi325}, preference, freeGPUs, rejected)
+
+			if len(result) != 1 || result[0].Name != tt.expected {
+				t.Fatalf("expected [%s], got %+v", tt.expected, result)
+			}
+			if len(rejected[StagePreference]) != 1 || rejected[StagePreference][0].Name != "mi210" {
+				t.Errorf("expected mi210 to be rejected as not preferred, got %+v", rejected[StagePreference])
+			}
+			if len(rejected[StageGPURank]) != 1 {
+				t.Errorf("expected one lower preference rejection, got %+v", rejected[StageGPURank])
+			}
+		})
+	}
+}
+
+// ============================================================================
+// STAGE 5: SCOPE PREFERENCE TESTS
+// ============================================================================
+
+func TestPreferNamespaceTemplates(t *testing.T) {
+	tests := []struct {
+		name          string
+		candidates    []Candidate
+		expectedNames []string
+	}{
+		{
+			name: "all namespace-scoped",
+			candidates: []Candidate{
+				NewCandidate("ns1").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
+				NewCandidate("ns2").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
+			},
+			expectedNames: []string{"ns1", "ns2"},
+		},
+		{
+			name: "all cluster-scoped",
+			candidates: []Candidate{
+				NewCandidate("cl1").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
+				NewCandidate("cl2").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
+			},
+			expectedNames: []string{"cl1", "cl2"}, // No namespace templates, so cluster ones pass
+		},
+		{
+			name: "mixed - namespace preferred",
+			candidates: []Candidate{
+				NewCandidate("cluster").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
+				NewCandidate("namespace").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
+			},
+			expectedNames: []string{"namespace"}, // Only namespace template returned
+		},
+		{
+			name: "multiple mixed - all namespace returned",
+			candidates: []Candidate{
+				NewCandidate("cl1").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
+				NewCandidate("ns1").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
+				NewCandidate("cl2").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build(),
+				NewCandidate("ns2").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).Build(),
+			},
+			expectedNames: []string{"ns1", "ns2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PreferNamespaceTemplates(tt.candidates)
+
+			if len(result) != len(tt.expectedNames) {
+				t.Errorf("expected %d candidates, got %d", len(tt.expectedNames), len(result))
+				return
+			}
+
+			// Check names (order may vary for namespace templates)
+			resultNames := make(map[string]bool)
+			for _, c := range result {
+				resultNames[c.Name] = true
+			}
+			for _, expected := range tt.expectedNames {
+				if !resultNames[expected] {
+					t.Errorf("expected candidate %s not found in result", expected)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterBySelectionGoal(t *testing.T) {
+	withPerf := func(name string, latency time.Duration, perGPU int64) Candidate {
+		c := NewCandidate(name).WithGPU("MI300X", 1).Build()
+		c.Status.Performance = &aimv1alpha1.AIMProfilePerformance{
+			Source:                aimv1alpha1.AIMPerformanceSourceBenchmark,
+			Latency:               &metav1.Duration{Duration: latency},
+			TokensPerSecondPerGPU: ptr.To(perGPU),
+		}
+		return c
+	}
+	fast := withPerf("fast", 10*time.Millisecond, 500)
+	dense := withPerf("dense", 20*time.Millisecond, 900)
+	unmeasured := NewCandidate("unmeasured").WithGPU("MI300X", 1).Build()
+
+	tests := []struct {
+		name          string
+		candidates    []Candidate
+		goal          aimv1alpha1.AIMSelectionGoal
+		expectedNames []string
+		rejected      int
+	}{
+		{
+			name:          "latency keeps the lowest latency",
+			candidates:    []Candidate{fast, dense, unmeasured},
+			goal:          aimv1alpha1.AIMSelectionGoalLatency,
+			expectedNames: []string{"fast"},
+			rejected:      2,
+		},
+		{
+			name:          "throughput per GPU keeps the highest throughput",
+			candidates:    []Candidate{fast, dense, unmeasured},
+			goal:          aimv1alpha1.AIMSelectionGoalThroughputPerGPU,
+			expectedNames: []string{"dense"},
+			rejected:      2,
+		},
+		{
+			name:          "no measurements keeps all candidates",
+			candidates:    []Candidate{unmeasured, NewCandidate("other").Build()},
+			goal:          aimv1alpha1.AIMSelectionGoalLatency,
+			expectedNames: []string{"unmeasured", "other"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rejected := make(Rejections)
+			result := FilterBySelectionGoal(tt.candidates, tt.goal, rejected)
+
+			var names []string
+			for _, c := range result {
+				names = append(names, c.Name)
+			}
+			if !slices.Equal(names, tt.expectedNames) {
+				t.Errorf("expected %v, got %v", tt.expectedNames, names)
+			}
+			if len(rejected[StageGoal]) != tt.rejected {
+				t.Errorf("expected %d goal rejections, got %d", tt.rejected, len(rejected[StageGoal]))
+			}
+		})
+	}
+}
+
+// ============================================================================
+// STAGE 6: PREFERENCE SCORING TESTS
+// ============================================================================
+
+func TestChoosePreferred(t *testing.T) {
+	latency := aimv1alpha1.AIMMetricLatency
+	throughput := aimv1alpha1.AIMMetricThroughput
+	fp16 := aimv1alpha1.AIMPrecisionFP16
+	fp8 := aimv1alpha1.AIMPrecisionFP8
+	bf16 := aimv1alpha1.AIMPrecisionBF16
+
+	tests := []struct {
+		name          string
+		candidates    []Candidate
+		expectedName  string
+		expectedCount int // Number of candidates with identical best scores
+	}{
+		{
+			name:          "empty candidates",
+			candidates:    []Candidate{},
+			expectedName:  "",
+			expectedCount: 0,
+		},
+		{
+			name: "single candidate",
+			candidates: []Candidate{
+				NewCandidate("only").Build(),
+			},
+			expectedName:  "only",
+			expectedCount: 1,
+		},
+		{
+			name: "prefer optimized over unoptimized",
+			candidates: []Candidate{
+				NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).Build(),
+				NewCandidate("optimized").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).Build(),
+			},
+			expectedName:  "optimized",
+			expectedCount: 1,
+		},
+		{
+			name: "prefer MI325X over MI300X (GPU tier)",
+			candidates: []Candidate{
+				NewCandidate("mi300x").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).Build(),
+				NewCandidate("mi325x").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI325X", 4).Build(),
+			},
+			expectedName:  "mi325x",
+			expectedCount: 1,
+		},
+		{
+			name: "prefer latency over throughput (metric)",
+			candidates: []Candidate{
+				NewCandidate("throughput").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(throughput).Build(),
+				NewCandidate("latency").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).Build(),
+			},
+			expectedName:  "latency",
+			expectedCount: 1,
+		},
+		{
+			name: "prefer fp8 over fp16 (precision)",
+			candidates: []Candidate{
+				NewCandidate("fp16").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
+				NewCandidate("fp8").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp8).Build(),
+			},
+			expectedName:  "fp8",
+			expectedCount: 1,
+		},
+		{
+			name: "profile type beats GPU tier",
+			candidates: []Candidate{
+				NewCandidate("unoptimized-mi325x").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).WithGPU("MI325X", 8).Build(),
+				NewCandidate("optimized-mi300x").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).Build(),
+			},
+			expectedName:  "optimized-mi300x",
+			expectedCount: 1,
+		},
+		{
+			name: "GPU tier beats metric",
+			candidates: []Candidate{
+				NewCandidate("mi300x-latency").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).Build(),
+				NewCandidate("mi325x-throughput").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI325X", 4).WithMetric(throughput).Build(),
+			},
+			expectedName:  "mi325x-throughput",
+			expectedCount: 1,
+		},
+		{
+			name: "identical scores - count > 1",
+			candidates: []Candidate{
+				NewCandidate("t1").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
+				NewCandidate("t2").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
+			},
+			expectedName:  "t1", // First one wins when identical
+			expectedCount: 2,
+		},
+		{
+			name: "complex scenario with multiple factors",
+			candidates: []Candidate{
+				NewCandidate("worst").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).WithGPU("MI210", 4).WithMetric(throughput).WithPrecision(bf16).Build(),
+				NewCandidate("mid").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).WithMetric(throughput).WithPrecision(fp16).Build(),
+				NewCandidate("best").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI325X", 8).WithMetric(latency).WithPrecision(fp8).Build(),
+			},
+			expectedName:  "best",
+			expectedCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, count := ChoosePreferred(tt.candidates)
+
+			if tt.expectedName == "" {
+				if selected != nil {
+					t.Errorf("expected nil selection, got %s", selected.Name)
+				}
+			} else {
+				if selected == nil {
+					t.Errorf("expected selection %s, got nil", tt.expectedName)
+					return
+				}
+				if selected.Name != tt.expectedName {
+					t.Errorf("expected selection %s, got %s", tt.expectedName, selected.Name)
+				}
+			}
+
+			if count != tt.expectedCount {
+				t.Errorf("expected count %d, got %d", tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// FULL SELECTION ALGORITHM TESTS
+// ============================================================================
+
+func TestSelect(t *testing.T) {
+	latency := aimv1alpha1.AIMMetricLatency
+	fp16 := aimv1alpha1.AIMPrecisionFP16
+
+	tests := []struct {
+		name             string
+		candidates       []Candidate
+		overrides        *aimv1alpha1.AIMServiceOverrides
+		compute          aimv1alpha1.AIMComputeMode
+		availableGPUs    []string
+		allowUnoptimized bool
+		expectedName     string
+		expectedCount    int
+	}{
+		{
+			name:          "empty candidates",
+			candidates:    []Candidate{},
+			availableGPUs: []string{"MI300X"},
+			expectedName:  "",
+			expectedCount: 0,
+		},
+		{
+			name: "single matching candidate",
+			candidates: []Candidate{
+				NewCandidate("only").WithGPU("MI300X", 4).Build(),
+			},
+			availableGPUs: []string{"MI300X"},
+			expectedName:  "only",
+			expectedCount: 1,
+		},
+		{
+			name: "filter by availability first",
+			candidates: []Candidate{
+				NewCandidate("not-ready").WithStatus(constants.AIMStatusPending).WithGPU("MI325X", 8).Build(),
+				NewCandidate("ready").WithStatus(constants.AIMStatusReady).WithGPU("MI300X", 4).Build(),
+			},
+			availableGPUs: []string{"MI300X", "MI325X"},
+			expectedName:  "ready",
+			expectedCount: 1,
+		},
+		{
+			name: "filter by optimization status",
+			candidates: []Candidate{
+				NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).WithGPU("MI325X", 8).Build(),
+				NewCandidate("optimized").WithProfileType(aimv1alpha1.AIMProfileTypeOptimized).WithGPU("MI300X", 4).Build(),
+			},
+			availableGPUs:    []string{"MI300X", "MI325X"},
+			allowUnoptimized: false,
+			expectedName:     "optimized",
+			expectedCount:    1,
+		},
+		{
+			name: "filter by overrides",
+			candidates: []Candidate{
+				NewCandidate("wrong-metric").WithGPU("MI300X", 4).WithMetric(aimv1alpha1.AIMMetricThroughput).Build(),
+				NewCandidate("right-metric").WithGPU("MI300X", 4).WithMetric(latency).Build(),
+			},
+			overrides:     &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Metric: &latency}},
+			availableGPUs: []string{"MI300X"},
+			expectedName:  "right-metric",
+			expectedCount: 1,
+		},
+		{
+			name: "filter by GPU availability",
+			candidates: []Candidate{
+				NewCandidate("unavailable-gpu").WithGPU("MI325X", 8).Build(),
+				NewCandidate("available-gpu").WithGPU("MI300X", 4).Build(),
+			},
+			availableGPUs: []string{"MI300X"}, // MI325X not available
+			expectedName:  "available-gpu",
+			expectedCount: 1,
+		},
+		{
+			name: "prefer namespace over cluster",
+			candidates: []Candidate{
+				NewCandidate("cluster").WithScope(aimv1alpha1.AIMResolutionScopeCluster).WithGPU("MI325X", 8).Build(),
+				NewCandidate("namespace").WithScope(aimv1alpha1.AIMResolutionScopeNamespace).WithGPU("MI300X", 4).Build(),
+			},
+			availableGPUs: []string{"MI300X", "MI325X"},
+			expectedName:  "namespace",
+			expectedCount: 1,
+		},
+		{
+			name: "all filters eliminate candidates",
+			candidates: []Candidate{
+				NewCandidate("not-ready").WithStatus(constants.AIMStatusFailed).Build(),
+				NewCandidate("wrong-gpu").WithGPU("MI325X", 8).Build(),
+			},
+			availableGPUs: []string{"MI300X"}, // MI325X not available
+			expectedName:  "",
+			expectedCount: 0,
+		},
+		{
+			name: "full pipeline with scoring",
+			candidates: []Candidate{
+				NewCandidate("good").WithGPU("MI300X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
+				NewCandidate("better").WithGPU("MI325X", 4).WithMetric(latency).WithPrecision(fp16).Build(),
+			},
+			availableGPUs: []string{"MI300X", "MI325X"},
+			expectedName:  "better", // MI325X preferred
+			expectedCount: 1,
+		},
+		{
+			name: "cpu compute selects cpu template without GPUs in cluster",
+			candidates: []Candidate{
+				NewCandidate("gpu").WithGPU("MI300X", 1).Build(),
+				NewCandidate("cpu").WithCompute(aimv1alpha1.AIMComputeModeCPU).Build(),
+			},
+			compute:       aimv1alpha1.AIMComputeModeCPU,
+			availableGPUs: nil,
+			expectedName:  "cpu",
+			expectedCount: 1,
+		},
+		{
+			name: "cpu compute without cpu templates",
+			candidates: []Candidate{
+				NewCandidate("gpu").WithGPU("MI300X", 1).Build(),
+			},
+			compute:       aimv1alpha1.AIMComputeModeCPU,
+			availableGPUs: []string{"MI300X"},
+			expectedName:  "",
+			expectedCount: 0,
+		},
+		{
+			name: "gpu compute excludes cpu templates",
+			candidates: []Candidate{
+				NewCandidate("gpu").WithGPU("MI300X", 1).Build(),
+				NewCandidate("cpu").WithCompute(aimv1alpha1.AIMComputeModeCPU).Build(),
+			},
+			compute:       aimv1alpha1.AIMComputeModeGPU,
+			availableGPUs: []string{"MI300X"},
+			expectedName:  "gpu",
+			expectedCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Select(tt.candidates, Options{
+				Overrides:        tt.overrides,
+				Compute:          tt.compute,
+				AvailableGPUs:    tt.availableGPUs,
+				AllowUnoptimized: tt.allowUnoptimized,
+			})
+			selected, count := result.Selected, result.Count
+
+			if tt.expectedName == "" {
+				if selected != nil {
+					t.Errorf("expected nil selection, got %s", selected.Name)
+				}
+			} else {
+				if selected == nil {
+					t.Errorf("expected selection %s, got nil", tt.expectedName)
+					return
+				}
+				if selected.Name != tt.expectedName {
+					t.Errorf("expected selection %s, got %s", tt.expectedName, selected.Name)
+				}
+			}
+
+			if count != tt.expectedCount {
+				t.Errorf("expected count %d, got %d", tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestSelect_UnoptimizedFallback(t *testing.T) {
+	candidates := []Candidate{
+		NewCandidate("unoptimized").WithProfileType(aimv1alpha1.AIMProfileTypeUnoptimized).WithGPU("MI300X", 1).Build(),
+	}
+	opts := Options{AvailableGPUs: []string{"MI300X"}}
+
+	if result := Select(candidates, opts); result.Selected != nil || result.UnoptimizedFallback {
+		t.Fatalf("expected no selection without fallback, got %+v", result.Selected)
+	}
+
+	opts.AllowUnoptimizedFallback = true
+	result := Select(candidates, opts)
+	if result.Selected == nil || result.Selected.Name != "unoptimized" || !result.UnoptimizedFallback {
+		t.Fatalf("expected fallback to the unoptimized template, got %+v", result)
+	}
+}
+
+func TestResultReason(t *testing.T) {
+	ready := NewCandidate("ready").WithGPU("MI300X", 8).Build()
+	pending := NewCandidate("pending").WithStatus(constants.AIMStatusPending).Build()
+	denied := NewCandidate("denied").WithScope(aimv1alpha1.AIMResolutionScopeCluster).Build()
+	denied.NamespaceDenied = true
+
+	tests := []struct {
+		name           string
+		candidates     []Candidate
+		opts           Options
+		expectedReason string
+		notReady       bool
+	}{
+		{
+			name:           "no candidates",
+			expectedReason: aimv1alpha1.AIMServiceReasonTemplateNotFound,
+		},
+		{
+			name:           "namespace denied",
+			candidates:     []Candidate{denied},
+			expectedReason: aimv1alpha1.AIMServiceReasonTemplateNamespaceDenied,
+		},
+		{
+			name:       "not ready",
+			candidates: []Candidate{pending},
+			notReady:   true,
+		},
+		{
+			name:       "headroom exceeded",
+			candidates: []Candidate{ready},
+			opts: Options{
+				AvailableGPUs: []string{"MI300X"},
+				Headroom:      &GPUHeadroom{Percent: 50, Free: map[string]int64{"MI300X": 8}},
+			},
+			expectedReason: aimv1alpha1.AIMServiceReasonInsufficientGPUHeadroom,
+		},
+		{
+			name:       "ambiguous",
+			candidates: []Candidate{ready, NewCandidate("twin").WithGPU("MI300X", 8).Build()},
+			opts:       Options{AvailableGPUs: []string{"MI300X"}},
+
+			expectedReason: aimv1alpha1.AIMServiceReasonTemplateSelectionAmbiguous,
+		},
+		{
+			name:       "selected",
+			candidates: []Candidate{ready},
+			opts:       Options{AvailableGPUs: []string{"MI300X"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Select(tt.candidates, tt.opts)
+			reason, message := result.Reason("llama", testNamespace)
+			if reason != tt.expectedReason {
+				t.Errorf("expected reason %q, got %q (%s)", tt.expectedReason, reason, message)
+			}
+			if (reason == "") != (message == "") {
+				t.Errorf("expected reason and message to be set together, got %q and %q", reason, message)
+			}
+			if result.NotReady() != tt.notReady {
+				t.Errorf("expected NotReady %v, got %v", tt.notReady, result.NotReady())
+			}
+		})
+	}
+}
+
+func TestValidateOverrides(t *testing.T) {
+	latency := aimv1alpha1.AIMMetricLatency
+	overrides := &aimv1alpha1.AIMServiceOverrides{AIMRuntimeParameters: aimv1alpha1.AIMRuntimeParameters{Metric: &latency}}
+
+	tests := []struct {
+		name     string
+		spec     aimv1alpha1.AIMServiceSpec
+		rejected bool
+	}{
+		{
+			name: "overrides without template name",
+			spec: aimv1alpha1.AIMServiceSpec{Overrides: overrides},
+		},
+		{
+			name: "overrides derive from named template",
+			spec: aimv1alpha1.AIMServiceSpec{Overrides: overrides, Template: aimv1alpha1.AIMServiceTemplateConfig{Name: "base"}},
+		},
+		{
+			name: "overrides rejected with named template",
+			spec: aimv1alpha1.AIMServiceSpec{Overrides: overrides, Template: aimv1alpha1.AIMServiceTemplateConfig{
+				Name: "base", OverridesBehavior: aimv1alpha1.OverridesBehaviorReject,
+			}},
+			rejected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOverrides(&tt.spec)
+			if (err != nil) != tt.rejected {
+				t.Errorf("expected rejected=%v, got %v", tt.rejected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+//go:build conformance
+
+// MIT License
+//
+// Copyright (c) 2025 Advanced Micro Devices, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package conformance runs the AIM conformance suite as a Go test against the cluster in the
+// current kubeconfig. It is excluded from unit test runs by the conformance build tag:
+//
+//	go test -tags conformance ./tests/conformance -timeout 60m -args \
+//	    -model-image ghcr.io/silogen/aim-dummy:0.1.10 -junit report.xml
+package conformance
+
+import (
+	"context"
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aimv1alpha1 "github.com/amd-enterprise-ai/aim-engine/api/v1alpha1"
+	"github.com/amd-enterprise-ai/aim-engine/internal/conformance"
+)
+
+var (
+	namespace        = flag.String("namespace", "aim-conformance", "Namespace to run in; created and deleted if it does not exist.")
+	modelImage       = flag.String("model-image", conformance.DefaultModelImage, "AIM model image to deploy.")
+	discoveryTimeout = flag.Duration("discovery-timeout", 10*time.Minute, "How long to wait for model discovery.")
+	serviceTimeout   = flag.Duration("service-timeout", 20*time.Minute, "How long to wait for the service to run.")
+	keepResources    = flag.Bool("keep", false, "Keep the created resources for inspection.")
+	junitPath        = flag.String("junit", "", "Write a JUnit report to this file.")
+)
+
+func TestConformance(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(aimv1alpha1.AddToScheme(scheme))
+
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		t.Fatalf("no cluster configured: %v", err)
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suite := conformance.NewSuite(c, clientset, conformance.Config{
+		Namespace:        *namespace,
+		ModelImage:       *modelImage,
+		DiscoveryTimeout: *discoveryTimeout,
+		ServiceTimeout:   *serviceTimeout,
+		KeepResources:    *keepResources,
+	})
+	startedAt := time.Now()
+	results := suite.Run(context.Background(), func(r conformance.Result) {
+		t.Run(r.Name, func(t *testing.T) {
+			switch {
+			case r.Skipped:
+				t.Skip("skipped after an earlier step failed")
+			case r.Err != nil:
+				t.Fatal(r.Err)
+			}
+		})
+	})
+
+	if *junitPath != "" {
+		f, err := os.Create(*junitPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = f.Close() }()
+		if err := conformance.WriteJUnit(f, "aim-conformance", startedAt, results); err != nil {
+			t.Fatal(err)
+		}
+	}
+}